@@ -37,6 +37,9 @@ var ErrGetRolesForAccount = errors.New("get roles for account error")
 // ErrGetESDTNFTData signals an error in getting esdt nft data for given address, tokenID and nonce
 var ErrGetESDTNFTData = errors.New("get esdt nft data for account error")
 
+// ErrGetAccountTransactions signals an error in getting the transactions history of an account
+var ErrGetAccountTransactions = errors.New("get account transactions error")
+
 // ErrEmptyAddress signals that an empty address was provided
 var ErrEmptyAddress = errors.New("address is empty")
 
@@ -61,12 +64,18 @@ var ErrTxGenerationFailed = errors.New("transaction generation failed")
 // ErrValidationEmptyTxHash signals that an empty tx hash was provided
 var ErrValidationEmptyTxHash = errors.New("TxHash is empty")
 
+// ErrValidationEmptySender signals that an empty sender was provided
+var ErrValidationEmptySender = errors.New("sender is empty")
+
 // ErrInvalidBlockNonce signals that an invalid block nonce was provided
 var ErrInvalidBlockNonce = errors.New("invalid block nonce")
 
 // ErrInvalidBlockRound signals that an invalid block round was provided
 var ErrInvalidBlockRound = errors.New("invalid block round")
 
+// ErrInvalidBlockEpoch signals that an invalid block epoch was provided
+var ErrInvalidBlockEpoch = errors.New("invalid block epoch")
+
 // ErrInvalidQueryParameter signals and invalid query parameter was provided
 var ErrInvalidQueryParameter = errors.New("invalid query parameter")
 
@@ -79,9 +88,18 @@ var ErrValidationEmptyToken = errors.New("token is empty")
 // ErrGetTransaction signals an error happening when trying to fetch a transaction
 var ErrGetTransaction = errors.New("getting transaction failed")
 
+// ErrGetTransactionsPool signals an error happening when trying to inspect the transactions pool
+var ErrGetTransactionsPool = errors.New("getting transactions pool failed")
+
 // ErrGetBlock signals an error happening when trying to fetch a block
 var ErrGetBlock = errors.New("getting block failed")
 
+// ErrGetBlocks signals an error happening when trying to fetch a range of blocks
+var ErrGetBlocks = errors.New("getting blocks failed")
+
+// ErrGetBlockStateChanges signals an error happening when trying to fetch the state changes of a block
+var ErrGetBlockStateChanges = errors.New("getting block state changes failed")
+
 // ErrQueryError signals a general query error
 var ErrQueryError = errors.New("query error")
 
@@ -100,9 +118,18 @@ var ErrValidationEmptyAddress = errors.New("address is empty")
 // ErrValidationEmptyKey signals that an empty key was provided
 var ErrValidationEmptyKey = errors.New("key is empty")
 
+// ErrValidationProofKeys signals that neither or both the keys and the key suffix of a multi-key proof were provided
+var ErrValidationProofKeys = errors.New("exactly one of keys and keySuffix must be provided")
+
+// ErrValidationTooManyProofKeys signals that too many keys were provided for a multi-key proof
+var ErrValidationTooManyProofKeys = errors.New("too many keys")
+
 // ErrGetProof signals an error happening when trying to compute a Merkle proof
 var ErrGetProof = errors.New("getting proof failed")
 
+// ErrGetStateDiff signals an error happening when trying to compute the diff between two states
+var ErrGetStateDiff = errors.New("getting state diff failed")
+
 // ErrVerifyProof signals an error happening when trying to verify a Merkle proof
 var ErrVerifyProof = errors.New("verifying proof failed")
 
@@ -117,3 +144,18 @@ var ErrNilFacadeHandler = errors.New("nil facade handler")
 
 // ErrFacadeWrongTypeAssertion signals that a type conversion to a facade type failed
 var ErrFacadeWrongTypeAssertion = errors.New("facade - wrong type assertion")
+
+// ErrEmptyBatch signals that a batch request without sub-requests has been received
+var ErrEmptyBatch = errors.New("empty batch")
+
+// ErrTooManyBatchSubRequests signals that a batch request holds more sub-requests than allowed
+var ErrTooManyBatchSubRequests = errors.New("too many sub-requests in batch")
+
+// ErrInvalidBatchSubRequest signals that a batch request holds a sub-request that cannot be routed
+var ErrInvalidBatchSubRequest = errors.New("invalid batch sub-request")
+
+// ErrGetStorageStatistics signals that an error occurred while getting the storage statistics
+var ErrGetStorageStatistics = errors.New("error getting storage statistics")
+
+// ErrBlockNonceAndHashProvided signals that both the block nonce and the block hash were provided, selecting the block twice
+var ErrBlockNonceAndHashProvided = errors.New("only one of the block nonce and the block hash can be provided")
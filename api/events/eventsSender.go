@@ -0,0 +1,115 @@
+package events
+
+import (
+	"encoding/json"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/outport/events"
+	"github.com/gorilla/websocket"
+)
+
+type errorMessage struct {
+	Error string `json:"error"`
+}
+
+type eventsSender struct {
+	facade eventsFacadeHandler
+	conn   wsConn
+	log    logger.Logger
+}
+
+// NewEventsSender returns a new component that streams the blocks, transactions and logs events on a web socket connection.
+// The first message received on the connection is expected to hold the JSON encoded subscription filter
+func NewEventsSender(facade eventsFacadeHandler, conn wsConn, log logger.Logger) (*eventsSender, error) {
+	if check.IfNil(facade) {
+		return nil, ErrNilFacade
+	}
+	if conn == nil {
+		return nil, ErrNilWsConn
+	}
+	if check.IfNil(log) {
+		return nil, ErrNilLogger
+	}
+
+	return &eventsSender{
+		facade: facade,
+		conn:   conn,
+		log:    log,
+	}, nil
+}
+
+// StartSendingBlocking waits for the subscription filter and after that will send the matching events while, in the
+// same time, monitoring the current connection. It returns when either the connection or the subscription ends
+func (es *eventsSender) StartSendingBlocking() {
+	defer func() {
+		_ = es.conn.Close()
+	}()
+
+	subscription, err := es.waitForSubscription()
+	if err != nil {
+		es.log.Debug("events subscription failed", "error", err.Error())
+		es.sendError(err)
+		return
+	}
+	defer es.facade.UnsubscribeFromEvents(subscription)
+
+	es.log.Debug("events subscription started", "id", subscription.ID())
+
+	go es.monitorConnection(subscription)
+	es.doSendContinuously(subscription)
+
+	es.log.Debug("events subscription ended", "id", subscription.ID())
+}
+
+func (es *eventsSender) waitForSubscription() (*events.Subscription, error) {
+	_, message, err := es.conn.ReadMessage()
+	if err != nil {
+		return nil, err
+	}
+
+	filter := events.SubscriptionFilter{}
+	err = json.Unmarshal(message, &filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return es.facade.SubscribeToEvents(filter)
+}
+
+// monitorConnection ends the subscription as soon as the connection is closed by the client
+func (es *eventsSender) monitorConnection(subscription *events.Subscription) {
+	defer es.facade.UnsubscribeFromEvents(subscription)
+
+	for {
+		mt, _, err := es.conn.ReadMessage()
+		if mt == websocket.CloseMessage || err != nil {
+			return
+		}
+	}
+}
+
+func (es *eventsSender) doSendContinuously(subscription *events.Subscription) {
+	for event := range subscription.Events() {
+		data, err := json.Marshal(event)
+		if err != nil {
+			es.log.Warn("cannot marshal event", "error", err.Error())
+			continue
+		}
+
+		err = es.conn.WriteMessage(websocket.TextMessage, data)
+		if err != nil {
+			es.log.Debug("events web socket write error", "error", err.Error())
+			return
+		}
+	}
+}
+
+func (es *eventsSender) sendError(err error) {
+	data, errMarshal := json.Marshal(&errorMessage{Error: err.Error()})
+	if errMarshal != nil {
+		return
+	}
+
+	_ = es.conn.WriteMessage(websocket.TextMessage, data)
+}
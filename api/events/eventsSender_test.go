@@ -0,0 +1,189 @@
+package events_test
+
+import (
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go/api/events"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	outportEvents "github.com/ElrondNetwork/elrond-go/outport/events"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/hashingMocks"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockWsConn(messages [][]byte) (*mock.WsConnStub, func() [][]byte) {
+	mut := sync.Mutex{}
+	written := make([][]byte, 0)
+	numRead := 0
+
+	conn := &mock.WsConnStub{}
+	conn.SetCloseHandler(func() error {
+		return nil
+	})
+	conn.SetReadMessageHandler(func() (messageType int, p []byte, err error) {
+		mut.Lock()
+		defer mut.Unlock()
+
+		if numRead >= len(messages) {
+			return websocket.CloseMessage, nil, nil
+		}
+		numRead++
+
+		return websocket.TextMessage, messages[numRead-1], nil
+	})
+	conn.SetWriteMessageHandler(func(messageType int, data []byte) error {
+		mut.Lock()
+		defer mut.Unlock()
+
+		written = append(written, data)
+		return nil
+	})
+
+	return conn, func() [][]byte {
+		mut.Lock()
+		defer mut.Unlock()
+
+		return written
+	}
+}
+
+//------- NewEventsSender
+
+func TestNewEventsSender_NilFacadeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	es, err := events.NewEventsSender(nil, &mock.WsConnStub{}, &mock.LoggerStub{})
+
+	assert.Nil(t, es)
+	assert.Equal(t, events.ErrNilFacade, err)
+}
+
+func TestNewEventsSender_NilConnectionShouldErr(t *testing.T) {
+	t.Parallel()
+
+	es, err := events.NewEventsSender(&mock.FacadeStub{}, nil, &mock.LoggerStub{})
+
+	assert.Nil(t, es)
+	assert.Equal(t, events.ErrNilWsConn, err)
+}
+
+func TestNewEventsSender_NilLoggerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	es, err := events.NewEventsSender(&mock.FacadeStub{}, &mock.WsConnStub{}, nil)
+
+	assert.Nil(t, es)
+	assert.Equal(t, events.ErrNilLogger, err)
+}
+
+func TestNewEventsSender_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	es, err := events.NewEventsSender(&mock.FacadeStub{}, &mock.WsConnStub{}, &mock.LoggerStub{})
+
+	assert.NotNil(t, es)
+	assert.Nil(t, err)
+}
+
+//------- StartSendingBlocking
+
+func TestEventsSender_StartSendingBlockingInvalidFilterShouldSendError(t *testing.T) {
+	t.Parallel()
+
+	conn, getWritten := createMockWsConn([][]byte{[]byte("not a json")})
+	facade := &mock.FacadeStub{
+		SubscribeToEventsCalled: func(filter outportEvents.SubscriptionFilter) (*outportEvents.Subscription, error) {
+			assert.Fail(t, "should not subscribe")
+			return nil, nil
+		},
+	}
+
+	es, _ := events.NewEventsSender(facade, conn, &mock.LoggerStub{})
+	es.StartSendingBlocking()
+
+	written := getWritten()
+	require.Len(t, written, 1)
+	assert.Contains(t, string(written[0]), "error")
+}
+
+func TestEventsSender_StartSendingBlockingSubscribeErrorShouldSendError(t *testing.T) {
+	t.Parallel()
+
+	filterBytes, _ := json.Marshal(&outportEvents.SubscriptionFilter{Blocks: true})
+	conn, getWritten := createMockWsConn([][]byte{filterBytes})
+	expectedErr := errors.New("expected error")
+	facade := &mock.FacadeStub{
+		SubscribeToEventsCalled: func(filter outportEvents.SubscriptionFilter) (*outportEvents.Subscription, error) {
+			return nil, expectedErr
+		},
+	}
+
+	es, _ := events.NewEventsSender(facade, conn, &mock.LoggerStub{})
+	es.StartSendingBlocking()
+
+	written := getWritten()
+	require.Len(t, written, 1)
+	assert.Equal(t, `{"error":"expected error"}`, string(written[0]))
+}
+
+func TestEventsSender_StartSendingBlockingShouldSendEvents(t *testing.T) {
+	t.Parallel()
+
+	driver, _ := outportEvents.NewEventsDriver(outportEvents.ArgsEventsDriver{
+		Marshalizer:            &testscommon.MarshalizerMock{},
+		Hasher:                 &hashingMocks.HasherMock{},
+		AddressPubKeyConverter: testscommon.NewPubkeyConverterMock(32),
+		MaxSubscribers:         1,
+		SubscriptionBufferSize: 10,
+	})
+
+	filterBytes, _ := json.Marshal(&outportEvents.SubscriptionFilter{Blocks: true})
+	conn, getWritten := createMockWsConn([][]byte{filterBytes})
+	numUnsubscribeCalls := 0
+	mutUnsubscribe := sync.Mutex{}
+	facade := &mock.FacadeStub{
+		SubscribeToEventsCalled: func(filter outportEvents.SubscriptionFilter) (*outportEvents.Subscription, error) {
+			subscription, err := driver.Subscribe(filter)
+			require.Nil(t, err)
+
+			// the events are buffered before the client starts reading them
+			_ = driver.SaveBlock(&indexer.ArgsSaveBlockData{
+				HeaderHash: []byte("hash"),
+				Header:     &block.Header{Nonce: 1},
+			})
+			_ = driver.FinalizedBlock([]byte("hash"))
+
+			return subscription, nil
+		},
+		UnsubscribeFromEventsCalled: func(subscription *outportEvents.Subscription) {
+			mutUnsubscribe.Lock()
+			numUnsubscribeCalls++
+			mutUnsubscribe.Unlock()
+
+			driver.Unsubscribe(subscription)
+		},
+	}
+
+	es, _ := events.NewEventsSender(facade, conn, &mock.LoggerStub{})
+	es.StartSendingBlocking()
+
+	written := getWritten()
+	require.Len(t, written, 1)
+
+	event := &outportEvents.Event{}
+	err := json.Unmarshal(written[0], event)
+	require.Nil(t, err)
+	assert.Equal(t, outportEvents.FinalizedBlockEvent, event.Type)
+	assert.Equal(t, uint64(1), event.Block.Nonce)
+
+	mutUnsubscribe.Lock()
+	assert.True(t, numUnsubscribeCalls > 0)
+	mutUnsubscribe.Unlock()
+}
@@ -0,0 +1,19 @@
+package events
+
+import (
+	"io"
+
+	"github.com/ElrondNetwork/elrond-go/outport/events"
+)
+
+type wsConn interface {
+	io.Closer
+	ReadMessage() (messageType int, p []byte, err error)
+	WriteMessage(messageType int, data []byte) error
+}
+
+type eventsFacadeHandler interface {
+	SubscribeToEvents(filter events.SubscriptionFilter) (*events.Subscription, error)
+	UnsubscribeFromEvents(subscription *events.Subscription)
+	IsInterfaceNil() bool
+}
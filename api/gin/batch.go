@@ -0,0 +1,153 @@
+package gin
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	batchPath     = "/batch"
+	batchEndpoint = "/batch"
+
+	defaultBatchMaxSubRequests = 500
+)
+
+// batchResponseWriter collects the response of a sub-request in memory
+type batchResponseWriter struct {
+	header     http.Header
+	statusCode int
+	body       bytes.Buffer
+}
+
+func newBatchResponseWriter() *batchResponseWriter {
+	return &batchResponseWriter{
+		header:     make(http.Header),
+		statusCode: http.StatusOK,
+	}
+}
+
+// Header returns the header map of the sub-response
+func (brw *batchResponseWriter) Header() http.Header {
+	return brw.header
+}
+
+// Write appends the provided bytes to the sub-response body
+func (brw *batchResponseWriter) Write(buff []byte) (int, error) {
+	return brw.body.Write(buff)
+}
+
+// WriteHeader saves the status code of the sub-response
+func (brw *batchResponseWriter) WriteHeader(statusCode int) {
+	brw.statusCode = statusCode
+}
+
+// registerBatchRoute registers the /batch route which executes each of the received sub-requests through the provided
+// engine, one after another. This way the sub-requests pass through the same middlewares and endpoint throttlers as
+// the requests received directly, and the closed routes stay closed
+func registerBatchRoute(ws *gin.Engine, throttlerGetter interface{}, maxSubRequests uint32) {
+	if maxSubRequests == 0 {
+		maxSubRequests = defaultBatchMaxSubRequests
+	}
+
+	ws.POST(
+		batchPath,
+		middleware.CreateEndpointThrottlerFromFacade(batchEndpoint, throttlerGetter),
+		func(c *gin.Context) {
+			var subRequests []*shared.BatchSubRequest
+			err := c.ShouldBindJSON(&subRequests)
+			if err != nil {
+				respondWithBatchError(c, fmt.Errorf("%w: %s", apiErrors.ErrValidation, err.Error()))
+				return
+			}
+
+			err = checkBatchSubRequests(subRequests, maxSubRequests)
+			if err != nil {
+				respondWithBatchError(c, err)
+				return
+			}
+
+			subResponses := make([]*shared.BatchSubResponse, 0, len(subRequests))
+			for _, subRequest := range subRequests {
+				subResponses = append(subResponses, executeBatchSubRequest(ws, c.Request, subRequest))
+			}
+
+			shared.RespondWith(c, http.StatusOK, gin.H{"responses": subResponses}, "", shared.ReturnCodeSuccess)
+		},
+	)
+}
+
+func checkBatchSubRequests(subRequests []*shared.BatchSubRequest, maxSubRequests uint32) error {
+	if len(subRequests) == 0 {
+		return apiErrors.ErrEmptyBatch
+	}
+	if len(subRequests) > int(maxSubRequests) {
+		return fmt.Errorf("%w: %d, maximum %d", apiErrors.ErrTooManyBatchSubRequests, len(subRequests), maxSubRequests)
+	}
+
+	for i, subRequest := range subRequests {
+		if subRequest == nil {
+			return fmt.Errorf("%w: index %d is empty", apiErrors.ErrInvalidBatchSubRequest, i)
+		}
+		if subRequest.Method != http.MethodGet && subRequest.Method != http.MethodPost {
+			return fmt.Errorf("%w: index %d has unsupported method %s", apiErrors.ErrInvalidBatchSubRequest, i, subRequest.Method)
+		}
+		if !strings.HasPrefix(subRequest.Path, "/") {
+			return fmt.Errorf("%w: index %d has a path not starting with /", apiErrors.ErrInvalidBatchSubRequest, i)
+		}
+		if strings.HasPrefix(subRequest.Path, batchPath) {
+			return fmt.Errorf("%w: index %d is a nested batch", apiErrors.ErrInvalidBatchSubRequest, i)
+		}
+	}
+
+	return nil
+}
+
+func executeBatchSubRequest(ws *gin.Engine, parent *http.Request, subRequest *shared.BatchSubRequest) *shared.BatchSubResponse {
+	request, err := http.NewRequest(subRequest.Method, subRequest.Path, bytes.NewReader(subRequest.Body))
+	if err != nil {
+		return createBatchErrorSubResponse(fmt.Errorf("%w: %s", apiErrors.ErrInvalidBatchSubRequest, err.Error()))
+	}
+	request = request.WithContext(parent.Context())
+	// the source throttler identifies the sender by its remote address
+	request.RemoteAddr = parent.RemoteAddr
+	request.Header.Set("Content-Type", "application/json")
+
+	writer := newBatchResponseWriter()
+	ws.ServeHTTP(writer, request)
+
+	response := writer.body.Bytes()
+	if !json.Valid(response) {
+		// not a JSON reply (i.e. the plain text reply for a missing or closed route)
+		response, _ = json.Marshal(string(response))
+	}
+
+	return &shared.BatchSubResponse{
+		StatusCode: writer.statusCode,
+		Response:   response,
+	}
+}
+
+func createBatchErrorSubResponse(err error) *shared.BatchSubResponse {
+	response, _ := json.Marshal(shared.GenericAPIResponse{
+		Data:  nil,
+		Error: err.Error(),
+		Code:  shared.ReturnCodeRequestError,
+	})
+
+	return &shared.BatchSubResponse{
+		StatusCode: http.StatusBadRequest,
+		Response:   response,
+	}
+}
+
+func respondWithBatchError(c *gin.Context, err error) {
+	shared.RespondWith(c, http.StatusBadRequest, nil, err.Error(), shared.ReturnCodeRequestError)
+}
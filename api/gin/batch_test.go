@@ -0,0 +1,160 @@
+package gin
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type batchResponse struct {
+	Data struct {
+		Responses []*shared.BatchSubResponse `json:"responses"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+func createBatchTestEngine(facade *mock.FacadeStub, maxSubRequests uint32) *gin.Engine {
+	ws := gin.New()
+
+	ws.GET("/address/:address", func(c *gin.Context) {
+		shared.RespondWith(c, http.StatusOK, gin.H{"address": c.Param("address")}, "", shared.ReturnCodeSuccess)
+	})
+	ws.POST(
+		"/transaction/send",
+		middleware.CreateEndpointThrottlerFromFacade("/transaction/send", facade),
+		func(c *gin.Context) {
+			body := make(map[string]interface{})
+			_ = c.ShouldBindJSON(&body)
+			shared.RespondWith(c, http.StatusOK, body, "", shared.ReturnCodeSuccess)
+		},
+	)
+	registerBatchRoute(ws, facade, maxSubRequests)
+
+	return ws
+}
+
+func doBatchRequest(ws *gin.Engine, body string) (*batchResponse, int) {
+	req, _ := http.NewRequest(http.MethodPost, "/batch", bytes.NewBufferString(body))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &batchResponse{}
+	_ = json.Unmarshal(resp.Body.Bytes(), response)
+
+	return response, resp.Code
+}
+
+func TestBatch_InvalidRequestsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := createBatchTestEngine(&mock.FacadeStub{}, 2)
+
+	testData := []struct {
+		body        string
+		expectedErr error
+	}{
+		{body: "invalid", expectedErr: apiErrors.ErrValidation},
+		{body: "[]", expectedErr: apiErrors.ErrEmptyBatch},
+		{body: `[{"method":"GET","path":"/a"},{"method":"GET","path":"/b"},{"method":"GET","path":"/c"}]`, expectedErr: apiErrors.ErrTooManyBatchSubRequests},
+		{body: `[{"method":"PUT","path":"/address/a"}]`, expectedErr: apiErrors.ErrInvalidBatchSubRequest},
+		{body: `[{"method":"GET","path":"address/a"}]`, expectedErr: apiErrors.ErrInvalidBatchSubRequest},
+		{body: `[{"method":"POST","path":"/batch"}]`, expectedErr: apiErrors.ErrInvalidBatchSubRequest},
+	}
+
+	for _, td := range testData {
+		response, statusCode := doBatchRequest(ws, td.body)
+		assert.Equal(t, http.StatusBadRequest, statusCode)
+		assert.True(t, strings.Contains(response.Error, td.expectedErr.Error()), td.body)
+	}
+}
+
+func TestBatch_ShouldRouteSubRequests(t *testing.T) {
+	t.Parallel()
+
+	ws := createBatchTestEngine(&mock.FacadeStub{}, 10)
+
+	body := `[
+		{"method":"GET","path":"/address/alice"},
+		{"method":"POST","path":"/transaction/send","body":{"nonce":1}},
+		{"method":"GET","path":"/missing"}
+	]`
+	response, statusCode := doBatchRequest(ws, body)
+	require.Equal(t, http.StatusOK, statusCode)
+	require.Equal(t, 3, len(response.Data.Responses))
+
+	assert.Equal(t, http.StatusOK, response.Data.Responses[0].StatusCode)
+	subResponse := shared.GenericAPIResponse{}
+	_ = json.Unmarshal(response.Data.Responses[0].Response, &subResponse)
+	assert.Equal(t, map[string]interface{}{"address": "alice"}, subResponse.Data)
+
+	assert.Equal(t, http.StatusOK, response.Data.Responses[1].StatusCode)
+	_ = json.Unmarshal(response.Data.Responses[1].Response, &subResponse)
+	assert.Equal(t, map[string]interface{}{"nonce": float64(1)}, subResponse.Data)
+
+	assert.Equal(t, http.StatusNotFound, response.Data.Responses[2].StatusCode)
+	plainTextResponse := ""
+	err := json.Unmarshal(response.Data.Responses[2].Response, &plainTextResponse)
+	assert.Nil(t, err)
+	assert.Equal(t, "404 page not found", plainTextResponse)
+}
+
+func TestBatch_SubRequestsShouldBeThrottled(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		GetThrottlerForEndpointCalled: func(endpoint string) (core.Throttler, bool) {
+			if endpoint != "/transaction/send" {
+				return nil, false
+			}
+
+			return &mock.ThrottlerStub{
+				CanProcessCalled: func() bool {
+					return false
+				},
+			}, true
+		},
+	}
+	ws := createBatchTestEngine(facade, 10)
+
+	body := `[{"method":"GET","path":"/address/alice"},{"method":"POST","path":"/transaction/send","body":{}}]`
+	response, statusCode := doBatchRequest(ws, body)
+	require.Equal(t, http.StatusOK, statusCode)
+	require.Equal(t, 2, len(response.Data.Responses))
+	assert.Equal(t, http.StatusOK, response.Data.Responses[0].StatusCode)
+	assert.Equal(t, http.StatusTooManyRequests, response.Data.Responses[1].StatusCode)
+}
+
+func TestBatch_BatchEndpointShouldBeThrottled(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		GetThrottlerForEndpointCalled: func(endpoint string) (core.Throttler, bool) {
+			return &mock.ThrottlerStub{
+				CanProcessCalled: func() bool {
+					return endpoint != batchEndpoint
+				},
+			}, true
+		},
+	}
+	ws := createBatchTestEngine(facade, 10)
+
+	_, statusCode := doBatchRequest(ws, `[{"method":"GET","path":"/address/alice"}]`)
+	assert.Equal(t, http.StatusTooManyRequests, statusCode)
+}
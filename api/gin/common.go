@@ -47,13 +47,21 @@ func checkArgs(args ArgsNewWebServer) error {
 }
 
 func isLogRouteEnabled(routesConfig config.ApiRoutesConfig) bool {
-	logConfig, ok := routesConfig.APIPackages["log"]
+	return isRouteOpen(routesConfig, "log", "/log")
+}
+
+func isBatchRouteEnabled(routesConfig config.ApiRoutesConfig) bool {
+	return isRouteOpen(routesConfig, "batch", batchPath)
+}
+
+func isRouteOpen(routesConfig config.ApiRoutesConfig, packageName string, routeName string) bool {
+	packageConfig, ok := routesConfig.APIPackages[packageName]
 	if !ok {
 		return false
 	}
 
-	for _, cfg := range logConfig.Routes {
-		if cfg.Name == "/log" && cfg.Open {
+	for _, cfg := range packageConfig.Routes {
+		if cfg.Name == routeName && cfg.Open {
 			return true
 		}
 	}
@@ -45,3 +45,21 @@ func TestCommon_isLogRouteEnabled(t *testing.T) {
 	}
 	require.True(t, isLogRouteEnabled(routesConfig))
 }
+
+func TestCommon_isBatchRouteEnabled(t *testing.T) {
+	t.Parallel()
+
+	routesConfig := config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"batch": {
+				Routes: []config.RouteConfig{
+					{Name: "/batch", Open: false},
+				},
+			},
+		},
+	}
+	require.False(t, isBatchRouteEnabled(routesConfig))
+
+	routesConfig.APIPackages["batch"].Routes[0].Open = true
+	require.True(t, isBatchRouteEnabled(routesConfig))
+}
@@ -150,6 +150,18 @@ func (ws *webServer) createGroups() error {
 	}
 	groupsMap["block"] = blockGroup
 
+	eventsGroup, err := groups.NewEventsGroup(ws.facade)
+	if err != nil {
+		return err
+	}
+	groupsMap["events"] = eventsGroup
+
+	graphqlGroup, err := groups.NewGraphQLGroup(ws.facade, ws.antiFloodConfig)
+	if err != nil {
+		return err
+	}
+	groupsMap["graphql"] = graphqlGroup
+
 	hardforkGroup, err := groups.NewHardforkGroup(ws.facade)
 	if err != nil {
 		return err
@@ -174,6 +186,12 @@ func (ws *webServer) createGroups() error {
 	}
 	groupsMap["proof"] = proofGroup
 
+	stateGroup, err := groups.NewStateGroup(ws.facade)
+	if err != nil {
+		return err
+	}
+	groupsMap["state"] = stateGroup
+
 	transactionGroup, err := groups.NewTransactionGroup(ws.facade)
 	if err != nil {
 		return err
@@ -209,6 +227,10 @@ func (ws *webServer) registerRoutes(ginRouter *gin.Engine) {
 		registerLoggerWsRoute(ginRouter, marshalizerForLogs)
 	}
 
+	if isBatchRouteEnabled(ws.apiConfig) {
+		registerBatchRoute(ginRouter, ws.facade, ws.antiFloodConfig.BatchMaxSubRequests)
+	}
+
 	if ws.facade.PprofEnabled() {
 		pprof.Register(ginRouter)
 	}
@@ -0,0 +1,274 @@
+package graphql
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"math/big"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go-core/data/api"
+	"github.com/ElrondNetwork/elrond-go-core/data/esdt"
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+type accountResolver struct {
+	account api.AccountResponse
+	facade  FacadeHandler
+}
+
+type esdtTokenArgs struct {
+	TokenIdentifier string
+	Nonce           *Uint64
+}
+
+// Address returns the bech32 address of the account
+func (ar *accountResolver) Address() string {
+	return ar.account.Address
+}
+
+// Nonce returns the nonce of the account
+func (ar *accountResolver) Nonce() Uint64 {
+	return Uint64(ar.account.Nonce)
+}
+
+// Balance returns the balance of the account
+func (ar *accountResolver) Balance() string {
+	return ar.account.Balance
+}
+
+// Username returns the username of the account
+func (ar *accountResolver) Username() string {
+	return ar.account.Username
+}
+
+// Code returns the code of the account
+func (ar *accountResolver) Code() string {
+	return ar.account.Code
+}
+
+// CodeHash returns the code hash of the account
+func (ar *accountResolver) CodeHash() string {
+	return base64.StdEncoding.EncodeToString(ar.account.CodeHash)
+}
+
+// RootHash returns the data trie root hash of the account
+func (ar *accountResolver) RootHash() string {
+	return base64.StdEncoding.EncodeToString(ar.account.RootHash)
+}
+
+// CodeMetadata returns the code metadata of the account
+func (ar *accountResolver) CodeMetadata() string {
+	return base64.StdEncoding.EncodeToString(ar.account.CodeMetadata)
+}
+
+// DeveloperReward returns the developer reward of the account
+func (ar *accountResolver) DeveloperReward() string {
+	return ar.account.DeveloperReward
+}
+
+// OwnerAddress returns the owner address of the account
+func (ar *accountResolver) OwnerAddress() string {
+	return ar.account.OwnerAddress
+}
+
+// ESDTTokens returns all the ESDT tokens held by the account, sorted by their identifier
+func (ar *accountResolver) ESDTTokens(ctx context.Context) ([]*esdtTokenResolver, error) {
+	err := chargeComplexity(ctx, esdtTokensCost)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := ar.facade.GetAllESDTTokens(ar.account.Address, common.AccountQueryOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	tokenIdentifiers := make([]string, 0, len(tokens))
+	for tokenIdentifier, token := range tokens {
+		if token == nil {
+			continue
+		}
+		tokenIdentifiers = append(tokenIdentifiers, tokenIdentifier)
+	}
+	sort.Strings(tokenIdentifiers)
+
+	resolvers := make([]*esdtTokenResolver, 0, len(tokens))
+	for _, tokenIdentifier := range tokenIdentifiers {
+		resolvers = append(resolvers, &esdtTokenResolver{
+			tokenIdentifier: tokenIdentifier,
+			token:           tokens[tokenIdentifier],
+		})
+	}
+
+	return resolvers, nil
+}
+
+// ESDTToken returns the data of one ESDT token held by the account
+func (ar *accountResolver) ESDTToken(ctx context.Context, args esdtTokenArgs) (*esdtTokenResolver, error) {
+	err := chargeComplexity(ctx, esdtTokenCost)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := uint64(0)
+	if args.Nonce != nil {
+		nonce = uint64(*args.Nonce)
+	}
+
+	token, err := ar.facade.GetESDTData(ar.account.Address, args.TokenIdentifier, nonce, common.AccountQueryOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if token == nil {
+		return nil, nil
+	}
+
+	return &esdtTokenResolver{
+		tokenIdentifier: args.TokenIdentifier,
+		token:           token,
+	}, nil
+}
+
+// ESDTRoles returns the ESDT roles of the account, sorted by the token identifier
+func (ar *accountResolver) ESDTRoles(ctx context.Context) ([]*esdtRolesResolver, error) {
+	err := chargeComplexity(ctx, esdtRolesCost)
+	if err != nil {
+		return nil, err
+	}
+
+	rolesMap, err := ar.facade.GetESDTsRoles(ar.account.Address, common.AccountQueryOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	resolvers := make([]*esdtRolesResolver, 0, len(rolesMap))
+	for tokenIdentifier, roles := range rolesMap {
+		resolvers = append(resolvers, &esdtRolesResolver{
+			tokenIdentifier: tokenIdentifier,
+			roles:           roles,
+		})
+	}
+	sort.Slice(resolvers, func(i, j int) bool {
+		return resolvers[i].tokenIdentifier < resolvers[j].tokenIdentifier
+	})
+
+	return resolvers, nil
+}
+
+// RegisteredNFTs returns the identifiers of the NFT tokens registered by the account
+func (ar *accountResolver) RegisteredNFTs(ctx context.Context) ([]string, error) {
+	err := chargeComplexity(ctx, registeredNFTsCost)
+	if err != nil {
+		return nil, err
+	}
+
+	return ar.facade.GetNFTTokenIDsRegisteredByAddress(ar.account.Address, common.AccountQueryOptions{})
+}
+
+type esdtTokenResolver struct {
+	tokenIdentifier string
+	token           *esdt.ESDigitalToken
+}
+
+// TokenIdentifier returns the identifier of the token
+func (etr *esdtTokenResolver) TokenIdentifier() string {
+	return etr.tokenIdentifier
+}
+
+// Balance returns the balance of the token
+func (etr *esdtTokenResolver) Balance() string {
+	if etr.token.Value == nil {
+		return "0"
+	}
+
+	return etr.token.Value.String()
+}
+
+// Properties returns the hex encoded properties of the token
+func (etr *esdtTokenResolver) Properties() string {
+	return hex.EncodeToString(etr.token.Properties)
+}
+
+// Name returns the name from the token metadata
+func (etr *esdtTokenResolver) Name() string {
+	if etr.token.TokenMetaData == nil {
+		return ""
+	}
+
+	return string(etr.token.TokenMetaData.Name)
+}
+
+// Nonce returns the nonce from the token metadata
+func (etr *esdtTokenResolver) Nonce() Uint64 {
+	if etr.token.TokenMetaData == nil {
+		return 0
+	}
+
+	return Uint64(etr.token.TokenMetaData.Nonce)
+}
+
+// Creator returns the creator from the token metadata
+func (etr *esdtTokenResolver) Creator() string {
+	if etr.token.TokenMetaData == nil {
+		return ""
+	}
+
+	return string(etr.token.TokenMetaData.Creator)
+}
+
+// Royalties returns the royalties from the token metadata
+func (etr *esdtTokenResolver) Royalties() string {
+	if etr.token.TokenMetaData == nil {
+		return ""
+	}
+
+	return big.NewInt(int64(etr.token.TokenMetaData.Royalties)).String()
+}
+
+// Hash returns the hash from the token metadata
+func (etr *esdtTokenResolver) Hash() string {
+	if etr.token.TokenMetaData == nil {
+		return ""
+	}
+
+	return base64.StdEncoding.EncodeToString(etr.token.TokenMetaData.Hash)
+}
+
+// URIs returns the URIs from the token metadata
+func (etr *esdtTokenResolver) URIs() []string {
+	if etr.token.TokenMetaData == nil {
+		return make([]string, 0)
+	}
+
+	uris := make([]string, 0, len(etr.token.TokenMetaData.URIs))
+	for _, uri := range etr.token.TokenMetaData.URIs {
+		uris = append(uris, base64.StdEncoding.EncodeToString(uri))
+	}
+
+	return uris
+}
+
+// Attributes returns the attributes from the token metadata
+func (etr *esdtTokenResolver) Attributes() string {
+	if etr.token.TokenMetaData == nil {
+		return ""
+	}
+
+	return base64.StdEncoding.EncodeToString(etr.token.TokenMetaData.Attributes)
+}
+
+type esdtRolesResolver struct {
+	tokenIdentifier string
+	roles           []string
+}
+
+// TokenIdentifier returns the identifier of the token
+func (erg *esdtRolesResolver) TokenIdentifier() string {
+	return erg.tokenIdentifier
+}
+
+// Roles returns the roles the account has for the token
+func (erg *esdtRolesResolver) Roles() []string {
+	return erg.roles
+}
@@ -0,0 +1,116 @@
+package graphql
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/data/api"
+)
+
+type blockResolver struct {
+	block *api.Block
+}
+
+// Nonce returns the nonce of the block
+func (br *blockResolver) Nonce() Uint64 {
+	return Uint64(br.block.Nonce)
+}
+
+// Round returns the round of the block
+func (br *blockResolver) Round() Uint64 {
+	return Uint64(br.block.Round)
+}
+
+// Hash returns the hash of the block
+func (br *blockResolver) Hash() string {
+	return br.block.Hash
+}
+
+// PrevBlockHash returns the hash of the previous block
+func (br *blockResolver) PrevBlockHash() string {
+	return br.block.PrevBlockHash
+}
+
+// Epoch returns the epoch of the block
+func (br *blockResolver) Epoch() Uint64 {
+	return Uint64(br.block.Epoch)
+}
+
+// Shard returns the shard of the block
+func (br *blockResolver) Shard() Uint64 {
+	return Uint64(br.block.Shard)
+}
+
+// NumTxs returns the number of transactions in the block
+func (br *blockResolver) NumTxs() Uint64 {
+	return Uint64(br.block.NumTxs)
+}
+
+// Timestamp returns the timestamp of the block
+func (br *blockResolver) Timestamp() Uint64 {
+	return Uint64(br.block.Timestamp)
+}
+
+// AccumulatedFees returns the fees accumulated in the block
+func (br *blockResolver) AccumulatedFees() string {
+	return br.block.AccumulatedFees
+}
+
+// DeveloperFees returns the developer fees accumulated in the block
+func (br *blockResolver) DeveloperFees() string {
+	return br.block.DeveloperFees
+}
+
+// Status returns the status of the block
+func (br *blockResolver) Status() string {
+	return br.block.Status
+}
+
+// MiniBlocks returns the miniblocks of the block
+func (br *blockResolver) MiniBlocks() []*miniBlockResolver {
+	resolvers := make([]*miniBlockResolver, 0, len(br.block.MiniBlocks))
+	for _, miniBlock := range br.block.MiniBlocks {
+		if miniBlock == nil {
+			continue
+		}
+
+		resolvers = append(resolvers, &miniBlockResolver{miniBlock: miniBlock})
+	}
+
+	return resolvers
+}
+
+type miniBlockResolver struct {
+	miniBlock *api.MiniBlock
+}
+
+// Hash returns the hash of the miniblock
+func (mr *miniBlockResolver) Hash() string {
+	return mr.miniBlock.Hash
+}
+
+// Type returns the type of the miniblock
+func (mr *miniBlockResolver) Type() string {
+	return mr.miniBlock.Type
+}
+
+// SourceShard returns the source shard of the miniblock
+func (mr *miniBlockResolver) SourceShard() Uint64 {
+	return Uint64(mr.miniBlock.SourceShard)
+}
+
+// DestinationShard returns the destination shard of the miniblock
+func (mr *miniBlockResolver) DestinationShard() Uint64 {
+	return Uint64(mr.miniBlock.DestinationShard)
+}
+
+// Transactions returns the transactions of the miniblock, if they were requested
+func (mr *miniBlockResolver) Transactions() []*transactionResolver {
+	resolvers := make([]*transactionResolver, 0, len(mr.miniBlock.Transactions))
+	for _, tx := range mr.miniBlock.Transactions {
+		if tx == nil {
+			continue
+		}
+
+		resolvers = append(resolvers, &transactionResolver{tx: tx})
+	}
+
+	return resolvers
+}
@@ -0,0 +1,45 @@
+package graphql
+
+import (
+	"context"
+	"sync/atomic"
+)
+
+// the costs charged from the query complexity budget for each facade call
+const (
+	accountCost                = 1
+	esdtTokenCost              = 1
+	esdtTokensCost             = 5
+	esdtRolesCost              = 5
+	registeredNFTsCost         = 5
+	transactionCost            = 1
+	transactionWithResultsCost = 2
+	blockCost                  = 1
+	blockWithTxsCost           = 10
+	vmQueryCost                = 10
+)
+
+type complexityBudgetKey struct{}
+
+type complexityBudget struct {
+	remaining int64
+}
+
+func contextWithComplexityBudget(ctx context.Context, maxComplexity uint32) context.Context {
+	return context.WithValue(ctx, complexityBudgetKey{}, &complexityBudget{remaining: int64(maxComplexity)})
+}
+
+// chargeComplexity consumes the provided cost from the budget of the request. The resolvers run concurrently,
+// hence the atomic operation
+func chargeComplexity(ctx context.Context, cost int64) error {
+	budget, ok := ctx.Value(complexityBudgetKey{}).(*complexityBudget)
+	if !ok {
+		return nil
+	}
+
+	if atomic.AddInt64(&budget.remaining, -cost) < 0 {
+		return ErrQueryComplexityExceeded
+	}
+
+	return nil
+}
@@ -0,0 +1,15 @@
+package graphql
+
+import "errors"
+
+// ErrNilFacadeGetter signals that a nil facade getter has been provided
+var ErrNilFacadeGetter = errors.New("nil facade getter")
+
+// ErrQueryComplexityExceeded signals that the query needs more facade calls than the configured complexity allows
+var ErrQueryComplexityExceeded = errors.New("query complexity limit exceeded")
+
+// ErrInvalidBlockSelector signals that the block query was not provided with exactly one of nonce, hash or round
+var ErrInvalidBlockSelector = errors.New("exactly one of nonce, hash or round should be provided")
+
+// ErrInvalidUint64Value signals that a value could not be converted to an unsigned 64 bits integer
+var ErrInvalidUint64Value = errors.New("invalid uint64 value")
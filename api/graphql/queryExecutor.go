@@ -0,0 +1,59 @@
+package graphql
+
+import (
+	"context"
+
+	graphqlgo "github.com/graph-gophers/graphql-go"
+)
+
+// ArgsQueryExecutor holds the arguments needed to create a new query executor
+type ArgsQueryExecutor struct {
+	FacadeGetter       func() FacadeHandler
+	MaxQueryDepth      uint32
+	MaxQueryComplexity uint32
+}
+
+// Request is the body of a GraphQL request
+type Request struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+type queryExecutor struct {
+	schema             *graphqlgo.Schema
+	maxQueryComplexity uint32
+}
+
+// NewQueryExecutor returns a new instance of queryExecutor
+func NewQueryExecutor(args ArgsQueryExecutor) (*queryExecutor, error) {
+	if args.FacadeGetter == nil {
+		return nil, ErrNilFacadeGetter
+	}
+
+	resolver := &rootResolver{
+		facadeGetter: args.FacadeGetter,
+	}
+	schema, err := graphqlgo.ParseSchema(schemaDefinition, resolver, graphqlgo.MaxDepth(int(args.MaxQueryDepth)))
+	if err != nil {
+		return nil, err
+	}
+
+	return &queryExecutor{
+		schema:             schema,
+		maxQueryComplexity: args.MaxQueryComplexity,
+	}, nil
+}
+
+// Execute runs the request against the schema. Every facade call done while resolving the query is charged against
+// the maximum query complexity, the fields resolved after the limit was reached being returned with errors
+func (qe *queryExecutor) Execute(ctx context.Context, request *Request) *graphqlgo.Response {
+	ctx = contextWithComplexityBudget(ctx, qe.maxQueryComplexity)
+
+	return qe.schema.Exec(ctx, request.Query, request.OperationName, request.Variables)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (qe *queryExecutor) IsInterfaceNil() bool {
+	return qe == nil
+}
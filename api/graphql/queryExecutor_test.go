@@ -0,0 +1,164 @@
+package graphql_test
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/data/api"
+	"github.com/ElrondNetwork/elrond-go-core/data/vm"
+	"github.com/ElrondNetwork/elrond-go/api/graphql"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func executeQuery(t *testing.T, facade graphql.FacadeHandler, maxComplexity uint32, query string) (map[string]interface{}, []string) {
+	qe, err := graphql.NewQueryExecutor(graphql.ArgsQueryExecutor{
+		FacadeGetter: func() graphql.FacadeHandler {
+			return facade
+		},
+		MaxQueryDepth:      10,
+		MaxQueryComplexity: maxComplexity,
+	})
+	require.NoError(t, err)
+
+	response := qe.Execute(context.Background(), &graphql.Request{Query: query})
+
+	data := make(map[string]interface{})
+	if len(response.Data) > 0 {
+		err = json.Unmarshal(response.Data, &data)
+		require.NoError(t, err)
+	}
+
+	errorMessages := make([]string, 0, len(response.Errors))
+	for _, queryErr := range response.Errors {
+		errorMessages = append(errorMessages, queryErr.Message)
+	}
+
+	return data, errorMessages
+}
+
+func TestNewQueryExecutor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade getter should err", func(t *testing.T) {
+		qe, err := graphql.NewQueryExecutor(graphql.ArgsQueryExecutor{})
+		assert.Equal(t, graphql.ErrNilFacadeGetter, err)
+		assert.True(t, qe.IsInterfaceNil())
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		qe, err := graphql.NewQueryExecutor(graphql.ArgsQueryExecutor{
+			FacadeGetter: func() graphql.FacadeHandler {
+				return &mock.FacadeStub{}
+			},
+		})
+		assert.Nil(t, err)
+		assert.False(t, qe.IsInterfaceNil())
+	})
+}
+
+func TestQueryExecutor_BlockSelectors(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		GetBlockByNonceCalled: func(nonce uint64, withTxs bool) (*api.Block, error) {
+			return &api.Block{Nonce: nonce, Hash: "by nonce"}, nil
+		},
+		GetBlockByHashCalled: func(hash string, withTxs bool) (*api.Block, error) {
+			return &api.Block{Hash: hash}, nil
+		},
+		GetBlockByRoundCalled: func(round uint64, withTxs bool) (*api.Block, error) {
+			return &api.Block{Round: round}, nil
+		},
+	}
+
+	data, errs := executeQuery(t, facade, 100, `{
+		byNonce: block(nonce: 7) { nonce hash }
+		byHash: block(hash: "aabb") { hash }
+		byRound: block(round: "12") { round }
+	}`)
+	require.Empty(t, errs)
+	assert.Equal(t, map[string]interface{}{"nonce": float64(7), "hash": "by nonce"}, data["byNonce"])
+	assert.Equal(t, map[string]interface{}{"hash": "aabb"}, data["byHash"])
+	assert.Equal(t, map[string]interface{}{"round": float64(12)}, data["byRound"])
+
+	_, errs = executeQuery(t, facade, 100, `{ block(nonce: 7, round: 8) { nonce } }`)
+	require.Equal(t, 1, len(errs))
+	assert.Equal(t, graphql.ErrInvalidBlockSelector.Error(), errs[0])
+
+	_, errs = executeQuery(t, facade, 100, `{ block(nonce: -1) { nonce } }`)
+	require.Equal(t, 1, len(errs))
+	assert.True(t, strings.Contains(errs[0], graphql.ErrInvalidUint64Value.Error()))
+}
+
+func TestQueryExecutor_VMQuery(t *testing.T) {
+	t.Parallel()
+
+	var receivedQuery *process.SCQuery
+	facade := &mock.FacadeStub{
+		ExecuteSCQueryHandler: func(query *process.SCQuery) (*vm.VMOutputApi, error) {
+			receivedQuery = query
+			return &vm.VMOutputApi{
+				ReturnData:   [][]byte{[]byte("result")},
+				ReturnCode:   "ok",
+				GasRemaining: 100,
+			}, nil
+		},
+	}
+
+	data, errs := executeQuery(t, facade, 100, `{
+		vmQuery(scAddress: "aabb", funcName: "get", args: ["0102"], caller: "ccdd", value: "10") {
+			returnData returnCode gasRemaining
+		}
+	}`)
+	require.Empty(t, errs)
+	assert.Equal(t, map[string]interface{}{
+		"returnData":   []interface{}{"cmVzdWx0"},
+		"returnCode":   "ok",
+		"gasRemaining": float64(100),
+	}, data["vmQuery"])
+	require.NotNil(t, receivedQuery)
+	assert.Equal(t, "aabb", hex.EncodeToString(receivedQuery.ScAddress))
+	assert.Equal(t, "get", receivedQuery.FuncName)
+	assert.Equal(t, [][]byte{{1, 2}}, receivedQuery.Arguments)
+	assert.Equal(t, "ccdd", hex.EncodeToString(receivedQuery.CallerAddr))
+	assert.Equal(t, "10", receivedQuery.CallValue.String())
+
+	_, errs = executeQuery(t, facade, 100, `{ vmQuery(scAddress: "aabb", funcName: "get", args: ["zz"]) { returnCode } }`)
+	require.Equal(t, 1, len(errs))
+	assert.True(t, strings.Contains(errs[0], "is not a valid hex string"))
+}
+
+func TestQueryExecutor_ComplexityIsChargedPerRequest(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		GetAccountHandler: func(address string, _ common.AccountQueryOptions) (api.AccountResponse, error) {
+			return api.AccountResponse{Address: address}, nil
+		},
+	}
+	qe, err := graphql.NewQueryExecutor(graphql.ArgsQueryExecutor{
+		FacadeGetter: func() graphql.FacadeHandler {
+			return facade
+		},
+		MaxQueryComplexity: 2,
+	})
+	require.NoError(t, err)
+
+	request := &graphql.Request{Query: `{ a: account(address: "a") { address } b: account(address: "b") { address } }`}
+	for i := 0; i < 3; i++ {
+		response := qe.Execute(context.Background(), request)
+		assert.Empty(t, response.Errors)
+	}
+
+	request = &graphql.Request{Query: `{ a: account(address: "a") { address } b: account(address: "b") { address } c: account(address: "c") { address } }`}
+	response := qe.Execute(context.Background(), request)
+	require.Equal(t, 1, len(response.Errors))
+	assert.Equal(t, graphql.ErrQueryComplexityExceeded.Error(), response.Errors[0].Message)
+}
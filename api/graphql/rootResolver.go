@@ -0,0 +1,183 @@
+package graphql
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go-core/data/api"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+type rootResolver struct {
+	facadeGetter func() FacadeHandler
+}
+
+type accountArgs struct {
+	Address string
+}
+
+type transactionArgs struct {
+	Hash        string
+	WithResults *bool
+}
+
+type blockArgs struct {
+	Nonce   *Uint64
+	Hash    *string
+	Round   *Uint64
+	WithTxs *bool
+}
+
+type vmQueryArgs struct {
+	ScAddress string
+	FuncName  string
+	Args      *[]string
+	Caller    *string
+	Value     *string
+}
+
+// Account resolves the account query
+func (rr *rootResolver) Account(ctx context.Context, args accountArgs) (*accountResolver, error) {
+	err := chargeComplexity(ctx, accountCost)
+	if err != nil {
+		return nil, err
+	}
+
+	facade := rr.facadeGetter()
+	account, err := facade.GetAccount(args.Address, common.AccountQueryOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &accountResolver{
+		account: account,
+		facade:  facade,
+	}, nil
+}
+
+// Transaction resolves the transaction query
+func (rr *rootResolver) Transaction(ctx context.Context, args transactionArgs) (*transactionResolver, error) {
+	withResults := isTrue(args.WithResults)
+	cost := int64(transactionCost)
+	if withResults {
+		cost = transactionWithResultsCost
+	}
+	err := chargeComplexity(ctx, cost)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := rr.facadeGetter().GetTransaction(args.Hash, withResults)
+	if err != nil {
+		return nil, err
+	}
+	if tx == nil {
+		return nil, nil
+	}
+
+	return &transactionResolver{tx: tx}, nil
+}
+
+// Block resolves the block query
+func (rr *rootResolver) Block(ctx context.Context, args blockArgs) (*blockResolver, error) {
+	withTxs := isTrue(args.WithTxs)
+	cost := int64(blockCost)
+	if withTxs {
+		cost = blockWithTxsCost
+	}
+	err := chargeComplexity(ctx, cost)
+	if err != nil {
+		return nil, err
+	}
+
+	facade := rr.facadeGetter()
+	var block *api.Block
+	switch {
+	case args.Nonce != nil && args.Hash == nil && args.Round == nil:
+		block, err = facade.GetBlockByNonce(uint64(*args.Nonce), withTxs)
+	case args.Nonce == nil && args.Hash != nil && args.Round == nil:
+		block, err = facade.GetBlockByHash(*args.Hash, withTxs)
+	case args.Nonce == nil && args.Hash == nil && args.Round != nil:
+		block, err = facade.GetBlockByRound(uint64(*args.Round), withTxs)
+	default:
+		return nil, ErrInvalidBlockSelector
+	}
+	if err != nil {
+		return nil, err
+	}
+	if block == nil {
+		return nil, nil
+	}
+
+	return &blockResolver{block: block}, nil
+}
+
+// VMQuery resolves the vmQuery query
+func (rr *rootResolver) VMQuery(ctx context.Context, args vmQueryArgs) (*vmQueryResolver, error) {
+	err := chargeComplexity(ctx, vmQueryCost)
+	if err != nil {
+		return nil, err
+	}
+
+	facade := rr.facadeGetter()
+	scQuery, err := createSCQuery(facade, args)
+	if err != nil {
+		return nil, err
+	}
+
+	vmOutput, err := facade.ExecuteSCQuery(scQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	return &vmQueryResolver{vmOutput: vmOutput}, nil
+}
+
+func createSCQuery(facade FacadeHandler, args vmQueryArgs) (*process.SCQuery, error) {
+	scAddress, err := facade.DecodeAddressPubkey(args.ScAddress)
+	if err != nil {
+		return nil, fmt.Errorf("'%s' is not a valid address: %s", args.ScAddress, err.Error())
+	}
+
+	scQuery := &process.SCQuery{
+		ScAddress: scAddress,
+		FuncName:  args.FuncName,
+		Arguments: make([][]byte, 0),
+	}
+
+	if args.Args != nil {
+		for _, arg := range *args.Args {
+			argBytes, errDecode := hex.DecodeString(arg)
+			if errDecode != nil {
+				return nil, fmt.Errorf("'%s' is not a valid hex string: %s", arg, errDecode.Error())
+			}
+
+			scQuery.Arguments = append(scQuery.Arguments, argBytes)
+		}
+	}
+
+	if args.Caller != nil && len(*args.Caller) > 0 {
+		scQuery.CallerAddr, err = facade.DecodeAddressPubkey(*args.Caller)
+		if err != nil {
+			return nil, fmt.Errorf("'%s' is not a valid address: %s", *args.Caller, err.Error())
+		}
+	}
+
+	if args.Value != nil && len(*args.Value) > 0 {
+		callValue, ok := big.NewInt(0).SetString(*args.Value, 10)
+		if !ok {
+			return nil, fmt.Errorf("non numeric call value provided: %s", *args.Value)
+		}
+
+		scQuery.CallValue = callValue
+	}
+
+	return scQuery, nil
+}
+
+func isTrue(value *bool) bool {
+	return value != nil && *value
+}
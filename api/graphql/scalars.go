@@ -0,0 +1,44 @@
+package graphql
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+)
+
+const uint64ScalarName = "Uint64"
+
+// Uint64 is the custom scalar used for nonces, rounds, gas values and shard IDs that do not fit into the
+// 32 bits signed Int type defined by the GraphQL specification. Values above the Int range should be sent as strings
+type Uint64 uint64
+
+// ImplementsGraphQLType returns true if the provided name is the name of this scalar in the schema
+func (u Uint64) ImplementsGraphQLType(name string) bool {
+	return name == uint64ScalarName
+}
+
+// UnmarshalGraphQL decodes an input value, accepting integers, integral floats and decimal strings
+func (u *Uint64) UnmarshalGraphQL(input interface{}) error {
+	switch value := input.(type) {
+	case int32:
+		if value < 0 {
+			return fmt.Errorf("%w: %d", ErrInvalidUint64Value, value)
+		}
+		*u = Uint64(value)
+	case float64:
+		if value < 0 || value > math.MaxUint64 || value != math.Trunc(value) {
+			return fmt.Errorf("%w: %v", ErrInvalidUint64Value, value)
+		}
+		*u = Uint64(value)
+	case string:
+		parsed, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return fmt.Errorf("%w: %s", ErrInvalidUint64Value, value)
+		}
+		*u = Uint64(parsed)
+	default:
+		return fmt.Errorf("%w: wrong type %T", ErrInvalidUint64Value, input)
+	}
+
+	return nil
+}
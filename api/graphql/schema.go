@@ -0,0 +1,127 @@
+package graphql
+
+// schemaDefinition describes the types exposed by the GraphQL endpoint. All byte fields are base64 encoded, the same
+// way the REST API encodes them
+const schemaDefinition = `
+schema {
+	query: Query
+}
+
+# Uint64 values are returned as numbers. Inputs above the Int range should be provided as decimal strings
+scalar Uint64
+
+type Query {
+	# account returns the state of the provided bech32 address
+	account(address: String!): Account
+	# transaction returns the transaction with the provided hash, optionally with its smart contract results
+	transaction(hash: String!, withResults: Boolean): Transaction
+	# block returns the block identified by exactly one of the nonce, hash or round arguments
+	block(nonce: Uint64, hash: String, round: Uint64, withTxs: Boolean): Block
+	# vmQuery executes a smart contract view function. The arguments are hex encoded
+	vmQuery(scAddress: String!, funcName: String!, args: [String!], caller: String, value: String): VMQueryResult
+}
+
+type Account {
+	address: String!
+	nonce: Uint64!
+	balance: String!
+	username: String!
+	code: String!
+	codeHash: String!
+	rootHash: String!
+	codeMetadata: String!
+	developerReward: String!
+	ownerAddress: String!
+	esdtTokens: [ESDTToken!]!
+	esdtToken(tokenIdentifier: String!, nonce: Uint64): ESDTToken
+	esdtRoles: [ESDTRoles!]!
+	registeredNFTs: [String!]!
+}
+
+type ESDTToken {
+	tokenIdentifier: String!
+	balance: String!
+	properties: String!
+	name: String!
+	nonce: Uint64!
+	creator: String!
+	royalties: String!
+	hash: String!
+	uris: [String!]!
+	attributes: String!
+}
+
+type ESDTRoles {
+	tokenIdentifier: String!
+	roles: [String!]!
+}
+
+type Transaction {
+	type: String!
+	hash: String!
+	nonce: Uint64!
+	round: Uint64!
+	epoch: Uint64!
+	value: String!
+	receiver: String!
+	sender: String!
+	gasPrice: Uint64!
+	gasLimit: Uint64!
+	data: String!
+	signature: String!
+	sourceShard: Uint64!
+	destinationShard: Uint64!
+	blockNonce: Uint64!
+	blockHash: String!
+	miniBlockType: String!
+	miniBlockHash: String!
+	timestamp: Uint64!
+	status: String!
+	function: String!
+	smartContractResults: [SmartContractResult!]!
+}
+
+type SmartContractResult {
+	hash: String!
+	nonce: Uint64!
+	value: String!
+	receiver: String!
+	sender: String!
+	data: String!
+	prevTxHash: String!
+	originalTxHash: String!
+	gasLimit: Uint64!
+	gasPrice: Uint64!
+	returnMessage: String!
+}
+
+type Block {
+	nonce: Uint64!
+	round: Uint64!
+	hash: String!
+	prevBlockHash: String!
+	epoch: Uint64!
+	shard: Uint64!
+	numTxs: Uint64!
+	timestamp: Uint64!
+	accumulatedFees: String!
+	developerFees: String!
+	status: String!
+	miniBlocks: [MiniBlock!]!
+}
+
+type MiniBlock {
+	hash: String!
+	type: String!
+	sourceShard: Uint64!
+	destinationShard: Uint64!
+	transactions: [Transaction!]!
+}
+
+type VMQueryResult {
+	returnData: [String!]!
+	returnCode: String!
+	returnMessage: String!
+	gasRemaining: Uint64!
+}
+`
@@ -0,0 +1,193 @@
+package graphql
+
+import (
+	"encoding/base64"
+
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+)
+
+type transactionResolver struct {
+	tx *transaction.ApiTransactionResult
+}
+
+// Type returns the type of the transaction
+func (tr *transactionResolver) Type() string {
+	return tr.tx.Type
+}
+
+// Hash returns the hash of the transaction
+func (tr *transactionResolver) Hash() string {
+	return tr.tx.Hash
+}
+
+// Nonce returns the nonce of the transaction
+func (tr *transactionResolver) Nonce() Uint64 {
+	return Uint64(tr.tx.Nonce)
+}
+
+// Round returns the round of the transaction
+func (tr *transactionResolver) Round() Uint64 {
+	return Uint64(tr.tx.Round)
+}
+
+// Epoch returns the epoch of the transaction
+func (tr *transactionResolver) Epoch() Uint64 {
+	return Uint64(tr.tx.Epoch)
+}
+
+// Value returns the value of the transaction
+func (tr *transactionResolver) Value() string {
+	return tr.tx.Value
+}
+
+// Receiver returns the receiver of the transaction
+func (tr *transactionResolver) Receiver() string {
+	return tr.tx.Receiver
+}
+
+// Sender returns the sender of the transaction
+func (tr *transactionResolver) Sender() string {
+	return tr.tx.Sender
+}
+
+// GasPrice returns the gas price of the transaction
+func (tr *transactionResolver) GasPrice() Uint64 {
+	return Uint64(tr.tx.GasPrice)
+}
+
+// GasLimit returns the gas limit of the transaction
+func (tr *transactionResolver) GasLimit() Uint64 {
+	return Uint64(tr.tx.GasLimit)
+}
+
+// Data returns the base64 encoded data field of the transaction
+func (tr *transactionResolver) Data() string {
+	return base64.StdEncoding.EncodeToString(tr.tx.Data)
+}
+
+// Signature returns the signature of the transaction
+func (tr *transactionResolver) Signature() string {
+	return tr.tx.Signature
+}
+
+// SourceShard returns the source shard of the transaction
+func (tr *transactionResolver) SourceShard() Uint64 {
+	return Uint64(tr.tx.SourceShard)
+}
+
+// DestinationShard returns the destination shard of the transaction
+func (tr *transactionResolver) DestinationShard() Uint64 {
+	return Uint64(tr.tx.DestinationShard)
+}
+
+// BlockNonce returns the nonce of the block holding the transaction
+func (tr *transactionResolver) BlockNonce() Uint64 {
+	return Uint64(tr.tx.BlockNonce)
+}
+
+// BlockHash returns the hash of the block holding the transaction
+func (tr *transactionResolver) BlockHash() string {
+	return tr.tx.BlockHash
+}
+
+// MiniBlockType returns the type of the miniblock holding the transaction
+func (tr *transactionResolver) MiniBlockType() string {
+	return tr.tx.MiniBlockType
+}
+
+// MiniBlockHash returns the hash of the miniblock holding the transaction
+func (tr *transactionResolver) MiniBlockHash() string {
+	return tr.tx.MiniBlockHash
+}
+
+// Timestamp returns the timestamp of the transaction
+func (tr *transactionResolver) Timestamp() Uint64 {
+	return Uint64(tr.tx.Timestamp)
+}
+
+// Status returns the status of the transaction
+func (tr *transactionResolver) Status() string {
+	return string(tr.tx.Status)
+}
+
+// Function returns the function called by the transaction
+func (tr *transactionResolver) Function() string {
+	return tr.tx.Function
+}
+
+// SmartContractResults returns the smart contract results of the transaction, if they were requested
+func (tr *transactionResolver) SmartContractResults() []*smartContractResultResolver {
+	resolvers := make([]*smartContractResultResolver, 0, len(tr.tx.SmartContractResults))
+	for _, scr := range tr.tx.SmartContractResults {
+		if scr == nil {
+			continue
+		}
+
+		resolvers = append(resolvers, &smartContractResultResolver{scr: scr})
+	}
+
+	return resolvers
+}
+
+type smartContractResultResolver struct {
+	scr *transaction.ApiSmartContractResult
+}
+
+// Hash returns the hash of the smart contract result
+func (sr *smartContractResultResolver) Hash() string {
+	return sr.scr.Hash
+}
+
+// Nonce returns the nonce of the smart contract result
+func (sr *smartContractResultResolver) Nonce() Uint64 {
+	return Uint64(sr.scr.Nonce)
+}
+
+// Value returns the value of the smart contract result
+func (sr *smartContractResultResolver) Value() string {
+	if sr.scr.Value == nil {
+		return "0"
+	}
+
+	return sr.scr.Value.String()
+}
+
+// Receiver returns the receiver of the smart contract result
+func (sr *smartContractResultResolver) Receiver() string {
+	return sr.scr.RcvAddr
+}
+
+// Sender returns the sender of the smart contract result
+func (sr *smartContractResultResolver) Sender() string {
+	return sr.scr.SndAddr
+}
+
+// Data returns the data field of the smart contract result
+func (sr *smartContractResultResolver) Data() string {
+	return sr.scr.Data
+}
+
+// PrevTxHash returns the hash of the previous transaction
+func (sr *smartContractResultResolver) PrevTxHash() string {
+	return sr.scr.PrevTxHash
+}
+
+// OriginalTxHash returns the hash of the original transaction
+func (sr *smartContractResultResolver) OriginalTxHash() string {
+	return sr.scr.OriginalTxHash
+}
+
+// GasLimit returns the gas limit of the smart contract result
+func (sr *smartContractResultResolver) GasLimit() Uint64 {
+	return Uint64(sr.scr.GasLimit)
+}
+
+// GasPrice returns the gas price of the smart contract result
+func (sr *smartContractResultResolver) GasPrice() Uint64 {
+	return Uint64(sr.scr.GasPrice)
+}
+
+// ReturnMessage returns the return message of the smart contract result
+func (sr *smartContractResultResolver) ReturnMessage() string {
+	return sr.scr.ReturnMessage
+}
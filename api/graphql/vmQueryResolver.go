@@ -0,0 +1,36 @@
+package graphql
+
+import (
+	"encoding/base64"
+
+	"github.com/ElrondNetwork/elrond-go-core/data/vm"
+)
+
+type vmQueryResolver struct {
+	vmOutput *vm.VMOutputApi
+}
+
+// ReturnData returns the base64 encoded values returned by the smart contract
+func (vr *vmQueryResolver) ReturnData() []string {
+	returnData := make([]string, 0, len(vr.vmOutput.ReturnData))
+	for _, data := range vr.vmOutput.ReturnData {
+		returnData = append(returnData, base64.StdEncoding.EncodeToString(data))
+	}
+
+	return returnData
+}
+
+// ReturnCode returns the return code of the execution
+func (vr *vmQueryResolver) ReturnCode() string {
+	return vr.vmOutput.ReturnCode
+}
+
+// ReturnMessage returns the return message of the execution
+func (vr *vmQueryResolver) ReturnMessage() string {
+	return vr.vmOutput.ReturnMessage
+}
+
+// GasRemaining returns the gas remaining after the execution
+func (vr *vmQueryResolver) GasRemaining() Uint64 {
+	return Uint64(vr.vmOutput.GasRemaining)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math/big"
 	"net/http"
+	"strconv"
 	"sync"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
@@ -13,6 +14,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data/esdt"
 	"github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/gin-gonic/gin"
 )
 
@@ -28,20 +30,27 @@ const (
 	getESDTsRolesPath         = "/:address/esdts/roles"
 	getRegisteredNFTsPath     = "/:address/registered-nfts"
 	getESDTNFTDataPath        = "/:address/nft/:tokenIdentifier/nonce/:nonce"
+	getTransactionsPath       = "/:address/transactions"
+
+	queryParamCursor     = "cursor"
+	queryParamLimit      = "limit"
+	queryParamBlockNonce = "blockNonce"
+	queryParamBlockHash  = "blockHash"
 )
 
 // addressFacadeHandler defines the methods to be implemented by a facade for handling address requests
 type addressFacadeHandler interface {
-	GetBalance(address string) (*big.Int, error)
-	GetUsername(address string) (string, error)
-	GetValueForKey(address string, key string) (string, error)
-	GetAccount(address string) (api.AccountResponse, error)
-	GetESDTData(address string, key string, nonce uint64) (*esdt.ESDigitalToken, error)
-	GetESDTsRoles(address string) (map[string][]string, error)
-	GetNFTTokenIDsRegisteredByAddress(address string) ([]string, error)
-	GetESDTsWithRole(address string, role string) ([]string, error)
-	GetAllESDTTokens(address string) (map[string]*esdt.ESDigitalToken, error)
-	GetKeyValuePairs(address string) (map[string]string, error)
+	GetBalance(address string, options common.AccountQueryOptions) (*big.Int, error)
+	GetUsername(address string, options common.AccountQueryOptions) (string, error)
+	GetValueForKey(address string, key string, options common.AccountQueryOptions) (string, error)
+	GetAccount(address string, options common.AccountQueryOptions) (api.AccountResponse, error)
+	GetESDTData(address string, key string, nonce uint64, options common.AccountQueryOptions) (*esdt.ESDigitalToken, error)
+	GetESDTsRoles(address string, options common.AccountQueryOptions) (map[string][]string, error)
+	GetNFTTokenIDsRegisteredByAddress(address string, options common.AccountQueryOptions) ([]string, error)
+	GetESDTsWithRole(address string, role string, options common.AccountQueryOptions) ([]string, error)
+	GetAllESDTTokens(address string, options common.AccountQueryOptions) (map[string]*esdt.ESDigitalToken, error)
+	GetKeyValuePairs(address string, options common.AccountQueryOptions) (map[string]string, error)
+	GetAccountTransactions(address string, cursor uint64, limit int) (*common.AccountTransactionsResponse, error)
 	IsInterfaceNil() bool
 }
 
@@ -137,6 +146,11 @@ func NewAddressGroup(facade addressFacadeHandler) (*addressGroup, error) {
 			Method:  http.MethodGet,
 			Handler: ag.getESDTsRoles,
 		},
+		{
+			Path:    getTransactionsPath,
+			Method:  http.MethodGet,
+			Handler: ag.getAccountTransactions,
+		},
 	}
 	ag.endpoints = endpoints
 
@@ -146,7 +160,20 @@ func NewAddressGroup(facade addressFacadeHandler) (*addressGroup, error) {
 // addressGroup returns a response containing information about the account correlated with provided address
 func (ag *addressGroup) getAccount(c *gin.Context) {
 	addr := c.Param("address")
-	accountResponse, err := ag.getFacade().GetAccount(addr)
+	options, err := parseAccountQueryOptions(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrCouldNotGetAccount.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	accountResponse, err := ag.getFacade().GetAccount(addr, options)
 	if err != nil {
 		c.JSON(
 			http.StatusInternalServerError,
@@ -186,7 +213,20 @@ func (ag *addressGroup) getBalance(c *gin.Context) {
 		return
 	}
 
-	balance, err := ag.getFacade().GetBalance(addr)
+	options, err := parseAccountQueryOptions(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetBalance.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	balance, err := ag.getFacade().GetBalance(addr, options)
 	if err != nil {
 		c.JSON(
 			http.StatusInternalServerError,
@@ -223,7 +263,20 @@ func (ag *addressGroup) getUsername(c *gin.Context) {
 		return
 	}
 
-	userName, err := ag.getFacade().GetUsername(addr)
+	options, err := parseAccountQueryOptions(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetUsername.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	userName, err := ag.getFacade().GetUsername(addr, options)
 	if err != nil {
 		c.JSON(
 			http.StatusInternalServerError,
@@ -273,7 +326,20 @@ func (ag *addressGroup) getValueForKey(c *gin.Context) {
 		return
 	}
 
-	value, err := ag.getFacade().GetValueForKey(addr, key)
+	options, err := parseAccountQueryOptions(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetValueForKey.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	value, err := ag.getFacade().GetValueForKey(addr, key, options)
 	if err != nil {
 		c.JSON(
 			http.StatusInternalServerError,
@@ -311,7 +377,20 @@ func (ag *addressGroup) getKeyValuePairs(c *gin.Context) {
 		return
 	}
 
-	value, err := ag.getFacade().GetKeyValuePairs(addr)
+	options, err := parseAccountQueryOptions(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetKeyValuePairs.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	value, err := ag.getFacade().GetKeyValuePairs(addr, options)
 	if err != nil {
 		c.JSON(
 			http.StatusInternalServerError,
@@ -362,7 +441,20 @@ func (ag *addressGroup) getESDTBalance(c *gin.Context) {
 		return
 	}
 
-	esdtData, err := ag.getFacade().GetESDTData(addr, tokenIdentifier, 0)
+	options, err := parseAccountQueryOptions(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetESDTBalance.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	esdtData, err := ag.getFacade().GetESDTData(addr, tokenIdentifier, 0, options)
 	if err != nil {
 		c.JSON(
 			http.StatusInternalServerError,
@@ -406,7 +498,20 @@ func (ag *addressGroup) getESDTsRoles(c *gin.Context) {
 		return
 	}
 
-	tokensRoles, err := ag.getFacade().GetESDTsRoles(addr)
+	options, err := parseAccountQueryOptions(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetRolesForAccount.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	tokensRoles, err := ag.getFacade().GetESDTsRoles(addr, options)
 	if err != nil {
 		c.JSON(
 			http.StatusInternalServerError,
@@ -469,7 +574,20 @@ func (ag *addressGroup) getESDTTokensWithRole(c *gin.Context) {
 		return
 	}
 
-	tokens, err := ag.getFacade().GetESDTsWithRole(addr, role)
+	options, err := parseAccountQueryOptions(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetESDTBalance.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	tokens, err := ag.getFacade().GetESDTsWithRole(addr, role, options)
 	if err != nil {
 		c.JSON(
 			http.StatusInternalServerError,
@@ -507,7 +625,20 @@ func (ag *addressGroup) getNFTTokenIDsRegisteredByAddress(c *gin.Context) {
 		return
 	}
 
-	tokens, err := ag.getFacade().GetNFTTokenIDsRegisteredByAddress(addr)
+	options, err := parseAccountQueryOptions(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetESDTBalance.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	tokens, err := ag.getFacade().GetNFTTokenIDsRegisteredByAddress(addr, options)
 	if err != nil {
 		c.JSON(
 			http.StatusInternalServerError,
@@ -530,6 +661,70 @@ func (ag *addressGroup) getNFTTokenIDsRegisteredByAddress(c *gin.Context) {
 	)
 }
 
+// getAccountTransactions returns the transactions in which the given address was involved, newest first
+func (ag *addressGroup) getAccountTransactions(c *gin.Context) {
+	addr := c.Param("address")
+	if addr == "" {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetAccountTransactions.Error(), errors.ErrEmptyAddress.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	cursor, err := getQueryParamUint64(c, queryParamCursor)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetAccountTransactions.Error(), errors.ErrInvalidQueryParameter.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	limit, err := getQueryParamUint64(c, queryParamLimit)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetAccountTransactions.Error(), errors.ErrInvalidQueryParameter.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	response, err := ag.getFacade().GetAccountTransactions(addr, cursor, int(limit))
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetAccountTransactions.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"transactions": response.Transactions, "nextCursor": response.NextCursor},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
 // getESDTNFTData returns the nft data for the given token
 func (ag *addressGroup) getESDTNFTData(c *gin.Context) {
 	addr := c.Param("address")
@@ -584,7 +779,20 @@ func (ag *addressGroup) getESDTNFTData(c *gin.Context) {
 		return
 	}
 
-	esdtData, err := ag.getFacade().GetESDTData(addr, tokenIdentifier, nonceAsBigInt.Uint64())
+	options, err := parseAccountQueryOptions(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetESDTNFTData.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	esdtData, err := ag.getFacade().GetESDTData(addr, tokenIdentifier, nonceAsBigInt.Uint64(), options)
 	if err != nil {
 		c.JSON(
 			http.StatusInternalServerError,
@@ -624,7 +832,20 @@ func (ag *addressGroup) getAllESDTData(c *gin.Context) {
 		return
 	}
 
-	tokens, err := ag.getFacade().GetAllESDTTokens(addr)
+	options, err := parseAccountQueryOptions(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetESDTTokens.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	tokens, err := ag.getFacade().GetAllESDTTokens(addr, options)
 	if err != nil {
 		c.JSON(
 			http.StatusInternalServerError,
@@ -673,6 +894,49 @@ func buildTokenDataApiResponse(tokenIdentifier string, esdtData *esdt.ESDigitalT
 	return tokenData
 }
 
+func getQueryParamUint64(c *gin.Context, name string) (uint64, error) {
+	valueStr := c.Request.URL.Query().Get(name)
+	if valueStr == "" {
+		return 0, nil
+	}
+
+	return strconv.ParseUint(valueStr, 10, 64)
+}
+
+// parseAccountQueryOptions reads the block whose state should answer the query, selected either by its nonce or by its
+// hash. No block selected means the current state
+func parseAccountQueryOptions(c *gin.Context) (common.AccountQueryOptions, error) {
+	options := common.AccountQueryOptions{}
+	urlQuery := c.Request.URL.Query()
+
+	blockNonceStr := urlQuery.Get(queryParamBlockNonce)
+	if blockNonceStr != "" {
+		blockNonce, err := strconv.ParseUint(blockNonceStr, 10, 64)
+		if err != nil {
+			return common.AccountQueryOptions{}, fmt.Errorf("%w: %s", errors.ErrInvalidQueryParameter, queryParamBlockNonce)
+		}
+
+		options.BlockNonce = blockNonce
+		options.HasBlockNonce = true
+	}
+
+	blockHashStr := urlQuery.Get(queryParamBlockHash)
+	if blockHashStr != "" {
+		blockHash, err := hex.DecodeString(blockHashStr)
+		if err != nil {
+			return common.AccountQueryOptions{}, fmt.Errorf("%w: %s", errors.ErrInvalidQueryParameter, queryParamBlockHash)
+		}
+
+		options.BlockHash = blockHash
+	}
+
+	if options.HasBlockNonce && len(options.BlockHash) > 0 {
+		return common.AccountQueryOptions{}, errors.ErrBlockNonceAndHashProvided
+	}
+
+	return options, nil
+}
+
 func (ag *addressGroup) getFacade() addressFacadeHandler {
 	ag.mutFacade.RLock()
 	defer ag.mutFacade.RUnlock()
@@ -16,6 +16,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/groups"
 	"github.com/ElrondNetwork/elrond-go/api/mock"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -80,6 +81,17 @@ type esdtsWithRoleResponse struct {
 	Code  string                    `json:"code"`
 }
 
+type accountTransactionsResponseData struct {
+	Transactions []*common.AccountTransaction `json:"transactions"`
+	NextCursor   uint64                       `json:"nextCursor"`
+}
+
+type accountTransactionsResponse struct {
+	Data  accountTransactionsResponseData `json:"data"`
+	Error string                          `json:"error"`
+	Code  string                          `json:"code"`
+}
+
 type esdtTokenResponse struct {
 	Data  esdtTokenResponseData `json:"data"`
 	Error string                `json:"error"`
@@ -182,7 +194,7 @@ func TestGetBalance_WithCorrectAddressShouldNotReturnError(t *testing.T) {
 	amount := big.NewInt(10)
 	addr := "testAddress"
 	facade := mock.FacadeStub{
-		BalanceHandler: func(s string) (i *big.Int, e error) {
+		BalanceHandler: func(s string, _ common.AccountQueryOptions) (i *big.Int, e error) {
 			return amount, nil
 		},
 	}
@@ -211,7 +223,7 @@ func TestGetBalance_WithWrongAddressShouldError(t *testing.T) {
 	t.Parallel()
 	otherAddress := "otherAddress"
 	facade := mock.FacadeStub{
-		BalanceHandler: func(s string) (i *big.Int, e error) {
+		BalanceHandler: func(s string, _ common.AccountQueryOptions) (i *big.Int, e error) {
 			return big.NewInt(0), nil
 		},
 	}
@@ -236,7 +248,7 @@ func TestGetBalance_NodeGetBalanceReturnsError(t *testing.T) {
 	addr := "addr"
 	balanceError := errors.New("error")
 	facade := mock.FacadeStub{
-		BalanceHandler: func(s string) (i *big.Int, e error) {
+		BalanceHandler: func(s string, _ common.AccountQueryOptions) (i *big.Int, e error) {
 			return nil, balanceError
 		},
 	}
@@ -259,7 +271,7 @@ func TestGetBalance_NodeGetBalanceReturnsError(t *testing.T) {
 func TestGetBalance_WithEmptyAddressShouldReturnError(t *testing.T) {
 	t.Parallel()
 	facade := mock.FacadeStub{
-		BalanceHandler: func(s string) (i *big.Int, e error) {
+		BalanceHandler: func(s string, _ common.AccountQueryOptions) (i *big.Int, e error) {
 			return big.NewInt(0), errors.New("address was empty")
 		},
 	}
@@ -290,7 +302,7 @@ func TestGetValueForKey_NodeFailsShouldError(t *testing.T) {
 	testAddress := "address"
 	expectedErr := errors.New("expected error")
 	facade := mock.FacadeStub{
-		GetValueForKeyCalled: func(_ string, _ string) (string, error) {
+		GetValueForKeyCalled: func(_ string, _ string, _ common.AccountQueryOptions) (string, error) {
 			return "", expectedErr
 		},
 	}
@@ -316,7 +328,7 @@ func TestGetValueForKey_ShouldWork(t *testing.T) {
 	testAddress := "address"
 	testValue := "value"
 	facade := mock.FacadeStub{
-		GetValueForKeyCalled: func(_ string, _ string) (string, error) {
+		GetValueForKeyCalled: func(_ string, _ string, _ common.AccountQueryOptions) (string, error) {
 			return testValue, nil
 		},
 	}
@@ -342,7 +354,7 @@ func TestGetUsername_NodeFailsShouldError(t *testing.T) {
 	testAddress := "address"
 	expectedErr := errors.New("expected error")
 	facade := mock.FacadeStub{
-		GetUsernameCalled: func(_ string) (string, error) {
+		GetUsernameCalled: func(_ string, _ common.AccountQueryOptions) (string, error) {
 			return "", expectedErr
 		},
 	}
@@ -368,7 +380,7 @@ func TestGetUsername_ShouldWork(t *testing.T) {
 	testAddress := "address"
 	testUsername := "value"
 	facade := mock.FacadeStub{
-		GetUsernameCalled: func(_ string) (string, error) {
+		GetUsernameCalled: func(_ string, _ common.AccountQueryOptions) (string, error) {
 			return testUsername, nil
 		},
 	}
@@ -393,7 +405,7 @@ func TestGetAccount_FailWhenFacadeStubGetAccountFails(t *testing.T) {
 
 	returnedError := "i am an error"
 	facade := mock.FacadeStub{
-		GetAccountHandler: func(address string) (api.AccountResponse, error) {
+		GetAccountHandler: func(address string, _ common.AccountQueryOptions) (api.AccountResponse, error) {
 			return api.AccountResponse{}, errors.New(returnedError)
 		},
 	}
@@ -419,7 +431,7 @@ func TestGetAccount_ReturnsSuccessfully(t *testing.T) {
 	t.Parallel()
 
 	facade := mock.FacadeStub{
-		GetAccountHandler: func(address string) (api.AccountResponse, error) {
+		GetAccountHandler: func(address string, _ common.AccountQueryOptions) (api.AccountResponse, error) {
 			return api.AccountResponse{
 				Address:         "1234",
 				Balance:         big.NewInt(100).String(),
@@ -461,7 +473,7 @@ func TestGetESDTBalance_NodeFailsShouldError(t *testing.T) {
 	testAddress := "address"
 	expectedErr := errors.New("expected error")
 	facade := mock.FacadeStub{
-		GetESDTDataCalled: func(_ string, _ string, _ uint64) (*esdt.ESDigitalToken, error) {
+		GetESDTDataCalled: func(_ string, _ string, _ uint64, _ common.AccountQueryOptions) (*esdt.ESDigitalToken, error) {
 			return nil, expectedErr
 		},
 	}
@@ -488,7 +500,7 @@ func TestGetESDTBalance_ShouldWork(t *testing.T) {
 	testValue := big.NewInt(100).String()
 	testProperties := []byte{byte(0), byte(1), byte(0)}
 	facade := mock.FacadeStub{
-		GetESDTDataCalled: func(_ string, _ string, _ uint64) (*esdt.ESDigitalToken, error) {
+		GetESDTDataCalled: func(_ string, _ string, _ uint64, _ common.AccountQueryOptions) (*esdt.ESDigitalToken, error) {
 			return &esdt.ESDigitalToken{Value: big.NewInt(100), Properties: testProperties}, nil
 		},
 	}
@@ -515,7 +527,7 @@ func TestGetESDTNFTData_NodeFailsShouldError(t *testing.T) {
 	testAddress := "address"
 	expectedErr := errors.New("expected error")
 	facade := mock.FacadeStub{
-		GetESDTDataCalled: func(_ string, _ string, _ uint64) (*esdt.ESDigitalToken, error) {
+		GetESDTDataCalled: func(_ string, _ string, _ uint64, _ common.AccountQueryOptions) (*esdt.ESDigitalToken, error) {
 			return nil, expectedErr
 		},
 	}
@@ -543,7 +555,7 @@ func TestGetESDTNFTData_ShouldWork(t *testing.T) {
 	testNonce := uint64(37)
 	testProperties := []byte{byte(1), byte(0), byte(0)}
 	facade := mock.FacadeStub{
-		GetESDTDataCalled: func(_ string, _ string, _ uint64) (*esdt.ESDigitalToken, error) {
+		GetESDTDataCalled: func(_ string, _ string, _ uint64, _ common.AccountQueryOptions) (*esdt.ESDigitalToken, error) {
 			return &esdt.ESDigitalToken{
 				Value:         big.NewInt(100),
 				Properties:    []byte(testProperties),
@@ -575,7 +587,7 @@ func TestGetESDTTokensWithRole_InvalidRoleShouldError(t *testing.T) {
 	testAddress := "address"
 	expectedErr := errors.New("expected error")
 	facade := mock.FacadeStub{
-		GetESDTsWithRoleCalled: func(_ string, _ string) ([]string, error) {
+		GetESDTsWithRoleCalled: func(_ string, _ string, _ common.AccountQueryOptions) ([]string, error) {
 			return nil, expectedErr
 		},
 	}
@@ -601,7 +613,7 @@ func TestGetESDTTokensWithRole_NodeFailsShouldError(t *testing.T) {
 	testAddress := "address"
 	expectedErr := errors.New("expected error")
 	facade := mock.FacadeStub{
-		GetESDTsWithRoleCalled: func(_ string, _ string) ([]string, error) {
+		GetESDTsWithRoleCalled: func(_ string, _ string, _ common.AccountQueryOptions) ([]string, error) {
 			return nil, expectedErr
 		},
 	}
@@ -627,7 +639,7 @@ func TestGetESDTTokensWithRole_ShouldWork(t *testing.T) {
 	testAddress := "address"
 	expectedTokens := []string{"ABC-0o9i8u", "XYZ-r5y7i9"}
 	facade := mock.FacadeStub{
-		GetESDTsWithRoleCalled: func(address string, role string) ([]string, error) {
+		GetESDTsWithRoleCalled: func(address string, role string, _ common.AccountQueryOptions) ([]string, error) {
 			return expectedTokens, nil
 		},
 	}
@@ -653,7 +665,7 @@ func TestGetNFTTokenIDsRegisteredByAddress_NodeFailsShouldError(t *testing.T) {
 	testAddress := "address"
 	expectedErr := errors.New("expected error")
 	facade := mock.FacadeStub{
-		GetNFTTokenIDsRegisteredByAddressCalled: func(_ string) ([]string, error) {
+		GetNFTTokenIDsRegisteredByAddressCalled: func(_ string, _ common.AccountQueryOptions) ([]string, error) {
 			return nil, expectedErr
 		},
 	}
@@ -679,7 +691,7 @@ func TestGetNFTTokenIDsRegisteredByAddress_ShouldWork(t *testing.T) {
 	testAddress := "address"
 	expectedTokens := []string{"ABC-0o9i8u", "XYZ-r5y7i9"}
 	facade := mock.FacadeStub{
-		GetNFTTokenIDsRegisteredByAddressCalled: func(address string) ([]string, error) {
+		GetNFTTokenIDsRegisteredByAddressCalled: func(address string, _ common.AccountQueryOptions) ([]string, error) {
 			return expectedTokens, nil
 		},
 	}
@@ -705,7 +717,7 @@ func TestGetFullESDTTokens_NodeFailsShouldError(t *testing.T) {
 	testAddress := "address"
 	expectedErr := errors.New("expected error")
 	facade := mock.FacadeStub{
-		GetAllESDTTokensCalled: func(_ string) (map[string]*esdt.ESDigitalToken, error) {
+		GetAllESDTTokensCalled: func(_ string, _ common.AccountQueryOptions) (map[string]*esdt.ESDigitalToken, error) {
 			return nil, expectedErr
 		},
 	}
@@ -732,7 +744,7 @@ func TestGetFullESDTTokens_ShouldWork(t *testing.T) {
 	testValue1 := "token1"
 	testValue2 := "token2"
 	facade := mock.FacadeStub{
-		GetAllESDTTokensCalled: func(address string) (map[string]*esdt.ESDigitalToken, error) {
+		GetAllESDTTokensCalled: func(address string, _ common.AccountQueryOptions) (map[string]*esdt.ESDigitalToken, error) {
 			tokens := make(map[string]*esdt.ESDigitalToken)
 			tokens[testValue1] = &esdt.ESDigitalToken{Value: big.NewInt(10)}
 			tokens[testValue2] = &esdt.ESDigitalToken{Value: big.NewInt(100)}
@@ -785,7 +797,7 @@ func TestGetKeyValuePairs_NodeFailsShouldError(t *testing.T) {
 	testAddress := "address"
 	expectedErr := errors.New("expected error")
 	facade := mock.FacadeStub{
-		GetKeyValuePairsCalled: func(_ string) (map[string]string, error) {
+		GetKeyValuePairsCalled: func(_ string, _ common.AccountQueryOptions) (map[string]string, error) {
 			return nil, expectedErr
 		},
 	}
@@ -814,7 +826,7 @@ func TestGetKeyValuePairs_ShouldWork(t *testing.T) {
 	}
 	testAddress := "address"
 	facade := mock.FacadeStub{
-		GetKeyValuePairsCalled: func(_ string) (map[string]string, error) {
+		GetKeyValuePairsCalled: func(_ string, _ common.AccountQueryOptions) (map[string]string, error) {
 			return pairs, nil
 		},
 	}
@@ -864,7 +876,7 @@ func TestGetESDTsRoles_NodeFailsShouldError(t *testing.T) {
 	testAddress := "address"
 	expectedErr := errors.New("expected error")
 	facade := mock.FacadeStub{
-		GetESDTsRolesCalled: func(_ string) (map[string][]string, error) {
+		GetESDTsRolesCalled: func(_ string, _ common.AccountQueryOptions) (map[string][]string, error) {
 			return nil, expectedErr
 		},
 	}
@@ -893,7 +905,7 @@ func TestGetESDTsRoles_ShouldWork(t *testing.T) {
 	}
 	testAddress := "address"
 	facade := mock.FacadeStub{
-		GetESDTsRolesCalled: func(_ string) (map[string][]string, error) {
+		GetESDTsRolesCalled: func(_ string, _ common.AccountQueryOptions) (map[string][]string, error) {
 			return roles, nil
 		},
 	}
@@ -913,6 +925,157 @@ func TestGetESDTsRoles_ShouldWork(t *testing.T) {
 	assert.Equal(t, roles, response.Data.Roles)
 }
 
+func TestGetBalance_HistoricalQueryShouldPassTheBlockOptions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("block nonce", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			BalanceHandler: func(_ string, options common.AccountQueryOptions) (*big.Int, error) {
+				assert.Equal(t, common.AccountQueryOptions{BlockNonce: 37, HasBlockNonce: true}, options)
+				return big.NewInt(10), nil
+			},
+		}
+
+		response, code := requestBalance(t, &facade, "/address/address/balance?blockNonce=37")
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, "10", getValueForKey(response.Data, "balance"))
+	})
+	t.Run("block hash", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			BalanceHandler: func(_ string, options common.AccountQueryOptions) (*big.Int, error) {
+				assert.Equal(t, common.AccountQueryOptions{BlockHash: []byte{0xab, 0xcd}}, options)
+				return big.NewInt(10), nil
+			},
+		}
+
+		response, code := requestBalance(t, &facade, "/address/address/balance?blockHash=abcd")
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, "10", getValueForKey(response.Data, "balance"))
+	})
+}
+
+func TestGetBalance_InvalidBlockOptionsShouldError(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.FacadeStub{
+		BalanceHandler: func(_ string, _ common.AccountQueryOptions) (*big.Int, error) {
+			require.Fail(t, "should have not called the facade")
+			return nil, nil
+		},
+	}
+
+	response, code := requestBalance(t, &facade, "/address/address/balance?blockNonce=abc")
+	assert.Equal(t, http.StatusBadRequest, code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidQueryParameter.Error()))
+
+	response, code = requestBalance(t, &facade, "/address/address/balance?blockHash=xyz")
+	assert.Equal(t, http.StatusBadRequest, code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidQueryParameter.Error()))
+
+	response, code = requestBalance(t, &facade, "/address/address/balance?blockNonce=37&blockHash=abcd")
+	assert.Equal(t, http.StatusBadRequest, code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrBlockNonceAndHashProvided.Error()))
+}
+
+func requestBalance(t *testing.T, facade *mock.FacadeStub, url string) (shared.GenericAPIResponse, int) {
+	addrGroup, err := groups.NewAddressGroup(facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(addrGroup, "address", getAddressRoutesConfig())
+
+	req, _ := http.NewRequest("GET", url, nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	return response, resp.Code
+}
+
+func TestGetAccountTransactions_InvalidQueryParameterShouldError(t *testing.T) {
+	t.Parallel()
+
+	addrGroup, err := groups.NewAddressGroup(&mock.FacadeStub{})
+	require.NoError(t, err)
+
+	ws := startWebServer(addrGroup, "address", getAddressRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/address/address/transactions?cursor=abc", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := accountTransactionsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidQueryParameter.Error()))
+}
+
+func TestGetAccountTransactions_NodeFailsShouldError(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.FacadeStub{
+		GetAccountTransactionsCalled: func(_ string, _ uint64, _ int) (*common.AccountTransactionsResponse, error) {
+			return nil, expectedErr
+		},
+	}
+
+	addrGroup, err := groups.NewAddressGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(addrGroup, "address", getAddressRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/address/address/transactions", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := accountTransactionsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetAccountTransactions_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	testAddress := "address"
+	expectedTransactions := []*common.AccountTransaction{
+		{Hash: "aa", BlockHash: "bb", BlockNonce: 2, Round: 3, Epoch: 1},
+	}
+	facade := mock.FacadeStub{
+		GetAccountTransactionsCalled: func(address string, cursor uint64, limit int) (*common.AccountTransactionsResponse, error) {
+			assert.Equal(t, testAddress, address)
+			assert.Equal(t, uint64(37), cursor)
+			assert.Equal(t, 5, limit)
+
+			return &common.AccountTransactionsResponse{
+				Transactions: expectedTransactions,
+				NextCursor:   36,
+			}, nil
+		},
+	}
+
+	addrGroup, err := groups.NewAddressGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(addrGroup, "address", getAddressRoutesConfig())
+
+	req, _ := http.NewRequest("GET", fmt.Sprintf("/address/%s/transactions?cursor=37&limit=5", testAddress), nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := accountTransactionsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedTransactions, response.Data.Transactions)
+	assert.Equal(t, uint64(36), response.Data.NextCursor)
+}
+
 func TestAddressGroup_UpdateFacadeStub(t *testing.T) {
 	t.Parallel()
 
@@ -922,7 +1085,7 @@ func TestAddressGroup_UpdateFacadeStub(t *testing.T) {
 	}
 	testAddress := "address"
 	facade := mock.FacadeStub{
-		GetESDTsRolesCalled: func(_ string) (map[string][]string, error) {
+		GetESDTsRolesCalled: func(_ string, _ common.AccountQueryOptions) (map[string][]string, error) {
 			return roles, nil
 		},
 	}
@@ -943,7 +1106,7 @@ func TestAddressGroup_UpdateFacadeStub(t *testing.T) {
 
 	newErr := errors.New("new error")
 	newFacadeStub := mock.FacadeStub{
-		GetESDTsRolesCalled: func(_ string) (map[string][]string, error) {
+		GetESDTsRolesCalled: func(_ string, _ common.AccountQueryOptions) (map[string][]string, error) {
 			return nil, newErr
 		},
 	}
@@ -976,6 +1139,7 @@ func getAddressRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/:address/nft/:tokenIdentifier/nonce/:nonce", Open: true},
 					{Name: "/:address/esdts-with-role/:role", Open: true},
 					{Name: "/:address/registered-nfts", Open: true},
+					{Name: "/:address/transactions", Open: true},
 				},
 			},
 		},
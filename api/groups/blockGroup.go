@@ -11,13 +11,21 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data/api"
 	"github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/gin-gonic/gin"
 )
 
 const (
-	getBlockByNoncePath = "/by-nonce/:nonce"
-	getBlockByHashPath  = "/by-hash/:hash"
-	getBlockByRoundPath = "/by-round/:round"
+	getBlockByNoncePath             = "/by-nonce/:nonce"
+	getBlockByHashPath              = "/by-hash/:hash"
+	getBlockByRoundPath             = "/by-round/:round"
+	getBlocksPath                   = "/blocks"
+	getBlocksByEpochPath            = "/blocks/epoch/:epoch"
+	getBlockStateChangesByNoncePath = "/by-nonce/:nonce/state-changes"
+
+	queryParamFromNonce   = "fromNonce"
+	queryParamToNonce     = "toNonce"
+	queryParamHeadersOnly = "headersOnly"
 )
 
 // blockFacadeHandler defines the methods to be implemented by a facade for handling block requests
@@ -25,6 +33,9 @@ type blockFacadeHandler interface {
 	GetBlockByHash(hash string, withTxs bool) (*api.Block, error)
 	GetBlockByNonce(nonce uint64, withTxs bool) (*api.Block, error)
 	GetBlockByRound(round uint64, withTxs bool) (*api.Block, error)
+	GetBlocksByNonceRange(fromNonce uint64, toNonce uint64, options common.BlocksQueryOptions) (*common.BlocksRangeResponse, error)
+	GetBlocksByEpoch(epoch uint32, fromNonce uint64, options common.BlocksQueryOptions) (*common.BlocksRangeResponse, error)
+	GetBlockStateChangesByNonce(nonce uint64) (*common.BlockStateChangesResponse, error)
 	IsInterfaceNil() bool
 }
 
@@ -61,6 +72,21 @@ func NewBlockGroup(facade blockFacadeHandler) (*blockGroup, error) {
 			Method:  http.MethodGet,
 			Handler: bg.getBlockByRound,
 		},
+		{
+			Path:    getBlocksPath,
+			Method:  http.MethodGet,
+			Handler: bg.getBlocksByNonceRange,
+		},
+		{
+			Path:    getBlocksByEpochPath,
+			Method:  http.MethodGet,
+			Handler: bg.getBlocksByEpoch,
+		},
+		{
+			Path:    getBlockStateChangesByNoncePath,
+			Method:  http.MethodGet,
+			Handler: bg.getBlockStateChangesByNonce,
+		},
 	}
 	bg.endpoints = endpoints
 
@@ -170,6 +196,151 @@ func (bg *blockGroup) getBlockByRound(c *gin.Context) {
 	shared.RespondWith(c, http.StatusOK, gin.H{"block": block}, "", shared.ReturnCodeSuccess)
 }
 
+func (bg *blockGroup) getBlocksByNonceRange(c *gin.Context) {
+	fromNonce, err := strconv.ParseUint(c.Request.URL.Query().Get(queryParamFromNonce), 10, 64)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrInvalidBlockNonce.Error()),
+		)
+		return
+	}
+
+	toNonce, err := strconv.ParseUint(c.Request.URL.Query().Get(queryParamToNonce), 10, 64)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrInvalidBlockNonce.Error()),
+		)
+		return
+	}
+
+	options, err := getBlocksQueryOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrInvalidQueryParameter.Error()),
+		)
+		return
+	}
+
+	start := time.Now()
+	response, err := bg.getFacade().GetBlocksByNonceRange(fromNonce, toNonce, options)
+	log.Debug(fmt.Sprintf("GetBlocksByNonceRange took %s", time.Since(start)))
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrGetBlocks.Error(), err.Error()),
+			shared.ReturnCodeInternalError,
+		)
+		return
+	}
+
+	respondWithBlocksRange(c, response)
+}
+
+func (bg *blockGroup) getBlocksByEpoch(c *gin.Context) {
+	epoch, err := strconv.ParseUint(c.Param("epoch"), 10, 32)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrInvalidBlockEpoch.Error()),
+		)
+		return
+	}
+
+	fromNonce := uint64(0)
+	fromNonceStr := c.Request.URL.Query().Get(queryParamFromNonce)
+	if fromNonceStr != "" {
+		fromNonce, err = strconv.ParseUint(fromNonceStr, 10, 64)
+		if err != nil {
+			shared.RespondWithValidationError(
+				c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrInvalidBlockNonce.Error()),
+			)
+			return
+		}
+	}
+
+	options, err := getBlocksQueryOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrInvalidQueryParameter.Error()),
+		)
+		return
+	}
+
+	start := time.Now()
+	response, err := bg.getFacade().GetBlocksByEpoch(uint32(epoch), fromNonce, options)
+	log.Debug(fmt.Sprintf("GetBlocksByEpoch took %s", time.Since(start)))
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrGetBlocks.Error(), err.Error()),
+			shared.ReturnCodeInternalError,
+		)
+		return
+	}
+
+	respondWithBlocksRange(c, response)
+}
+
+func (bg *blockGroup) getBlockStateChangesByNonce(c *gin.Context) {
+	nonce, err := getQueryParamNonce(c)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrInvalidBlockNonce.Error()),
+		)
+		return
+	}
+
+	start := time.Now()
+	response, err := bg.getFacade().GetBlockStateChangesByNonce(nonce)
+	log.Debug(fmt.Sprintf("GetBlockStateChangesByNonce took %s", time.Since(start)))
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrGetBlockStateChanges.Error(), err.Error()),
+			shared.ReturnCodeInternalError,
+		)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"stateChanges": response}, "", shared.ReturnCodeSuccess)
+}
+
+func respondWithBlocksRange(c *gin.Context, response *common.BlocksRangeResponse) {
+	shared.RespondWith(
+		c,
+		http.StatusOK,
+		gin.H{"blocks": response.Blocks, "nextNonce": response.NextNonce, "hasMore": response.HasMore},
+		"",
+		shared.ReturnCodeSuccess,
+	)
+}
+
+func getBlocksQueryOptions(c *gin.Context) (common.BlocksQueryOptions, error) {
+	withTxs, err := getQueryParamWithTxs(c)
+	if err != nil {
+		return common.BlocksQueryOptions{}, err
+	}
+
+	headersOnly := false
+	headersOnlyStr := c.Request.URL.Query().Get(queryParamHeadersOnly)
+	if headersOnlyStr != "" {
+		headersOnly, err = strconv.ParseBool(headersOnlyStr)
+		if err != nil {
+			return common.BlocksQueryOptions{}, err
+		}
+	}
+
+	return common.BlocksQueryOptions{
+		WithTxs:     withTxs,
+		HeadersOnly: headersOnly,
+	}, nil
+}
+
 func getQueryParamWithTxs(c *gin.Context) (bool, error) {
 	withTxsStr := c.Request.URL.Query().Get("withTxs")
 	if withTxsStr == "" {
@@ -11,6 +11,7 @@ import (
 	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/groups"
 	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -233,6 +234,9 @@ func getBlockRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/by-nonce/:nonce", Open: true},
 					{Name: "/by-hash/:hash", Open: true},
 					{Name: "/by-round/:round", Open: true},
+					{Name: "/blocks", Open: true},
+					{Name: "/blocks/epoch/:epoch", Open: true},
+					{Name: "/by-nonce/:nonce/state-changes", Open: true},
 				},
 			},
 		},
@@ -423,3 +427,237 @@ func TestGetBlockByRound_WithTxs_ShouldWork(t *testing.T) {
 	assert.Equal(t, http.StatusOK, resp.Code)
 	assert.Equal(t, expectedBlock, response.Data.Block)
 }
+
+// ---- blocks range
+
+type blocksRangeResponseData struct {
+	Blocks    []*api.Block `json:"blocks"`
+	NextNonce uint64       `json:"nextNonce"`
+	HasMore   bool         `json:"hasMore"`
+}
+
+type blocksRangeResponse struct {
+	Data  blocksRangeResponseData `json:"data"`
+	Error string                  `json:"error"`
+	Code  string                  `json:"code"`
+}
+
+func TestGetBlocksByNonceRange_InvalidNonceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	blockGroup, err := groups.NewBlockGroup(&mock.FacadeStub{})
+	require.NoError(t, err)
+
+	ws := startWebServer(blockGroup, "block", getBlockRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/block/blocks?fromNonce=1&toNonce=invalid", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := blocksRangeResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidBlockNonce.Error()))
+}
+
+func TestGetBlocksByNonceRange_FacadeErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("local err")
+	facade := mock.FacadeStub{
+		GetBlocksByNonceRangeCalled: func(_ uint64, _ uint64, _ common.BlocksQueryOptions) (*common.BlocksRangeResponse, error) {
+			return nil, expectedErr
+		},
+	}
+
+	blockGroup, err := groups.NewBlockGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(blockGroup, "block", getBlockRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/block/blocks?fromNonce=1&toNonce=10", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := blocksRangeResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetBlocksByNonceRange_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	var receivedOptions common.BlocksQueryOptions
+	facade := mock.FacadeStub{
+		GetBlocksByNonceRangeCalled: func(fromNonce uint64, toNonce uint64, options common.BlocksQueryOptions) (*common.BlocksRangeResponse, error) {
+			receivedOptions = options
+			return &common.BlocksRangeResponse{
+				Blocks:    []*api.Block{{Nonce: fromNonce}, {Nonce: toNonce}},
+				NextNonce: toNonce + 1,
+				HasMore:   true,
+			}, nil
+		},
+	}
+
+	blockGroup, err := groups.NewBlockGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(blockGroup, "block", getBlockRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/block/blocks?fromNonce=5&toNonce=6&withTxs=true&headersOnly=true", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := blocksRangeResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, common.BlocksQueryOptions{WithTxs: true, HeadersOnly: true}, receivedOptions)
+	require.Len(t, response.Data.Blocks, 2)
+	assert.Equal(t, uint64(5), response.Data.Blocks[0].Nonce)
+	assert.Equal(t, uint64(7), response.Data.NextNonce)
+	assert.True(t, response.Data.HasMore)
+}
+
+func TestGetBlocksByEpoch_InvalidEpochShouldErr(t *testing.T) {
+	t.Parallel()
+
+	blockGroup, err := groups.NewBlockGroup(&mock.FacadeStub{})
+	require.NoError(t, err)
+
+	ws := startWebServer(blockGroup, "block", getBlockRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/block/blocks/epoch/invalid", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := blocksRangeResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidBlockEpoch.Error()))
+}
+
+func TestGetBlocksByEpoch_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.FacadeStub{
+		GetBlocksByEpochCalled: func(epoch uint32, fromNonce uint64, options common.BlocksQueryOptions) (*common.BlocksRangeResponse, error) {
+			return &common.BlocksRangeResponse{
+				Blocks: []*api.Block{{Nonce: fromNonce, Epoch: epoch}},
+			}, nil
+		},
+	}
+
+	blockGroup, err := groups.NewBlockGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(blockGroup, "block", getBlockRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/block/blocks/epoch/3?fromNonce=120", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := blocksRangeResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	require.Len(t, response.Data.Blocks, 1)
+	assert.Equal(t, uint32(3), response.Data.Blocks[0].Epoch)
+	assert.Equal(t, uint64(120), response.Data.Blocks[0].Nonce)
+	assert.False(t, response.Data.HasMore)
+}
+
+type blockStateChangesResponseData struct {
+	StateChanges *common.BlockStateChangesResponse `json:"stateChanges"`
+}
+
+type blockStateChangesResponse struct {
+	Data  blockStateChangesResponseData `json:"data"`
+	Error string                        `json:"error"`
+	Code  string                        `json:"code"`
+}
+
+func TestGetBlockStateChangesByNonce_InvalidNonceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	blockGroup, err := groups.NewBlockGroup(&mock.FacadeStub{})
+	require.NoError(t, err)
+
+	ws := startWebServer(blockGroup, "block", getBlockRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/block/by-nonce/invalid/state-changes", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := blockStateChangesResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidBlockNonce.Error()))
+}
+
+func TestGetBlockStateChangesByNonce_FacadeErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("local err")
+	facade := mock.FacadeStub{
+		GetBlockStateChangesByNonceCalled: func(_ uint64) (*common.BlockStateChangesResponse, error) {
+			return nil, expectedErr
+		},
+	}
+
+	blockGroup, err := groups.NewBlockGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(blockGroup, "block", getBlockRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/block/by-nonce/37/state-changes", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := blockStateChangesResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetBlockStateChanges.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetBlockStateChangesByNonce_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.FacadeStub{
+		GetBlockStateChangesByNonceCalled: func(nonce uint64) (*common.BlockStateChangesResponse, error) {
+			return &common.BlockStateChangesResponse{
+				BlockHash:  "abcd",
+				BlockNonce: nonce,
+				Accounts: []*common.AccountStateChangeResponse{
+					{Address: "erd1alice", OldBalance: "10", NewBalance: "7", OldNonce: 1, NewNonce: 2},
+				},
+			}, nil
+		},
+	}
+
+	blockGroup, err := groups.NewBlockGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(blockGroup, "block", getBlockRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/block/by-nonce/37/state-changes", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := blockStateChangesResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	require.NotNil(t, response.Data.StateChanges)
+	assert.Equal(t, uint64(37), response.Data.StateChanges.BlockNonce)
+	require.Len(t, response.Data.StateChanges.Accounts, 1)
+	assert.Equal(t, "erd1alice", response.Data.StateChanges.Accounts[0].Address)
+	assert.Equal(t, "7", response.Data.StateChanges.Accounts[0].NewBalance)
+}
@@ -0,0 +1,107 @@
+package groups
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	apiEvents "github.com/ElrondNetwork/elrond-go/api/events"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/outport/events"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+)
+
+const subscribePath = "/subscribe"
+
+// eventsFacadeHandler defines the methods to be implemented by a facade for handling events subscriptions
+type eventsFacadeHandler interface {
+	SubscribeToEvents(filter events.SubscriptionFilter) (*events.Subscription, error)
+	UnsubscribeFromEvents(subscription *events.Subscription)
+	IsInterfaceNil() bool
+}
+
+type eventsGroup struct {
+	*baseGroup
+	facade    eventsFacadeHandler
+	mutFacade sync.RWMutex
+	upgrader  websocket.Upgrader
+}
+
+// NewEventsGroup returns a new instance of eventsGroup
+func NewEventsGroup(facade eventsFacadeHandler) (*eventsGroup, error) {
+	if check.IfNil(facade) {
+		return nil, fmt.Errorf("%w for events group", errors.ErrNilFacadeHandler)
+	}
+
+	eg := &eventsGroup{
+		facade:    facade,
+		baseGroup: &baseGroup{},
+		upgrader: websocket.Upgrader{
+			CheckOrigin: func(r *http.Request) bool {
+				return true
+			},
+		},
+	}
+
+	endpoints := []*shared.EndpointHandlerData{
+		{
+			Path:    subscribePath,
+			Method:  http.MethodGet,
+			Handler: eg.subscribe,
+		},
+	}
+	eg.endpoints = endpoints
+
+	return eg, nil
+}
+
+// subscribe upgrades the connection to a web socket one and streams the events matching the filter sent by the client
+func (eg *eventsGroup) subscribe(c *gin.Context) {
+	conn, err := eg.upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		// the upgrader already replied with an HTTP error
+		log.Debug("events web socket upgrade failed", "error", err.Error())
+		return
+	}
+
+	sender, err := apiEvents.NewEventsSender(eg.getFacade(), conn, log)
+	if err != nil {
+		log.Error(err.Error())
+		_ = conn.Close()
+		return
+	}
+
+	sender.StartSendingBlocking()
+}
+
+func (eg *eventsGroup) getFacade() eventsFacadeHandler {
+	eg.mutFacade.RLock()
+	defer eg.mutFacade.RUnlock()
+
+	return eg.facade
+}
+
+// UpdateFacade will update the facade
+func (eg *eventsGroup) UpdateFacade(newFacade interface{}) error {
+	if newFacade == nil {
+		return errors.ErrNilFacadeHandler
+	}
+	castFacade, ok := newFacade.(eventsFacadeHandler)
+	if !ok {
+		return errors.ErrFacadeWrongTypeAssertion
+	}
+
+	eg.mutFacade.Lock()
+	eg.facade = castFacade
+	eg.mutFacade.Unlock()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (eg *eventsGroup) IsInterfaceNil() bool {
+	return eg == nil
+}
@@ -0,0 +1,120 @@
+package groups_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/groups"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/outport/events"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/hashingMocks"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewEventsGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade", func(t *testing.T) {
+		eg, err := groups.NewEventsGroup(nil)
+		require.True(t, errors.Is(err, apiErrors.ErrNilFacadeHandler))
+		require.Nil(t, eg)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		eg, err := groups.NewEventsGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		require.NotNil(t, eg)
+	})
+}
+
+func TestSubscribe_NotWebSocketShouldErr(t *testing.T) {
+	t.Parallel()
+
+	eventsGroup, err := groups.NewEventsGroup(&mock.FacadeStub{})
+	require.NoError(t, err)
+
+	ws := startWebServer(eventsGroup, "events", getEventsRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/events/subscribe", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+}
+
+func TestSubscribe_ShouldStreamEvents(t *testing.T) {
+	t.Parallel()
+
+	driver, _ := events.NewEventsDriver(events.ArgsEventsDriver{
+		Marshalizer:            &testscommon.MarshalizerMock{},
+		Hasher:                 &hashingMocks.HasherMock{},
+		AddressPubKeyConverter: testscommon.NewPubkeyConverterMock(32),
+		MaxSubscribers:         1,
+		SubscriptionBufferSize: 10,
+	})
+	subscribed := make(chan struct{})
+	facade := &mock.FacadeStub{
+		SubscribeToEventsCalled: func(filter events.SubscriptionFilter) (*events.Subscription, error) {
+			defer close(subscribed)
+			return driver.Subscribe(filter)
+		},
+		UnsubscribeFromEventsCalled: func(subscription *events.Subscription) {
+			driver.Unsubscribe(subscription)
+		},
+	}
+
+	eventsGroup, err := groups.NewEventsGroup(facade)
+	require.NoError(t, err)
+
+	server := httptest.NewServer(startWebServer(eventsGroup, "events", getEventsRoutesConfig()))
+	defer server.Close()
+
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + "/events/subscribe"
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	require.NoError(t, err)
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	err = conn.WriteJSON(&events.SubscriptionFilter{Blocks: true})
+	require.NoError(t, err)
+	<-subscribed
+
+	_ = driver.SaveBlock(&indexer.ArgsSaveBlockData{
+		HeaderHash: []byte("hash"),
+		Header:     &block.Header{Nonce: 37},
+	})
+	_ = driver.FinalizedBlock([]byte("hash"))
+
+	_, message, err := conn.ReadMessage()
+	require.NoError(t, err)
+
+	event := &events.Event{}
+	err = json.Unmarshal(message, event)
+	require.NoError(t, err)
+	assert.Equal(t, events.FinalizedBlockEvent, event.Type)
+	assert.Equal(t, uint64(37), event.Block.Nonce)
+}
+
+func getEventsRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"events": {
+				Routes: []config.RouteConfig{
+					{Name: "/subscribe", Open: true},
+				},
+			},
+		},
+	}
+}
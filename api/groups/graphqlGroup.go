@@ -0,0 +1,144 @@
+package groups
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/graphql"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/gin-gonic/gin"
+	graphqlgo "github.com/graph-gophers/graphql-go"
+)
+
+const (
+	graphqlQueryEndpoint = "/graphql/query"
+	graphqlQueryPath     = "/query"
+
+	defaultGraphQLMaxQueryDepth      = 10
+	defaultGraphQLMaxQueryComplexity = 100
+)
+
+// graphqlFacadeHandler defines the methods to be implemented by a facade for GraphQL requests
+type graphqlFacadeHandler interface {
+	graphql.FacadeHandler
+	GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool)
+}
+
+type queryExecutor interface {
+	Execute(ctx context.Context, request *graphql.Request) *graphqlgo.Response
+}
+
+type graphqlGroup struct {
+	*baseGroup
+	facade        graphqlFacadeHandler
+	mutFacade     sync.RWMutex
+	queryExecutor queryExecutor
+}
+
+// NewGraphQLGroup returns a new instance of graphqlGroup. The query depth and complexity limits are read from the
+// web server antiflood configuration, the defaults being used for the values left unset
+func NewGraphQLGroup(facade graphqlFacadeHandler, antifloodConfig config.WebServerAntifloodConfig) (*graphqlGroup, error) {
+	if check.IfNil(facade) {
+		return nil, fmt.Errorf("%w for graphql group", errors.ErrNilFacadeHandler)
+	}
+
+	gg := &graphqlGroup{
+		facade:    facade,
+		baseGroup: &baseGroup{},
+	}
+
+	argsQueryExecutor := graphql.ArgsQueryExecutor{
+		FacadeGetter: func() graphql.FacadeHandler {
+			return gg.getFacade()
+		},
+		MaxQueryDepth:      antifloodConfig.GraphQLMaxQueryDepth,
+		MaxQueryComplexity: antifloodConfig.GraphQLMaxQueryComplexity,
+	}
+	if argsQueryExecutor.MaxQueryDepth == 0 {
+		argsQueryExecutor.MaxQueryDepth = defaultGraphQLMaxQueryDepth
+	}
+	if argsQueryExecutor.MaxQueryComplexity == 0 {
+		argsQueryExecutor.MaxQueryComplexity = defaultGraphQLMaxQueryComplexity
+	}
+
+	var err error
+	gg.queryExecutor, err = graphql.NewQueryExecutor(argsQueryExecutor)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoints := []*shared.EndpointHandlerData{
+		{
+			Path:    graphqlQueryPath,
+			Method:  http.MethodPost,
+			Handler: gg.query,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(graphqlQueryEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
+	}
+	gg.endpoints = endpoints
+
+	return gg, nil
+}
+
+// query will execute the GraphQL request and will respond with the standard GraphQL response, holding the data and
+// the errors encountered while resolving each field
+func (gg *graphqlGroup) query(c *gin.Context) {
+	request := graphql.Request{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	response := gg.queryExecutor.Execute(c.Request.Context(), &request)
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (gg *graphqlGroup) getFacade() graphqlFacadeHandler {
+	gg.mutFacade.RLock()
+	defer gg.mutFacade.RUnlock()
+
+	return gg.facade
+}
+
+// UpdateFacade will update the facade
+func (gg *graphqlGroup) UpdateFacade(newFacade interface{}) error {
+	if newFacade == nil {
+		return errors.ErrNilFacadeHandler
+	}
+	castFacade, ok := newFacade.(graphqlFacadeHandler)
+	if !ok {
+		return errors.ErrFacadeWrongTypeAssertion
+	}
+
+	gg.mutFacade.Lock()
+	gg.facade = castFacade
+	gg.mutFacade.Unlock()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (gg *graphqlGroup) IsInterfaceNil() bool {
+	return gg == nil
+}
@@ -0,0 +1,191 @@
+package groups_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/data/api"
+	"github.com/ElrondNetwork/elrond-go-core/data/esdt"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/groups"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type graphqlResponse struct {
+	Data   map[string]interface{} `json:"data"`
+	Errors []struct {
+		Message string        `json:"message"`
+		Path    []interface{} `json:"path"`
+	} `json:"errors"`
+}
+
+func TestNewGraphQLGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade", func(t *testing.T) {
+		gg, err := groups.NewGraphQLGroup(nil, config.WebServerAntifloodConfig{})
+		require.True(t, errors.Is(err, apiErrors.ErrNilFacadeHandler))
+		require.Nil(t, gg)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		gg, err := groups.NewGraphQLGroup(&mock.FacadeStub{}, config.WebServerAntifloodConfig{})
+		require.NoError(t, err)
+		require.NotNil(t, gg)
+	})
+}
+
+func TestGraphQLQuery_InvalidJSONShouldErr(t *testing.T) {
+	t.Parallel()
+
+	gg, err := groups.NewGraphQLGroup(&mock.FacadeStub{}, config.WebServerAntifloodConfig{})
+	require.NoError(t, err)
+
+	ws := startWebServer(gg, "graphql", getGraphQLRoutesConfig())
+
+	req, _ := http.NewRequest("POST", "/graphql/query", bytes.NewBuffer([]byte("invalid")))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrValidation.Error()))
+}
+
+func TestGraphQLQuery_ShouldResolveThroughFacade(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		GetAccountHandler: func(addr string, _ common.AccountQueryOptions) (api.AccountResponse, error) {
+			return api.AccountResponse{Address: addr, Nonce: 7, Balance: "100"}, nil
+		},
+		GetAllESDTTokensCalled: func(addr string, _ common.AccountQueryOptions) (map[string]*esdt.ESDigitalToken, error) {
+			return map[string]*esdt.ESDigitalToken{
+				"TKN-0002": {Value: big.NewInt(20)},
+				"TKN-0001": {Value: big.NewInt(10)},
+			}, nil
+		},
+		GetTransactionHandler: func(hash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+			return &transaction.ApiTransactionResult{Hash: hash, Nonce: 3, Status: transaction.TxStatusSuccess}, nil
+		},
+		GetBlockByNonceCalled: func(nonce uint64, withTxs bool) (*api.Block, error) {
+			return &api.Block{Nonce: nonce, Shard: 4294967295}, nil
+		},
+	}
+
+	query := `{
+		account(address: "erd1alice") { nonce balance esdtTokens { tokenIdentifier balance } }
+		transaction(hash: "aabb") { hash nonce status }
+		block(nonce: "5000000000") { nonce shard }
+	}`
+	response, statusCode := doGraphQLQuery(t, facade, config.WebServerAntifloodConfig{}, query)
+
+	require.Equal(t, http.StatusOK, statusCode)
+	require.Empty(t, response.Errors)
+
+	account := response.Data["account"].(map[string]interface{})
+	assert.Equal(t, float64(7), account["nonce"])
+	assert.Equal(t, "100", account["balance"])
+	tokens := account["esdtTokens"].([]interface{})
+	require.Equal(t, 2, len(tokens))
+	assert.Equal(t, "TKN-0001", tokens[0].(map[string]interface{})["tokenIdentifier"])
+	assert.Equal(t, "20", tokens[1].(map[string]interface{})["balance"])
+
+	tx := response.Data["transaction"].(map[string]interface{})
+	assert.Equal(t, "aabb", tx["hash"])
+	assert.Equal(t, string(transaction.TxStatusSuccess), tx["status"])
+
+	block := response.Data["block"].(map[string]interface{})
+	assert.Equal(t, float64(5000000000), block["nonce"])
+	assert.Equal(t, float64(4294967295), block["shard"])
+}
+
+func TestGraphQLQuery_ComplexityLimitExceededShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		GetAccountHandler: func(addr string, _ common.AccountQueryOptions) (api.AccountResponse, error) {
+			return api.AccountResponse{Address: addr}, nil
+		},
+	}
+	antifloodConfig := config.WebServerAntifloodConfig{
+		GraphQLMaxQueryComplexity: 6,
+	}
+
+	query := `{ account(address: "erd1alice") { esdtTokens { balance } esdtRoles { roles } } }`
+	response, statusCode := doGraphQLQuery(t, facade, antifloodConfig, query)
+
+	require.Equal(t, http.StatusOK, statusCode)
+	require.Equal(t, 1, len(response.Errors))
+	assert.True(t, strings.Contains(response.Errors[0].Message, "query complexity limit exceeded"))
+}
+
+func TestGraphQLQuery_DepthLimitExceededShouldErr(t *testing.T) {
+	t.Parallel()
+
+	getBlockCalled := false
+	facade := &mock.FacadeStub{
+		GetBlockByNonceCalled: func(nonce uint64, withTxs bool) (*api.Block, error) {
+			getBlockCalled = true
+			return &api.Block{}, nil
+		},
+	}
+	antifloodConfig := config.WebServerAntifloodConfig{
+		GraphQLMaxQueryDepth: 2,
+	}
+
+	query := `{ block(nonce: 1, withTxs: true) { miniBlocks { transactions { hash } } } }`
+	response, statusCode := doGraphQLQuery(t, facade, antifloodConfig, query)
+
+	require.Equal(t, http.StatusOK, statusCode)
+	require.Equal(t, 1, len(response.Errors))
+	assert.Nil(t, response.Data)
+	assert.False(t, getBlockCalled)
+}
+
+func doGraphQLQuery(
+	t *testing.T,
+	facade *mock.FacadeStub,
+	antifloodConfig config.WebServerAntifloodConfig,
+	query string,
+) (*graphqlResponse, int) {
+	gg, err := groups.NewGraphQLGroup(facade, antifloodConfig)
+	require.NoError(t, err)
+
+	ws := startWebServer(gg, "graphql", getGraphQLRoutesConfig())
+
+	requestBytes, _ := json.Marshal(map[string]interface{}{"query": query})
+	req, _ := http.NewRequest("POST", "/graphql/query", bytes.NewBuffer(requestBytes))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &graphqlResponse{}
+	loadResponse(resp.Body, response)
+
+	return response, resp.Code
+}
+
+func getGraphQLRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"graphql": {
+				Routes: []config.RouteConfig{
+					{Name: "/query", Open: true},
+				},
+			},
+		},
+	}
+}
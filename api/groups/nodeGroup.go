@@ -3,6 +3,7 @@ package groups
 import (
 	"fmt"
 	"net/http"
+	"strconv"
 	"sync"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
@@ -12,17 +13,20 @@ import (
 	"github.com/ElrondNetwork/elrond-go/debug"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/gin-gonic/gin"
 )
 
 const (
-	pidQueryParam       = "pid"
-	debugPath           = "/debug"
-	heartbeatStatusPath = "/heartbeatstatus"
-	metricsPath         = "/metrics"
-	p2pStatusPath       = "/p2pstatus"
-	peerInfoPath        = "/peerinfo"
-	statusPath          = "/status"
+	pidQueryParam              = "pid"
+	computeDiskUsageQueryParam = "computeDiskUsage"
+	debugPath                  = "/debug"
+	heartbeatStatusPath        = "/heartbeatstatus"
+	metricsPath                = "/metrics"
+	p2pStatusPath              = "/p2pstatus"
+	peerInfoPath               = "/peerinfo"
+	statusPath                 = "/status"
+	storagePath                = "/storage"
 
 	// AccStateCheckpointsKey is used as a key for the number of account state checkpoints in the api response
 	AccStateCheckpointsKey = "erd_num_accounts_state_checkpoints"
@@ -37,6 +41,7 @@ type nodeFacadeHandler interface {
 	StatusMetrics() external.StatusMetricsHandler
 	GetQueryHandler(name string) (debug.QueryHandler, error)
 	GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error)
+	GetStorageStatistics(computeDiskUsage bool) ([]storage.UnitStatistics, error)
 	GetNumCheckpointsFromAccountState() uint32
 	GetNumCheckpointsFromPeerState() uint32
 	IsInterfaceNil() bool
@@ -96,6 +101,11 @@ func NewNodeGroup(facade nodeFacadeHandler) (*nodeGroup, error) {
 			Method:  http.MethodGet,
 			Handler: ng.peerInfo,
 		},
+		{
+			Path:    storagePath,
+			Method:  http.MethodGet,
+			Handler: ng.storageStatistics,
+		},
 	}
 	ng.endpoints = endpoints
 
@@ -227,6 +237,48 @@ func (ng *nodeGroup) peerInfo(c *gin.Context) {
 	)
 }
 
+// storageStatistics returns the operations statistics of the storage units and, on request, their size on disk
+func (ng *nodeGroup) storageStatistics(c *gin.Context) {
+	computeDiskUsage, err := getQueryParamComputeDiskUsage(c)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrInvalidQueryParameter.Error()),
+		)
+		return
+	}
+
+	statistics, err := ng.getFacade().GetStorageStatistics(computeDiskUsage)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetStorageStatistics.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"units": statistics},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+func getQueryParamComputeDiskUsage(c *gin.Context) (bool, error) {
+	computeDiskUsageStr := c.Request.URL.Query().Get(computeDiskUsageQueryParam)
+	if computeDiskUsageStr == "" {
+		return false, nil
+	}
+
+	return strconv.ParseBool(computeDiskUsageStr)
+}
+
 // prometheusMetrics is the endpoint which will return the data in the way that prometheus expects them
 func (ng *nodeGroup) prometheusMetrics(c *gin.Context) {
 	metrics := ng.getFacade().StatusMetrics().StatusMetricsWithoutP2PPrometheusString()
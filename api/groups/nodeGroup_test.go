@@ -24,6 +24,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/statusHandler"
+	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -341,6 +342,118 @@ func TestPeerInfo_PeerInfoShouldWork(t *testing.T) {
 	assert.NotNil(t, responseInfo["info"])
 }
 
+func TestStorageStatistics_InvalidQueryParameterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.FacadeStub{
+		GetStorageStatisticsCalled: func(computeDiskUsage bool) ([]storage.UnitStatistics, error) {
+			assert.Fail(t, "should have not called the facade")
+			return nil, nil
+		},
+	}
+
+	nodeGroup, err := groups.NewNodeGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/node/storage?computeDiskUsage=not-a-bool", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidQueryParameter.Error()))
+}
+
+func TestStorageStatistics_FacadeErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.FacadeStub{
+		GetStorageStatisticsCalled: func(computeDiskUsage bool) ([]storage.UnitStatistics, error) {
+			return nil, expectedErr
+		},
+	}
+
+	nodeGroup, err := groups.NewNodeGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/node/storage", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestStorageStatistics_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	computeDiskUsageProvided := false
+	facade := mock.FacadeStub{
+		GetStorageStatisticsCalled: func(computeDiskUsage bool) ([]storage.UnitStatistics, error) {
+			computeDiskUsageProvided = computeDiskUsage
+			return []storage.UnitStatistics{
+				{
+					OperationsStatistics: storage.OperationsStatistics{
+						NumPuts:   2,
+						DiskUsage: 1024,
+					},
+					Unit: "TransactionUnit",
+					Persisters: []storage.PersisterStatistics{
+						{
+							OperationsStatistics: storage.OperationsStatistics{
+								NumPuts:   2,
+								DiskUsage: 1024,
+							},
+							Epoch: "7",
+							Path:  "Epoch_7/Shard_0/Transactions",
+						},
+					},
+				},
+			}, nil
+		},
+	}
+
+	nodeGroup, err := groups.NewNodeGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/node/storage?computeDiskUsage=true", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "", response.Error)
+	assert.True(t, computeDiskUsageProvided)
+
+	responseData, ok := response.Data.(map[string]interface{})
+	require.True(t, ok)
+	units, ok := responseData["units"].([]interface{})
+	require.True(t, ok)
+	require.Equal(t, 1, len(units))
+
+	unit := units[0].(map[string]interface{})
+	assert.Equal(t, "TransactionUnit", unit["unit"])
+	assert.Equal(t, float64(2), unit["numPuts"])
+	assert.Equal(t, float64(1024), unit["diskUsage"])
+	persisters := unit["persisters"].([]interface{})
+	require.Equal(t, 1, len(persisters))
+	assert.Equal(t, "7", persisters[0].(map[string]interface{})["epoch"])
+}
+
 func TestPrometheusMetrics_ShouldWork(t *testing.T) {
 	statusMetricsProvider := statusHandler.NewStatusMetrics()
 	key := "test-key"
@@ -390,6 +503,7 @@ func getNodeRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/p2pstatus", Open: true},
 					{Name: "/debug", Open: true},
 					{Name: "/peerinfo", Open: true},
+					{Name: "/storage", Open: true},
 				},
 			},
 		},
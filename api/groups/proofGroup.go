@@ -4,6 +4,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"net/http"
+	"strings"
 	"sync"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
@@ -19,17 +20,25 @@ const (
 	getProofCurrentRootHashEndpoint = "/proof/address/:address"
 	getProofEndpoint                = "/proof/root-hash/:roothash/address/:address"
 	getProofDataTrieEndpoint        = "/proof/root-hash/:roothash/address/:address/key/:key"
+	getProofDataTrieKeysEndpoint    = "/proof/root-hash/:roothash/address/:address/keys"
 	verifyProofEndpoint             = "/proof/verify"
 	getProofCurrentRootHashPath     = "/address/:address"
 	getProofPath                    = "/root-hash/:roothash/address/:address"
 	getProofDataTriePath            = "/root-hash/:roothash/address/:address/key/:key"
+	getProofDataTrieKeysPath        = "/root-hash/:roothash/address/:address/keys"
 	verifyProofPath                 = "/verify"
+
+	queryParamKeys       = "keys"
+	queryParamKeySuffix  = "keySuffix"
+	maxNumKeysInOneProof = 100
 )
 
 // proofFacadeHandler defines the methods to be implemented by a facade for proof requests
 type proofFacadeHandler interface {
 	GetProof(rootHash string, address string) (*common.GetProofResponse, error)
 	GetProofDataTrie(rootHash string, address string, key string) (*common.GetProofResponse, *common.GetProofResponse, error)
+	GetProofDataTrieKeys(rootHash string, address string, keys []string) (*common.GetProofResponse, *common.GetMultiProofResponse, error)
+	GetRangeProofDataTrie(rootHash string, address string, keySuffix string) (*common.GetProofResponse, *common.GetMultiProofResponse, error)
 	GetProofCurrentRootHash(address string) (*common.GetProofResponse, error)
 	VerifyProof(rootHash string, address string, proof [][]byte) (bool, error)
 	GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool)
@@ -76,6 +85,17 @@ func NewProofGroup(facade proofFacadeHandler) (*proofGroup, error) {
 				},
 			},
 		},
+		{
+			Path:    getProofDataTrieKeysPath,
+			Method:  http.MethodGet,
+			Handler: pg.getProofDataTrieKeys,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(getProofDataTrieKeysEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
 		{
 			Path:    getProofCurrentRootHashPath,
 			Method:  http.MethodGet,
@@ -240,6 +260,115 @@ func (pg *proofGroup) getProofDataTrie(c *gin.Context) {
 	)
 }
 
+// getProofDataTrieKeys will receive a rootHash, an address and either a list of keys or a key suffix from the client,
+// and it will return the Merkle proof for the address and a single Merkle proof for all the requested keys. Ranges
+// are only supported as the keys ending with the given key suffix, as the data trie paths are the reversed keys.
+// The returned values are stripped of the key and address appended to each value saved in a data trie
+func (pg *proofGroup) getProofDataTrieKeys(c *gin.Context) {
+	rootHash := c.Param("roothash")
+	if rootHash == "" {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationEmptyRootHash.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	address := c.Param("address")
+	if address == "" {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationEmptyAddress.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	keys, keySuffix, err := getQueryParamsProofKeys(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	var mainTrieResponse *common.GetProofResponse
+	var dataTrieResponse *common.GetMultiProofResponse
+	if len(keys) > 0 {
+		mainTrieResponse, dataTrieResponse, err = pg.getFacade().GetProofDataTrieKeys(rootHash, address, keys)
+	} else {
+		mainTrieResponse, dataTrieResponse, err = pg.getFacade().GetRangeProofDataTrie(rootHash, address, keySuffix)
+	}
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetProof.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	proofs := make(map[string]interface{})
+	proofs["mainProof"] = bytesToHex(mainTrieResponse.Proof)
+	proofs["dataTrieProof"] = bytesToHex(dataTrieResponse.Proof)
+
+	values := make(map[string]string, len(dataTrieResponse.Keys))
+	for i, key := range dataTrieResponse.Keys {
+		values[hex.EncodeToString(key)] = hex.EncodeToString(dataTrieResponse.Values[i])
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data: gin.H{
+				"proofs":           proofs,
+				"values":           values,
+				"dataTrieRootHash": dataTrieResponse.RootHash,
+			},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+func getQueryParamsProofKeys(c *gin.Context) ([]string, string, error) {
+	keysStr := c.Request.URL.Query().Get(queryParamKeys)
+	keySuffix := c.Request.URL.Query().Get(queryParamKeySuffix)
+	if (keysStr == "") == (keySuffix == "") {
+		return nil, "", errors.ErrValidationProofKeys
+	}
+	if keySuffix != "" {
+		return nil, keySuffix, nil
+	}
+
+	keys := strings.Split(keysStr, ",")
+	if len(keys) > maxNumKeysInOneProof {
+		return nil, "", fmt.Errorf("%w, maximum is %d", errors.ErrValidationTooManyProofKeys, maxNumKeysInOneProof)
+	}
+	for _, key := range keys {
+		if key == "" {
+			return nil, "", errors.ErrValidationEmptyKey
+		}
+	}
+
+	return keys, "", nil
+}
+
 func bytesToHex(bytesValue [][]byte) []string {
 	hexValue := make([]string, 0)
 	for _, byteValue := range bytesValue {
@@ -379,6 +379,113 @@ func TestVerifyProof(t *testing.T) {
 	assert.True(t, isValid)
 }
 
+func TestGetProofDataTrieKeys_InvalidQueryParamsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		GetProofDataTrieKeysCalled: func(_ string, _ string, _ []string) (*common.GetProofResponse, *common.GetMultiProofResponse, error) {
+			assert.Fail(t, "should have not been called")
+			return nil, nil, nil
+		},
+	}
+
+	proofGroup, err := groups.NewProofGroup(facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+	tooManyKeys := strings.Repeat("aa,", 100) + "aa"
+	queries := []string{"", "?keys=aa&keySuffix=bb", "?keys=aa,,bb", "?keys=" + tooManyKeys}
+	for _, query := range queries {
+		req, _ := http.NewRequest("GET", "/proof/root-hash/roothash/address/addr/keys"+query, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrValidation.Error()))
+	}
+}
+
+func TestGetProofDataTrieKeys_GetProofError(t *testing.T) {
+	t.Parallel()
+
+	getProofErr := fmt.Errorf("GetProofDataTrieKeys error")
+	facade := &mock.FacadeStub{
+		GetProofDataTrieKeysCalled: func(_ string, _ string, _ []string) (*common.GetProofResponse, *common.GetMultiProofResponse, error) {
+			return nil, nil, getProofErr
+		},
+	}
+
+	proofGroup, err := groups.NewProofGroup(facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+	req, _ := http.NewRequest("GET", "/proof/root-hash/roothash/address/addr/keys?keys=aa,bb", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, shared.ReturnCodeInternalError, response.Code)
+	assert.True(t, strings.Contains(response.Error, getProofErr.Error()))
+}
+
+func TestGetProofDataTrieKeys(t *testing.T) {
+	t.Parallel()
+
+	mainTrieProof := [][]byte{[]byte("main"), []byte("proof")}
+	multiProofResponse := &common.GetMultiProofResponse{
+		Proof:    [][]byte{[]byte("data"), []byte("proof")},
+		Keys:     [][]byte{{0xaa}, {0xbb}},
+		Values:   [][]byte{[]byte("value"), nil},
+		RootHash: "dataTrieRootHash",
+	}
+	facade := &mock.FacadeStub{
+		GetProofDataTrieKeysCalled: func(rootHash string, address string, keys []string) (*common.GetProofResponse, *common.GetMultiProofResponse, error) {
+			assert.Equal(t, "roothash", rootHash)
+			assert.Equal(t, "addr", address)
+			assert.Equal(t, []string{"aa", "bb"}, keys)
+			return &common.GetProofResponse{Proof: mainTrieProof}, multiProofResponse, nil
+		},
+		GetRangeProofDataTrieCalled: func(rootHash string, address string, keySuffix string) (*common.GetProofResponse, *common.GetMultiProofResponse, error) {
+			assert.Equal(t, "cc", keySuffix)
+			return &common.GetProofResponse{Proof: mainTrieProof}, multiProofResponse, nil
+		},
+	}
+
+	proofGroup, err := groups.NewProofGroup(facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+	for _, query := range []string{"?keys=aa,bb", "?keySuffix=cc"} {
+		req, _ := http.NewRequest("GET", "/proof/root-hash/roothash/address/addr/keys"+query, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		require.Equal(t, shared.ReturnCodeSuccess, response.Code)
+
+		responseMap, ok := response.Data.(map[string]interface{})
+		require.True(t, ok)
+		assert.Equal(t, "dataTrieRootHash", responseMap["dataTrieRootHash"])
+
+		values, ok := responseMap["values"].(map[string]interface{})
+		require.True(t, ok)
+		assert.Equal(t, hex.EncodeToString([]byte("value")), values["aa"])
+		assert.Equal(t, "", values["bb"])
+
+		proofsResponseMap, ok := responseMap["proofs"].(map[string]interface{})
+		require.True(t, ok)
+		proofs, ok := proofsResponseMap["dataTrieProof"].([]interface{})
+		require.True(t, ok)
+		assert.Equal(t, hex.EncodeToString([]byte("data")), proofs[0])
+	}
+}
+
 func getProofRoutesConfig() config.ApiRoutesConfig {
 	return config.ApiRoutesConfig{
 		APIPackages: map[string]config.APIPackageConfig{
@@ -386,6 +493,7 @@ func getProofRoutesConfig() config.ApiRoutesConfig {
 				Routes: []config.RouteConfig{
 					{Name: "/root-hash/:roothash/address/:address", Open: true},
 					{Name: "/root-hash/:roothash/address/:address/key/:key", Open: true},
+					{Name: "/root-hash/:roothash/address/:address/keys", Open: true},
 					{Name: "/address/:address", Open: true},
 					{Name: "/verify", Open: true},
 				},
@@ -0,0 +1,135 @@
+package groups
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	getStateDiffEndpoint = "/state/diff"
+	getStateDiffPath     = "/diff"
+
+	queryParamFromRootHash = "from"
+	queryParamToRootHash   = "to"
+)
+
+// stateFacadeHandler defines the methods to be implemented by a facade for state requests
+type stateFacadeHandler interface {
+	GetStateDiff(fromRootHash string, toRootHash string, cursor string) (*common.StateDiffResponse, error)
+	GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool)
+	IsInterfaceNil() bool
+}
+
+type stateGroup struct {
+	*baseGroup
+	facade    stateFacadeHandler
+	mutFacade sync.RWMutex
+}
+
+// NewStateGroup returns a new instance of stateGroup
+func NewStateGroup(facade stateFacadeHandler) (*stateGroup, error) {
+	if check.IfNil(facade) {
+		return nil, fmt.Errorf("%w for state group", errors.ErrNilFacadeHandler)
+	}
+
+	sg := &stateGroup{
+		facade:    facade,
+		baseGroup: &baseGroup{},
+	}
+
+	endpoints := []*shared.EndpointHandlerData{
+		{
+			Path:    getStateDiffPath,
+			Method:  http.MethodGet,
+			Handler: sg.getStateDiff,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(getStateDiffEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
+	}
+	sg.endpoints = endpoints
+
+	return sg, nil
+}
+
+// getStateDiff will receive two accounts trie root hashes from the client, and it will return the accounts changed
+// between them, starting after the optional cursor returned by a previous truncated response
+func (sg *stateGroup) getStateDiff(c *gin.Context) {
+	fromRootHash := c.Query(queryParamFromRootHash)
+	toRootHash := c.Query(queryParamToRootHash)
+	if fromRootHash == "" || toRootHash == "" {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationEmptyRootHash.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	cursor := c.Query(queryParamCursor)
+	response, err := sg.getFacade().GetStateDiff(fromRootHash, toRootHash, cursor)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetStateDiff.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"diff": response},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+func (sg *stateGroup) getFacade() stateFacadeHandler {
+	sg.mutFacade.RLock()
+	defer sg.mutFacade.RUnlock()
+
+	return sg.facade
+}
+
+// UpdateFacade will update the facade
+func (sg *stateGroup) UpdateFacade(newFacade interface{}) error {
+	if newFacade == nil {
+		return errors.ErrNilFacadeHandler
+	}
+	castFacade, ok := newFacade.(stateFacadeHandler)
+	if !ok {
+		return errors.ErrFacadeWrongTypeAssertion
+	}
+
+	sg.mutFacade.Lock()
+	sg.facade = castFacade
+	sg.mutFacade.Unlock()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sg *stateGroup) IsInterfaceNil() bool {
+	return sg == nil
+}
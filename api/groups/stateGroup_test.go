@@ -0,0 +1,151 @@
+package groups_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/groups"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stateDiffResponseData struct {
+	Diff common.StateDiffResponse `json:"diff"`
+}
+
+type stateDiffResponse struct {
+	Data  stateDiffResponseData `json:"data"`
+	Error string                `json:"error"`
+	Code  string                `json:"code"`
+}
+
+func TestNewStateGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade", func(t *testing.T) {
+		sg, err := groups.NewStateGroup(nil)
+		require.True(t, errors.Is(err, apiErrors.ErrNilFacadeHandler))
+		require.Nil(t, sg)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		sg, err := groups.NewStateGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		require.NotNil(t, sg)
+	})
+}
+
+func TestGetStateDiff_MissingRootHashesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	stateGroup, err := groups.NewStateGroup(&mock.FacadeStub{
+		GetStateDiffCalled: func(_ string, _ string, _ string) (*common.StateDiffResponse, error) {
+			assert.Fail(t, "should have not been called")
+			return nil, nil
+		},
+	})
+	require.NoError(t, err)
+
+	ws := startWebServer(stateGroup, "state", getStateRoutesConfig())
+
+	for _, url := range []string{"/state/diff", "/state/diff?from=aa", "/state/diff?to=bb"} {
+		req, _ := http.NewRequest("GET", url, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrValidationEmptyRootHash.Error()))
+	}
+}
+
+func TestGetStateDiff_FacadeErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	stateGroup, err := groups.NewStateGroup(&mock.FacadeStub{
+		GetStateDiffCalled: func(_ string, _ string, _ string) (*common.StateDiffResponse, error) {
+			return nil, expectedErr
+		},
+	})
+	require.NoError(t, err)
+
+	ws := startWebServer(stateGroup, "state", getStateRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/state/diff?from=aa&to=bb", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetStateDiff.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetStateDiff_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedDiff := &common.StateDiffResponse{
+		FromRootHash: "aa",
+		ToRootHash:   "bb",
+		Accounts: []*common.AccountStateChangeResponse{
+			{
+				Address:    "erd1alice",
+				OldBalance: "10",
+				NewBalance: "7",
+				NewNonce:   1,
+				DataTrieChanges: []*common.DataTrieChangeResponse{
+					{Key: "6b6579", NewValue: "76616c7565"},
+				},
+			},
+		},
+		HasMore: true,
+		Cursor:  "616c696365-6b6579",
+	}
+	stateGroup, err := groups.NewStateGroup(&mock.FacadeStub{
+		GetStateDiffCalled: func(fromRootHash string, toRootHash string, cursor string) (*common.StateDiffResponse, error) {
+			assert.Equal(t, "aa", fromRootHash)
+			assert.Equal(t, "bb", toRootHash)
+			assert.Equal(t, "cc", cursor)
+			return expectedDiff, nil
+		},
+	})
+	require.NoError(t, err)
+
+	ws := startWebServer(stateGroup, "state", getStateRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/state/diff?from=aa&to=bb&cursor=cc", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := stateDiffResponse{}
+	err = json.NewDecoder(resp.Body).Decode(&response)
+	require.NoError(t, err)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, string(shared.ReturnCodeSuccess), response.Code)
+	assert.Equal(t, *expectedDiff, response.Data.Diff)
+}
+
+func getStateRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"state": {
+				Routes: []config.RouteConfig{
+					{Name: "/diff", Open: true},
+				},
+			},
+		},
+	}
+}
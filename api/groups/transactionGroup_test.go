@@ -18,6 +18,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/groups"
 	"github.com/ElrondNetwork/elrond-go/api/mock"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/config"
 	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
 	"github.com/stretchr/testify/assert"
@@ -823,6 +824,219 @@ func TestSimulateTransaction(t *testing.T) {
 	assert.Equal(t, string(shared.ReturnCodeSuccess), simulateResponse.Code)
 }
 
+type txPoolCountersResponse struct {
+	Data struct {
+		Pool common.TxPoolCountersResponse `json:"pool"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type txPoolSenderResponse struct {
+	Data struct {
+		Sender common.TxPoolSenderResponse `json:"sender"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type txPoolTransactionResponse struct {
+	Data struct {
+		Transaction common.TxPoolTransaction `json:"transaction"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+func TestGetTransactionsPoolCounters(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should err", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			GetTransactionsPoolCountersCalled: func() (*common.TxPoolCountersResponse, error) {
+				return nil, expectedErr
+			},
+		}
+
+		transactionGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/transaction/pool", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := txPoolCountersResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		counters := &common.TxPoolCountersResponse{
+			NumTxs:     3,
+			NumSenders: 2,
+			NumBytes:   300,
+			Caches: []*common.TxPoolCacheCounters{
+				{Name: "0", NumTxs: 3, NumSenders: 2, NumBytes: 300, IsEvictionEnabled: true},
+			},
+		}
+		facade := &mock.FacadeStub{
+			GetTransactionsPoolCountersCalled: func() (*common.TxPoolCountersResponse, error) {
+				return counters, nil
+			},
+		}
+
+		transactionGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/transaction/pool", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := txPoolCountersResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, *counters, response.Data.Pool)
+	})
+}
+
+func TestGetTransactionsPoolForSender(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should err", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			GetTransactionsPoolForSenderCalled: func(sender string) (*common.TxPoolSenderResponse, error) {
+				return nil, expectedErr
+			},
+		}
+
+		transactionGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/transaction/pool/by-sender/erd1alice", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := txPoolSenderResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetTransactionsPoolForSenderCalled: func(sender string) (*common.TxPoolSenderResponse, error) {
+				return &common.TxPoolSenderResponse{
+					Sender: sender,
+					Caches: []*common.TxPoolSenderCache{
+						{
+							Cache:     "0",
+							NonceGaps: []*common.TxPoolNonceGap{{FromNonce: 3, ToNonce: 4}},
+							Transactions: []*common.TxPoolTransaction{
+								{Hash: "aa", Nonce: 5},
+							},
+						},
+					},
+				}, nil
+			},
+		}
+
+		transactionGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/transaction/pool/by-sender/erd1alice", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := txPoolSenderResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "erd1alice", response.Data.Sender.Sender)
+		require.Len(t, response.Data.Sender.Caches, 1)
+		assert.Equal(t, uint64(3), response.Data.Sender.Caches[0].NonceGaps[0].FromNonce)
+		assert.Equal(t, "aa", response.Data.Sender.Caches[0].Transactions[0].Hash)
+	})
+}
+
+func TestGetTransactionFromPool(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should err", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			GetTransactionFromPoolCalled: func(hash string) (*common.TxPoolTransaction, error) {
+				return nil, expectedErr
+			},
+		}
+
+		transactionGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/transaction/pool/by-hash/aabb", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := txPoolTransactionResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetTransactionFromPoolCalled: func(hash string) (*common.TxPoolTransaction, error) {
+				return &common.TxPoolTransaction{Hash: hash, Cache: "0_1", Nonce: 7}, nil
+			},
+		}
+
+		transactionGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/transaction/pool/by-hash/aabb", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := txPoolTransactionResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "aabb", response.Data.Transaction.Hash)
+		assert.Equal(t, "0_1", response.Data.Transaction.Cache)
+		assert.Equal(t, uint64(7), response.Data.Transaction.Nonce)
+	})
+}
+
 func getTransactionRoutesConfig() config.ApiRoutesConfig {
 	return config.ApiRoutesConfig{
 		APIPackages: map[string]config.APIPackageConfig{
@@ -834,6 +1048,9 @@ func getTransactionRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/:txhash", Open: true},
 					{Name: "/:txhash/status", Open: true},
 					{Name: "/simulate", Open: true},
+					{Name: "/pool", Open: true},
+					{Name: "/pool/by-sender/:sender", Open: true},
+					{Name: "/pool/by-hash/:txhash", Open: true},
 				},
 			},
 		},
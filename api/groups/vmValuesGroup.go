@@ -139,6 +139,11 @@ func (vvg *vmValuesGroup) doExecuteQuery(context *gin.Context) (*vm.VMOutputApi,
 		return nil, "", err
 	}
 
+	command.AccountQueryOptions, err = parseAccountQueryOptions(context)
+	if err != nil {
+		return nil, "", err
+	}
+
 	vmOutputApi, err := vvg.getFacade().ExecuteSCQuery(command)
 	if err != nil {
 		return nil, "", err
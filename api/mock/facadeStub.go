@@ -13,9 +13,11 @@ import (
 	"github.com/ElrondNetwork/elrond-go/debug"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/ElrondNetwork/elrond-go/outport/events"
 	"github.com/ElrondNetwork/elrond-go/process"
 	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
 	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/storage"
 )
 
 // FacadeStub is the mock implementation of a node router handler
@@ -23,8 +25,8 @@ type FacadeStub struct {
 	ShouldErrorStart           bool
 	ShouldErrorStop            bool
 	GetHeartbeatsHandler       func() ([]data.PubKeyHeartbeat, error)
-	BalanceHandler             func(string) (*big.Int, error)
-	GetAccountHandler          func(address string) (api.AccountResponse, error)
+	BalanceHandler             func(string, common.AccountQueryOptions) (*big.Int, error)
+	GetAccountHandler          func(address string, options common.AccountQueryOptions) (api.AccountResponse, error)
 	GenerateTransactionHandler func(sender string, receiver string, value *big.Int, code string) (*transaction.Transaction, error)
 	GetTransactionHandler      func(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
 	CreateTransactionHandler   func(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64,
@@ -38,22 +40,28 @@ type FacadeStub struct {
 	ComputeTransactionGasLimitHandler       func(tx *transaction.Transaction) (*transaction.CostResponse, error)
 	NodeConfigCalled                        func() map[string]interface{}
 	GetQueryHandlerCalled                   func(name string) (debug.QueryHandler, error)
-	GetValueForKeyCalled                    func(address string, key string) (string, error)
+	GetValueForKeyCalled                    func(address string, key string, options common.AccountQueryOptions) (string, error)
 	GetPeerInfoCalled                       func(pid string) ([]core.QueryP2PPeerInfo, error)
+	GetStorageStatisticsCalled              func(computeDiskUsage bool) ([]storage.UnitStatistics, error)
 	GetThrottlerForEndpointCalled           func(endpoint string) (core.Throttler, bool)
-	GetUsernameCalled                       func(address string) (string, error)
-	GetKeyValuePairsCalled                  func(address string) (map[string]string, error)
+	GetUsernameCalled                       func(address string, options common.AccountQueryOptions) (string, error)
+	GetKeyValuePairsCalled                  func(address string, options common.AccountQueryOptions) (map[string]string, error)
 	SimulateTransactionExecutionHandler     func(tx *transaction.Transaction) (*txSimData.SimulationResults, error)
 	GetNumCheckpointsFromAccountStateCalled func() uint32
 	GetNumCheckpointsFromPeerStateCalled    func() uint32
-	GetESDTDataCalled                       func(address string, key string, nonce uint64) (*esdt.ESDigitalToken, error)
-	GetAllESDTTokensCalled                  func(address string) (map[string]*esdt.ESDigitalToken, error)
-	GetESDTsWithRoleCalled                  func(address string, role string) ([]string, error)
-	GetESDTsRolesCalled                     func(address string) (map[string][]string, error)
-	GetNFTTokenIDsRegisteredByAddressCalled func(address string) ([]string, error)
+	GetESDTDataCalled                       func(address string, key string, nonce uint64, options common.AccountQueryOptions) (*esdt.ESDigitalToken, error)
+	GetAllESDTTokensCalled                  func(address string, options common.AccountQueryOptions) (map[string]*esdt.ESDigitalToken, error)
+	GetESDTsWithRoleCalled                  func(address string, role string, options common.AccountQueryOptions) ([]string, error)
+	GetESDTsRolesCalled                     func(address string, options common.AccountQueryOptions) (map[string][]string, error)
+	GetNFTTokenIDsRegisteredByAddressCalled func(address string, options common.AccountQueryOptions) ([]string, error)
+	GetAccountTransactionsCalled            func(address string, cursor uint64, limit int) (*common.AccountTransactionsResponse, error)
 	GetBlockByHashCalled                    func(hash string, withTxs bool) (*api.Block, error)
 	GetBlockByNonceCalled                   func(nonce uint64, withTxs bool) (*api.Block, error)
 	GetBlockByRoundCalled                   func(round uint64, withTxs bool) (*api.Block, error)
+	GetBlocksByNonceRangeCalled             func(fromNonce uint64, toNonce uint64, options common.BlocksQueryOptions) (*common.BlocksRangeResponse, error)
+	GetBlocksByEpochCalled                  func(epoch uint32, fromNonce uint64, options common.BlocksQueryOptions) (*common.BlocksRangeResponse, error)
+	GetBlockStateChangesByNonceCalled       func(nonce uint64) (*common.BlockStateChangesResponse, error)
+	GetStateDiffCalled                      func(fromRootHash string, toRootHash string, cursor string) (*common.StateDiffResponse, error)
 	GetTotalStakedValueHandler              func() (*api.StakeValues, error)
 	GetAllIssuedESDTsCalled                 func(tokenType string) ([]string, error)
 	GetDirectStakedListHandler              func() ([]*api.DirectStakedValue, error)
@@ -61,8 +69,15 @@ type FacadeStub struct {
 	GetProofCalled                          func(string, string) (*common.GetProofResponse, error)
 	GetProofCurrentRootHashCalled           func(string) (*common.GetProofResponse, error)
 	GetProofDataTrieCalled                  func(string, string, string) (*common.GetProofResponse, *common.GetProofResponse, error)
+	GetProofDataTrieKeysCalled              func(string, string, []string) (*common.GetProofResponse, *common.GetMultiProofResponse, error)
+	GetRangeProofDataTrieCalled             func(string, string, string) (*common.GetProofResponse, *common.GetMultiProofResponse, error)
 	VerifyProofCalled                       func(string, string, [][]byte) (bool, error)
 	GetTokenSupplyCalled                    func(token string) (*api.ESDTSupply, error)
+	GetTransactionsPoolCountersCalled       func() (*common.TxPoolCountersResponse, error)
+	GetTransactionsPoolForSenderCalled      func(sender string) (*common.TxPoolSenderResponse, error)
+	GetTransactionFromPoolCalled            func(hash string) (*common.TxPoolTransaction, error)
+	SubscribeToEventsCalled                 func(filter events.SubscriptionFilter) (*events.Subscription, error)
+	UnsubscribeFromEventsCalled             func(subscription *events.Subscription)
 }
 
 // GetTokenSupply -
@@ -101,6 +116,24 @@ func (f *FacadeStub) GetProofDataTrie(rootHash string, address string, key strin
 	return nil, nil, nil
 }
 
+// GetProofDataTrieKeys -
+func (f *FacadeStub) GetProofDataTrieKeys(rootHash string, address string, keys []string) (*common.GetProofResponse, *common.GetMultiProofResponse, error) {
+	if f.GetProofDataTrieKeysCalled != nil {
+		return f.GetProofDataTrieKeysCalled(rootHash, address, keys)
+	}
+
+	return nil, nil, nil
+}
+
+// GetRangeProofDataTrie -
+func (f *FacadeStub) GetRangeProofDataTrie(rootHash string, address string, keySuffix string) (*common.GetProofResponse, *common.GetMultiProofResponse, error) {
+	if f.GetRangeProofDataTrieCalled != nil {
+		return f.GetRangeProofDataTrieCalled(rootHash, address, keySuffix)
+	}
+
+	return nil, nil, nil
+}
+
 // VerifyProof -
 func (f *FacadeStub) VerifyProof(rootHash string, address string, proof [][]byte) (bool, error) {
 	if f.VerifyProofCalled != nil {
@@ -111,9 +144,9 @@ func (f *FacadeStub) VerifyProof(rootHash string, address string, proof [][]byte
 }
 
 // GetUsername -
-func (f *FacadeStub) GetUsername(address string) (string, error) {
+func (f *FacadeStub) GetUsername(address string, options common.AccountQueryOptions) (string, error) {
 	if f.GetUsernameCalled != nil {
-		return f.GetUsernameCalled(address)
+		return f.GetUsernameCalled(address, options)
 	}
 
 	return "", nil
@@ -149,68 +182,77 @@ func (f *FacadeStub) GetHeartbeats() ([]data.PubKeyHeartbeat, error) {
 }
 
 // GetBalance is the mock implementation of a handler's GetBalance method
-func (f *FacadeStub) GetBalance(address string) (*big.Int, error) {
-	return f.BalanceHandler(address)
+func (f *FacadeStub) GetBalance(address string, options common.AccountQueryOptions) (*big.Int, error) {
+	return f.BalanceHandler(address, options)
 }
 
 // GetValueForKey is the mock implementation of a handler's GetValueForKey method
-func (f *FacadeStub) GetValueForKey(address string, key string) (string, error) {
+func (f *FacadeStub) GetValueForKey(address string, key string, options common.AccountQueryOptions) (string, error) {
 	if f.GetValueForKeyCalled != nil {
-		return f.GetValueForKeyCalled(address, key)
+		return f.GetValueForKeyCalled(address, key, options)
 	}
 
 	return "", nil
 }
 
 // GetKeyValuePairs -
-func (f *FacadeStub) GetKeyValuePairs(address string) (map[string]string, error) {
+func (f *FacadeStub) GetKeyValuePairs(address string, options common.AccountQueryOptions) (map[string]string, error) {
 	if f.GetKeyValuePairsCalled != nil {
-		return f.GetKeyValuePairsCalled(address)
+		return f.GetKeyValuePairsCalled(address, options)
 	}
 
 	return nil, nil
 }
 
 // GetESDTData -
-func (f *FacadeStub) GetESDTData(address string, key string, nonce uint64) (*esdt.ESDigitalToken, error) {
+func (f *FacadeStub) GetESDTData(address string, key string, nonce uint64, options common.AccountQueryOptions) (*esdt.ESDigitalToken, error) {
 	if f.GetESDTDataCalled != nil {
-		return f.GetESDTDataCalled(address, key, nonce)
+		return f.GetESDTDataCalled(address, key, nonce, options)
 	}
 
 	return &esdt.ESDigitalToken{Value: big.NewInt(0)}, nil
 }
 
 // GetESDTsRoles -
-func (f *FacadeStub) GetESDTsRoles(address string) (map[string][]string, error) {
+func (f *FacadeStub) GetESDTsRoles(address string, options common.AccountQueryOptions) (map[string][]string, error) {
 	if f.GetESDTsRolesCalled != nil {
-		return f.GetESDTsRolesCalled(address)
+		return f.GetESDTsRolesCalled(address, options)
 	}
 
 	return map[string][]string{}, nil
 }
 
 // GetAllESDTTokens -
-func (f *FacadeStub) GetAllESDTTokens(address string) (map[string]*esdt.ESDigitalToken, error) {
+func (f *FacadeStub) GetAllESDTTokens(address string, options common.AccountQueryOptions) (map[string]*esdt.ESDigitalToken, error) {
 	if f.GetAllESDTTokensCalled != nil {
-		return f.GetAllESDTTokensCalled(address)
+		return f.GetAllESDTTokensCalled(address, options)
 	}
 
 	return make(map[string]*esdt.ESDigitalToken), nil
 }
 
 // GetNFTTokenIDsRegisteredByAddress -
-func (f *FacadeStub) GetNFTTokenIDsRegisteredByAddress(address string) ([]string, error) {
+func (f *FacadeStub) GetNFTTokenIDsRegisteredByAddress(address string, options common.AccountQueryOptions) ([]string, error) {
 	if f.GetNFTTokenIDsRegisteredByAddressCalled != nil {
-		return f.GetNFTTokenIDsRegisteredByAddressCalled(address)
+		return f.GetNFTTokenIDsRegisteredByAddressCalled(address, options)
 	}
 
 	return make([]string, 0), nil
 }
 
+// GetAccountTransactions -
+func (f *FacadeStub) GetAccountTransactions(address string, cursor uint64, limit int) (*common.AccountTransactionsResponse, error) {
+	if f.GetAccountTransactionsCalled != nil {
+		return f.GetAccountTransactionsCalled(address, cursor, limit)
+	}
+
+	return &common.AccountTransactionsResponse{}, nil
+}
+
 // GetESDTsWithRole -
-func (f *FacadeStub) GetESDTsWithRole(address string, role string) ([]string, error) {
+func (f *FacadeStub) GetESDTsWithRole(address string, role string, options common.AccountQueryOptions) ([]string, error) {
 	if f.GetESDTsWithRoleCalled != nil {
-		return f.GetESDTsWithRoleCalled(address, role)
+		return f.GetESDTsWithRoleCalled(address, role, options)
 	}
 
 	return make([]string, 0), nil
@@ -226,8 +268,8 @@ func (f *FacadeStub) GetAllIssuedESDTs(tokenType string) ([]string, error) {
 }
 
 // GetAccount -
-func (f *FacadeStub) GetAccount(address string) (api.AccountResponse, error) {
-	return f.GetAccountHandler(address)
+func (f *FacadeStub) GetAccount(address string, options common.AccountQueryOptions) (api.AccountResponse, error) {
+	return f.GetAccountHandler(address, options)
 }
 
 // CreateTransaction is  mock implementation of a handler's CreateTransaction method
@@ -334,6 +376,15 @@ func (f *FacadeStub) GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error) {
 	return f.GetPeerInfoCalled(pid)
 }
 
+// GetStorageStatistics -
+func (f *FacadeStub) GetStorageStatistics(computeDiskUsage bool) ([]storage.UnitStatistics, error) {
+	if f.GetStorageStatisticsCalled != nil {
+		return f.GetStorageStatisticsCalled(computeDiskUsage)
+	}
+
+	return make([]storage.UnitStatistics, 0), nil
+}
+
 // GetNumCheckpointsFromAccountState -
 func (f *FacadeStub) GetNumCheckpointsFromAccountState() uint32 {
 	if f.GetNumCheckpointsFromAccountStateCalled != nil {
@@ -370,6 +421,38 @@ func (f *FacadeStub) GetBlockByRound(round uint64, withTxs bool) (*api.Block, er
 	return nil, nil
 }
 
+// GetBlocksByNonceRange -
+func (f *FacadeStub) GetBlocksByNonceRange(fromNonce uint64, toNonce uint64, options common.BlocksQueryOptions) (*common.BlocksRangeResponse, error) {
+	if f.GetBlocksByNonceRangeCalled != nil {
+		return f.GetBlocksByNonceRangeCalled(fromNonce, toNonce, options)
+	}
+	return nil, nil
+}
+
+// GetBlocksByEpoch -
+func (f *FacadeStub) GetBlocksByEpoch(epoch uint32, fromNonce uint64, options common.BlocksQueryOptions) (*common.BlocksRangeResponse, error) {
+	if f.GetBlocksByEpochCalled != nil {
+		return f.GetBlocksByEpochCalled(epoch, fromNonce, options)
+	}
+	return nil, nil
+}
+
+// GetBlockStateChangesByNonce -
+func (f *FacadeStub) GetBlockStateChangesByNonce(nonce uint64) (*common.BlockStateChangesResponse, error) {
+	if f.GetBlockStateChangesByNonceCalled != nil {
+		return f.GetBlockStateChangesByNonceCalled(nonce)
+	}
+	return nil, nil
+}
+
+// GetStateDiff -
+func (f *FacadeStub) GetStateDiff(fromRootHash string, toRootHash string, cursor string) (*common.StateDiffResponse, error) {
+	if f.GetStateDiffCalled != nil {
+		return f.GetStateDiffCalled(fromRootHash, toRootHash, cursor)
+	}
+	return nil, nil
+}
+
 // Trigger -
 func (f *FacadeStub) Trigger(_ uint32, _ bool) error {
 	return nil
@@ -385,6 +468,45 @@ func (f *FacadeStub) Close() error {
 	return nil
 }
 
+// GetTransactionsPoolCounters -
+func (f *FacadeStub) GetTransactionsPoolCounters() (*common.TxPoolCountersResponse, error) {
+	if f.GetTransactionsPoolCountersCalled != nil {
+		return f.GetTransactionsPoolCountersCalled()
+	}
+	return nil, nil
+}
+
+// GetTransactionsPoolForSender -
+func (f *FacadeStub) GetTransactionsPoolForSender(sender string) (*common.TxPoolSenderResponse, error) {
+	if f.GetTransactionsPoolForSenderCalled != nil {
+		return f.GetTransactionsPoolForSenderCalled(sender)
+	}
+	return nil, nil
+}
+
+// GetTransactionFromPool -
+func (f *FacadeStub) GetTransactionFromPool(hash string) (*common.TxPoolTransaction, error) {
+	if f.GetTransactionFromPoolCalled != nil {
+		return f.GetTransactionFromPoolCalled(hash)
+	}
+	return nil, nil
+}
+
+// SubscribeToEvents -
+func (f *FacadeStub) SubscribeToEvents(filter events.SubscriptionFilter) (*events.Subscription, error) {
+	if f.SubscribeToEventsCalled != nil {
+		return f.SubscribeToEventsCalled(filter)
+	}
+	return nil, nil
+}
+
+// UnsubscribeFromEvents -
+func (f *FacadeStub) UnsubscribeFromEvents(subscription *events.Subscription) {
+	if f.UnsubscribeFromEventsCalled != nil {
+		f.UnsubscribeFromEventsCalled(subscription)
+	}
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (f *FacadeStub) IsInterfaceNil() bool {
 	return f == nil
@@ -1,6 +1,7 @@
 package shared
 
 import (
+	"encoding/json"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
@@ -38,6 +39,19 @@ type GenericAPIResponse struct {
 	Code  ReturnCode  `json:"code"`
 }
 
+// BatchSubRequest defines one of the API calls bundled in a batch request
+type BatchSubRequest struct {
+	Method string          `json:"method"`
+	Path   string          `json:"path"`
+	Body   json.RawMessage `json:"body,omitempty"`
+}
+
+// BatchSubResponse holds the status code and the response of an API call bundled in a batch request
+type BatchSubResponse struct {
+	StatusCode int             `json:"statusCode"`
+	Response   json.RawMessage `json:"response"`
+}
+
 // ReturnCode defines the type defines to identify return codes
 type ReturnCode string
 
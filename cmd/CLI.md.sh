@@ -6,6 +6,8 @@ generate() {
     generateForTermUi
     generateForLogViewer
     generateForSeedNode
+    generateForOutportReplay
+    generateForDbTool
 }
 
 generateForNode() {
@@ -78,6 +80,34 @@ $(code)
     echo "$HELP" > ./seednode/CLI.md
 }
 
+generateForOutportReplay() {
+    HELP="
+# Elrond Outport Replay Tool CLI
+
+The **Elrond Outport Replay Tool** exposes the following Command Line Interface:
+$(code)
+\$ outportreplay --help
+
+$(./outportreplay/outportreplay --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./outportreplay/CLI.md
+}
+
+generateForDbTool() {
+    HELP="
+# Elrond Database Tool CLI
+
+The **Elrond Database Tool** exposes the following Command Line Interface:
+$(code)
+\$ dbtool --help
+
+$(./dbtool/dbtool --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./dbtool/CLI.md
+}
+
 code() {
     printf "\n\`\`\`\n"
 }
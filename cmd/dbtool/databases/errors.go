@@ -0,0 +1,45 @@
+package databases
+
+import "errors"
+
+// ErrNilHasher signals that a nil hasher has been provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrEmptyDatabasePath signals that an empty databases path has been provided
+var ErrEmptyDatabasePath = errors.New("empty databases path")
+
+// ErrNoUnits signals that no storage units have been provided
+var ErrNoUnits = errors.New("no storage units provided")
+
+// ErrInvalidTargetDBType signals that the provided target DB type can not be used for migration
+var ErrInvalidTargetDBType = errors.New("invalid target DB type")
+
+// ErrDestinationNotEmpty signals that the migration destination already holds data
+var ErrDestinationNotEmpty = errors.New("destination directory is not empty")
+
+// ErrSameSourceAndDestination signals that the migration source and destination are the same
+var ErrSameSourceAndDestination = errors.New("source and destination directories are the same")
+
+// ErrCountMismatch signals that the number of entries in the migrated database differs from the source one
+var ErrCountMismatch = errors.New("number of entries mismatch")
+
+// ErrSampleHashMismatch signals that a sampled entry from the migrated database differs from the source one
+var ErrSampleHashMismatch = errors.New("sampled entry hash mismatch")
+
+// ErrBootstrapUnitNotFound signals that the bootstrap unit was not found in the provided units
+var ErrBootstrapUnitNotFound = errors.New("bootstrap unit not found")
+
+// ErrAccountsTrieUnitNotFound signals that no accounts trie unit was found in the provided units
+var ErrAccountsTrieUnitNotFound = errors.New("accounts trie unit not found")
+
+// ErrNilPubKeyConverter signals that a nil public key converter has been provided
+var ErrNilPubKeyConverter = errors.New("nil public key converter")
+
+// ErrEmptyAddressEncoding signals that an empty address encoding name has been provided
+var ErrEmptyAddressEncoding = errors.New("empty address encoding")
+
+// ErrInvalidExportFormat signals that the provided state export format is not supported
+var ErrInvalidExportFormat = errors.New("invalid export format")
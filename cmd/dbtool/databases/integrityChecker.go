@@ -0,0 +1,222 @@
+package databases
+
+import (
+	"strconv"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/process/block/bootstrapStorage"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+)
+
+// ArgsIntegrityChecker holds the arguments needed for creating a new integrity checker
+type ArgsIntegrityChecker struct {
+	DbPath        string
+	BootstrapDB   config.DBConfig
+	BlockHeaderDB config.DBConfig
+	MetaBlockDB   config.DBConfig
+	Marshalizer   marshal.Marshalizer
+}
+
+// MissingHeader holds the information about a header referenced by the bootstrap unit that is missing from storage
+type MissingHeader struct {
+	ShardID       uint32
+	Epoch         uint32
+	Nonce         uint64
+	Hash          []byte
+	BootstrapPath string
+}
+
+// IntegrityReport holds the results of an integrity check
+type IntegrityReport struct {
+	NumBootstrapUnits   int
+	NumBootstrapEntries int
+	NumCheckedHeaders   int
+	NumSkippedHeaders   int
+	MissingHeaders      []MissingHeader
+}
+
+type integrityChecker struct {
+	bootstrapDB   config.DBConfig
+	blockHeaderDB config.DBConfig
+	metaBlockDB   config.DBConfig
+	marshalizer   marshal.Marshalizer
+	pathManager   storage.PathManagerHandler
+	persisters    map[string]storage.Persister
+}
+
+// NewIntegrityChecker creates a component able to verify that every header referenced by the bootstrap
+// units of a node's databases directory exists in the headers storage units
+func NewIntegrityChecker(args ArgsIntegrityChecker) (*integrityChecker, error) {
+	if len(args.DbPath) == 0 {
+		return nil, ErrEmptyDatabasePath
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+
+	pathManager, err := storageFactory.CreatePathManagerFromSinglePathString(args.DbPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return &integrityChecker{
+		bootstrapDB:   args.BootstrapDB,
+		blockHeaderDB: args.BlockHeaderDB,
+		metaBlockDB:   args.MetaBlockDB,
+		marshalizer:   args.Marshalizer,
+		pathManager:   pathManager,
+	}, nil
+}
+
+// Check verifies the bootstrap units found in the provided locations. Headers stored in epochs no longer
+// available on disk (e.g. removed by the pruning mechanism) are counted as skipped
+func (ic *integrityChecker) Check(locations []UnitLocation) (*IntegrityReport, error) {
+	ic.persisters = make(map[string]storage.Persister)
+	defer ic.closePersisters()
+
+	report := &IntegrityReport{
+		MissingHeaders: make([]MissingHeader, 0),
+	}
+	for _, location := range locations {
+		if location.Unit.DB.FilePath != ic.bootstrapDB.FilePath {
+			continue
+		}
+
+		err := ic.checkBootstrapUnit(location, report)
+		if err != nil {
+			return nil, err
+		}
+		report.NumBootstrapUnits++
+	}
+
+	if report.NumBootstrapUnits == 0 {
+		return nil, ErrBootstrapUnitNotFound
+	}
+
+	return report, nil
+}
+
+func (ic *integrityChecker) checkBootstrapUnit(location UnitLocation, report *IntegrityReport) error {
+	persister, err := ic.getPersister(ic.bootstrapDB, location.Path)
+	if err != nil {
+		return err
+	}
+
+	checkedHashes := make(map[string]struct{})
+	var errUnmarshal error
+	persister.RangeKeys(func(key []byte, val []byte) bool {
+		_, errParse := strconv.ParseInt(string(key), 10, 64)
+		if errParse != nil {
+			// not a round key (e.g. the highest round or the epoch start trigger keys)
+			return true
+		}
+
+		bootstrapData := &bootstrapStorage.BootstrapData{}
+		errUnmarshal = ic.marshalizer.Unmarshal(bootstrapData, val)
+		if errUnmarshal != nil {
+			return false
+		}
+		report.NumBootstrapEntries++
+
+		for _, headerInfo := range getReferencedHeaders(bootstrapData) {
+			_, checked := checkedHashes[string(headerInfo.Hash)]
+			if checked {
+				continue
+			}
+			checkedHashes[string(headerInfo.Hash)] = struct{}{}
+
+			ic.checkHeader(location, headerInfo, report)
+		}
+
+		return true
+	})
+
+	return errUnmarshal
+}
+
+func getReferencedHeaders(bootstrapData *bootstrapStorage.BootstrapData) []bootstrapStorage.BootstrapHeaderInfo {
+	headers := make([]bootstrapStorage.BootstrapHeaderInfo, 0, 1+len(bootstrapData.LastCrossNotarizedHeaders)+len(bootstrapData.LastSelfNotarizedHeaders))
+	headers = append(headers, bootstrapData.LastHeader)
+	headers = append(headers, bootstrapData.LastCrossNotarizedHeaders...)
+	headers = append(headers, bootstrapData.LastSelfNotarizedHeaders...)
+
+	return headers
+}
+
+func (ic *integrityChecker) checkHeader(location UnitLocation, headerInfo bootstrapStorage.BootstrapHeaderInfo, report *IntegrityReport) {
+	if len(headerInfo.Hash) == 0 {
+		return
+	}
+
+	headersDB := ic.blockHeaderDB
+	if headerInfo.ShardId == core.MetachainShardId {
+		headersDB = ic.metaBlockDB
+	}
+
+	// a header can be saved in the next epoch's storer if it was committed after the epoch change
+	foundStorer := false
+	for _, epoch := range []uint32{headerInfo.Epoch, headerInfo.Epoch + 1} {
+		path := ic.pathManager.PathForEpoch(location.ShardID, epoch, headersDB.FilePath)
+		if !isDatabaseDirectory(path) {
+			continue
+		}
+		foundStorer = true
+
+		persister, err := ic.getPersister(headersDB, path)
+		if err != nil {
+			log.Warn("can not open headers unit", "path", path, "error", err)
+			continue
+		}
+
+		if persister.Has(headerInfo.Hash) == nil {
+			report.NumCheckedHeaders++
+			return
+		}
+	}
+
+	if !foundStorer {
+		report.NumSkippedHeaders++
+		return
+	}
+
+	report.NumCheckedHeaders++
+	report.MissingHeaders = append(report.MissingHeaders, MissingHeader{
+		ShardID:       headerInfo.ShardId,
+		Epoch:         headerInfo.Epoch,
+		Nonce:         headerInfo.Nonce,
+		Hash:          headerInfo.Hash,
+		BootstrapPath: location.Path,
+	})
+}
+
+func (ic *integrityChecker) getPersister(dbConfig config.DBConfig, path string) (storage.Persister, error) {
+	persister, found := ic.persisters[path]
+	if found {
+		return persister, nil
+	}
+
+	persister, err := openPersister(dbConfig, storageUnit.DBType(dbConfig.Type), path)
+	if err != nil {
+		return nil, err
+	}
+	ic.persisters[path] = persister
+
+	return persister, nil
+}
+
+func (ic *integrityChecker) closePersisters() {
+	for path, persister := range ic.persisters {
+		log.LogIfError(persister.Close(), "path", path)
+	}
+	ic.persisters = nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ic *integrityChecker) IsInterfaceNil() bool {
+	return ic == nil
+}
@@ -0,0 +1,141 @@
+package databases
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/process/block/bootstrapStorage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsIntegrityChecker(dbPath string) ArgsIntegrityChecker {
+	units := createTestUnits()
+	return ArgsIntegrityChecker{
+		DbPath:        dbPath,
+		BootstrapDB:   units[0].DB,
+		BlockHeaderDB: units[1].DB,
+		MetaBlockDB:   units[2].DB,
+		Marshalizer:   &marshal.GogoProtoMarshalizer{},
+	}
+}
+
+func TestNewIntegrityChecker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty db path should error", func(t *testing.T) {
+		t.Parallel()
+
+		ic, err := NewIntegrityChecker(createMockArgsIntegrityChecker(""))
+		assert.Nil(t, ic)
+		assert.Equal(t, ErrEmptyDatabasePath, err)
+	})
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsIntegrityChecker(t.TempDir())
+		args.Marshalizer = nil
+		ic, err := NewIntegrityChecker(args)
+		assert.Nil(t, ic)
+		assert.Equal(t, ErrNilMarshalizer, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		ic, err := NewIntegrityChecker(createMockArgsIntegrityChecker(t.TempDir()))
+		assert.Nil(t, err)
+		assert.False(t, ic.IsInterfaceNil())
+	})
+}
+
+func TestIntegrityChecker_CheckWithoutBootstrapUnitShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ic, _ := NewIntegrityChecker(createMockArgsIntegrityChecker(t.TempDir()))
+	report, err := ic.Check(make([]UnitLocation, 0))
+	assert.Nil(t, report)
+	assert.Equal(t, ErrBootstrapUnitNotFound, err)
+}
+
+func TestIntegrityChecker_Check(t *testing.T) {
+	t.Parallel()
+
+	dbPath := t.TempDir()
+	units := createTestUnits()
+	marshalizer := &marshal.GogoProtoMarshalizer{}
+
+	bootstrapData := &bootstrapStorage.BootstrapData{
+		LastHeader: bootstrapStorage.BootstrapHeaderInfo{ShardId: 0, Epoch: 3, Nonce: 30, Hash: []byte("shard hash")},
+		LastCrossNotarizedHeaders: []bootstrapStorage.BootstrapHeaderInfo{
+			{ShardId: core.MetachainShardId, Epoch: 3, Nonce: 31, Hash: []byte("meta hash in next epoch")},
+			{ShardId: core.MetachainShardId, Epoch: 3, Nonce: 32, Hash: []byte("missing meta hash")},
+		},
+		LastSelfNotarizedHeaders: []bootstrapStorage.BootstrapHeaderInfo{
+			{ShardId: 0, Epoch: 1, Nonce: 10, Hash: []byte("pruned epoch hash")},
+			{ShardId: 0, Epoch: 3, Nonce: 30, Hash: []byte("shard hash")},
+		},
+		LastRound: 100,
+	}
+	bootstrapDataBytes, err := marshalizer.Marshal(bootstrapData)
+	require.Nil(t, err)
+
+	createTestUnit(t, units[0].DB, epochUnitPath(dbPath, 4, "0", units[0].DB.FilePath), map[string][]byte{
+		"100":                              bootstrapDataBytes,
+		common.HighestRoundFromBootStorage: []byte("not a bootstrap data"),
+	})
+	createTestUnit(t, units[1].DB, epochUnitPath(dbPath, 3, "0", units[1].DB.FilePath), map[string][]byte{
+		"shard hash": []byte("header"),
+	})
+	createTestUnit(t, units[2].DB, epochUnitPath(dbPath, 3, "0", units[2].DB.FilePath), map[string][]byte{
+		"other meta hash": []byte("header"),
+	})
+	createTestUnit(t, units[2].DB, epochUnitPath(dbPath, 4, "0", units[2].DB.FilePath), map[string][]byte{
+		"meta hash in next epoch": []byte("header"),
+	})
+
+	ud, _ := NewUnitsDiscoverer(createMockArgsUnitsDiscoverer(dbPath))
+	locations, err := ud.Discover()
+	require.Nil(t, err)
+
+	ic, _ := NewIntegrityChecker(createMockArgsIntegrityChecker(dbPath))
+	report, err := ic.Check(locations)
+	require.Nil(t, err)
+
+	expectedReport := &IntegrityReport{
+		NumBootstrapUnits:   1,
+		NumBootstrapEntries: 1,
+		NumCheckedHeaders:   3,
+		NumSkippedHeaders:   1,
+		MissingHeaders: []MissingHeader{
+			{
+				ShardID:       core.MetachainShardId,
+				Epoch:         3,
+				Nonce:         32,
+				Hash:          []byte("missing meta hash"),
+				BootstrapPath: epochUnitPath(dbPath, 4, "0", units[0].DB.FilePath),
+			},
+		},
+	}
+	assert.Equal(t, expectedReport, report)
+}
+
+func TestIntegrityChecker_CheckInvalidBootstrapDataShouldErr(t *testing.T) {
+	t.Parallel()
+
+	dbPath := t.TempDir()
+	units := createTestUnits()
+	createTestUnit(t, units[0].DB, epochUnitPath(dbPath, 0, "0", units[0].DB.FilePath), map[string][]byte{
+		"100": []byte("invalid bootstrap data"),
+	})
+
+	ud, _ := NewUnitsDiscoverer(createMockArgsUnitsDiscoverer(dbPath))
+	locations, err := ud.Discover()
+	require.Nil(t, err)
+
+	ic, _ := NewIntegrityChecker(createMockArgsIntegrityChecker(dbPath))
+	report, err := ic.Check(locations)
+	assert.NotNil(t, err)
+	assert.Nil(t, report)
+}
@@ -0,0 +1,290 @@
+package databases
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"path/filepath"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+)
+
+var log = logger.GetOrCreate("dbtool/databases")
+
+// ArgsMigrator holds the arguments needed for creating a new migrator
+type ArgsMigrator struct {
+	SourceDbPath      string
+	DestinationDbPath string
+	TargetDBType      string
+	Hasher            hashing.Hasher
+	NumVerifySamples  int
+}
+
+// MigrationStatistics holds the results of a migration
+type MigrationStatistics struct {
+	NumUnits   int
+	NumEntries uint64
+	Duration   time.Duration
+}
+
+type entrySample struct {
+	key  []byte
+	hash []byte
+}
+
+type migrator struct {
+	destinationDbPath      string
+	targetDBType           storageUnit.DBType
+	hasher                 hashing.Hasher
+	numVerifySamples       int
+	destinationPathManager storage.PathManagerHandler
+	randomizer             *rand.Rand
+}
+
+// NewMigrator creates a component able to copy the storage units of a node's databases directory
+// into a new directory, using the provided target DB type. Using the same DB type as the source one
+// results in an offline compaction of the databases
+func NewMigrator(args ArgsMigrator) (*migrator, error) {
+	err := checkArgsMigrator(args)
+	if err != nil {
+		return nil, err
+	}
+
+	destinationPathManager, err := storageFactory.CreatePathManagerFromSinglePathString(args.DestinationDbPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return &migrator{
+		destinationDbPath:      args.DestinationDbPath,
+		targetDBType:           storageUnit.DBType(args.TargetDBType),
+		hasher:                 args.Hasher,
+		numVerifySamples:       args.NumVerifySamples,
+		destinationPathManager: destinationPathManager,
+		randomizer:             rand.New(rand.NewSource(time.Now().UnixNano())),
+	}, nil
+}
+
+func checkArgsMigrator(args ArgsMigrator) error {
+	if len(args.SourceDbPath) == 0 || len(args.DestinationDbPath) == 0 {
+		return ErrEmptyDatabasePath
+	}
+	if check.IfNil(args.Hasher) {
+		return ErrNilHasher
+	}
+
+	switch storageUnit.DBType(args.TargetDBType) {
+	case storageUnit.LvlDB, storageUnit.LvlDBSerial, storageUnit.PebbleDB:
+	default:
+		return fmt.Errorf("%w: %s", ErrInvalidTargetDBType, args.TargetDBType)
+	}
+
+	sourcePath, err := filepath.Abs(args.SourceDbPath)
+	if err != nil {
+		return err
+	}
+	destinationPath, err := filepath.Abs(args.DestinationDbPath)
+	if err != nil {
+		return err
+	}
+	if sourcePath == destinationPath {
+		return ErrSameSourceAndDestination
+	}
+
+	if !directoryExists(destinationPath) {
+		return nil
+	}
+	files, err := ioutil.ReadDir(destinationPath)
+	if err != nil {
+		return err
+	}
+	if len(files) > 0 {
+		return fmt.Errorf("%w: %s", ErrDestinationNotEmpty, destinationPath)
+	}
+
+	return nil
+}
+
+// Migrate copies all the provided storage units into the destination directory, verifying each migrated unit
+// by comparing the number of entries and the hashes of a random sample of entries
+func (m *migrator) Migrate(locations []UnitLocation) (*MigrationStatistics, error) {
+	start := time.Now()
+	statistics := &MigrationStatistics{}
+	for _, location := range locations {
+		numEntries, err := m.migrateUnit(location)
+		if err != nil {
+			return statistics, fmt.Errorf("%w while migrating %s", err, location.Path)
+		}
+
+		statistics.NumUnits++
+		statistics.NumEntries += numEntries
+	}
+	statistics.Duration = time.Since(start)
+
+	return statistics, nil
+}
+
+func (m *migrator) migrateUnit(location UnitLocation) (uint64, error) {
+	destinationPath := m.DestinationPath(location)
+	log.Info("migrating unit",
+		"unit", location.Unit.Name,
+		"epoch", epochForLogging(location),
+		"shard", location.ShardID,
+		"source type", location.Unit.DB.Type,
+		"destination type", m.targetDBType,
+	)
+
+	source, err := openPersister(location.Unit.DB, storageUnit.DBType(location.Unit.DB.Type), location.Path)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		log.LogIfError(source.Close(), "path", location.Path)
+	}()
+
+	numEntries, samples, err := m.copyEntries(source, location.Unit.DB, destinationPath)
+	if err != nil {
+		return 0, err
+	}
+
+	err = m.verifyUnit(location.Unit.DB, destinationPath, numEntries, samples)
+	if err != nil {
+		return 0, err
+	}
+
+	log.Debug("unit migrated", "path", destinationPath, "entries", numEntries, "verified samples", len(samples))
+
+	return numEntries, nil
+}
+
+func (m *migrator) copyEntries(
+	source storage.Persister,
+	dbConfig config.DBConfig,
+	destinationPath string,
+) (uint64, []entrySample, error) {
+	destination, err := openPersister(dbConfig, m.targetDBType, destinationPath)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	numEntries := uint64(0)
+	samples := make([]entrySample, 0, m.numVerifySamples)
+	var errPut error
+	source.RangeKeys(func(key []byte, val []byte) bool {
+		errPut = destination.Put(key, val)
+		if errPut != nil {
+			return false
+		}
+
+		numEntries++
+		samples = m.addSample(samples, numEntries, key, val)
+
+		return true
+	})
+
+	// closing the destination persister writes the last batch
+	errClose := destination.Close()
+	if errPut != nil {
+		return 0, nil, errPut
+	}
+	if errClose != nil {
+		return 0, nil, errClose
+	}
+
+	return numEntries, samples, nil
+}
+
+// addSample keeps a uniformly distributed random sample of the iterated entries (reservoir sampling)
+func (m *migrator) addSample(samples []entrySample, numEntries uint64, key []byte, val []byte) []entrySample {
+	if m.numVerifySamples <= 0 {
+		return samples
+	}
+
+	sample := entrySample{
+		key:  key,
+		hash: m.hasher.Compute(string(val)),
+	}
+	if len(samples) < m.numVerifySamples {
+		return append(samples, sample)
+	}
+
+	index := m.randomizer.Int63n(int64(numEntries))
+	if index < int64(m.numVerifySamples) {
+		samples[index] = sample
+	}
+
+	return samples
+}
+
+func (m *migrator) verifyUnit(dbConfig config.DBConfig, destinationPath string, numEntries uint64, samples []entrySample) error {
+	destination, err := openPersister(dbConfig, m.targetDBType, destinationPath)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		log.LogIfError(destination.Close(), "path", destinationPath)
+	}()
+
+	numMigratedEntries := uint64(0)
+	destination.RangeKeys(func(_ []byte, _ []byte) bool {
+		numMigratedEntries++
+		return true
+	})
+	if numMigratedEntries != numEntries {
+		return fmt.Errorf("%w: source has %d entries, destination has %d entries",
+			ErrCountMismatch, numEntries, numMigratedEntries)
+	}
+
+	for _, sample := range samples {
+		val, errGet := destination.Get(sample.key)
+		if errGet != nil {
+			return fmt.Errorf("%w for sampled key %x", errGet, sample.key)
+		}
+
+		if !bytes.Equal(m.hasher.Compute(string(val)), sample.hash) {
+			return fmt.Errorf("%w for key %x", ErrSampleHashMismatch, sample.key)
+		}
+	}
+
+	return nil
+}
+
+// DestinationPath returns the path the provided storage unit is migrated in
+func (m *migrator) DestinationPath(location UnitLocation) string {
+	if location.IsStatic {
+		return m.destinationPathManager.PathForStatic(location.ShardID, location.Unit.DB.FilePath)
+	}
+
+	return m.destinationPathManager.PathForEpoch(location.ShardID, location.Epoch, location.Unit.DB.FilePath)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (m *migrator) IsInterfaceNil() bool {
+	return m == nil
+}
+
+func openPersister(dbConfig config.DBConfig, dbType storageUnit.DBType, path string) (storage.Persister, error) {
+	return storageUnit.NewDB(storageUnit.ArgDB{
+		DBType:            dbType,
+		Path:              path,
+		BatchDelaySeconds: dbConfig.BatchDelaySeconds,
+		MaxBatchSize:      dbConfig.MaxBatchSize,
+		MaxOpenFiles:      dbConfig.MaxOpenFiles,
+	})
+}
+
+func epochForLogging(location UnitLocation) string {
+	if location.IsStatic {
+		return "static"
+	}
+
+	return fmt.Sprintf("%d", location.Epoch)
+}
@@ -0,0 +1,170 @@
+package databases
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/hashing/blake2b"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsMigrator(sourceDbPath string, destinationDbPath string) ArgsMigrator {
+	return ArgsMigrator{
+		SourceDbPath:      sourceDbPath,
+		DestinationDbPath: destinationDbPath,
+		TargetDBType:      string(storageUnit.PebbleDB),
+		Hasher:            blake2b.NewBlake2b(),
+		NumVerifySamples:  5,
+	}
+}
+
+func TestNewMigrator(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty source path should error", func(t *testing.T) {
+		t.Parallel()
+
+		m, err := NewMigrator(createMockArgsMigrator("", t.TempDir()))
+		assert.Nil(t, m)
+		assert.Equal(t, ErrEmptyDatabasePath, err)
+	})
+	t.Run("empty destination path should error", func(t *testing.T) {
+		t.Parallel()
+
+		m, err := NewMigrator(createMockArgsMigrator(t.TempDir(), ""))
+		assert.Nil(t, m)
+		assert.Equal(t, ErrEmptyDatabasePath, err)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsMigrator(t.TempDir(), t.TempDir())
+		args.Hasher = nil
+		m, err := NewMigrator(args)
+		assert.Nil(t, m)
+		assert.Equal(t, ErrNilHasher, err)
+	})
+	t.Run("memory DB target type should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsMigrator(t.TempDir(), t.TempDir())
+		args.TargetDBType = string(storageUnit.MemoryDB)
+		m, err := NewMigrator(args)
+		assert.Nil(t, m)
+		assert.True(t, errors.Is(err, ErrInvalidTargetDBType))
+	})
+	t.Run("same source and destination should error", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		m, err := NewMigrator(createMockArgsMigrator(dir, dir+string(filepath.Separator)))
+		assert.Nil(t, m)
+		assert.Equal(t, ErrSameSourceAndDestination, err)
+	})
+	t.Run("not empty destination should error", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		err := os.Mkdir(filepath.Join(dir, "Static"), os.ModePerm)
+		require.Nil(t, err)
+
+		m, err := NewMigrator(createMockArgsMigrator(t.TempDir(), dir))
+		assert.Nil(t, m)
+		assert.True(t, errors.Is(err, ErrDestinationNotEmpty))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		m, err := NewMigrator(createMockArgsMigrator(t.TempDir(), filepath.Join(t.TempDir(), "missing")))
+		assert.Nil(t, err)
+		assert.False(t, m.IsInterfaceNil())
+	})
+}
+
+func TestMigrator_Migrate(t *testing.T) {
+	t.Parallel()
+
+	sourceDbPath := t.TempDir()
+	destinationDbPath := filepath.Join(t.TempDir(), "db")
+	units := createTestUnits()
+
+	headersEntries := make(map[string][]byte)
+	for i := 0; i < 100; i++ {
+		headersEntries[fmt.Sprintf("hash%d", i)] = []byte(fmt.Sprintf("header%d", i))
+	}
+	bootstrapEntries := map[string][]byte{"key": []byte("value")}
+	createTestUnit(t, units[1].DB, epochUnitPath(sourceDbPath, 1, "0", units[1].DB.FilePath), headersEntries)
+	createTestUnit(t, units[0].DB, staticUnitPath(sourceDbPath, "0", units[0].DB.FilePath), bootstrapEntries)
+
+	ud, _ := NewUnitsDiscoverer(createMockArgsUnitsDiscoverer(sourceDbPath))
+	locations, err := ud.Discover()
+	require.Nil(t, err)
+	require.Equal(t, 2, len(locations))
+
+	m, _ := NewMigrator(createMockArgsMigrator(sourceDbPath, destinationDbPath))
+	statistics, err := m.Migrate(locations)
+	require.Nil(t, err)
+	assert.Equal(t, 2, statistics.NumUnits)
+	assert.Equal(t, uint64(101), statistics.NumEntries)
+
+	checkMigratedUnit(t, units[1].DB.FilePath, epochUnitPath(destinationDbPath, 1, "0", units[1].DB.FilePath), headersEntries)
+	checkMigratedUnit(t, units[0].DB.FilePath, staticUnitPath(destinationDbPath, "0", units[0].DB.FilePath), bootstrapEntries)
+	assert.Equal(t, epochUnitPath(destinationDbPath, 1, "0", units[1].DB.FilePath), m.DestinationPath(locations[0]))
+}
+
+func checkMigratedUnit(t *testing.T, filePath string, path string, expectedEntries map[string][]byte) {
+	persister, err := openPersister(createTestDBConfig(filePath), storageUnit.PebbleDB, path)
+	require.Nil(t, err)
+	defer func() {
+		_ = persister.Close()
+	}()
+
+	entries := make(map[string][]byte)
+	persister.RangeKeys(func(key []byte, val []byte) bool {
+		entries[string(key)] = val
+		return true
+	})
+	assert.Equal(t, expectedEntries, entries)
+}
+
+func TestMigrator_AddSampleShouldKeepTheConfiguredNumberOfSamples(t *testing.T) {
+	t.Parallel()
+
+	m, _ := NewMigrator(createMockArgsMigrator(t.TempDir(), t.TempDir()))
+	samples := make([]entrySample, 0)
+	for i := 0; i < 1000; i++ {
+		samples = m.addSample(samples, uint64(i+1), []byte(fmt.Sprintf("key%d", i)), []byte("val"))
+	}
+	assert.Equal(t, 5, len(samples))
+
+	m.numVerifySamples = 0
+	samples = m.addSample(make([]entrySample, 0), 1, []byte("key"), []byte("val"))
+	assert.Equal(t, 0, len(samples))
+}
+
+func TestMigrator_VerifyUnitShouldDetectMismatches(t *testing.T) {
+	t.Parallel()
+
+	dbPath := t.TempDir()
+	dbConfig := createTestDBConfig("BlockHeaders")
+	path := epochUnitPath(dbPath, 0, "0", dbConfig.FilePath)
+	m, _ := NewMigrator(createMockArgsMigrator(t.TempDir(), filepath.Join(t.TempDir(), "db")))
+	m.targetDBType = storageUnit.LvlDBSerial
+	createTestUnit(t, dbConfig, path, map[string][]byte{"key": []byte("value")})
+
+	err := m.verifyUnit(dbConfig, path, 2, nil)
+	assert.True(t, errors.Is(err, ErrCountMismatch))
+
+	samples := []entrySample{{key: []byte("key"), hash: m.hasher.Compute("other value")}}
+	err = m.verifyUnit(dbConfig, path, 1, samples)
+	assert.True(t, errors.Is(err, ErrSampleHashMismatch))
+
+	samples = []entrySample{{key: []byte("key"), hash: m.hasher.Compute("value")}}
+	err = m.verifyUnit(dbConfig, path, 1, samples)
+	assert.Nil(t, err)
+}
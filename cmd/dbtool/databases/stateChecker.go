@@ -0,0 +1,63 @@
+package databases
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/state"
+)
+
+// ArgsStateChecker holds the arguments needed for creating a new state checker
+type ArgsStateChecker struct {
+	AccountsTrieDB       config.DBConfig
+	MaxTrieLevelInMemory uint
+	Marshalizer          marshal.Marshalizer
+	Hasher               hashing.Hasher
+}
+
+type stateChecker struct {
+	*accountsTrieOpener
+}
+
+// NewStateChecker creates a component able to verify, offline, the integrity of an accounts trie and all its data
+// tries out of a node's databases directory
+func NewStateChecker(args ArgsStateChecker) (*stateChecker, error) {
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+
+	return &stateChecker{
+		accountsTrieOpener: &accountsTrieOpener{
+			accountsTrieDB:       args.AccountsTrieDB,
+			maxTrieLevelInMemory: args.MaxTrieLevelInMemory,
+			marshalizer:          args.Marshalizer,
+			hasher:               args.Hasher,
+		},
+	}, nil
+}
+
+// Check walks the accounts trie with the provided root hash and all its data tries, reporting the missing and corrupt
+// trie nodes. The trie nodes are searched in the accounts trie units of the provided shard found in the locations
+func (sc *stateChecker) Check(locations []UnitLocation, shardID string, rootHash []byte) (*state.IntegrityReport, error) {
+	accountsTrie, db, err := sc.openAccountsTrie(locations, shardID)
+	if err != nil {
+		return nil, err
+	}
+	defer db.closePersisters()
+
+	checker, err := state.NewAccountsIntegrityChecker(accountsTrie, sc.marshalizer)
+	if err != nil {
+		return nil, err
+	}
+
+	return checker.CheckIntegrity(rootHash)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sc *stateChecker) IsInterfaceNil() bool {
+	return sc == nil
+}
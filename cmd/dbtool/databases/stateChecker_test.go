@@ -0,0 +1,104 @@
+package databases
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/ElrondNetwork/elrond-go/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsStateChecker() ArgsStateChecker {
+	argsStateDiffer := createMockArgsStateDiffer()
+
+	return ArgsStateChecker{
+		AccountsTrieDB:       argsStateDiffer.AccountsTrieDB,
+		MaxTrieLevelInMemory: argsStateDiffer.MaxTrieLevelInMemory,
+		Marshalizer:          argsStateDiffer.Marshalizer,
+		Hasher:               argsStateDiffer.Hasher,
+	}
+}
+
+func TestNewStateChecker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStateChecker()
+		args.Marshalizer = nil
+		sc, err := NewStateChecker(args)
+		assert.Nil(t, sc)
+		assert.Equal(t, ErrNilMarshalizer, err)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStateChecker()
+		args.Hasher = nil
+		sc, err := NewStateChecker(args)
+		assert.Nil(t, sc)
+		assert.Equal(t, ErrNilHasher, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		sc, err := NewStateChecker(createMockArgsStateChecker())
+		assert.Nil(t, err)
+		assert.False(t, sc.IsInterfaceNil())
+	})
+}
+
+func TestStateChecker_Check(t *testing.T) {
+	t.Parallel()
+
+	numAccounts := 20
+	args := createMockArgsStateChecker()
+	dbPath := t.TempDir()
+	unitPath := epochUnitPath(dbPath, 0, "0", args.AccountsTrieDB.FilePath)
+
+	persister, err := openPersister(args.AccountsTrieDB, storageUnit.LvlDBSerial, unitPath)
+	require.Nil(t, err)
+	storageManager, _ := trie.NewTrieStorageManagerWithoutPruning(persister)
+	accountsTrie, _ := trie.NewTrie(storageManager, args.Marshalizer, args.Hasher, args.MaxTrieLevelInMemory)
+	for i := 0; i < numAccounts; i++ {
+		saveTestAccount(t, accountsTrie, fmt.Sprintf("address%d", i), int64(i))
+	}
+	require.Nil(t, accountsTrie.Commit())
+	rootHash, _ := accountsTrie.RootHash()
+	allHashes, _ := accountsTrie.GetAllHashes()
+	require.Nil(t, persister.Close())
+
+	locations := []UnitLocation{
+		{Unit: Unit{Name: "AccountsTrieStorage", DB: args.AccountsTrieDB}, ShardID: "0", Epoch: 0, Path: unitPath},
+	}
+
+	t.Run("no accounts trie unit should error", func(t *testing.T) {
+		sc, _ := NewStateChecker(args)
+		report, errCheck := sc.Check(locations, "1", rootHash)
+		assert.Nil(t, report)
+		assert.Equal(t, ErrAccountsTrieUnitNotFound, errCheck)
+	})
+	t.Run("intact state should work", func(t *testing.T) {
+		sc, _ := NewStateChecker(args)
+		report, errCheck := sc.Check(locations, "0", rootHash)
+		require.Nil(t, errCheck)
+		assert.True(t, report.IsIntact())
+		assert.Equal(t, uint64(len(allHashes)), report.NumNodes)
+		assert.Equal(t, uint64(numAccounts), report.NumLeaves)
+	})
+	t.Run("missing trie node should be reported", func(t *testing.T) {
+		brokenPersister, errOpen := openPersister(args.AccountsTrieDB, storageUnit.LvlDBSerial, unitPath)
+		require.Nil(t, errOpen)
+		require.Nil(t, brokenPersister.Remove(allHashes[0]))
+		require.Nil(t, brokenPersister.Close())
+
+		sc, _ := NewStateChecker(args)
+		report, errCheck := sc.Check(locations, "0", rootHash)
+		require.Nil(t, errCheck)
+		assert.False(t, report.IsIntact())
+		assert.Equal(t, [][]byte{allHashes[0]}, report.MissingNodes)
+	})
+}
@@ -0,0 +1,70 @@
+package databases
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/state"
+)
+
+// ArgsStateDiffer holds the arguments needed for creating a new state differ
+type ArgsStateDiffer struct {
+	AccountsTrieDB       config.DBConfig
+	MaxTrieLevelInMemory uint
+	Marshalizer          marshal.Marshalizer
+	Hasher               hashing.Hasher
+}
+
+type stateDiffer struct {
+	*accountsTrieOpener
+}
+
+// NewStateDiffer creates a component able to compute, offline, the accounts changed between two accounts trie
+// root hashes out of a node's databases directory
+func NewStateDiffer(args ArgsStateDiffer) (*stateDiffer, error) {
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+
+	return &stateDiffer{
+		accountsTrieOpener: &accountsTrieOpener{
+			accountsTrieDB:       args.AccountsTrieDB,
+			maxTrieLevelInMemory: args.MaxTrieLevelInMemory,
+			marshalizer:          args.Marshalizer,
+			hasher:               args.Hasher,
+		},
+	}, nil
+}
+
+// Diff calls the handler for each account changed between the two accounts trie root hashes. The trie nodes are
+// searched in the accounts trie units of the provided shard found in the locations, newest epoch first
+func (sd *stateDiffer) Diff(
+	locations []UnitLocation,
+	shardID string,
+	fromRootHash []byte,
+	toRootHash []byte,
+	handler func(change *state.AccountStateChange) bool,
+) error {
+	accountsTrie, db, err := sd.openAccountsTrie(locations, shardID)
+	if err != nil {
+		return err
+	}
+	defer db.closePersisters()
+
+	differ, err := state.NewAccountsDiffer(accountsTrie, sd.marshalizer)
+	if err != nil {
+		return err
+	}
+
+	_, err = differ.GetAccountsDiff(fromRootHash, toRootHash, nil, 0, handler)
+	return err
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sd *stateDiffer) IsInterfaceNil() bool {
+	return sd == nil
+}
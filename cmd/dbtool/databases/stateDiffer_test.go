@@ -0,0 +1,125 @@
+package databases
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/hashing/sha256"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/ElrondNetwork/elrond-go/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsStateDiffer() ArgsStateDiffer {
+	return ArgsStateDiffer{
+		AccountsTrieDB:       createTestDBConfig("AccountsTrie/MainDB"),
+		MaxTrieLevelInMemory: 5,
+		Marshalizer:          &marshal.GogoProtoMarshalizer{},
+		Hasher:               sha256.NewSha256(),
+	}
+}
+
+func TestNewStateDiffer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStateDiffer()
+		args.Marshalizer = nil
+		sd, err := NewStateDiffer(args)
+		assert.Nil(t, sd)
+		assert.Equal(t, ErrNilMarshalizer, err)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStateDiffer()
+		args.Hasher = nil
+		sd, err := NewStateDiffer(args)
+		assert.Nil(t, sd)
+		assert.Equal(t, ErrNilHasher, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		sd, err := NewStateDiffer(createMockArgsStateDiffer())
+		assert.Nil(t, err)
+		assert.False(t, sd.IsInterfaceNil())
+	})
+}
+
+func saveTestAccount(t *testing.T, accountsTrie common.Trie, address string, balance int64) {
+	account, _ := state.NewUserAccount([]byte(address))
+	_ = account.AddToBalance(big.NewInt(balance))
+	accountBytes, err := (&marshal.GogoProtoMarshalizer{}).Marshal(account)
+	require.Nil(t, err)
+	require.Nil(t, accountsTrie.Update([]byte(address), accountBytes))
+}
+
+func TestStateDiffer_Diff(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsStateDiffer()
+	dbPath := t.TempDir()
+	oldEpochPath := epochUnitPath(dbPath, 0, "0", args.AccountsTrieDB.FilePath)
+	newEpochPath := epochUnitPath(dbPath, 1, "0", args.AccountsTrieDB.FilePath)
+
+	commitToPersister := func(path string, update func(accountsTrie common.Trie)) []byte {
+		persister, err := openPersister(args.AccountsTrieDB, storageUnit.LvlDBSerial, path)
+		require.Nil(t, err)
+		storageManager, _ := trie.NewTrieStorageManagerWithoutPruning(persister)
+		accountsTrie, _ := trie.NewTrie(storageManager, args.Marshalizer, args.Hasher, args.MaxTrieLevelInMemory)
+
+		update(accountsTrie)
+		require.Nil(t, accountsTrie.Commit())
+		rootHash, _ := accountsTrie.RootHash()
+		require.Nil(t, persister.Close())
+
+		return rootHash
+	}
+
+	// the tries are saved in different epochs, so the diff needs the accounts trie units of both epochs
+	fromRootHash := commitToPersister(oldEpochPath, func(accountsTrie common.Trie) {
+		saveTestAccount(t, accountsTrie, "alice", 10)
+		saveTestAccount(t, accountsTrie, "bob", 20)
+	})
+	toRootHash := commitToPersister(newEpochPath, func(accountsTrie common.Trie) {
+		saveTestAccount(t, accountsTrie, "alice", 10)
+		saveTestAccount(t, accountsTrie, "bob", 25)
+		saveTestAccount(t, accountsTrie, "carol", 5)
+	})
+
+	locations := []UnitLocation{
+		{Unit: Unit{Name: "AccountsTrieStorage", DB: args.AccountsTrieDB}, ShardID: "0", Epoch: 0, Path: oldEpochPath},
+		{Unit: Unit{Name: "AccountsTrieStorage", DB: args.AccountsTrieDB}, ShardID: "0", Epoch: 1, Path: newEpochPath},
+		{Unit: Unit{Name: "BootstrapStorage", DB: createTestDBConfig("BootstrapData")}, ShardID: "0", Epoch: 1},
+	}
+
+	t.Run("no accounts trie unit should error", func(t *testing.T) {
+		sd, _ := NewStateDiffer(args)
+		err := sd.Diff(locations, "1", fromRootHash, toRootHash, func(_ *state.AccountStateChange) bool {
+			return true
+		})
+		assert.Equal(t, ErrAccountsTrieUnitNotFound, err)
+	})
+	t.Run("should return the changed accounts from all the epochs", func(t *testing.T) {
+		sd, _ := NewStateDiffer(args)
+		changes := make(map[string]*state.AccountStateChange)
+		err := sd.Diff(locations, "0", fromRootHash, toRootHash, func(change *state.AccountStateChange) bool {
+			changes[string(change.Address)] = change
+			return true
+		})
+		require.Nil(t, err)
+		require.Len(t, changes, 2)
+
+		assert.Equal(t, big.NewInt(20), changes["bob"].OldBalance)
+		assert.Equal(t, big.NewInt(25), changes["bob"].NewBalance)
+		assert.True(t, changes["carol"].IsCreated)
+		assert.Equal(t, big.NewInt(5), changes["carol"].NewBalance)
+	})
+}
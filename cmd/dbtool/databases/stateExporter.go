@@ -0,0 +1,70 @@
+package databases
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/state"
+)
+
+// ArgsStateExporter holds the arguments needed for creating a new state exporter
+type ArgsStateExporter struct {
+	AccountsTrieDB       config.DBConfig
+	MaxTrieLevelInMemory uint
+	Marshalizer          marshal.Marshalizer
+	Hasher               hashing.Hasher
+}
+
+type stateExporter struct {
+	*accountsTrieOpener
+}
+
+// NewStateExporter creates a component able to stream, offline, all the accounts found under an accounts trie root
+// hash out of a node's databases directory
+func NewStateExporter(args ArgsStateExporter) (*stateExporter, error) {
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+
+	return &stateExporter{
+		accountsTrieOpener: &accountsTrieOpener{
+			accountsTrieDB:       args.AccountsTrieDB,
+			maxTrieLevelInMemory: args.MaxTrieLevelInMemory,
+			marshalizer:          args.Marshalizer,
+			hasher:               args.Hasher,
+		},
+	}, nil
+}
+
+// Export saves, using the provided handler, every account found under the provided accounts trie root hash and, if
+// requested, the entries of their data tries. The trie nodes are searched in the accounts trie units of the provided
+// shard found in the locations. The statistics are returned along state.ErrIncompleteStateDump if the state is broken
+func (se *stateExporter) Export(
+	locations []UnitLocation,
+	shardID string,
+	rootHash []byte,
+	withDataTries bool,
+	handler state.AccountsDumpHandler,
+) (*state.DumpStatistics, error) {
+	accountsTrie, db, err := se.openAccountsTrie(locations, shardID)
+	if err != nil {
+		return nil, err
+	}
+	defer db.closePersisters()
+
+	dumper, err := state.NewAccountsDumper(accountsTrie, se.marshalizer)
+	if err != nil {
+		return nil, err
+	}
+
+	return dumper.Dump(rootHash, withDataTries, handler)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (se *stateExporter) IsInterfaceNil() bool {
+	return se == nil
+}
@@ -0,0 +1,113 @@
+package databases
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
+	"github.com/ElrondNetwork/elrond-go/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsStateExporter() ArgsStateExporter {
+	argsStateDiffer := createMockArgsStateDiffer()
+
+	return ArgsStateExporter{
+		AccountsTrieDB:       argsStateDiffer.AccountsTrieDB,
+		MaxTrieLevelInMemory: argsStateDiffer.MaxTrieLevelInMemory,
+		Marshalizer:          argsStateDiffer.Marshalizer,
+		Hasher:               argsStateDiffer.Hasher,
+	}
+}
+
+func TestNewStateExporter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStateExporter()
+		args.Marshalizer = nil
+		se, err := NewStateExporter(args)
+		assert.Nil(t, se)
+		assert.Equal(t, ErrNilMarshalizer, err)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStateExporter()
+		args.Hasher = nil
+		se, err := NewStateExporter(args)
+		assert.Nil(t, se)
+		assert.Equal(t, ErrNilHasher, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		se, err := NewStateExporter(createMockArgsStateExporter())
+		assert.Nil(t, err)
+		assert.False(t, se.IsInterfaceNil())
+	})
+}
+
+func TestStateExporter_Export(t *testing.T) {
+	t.Parallel()
+
+	numAccounts := 20
+	args := createMockArgsStateExporter()
+	dbPath := t.TempDir()
+	unitPath := epochUnitPath(dbPath, 0, "0", args.AccountsTrieDB.FilePath)
+
+	persister, err := openPersister(args.AccountsTrieDB, storageUnit.LvlDBSerial, unitPath)
+	require.Nil(t, err)
+	storageManager, _ := trie.NewTrieStorageManagerWithoutPruning(persister)
+	accountsTrie, _ := trie.NewTrie(storageManager, args.Marshalizer, args.Hasher, args.MaxTrieLevelInMemory)
+	for i := 0; i < numAccounts; i++ {
+		saveTestAccount(t, accountsTrie, fmt.Sprintf("address%d", i), int64(i))
+	}
+	require.Nil(t, accountsTrie.Commit())
+	rootHash, _ := accountsTrie.RootHash()
+	allHashes, _ := accountsTrie.GetAllHashes()
+	require.Nil(t, persister.Close())
+
+	locations := []UnitLocation{
+		{Unit: Unit{Name: "AccountsTrieStorage", DB: args.AccountsTrieDB}, ShardID: "0", Epoch: 0, Path: unitPath},
+	}
+
+	t.Run("no accounts trie unit should error", func(t *testing.T) {
+		se, _ := NewStateExporter(args)
+		statistics, errExport := se.Export(locations, "1", rootHash, true, &stateMock.AccountsDumpHandlerStub{})
+		assert.Nil(t, statistics)
+		assert.Equal(t, ErrAccountsTrieUnitNotFound, errExport)
+	})
+	t.Run("intact state should export all the accounts", func(t *testing.T) {
+		balances := make(map[string]int64)
+		se, _ := NewStateExporter(args)
+		statistics, errExport := se.Export(locations, "0", rootHash, true, &stateMock.AccountsDumpHandlerStub{
+			SaveAccountCalled: func(account *state.AccountDump) error {
+				balances[string(account.Address)] = account.Balance.Int64()
+				return nil
+			},
+		})
+		require.Nil(t, errExport)
+		assert.Equal(t, uint64(numAccounts), statistics.NumAccounts)
+		assert.Equal(t, uint64(len(allHashes)), statistics.NumCheckedTrieNodes)
+		require.Len(t, balances, numAccounts)
+		assert.Equal(t, int64(7), balances["address7"])
+	})
+	t.Run("missing trie node should error", func(t *testing.T) {
+		brokenPersister, errOpen := openPersister(args.AccountsTrieDB, storageUnit.LvlDBSerial, unitPath)
+		require.Nil(t, errOpen)
+		require.Nil(t, brokenPersister.Remove(allHashes[0]))
+		require.Nil(t, brokenPersister.Close())
+
+		se, _ := NewStateExporter(args)
+		statistics, errExport := se.Export(locations, "0", rootHash, true, &stateMock.AccountsDumpHandlerStub{})
+		assert.True(t, errors.Is(errExport, state.ErrIncompleteStateDump))
+		assert.True(t, statistics.NumAccounts < uint64(numAccounts))
+	})
+}
@@ -0,0 +1,419 @@
+package databases
+
+import (
+	"bufio"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/state"
+)
+
+const (
+	// ExportFormatNDJSON writes one JSON encoded account or data trie entry per line
+	ExportFormatNDJSON = "ndjson"
+	// ExportFormatColumnar writes each column of the accounts and data trie entries tables in its own file
+	ExportFormatColumnar = "columnar"
+
+	accountsTableName  = "accounts"
+	dataTriesTableName = "data-tries"
+	ndjsonExtension    = ".ndjson"
+	columnExtension    = ".txt"
+	schemaFileName     = "schema.json"
+)
+
+// StateWriter defines a state dump handler writing the accounts in files. Close must be called after the dump ends
+type StateWriter interface {
+	state.AccountsDumpHandler
+	Close() error
+}
+
+// ArgsStateWriter holds the arguments needed for creating a new state writer
+type ArgsStateWriter struct {
+	Directory       string
+	Format          string
+	WithDataTries   bool
+	PubKeyConverter core.PubkeyConverter
+	// AddressEncoding is the name of the encoding used by the public key converter, as set in its config
+	AddressEncoding string
+}
+
+// NewStateWriter creates the state writer for the provided format. The directory must not exist or be empty
+func NewStateWriter(args ArgsStateWriter) (StateWriter, error) {
+	if check.IfNil(args.PubKeyConverter) {
+		return nil, ErrNilPubKeyConverter
+	}
+	if len(args.AddressEncoding) == 0 {
+		return nil, ErrEmptyAddressEncoding
+	}
+	if args.Format != ExportFormatNDJSON && args.Format != ExportFormatColumnar {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidExportFormat, args.Format)
+	}
+
+	err := createEmptyDirectory(args.Directory)
+	if err != nil {
+		return nil, err
+	}
+
+	if args.Format == ExportFormatNDJSON {
+		return newNDJSONStateWriter(args)
+	}
+
+	return newColumnarStateWriter(args)
+}
+
+func createEmptyDirectory(directory string) error {
+	if directoryExists(directory) {
+		files, err := ioutil.ReadDir(directory)
+		if err != nil {
+			return err
+		}
+		if len(files) > 0 {
+			return fmt.Errorf("%w: %s", ErrDestinationNotEmpty, directory)
+		}
+	}
+
+	return os.MkdirAll(directory, 0750)
+}
+
+type exportedAccount struct {
+	Address  string `json:"address"`
+	Nonce    uint64 `json:"nonce"`
+	Balance  string `json:"balance"`
+	CodeHash string `json:"codeHash,omitempty"`
+	Username string `json:"username,omitempty"`
+	RootHash string `json:"rootHash,omitempty"`
+}
+
+type exportedDataTrieEntry struct {
+	Address string `json:"address"`
+	Key     string `json:"key"`
+	Value   string `json:"value"`
+}
+
+// bufferedFile is a file whose writes are buffered until it is closed
+type bufferedFile struct {
+	file   *os.File
+	writer *bufio.Writer
+}
+
+func createBufferedFile(path string) (*bufferedFile, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, err
+	}
+
+	return &bufferedFile{
+		file:   file,
+		writer: bufio.NewWriter(file),
+	}, nil
+}
+
+func (bf *bufferedFile) close() error {
+	errFlush := bf.writer.Flush()
+	errClose := bf.file.Close()
+	if errFlush != nil {
+		return errFlush
+	}
+
+	return errClose
+}
+
+// ndjsonStateWriter writes the accounts in the accounts.ndjson file and the data trie entries in the
+// data-tries.ndjson file, one JSON object per line. The objects fields are described, with the same encodings as the
+// columnar format, in the schema.json file written when the writer is closed
+type ndjsonStateWriter struct {
+	directory       string
+	pubKeyConverter core.PubkeyConverter
+	accounts        *bufferedFile
+	dataTries       *bufferedFile
+	accountsEncoder *json.Encoder
+	dataEncoder     *json.Encoder
+	accountsSchema  TableSchema
+	dataTriesSchema TableSchema
+}
+
+func newNDJSONStateWriter(args ArgsStateWriter) (*ndjsonStateWriter, error) {
+	accounts, err := createBufferedFile(filepath.Join(args.Directory, accountsTableName+ndjsonExtension))
+	if err != nil {
+		return nil, err
+	}
+
+	writer := &ndjsonStateWriter{
+		directory:       args.Directory,
+		pubKeyConverter: args.PubKeyConverter,
+		accounts:        accounts,
+		accountsEncoder: json.NewEncoder(accounts.writer),
+		accountsSchema: TableSchema{
+			Name:    accountsTableName,
+			Columns: createAccountsColumns(args.AddressEncoding),
+		},
+		dataTriesSchema: TableSchema{
+			Name:    dataTriesTableName,
+			Columns: createDataTriesColumns(args.AddressEncoding),
+		},
+	}
+	if !args.WithDataTries {
+		return writer, nil
+	}
+
+	writer.dataTries, err = createBufferedFile(filepath.Join(args.Directory, dataTriesTableName+ndjsonExtension))
+	if err != nil {
+		log.LogIfError(accounts.close())
+		return nil, err
+	}
+	writer.dataEncoder = json.NewEncoder(writer.dataTries.writer)
+
+	return writer, nil
+}
+
+// SaveAccount writes the account as a JSON object on a new line of the accounts file
+func (w *ndjsonStateWriter) SaveAccount(account *state.AccountDump) error {
+	err := w.accountsEncoder.Encode(&exportedAccount{
+		Address:  w.pubKeyConverter.Encode(account.Address),
+		Nonce:    account.Nonce,
+		Balance:  account.Balance.String(),
+		CodeHash: hex.EncodeToString(account.CodeHash),
+		Username: hex.EncodeToString(account.UserName),
+		RootHash: hex.EncodeToString(account.RootHash),
+	})
+	if err != nil {
+		return err
+	}
+
+	w.accountsSchema.NumRows++
+	return nil
+}
+
+// SaveDataTrieEntry writes the data trie entry as a JSON object on a new line of the data tries file
+func (w *ndjsonStateWriter) SaveDataTrieEntry(address []byte, key []byte, value []byte) error {
+	if w.dataEncoder == nil {
+		return nil
+	}
+
+	err := w.dataEncoder.Encode(&exportedDataTrieEntry{
+		Address: w.pubKeyConverter.Encode(address),
+		Key:     hex.EncodeToString(key),
+		Value:   hex.EncodeToString(value),
+	})
+	if err != nil {
+		return err
+	}
+
+	w.dataTriesSchema.NumRows++
+	return nil
+}
+
+// Close flushes and closes the written files and writes the files schema
+func (w *ndjsonStateWriter) Close() error {
+	err := w.accounts.close()
+	if err != nil {
+		if w.dataTries != nil {
+			log.LogIfError(w.dataTries.close())
+		}
+		return err
+	}
+
+	schema := []TableSchema{w.accountsSchema}
+	if w.dataTries != nil {
+		err = w.dataTries.close()
+		if err != nil {
+			return err
+		}
+		schema = append(schema, w.dataTriesSchema)
+	}
+
+	return writeSchema(w.directory, schema)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (w *ndjsonStateWriter) IsInterfaceNil() bool {
+	return w == nil
+}
+
+// ColumnSchema describes a column of an exported table. All the values are written as text, one per line
+type ColumnSchema struct {
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	Encoding string `json:"encoding"`
+}
+
+// TableSchema describes an exported table: the columns are stored in the table's directory for the columnar format
+// and are the fields of the JSON objects of the table's file for the ndjson format
+type TableSchema struct {
+	Name    string         `json:"name"`
+	NumRows uint64         `json:"numRows"`
+	Columns []ColumnSchema `json:"columns"`
+}
+
+func writeSchema(directory string, schema []TableSchema) error {
+	schemaBytes, err := json.MarshalIndent(schema, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(filepath.Join(directory, schemaFileName), schemaBytes, 0644)
+}
+
+func createAccountsColumns(addressEncoding string) []ColumnSchema {
+	return []ColumnSchema{
+		{Name: "address", Type: "bytes", Encoding: addressEncoding},
+		{Name: "nonce", Type: "uint64", Encoding: "decimal"},
+		{Name: "balance", Type: "bigint", Encoding: "decimal"},
+		{Name: "codeHash", Type: "bytes", Encoding: "hex"},
+		{Name: "username", Type: "bytes", Encoding: "hex"},
+		{Name: "rootHash", Type: "bytes", Encoding: "hex"},
+	}
+}
+
+func createDataTriesColumns(addressEncoding string) []ColumnSchema {
+	return []ColumnSchema{
+		{Name: "address", Type: "bytes", Encoding: addressEncoding},
+		{Name: "key", Type: "bytes", Encoding: "hex"},
+		{Name: "value", Type: "bytes", Encoding: "hex"},
+	}
+}
+
+// columnarTable writes each column of a table in its own file, so that a column can be read without the others
+type columnarTable struct {
+	schema  TableSchema
+	columns []*bufferedFile
+}
+
+func newColumnarTable(directory string, name string, columns []ColumnSchema) (*columnarTable, error) {
+	tableDirectory := filepath.Join(directory, name)
+	err := os.Mkdir(tableDirectory, 0750)
+	if err != nil {
+		return nil, err
+	}
+
+	table := &columnarTable{
+		schema: TableSchema{
+			Name:    name,
+			Columns: columns,
+		},
+		columns: make([]*bufferedFile, 0, len(columns)),
+	}
+	for _, column := range columns {
+		columnFile, errCreate := createBufferedFile(filepath.Join(tableDirectory, column.Name+columnExtension))
+		if errCreate != nil {
+			log.LogIfError(table.close())
+			return nil, errCreate
+		}
+		table.columns = append(table.columns, columnFile)
+	}
+
+	return table, nil
+}
+
+func (table *columnarTable) appendRow(values ...string) error {
+	for i, value := range values {
+		_, err := table.columns[i].writer.WriteString(value + "\n")
+		if err != nil {
+			return err
+		}
+	}
+	table.schema.NumRows++
+
+	return nil
+}
+
+func (table *columnarTable) close() error {
+	var lastErr error
+	for _, column := range table.columns {
+		err := column.close()
+		if err != nil {
+			lastErr = err
+		}
+	}
+
+	return lastErr
+}
+
+// columnarStateWriter writes the accounts and the data trie entries tables column by column, a directory per table.
+// The tables schema, including their number of rows, is written in the schema.json file when the writer is closed
+type columnarStateWriter struct {
+	directory       string
+	pubKeyConverter core.PubkeyConverter
+	accounts        *columnarTable
+	dataTries       *columnarTable
+}
+
+func newColumnarStateWriter(args ArgsStateWriter) (*columnarStateWriter, error) {
+	accounts, err := newColumnarTable(args.Directory, accountsTableName, createAccountsColumns(args.AddressEncoding))
+	if err != nil {
+		return nil, err
+	}
+
+	writer := &columnarStateWriter{
+		directory:       args.Directory,
+		pubKeyConverter: args.PubKeyConverter,
+		accounts:        accounts,
+	}
+	if !args.WithDataTries {
+		return writer, nil
+	}
+
+	writer.dataTries, err = newColumnarTable(args.Directory, dataTriesTableName, createDataTriesColumns(args.AddressEncoding))
+	if err != nil {
+		log.LogIfError(accounts.close())
+		return nil, err
+	}
+
+	return writer, nil
+}
+
+// SaveAccount appends the account to the accounts table
+func (w *columnarStateWriter) SaveAccount(account *state.AccountDump) error {
+	return w.accounts.appendRow(
+		w.pubKeyConverter.Encode(account.Address),
+		strconv.FormatUint(account.Nonce, 10),
+		account.Balance.String(),
+		hex.EncodeToString(account.CodeHash),
+		hex.EncodeToString(account.UserName),
+		hex.EncodeToString(account.RootHash),
+	)
+}
+
+// SaveDataTrieEntry appends the data trie entry to the data tries table
+func (w *columnarStateWriter) SaveDataTrieEntry(address []byte, key []byte, value []byte) error {
+	if w.dataTries == nil {
+		return nil
+	}
+
+	return w.dataTries.appendRow(
+		w.pubKeyConverter.Encode(address),
+		hex.EncodeToString(key),
+		hex.EncodeToString(value),
+	)
+}
+
+// Close flushes and closes the columns files and writes the tables schema
+func (w *columnarStateWriter) Close() error {
+	tables := []*columnarTable{w.accounts}
+	if w.dataTries != nil {
+		tables = append(tables, w.dataTries)
+	}
+
+	schema := make([]TableSchema, 0, len(tables))
+	for _, table := range tables {
+		err := table.close()
+		if err != nil {
+			return err
+		}
+		schema = append(schema, table.schema)
+	}
+
+	return writeSchema(w.directory, schema)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (w *columnarStateWriter) IsInterfaceNil() bool {
+	return w == nil
+}
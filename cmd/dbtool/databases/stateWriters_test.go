@@ -0,0 +1,163 @@
+package databases
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsStateWriter(directory string, format string) ArgsStateWriter {
+	return ArgsStateWriter{
+		Directory:       directory,
+		Format:          format,
+		WithDataTries:   true,
+		PubKeyConverter: testscommon.NewPubkeyConverterMock(32),
+		AddressEncoding: "hex",
+	}
+}
+
+func writeTestState(t *testing.T, writer StateWriter) {
+	require.Nil(t, writer.SaveAccount(&state.AccountDump{
+		Address:  []byte("address0"),
+		Nonce:    2,
+		Balance:  big.NewInt(1000),
+		CodeHash: []byte("code hash"),
+		UserName: []byte("alice"),
+		RootHash: []byte("root hash"),
+	}))
+	require.Nil(t, writer.SaveDataTrieEntry([]byte("address0"), []byte("key"), []byte("value")))
+	require.Nil(t, writer.SaveAccount(&state.AccountDump{
+		Address: []byte("address1"),
+		Balance: big.NewInt(0),
+	}))
+	require.Nil(t, writer.Close())
+}
+
+func readTestFile(t *testing.T, path ...string) string {
+	content, err := ioutil.ReadFile(filepath.Join(path...))
+	require.Nil(t, err)
+
+	return string(content)
+}
+
+func TestNewStateWriter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil public key converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStateWriter(t.TempDir(), ExportFormatNDJSON)
+		args.PubKeyConverter = nil
+		writer, err := NewStateWriter(args)
+		assert.Nil(t, writer)
+		assert.Equal(t, ErrNilPubKeyConverter, err)
+	})
+	t.Run("empty address encoding should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStateWriter(t.TempDir(), ExportFormatNDJSON)
+		args.AddressEncoding = ""
+		writer, err := NewStateWriter(args)
+		assert.Nil(t, writer)
+		assert.Equal(t, ErrEmptyAddressEncoding, err)
+	})
+	t.Run("invalid format should error", func(t *testing.T) {
+		t.Parallel()
+
+		writer, err := NewStateWriter(createMockArgsStateWriter(t.TempDir(), "parquet"))
+		assert.Nil(t, writer)
+		assert.True(t, errors.Is(err, ErrInvalidExportFormat))
+	})
+	t.Run("not empty directory should error", func(t *testing.T) {
+		t.Parallel()
+
+		directory := t.TempDir()
+		require.Nil(t, ioutil.WriteFile(filepath.Join(directory, "file"), []byte("data"), 0644))
+		writer, err := NewStateWriter(createMockArgsStateWriter(directory, ExportFormatNDJSON))
+		assert.Nil(t, writer)
+		assert.True(t, errors.Is(err, ErrDestinationNotEmpty))
+	})
+	t.Run("missing directory should be created", func(t *testing.T) {
+		t.Parallel()
+
+		directory := filepath.Join(t.TempDir(), "export")
+		writer, err := NewStateWriter(createMockArgsStateWriter(directory, ExportFormatColumnar))
+		require.Nil(t, err)
+		assert.False(t, writer.IsInterfaceNil())
+		assert.Nil(t, writer.Close())
+		assert.True(t, directoryExists(directory))
+	})
+}
+
+func TestNdjsonStateWriter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should write the accounts and the data trie entries", func(t *testing.T) {
+		t.Parallel()
+
+		directory := t.TempDir()
+		writer, err := NewStateWriter(createMockArgsStateWriter(directory, ExportFormatNDJSON))
+		require.Nil(t, err)
+		writeTestState(t, writer)
+
+		expectedAccounts := `{"address":"6164647265737330","nonce":2,"balance":"1000","codeHash":"636f64652068617368","username":"616c696365","rootHash":"726f6f742068617368"}` + "\n" +
+			`{"address":"6164647265737331","nonce":0,"balance":"0"}` + "\n"
+		assert.Equal(t, expectedAccounts, readTestFile(t, directory, "accounts.ndjson"))
+		expectedDataTries := `{"address":"6164647265737330","key":"6b6579","value":"76616c7565"}` + "\n"
+		assert.Equal(t, expectedDataTries, readTestFile(t, directory, "data-tries.ndjson"))
+
+		var schema []TableSchema
+		require.Nil(t, json.Unmarshal([]byte(readTestFile(t, directory, "schema.json")), &schema))
+		require.Len(t, schema, 2)
+		assert.Equal(t, TableSchema{Name: "accounts", NumRows: 2, Columns: createAccountsColumns("hex")}, schema[0])
+		assert.Equal(t, TableSchema{Name: "data-tries", NumRows: 1, Columns: createDataTriesColumns("hex")}, schema[1])
+	})
+	t.Run("without data tries should only write the accounts", func(t *testing.T) {
+		t.Parallel()
+
+		directory := t.TempDir()
+		args := createMockArgsStateWriter(directory, ExportFormatNDJSON)
+		args.WithDataTries = false
+		writer, err := NewStateWriter(args)
+		require.Nil(t, err)
+		writeTestState(t, writer)
+
+		_, err = os.Stat(filepath.Join(directory, "data-tries.ndjson"))
+		assert.True(t, os.IsNotExist(err))
+	})
+}
+
+func TestColumnarStateWriter(t *testing.T) {
+	t.Parallel()
+
+	directory := t.TempDir()
+	writer, err := NewStateWriter(createMockArgsStateWriter(directory, ExportFormatColumnar))
+	require.Nil(t, err)
+	writeTestState(t, writer)
+
+	assert.Equal(t, "6164647265737330\n6164647265737331\n", readTestFile(t, directory, "accounts", "address.txt"))
+	assert.Equal(t, "2\n0\n", readTestFile(t, directory, "accounts", "nonce.txt"))
+	assert.Equal(t, "1000\n0\n", readTestFile(t, directory, "accounts", "balance.txt"))
+	assert.Equal(t, "616c696365\n\n", readTestFile(t, directory, "accounts", "username.txt"))
+	assert.Equal(t, "6b6579\n", readTestFile(t, directory, "data-tries", "key.txt"))
+	assert.Equal(t, "76616c7565\n", readTestFile(t, directory, "data-tries", "value.txt"))
+
+	var schema []TableSchema
+	require.Nil(t, json.Unmarshal([]byte(readTestFile(t, directory, "schema.json")), &schema))
+	require.Len(t, schema, 2)
+	assert.Equal(t, "accounts", schema[0].Name)
+	assert.Equal(t, uint64(2), schema[0].NumRows)
+	assert.Equal(t, createAccountsColumns("hex"), schema[0].Columns)
+	assert.Equal(t, "data-tries", schema[1].Name)
+	assert.Equal(t, uint64(1), schema[1].NumRows)
+	assert.Equal(t, createDataTriesColumns("hex"), schema[1].Columns)
+}
@@ -0,0 +1,102 @@
+package databases
+
+import (
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/ElrondNetwork/elrond-go/trie"
+)
+
+// accountsTrieOpener opens, read only, the accounts trie found in a node's databases directory
+type accountsTrieOpener struct {
+	accountsTrieDB       config.DBConfig
+	maxTrieLevelInMemory uint
+	marshalizer          marshal.Marshalizer
+	hasher               hashing.Hasher
+}
+
+// openAccountsTrie returns the accounts trie whose nodes are searched in the accounts trie units of the provided
+// shard found in the locations, newest epoch first. The returned database persisters should be closed by the caller
+func (opener *accountsTrieOpener) openAccountsTrie(locations []UnitLocation, shardID string) (common.Trie, *trieNodesDB, error) {
+	db := &trieNodesDB{
+		persisters: make([]storage.Persister, 0),
+	}
+
+	for i := len(locations) - 1; i >= 0; i-- {
+		location := locations[i]
+		if location.Unit.DB.FilePath != opener.accountsTrieDB.FilePath || location.ShardID != shardID {
+			continue
+		}
+
+		persister, err := openPersister(opener.accountsTrieDB, storageUnit.DBType(opener.accountsTrieDB.Type), location.Path)
+		if err != nil {
+			db.closePersisters()
+			return nil, nil, fmt.Errorf("%w while opening %s", err, location.Path)
+		}
+		db.persisters = append(db.persisters, persister)
+	}
+	if len(db.persisters) == 0 {
+		return nil, nil, ErrAccountsTrieUnitNotFound
+	}
+
+	storageManager, err := trie.NewTrieStorageManagerWithoutPruning(db)
+	if err != nil {
+		db.closePersisters()
+		return nil, nil, err
+	}
+	accountsTrie, err := trie.NewTrie(storageManager, opener.marshalizer, opener.hasher, opener.maxTrieLevelInMemory)
+	if err != nil {
+		db.closePersisters()
+		return nil, nil, err
+	}
+
+	return accountsTrie, db, nil
+}
+
+// trieNodesDB is a read only trie database which searches the trie nodes in the provided persisters, in order
+type trieNodesDB struct {
+	persisters []storage.Persister
+}
+
+// Get returns the trie node stored at the provided hash in the first persister holding it
+func (db *trieNodesDB) Get(key []byte) ([]byte, error) {
+	for _, persister := range db.persisters {
+		value, err := persister.Get(key)
+		if err == nil {
+			return value, nil
+		}
+	}
+
+	return nil, storage.ErrKeyNotFound
+}
+
+// Put returns ErrReadOnlyPersister
+func (db *trieNodesDB) Put(_ []byte, _ []byte) error {
+	return storage.ErrReadOnlyPersister
+}
+
+// Remove returns ErrReadOnlyPersister
+func (db *trieNodesDB) Remove(_ []byte) error {
+	return storage.ErrReadOnlyPersister
+}
+
+// Close does nothing as the persisters are closed by the component which opened the accounts trie
+func (db *trieNodesDB) Close() error {
+	return nil
+}
+
+func (db *trieNodesDB) closePersisters() {
+	for _, persister := range db.persisters {
+		log.LogIfError(persister.Close())
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (db *trieNodesDB) IsInterfaceNil() bool {
+	return db == nil
+}
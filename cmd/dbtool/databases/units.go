@@ -0,0 +1,58 @@
+package databases
+
+import (
+	"github.com/ElrondNetwork/elrond-go/config"
+)
+
+// Unit defines a storage unit as configured in the node's main configuration file
+type Unit struct {
+	Name string
+	DB   config.DBConfig
+}
+
+// UnitLocation defines a storage unit found on disk, for a shard in an epoch or in the static directory
+type UnitLocation struct {
+	Unit     Unit
+	ShardID  string
+	Epoch    uint32
+	IsStatic bool
+	Path     string
+}
+
+// UnitsFromConfig returns the persistent storage units the node uses, as defined in the main configuration file
+func UnitsFromConfig(cfg config.Config) []Unit {
+	return []Unit{
+		{Name: "MiniBlocksStorage", DB: cfg.MiniBlocksStorage.DB},
+		{Name: "PeerBlockBodyStorage", DB: cfg.PeerBlockBodyStorage.DB},
+		{Name: "BlockHeaderStorage", DB: cfg.BlockHeaderStorage.DB},
+		{Name: "TxStorage", DB: cfg.TxStorage.DB},
+		{Name: "UnsignedTransactionStorage", DB: cfg.UnsignedTransactionStorage.DB},
+		{Name: "RewardTxStorage", DB: cfg.RewardTxStorage.DB},
+		{Name: "ShardHdrNonceHashStorage", DB: cfg.ShardHdrNonceHashStorage.DB},
+		{Name: "MetaHdrNonceHashStorage", DB: cfg.MetaHdrNonceHashStorage.DB},
+		{Name: "StatusMetricsStorage", DB: cfg.StatusMetricsStorage.DB},
+		{Name: "ReceiptsStorage", DB: cfg.ReceiptsStorage.DB},
+		{Name: "ScheduledSCRsStorage", DB: cfg.ScheduledSCRsStorage.DB},
+		{Name: "SmartContractsStorage", DB: cfg.SmartContractsStorage.DB},
+		{Name: "SmartContractsStorageForSCQuery", DB: cfg.SmartContractsStorageForSCQuery.DB},
+		{Name: "TrieEpochRootHashStorage", DB: cfg.TrieEpochRootHashStorage.DB},
+		{Name: "SmartContractsStorageSimulate", DB: cfg.SmartContractsStorageSimulate.DB},
+		{Name: "BootstrapStorage", DB: cfg.BootstrapStorage.DB},
+		{Name: "MetaBlockStorage", DB: cfg.MetaBlockStorage.DB},
+		{Name: "AccountsTrieStorageOld", DB: cfg.AccountsTrieStorageOld.DB},
+		{Name: "PeerAccountsTrieStorageOld", DB: cfg.PeerAccountsTrieStorageOld.DB},
+		{Name: "AccountsTrieStorage", DB: cfg.AccountsTrieStorage.DB},
+		{Name: "PeerAccountsTrieStorage", DB: cfg.PeerAccountsTrieStorage.DB},
+		{Name: "AccountsTrieCheckpointsStorage", DB: cfg.AccountsTrieCheckpointsStorage.DB},
+		{Name: "PeerAccountsTrieCheckpointsStorage", DB: cfg.PeerAccountsTrieCheckpointsStorage.DB},
+		{Name: "HeartbeatStorage", DB: cfg.Heartbeat.HeartbeatStorage.DB},
+		{Name: "TxLogsStorage", DB: cfg.LogsAndEvents.TxLogsStorage.DB},
+		{Name: "MiniblocksMetadataStorage", DB: cfg.DbLookupExtensions.MiniblocksMetadataStorageConfig.DB},
+		{Name: "MiniblockHashByTxHashStorage", DB: cfg.DbLookupExtensions.MiniblockHashByTxHashStorageConfig.DB},
+		{Name: "EpochByHashStorage", DB: cfg.DbLookupExtensions.EpochByHashStorageConfig.DB},
+		{Name: "ResultsHashesByTxHashStorage", DB: cfg.DbLookupExtensions.ResultsHashesByTxHashStorageConfig.DB},
+		{Name: "ESDTSuppliesStorage", DB: cfg.DbLookupExtensions.ESDTSuppliesStorageConfig.DB},
+		{Name: "RoundHashStorage", DB: cfg.DbLookupExtensions.RoundHashStorageConfig.DB},
+		{Name: "AccountTransactionsStorage", DB: cfg.DbLookupExtensions.AccountTransactionsStorageConfig.DB},
+	}
+}
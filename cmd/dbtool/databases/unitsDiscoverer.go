@@ -0,0 +1,193 @@
+package databases
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
+	"github.com/ElrondNetwork/elrond-go/storage/factory/directoryhandler"
+	"github.com/ElrondNetwork/elrond-go/storage/latestData"
+)
+
+// databaseMarkerFile is the file both LevelDB and Pebble databases hold in their root directory
+const databaseMarkerFile = "CURRENT"
+
+// ArgsUnitsDiscoverer holds the arguments needed for creating a new units discoverer
+type ArgsUnitsDiscoverer struct {
+	DbPath        string
+	Units         []Unit
+	GeneralConfig config.Config
+	Marshalizer   marshal.Marshalizer
+}
+
+type unitsDiscoverer struct {
+	dbPath             string
+	units              []Unit
+	pathManager        storage.PathManagerHandler
+	directoryReader    storage.DirectoryReaderHandler
+	latestDataProvider storage.LatestStorageDataProviderHandler
+}
+
+// NewUnitsDiscoverer creates a component able to find the storage units of a node's databases directory,
+// following the same directory layout the node uses (<db path>/Epoch_<epoch>/Shard_<shard>/<unit> and
+// <db path>/Static/Shard_<shard>/<unit>)
+func NewUnitsDiscoverer(args ArgsUnitsDiscoverer) (*unitsDiscoverer, error) {
+	if len(args.DbPath) == 0 {
+		return nil, ErrEmptyDatabasePath
+	}
+	if len(args.Units) == 0 {
+		return nil, ErrNoUnits
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+
+	pathManager, err := storageFactory.CreatePathManagerFromSinglePathString(args.DbPath)
+	if err != nil {
+		return nil, err
+	}
+
+	bootstrapDataProvider, err := storageFactory.NewBootstrapDataProvider(args.Marshalizer)
+	if err != nil {
+		return nil, err
+	}
+
+	directoryReader := directoryhandler.NewDirectoryReader()
+	latestDataProvider, err := latestData.NewLatestDataProvider(latestData.ArgsLatestDataProvider{
+		GeneralConfig:         args.GeneralConfig,
+		BootstrapDataProvider: bootstrapDataProvider,
+		DirectoryReader:       directoryReader,
+		ParentDir:             args.DbPath,
+		DefaultEpochString:    common.DefaultEpochString,
+		DefaultShardString:    common.DefaultShardString,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &unitsDiscoverer{
+		dbPath:             args.DbPath,
+		units:              args.Units,
+		pathManager:        pathManager,
+		directoryReader:    directoryReader,
+		latestDataProvider: latestDataProvider,
+	}, nil
+}
+
+// LatestData returns the latest usable data found in the databases directory
+func (ud *unitsDiscoverer) LatestData() (storage.LatestDataFromStorage, error) {
+	return ud.latestDataProvider.Get()
+}
+
+// Discover returns all the storage units found on disk, ordered by epoch, with the static ones at the end
+func (ud *unitsDiscoverer) Discover() ([]UnitLocation, error) {
+	epochs, err := ud.getEpochs()
+	if err != nil {
+		return nil, err
+	}
+
+	locations := make([]UnitLocation, 0)
+	for _, epoch := range epochs {
+		epochDir := filepath.Join(ud.dbPath, fmt.Sprintf("%s_%d", common.DefaultEpochString, epoch))
+		shardIDs, errGet := ud.latestDataProvider.GetShardsFromDirectory(epochDir)
+		if errGet != nil {
+			return nil, fmt.Errorf("%w while reading the shards of epoch %d", errGet, epoch)
+		}
+
+		for _, shardID := range shardIDs {
+			for _, unit := range ud.units {
+				path := ud.pathManager.PathForEpoch(shardID, epoch, unit.DB.FilePath)
+				if !isDatabaseDirectory(path) {
+					continue
+				}
+
+				locations = append(locations, UnitLocation{
+					Unit:    unit,
+					ShardID: shardID,
+					Epoch:   epoch,
+					Path:    path,
+				})
+			}
+		}
+	}
+
+	staticDir := filepath.Join(ud.dbPath, common.DefaultStaticDbString)
+	if !directoryExists(staticDir) {
+		return locations, nil
+	}
+
+	shardIDs, err := ud.latestDataProvider.GetShardsFromDirectory(staticDir)
+	if err != nil {
+		return nil, fmt.Errorf("%w while reading the shards of the static directory", err)
+	}
+	for _, shardID := range shardIDs {
+		for _, unit := range ud.units {
+			path := ud.pathManager.PathForStatic(shardID, unit.DB.FilePath)
+			if !isDatabaseDirectory(path) {
+				continue
+			}
+
+			locations = append(locations, UnitLocation{
+				Unit:     unit,
+				ShardID:  shardID,
+				IsStatic: true,
+				Path:     path,
+			})
+		}
+	}
+
+	return locations, nil
+}
+
+func (ud *unitsDiscoverer) getEpochs() ([]uint32, error) {
+	directoriesNames, err := ud.directoryReader.ListDirectoriesAsString(ud.dbPath)
+	if err != nil {
+		return nil, err
+	}
+
+	epochPrefix := common.DefaultEpochString + "_"
+	epochs := make([]uint32, 0, len(directoriesNames))
+	for _, dirName := range directoriesNames {
+		if !strings.HasPrefix(dirName, epochPrefix) {
+			continue
+		}
+
+		epoch, errParse := strconv.ParseUint(strings.TrimPrefix(dirName, epochPrefix), 10, 32)
+		if errParse != nil {
+			log.Debug("skipping directory", "name", dirName, "error", errParse)
+			continue
+		}
+
+		epochs = append(epochs, uint32(epoch))
+	}
+
+	sort.Slice(epochs, func(i, j int) bool {
+		return epochs[i] < epochs[j]
+	})
+
+	return epochs, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ud *unitsDiscoverer) IsInterfaceNil() bool {
+	return ud == nil
+}
+
+func isDatabaseDirectory(path string) bool {
+	_, err := os.Stat(filepath.Join(path, databaseMarkerFile))
+	return err == nil
+}
+
+func directoryExists(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && info.IsDir()
+}
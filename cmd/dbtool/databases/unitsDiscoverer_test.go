@@ -0,0 +1,174 @@
+package databases
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestDBConfig(filePath string) config.DBConfig {
+	return config.DBConfig{
+		FilePath:          filePath,
+		Type:              string(storageUnit.LvlDBSerial),
+		BatchDelaySeconds: 2,
+		MaxBatchSize:      100,
+		MaxOpenFiles:      10,
+	}
+}
+
+func createTestUnits() []Unit {
+	return []Unit{
+		{Name: "BootstrapStorage", DB: createTestDBConfig("BootstrapData")},
+		{Name: "BlockHeaderStorage", DB: createTestDBConfig("BlockHeaders")},
+		{Name: "MetaBlockStorage", DB: createTestDBConfig("MetaBlock")},
+		{Name: "AccountsTrieStorageOld", DB: createTestDBConfig("AccountsTrie/MainDB")},
+	}
+}
+
+func createMockArgsUnitsDiscoverer(dbPath string) ArgsUnitsDiscoverer {
+	units := createTestUnits()
+	return ArgsUnitsDiscoverer{
+		DbPath: dbPath,
+		Units:  units,
+		GeneralConfig: config.Config{
+			BootstrapStorage: config.StorageConfig{DB: units[0].DB},
+		},
+		Marshalizer: &marshal.GogoProtoMarshalizer{},
+	}
+}
+
+func epochUnitPath(dbPath string, epoch uint32, shardID string, filePath string) string {
+	return filepath.Join(
+		dbPath,
+		fmt.Sprintf("%s_%d", common.DefaultEpochString, epoch),
+		fmt.Sprintf("%s_%s", common.DefaultShardString, shardID),
+		filePath,
+	)
+}
+
+func staticUnitPath(dbPath string, shardID string, filePath string) string {
+	return filepath.Join(
+		dbPath,
+		common.DefaultStaticDbString,
+		fmt.Sprintf("%s_%s", common.DefaultShardString, shardID),
+		filePath,
+	)
+}
+
+func createTestUnit(t *testing.T, dbConfig config.DBConfig, path string, entries map[string][]byte) {
+	persister, err := openPersister(dbConfig, storageUnit.DBType(dbConfig.Type), path)
+	require.Nil(t, err)
+
+	for key, val := range entries {
+		err = persister.Put([]byte(key), val)
+		require.Nil(t, err)
+	}
+
+	err = persister.Close()
+	require.Nil(t, err)
+}
+
+func TestNewUnitsDiscoverer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty db path should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsUnitsDiscoverer("")
+		ud, err := NewUnitsDiscoverer(args)
+		assert.Nil(t, ud)
+		assert.Equal(t, ErrEmptyDatabasePath, err)
+	})
+	t.Run("no units should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsUnitsDiscoverer(t.TempDir())
+		args.Units = nil
+		ud, err := NewUnitsDiscoverer(args)
+		assert.Nil(t, ud)
+		assert.Equal(t, ErrNoUnits, err)
+	})
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsUnitsDiscoverer(t.TempDir())
+		args.Marshalizer = nil
+		ud, err := NewUnitsDiscoverer(args)
+		assert.Nil(t, ud)
+		assert.Equal(t, ErrNilMarshalizer, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		ud, err := NewUnitsDiscoverer(createMockArgsUnitsDiscoverer(t.TempDir()))
+		assert.Nil(t, err)
+		assert.False(t, ud.IsInterfaceNil())
+	})
+}
+
+func TestUnitsDiscoverer_Discover(t *testing.T) {
+	t.Parallel()
+
+	dbPath := t.TempDir()
+	units := createTestUnits()
+	entries := map[string][]byte{"key": []byte("value")}
+
+	createTestUnit(t, units[1].DB, epochUnitPath(dbPath, 10, "0", units[1].DB.FilePath), entries)
+	createTestUnit(t, units[3].DB, epochUnitPath(dbPath, 10, "0", units[3].DB.FilePath), entries)
+	createTestUnit(t, units[1].DB, epochUnitPath(dbPath, 2, "0", units[1].DB.FilePath), entries)
+	createTestUnit(t, units[2].DB, epochUnitPath(dbPath, 2, "metachain", units[2].DB.FilePath), entries)
+	createTestUnit(t, units[0].DB, staticUnitPath(dbPath, "0", units[0].DB.FilePath), entries)
+
+	// directories not holding a database should be ignored
+	err := os.MkdirAll(epochUnitPath(dbPath, 2, "0", units[0].DB.FilePath), os.ModePerm)
+	require.Nil(t, err)
+	err = os.MkdirAll(filepath.Join(dbPath, "Epoch_invalid"), os.ModePerm)
+	require.Nil(t, err)
+
+	ud, _ := NewUnitsDiscoverer(createMockArgsUnitsDiscoverer(dbPath))
+	locations, err := ud.Discover()
+	require.Nil(t, err)
+
+	expectedLocations := []UnitLocation{
+		{Unit: units[1], ShardID: "0", Epoch: 2, Path: epochUnitPath(dbPath, 2, "0", units[1].DB.FilePath)},
+		{Unit: units[2], ShardID: "metachain", Epoch: 2, Path: epochUnitPath(dbPath, 2, "metachain", units[2].DB.FilePath)},
+		{Unit: units[1], ShardID: "0", Epoch: 10, Path: epochUnitPath(dbPath, 10, "0", units[1].DB.FilePath)},
+		{Unit: units[3], ShardID: "0", Epoch: 10, Path: epochUnitPath(dbPath, 10, "0", units[3].DB.FilePath)},
+		{Unit: units[0], ShardID: "0", IsStatic: true, Path: staticUnitPath(dbPath, "0", units[0].DB.FilePath)},
+	}
+	assert.Equal(t, expectedLocations, locations)
+}
+
+func TestUnitsDiscoverer_DiscoverMissingDirectoryShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ud, _ := NewUnitsDiscoverer(createMockArgsUnitsDiscoverer(filepath.Join(t.TempDir(), "missing")))
+	locations, err := ud.Discover()
+	assert.NotNil(t, err)
+	assert.Nil(t, locations)
+}
+
+func TestUnitsFromConfig(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.Config{}
+	cfg.BootstrapStorage.DB.FilePath = "BootstrapData"
+	cfg.DbLookupExtensions.RoundHashStorageConfig.DB.FilePath = "DbLookupExtensions_RoundHash"
+
+	units := UnitsFromConfig(cfg)
+	filePaths := make(map[string]string)
+	for _, unit := range units {
+		filePaths[unit.Name] = unit.DB.FilePath
+	}
+
+	assert.Equal(t, "BootstrapData", filePaths["BootstrapStorage"])
+	assert.Equal(t, "DbLookupExtensions_RoundHash", filePaths["RoundHashStorage"])
+}
@@ -0,0 +1,656 @@
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	hasherFactory "github.com/ElrondNetwork/elrond-go-core/hashing/factory"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	marshalizerFactory "github.com/ElrondNetwork/elrond-go-core/marshal/factory"
+	"github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/cmd/dbtool/databases"
+	"github.com/ElrondNetwork/elrond-go/common"
+	commonFactory "github.com/ElrondNetwork/elrond-go/common/factory"
+	elrondConfig "github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/epochStart/snapshot"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/urfave/cli"
+)
+
+type config struct {
+	configFile           string
+	workingDirectory     string
+	destinationDirectory string
+	targetDBType         string
+	numVerifySamples     int
+	checkOnly            bool
+	exportSnapshot       bool
+	snapshotEpoch        uint
+	snapshotFile         string
+	diffState            bool
+	diffFromRootHash     string
+	diffToRootHash       string
+	diffFile             string
+	checkState           bool
+	stateRootHash        string
+	exportState          bool
+	exportFormat         string
+	exportDataTries      bool
+	exportDirectory      string
+	logLevel             string
+}
+
+var (
+	helpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+	// configurationFile defines a flag for the path to the main toml configuration file
+	configurationFile = cli.StringFlag{
+		Name:        "config",
+		Usage:       "The `filepath` for the main configuration file of the node that wrote the databases. It defines the storage units, their DB types and the chain ID.",
+		Value:       "./config/config.toml",
+		Destination: &argsConfig.configFile,
+	}
+	// workingDirectory defines a flag for the node's working directory
+	workingDirectory = cli.StringFlag{
+		Name:        "working-directory",
+		Usage:       "The node's working `directory`, holding the db directory. The node must be stopped while the tool runs.",
+		Value:       ".",
+		Destination: &argsConfig.workingDirectory,
+	}
+	// destinationDirectory defines a flag for the directory the databases will be migrated in
+	destinationDirectory = cli.StringFlag{
+		Name:        "destination-directory",
+		Usage:       "The working `directory` the databases will be migrated in. Its db directory must not exist or be empty.",
+		Value:       "./migrated",
+		Destination: &argsConfig.destinationDirectory,
+	}
+	// targetDBType defines a flag for the DB type the databases will be migrated to
+	targetDBType = cli.StringFlag{
+		Name: "target-db-type",
+		Usage: "The DB `type` the databases will be migrated to. Can be LvlDB, LvlDBSerial or PebbleDB. Using the " +
+			"same type as the source databases results in an offline compaction.",
+		Value:       "LvlDBSerial",
+		Destination: &argsConfig.targetDBType,
+	}
+	// numVerifySamples defines a flag for the number of entries verified for each migrated unit
+	numVerifySamples = cli.IntFlag{
+		Name:        "verify-samples",
+		Usage:       "The number of randomly chosen entries whose hashes are compared after migrating each storage unit.",
+		Value:       1000,
+		Destination: &argsConfig.numVerifySamples,
+	}
+	// checkOnly defines a flag for only running the integrity checks on the working directory
+	checkOnly = cli.BoolFlag{
+		Name:        "check-only",
+		Usage:       "Boolean option for only running the integrity checks on the working directory's databases, without migrating them.",
+		Destination: &argsConfig.checkOnly,
+	}
+	// exportSnapshot defines a flag for exporting an epoch start snapshot from the working directory's databases
+	exportSnapshot = cli.BoolFlag{
+		Name: "export-snapshot",
+		Usage: "Boolean option for exporting a snapshot of the working directory's databases at the start of an epoch, " +
+			"without migrating them. A node started with the --import-snapshot flag can bootstrap from it.",
+		Destination: &argsConfig.exportSnapshot,
+	}
+	// snapshotEpoch defines a flag for the epoch the snapshot will be exported for
+	snapshotEpoch = cli.UintFlag{
+		Name:        "snapshot-epoch",
+		Usage:       "The `epoch` whose start will be exported in the snapshot. If set to 0, the latest epoch found in storage is used.",
+		Value:       0,
+		Destination: &argsConfig.snapshotEpoch,
+	}
+	// snapshotFile defines a flag for the path of the exported snapshot
+	snapshotFile = cli.StringFlag{
+		Name:        "snapshot-file",
+		Usage:       "The `filepath` the snapshot will be exported to. The file must not exist.",
+		Value:       "./snapshot.bin",
+		Destination: &argsConfig.snapshotFile,
+	}
+	// diffState defines a flag for writing the accounts changed between two accounts trie root hashes
+	diffState = cli.BoolFlag{
+		Name: "diff-state",
+		Usage: "Boolean option for writing the accounts changed between two accounts trie root hashes found in the " +
+			"working directory's databases, without migrating them. The altered data trie entries are written as well.",
+		Destination: &argsConfig.diffState,
+	}
+	// diffFromRootHash defines a flag for the accounts trie root hash the state diff starts from
+	diffFromRootHash = cli.StringFlag{
+		Name:        "diff-from",
+		Usage:       "The hex encoded accounts trie root `hash` the state diff starts from.",
+		Destination: &argsConfig.diffFromRootHash,
+	}
+	// diffToRootHash defines a flag for the accounts trie root hash the state diff ends at
+	diffToRootHash = cli.StringFlag{
+		Name:        "diff-to",
+		Usage:       "The hex encoded accounts trie root `hash` the state diff ends at.",
+		Destination: &argsConfig.diffToRootHash,
+	}
+	// diffFile defines a flag for the path of the state diff file
+	diffFile = cli.StringFlag{
+		Name:        "diff-file",
+		Usage:       "The `filepath` the state diff will be written to, one JSON encoded account per line. The file must not exist.",
+		Value:       "./state-diff.json",
+		Destination: &argsConfig.diffFile,
+	}
+	// checkState defines a flag for checking the integrity of an accounts trie and all its data tries
+	checkState = cli.BoolFlag{
+		Name: "check-state",
+		Usage: "Boolean option for walking the accounts trie with the provided root hash and all its data tries, " +
+			"found in the working directory's databases, without migrating them. Every trie node hash is verified and " +
+			"the missing or corrupt trie nodes are reported. A node started with the --repair-state flag can request " +
+			"them from its peers.",
+		Destination: &argsConfig.checkState,
+	}
+	// stateRootHash defines a flag for the accounts trie root hash whose integrity is checked or which is exported
+	stateRootHash = cli.StringFlag{
+		Name:        "state-root-hash",
+		Usage:       "The hex encoded accounts trie root `hash` whose integrity is checked or which is exported.",
+		Destination: &argsConfig.stateRootHash,
+	}
+	// exportState defines a flag for exporting all the accounts found under an accounts trie root hash
+	exportState = cli.BoolFlag{
+		Name: "export-state",
+		Usage: "Boolean option for exporting every account found under the provided accounts trie root hash, " +
+			"from the working directory's databases, without migrating them. Every trie node hash is verified and " +
+			"the export fails if the state is incomplete.",
+		Destination: &argsConfig.exportState,
+	}
+	// exportFormat defines a flag for the format of the exported state
+	exportFormat = cli.StringFlag{
+		Name: "export-format",
+		Usage: "The `format` of the exported state. Can be ndjson (one JSON encoded account per line) or columnar " +
+			"(a directory per table, a text file per column), both described by a schema.json file.",
+		Value:       databases.ExportFormatNDJSON,
+		Destination: &argsConfig.exportFormat,
+	}
+	// exportDataTries defines a flag for exporting the data tries entries along the accounts
+	exportDataTries = cli.BoolFlag{
+		Name:        "export-data-tries",
+		Usage:       "Boolean option for exporting the key/value entries of each account's data trie as well.",
+		Destination: &argsConfig.exportDataTries,
+	}
+	// exportDirectory defines a flag for the directory the state will be exported in
+	exportDirectory = cli.StringFlag{
+		Name:        "export-directory",
+		Usage:       "The `directory` the state will be exported in. It must not exist or be empty.",
+		Value:       "./state-export",
+		Destination: &argsConfig.exportDirectory,
+	}
+	// logLevel defines the logger level
+	logLevel = cli.StringFlag{
+		Name: "log-level",
+		Usage: "This flag specifies the logger `level(s)`. It can contain multiple comma-separated value. For example" +
+			", if set to *:INFO the logs for all packages will have the INFO level. However, if set to *:INFO,api:DEBUG" +
+			" the logs for all packages will have the INFO level, excepting the api package which will receive a DEBUG" +
+			" log level.",
+		Value:       "*:" + logger.LogInfo.String(),
+		Destination: &argsConfig.logLevel,
+	}
+
+	argsConfig = &config{}
+
+	log = logger.GetOrCreate("dbtool")
+)
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = helpTemplate
+	app.Name = "Elrond Database Tool"
+	app.Version = fmt.Sprintf("%s/%s/%s-%s", "1.0.0", runtime.Version(), runtime.GOOS, runtime.GOARCH)
+	app.Usage = "Migrates the node's databases to another storage backend (or compacts them), checks their integrity (including the state's) and exports epoch start snapshots, state diffs and full states"
+	app.Flags = []cli.Flag{
+		configurationFile,
+		workingDirectory,
+		destinationDirectory,
+		targetDBType,
+		numVerifySamples,
+		checkOnly,
+		exportSnapshot,
+		snapshotEpoch,
+		snapshotFile,
+		diffState,
+		diffFromRootHash,
+		diffToRootHash,
+		diffFile,
+		checkState,
+		stateRootHash,
+		exportState,
+		exportFormat,
+		exportDataTries,
+		exportDirectory,
+		logLevel,
+	}
+	app.Authors = []cli.Author{
+		{
+			Name:  "The Elrond Team",
+			Email: "contact@elrond.com",
+		},
+	}
+
+	app.Action = func(_ *cli.Context) error {
+		return startDbTool()
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+func startDbTool() error {
+	err := logger.SetLogLevel(argsConfig.logLevel)
+	if err != nil {
+		return err
+	}
+
+	generalConfig, err := common.LoadMainConfig(argsConfig.configFile)
+	if err != nil {
+		return err
+	}
+	marshalizer, err := marshalizerFactory.NewMarshalizer(generalConfig.Marshalizer.Type)
+	if err != nil {
+		return err
+	}
+
+	sourceDbPath := dbPathForWorkingDirectory(argsConfig.workingDirectory, generalConfig)
+	units := databases.UnitsFromConfig(*generalConfig)
+	discoverer, err := databases.NewUnitsDiscoverer(databases.ArgsUnitsDiscoverer{
+		DbPath:        sourceDbPath,
+		Units:         units,
+		GeneralConfig: *generalConfig,
+		Marshalizer:   marshalizer,
+	})
+	if err != nil {
+		return err
+	}
+
+	latestData, errLatestData := discoverer.LatestData()
+	if argsConfig.exportSnapshot {
+		if errLatestData != nil {
+			return fmt.Errorf("%w while reading the latest data from storage, path %s", errLatestData, sourceDbPath)
+		}
+
+		return exportEpochStartSnapshot(sourceDbPath, *generalConfig, marshalizer, latestData)
+	}
+	if errLatestData != nil {
+		log.Warn("can not read the latest data from storage", "path", sourceDbPath, "error", errLatestData)
+	} else {
+		log.Info("latest data from storage",
+			"epoch", latestData.Epoch,
+			"shard", latestData.ShardID,
+			"round", latestData.LastRound)
+	}
+
+	locations, err := discoverer.Discover()
+	if err != nil {
+		return err
+	}
+	log.Info("storage units found", "path", sourceDbPath, "num units", len(locations))
+
+	if argsConfig.checkOnly {
+		return checkIntegrity(sourceDbPath, *generalConfig, marshalizer, locations)
+	}
+	if argsConfig.diffState {
+		if errLatestData != nil {
+			return fmt.Errorf("%w while reading the latest data from storage, path %s", errLatestData, sourceDbPath)
+		}
+
+		return writeStateDiff(*generalConfig, marshalizer, locations, latestData.ShardID)
+	}
+	if argsConfig.checkState {
+		if errLatestData != nil {
+			return fmt.Errorf("%w while reading the latest data from storage, path %s", errLatestData, sourceDbPath)
+		}
+
+		return checkStateIntegrity(*generalConfig, marshalizer, locations, latestData.ShardID)
+	}
+	if argsConfig.exportState {
+		if errLatestData != nil {
+			return fmt.Errorf("%w while reading the latest data from storage, path %s", errLatestData, sourceDbPath)
+		}
+
+		return exportFullState(*generalConfig, marshalizer, locations, latestData.ShardID)
+	}
+
+	hasher, err := hasherFactory.NewHasher(generalConfig.Hasher.Type)
+	if err != nil {
+		return err
+	}
+
+	destinationDbPath := dbPathForWorkingDirectory(argsConfig.destinationDirectory, generalConfig)
+	migrator, err := databases.NewMigrator(databases.ArgsMigrator{
+		SourceDbPath:      sourceDbPath,
+		DestinationDbPath: destinationDbPath,
+		TargetDBType:      argsConfig.targetDBType,
+		Hasher:            hasher,
+		NumVerifySamples:  argsConfig.numVerifySamples,
+	})
+	if err != nil {
+		return err
+	}
+
+	statistics, err := migrator.Migrate(locations)
+	if statistics != nil {
+		log.Info("migration ended",
+			"units", statistics.NumUnits,
+			"entries", statistics.NumEntries,
+			"duration", statistics.Duration)
+	}
+	if err != nil {
+		return err
+	}
+
+	migratedConfig := configWithDBType(*generalConfig, argsConfig.targetDBType)
+	migratedLocations := make([]databases.UnitLocation, 0, len(locations))
+	for _, location := range locations {
+		location.Unit.DB.Type = argsConfig.targetDBType
+		location.Path = migrator.DestinationPath(location)
+		migratedLocations = append(migratedLocations, location)
+	}
+
+	return checkIntegrity(destinationDbPath, migratedConfig, marshalizer, migratedLocations)
+}
+
+func checkIntegrity(
+	dbPath string,
+	generalConfig elrondConfig.Config,
+	marshalizer marshal.Marshalizer,
+	locations []databases.UnitLocation,
+) error {
+	checker, err := databases.NewIntegrityChecker(databases.ArgsIntegrityChecker{
+		DbPath:        dbPath,
+		BootstrapDB:   generalConfig.BootstrapStorage.DB,
+		BlockHeaderDB: generalConfig.BlockHeaderStorage.DB,
+		MetaBlockDB:   generalConfig.MetaBlockStorage.DB,
+		Marshalizer:   marshalizer,
+	})
+	if err != nil {
+		return err
+	}
+
+	report, err := checker.Check(locations)
+	if err != nil {
+		return err
+	}
+
+	for _, missingHeader := range report.MissingHeaders {
+		log.Error("missing header",
+			"shard", missingHeader.ShardID,
+			"epoch", missingHeader.Epoch,
+			"nonce", missingHeader.Nonce,
+			"hash", missingHeader.Hash,
+			"referenced from", missingHeader.BootstrapPath)
+	}
+	log.Info("integrity check ended",
+		"path", dbPath,
+		"bootstrap units", report.NumBootstrapUnits,
+		"bootstrap entries", report.NumBootstrapEntries,
+		"checked headers", report.NumCheckedHeaders,
+		"skipped headers (epoch not on disk)", report.NumSkippedHeaders,
+		"missing headers", len(report.MissingHeaders))
+
+	if len(report.MissingHeaders) > 0 {
+		return fmt.Errorf("%d headers referenced by the bootstrap units are missing", len(report.MissingHeaders))
+	}
+
+	return nil
+}
+
+func exportEpochStartSnapshot(
+	dbPath string,
+	generalConfig elrondConfig.Config,
+	marshalizer marshal.Marshalizer,
+	latestData storage.LatestDataFromStorage,
+) error {
+	hasher, err := hasherFactory.NewHasher(generalConfig.Hasher.Type)
+	if err != nil {
+		return err
+	}
+
+	exp, err := snapshot.NewExporter(snapshot.ArgsExporter{
+		DbPath:        dbPath,
+		GeneralConfig: generalConfig,
+		Marshalizer:   marshalizer,
+		Hasher:        hasher,
+	})
+	if err != nil {
+		return err
+	}
+
+	epoch := uint32(argsConfig.snapshotEpoch)
+	if epoch == 0 {
+		epoch = latestData.Epoch
+	}
+
+	manifest, err := exp.Export(latestData.ShardID, epoch, argsConfig.snapshotFile)
+	if err != nil {
+		return err
+	}
+
+	log.Info("snapshot exported",
+		"path", argsConfig.snapshotFile,
+		"shard", manifest.ShardID,
+		"epoch", manifest.Epoch,
+		"round", manifest.Round,
+		"epoch start meta block hash", manifest.EpochStartMetaBlockHash,
+		"root hash", manifest.RootHash,
+		"entries", manifest.NumEntries,
+		"trie nodes", manifest.NumTrieNodes)
+
+	return nil
+}
+
+func writeStateDiff(
+	generalConfig elrondConfig.Config,
+	marshalizer marshal.Marshalizer,
+	locations []databases.UnitLocation,
+	shardID uint32,
+) error {
+	fromRootHash, err := hex.DecodeString(argsConfig.diffFromRootHash)
+	if err != nil {
+		return fmt.Errorf("%w for the diff-from root hash", err)
+	}
+	toRootHash, err := hex.DecodeString(argsConfig.diffToRootHash)
+	if err != nil {
+		return fmt.Errorf("%w for the diff-to root hash", err)
+	}
+
+	hasher, err := hasherFactory.NewHasher(generalConfig.Hasher.Type)
+	if err != nil {
+		return err
+	}
+	pubKeyConverter, err := commonFactory.NewPubkeyConverter(generalConfig.AddressPubkeyConverter)
+	if err != nil {
+		return err
+	}
+
+	differ, err := databases.NewStateDiffer(databases.ArgsStateDiffer{
+		AccountsTrieDB:       generalConfig.AccountsTrieStorage.DB,
+		MaxTrieLevelInMemory: generalConfig.StateTriesConfig.MaxStateTrieLevelInMemory,
+		Marshalizer:          marshalizer,
+		Hasher:               hasher,
+	})
+	if err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(argsConfig.diffFile, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		log.LogIfError(file.Close())
+	}()
+
+	numAccounts := 0
+	encoder := json.NewEncoder(file)
+	var errEncode error
+	err = differ.Diff(locations, core.GetShardIDString(shardID), fromRootHash, toRootHash, func(change *state.AccountStateChange) bool {
+		errEncode = encoder.Encode(state.NewAccountStateChangeResponse(change, pubKeyConverter))
+		numAccounts++
+
+		return errEncode == nil
+	})
+	if err != nil {
+		return err
+	}
+	if errEncode != nil {
+		return errEncode
+	}
+
+	log.Info("state diff written",
+		"path", argsConfig.diffFile,
+		"shard", shardID,
+		"from root hash", fromRootHash,
+		"to root hash", toRootHash,
+		"changed accounts", numAccounts)
+
+	return nil
+}
+
+func checkStateIntegrity(
+	generalConfig elrondConfig.Config,
+	marshalizer marshal.Marshalizer,
+	locations []databases.UnitLocation,
+	shardID uint32,
+) error {
+	rootHash, err := hex.DecodeString(argsConfig.stateRootHash)
+	if err != nil {
+		return fmt.Errorf("%w for the state root hash", err)
+	}
+
+	hasher, err := hasherFactory.NewHasher(generalConfig.Hasher.Type)
+	if err != nil {
+		return err
+	}
+
+	checker, err := databases.NewStateChecker(databases.ArgsStateChecker{
+		AccountsTrieDB:       generalConfig.AccountsTrieStorage.DB,
+		MaxTrieLevelInMemory: generalConfig.StateTriesConfig.MaxStateTrieLevelInMemory,
+		Marshalizer:          marshalizer,
+		Hasher:               hasher,
+	})
+	if err != nil {
+		return err
+	}
+
+	report, err := checker.Check(locations, core.GetShardIDString(shardID), rootHash)
+	if err != nil {
+		return err
+	}
+
+	for _, hash := range report.MissingNodes {
+		log.Error("missing trie node", "hash", hash)
+	}
+	for _, hash := range report.CorruptNodes {
+		log.Error("corrupt trie node", "hash", hash)
+	}
+	log.Info("state integrity check ended",
+		"shard", shardID,
+		"root hash", rootHash,
+		"data tries", report.NumDataTries,
+		"trie nodes", report.NumNodes,
+		"leaves", report.NumLeaves,
+		"missing nodes", len(report.MissingNodes),
+		"corrupt nodes", len(report.CorruptNodes))
+
+	if !report.IsIntact() {
+		return fmt.Errorf("the state has %d missing and %d corrupt trie nodes", len(report.MissingNodes), len(report.CorruptNodes))
+	}
+
+	return nil
+}
+
+func exportFullState(
+	generalConfig elrondConfig.Config,
+	marshalizer marshal.Marshalizer,
+	locations []databases.UnitLocation,
+	shardID uint32,
+) error {
+	rootHash, err := hex.DecodeString(argsConfig.stateRootHash)
+	if err != nil {
+		return fmt.Errorf("%w for the state root hash", err)
+	}
+
+	hasher, err := hasherFactory.NewHasher(generalConfig.Hasher.Type)
+	if err != nil {
+		return err
+	}
+	pubKeyConverter, err := commonFactory.NewPubkeyConverter(generalConfig.AddressPubkeyConverter)
+	if err != nil {
+		return err
+	}
+
+	exporter, err := databases.NewStateExporter(databases.ArgsStateExporter{
+		AccountsTrieDB:       generalConfig.AccountsTrieStorage.DB,
+		MaxTrieLevelInMemory: generalConfig.StateTriesConfig.MaxStateTrieLevelInMemory,
+		Marshalizer:          marshalizer,
+		Hasher:               hasher,
+	})
+	if err != nil {
+		return err
+	}
+
+	writer, err := databases.NewStateWriter(databases.ArgsStateWriter{
+		Directory:       argsConfig.exportDirectory,
+		Format:          argsConfig.exportFormat,
+		WithDataTries:   argsConfig.exportDataTries,
+		PubKeyConverter: pubKeyConverter,
+		AddressEncoding: generalConfig.AddressPubkeyConverter.Type,
+	})
+	if err != nil {
+		return err
+	}
+
+	statistics, err := exporter.Export(locations, core.GetShardIDString(shardID), rootHash, argsConfig.exportDataTries, writer)
+	errClose := writer.Close()
+	if err != nil {
+		return err
+	}
+	if errClose != nil {
+		return errClose
+	}
+
+	log.Info("state exported",
+		"path", argsConfig.exportDirectory,
+		"format", argsConfig.exportFormat,
+		"shard", shardID,
+		"root hash", rootHash,
+		"accounts", statistics.NumAccounts,
+		"data trie entries", statistics.NumDataTrieEntries,
+		"trie nodes", statistics.NumCheckedTrieNodes)
+
+	return nil
+}
+
+func dbPathForWorkingDirectory(workingDirectory string, generalConfig *elrondConfig.Config) string {
+	return filepath.Join(workingDirectory, common.DefaultDBPath, generalConfig.GeneralSettings.ChainID)
+}
+
+func configWithDBType(generalConfig elrondConfig.Config, dbType string) elrondConfig.Config {
+	generalConfig.BootstrapStorage.DB.Type = dbType
+	generalConfig.BlockHeaderStorage.DB.Type = dbType
+	generalConfig.MetaBlockStorage.DB.Type = dbType
+
+	return generalConfig
+}
@@ -1,15 +1,18 @@
 package main
 
 import (
+	"errors"
 	"fmt"
 	"math"
 	"os"
+	"path/filepath"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/facade"
+	"github.com/ElrondNetwork/elrond-go/node/secondary"
 	"github.com/urfave/cli"
 )
 
@@ -272,6 +275,38 @@ var (
 			"Should be enabled if data is not available in local disk.",
 	}
 
+	// importSnapshotFile defines a flag for the optional epoch start snapshot the node will bootstrap from
+	importSnapshotFile = cli.StringFlag{
+		Name: "import-snapshot",
+		Usage: "This flag, if set, will make the node import the epoch start snapshot from the provided `filepath` " +
+			"and bootstrap from it. The snapshot is exported with the dbtool and its state is verified against the " +
+			"epoch start header. The node's db directory must be empty, so it can be used together with --storage-cleanup",
+		Value: "",
+	}
+
+	// checkStateIntegrity defines a flag for the optional state integrity check done at startup
+	checkStateIntegrity = cli.BoolFlag{
+		Name: "check-state-integrity",
+		Usage: "Boolean option for walking the accounts trie of the last committed block and all its data tries at " +
+			"startup, before processing any block. Every trie node hash is verified and the node stops if missing or " +
+			"corrupt trie nodes are found",
+	}
+	// repairState defines a flag for the optional state repair done at startup
+	repairState = cli.BoolFlag{
+		Name: "repair-state",
+		Usage: "Boolean option for checking the state integrity at startup, as --check-state-integrity does, and " +
+			"requesting the missing or corrupt trie nodes from the peers in order to repair the database. The node " +
+			"stops if the state is still broken afterwards",
+	}
+	// verifyBlockWitness defines a flag for the optional block witness verification mode
+	verifyBlockWitness = cli.StringFlag{
+		Name: "verify-block-witness",
+		Usage: "This flag, if set, will make the node re-execute the committed block with the provided hex encoded " +
+			"header hash, reading the state trie nodes only from the block witness saved in its storage, and then stop. " +
+			"The node's own state is neither read nor altered. Requires the BlockWitness.Enabled config option",
+		Value: "",
+	}
+
 	// importDbDirectory defines a flag for the optional import DB directory on which the node will re-check the blockchain against
 	importDbDirectory = cli.StringFlag{
 		Name: "import-db",
@@ -295,6 +330,42 @@ var (
 		Value: 0,
 		Usage: "This flag will specify the start in epoch value in import-db process",
 	}
+	// outportBackfill defines a flag for the optional outport backfill mode
+	outportBackfill = cli.BoolFlag{
+		Name: "outport-backfill",
+		Usage: "This flag, if set, will make the node re-emit the blocks already saved in its storage through the " +
+			"enabled outport drivers (e.g. ElasticSearch) and then stop, without connecting to the network or " +
+			"participating in consensus. Should be used on full archive nodes, in order to fill a new driver",
+	}
+	// outportBackfillStartEpoch defines a flag that specifies the epoch from which the outport backfill starts
+	outportBackfillStartEpoch = cli.Uint64Flag{
+		Name:  "outport-backfill-start-epoch",
+		Value: 0,
+		Usage: "This flag will specify the epoch whose start block is the first one re-emitted in outport backfill mode",
+	}
+	// outportBackfillFromNonce defines a flag that specifies the nonce from which the outport backfill starts
+	outportBackfillFromNonce = cli.Uint64Flag{
+		Name:  "outport-backfill-from-nonce",
+		Value: 0,
+		Usage: "This flag will specify the nonce of the first block re-emitted in outport backfill mode. If set, it " +
+			"takes precedence over the start epoch",
+	}
+	// outportBackfillToNonce defines a flag that specifies the nonce at which the outport backfill ends
+	outportBackfillToNonce = cli.Uint64Flag{
+		Name:  "outport-backfill-to-nonce",
+		Value: 0,
+		Usage: "This flag will specify the nonce of the last block re-emitted in outport backfill mode. If not set, " +
+			"the backfill ends with the last block found in the storage",
+	}
+	// secondaryOf defines a flag for the optional secondary mode
+	secondaryOf = cli.StringFlag{
+		Name: "secondary-of",
+		Usage: "This flag, if set, will make the node serve the API queries from the databases of the node running " +
+			"in the provided `directory`, following the blocks it commits. The node will not connect to the network, " +
+			"will not process blocks and will close the API routes needing these, so it can be used to add API " +
+			"observers without duplicating the storage of an existing observer",
+		Value: "",
+	}
 	// redundancyLevel defines a flag that specifies the level of redundancy used by the current instance for the node (-1 = disabled, 0 = main instance (default), 1 = first backup, 2 = second backup, etc.)
 	redundancyLevel = cli.Int64Flag{
 		Name:  "redundancy-level",
@@ -357,10 +428,19 @@ func getFlags() []cli.Flag {
 		numEpochsToSave,
 		numActivePersisters,
 		startInEpoch,
+		importSnapshotFile,
+		checkStateIntegrity,
+		repairState,
+		verifyBlockWitness,
 		importDbDirectory,
 		importDbNoSigCheck,
 		importDbSaveEpochRootHash,
 		importDbStartInEpoch,
+		outportBackfill,
+		outportBackfillStartEpoch,
+		outportBackfillFromNonce,
+		outportBackfillToNonce,
+		secondaryOf,
 		redundancyLevel,
 		fullArchive,
 		memBallast,
@@ -386,6 +466,10 @@ func getFlagsConfig(ctx *cli.Context, log logger.Logger) *config.ContextFlagsCon
 	flagsConfig.EnablePprof = ctx.GlobalBool(profileMode.Name)
 	flagsConfig.UseLogView = ctx.GlobalBool(useLogView.Name)
 	flagsConfig.ValidatorKeyIndex = ctx.GlobalInt(validatorKeyIndex.Name)
+	flagsConfig.ImportSnapshotFile = ctx.GlobalString(importSnapshotFile.Name)
+	flagsConfig.CheckStateIntegrity = ctx.GlobalBool(checkStateIntegrity.Name)
+	flagsConfig.RepairState = ctx.GlobalBool(repairState.Name)
+	flagsConfig.VerifyBlockWitness = ctx.GlobalString(verifyBlockWitness.Name)
 	return flagsConfig
 }
 
@@ -414,6 +498,10 @@ func applyFlags(ctx *cli.Context, cfgs *config.Configs, flagsConfig *config.Cont
 	if ctx.IsSet(fullArchive.Name) {
 		cfgs.PreferencesConfig.Preferences.FullArchive = ctx.GlobalBool(fullArchive.Name)
 	}
+	if ctx.IsSet(secondaryOf.Name) {
+		cfgs.GeneralConfig.SecondaryMode.Enabled = true
+		cfgs.GeneralConfig.SecondaryMode.PrimaryWorkingDirectory = ctx.GlobalString(secondaryOf.Name)
+	}
 
 	importDbDirectoryValue := ctx.GlobalString(importDbDirectory.Name)
 	importDBConfigs := &config.ImportDbConfig{
@@ -423,8 +511,15 @@ func applyFlags(ctx *cli.Context, cfgs *config.Configs, flagsConfig *config.Cont
 		ImportDbSaveTrieEpochRootHash: ctx.GlobalBool(importDbSaveEpochRootHash.Name),
 		ImportDBStartInEpoch:          uint32(ctx.GlobalUint64(importDbStartInEpoch.Name)),
 	}
+	outportBackfillConfigs := &config.OutportBackfillConfig{
+		IsBackfillMode: ctx.GlobalBool(outportBackfill.Name),
+		StartEpoch:     uint32(ctx.GlobalUint64(outportBackfillStartEpoch.Name)),
+		FromNonce:      ctx.GlobalUint64(outportBackfillFromNonce.Name),
+		ToNonce:        ctx.GlobalUint64(outportBackfillToNonce.Name),
+	}
 	cfgs.FlagsConfig = flagsConfig
 	cfgs.ImportDbConfig = importDBConfigs
+	cfgs.OutportBackfillConfig = outportBackfillConfigs
 	err := applyCompatibleConfigs(log, cfgs)
 	if err != nil {
 		return err
@@ -460,9 +555,51 @@ func applyCompatibleConfigs(log logger.Logger, configs *config.Configs) error {
 	importDbFlags.ImportDbSaveTrieEpochRootHash = importDbFlags.ImportDbSaveTrieEpochRootHash && importDbFlags.IsImportDBMode
 
 	if importDbFlags.IsImportDBMode {
+		if configs.OutportBackfillConfig.IsBackfillMode {
+			return errors.New("the outport backfill mode cannot be used together with the import-db mode")
+		}
+		if len(configs.FlagsConfig.ImportSnapshotFile) > 0 {
+			return errors.New("the import-snapshot flag cannot be used together with the import-db mode")
+		}
+		if configs.GeneralConfig.SecondaryMode.Enabled {
+			return errors.New("the secondary mode cannot be used together with the import-db mode")
+		}
+		if len(configs.FlagsConfig.VerifyBlockWitness) > 0 {
+			return errors.New("the block witness verification cannot be used together with the import-db mode")
+		}
+
 		return processConfigImportDBMode(log, configs)
 	}
 
+	if configs.GeneralConfig.SecondaryMode.Enabled {
+		if configs.OutportBackfillConfig.IsBackfillMode {
+			return errors.New("the outport backfill mode cannot be used together with the secondary mode")
+		}
+		if len(configs.FlagsConfig.ImportSnapshotFile) > 0 {
+			return errors.New("the import-snapshot flag cannot be used together with the secondary mode")
+		}
+		if configs.FlagsConfig.CheckStateIntegrity || configs.FlagsConfig.RepairState {
+			return errors.New("the state integrity check cannot be used together with the secondary mode")
+		}
+		if len(configs.FlagsConfig.VerifyBlockWitness) > 0 {
+			return errors.New("the block witness verification cannot be used together with the secondary mode")
+		}
+
+		return processConfigSecondaryMode(log, configs)
+	}
+
+	if configs.OutportBackfillConfig.IsBackfillMode {
+		if len(configs.FlagsConfig.VerifyBlockWitness) > 0 {
+			return errors.New("the block witness verification cannot be used together with the outport backfill mode")
+		}
+
+		return processConfigOutportBackfillMode(log, configs)
+	}
+
+	if len(configs.FlagsConfig.VerifyBlockWitness) > 0 {
+		return processConfigVerifyBlockWitnessMode(log, configs)
+	}
+
 	// if FullArchive is enabled, we override the conflicting StoragePruning settings and StartInEpoch as well
 	if configs.PreferencesConfig.Preferences.FullArchive {
 		return processConfigFullArchiveMode(log, configs)
@@ -543,6 +680,95 @@ func processConfigFullArchiveMode(log logger.Logger, configs *config.Configs) er
 	return nil
 }
 
+func processConfigOutportBackfillMode(log logger.Logger, configs *config.Configs) error {
+	generalConfigs := configs.GeneralConfig
+	p2pConfigs := configs.P2pConfig
+
+	// the blocks are read from the local storage, so the node should neither bootstrap from the network nor remove
+	// the old epochs data
+	generalConfigs.GeneralSettings.StartInEpochEnabled = false
+	generalConfigs.StoragePruning.ValidatorCleanOldEpochsData = false
+	generalConfigs.StoragePruning.ObserverCleanOldEpochsData = false
+	p2pConfigs.Node.ThresholdMinConnectedPeers = 0
+	p2pConfigs.KadDhtPeerDiscovery.Enabled = false
+
+	log.Warn("the node is in outport backfill mode! Will auto-set some config values",
+		"GeneralSettings.StartInEpochEnabled", generalConfigs.GeneralSettings.StartInEpochEnabled,
+		"StoragePruning.ValidatorCleanOldEpochsData", generalConfigs.StoragePruning.ValidatorCleanOldEpochsData,
+		"StoragePruning.ObserverCleanOldEpochsData", generalConfigs.StoragePruning.ObserverCleanOldEpochsData,
+		"p2p.ThresholdMinConnectedPeers", p2pConfigs.Node.ThresholdMinConnectedPeers,
+		"kad dht discoverer", "off",
+		"start epoch", configs.OutportBackfillConfig.StartEpoch,
+		"from nonce", configs.OutportBackfillConfig.FromNonce,
+		"to nonce", configs.OutportBackfillConfig.ToNonce,
+	)
+
+	return nil
+}
+
+func processConfigVerifyBlockWitnessMode(log logger.Logger, configs *config.Configs) error {
+	generalConfigs := configs.GeneralConfig
+	p2pConfigs := configs.P2pConfig
+
+	if !generalConfigs.BlockWitness.Enabled {
+		return errors.New("the block witness verification requires the BlockWitness.Enabled config option")
+	}
+	if configs.FlagsConfig.CheckStateIntegrity || configs.FlagsConfig.RepairState {
+		return errors.New("the state integrity check cannot be used together with the block witness verification")
+	}
+
+	// the block, its transactions and its witness are read from the local storage, so the node should neither
+	// bootstrap from the network nor remove the old epochs data
+	generalConfigs.GeneralSettings.StartInEpochEnabled = false
+	generalConfigs.StoragePruning.ValidatorCleanOldEpochsData = false
+	generalConfigs.StoragePruning.ObserverCleanOldEpochsData = false
+	p2pConfigs.Node.ThresholdMinConnectedPeers = 0
+	p2pConfigs.KadDhtPeerDiscovery.Enabled = false
+
+	log.Warn("the node is in block witness verification mode! Will auto-set some config values",
+		"GeneralSettings.StartInEpochEnabled", generalConfigs.GeneralSettings.StartInEpochEnabled,
+		"StoragePruning.ValidatorCleanOldEpochsData", generalConfigs.StoragePruning.ValidatorCleanOldEpochsData,
+		"StoragePruning.ObserverCleanOldEpochsData", generalConfigs.StoragePruning.ObserverCleanOldEpochsData,
+		"p2p.ThresholdMinConnectedPeers", p2pConfigs.Node.ThresholdMinConnectedPeers,
+		"kad dht discoverer", "off",
+		"header hash", configs.FlagsConfig.VerifyBlockWitness,
+	)
+
+	return nil
+}
+
+func processConfigSecondaryMode(log logger.Logger, configs *config.Configs) error {
+	generalConfigs := configs.GeneralConfig
+	secondaryConfig := generalConfigs.SecondaryMode
+
+	if filepath.Clean(secondaryConfig.PrimaryWorkingDirectory) == filepath.Clean(configs.FlagsConfig.WorkingDir) {
+		return errors.New("the secondary mode should follow the working directory of another node")
+	}
+
+	// the databases belong to the primary node, so the secondary node should neither bootstrap from the network nor
+	// alter them
+	generalConfigs.GeneralSettings.StartInEpochEnabled = false
+	generalConfigs.StoragePruning.ValidatorCleanOldEpochsData = false
+	generalConfigs.StoragePruning.ObserverCleanOldEpochsData = false
+	generalConfigs.StateTriesConfig.CheckpointsEnabled = false
+	generalConfigs.StateTriesConfig.AccountsStatePruningEnabled = false
+	generalConfigs.StateTriesConfig.PeerStatePruningEnabled = false
+	secondary.RestrictApiRoutes(configs.ApiRoutesConfig)
+
+	log.Warn("the node is in secondary mode! Will auto-set some config values and close the unsupported API routes",
+		"primary working directory", secondaryConfig.PrimaryWorkingDirectory,
+		"refresh interval in milliseconds", secondaryConfig.RefreshIntervalInMilliseconds,
+		"GeneralSettings.StartInEpochEnabled", generalConfigs.GeneralSettings.StartInEpochEnabled,
+		"StoragePruning.ValidatorCleanOldEpochsData", generalConfigs.StoragePruning.ValidatorCleanOldEpochsData,
+		"StoragePruning.ObserverCleanOldEpochsData", generalConfigs.StoragePruning.ObserverCleanOldEpochsData,
+		"StateTriesConfig.CheckpointsEnabled", generalConfigs.StateTriesConfig.CheckpointsEnabled,
+		"StateTriesConfig.AccountsStatePruningEnabled", generalConfigs.StateTriesConfig.AccountsStatePruningEnabled,
+		"StateTriesConfig.PeerStatePruningEnabled", generalConfigs.StateTriesConfig.PeerStatePruningEnabled,
+	)
+
+	return nil
+}
+
 func alterStorageConfigsForDBImport(config *config.Config) {
 	changeStorageConfigForDBImport(&config.MiniBlocksStorage)
 	changeStorageConfigForDBImport(&config.BlockHeaderStorage)
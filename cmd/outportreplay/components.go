@@ -0,0 +1,197 @@
+package main
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	covalentFactory "github.com/ElrondNetwork/covalent-indexer-go/factory"
+	indexerFactory "github.com/ElrondNetwork/elastic-indexer-go/factory"
+	hasherFactory "github.com/ElrondNetwork/elrond-go-core/hashing/factory"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	marshalizerFactory "github.com/ElrondNetwork/elrond-go-core/marshal/factory"
+	"github.com/ElrondNetwork/elrond-go/common"
+	commonFactory "github.com/ElrondNetwork/elrond-go/common/factory"
+	"github.com/ElrondNetwork/elrond-go/common/forking"
+	"github.com/ElrondNetwork/elrond-go/outport"
+	outportDriverFactory "github.com/ElrondNetwork/elrond-go/outport/factory"
+	"github.com/ElrondNetwork/elrond-go/outport/webhook"
+	"github.com/ElrondNetwork/elrond-go/process/economics"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	notifierFactory "github.com/ElrondNetwork/notifier-go/factory"
+)
+
+var errAccountsNotAvailable = errors.New("accounts are not available while replaying")
+
+type replayComponents struct {
+	marshalizer    marshal.Marshalizer
+	epochNotifier  epochNotifier
+	outportHandler outport.OutportHandler
+}
+
+// createComponents creates the outport handler, along with the drivers enabled in the external configuration.
+// The accounts state is not available, so the drivers that need it (e.g. ElasticSearch for the accounts indexes)
+// will only index the data found in the files
+func createComponents(cfg *config) (*replayComponents, error) {
+	generalConfig, err := common.LoadMainConfig(cfg.configFile)
+	if err != nil {
+		return nil, err
+	}
+	externalConfig, err := common.LoadExternalConfig(cfg.externalConfigFile)
+	if err != nil {
+		return nil, err
+	}
+	economicsConfig, err := common.LoadEconomicsConfig(cfg.economicsConfigFile)
+	if err != nil {
+		return nil, err
+	}
+	epochConfig, err := common.LoadEpochConfig(cfg.epochConfigFile)
+	if err != nil {
+		return nil, err
+	}
+
+	selfShardID, err := common.ProcessDestinationShardAsObserver(cfg.shard)
+	if err != nil {
+		return nil, err
+	}
+	shardCoordinator, err := sharding.NewMultiShardCoordinator(uint32(cfg.numShards), selfShardID)
+	if err != nil {
+		return nil, err
+	}
+
+	marshalizer, err := marshalizerFactory.NewMarshalizer(generalConfig.Marshalizer.Type)
+	if err != nil {
+		return nil, err
+	}
+	hasher, err := hasherFactory.NewHasher(generalConfig.Hasher.Type)
+	if err != nil {
+		return nil, err
+	}
+	addressPubKeyConverter, err := commonFactory.NewPubkeyConverter(generalConfig.AddressPubkeyConverter)
+	if err != nil {
+		return nil, err
+	}
+	validatorPubKeyConverter, err := commonFactory.NewPubkeyConverter(generalConfig.ValidatorPubkeyConverter)
+	if err != nil {
+		return nil, err
+	}
+
+	genericEpochNotifier := forking.NewGenericEpochNotifier()
+	gasScheduleNotifier, err := forking.NewGasScheduleNotifier(forking.ArgsNewGasScheduleNotifier{
+		GasScheduleConfig: epochConfig.GasSchedule,
+		ConfigDir:         cfg.gasScheduleDir,
+		EpochNotifier:     genericEpochNotifier,
+		ArwenChangeLocker: &sync.RWMutex{},
+	})
+	if err != nil {
+		return nil, err
+	}
+	builtInCostHandler, err := economics.NewBuiltInFunctionsCost(&economics.ArgsBuiltInFunctionCost{
+		ArgsParser:  smartContract.NewArgumentParser(),
+		GasSchedule: gasScheduleNotifier,
+	})
+	if err != nil {
+		return nil, err
+	}
+	economicsData, err := economics.NewEconomicsData(economics.ArgsNewEconomicsData{
+		Economics:                      economicsConfig,
+		PenalizedTooMuchGasEnableEpoch: epochConfig.EnableEpochs.PenalizedTooMuchGasEnableEpoch,
+		GasPriceModifierEnableEpoch:    epochConfig.EnableEpochs.GasPriceModifierEnableEpoch,
+		EpochNotifier:                  genericEpochNotifier,
+		BuiltInFunctionsCostHandler:    builtInCostHandler,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	accounts := &unavailableAccounts{}
+	elasticConfig := externalConfig.ElasticSearchConnector
+	notifierConfig := externalConfig.EventNotifierConnector
+	covalentConfig := externalConfig.CovalentConnector
+	webhookConfig := externalConfig.WebhookConnector
+	outportHandler, err := outportDriverFactory.CreateOutport(&outportDriverFactory.OutportFactoryArgs{
+		RetrialInterval: common.RetrialIntervalForOutportDriver,
+		ElasticIndexerFactoryArgs: &indexerFactory.ArgsIndexerFactory{
+			Enabled:                  elasticConfig.Enabled,
+			IndexerCacheSize:         elasticConfig.IndexerCacheSize,
+			ShardCoordinator:         shardCoordinator,
+			Url:                      elasticConfig.URL,
+			UserName:                 elasticConfig.Username,
+			Password:                 elasticConfig.Password,
+			Marshalizer:              marshalizer,
+			Hasher:                   hasher,
+			AddressPubkeyConverter:   addressPubKeyConverter,
+			ValidatorPubkeyConverter: validatorPubKeyConverter,
+			EnabledIndexes:           elasticConfig.EnabledIndexes,
+			AccountsDB:               accounts,
+			Denomination:             economicsConfig.GlobalSettings.Denomination,
+			TransactionFeeCalculator: economicsData,
+			UseKibana:                elasticConfig.UseKibana,
+		},
+		EventNotifierFactoryArgs: &notifierFactory.EventNotifierFactoryArgs{
+			Enabled:          notifierConfig.Enabled,
+			UseAuthorization: notifierConfig.UseAuthorization,
+			ProxyUrl:         notifierConfig.ProxyUrl,
+			Username:         notifierConfig.Username,
+			Password:         notifierConfig.Password,
+			Marshalizer:      marshalizer,
+			Hasher:           hasher,
+		},
+		CovalentIndexerFactoryArgs: &covalentFactory.ArgsCovalentIndexerFactory{
+			Enabled:              covalentConfig.Enabled,
+			URL:                  covalentConfig.URL,
+			RouteSendData:        covalentConfig.RouteSendData,
+			RouteAcknowledgeData: covalentConfig.RouteAcknowledgeData,
+			PubKeyConverter:      addressPubKeyConverter,
+			Accounts:             accounts,
+			Hasher:               hasher,
+			Marshaller:           marshalizer,
+			ShardCoordinator:     shardCoordinator,
+		},
+		WebhookDriverFactoryArgs: &outportDriverFactory.WebhookDriverFactoryArgs{
+			Enabled: webhookConfig.Enabled,
+			ArgsWebhookDriver: webhook.ArgsWebhookDriver{
+				Endpoints:              webhookConfig.Endpoints,
+				HMACSecret:             webhookConfig.HMACSecret,
+				BatchSize:              webhookConfig.BatchSize,
+				RequestTimeout:         time.Duration(webhookConfig.RequestTimeoutInSeconds) * time.Second,
+				MaxRetries:             webhookConfig.MaxRetries,
+				InitialBackoff:         time.Duration(webhookConfig.InitialBackoffInMilliseconds) * time.Millisecond,
+				MaxBackoff:             time.Duration(webhookConfig.MaxBackoffInMilliseconds) * time.Millisecond,
+				FlushInterval:          time.Duration(webhookConfig.FlushIntervalInSeconds) * time.Second,
+				Marshalizer:            marshalizer,
+				Hasher:                 hasher,
+				AddressPubKeyConverter: addressPubKeyConverter,
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !outportHandler.HasDrivers() {
+		_ = outportHandler.Close()
+		return nil, errNoDriversEnabled
+	}
+
+	return &replayComponents{
+		marshalizer:    marshalizer,
+		epochNotifier:  genericEpochNotifier,
+		outportHandler: outportHandler,
+	}, nil
+}
+
+// unavailableAccounts is used instead of the accounts state, which is not available while replaying
+type unavailableAccounts struct {
+}
+
+// LoadAccount returns an error, as the accounts are not available
+func (ua *unavailableAccounts) LoadAccount(_ []byte) (vmcommon.AccountHandler, error) {
+	return nil, errAccountsNotAvailable
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ua *unavailableAccounts) IsInterfaceNil() bool {
+	return ua == nil
+}
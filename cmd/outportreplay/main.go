@@ -0,0 +1,206 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"runtime"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/outport/files"
+	"github.com/urfave/cli"
+)
+
+type config struct {
+	inputDirectory      string
+	configFile          string
+	externalConfigFile  string
+	economicsConfigFile string
+	epochConfigFile     string
+	gasScheduleDir      string
+	numShards           uint
+	shard               string
+	logLevel            string
+}
+
+var (
+	helpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+	// inputDirectory defines a flag for the directory holding the files written by the outport files driver
+	inputDirectory = cli.StringFlag{
+		Name:        "input-directory",
+		Usage:       "The `directory` holding the files written by the node's outport files driver (OutportFiles section from external.toml).",
+		Value:       "./outport-files",
+		Destination: &argsConfig.inputDirectory,
+	}
+	// configurationFile defines a flag for the path to the main toml configuration file
+	configurationFile = cli.StringFlag{
+		Name:        "config",
+		Usage:       "The `filepath` for the main configuration file, used for the marshalizer, hasher and public keys converters.",
+		Value:       "./config/config.toml",
+		Destination: &argsConfig.configFile,
+	}
+	// externalConfigFile defines a flag for the path to the external toml configuration file
+	externalConfigFile = cli.StringFlag{
+		Name:        "config-external",
+		Usage:       "The `filepath` for the external configuration file. The drivers enabled in it will receive the replayed data.",
+		Value:       "./config/external.toml",
+		Destination: &argsConfig.externalConfigFile,
+	}
+	// economicsConfigFile defines a flag for the path to the economics toml configuration file
+	economicsConfigFile = cli.StringFlag{
+		Name:        "config-economics",
+		Usage:       "The `filepath` for the economics configuration file, used for the transactions fees computation.",
+		Value:       "./config/economics.toml",
+		Destination: &argsConfig.economicsConfigFile,
+	}
+	// epochConfigFile defines a flag for the path to the enable epochs toml configuration file
+	epochConfigFile = cli.StringFlag{
+		Name:        "epoch-config",
+		Usage:       "The `filepath` for the epoch configuration file, used for the transactions fees computation.",
+		Value:       "./config/enableEpochs.toml",
+		Destination: &argsConfig.epochConfigFile,
+	}
+	// gasScheduleDirectory defines a flag for the path to the directory containing the gas costs
+	gasScheduleDirectory = cli.StringFlag{
+		Name:        "gas-costs-config",
+		Usage:       "The `path` to the directory containing the gas costs, used for the transactions fees computation.",
+		Value:       "./config/gasSchedules",
+		Destination: &argsConfig.gasScheduleDir,
+	}
+	// numShards defines a flag for the number of shards of the network the files were written in
+	numShards = cli.UintFlag{
+		Name:        "num-shards",
+		Usage:       "The number of shards of the network the files were written in.",
+		Value:       3,
+		Destination: &argsConfig.numShards,
+	}
+	// shard defines a flag for the shard of the node that wrote the files
+	shard = cli.StringFlag{
+		Name:        "shard",
+		Usage:       "The shard of the node that wrote the files. Can be a shard number or metachain.",
+		Value:       "0",
+		Destination: &argsConfig.shard,
+	}
+	// logLevel defines the logger level
+	logLevel = cli.StringFlag{
+		Name: "log-level",
+		Usage: "This flag specifies the logger `level(s)`. It can contain multiple comma-separated value. For example" +
+			", if set to *:INFO the logs for all packages will have the INFO level. However, if set to *:INFO,api:DEBUG" +
+			" the logs for all packages will have the INFO level, excepting the api package which will receive a DEBUG" +
+			" log level.",
+		Value:       "*:" + logger.LogInfo.String(),
+		Destination: &argsConfig.logLevel,
+	}
+
+	argsConfig = &config{}
+
+	log = logger.GetOrCreate("outportreplay")
+)
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = helpTemplate
+	app.Name = "Elrond Outport Replay Tool"
+	app.Version = fmt.Sprintf("%s/%s/%s-%s", "1.0.0", runtime.Version(), runtime.GOOS, runtime.GOARCH)
+	app.Usage = "Replays the files written by the outport files driver into the drivers enabled in the external configuration"
+	app.Flags = []cli.Flag{
+		inputDirectory,
+		configurationFile,
+		externalConfigFile,
+		economicsConfigFile,
+		epochConfigFile,
+		gasScheduleDirectory,
+		numShards,
+		shard,
+		logLevel,
+	}
+	app.Authors = []cli.Author{
+		{
+			Name:  "The Elrond Team",
+			Email: "contact@elrond.com",
+		},
+	}
+
+	app.Action = func(_ *cli.Context) error {
+		return startReplay()
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+func startReplay() error {
+	err := logger.SetLogLevel(argsConfig.logLevel)
+	if err != nil {
+		return err
+	}
+
+	components, err := createComponents(argsConfig)
+	if err != nil {
+		return err
+	}
+
+	reader, err := files.NewFilesReader(files.ArgsFilesReader{
+		Directory:   argsConfig.inputDirectory,
+		Marshalizer: components.marshalizer,
+	})
+	if err != nil {
+		return err
+	}
+
+	driver, err := newOutportDriver(components.outportHandler, components.epochNotifier)
+	if err != nil {
+		return err
+	}
+
+	closeOnce := sync.Once{}
+	closeOutport := func() {
+		closeOnce.Do(func() {
+			log.LogIfError(components.outportHandler.Close())
+		})
+	}
+
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		<-sigs
+		log.Info("terminating at user's signal...")
+		driver.stop()
+		closeOutport()
+	}()
+
+	log.Info("replay started", "input directory", argsConfig.inputDirectory)
+	start := time.Now()
+	statistics, err := reader.Replay(driver)
+	if statistics != nil {
+		log.Info("replay ended",
+			"files", statistics.NumFiles,
+			"operations", statistics.NumOperations,
+			"blocks", statistics.NumBlocks,
+			"duration", time.Since(start))
+	}
+
+	closeOutport()
+
+	return err
+}
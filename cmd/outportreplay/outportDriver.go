@@ -0,0 +1,144 @@
+package main
+
+import (
+	"errors"
+	"sync/atomic"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go/outport"
+)
+
+var errNilOutportHandler = errors.New("nil outport handler")
+
+var errNilEpochNotifier = errors.New("nil epoch notifier")
+
+var errNoDriversEnabled = errors.New("no drivers are enabled in the external configuration")
+
+var errReplayInterrupted = errors.New("replay interrupted")
+
+type epochNotifier interface {
+	CheckEpoch(header data.HeaderHandler)
+}
+
+// outportDriver exposes the outport handler as a driver, so that the files reader can replay the calls into all the
+// subscribed drivers. The outport handler retries each call until the drivers accept it. Before each block is saved,
+// the epoch notifier is updated, so that the epoch dependent components (e.g. fees computation) behave as they
+// did when the block was indexed by the node
+type outportDriver struct {
+	outportHandler outport.OutportHandler
+	epochNotifier  epochNotifier
+	stopped        uint32
+}
+
+func newOutportDriver(outportHandler outport.OutportHandler, notifier epochNotifier) (*outportDriver, error) {
+	if check.IfNil(outportHandler) {
+		return nil, errNilOutportHandler
+	}
+	if notifier == nil {
+		return nil, errNilEpochNotifier
+	}
+
+	return &outportDriver{
+		outportHandler: outportHandler,
+		epochNotifier:  notifier,
+	}, nil
+}
+
+// SaveBlock updates the epoch notifier and calls the outport handler
+func (od *outportDriver) SaveBlock(args *indexer.ArgsSaveBlockData) error {
+	if od.isStopped() {
+		return errReplayInterrupted
+	}
+
+	od.epochNotifier.CheckEpoch(args.Header)
+	od.outportHandler.SaveBlock(args)
+
+	return nil
+}
+
+// RevertIndexedBlock calls the outport handler
+func (od *outportDriver) RevertIndexedBlock(header data.HeaderHandler, body data.BodyHandler) error {
+	if od.isStopped() {
+		return errReplayInterrupted
+	}
+
+	od.outportHandler.RevertIndexedBlock(header, body)
+
+	return nil
+}
+
+// SaveRoundsInfo calls the outport handler
+func (od *outportDriver) SaveRoundsInfo(roundsInfos []*indexer.RoundInfo) error {
+	if od.isStopped() {
+		return errReplayInterrupted
+	}
+
+	od.outportHandler.SaveRoundsInfo(roundsInfos)
+
+	return nil
+}
+
+// SaveValidatorsPubKeys calls the outport handler
+func (od *outportDriver) SaveValidatorsPubKeys(validatorsPubKeys map[uint32][][]byte, epoch uint32) error {
+	if od.isStopped() {
+		return errReplayInterrupted
+	}
+
+	od.outportHandler.SaveValidatorsPubKeys(validatorsPubKeys, epoch)
+
+	return nil
+}
+
+// SaveValidatorsRating calls the outport handler
+func (od *outportDriver) SaveValidatorsRating(indexID string, infoRating []*indexer.ValidatorRatingInfo) error {
+	if od.isStopped() {
+		return errReplayInterrupted
+	}
+
+	od.outportHandler.SaveValidatorsRating(indexID, infoRating)
+
+	return nil
+}
+
+// SaveAccounts calls the outport handler
+func (od *outportDriver) SaveAccounts(blockTimestamp uint64, acc []data.UserAccountHandler) error {
+	if od.isStopped() {
+		return errReplayInterrupted
+	}
+
+	od.outportHandler.SaveAccounts(blockTimestamp, acc)
+
+	return nil
+}
+
+// FinalizedBlock calls the outport handler
+func (od *outportDriver) FinalizedBlock(headerHash []byte) error {
+	if od.isStopped() {
+		return errReplayInterrupted
+	}
+
+	od.outportHandler.FinalizedBlock(headerHash)
+
+	return nil
+}
+
+func (od *outportDriver) isStopped() bool {
+	return atomic.LoadUint32(&od.stopped) == 1
+}
+
+// stop makes the next calls fail, ending the replay
+func (od *outportDriver) stop() {
+	atomic.StoreUint32(&od.stopped, 1)
+}
+
+// Close does nothing, as the outport handler is closed separately
+func (od *outportDriver) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (od *outportDriver) IsInterfaceNil() bool {
+	return od == nil
+}
@@ -168,6 +168,21 @@ const MetricNetworkSentBps = "erd_network_sent_bps"
 // MetricNetworkSentBpsPeak is the metric for monitoring network sent peak bytes per second
 const MetricNetworkSentBpsPeak = "erd_network_sent_bps_peak"
 
+// MetricStorageNumPutsPrefix is the prefix of the metrics holding the number of put operations done on each storage unit
+const MetricStorageNumPutsPrefix = "erd_storage_num_puts_"
+
+// MetricStorageNumGetsPrefix is the prefix of the metrics holding the number of get operations done on each storage unit
+const MetricStorageNumGetsPrefix = "erd_storage_num_gets_"
+
+// MetricStorageNumMissesPrefix is the prefix of the metrics holding the number of keys not found in each storage unit
+const MetricStorageNumMissesPrefix = "erd_storage_num_misses_"
+
+// MetricStorageBytesWrittenPrefix is the prefix of the metrics holding the number of bytes written in each storage unit
+const MetricStorageBytesWrittenPrefix = "erd_storage_bytes_written_"
+
+// MetricStorageBytesReadPrefix is the prefix of the metrics holding the number of bytes read from each storage unit
+const MetricStorageBytesReadPrefix = "erd_storage_bytes_read_"
+
 // MetricRoundTime is the metric for round time in seconds
 const MetricRoundTime = "erd_round_time"
 
@@ -527,6 +542,14 @@ const MetricP2PUnknownPeers = "erd_p2p_unknown_shard_peers"
 // MetricP2PNumConnectedPeersClassification is the metric for monitoring the number of connected peers split on the connection type
 const MetricP2PNumConnectedPeersClassification = "erd_p2p_num_connected_peers_classification"
 
+// MetricOutportQueuePendingOperations is the metric prefix for the number of operations waiting in the persistent
+// queue of an outport driver. The driver name is appended to the prefix
+const MetricOutportQueuePendingOperations = "erd_outport_queue_pending_operations"
+
+// MetricOutportQueuePendingBlocks is the metric prefix for the number of saved blocks not yet delivered from the
+// persistent queue of an outport driver. The driver name is appended to the prefix
+const MetricOutportQueuePendingBlocks = "erd_outport_queue_pending_blocks"
+
 // HighestRoundFromBootStorage is the key for the highest round that is saved in storage
 const HighestRoundFromBootStorage = "highestRoundFromBootStorage"
 
@@ -1,8 +1,178 @@
 package common
 
+import "github.com/ElrondNetwork/elrond-go-core/data/api"
+
 // GetProofResponse is a struct that stores the response of a GetProof API request
 type GetProofResponse struct {
 	Proof    [][]byte
 	Value    []byte
 	RootHash string
 }
+
+// GetMultiProofResponse is a struct that stores the response of a multi-key or range proof API request
+type GetMultiProofResponse struct {
+	Proof    [][]byte
+	Keys     [][]byte
+	Values   [][]byte
+	RootHash string
+}
+
+// TrieLeafDiff holds a leaf that differs between two versions of a trie. The old value is nil for an added leaf,
+// while the new value is nil for a removed leaf
+type TrieLeafDiff struct {
+	Key      []byte
+	OldValue []byte
+	NewValue []byte
+}
+
+// TrieIntegrityReport holds the result of a trie integrity check. A node is missing if it is not found in storage
+// and it is corrupt if its stored bytes do not hash to its key or can not be decoded
+type TrieIntegrityReport struct {
+	NumNodes     uint64
+	NumLeaves    uint64
+	MissingNodes [][]byte
+	CorruptNodes [][]byte
+}
+
+// AccountTransaction holds the coordinates of a transaction in which an account was involved
+type AccountTransaction struct {
+	Hash       string `json:"hash"`
+	BlockHash  string `json:"blockHash"`
+	BlockNonce uint64 `json:"blockNonce"`
+	Round      uint64 `json:"round"`
+	Epoch      uint32 `json:"epoch"`
+}
+
+// AccountTransactionsResponse is a struct that stores the response of an account transactions history API request
+type AccountTransactionsResponse struct {
+	Transactions []*AccountTransaction `json:"transactions"`
+	NextCursor   uint64                `json:"nextCursor"`
+}
+
+// BlocksQueryOptions holds the options used when fetching a range of blocks
+type BlocksQueryOptions struct {
+	WithTxs     bool
+	HeadersOnly bool
+}
+
+// AccountQueryOptions holds the options used when querying the state of the accounts. When no block is selected,
+// either by its nonce or by its hash, the query is answered from the current state
+type AccountQueryOptions struct {
+	BlockNonce    uint64
+	HasBlockNonce bool
+	BlockHash     []byte
+}
+
+// IsBlockSelected returns true if the query has to be answered from the state of a past block
+func (options AccountQueryOptions) IsBlockSelected() bool {
+	return options.HasBlockNonce || len(options.BlockHash) > 0
+}
+
+// BlocksRangeResponse is a struct that stores the response of a blocks range API request
+type BlocksRangeResponse struct {
+	Blocks    []*api.Block `json:"blocks"`
+	NextNonce uint64       `json:"nextNonce"`
+	HasMore   bool         `json:"hasMore"`
+}
+
+// TxPoolCacheCounters holds the counters of one of the caches of the transactions pool
+type TxPoolCacheCounters struct {
+	Name                 string `json:"name"`
+	NumTxs               uint64 `json:"numTxs"`
+	NumSenders           uint64 `json:"numSenders"`
+	NumBytes             int    `json:"numBytes"`
+	IsEvictionEnabled    bool   `json:"isEvictionEnabled"`
+	IsEvictionInProgress bool   `json:"isEvictionInProgress"`
+	IsCapacityExceeded   bool   `json:"isCapacityExceeded"`
+}
+
+// TxPoolCountersResponse is a struct that stores the response of a transactions pool counters API request
+type TxPoolCountersResponse struct {
+	NumTxs     uint64                 `json:"numTxs"`
+	NumSenders uint64                 `json:"numSenders"`
+	NumBytes   int                    `json:"numBytes"`
+	Caches     []*TxPoolCacheCounters `json:"caches"`
+}
+
+// TxPoolTransaction holds a transaction as found in the transactions pool
+type TxPoolTransaction struct {
+	Hash          string `json:"hash"`
+	Cache         string `json:"cache,omitempty"`
+	Nonce         uint64 `json:"nonce"`
+	Sender        string `json:"sender"`
+	Receiver      string `json:"receiver"`
+	Value         string `json:"value"`
+	GasPrice      uint64 `json:"gasPrice"`
+	GasLimit      uint64 `json:"gasLimit"`
+	Data          []byte `json:"data,omitempty"`
+	SenderShard   uint32 `json:"senderShard"`
+	ReceiverShard uint32 `json:"receiverShard"`
+	Size          int64  `json:"size"`
+}
+
+// TxPoolNonceGap holds an (inclusive) range of nonces missing from the transactions of a sender
+type TxPoolNonceGap struct {
+	FromNonce uint64 `json:"fromNonce"`
+	ToNonce   uint64 `json:"toNonce"`
+}
+
+// TxPoolSenderCache holds the state of a sender in one of the caches of the transactions pool
+type TxPoolSenderCache struct {
+	Cache               string               `json:"cache"`
+	AccountNonce        uint64               `json:"accountNonce"`
+	IsAccountNonceKnown bool                 `json:"isAccountNonceKnown"`
+	NonceGaps           []*TxPoolNonceGap    `json:"nonceGaps"`
+	Score               uint32               `json:"score"`
+	TotalBytes          uint64               `json:"totalBytes"`
+	TotalGas            uint64               `json:"totalGas"`
+	NumFailedSelections uint64               `json:"numFailedSelections"`
+	IsInGracePeriod     bool                 `json:"isInGracePeriod"`
+	IsSweepable         bool                 `json:"isSweepable"`
+	IsCapacityExceeded  bool                 `json:"isCapacityExceeded"`
+	Transactions        []*TxPoolTransaction `json:"transactions"`
+}
+
+// TxPoolSenderResponse is a struct that stores the response of a transactions pool API request for a sender
+type TxPoolSenderResponse struct {
+	Sender string               `json:"sender"`
+	Caches []*TxPoolSenderCache `json:"caches"`
+}
+
+// DataTrieChangeResponse holds a data trie key written by a block, together with its values before and after the block
+type DataTrieChangeResponse struct {
+	Key      string `json:"key"`
+	OldValue string `json:"oldValue"`
+	NewValue string `json:"newValue"`
+}
+
+// AccountStateChangeResponse holds the changes done by a block on an account
+type AccountStateChangeResponse struct {
+	Address         string                    `json:"address"`
+	IsCreated       bool                      `json:"isCreated,omitempty"`
+	IsRemoved       bool                      `json:"isRemoved,omitempty"`
+	OldBalance      string                    `json:"oldBalance"`
+	NewBalance      string                    `json:"newBalance"`
+	OldNonce        uint64                    `json:"oldNonce"`
+	NewNonce        uint64                    `json:"newNonce"`
+	OldCodeHash     string                    `json:"oldCodeHash,omitempty"`
+	NewCodeHash     string                    `json:"newCodeHash,omitempty"`
+	OldUsername     string                    `json:"oldUsername,omitempty"`
+	NewUsername     string                    `json:"newUsername,omitempty"`
+	DataTrieChanges []*DataTrieChangeResponse `json:"dataTrieChanges,omitempty"`
+}
+
+// BlockStateChangesResponse is a struct that stores the response of a block state changes API request
+type BlockStateChangesResponse struct {
+	BlockHash  string                        `json:"blockHash"`
+	BlockNonce uint64                        `json:"blockNonce"`
+	Accounts   []*AccountStateChangeResponse `json:"accounts"`
+}
+
+// StateDiffResponse is a struct that stores the response of a state diff API request
+type StateDiffResponse struct {
+	FromRootHash string                        `json:"fromRootHash"`
+	ToRootHash   string                        `json:"toRootHash"`
+	Accounts     []*AccountStateChangeResponse `json:"accounts"`
+	HasMore      bool                          `json:"hasMore"`
+	Cursor       string                        `json:"cursor,omitempty"`
+}
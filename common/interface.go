@@ -33,6 +33,12 @@ type Trie interface {
 	GetAllHashes() ([][]byte, error)
 	GetProof(key []byte) ([][]byte, []byte, error)
 	VerifyProof(rootHash []byte, key []byte, proof [][]byte) (bool, error)
+	GetMultiProof(keys [][]byte) ([][]byte, [][]byte, error)
+	GetRangeProof(keySuffix []byte, maxNumNodes uint32) ([][]byte, []core.KeyValueHolder, error)
+	VerifyMultiProof(rootHash []byte, keys [][]byte, values [][]byte, proof [][]byte) (bool, error)
+	VerifyRangeProof(rootHash []byte, keySuffix []byte, leaves []core.KeyValueHolder, proof [][]byte) (bool, error)
+	GetLeavesDiff(fromRootHash []byte, toRootHash []byte, startKey []byte, handler func(diff TrieLeafDiff) bool) error
+	CheckIntegrity(rootHash []byte, leafHandler func(key []byte, value []byte) error) (*TrieIntegrityReport, error)
 	GetStorageManager() StorageManager
 	Close() error
 	IsInterfaceNil() bool
@@ -104,6 +110,8 @@ type Locker interface {
 // MerkleProofVerifier is used to verify merkle proofs
 type MerkleProofVerifier interface {
 	VerifyProof(rootHash []byte, key []byte, proof [][]byte) (bool, error)
+	VerifyMultiProof(rootHash []byte, keys [][]byte, values [][]byte, proof [][]byte) (bool, error)
+	VerifyRangeProof(rootHash []byte, keySuffix []byte, leaves []core.KeyValueHolder, proof [][]byte) (bool, error)
 }
 
 // SizeSyncStatisticsHandler extends the SyncStatisticsHandler interface by allowing setting up the trie node size
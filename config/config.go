@@ -25,6 +25,16 @@ type DBConfig struct {
 	MaxBatchSize      int
 	MaxOpenFiles      int
 	UseTmpAsFilePath  bool
+	Compression       DBCompressionConfig
+}
+
+// DBCompressionConfig will map the values compression configuration of a database
+type DBCompressionConfig struct {
+	Type                      string
+	Level                     int
+	MinValueSizeInBytes       int
+	DictionaryTrainingSamples int
+	DictionarySizeInBytes     int
 }
 
 // StorageConfig will map the storage unit configuration
@@ -170,6 +180,9 @@ type Config struct {
 	Consensus           ConsensusConfig
 	StoragePruning      StoragePruningConfig
 	LogsAndEvents       LogsAndEventsConfig
+	StateChanges        StateChangesConfig
+	BlockWitness        BlockWitnessConfig
+	SecondaryMode       SecondaryModeConfig
 
 	NTPConfig               NTPConfig
 	HeadersPoolConfig       HeadersPoolConfig
@@ -202,6 +215,25 @@ type StoragePruningConfig struct {
 	NumEpochsToKeep                uint64
 	NumActivePersisters            uint64
 	FullArchiveNumActivePersisters uint32
+	ColdStorage                    ColdStorageConfig
+}
+
+// ColdStorageConfig will hold the settings used when sealing the old epochs databases into immutable segment files
+type ColdStorageConfig struct {
+	Enabled                bool
+	Path                   string
+	NumEpochsBeforeSealing uint32
+	BlockSizeInBytes       int
+	CompressionType        string
+	CompressionLevel       int
+	NumCachedBlocks        int
+}
+
+// SecondaryModeConfig will hold the settings of a node serving the API queries from the databases of a primary node
+type SecondaryModeConfig struct {
+	Enabled                       bool
+	PrimaryWorkingDirectory       string
+	RefreshIntervalInMilliseconds int
 }
 
 // ResourceStatsConfig will hold all resource stats settings
@@ -284,6 +316,11 @@ type WebServerAntifloodConfig struct {
 	SameSourceRequests           uint32
 	SameSourceResetIntervalInSec uint32
 	EndpointsThrottlers          []EndpointsThrottlersConfig
+	GraphQLMaxQueryDepth         uint32
+	GraphQLMaxQueryComplexity    uint32
+	BatchMaxSubRequests          uint32
+	RangeProofMaxTrieNodes       uint32
+	StateDiffMaxDataTrieChanges  uint32
 }
 
 // BlackListConfig will hold the p2p peer black list threshold values
@@ -398,6 +435,19 @@ type LogsAndEventsConfig struct {
 	TxLogsStorage        StorageConfig
 }
 
+// StateChangesConfig will hold the configuration of the state changes recorded for each committed block
+type StateChangesConfig struct {
+	Enabled              bool
+	SaveInOutportEnabled bool
+	StateChangesStorage  StorageConfig
+}
+
+// BlockWitnessConfig will hold the configuration of the witnesses recorded for each committed block
+type BlockWitnessConfig struct {
+	Enabled             bool
+	BlockWitnessStorage StorageConfig
+}
+
 // DbLookupExtensionsConfig holds the configuration for the db lookup extensions
 type DbLookupExtensionsConfig struct {
 	Enabled                            bool
@@ -408,6 +458,7 @@ type DbLookupExtensionsConfig struct {
 	ResultsHashesByTxHashStorageConfig StorageConfig
 	ESDTSuppliesStorageConfig          StorageConfig
 	RoundHashStorageConfig             StorageConfig
+	AccountTransactionsStorageConfig   StorageConfig
 }
 
 // DebugConfig will hold debugging configuration
@@ -507,6 +558,7 @@ type Configs struct {
 	P2pConfig                *P2PConfig
 	FlagsConfig              *ContextFlagsConfig
 	ImportDbConfig           *ImportDbConfig
+	OutportBackfillConfig    *OutportBackfillConfig
 	ConfigurationPathsHolder *ConfigurationPathsHolder
 	EpochConfig              *EpochConfig
 	RoundConfig              *RoundConfig
@@ -20,6 +20,10 @@ type ContextFlagsConfig struct {
 	ValidatorKeyIndex            int
 	EnableRestAPIServerDebugMode bool
 	Version                      string
+	ImportSnapshotFile           string
+	CheckStateIntegrity          bool
+	RepairState                  bool
+	VerifyBlockWitness           string
 }
 
 // ImportDbConfig will hold the import-db parameters
@@ -31,3 +35,11 @@ type ImportDbConfig struct {
 	ImportDbNoSigCheckFlag        bool
 	ImportDbSaveTrieEpochRootHash bool
 }
+
+// OutportBackfillConfig will hold the outport backfill parameters
+type OutportBackfillConfig struct {
+	IsBackfillMode bool
+	StartEpoch     uint32
+	FromNonce      uint64
+	ToNonce        uint64
+}
@@ -5,6 +5,10 @@ type ExternalConfig struct {
 	ElasticSearchConnector ElasticSearchConfig
 	EventNotifierConnector EventNotifierConfig
 	CovalentConnector      CovalentConfig
+	EventsSubscriptions    EventsSubscriptionsConfig
+	OutportQueue           OutportQueueConfig
+	WebhookConnector       WebhookConnectorConfig
+	OutportFiles           OutportFilesConfig
 }
 
 // ElasticSearchConfig will hold the configuration for the elastic search
@@ -34,3 +38,37 @@ type CovalentConfig struct {
 	RouteSendData        string
 	RouteAcknowledgeData string
 }
+
+// EventsSubscriptionsConfig will hold the configuration for the websocket events subscriptions
+type EventsSubscriptionsConfig struct {
+	Enabled                bool
+	MaxSubscribers         int
+	SubscriptionBufferSize int
+}
+
+// OutportQueueConfig will hold the configuration for the persistent queues of the outport drivers
+type OutportQueueConfig struct {
+	Enabled bool
+	DB      DBConfig
+}
+
+// WebhookConnectorConfig will hold the configuration for the generic webhook driver
+type WebhookConnectorConfig struct {
+	Enabled                      bool
+	Endpoints                    []string
+	HMACSecret                   string
+	BatchSize                    int
+	RequestTimeoutInSeconds      int
+	MaxRetries                   int
+	InitialBackoffInMilliseconds int
+	MaxBackoffInMilliseconds     int
+	FlushIntervalInSeconds       int
+}
+
+// OutportFilesConfig will hold the configuration for the outport files driver
+type OutportFilesConfig struct {
+	Enabled         bool
+	Directory       string
+	Format          string
+	MaxFileSizeInMB uint64
+}
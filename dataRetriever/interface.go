@@ -45,6 +45,34 @@ func (ut UnitType) String() string {
 		return "TrieEpochRootHashUnit"
 	case ScheduledSCRsUnit:
 		return "ScheduledSCRsUnit"
+	case TxLogsUnit:
+		return "TxLogsUnit"
+	case MiniblocksMetadataUnit:
+		return "MiniblocksMetadataUnit"
+	case EpochByHashUnit:
+		return "EpochByHashUnit"
+	case MiniblockHashByTxHashUnit:
+		return "MiniblockHashByTxHashUnit"
+	case ResultsHashesByTxHashUnit:
+		return "ResultsHashesByTxHashUnit"
+	case ESDTSuppliesUnit:
+		return "ESDTSuppliesUnit"
+	case RoundHdrHashDataUnit:
+		return "RoundHdrHashDataUnit"
+	case UserAccountsUnit:
+		return "UserAccountsUnit"
+	case UserAccountsCheckpointsUnit:
+		return "UserAccountsCheckpointsUnit"
+	case PeerAccountsUnit:
+		return "PeerAccountsUnit"
+	case PeerAccountsCheckpointsUnit:
+		return "PeerAccountsCheckpointsUnit"
+	case AccountTransactionsUnit:
+		return "AccountTransactionsUnit"
+	case BlockStateChangesUnit:
+		return "BlockStateChangesUnit"
+	case BlockWitnessUnit:
+		return "BlockWitnessUnit"
 	}
 
 	if ut < ShardHdrNonceHashDataUnit {
@@ -105,6 +133,12 @@ const (
 	PeerAccountsCheckpointsUnit UnitType = 23
 	// ScheduledSCRsUnit is the scheduled SCRs storage unit identifier
 	ScheduledSCRsUnit UnitType = 24
+	// AccountTransactionsUnit is the transactions by account storage unit identifier
+	AccountTransactionsUnit UnitType = 25
+	// BlockStateChangesUnit is the state changes by block hash storage unit identifier
+	BlockStateChangesUnit UnitType = 26
+	// BlockWitnessUnit is the block witness by block hash storage unit identifier
+	BlockWitnessUnit UnitType = 27
 
 	// ShardHdrNonceHashDataUnit is the header nonce-hash pair data unit identifier
 	//TODO: Add only unit types lower than 100
@@ -15,4 +15,6 @@ type txCache interface {
 	ForEachTransaction(function txcache.ForEachTransaction)
 	NumBytes() int
 	Diagnose(deep bool)
+	Inspect() txcache.CacheInspection
+	InspectSender(sender []byte) (*txcache.SenderInspection, bool)
 }
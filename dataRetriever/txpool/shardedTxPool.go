@@ -1,6 +1,7 @@
 package txpool
 
 import (
+	"sort"
 	"strconv"
 	"sync"
 
@@ -339,6 +340,63 @@ func (txPool *shardedTxPool) Diagnose(deep bool) {
 	}
 }
 
+// InspectCaches returns the aggregated counters of each internal cache, sorted by cache ID
+func (txPool *shardedTxPool) InspectCaches() []txcache.CacheInspection {
+	txPool.mutexBackingMap.RLock()
+	defer txPool.mutexBackingMap.RUnlock()
+
+	inspections := make([]txcache.CacheInspection, 0, len(txPool.backingMap))
+	for cacheID, shard := range txPool.backingMap {
+		inspection := shard.Cache.Inspect()
+		inspection.Name = cacheID
+		inspections = append(inspections, inspection)
+	}
+
+	sort.Slice(inspections, func(i, j int) bool {
+		return inspections[i].Name < inspections[j].Name
+	})
+
+	return inspections
+}
+
+// InspectSender returns the state of the provided sender in each of the internal caches holding its transactions
+func (txPool *shardedTxPool) InspectSender(sender []byte) []*txcache.SenderInspection {
+	txPool.mutexBackingMap.RLock()
+	defer txPool.mutexBackingMap.RUnlock()
+
+	inspections := make([]*txcache.SenderInspection, 0)
+	for cacheID, shard := range txPool.backingMap {
+		inspection, ok := shard.Cache.InspectSender(sender)
+		if !ok {
+			continue
+		}
+
+		inspection.CacheName = cacheID
+		inspections = append(inspections, inspection)
+	}
+
+	sort.Slice(inspections, func(i, j int) bool {
+		return inspections[i].CacheName < inspections[j].CacheName
+	})
+
+	return inspections
+}
+
+// InspectTransaction searches the transaction against all internal caches and returns it, along with the ID of the cache holding it
+func (txPool *shardedTxPool) InspectTransaction(txHash []byte) (*txcache.WrappedTransaction, string, bool) {
+	txPool.mutexBackingMap.RLock()
+	defer txPool.mutexBackingMap.RUnlock()
+
+	for cacheID, shard := range txPool.backingMap {
+		tx, ok := shard.Cache.GetByTxHash(txHash)
+		if ok {
+			return tx, cacheID, true
+		}
+	}
+
+	return nil, "", false
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (txPool *shardedTxPool) IsInterfaceNil() bool {
 	return txPool == nil
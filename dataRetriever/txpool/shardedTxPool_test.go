@@ -12,6 +12,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/ElrondNetwork/elrond-go/storage/txcache"
 	"github.com/ElrondNetwork/elrond-go/testscommon/txcachemocks"
 	"github.com/stretchr/testify/require"
 )
@@ -410,3 +411,39 @@ func newTxPoolToTest() (dataRetriever.ShardedDataCacherNotifier, error) {
 }
 
 // TODO: Add high load test, reach maximum capacity and inspect RAM usage. EN-6735.
+
+func Test_InspectCachesAndSender(t *testing.T) {
+	poolAsInterface, _ := newTxPoolToTest()
+	pool := poolAsInterface.(*shardedTxPool)
+
+	pool.AddData([]byte("hash-alice-1"), createTx("alice", 1), 0, "0")
+	pool.AddData([]byte("hash-alice-3"), createTx("alice", 3), 0, "0_1")
+	pool.AddData([]byte("hash-bob-7"), createTx("bob", 7), 0, "0")
+	pool.AddData([]byte("hash-carol-1"), createTx("carol", 1), 0, "1_0")
+
+	// "0_1" is routed to the "0" cache union
+	caches := pool.InspectCaches()
+	require.Len(t, caches, 2)
+	require.Equal(t, "0", caches[0].Name)
+	require.Equal(t, uint64(3), caches[0].NumTxs)
+	require.Equal(t, uint64(2), caches[0].NumSenders)
+	require.Equal(t, "1_0", caches[1].Name)
+	require.Equal(t, uint64(1), caches[1].NumTxs)
+
+	senderInspections := pool.InspectSender([]byte("alice"))
+	require.Len(t, senderInspections, 1)
+	require.Equal(t, "0", senderInspections[0].CacheName)
+	require.Len(t, senderInspections[0].Transactions, 2)
+	require.Equal(t, []txcache.NonceGap{{FromNonce: 2, ToNonce: 2}}, senderInspections[0].NonceGaps)
+
+	// transactions from other shards are held by caches not organized by sender
+	require.Empty(t, pool.InspectSender([]byte("carol")))
+
+	tx, cacheID, ok := pool.InspectTransaction([]byte("hash-carol-1"))
+	require.True(t, ok)
+	require.Equal(t, "1_0", cacheID)
+	require.Equal(t, []byte("carol"), tx.Tx.GetSndAddr())
+
+	_, _, ok = pool.InspectTransaction([]byte("hash-missing"))
+	require.False(t, ok)
+}
@@ -0,0 +1,1224 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: accountTransactions.proto
+
+package dblookupext
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AccountTransactionEntry is used to store the coordinates of a transaction in which an account was involved
+type AccountTransactionEntry struct {
+	TxHash      []byte `protobuf:"bytes,1,opt,name=TxHash,proto3" json:"TxHash,omitempty"`
+	HeaderHash  []byte `protobuf:"bytes,2,opt,name=HeaderHash,proto3" json:"HeaderHash,omitempty"`
+	HeaderNonce uint64 `protobuf:"varint,3,opt,name=HeaderNonce,proto3" json:"HeaderNonce,omitempty"`
+	Round       uint64 `protobuf:"varint,4,opt,name=Round,proto3" json:"Round,omitempty"`
+	Epoch       uint32 `protobuf:"varint,5,opt,name=Epoch,proto3" json:"Epoch,omitempty"`
+}
+
+func (m *AccountTransactionEntry) Reset()      { *m = AccountTransactionEntry{} }
+func (*AccountTransactionEntry) ProtoMessage() {}
+func (*AccountTransactionEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0a5f698702587fa3, []int{0}
+}
+func (m *AccountTransactionEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountTransactionEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AccountTransactionEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountTransactionEntry.Merge(m, src)
+}
+func (m *AccountTransactionEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountTransactionEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountTransactionEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountTransactionEntry proto.InternalMessageInfo
+
+func (m *AccountTransactionEntry) GetTxHash() []byte {
+	if m != nil {
+		return m.TxHash
+	}
+	return nil
+}
+
+func (m *AccountTransactionEntry) GetHeaderHash() []byte {
+	if m != nil {
+		return m.HeaderHash
+	}
+	return nil
+}
+
+func (m *AccountTransactionEntry) GetHeaderNonce() uint64 {
+	if m != nil {
+		return m.HeaderNonce
+	}
+	return 0
+}
+
+func (m *AccountTransactionEntry) GetRound() uint64 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *AccountTransactionEntry) GetEpoch() uint32 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+// AccountTransactionsPage is used to store a fixed-size chunk of the transactions history of an account
+type AccountTransactionsPage struct {
+	Entries []*AccountTransactionEntry `protobuf:"bytes,1,rep,name=Entries,proto3" json:"Entries,omitempty"`
+}
+
+func (m *AccountTransactionsPage) Reset()      { *m = AccountTransactionsPage{} }
+func (*AccountTransactionsPage) ProtoMessage() {}
+func (*AccountTransactionsPage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0a5f698702587fa3, []int{1}
+}
+func (m *AccountTransactionsPage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountTransactionsPage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AccountTransactionsPage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountTransactionsPage.Merge(m, src)
+}
+func (m *AccountTransactionsPage) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountTransactionsPage) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountTransactionsPage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountTransactionsPage proto.InternalMessageInfo
+
+func (m *AccountTransactionsPage) GetEntries() []*AccountTransactionEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+// AccountTransactionsMetadata is used to store the number of recorded transactions of an account
+type AccountTransactionsMetadata struct {
+	NumTransactions uint64 `protobuf:"varint,1,opt,name=NumTransactions,proto3" json:"NumTransactions,omitempty"`
+}
+
+func (m *AccountTransactionsMetadata) Reset()      { *m = AccountTransactionsMetadata{} }
+func (*AccountTransactionsMetadata) ProtoMessage() {}
+func (*AccountTransactionsMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0a5f698702587fa3, []int{2}
+}
+func (m *AccountTransactionsMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountTransactionsMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AccountTransactionsMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountTransactionsMetadata.Merge(m, src)
+}
+func (m *AccountTransactionsMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountTransactionsMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountTransactionsMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountTransactionsMetadata proto.InternalMessageInfo
+
+func (m *AccountTransactionsMetadata) GetNumTransactions() uint64 {
+	if m != nil {
+		return m.NumTransactions
+	}
+	return 0
+}
+
+// AccountTransactionsByBlock is used to store the accounts touched by a block, so that the block can be reverted
+type AccountTransactionsByBlock struct {
+	Addresses [][]byte `protobuf:"bytes,1,rep,name=Addresses,proto3" json:"Addresses,omitempty"`
+}
+
+func (m *AccountTransactionsByBlock) Reset()      { *m = AccountTransactionsByBlock{} }
+func (*AccountTransactionsByBlock) ProtoMessage() {}
+func (*AccountTransactionsByBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0a5f698702587fa3, []int{3}
+}
+func (m *AccountTransactionsByBlock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountTransactionsByBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AccountTransactionsByBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountTransactionsByBlock.Merge(m, src)
+}
+func (m *AccountTransactionsByBlock) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountTransactionsByBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountTransactionsByBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountTransactionsByBlock proto.InternalMessageInfo
+
+func (m *AccountTransactionsByBlock) GetAddresses() [][]byte {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*AccountTransactionEntry)(nil), "proto.AccountTransactionEntry")
+	proto.RegisterType((*AccountTransactionsPage)(nil), "proto.AccountTransactionsPage")
+	proto.RegisterType((*AccountTransactionsMetadata)(nil), "proto.AccountTransactionsMetadata")
+	proto.RegisterType((*AccountTransactionsByBlock)(nil), "proto.AccountTransactionsByBlock")
+}
+
+func init() { proto.RegisterFile("accountTransactions.proto", fileDescriptor_0a5f698702587fa3) }
+
+var fileDescriptor_0a5f698702587fa3 = []byte{
+	// 349 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x90, 0xcf, 0x4e, 0xea, 0x40,
+	0x14, 0xc6, 0x7b, 0x2e, 0x7f, 0x6e, 0xee, 0xc0, 0xcd, 0x4d, 0x26, 0x37, 0x5a, 0xd1, 0x9c, 0x34,
+	0x5d, 0x75, 0x23, 0x24, 0xba, 0x31, 0xee, 0x20, 0x21, 0xb2, 0x91, 0x98, 0xca, 0xca, 0xdd, 0xb4,
+	0x1d, 0x0b, 0x01, 0x3a, 0xa4, 0x33, 0x4d, 0x60, 0xe7, 0x23, 0xf8, 0x06, 0x6e, 0x7d, 0x14, 0x97,
+	0x2c, 0x59, 0xca, 0xb0, 0x71, 0xc9, 0x23, 0x18, 0xa6, 0x18, 0x89, 0xe2, 0xaa, 0xfd, 0xfd, 0xbe,
+	0x99, 0x93, 0xf3, 0x0d, 0x39, 0x62, 0x61, 0x28, 0xb2, 0x44, 0xf5, 0x52, 0x96, 0x48, 0x16, 0xaa,
+	0x81, 0x48, 0x64, 0x7d, 0x92, 0x0a, 0x25, 0x68, 0xc9, 0x7c, 0x6a, 0xa7, 0xf1, 0x40, 0xf5, 0xb3,
+	0xa0, 0x1e, 0x8a, 0x71, 0x23, 0x16, 0xb1, 0x68, 0x18, 0x1d, 0x64, 0xf7, 0x86, 0x0c, 0x98, 0xbf,
+	0xfc, 0x96, 0xfb, 0x04, 0xe4, 0xb0, 0xf9, 0x6d, 0x66, 0x3b, 0x51, 0xe9, 0x8c, 0x1e, 0x90, 0x72,
+	0x6f, 0xda, 0x61, 0xb2, 0x6f, 0x83, 0x03, 0x5e, 0xd5, 0xdf, 0x12, 0x45, 0x42, 0x3a, 0x9c, 0x45,
+	0x3c, 0x35, 0xd9, 0x2f, 0x93, 0xed, 0x18, 0xea, 0x90, 0x4a, 0x4e, 0x5d, 0x91, 0x84, 0xdc, 0x2e,
+	0x38, 0xe0, 0x15, 0xfd, 0x5d, 0x45, 0xff, 0x93, 0x92, 0x2f, 0xb2, 0x24, 0xb2, 0x8b, 0x26, 0xcb,
+	0x61, 0x63, 0xdb, 0x13, 0x11, 0xf6, 0xed, 0x92, 0x03, 0xde, 0x5f, 0x3f, 0x07, 0xf7, 0x76, 0xdf,
+	0x82, 0xf2, 0x86, 0xc5, 0x9c, 0x5e, 0x90, 0xdf, 0x9b, 0x4d, 0x07, 0x5c, 0xda, 0xe0, 0x14, 0xbc,
+	0xca, 0x19, 0xe6, 0xad, 0xea, 0x3f, 0x34, 0xf2, 0x3f, 0x8e, 0xbb, 0x57, 0xe4, 0x78, 0xcf, 0xd0,
+	0x6b, 0xae, 0x58, 0xc4, 0x14, 0xa3, 0x1e, 0xf9, 0xd7, 0xcd, 0xc6, 0xbb, 0x91, 0x79, 0x82, 0xa2,
+	0xff, 0x55, 0xbb, 0x97, 0xa4, 0xb6, 0x67, 0x50, 0x6b, 0xd6, 0x1a, 0x89, 0x70, 0x48, 0x4f, 0xc8,
+	0x9f, 0x66, 0x14, 0xa5, 0x5c, 0xca, 0xed, 0x8a, 0x55, 0xff, 0x53, 0xb4, 0xda, 0xf3, 0x25, 0x5a,
+	0x8b, 0x25, 0x5a, 0xeb, 0x25, 0xc2, 0x83, 0x46, 0x78, 0xd6, 0x08, 0x2f, 0x1a, 0x61, 0xae, 0x11,
+	0x16, 0x1a, 0xe1, 0x55, 0x23, 0xbc, 0x69, 0xb4, 0xd6, 0x1a, 0xe1, 0x71, 0x85, 0xd6, 0x7c, 0x85,
+	0xd6, 0x62, 0x85, 0xd6, 0x5d, 0x25, 0x0a, 0x46, 0x42, 0x0c, 0xb3, 0x09, 0x9f, 0xaa, 0xa0, 0x6c,
+	0x3a, 0x9f, 0xbf, 0x0f, 0x00, 0x2b, 0x51, 0x94, 0x3f, 0x1c, 0x02, 0x00, 0x00,
+}
+
+func (this *AccountTransactionEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AccountTransactionEntry)
+	if !ok {
+		that2, ok := that.(AccountTransactionEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.TxHash, that1.TxHash) {
+		return false
+	}
+	if !bytes.Equal(this.HeaderHash, that1.HeaderHash) {
+		return false
+	}
+	if this.HeaderNonce != that1.HeaderNonce {
+		return false
+	}
+	if this.Round != that1.Round {
+		return false
+	}
+	if this.Epoch != that1.Epoch {
+		return false
+	}
+	return true
+}
+func (this *AccountTransactionsPage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AccountTransactionsPage)
+	if !ok {
+		that2, ok := that.(AccountTransactionsPage)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Entries) != len(that1.Entries) {
+		return false
+	}
+	for i := range this.Entries {
+		if !this.Entries[i].Equal(that1.Entries[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *AccountTransactionsMetadata) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AccountTransactionsMetadata)
+	if !ok {
+		that2, ok := that.(AccountTransactionsMetadata)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NumTransactions != that1.NumTransactions {
+		return false
+	}
+	return true
+}
+func (this *AccountTransactionsByBlock) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AccountTransactionsByBlock)
+	if !ok {
+		that2, ok := that.(AccountTransactionsByBlock)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Addresses) != len(that1.Addresses) {
+		return false
+	}
+	for i := range this.Addresses {
+		if !bytes.Equal(this.Addresses[i], that1.Addresses[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *AccountTransactionEntry) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&dblookupext.AccountTransactionEntry{")
+	s = append(s, "TxHash: "+fmt.Sprintf("%#v", this.TxHash)+",\n")
+	s = append(s, "HeaderHash: "+fmt.Sprintf("%#v", this.HeaderHash)+",\n")
+	s = append(s, "HeaderNonce: "+fmt.Sprintf("%#v", this.HeaderNonce)+",\n")
+	s = append(s, "Round: "+fmt.Sprintf("%#v", this.Round)+",\n")
+	s = append(s, "Epoch: "+fmt.Sprintf("%#v", this.Epoch)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AccountTransactionsPage) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&dblookupext.AccountTransactionsPage{")
+	if this.Entries != nil {
+		s = append(s, "Entries: "+fmt.Sprintf("%#v", this.Entries)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AccountTransactionsMetadata) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&dblookupext.AccountTransactionsMetadata{")
+	s = append(s, "NumTransactions: "+fmt.Sprintf("%#v", this.NumTransactions)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AccountTransactionsByBlock) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&dblookupext.AccountTransactionsByBlock{")
+	s = append(s, "Addresses: "+fmt.Sprintf("%#v", this.Addresses)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringAccountTransactions(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *AccountTransactionEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountTransactionEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountTransactionEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		i = encodeVarintAccountTransactions(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Round != 0 {
+		i = encodeVarintAccountTransactions(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.HeaderNonce != 0 {
+		i = encodeVarintAccountTransactions(dAtA, i, uint64(m.HeaderNonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.HeaderHash) > 0 {
+		i -= len(m.HeaderHash)
+		copy(dAtA[i:], m.HeaderHash)
+		i = encodeVarintAccountTransactions(dAtA, i, uint64(len(m.HeaderHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintAccountTransactions(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountTransactionsPage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountTransactionsPage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountTransactionsPage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAccountTransactions(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountTransactionsMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountTransactionsMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountTransactionsMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumTransactions != 0 {
+		i = encodeVarintAccountTransactions(dAtA, i, uint64(m.NumTransactions))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountTransactionsByBlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountTransactionsByBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountTransactionsByBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintAccountTransactions(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAccountTransactions(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAccountTransactions(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AccountTransactionEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovAccountTransactions(uint64(l))
+	}
+	l = len(m.HeaderHash)
+	if l > 0 {
+		n += 1 + l + sovAccountTransactions(uint64(l))
+	}
+	if m.HeaderNonce != 0 {
+		n += 1 + sovAccountTransactions(uint64(m.HeaderNonce))
+	}
+	if m.Round != 0 {
+		n += 1 + sovAccountTransactions(uint64(m.Round))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovAccountTransactions(uint64(m.Epoch))
+	}
+	return n
+}
+
+func (m *AccountTransactionsPage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovAccountTransactions(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AccountTransactionsMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NumTransactions != 0 {
+		n += 1 + sovAccountTransactions(uint64(m.NumTransactions))
+	}
+	return n
+}
+
+func (m *AccountTransactionsByBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, b := range m.Addresses {
+			l = len(b)
+			n += 1 + l + sovAccountTransactions(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovAccountTransactions(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAccountTransactions(x uint64) (n int) {
+	return sovAccountTransactions(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *AccountTransactionEntry) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AccountTransactionEntry{`,
+		`TxHash:` + fmt.Sprintf("%v", this.TxHash) + `,`,
+		`HeaderHash:` + fmt.Sprintf("%v", this.HeaderHash) + `,`,
+		`HeaderNonce:` + fmt.Sprintf("%v", this.HeaderNonce) + `,`,
+		`Round:` + fmt.Sprintf("%v", this.Round) + `,`,
+		`Epoch:` + fmt.Sprintf("%v", this.Epoch) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AccountTransactionsPage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForEntries := "[]*AccountTransactionEntry{"
+	for _, f := range this.Entries {
+		repeatedStringForEntries += strings.Replace(f.String(), "AccountTransactionEntry", "AccountTransactionEntry", 1) + ","
+	}
+	repeatedStringForEntries += "}"
+	s := strings.Join([]string{`&AccountTransactionsPage{`,
+		`Entries:` + repeatedStringForEntries + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AccountTransactionsMetadata) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AccountTransactionsMetadata{`,
+		`NumTransactions:` + fmt.Sprintf("%v", this.NumTransactions) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AccountTransactionsByBlock) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AccountTransactionsByBlock{`,
+		`Addresses:` + fmt.Sprintf("%v", this.Addresses) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringAccountTransactions(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *AccountTransactionEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccountTransactions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountTransactionEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountTransactionEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountTransactions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAccountTransactions
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccountTransactions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = append(m.TxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxHash == nil {
+				m.TxHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeaderHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountTransactions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAccountTransactions
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccountTransactions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HeaderHash = append(m.HeaderHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.HeaderHash == nil {
+				m.HeaderHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeaderNonce", wireType)
+			}
+			m.HeaderNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountTransactions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeaderNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountTransactions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountTransactions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccountTransactions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAccountTransactions
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAccountTransactions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountTransactionsPage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccountTransactions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountTransactionsPage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountTransactionsPage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountTransactions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAccountTransactions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccountTransactions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, &AccountTransactionEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccountTransactions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAccountTransactions
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAccountTransactions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountTransactionsMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccountTransactions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountTransactionsMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountTransactionsMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumTransactions", wireType)
+			}
+			m.NumTransactions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountTransactions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumTransactions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccountTransactions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAccountTransactions
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAccountTransactions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountTransactionsByBlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccountTransactions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountTransactionsByBlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountTransactionsByBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountTransactions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAccountTransactions
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccountTransactions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, make([]byte, postIndex-iNdEx))
+			copy(m.Addresses[len(m.Addresses)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccountTransactions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAccountTransactions
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAccountTransactions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAccountTransactions(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAccountTransactions
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAccountTransactions
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAccountTransactions
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAccountTransactions
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAccountTransactions
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAccountTransactions
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAccountTransactions        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAccountTransactions          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAccountTransactions = fmt.Errorf("proto: unexpected end of group")
+)
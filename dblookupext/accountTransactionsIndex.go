@@ -0,0 +1,285 @@
+//go:generate protoc -I=. -I=$GOPATH/src -I=$GOPATH/src/github.com/ElrondNetwork/protobuf/protobuf  --gogoslick_out=. accountTransactions.proto
+
+package dblookupext
+
+import (
+	"bytes"
+	"encoding/binary"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+const (
+	accountTransactionsPageSize    = 100
+	maxAccountTransactionsPerQuery = 100
+
+	accountTransactionsMetadataPrefix = "m"
+	accountTransactionsPagePrefix     = "p"
+	accountTransactionsBlockPrefix    = "b"
+)
+
+// accountTransactionsIndex stores, for each account, the ordered list of transactions the account was involved in.
+// The list is split in fixed-size pages so that appending and paginating do not need to load the whole history.
+type accountTransactionsIndex struct {
+	marshalizer marshal.Marshalizer
+	storer      storage.Storer
+	mutex       sync.RWMutex
+}
+
+func newAccountTransactionsIndex(storer storage.Storer, marshalizer marshal.Marshalizer) *accountTransactionsIndex {
+	return &accountTransactionsIndex{
+		marshalizer: marshalizer,
+		storer:      storer,
+	}
+}
+
+func (ati *accountTransactionsIndex) saveTransactions(
+	blockHeaderHash []byte,
+	blockHeader data.HeaderHandler,
+	addresses [][]byte,
+	txHashesByAddress map[string][][]byte,
+) error {
+	if len(addresses) == 0 {
+		return nil
+	}
+
+	ati.mutex.Lock()
+	defer ati.mutex.Unlock()
+
+	blockKey := ati.blockKey(blockHeaderHash)
+	if ati.storer.Has(blockKey) == nil {
+		// block already recorded, avoid duplicating the entries
+		return nil
+	}
+
+	for _, address := range addresses {
+		entries := make([]*AccountTransactionEntry, 0, len(txHashesByAddress[string(address)]))
+		for _, txHash := range txHashesByAddress[string(address)] {
+			entries = append(entries, &AccountTransactionEntry{
+				TxHash:      txHash,
+				HeaderHash:  blockHeaderHash,
+				HeaderNonce: blockHeader.GetNonce(),
+				Round:       blockHeader.GetRound(),
+				Epoch:       blockHeader.GetEpoch(),
+			})
+		}
+
+		err := ati.appendEntries(address, entries)
+		if err != nil {
+			return err
+		}
+	}
+
+	record := &AccountTransactionsByBlock{
+		Addresses: addresses,
+	}
+
+	return ati.put(blockKey, record)
+}
+
+func (ati *accountTransactionsIndex) appendEntries(address []byte, entries []*AccountTransactionEntry) error {
+	metadata, err := ati.getMetadata(address)
+	if err != nil {
+		return err
+	}
+
+	for len(entries) > 0 {
+		pageIndex := metadata.NumTransactions / accountTransactionsPageSize
+		page, errGet := ati.getPage(address, pageIndex)
+		if errGet != nil {
+			return errGet
+		}
+
+		numFreeSlots := accountTransactionsPageSize - len(page.Entries)
+		if numFreeSlots > len(entries) {
+			numFreeSlots = len(entries)
+		}
+
+		page.Entries = append(page.Entries, entries[:numFreeSlots]...)
+		entries = entries[numFreeSlots:]
+		metadata.NumTransactions += uint64(numFreeSlots)
+
+		errPut := ati.put(ati.pageKey(address, pageIndex), page)
+		if errPut != nil {
+			return errPut
+		}
+	}
+
+	return ati.put(ati.metadataKey(address), metadata)
+}
+
+func (ati *accountTransactionsIndex) revertTransactions(blockHeaderHash []byte) error {
+	ati.mutex.Lock()
+	defer ati.mutex.Unlock()
+
+	blockKey := ati.blockKey(blockHeaderHash)
+	rawBytes, err := ati.storer.Get(blockKey)
+	if err != nil {
+		// nothing was recorded for this block
+		return nil
+	}
+
+	record := &AccountTransactionsByBlock{}
+	err = ati.marshalizer.Unmarshal(record, rawBytes)
+	if err != nil {
+		return err
+	}
+
+	for _, address := range record.Addresses {
+		err = ati.removeTrailingEntries(address, blockHeaderHash)
+		if err != nil {
+			return err
+		}
+	}
+
+	return ati.storer.Remove(blockKey)
+}
+
+func (ati *accountTransactionsIndex) removeTrailingEntries(address []byte, blockHeaderHash []byte) error {
+	metadata, err := ati.getMetadata(address)
+	if err != nil {
+		return err
+	}
+
+	for metadata.NumTransactions > 0 {
+		pageIndex := (metadata.NumTransactions - 1) / accountTransactionsPageSize
+		page, errGet := ati.getPage(address, pageIndex)
+		if errGet != nil {
+			return errGet
+		}
+
+		numEntries := len(page.Entries)
+		for numEntries > 0 && bytes.Equal(page.Entries[numEntries-1].HeaderHash, blockHeaderHash) {
+			numEntries--
+		}
+
+		numRemoved := len(page.Entries) - numEntries
+		if numRemoved == 0 {
+			break
+		}
+
+		page.Entries = page.Entries[:numEntries]
+		metadata.NumTransactions -= uint64(numRemoved)
+
+		pageKey := ati.pageKey(address, pageIndex)
+		if numEntries == 0 {
+			err = ati.storer.Remove(pageKey)
+		} else {
+			err = ati.put(pageKey, page)
+		}
+		if err != nil {
+			return err
+		}
+
+		if numEntries > 0 {
+			break
+		}
+	}
+
+	return ati.put(ati.metadataKey(address), metadata)
+}
+
+// getTransactions returns the transactions of an account, newest first, starting right below the provided cursor.
+// A zero cursor means "start with the most recent transaction". The returned cursor is zero when there is nothing left.
+func (ati *accountTransactionsIndex) getTransactions(address []byte, cursor uint64, limit int) ([]*AccountTransactionEntry, uint64, error) {
+	if limit <= 0 || limit > maxAccountTransactionsPerQuery {
+		limit = maxAccountTransactionsPerQuery
+	}
+
+	ati.mutex.RLock()
+	defer ati.mutex.RUnlock()
+
+	metadata, err := ati.getMetadata(address)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	upperBound := metadata.NumTransactions
+	if cursor > 0 && cursor < upperBound {
+		upperBound = cursor
+	}
+
+	entries := make([]*AccountTransactionEntry, 0, limit)
+	var page *AccountTransactionsPage
+	currentPageIndex := uint64(0)
+	for upperBound > 0 && len(entries) < limit {
+		position := upperBound - 1
+		pageIndex := position / accountTransactionsPageSize
+		if page == nil || pageIndex != currentPageIndex {
+			page, err = ati.getPage(address, pageIndex)
+			if err != nil {
+				return nil, 0, err
+			}
+			currentPageIndex = pageIndex
+		}
+
+		positionInPage := int(position % accountTransactionsPageSize)
+		if positionInPage >= len(page.Entries) {
+			return nil, 0, errInconsistentAccountTransactionsIndex
+		}
+
+		entries = append(entries, page.Entries[positionInPage])
+		upperBound--
+	}
+
+	return entries, upperBound, nil
+}
+
+func (ati *accountTransactionsIndex) getMetadata(address []byte) (*AccountTransactionsMetadata, error) {
+	metadata := &AccountTransactionsMetadata{}
+	rawBytes, err := ati.storer.Get(ati.metadataKey(address))
+	if err != nil {
+		// no history recorded yet for this account
+		return metadata, nil
+	}
+
+	err = ati.marshalizer.Unmarshal(metadata, rawBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return metadata, nil
+}
+
+func (ati *accountTransactionsIndex) getPage(address []byte, pageIndex uint64) (*AccountTransactionsPage, error) {
+	page := &AccountTransactionsPage{}
+	rawBytes, err := ati.storer.Get(ati.pageKey(address, pageIndex))
+	if err != nil {
+		return page, nil
+	}
+
+	err = ati.marshalizer.Unmarshal(page, rawBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return page, nil
+}
+
+func (ati *accountTransactionsIndex) put(key []byte, value interface{}) error {
+	rawBytes, err := ati.marshalizer.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	return ati.storer.Put(key, rawBytes)
+}
+
+func (ati *accountTransactionsIndex) metadataKey(address []byte) []byte {
+	return append([]byte(accountTransactionsMetadataPrefix), address...)
+}
+
+func (ati *accountTransactionsIndex) pageKey(address []byte, pageIndex uint64) []byte {
+	key := append([]byte(accountTransactionsPagePrefix), address...)
+	pageIndexBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(pageIndexBytes, pageIndex)
+
+	return append(key, pageIndexBytes...)
+}
+
+func (ati *accountTransactionsIndex) blockKey(blockHeaderHash []byte) []byte {
+	return append([]byte(accountTransactionsBlockPrefix), blockHeaderHash...)
+}
@@ -0,0 +1,110 @@
+package dblookupext
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go/common/mock"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/require"
+)
+
+func saveTestAccountTransactions(t *testing.T, index *accountTransactionsIndex, headerHash []byte, nonce uint64, address []byte, numTxs int) {
+	txHashes := make([][]byte, 0, numTxs)
+	for i := 0; i < numTxs; i++ {
+		txHashes = append(txHashes, []byte(fmt.Sprintf("%s_tx%d", headerHash, i)))
+	}
+
+	err := index.saveTransactions(
+		headerHash,
+		&block.Header{Nonce: nonce, Round: nonce, Epoch: 1},
+		[][]byte{address},
+		map[string][][]byte{string(address): txHashes},
+	)
+	require.Nil(t, err)
+}
+
+func TestAccountTransactionsIndex_GetTransactionsOfUnknownAccount(t *testing.T) {
+	t.Parallel()
+
+	index := newAccountTransactionsIndex(testscommon.CreateMemUnit(), &mock.MarshalizerMock{})
+
+	entries, nextCursor, err := index.getTransactions([]byte("alice"), 0, 10)
+	require.Nil(t, err)
+	require.Empty(t, entries)
+	require.Equal(t, uint64(0), nextCursor)
+}
+
+func TestAccountTransactionsIndex_SaveAndPaginate(t *testing.T) {
+	t.Parallel()
+
+	index := newAccountTransactionsIndex(testscommon.CreateMemUnit(), &mock.MarshalizerMock{})
+	alice := []byte("alice")
+
+	// spans multiple pages
+	saveTestAccountTransactions(t, index, []byte("blockA"), 1, alice, 150)
+	saveTestAccountTransactions(t, index, []byte("blockB"), 2, alice, 70)
+
+	entries, nextCursor, err := index.getTransactions(alice, 0, 50)
+	require.Nil(t, err)
+	require.Len(t, entries, 50)
+	require.Equal(t, uint64(170), nextCursor)
+	require.Equal(t, []byte("blockB_tx69"), entries[0].TxHash)
+	require.Equal(t, uint64(2), entries[0].HeaderNonce)
+
+	entries, nextCursor, err = index.getTransactions(alice, nextCursor, 100)
+	require.Nil(t, err)
+	require.Len(t, entries, 100)
+	require.Equal(t, uint64(70), nextCursor)
+	require.Equal(t, []byte("blockB_tx19"), entries[0].TxHash)
+	require.Equal(t, []byte("blockA_tx70"), entries[99].TxHash)
+
+	entries, nextCursor, err = index.getTransactions(alice, nextCursor, 1000)
+	require.Nil(t, err)
+	require.Len(t, entries, 70)
+	require.Equal(t, uint64(0), nextCursor)
+	require.Equal(t, []byte("blockA_tx0"), entries[69].TxHash)
+}
+
+func TestAccountTransactionsIndex_SaveSameBlockTwiceShouldNotDuplicate(t *testing.T) {
+	t.Parallel()
+
+	index := newAccountTransactionsIndex(testscommon.CreateMemUnit(), &mock.MarshalizerMock{})
+	alice := []byte("alice")
+
+	saveTestAccountTransactions(t, index, []byte("blockA"), 1, alice, 3)
+	saveTestAccountTransactions(t, index, []byte("blockA"), 1, alice, 3)
+
+	entries, _, err := index.getTransactions(alice, 0, 10)
+	require.Nil(t, err)
+	require.Len(t, entries, 3)
+}
+
+func TestAccountTransactionsIndex_Revert(t *testing.T) {
+	t.Parallel()
+
+	index := newAccountTransactionsIndex(testscommon.CreateMemUnit(), &mock.MarshalizerMock{})
+	alice := []byte("alice")
+
+	saveTestAccountTransactions(t, index, []byte("blockA"), 1, alice, 95)
+	saveTestAccountTransactions(t, index, []byte("blockB"), 2, alice, 120)
+
+	err := index.revertTransactions([]byte("blockB"))
+	require.Nil(t, err)
+
+	entries, nextCursor, err := index.getTransactions(alice, 0, 100)
+	require.Nil(t, err)
+	require.Len(t, entries, 95)
+	require.Equal(t, uint64(0), nextCursor)
+	require.Equal(t, []byte("blockA_tx94"), entries[0].TxHash)
+
+	// reverting an unknown block should not alter the index
+	err = index.revertTransactions([]byte("blockC"))
+	require.Nil(t, err)
+
+	saveTestAccountTransactions(t, index, []byte("blockB2"), 2, alice, 10)
+	entries, _, err = index.getTransactions(alice, 0, 1)
+	require.Nil(t, err)
+	require.Equal(t, []byte("blockB2_tx9"), entries[0].TxHash)
+}
@@ -3,7 +3,9 @@
 package dblookupext
 
 import (
+	"bytes"
 	"fmt"
+	"sort"
 	"sync"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
@@ -34,6 +36,7 @@ type HistoryRepositoryArguments struct {
 	Uint64ByteSliceConverter    typeConverters.Uint64ByteSliceConverter
 	EpochByHashStorer           storage.Storer
 	EventsHashesByTxHashStorer  storage.Storer
+	AccountTransactionsStorer   storage.Storer
 	Marshalizer                 marshal.Marshalizer
 	Hasher                      hashing.Hasher
 	ESDTSuppliesHandler         SuppliesHandler
@@ -47,6 +50,7 @@ type historyRepository struct {
 	uint64ByteSliceConverter   typeConverters.Uint64ByteSliceConverter
 	epochByHashIndex           *epochByHashIndex
 	eventsHashesByTxHashIndex  *eventsHashesByTxHash
+	accountTransactionsIndex   *accountTransactionsIndex
 	marshalizer                marshal.Marshalizer
 	hasher                     hashing.Hasher
 	esdtSuppliesHandler        SuppliesHandler
@@ -90,6 +94,9 @@ func NewHistoryRepository(arguments HistoryRepositoryArguments) (*historyReposit
 	if check.IfNil(arguments.EventsHashesByTxHashStorer) {
 		return nil, core.ErrNilStore
 	}
+	if check.IfNil(arguments.AccountTransactionsStorer) {
+		return nil, core.ErrNilStore
+	}
 	if check.IfNil(arguments.ESDTSuppliesHandler) {
 		return nil, errNilESDTSuppliesHandler
 	}
@@ -101,6 +108,7 @@ func NewHistoryRepository(arguments HistoryRepositoryArguments) (*historyReposit
 	deduplicationCacheForInsertMiniblockMetadata, _ := lrucache.NewCache(sizeOfDeduplicationCache)
 
 	eventsHashesToTxHashIndex := newEventsHashesByTxHash(arguments.EventsHashesByTxHashStorer, arguments.Marshalizer)
+	accountTransactionsIndex := newAccountTransactionsIndex(arguments.AccountTransactionsStorer, arguments.Marshalizer)
 
 	return &historyRepository{
 		selfShardID:                           arguments.SelfShardID,
@@ -115,6 +123,7 @@ func NewHistoryRepository(arguments HistoryRepositoryArguments) (*historyReposit
 		pendingNotarizedAtBothNotifications:          container.NewMutexMap(),
 		deduplicationCacheForInsertMiniblockMetadata: deduplicationCacheForInsertMiniblockMetadata,
 		eventsHashesByTxHashIndex:                    eventsHashesToTxHashIndex,
+		accountTransactionsIndex:                     accountTransactionsIndex,
 		esdtSuppliesHandler:                          arguments.ESDTSuppliesHandler,
 		uint64ByteSliceConverter:                     arguments.Uint64ByteSliceConverter,
 	}, nil
@@ -125,6 +134,7 @@ func NewHistoryRepository(arguments HistoryRepositoryArguments) (*historyReposit
 func (hr *historyRepository) RecordBlock(blockHeaderHash []byte,
 	blockHeader data.HeaderHandler,
 	blockBody data.BodyHandler,
+	txsFromPool map[string]data.TransactionHandler,
 	scrResultsFromPool map[string]data.TransactionHandler,
 	receiptsFromPool map[string]data.TransactionHandler,
 	logs []*data.LogData) error {
@@ -161,6 +171,11 @@ func (hr *historyRepository) RecordBlock(blockHeaderHash []byte,
 		return err
 	}
 
+	err = hr.recordAccountsTransactions(blockHeaderHash, blockHeader, body, txsFromPool, scrResultsFromPool)
+	if err != nil {
+		return err
+	}
+
 	err = hr.esdtSuppliesHandler.ProcessLogs(blockHeader.GetNonce(), logs)
 	if err != nil {
 		return err
@@ -174,6 +189,69 @@ func (hr *historyRepository) RecordBlock(blockHeaderHash []byte,
 	return nil
 }
 
+// recordAccountsTransactions indexes the transactions of the block by the addresses of their senders and receivers.
+// Transactions are indexed in the order in which they appear in the block body; the smart contract results that
+// are not part of the body (e.g. intra shard ones) are appended afterwards, ordered by hash.
+func (hr *historyRepository) recordAccountsTransactions(
+	blockHeaderHash []byte,
+	blockHeader data.HeaderHandler,
+	body *block.Body,
+	txsFromPool map[string]data.TransactionHandler,
+	scrResultsFromPool map[string]data.TransactionHandler,
+) error {
+	addresses := make([][]byte, 0)
+	txHashesByAddress := make(map[string][][]byte)
+	processedTxs := make(map[string]struct{})
+
+	addTx := func(txHash []byte, tx data.TransactionHandler) {
+		_, alreadyProcessed := processedTxs[string(txHash)]
+		if alreadyProcessed || check.IfNil(tx) {
+			return
+		}
+		processedTxs[string(txHash)] = struct{}{}
+
+		for _, address := range [][]byte{tx.GetSndAddr(), tx.GetRcvAddr()} {
+			if len(address) == 0 {
+				continue
+			}
+
+			txHashes, found := txHashesByAddress[string(address)]
+			if !found {
+				addresses = append(addresses, address)
+			}
+			if len(txHashes) > 0 && bytes.Equal(txHashes[len(txHashes)-1], txHash) {
+				continue
+			}
+			txHashesByAddress[string(address)] = append(txHashes, txHash)
+		}
+	}
+
+	for _, miniblock := range body.MiniBlocks {
+		if miniblock.Type == block.PeerBlock {
+			continue
+		}
+
+		for _, txHash := range miniblock.TxHashes {
+			tx, found := txsFromPool[string(txHash)]
+			if !found {
+				tx = scrResultsFromPool[string(txHash)]
+			}
+			addTx(txHash, tx)
+		}
+	}
+
+	scrHashes := make([]string, 0, len(scrResultsFromPool))
+	for scrHash := range scrResultsFromPool {
+		scrHashes = append(scrHashes, scrHash)
+	}
+	sort.Strings(scrHashes)
+	for _, scrHash := range scrHashes {
+		addTx([]byte(scrHash), scrResultsFromPool[scrHash])
+	}
+
+	return hr.accountTransactionsIndex.saveTransactions(blockHeaderHash, blockHeader, addresses, txHashesByAddress)
+}
+
 func (hr *historyRepository) putHashByRound(blockHeaderHash []byte, header data.HeaderHandler) error {
 	roundToByteSlice := hr.uint64ByteSliceConverter.ToByteSlice(header.GetRound())
 	return hr.blockHashByRound.Put(roundToByteSlice, blockHeaderHash)
@@ -459,6 +537,12 @@ func (hr *historyRepository) GetResultsHashesByTxHash(txHash []byte, epoch uint3
 	return hr.eventsHashesByTxHashIndex.getEventsHashesByTxHash(txHash, epoch)
 }
 
+// GetAccountTransactions will return the transactions in which the given address was involved, newest first.
+// The returned cursor can be used to fetch the next (older) batch and is zero when there is nothing left
+func (hr *historyRepository) GetAccountTransactions(address []byte, cursor uint64, limit int) ([]*AccountTransactionEntry, uint64, error) {
+	return hr.accountTransactionsIndex.getTransactions(address, cursor, limit)
+}
+
 // IsEnabled will always return true
 func (hr *historyRepository) IsEnabled() bool {
 	return true
@@ -466,6 +550,19 @@ func (hr *historyRepository) IsEnabled() bool {
 
 // RevertBlock will return the modification for the current block header
 func (hr *historyRepository) RevertBlock(blockHeader data.HeaderHandler, blockBody data.BodyHandler) error {
+	hr.recordBlockMutex.Lock()
+	defer hr.recordBlockMutex.Unlock()
+
+	blockHeaderHash, err := core.CalculateHash(hr.marshalizer, hr.hasher, blockHeader)
+	if err != nil {
+		return err
+	}
+
+	err = hr.accountTransactionsIndex.revertTransactions(blockHeaderHash)
+	if err != nil {
+		return err
+	}
+
 	return hr.esdtSuppliesHandler.RevertChanges(blockHeader, blockBody)
 }
 
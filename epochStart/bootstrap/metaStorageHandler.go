@@ -19,6 +19,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/storage/factory"
+	"github.com/ElrondNetwork/elrond-go/storage/statistics"
 )
 
 type metaStorageHandler struct {
@@ -47,6 +48,7 @@ func NewMetaStorageHandler(
 		nodeTypeProvider,
 		currentEpoch,
 		false,
+		statistics.NewDisabledStorageStatistics(),
 	)
 	if err != nil {
 		return nil, err
@@ -36,6 +36,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/state/syncer"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
+	"github.com/ElrondNetwork/elrond-go/storage/statistics"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 	"github.com/ElrondNetwork/elrond-go/storage/timecache"
 	"github.com/ElrondNetwork/elrond-go/trie/factory"
@@ -1048,6 +1049,7 @@ func (e *epochStartBootstrap) createStorageService(
 		e.coreComponentsHolder.NodeTypeProvider(),
 		startEpoch,
 		createTrieEpochRootHashStorer,
+		statistics.NewDisabledStorageStatistics(),
 	)
 	if err != nil {
 		return nil, err
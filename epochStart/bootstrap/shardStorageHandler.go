@@ -22,6 +22,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/storage/factory"
+	"github.com/ElrondNetwork/elrond-go/storage/statistics"
 )
 
 type shardStorageHandler struct {
@@ -50,6 +51,7 @@ func NewShardStorageHandler(
 		nodeTypeProvider,
 		currentEpoch,
 		false,
+		statistics.NewDisabledStorageStatistics(),
 	)
 	if err != nil {
 		return nil, err
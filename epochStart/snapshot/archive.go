@@ -0,0 +1,322 @@
+package snapshot
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+)
+
+const (
+	archiveMagic   = "ERDSNAPSHOT"
+	archiveVersion = uint32(1)
+
+	recordTypeEntry    = byte(1)
+	recordTypeManifest = byte(2)
+
+	// maxFieldLength bounds the allocations made while reading a (possibly corrupted) archive
+	maxFieldLength = 256 * 1024 * 1024
+)
+
+// Manifest describes the content of a snapshot archive
+type Manifest struct {
+	Version                 uint32 `json:"version"`
+	ChainID                 string `json:"chainID"`
+	ShardID                 uint32 `json:"shardID"`
+	Epoch                   uint32 `json:"epoch"`
+	Round                   int64  `json:"round"`
+	EpochStartMetaBlockHash []byte `json:"epochStartMetaBlockHash"`
+	EpochStartHeaderHash    []byte `json:"epochStartHeaderHash"`
+	RootHash                []byte `json:"rootHash"`
+	ValidatorStatsRootHash  []byte `json:"validatorStatsRootHash,omitempty"`
+	NumEntries              uint64 `json:"numEntries"`
+	NumTrieNodes            uint64 `json:"numTrieNodes"`
+}
+
+// Entry is a key-value pair of a storage unit, saved in a snapshot archive
+type Entry struct {
+	Unit  dataRetriever.UnitType
+	Key   []byte
+	Value []byte
+}
+
+// archiveWriter writes a snapshot archive: a header, the entries, the manifest and the sha256 checksum
+// of everything written before it
+type archiveWriter struct {
+	file     *os.File
+	buffer   *bufio.Writer
+	checksum hash.Hash
+	writer   io.Writer
+}
+
+func newArchiveWriter(path string) (*archiveWriter, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, err
+	}
+
+	buffer := bufio.NewWriter(file)
+	checksum := sha256.New()
+	aw := &archiveWriter{
+		file:     file,
+		buffer:   buffer,
+		checksum: checksum,
+		writer:   io.MultiWriter(buffer, checksum),
+	}
+
+	header := make([]byte, len(archiveMagic)+4)
+	copy(header, archiveMagic)
+	binary.BigEndian.PutUint32(header[len(archiveMagic):], archiveVersion)
+	_, err = aw.writer.Write(header)
+	if err != nil {
+		aw.abort()
+		return nil, err
+	}
+
+	return aw, nil
+}
+
+func (aw *archiveWriter) writeEntry(entry Entry) error {
+	_, err := aw.writer.Write([]byte{recordTypeEntry, byte(entry.Unit)})
+	if err != nil {
+		return err
+	}
+	err = aw.writeField(entry.Key)
+	if err != nil {
+		return err
+	}
+
+	return aw.writeField(entry.Value)
+}
+
+func (aw *archiveWriter) writeField(field []byte) error {
+	lengthBuff := make([]byte, binary.MaxVarintLen64)
+	n := binary.PutUvarint(lengthBuff, uint64(len(field)))
+	_, err := aw.writer.Write(lengthBuff[:n])
+	if err != nil {
+		return err
+	}
+
+	_, err = aw.writer.Write(field)
+
+	return err
+}
+
+// finalize writes the manifest and the checksum and closes the archive
+func (aw *archiveWriter) finalize(manifest *Manifest) error {
+	manifestBytes, err := json.Marshal(manifest)
+	if err != nil {
+		return err
+	}
+
+	_, err = aw.writer.Write([]byte{recordTypeManifest})
+	if err != nil {
+		return err
+	}
+	err = aw.writeField(manifestBytes)
+	if err != nil {
+		return err
+	}
+
+	_, err = aw.buffer.Write(aw.checksum.Sum(nil))
+	if err != nil {
+		return err
+	}
+	err = aw.buffer.Flush()
+	if err != nil {
+		return err
+	}
+	err = aw.file.Sync()
+	if err != nil {
+		return err
+	}
+
+	return aw.file.Close()
+}
+
+// abort closes and removes the partially written archive
+func (aw *archiveWriter) abort() {
+	_ = aw.file.Close()
+	log.LogIfError(os.Remove(aw.file.Name()), "path", aw.file.Name())
+}
+
+// hashingReader feeds all the bytes read from the archive into the checksum
+type hashingReader struct {
+	reader   *bufio.Reader
+	checksum hash.Hash
+}
+
+// Read reads from the underlying reader, updating the checksum
+func (hr *hashingReader) Read(p []byte) (int, error) {
+	n, err := hr.reader.Read(p)
+	_, _ = hr.checksum.Write(p[:n])
+
+	return n, err
+}
+
+// ReadByte reads a byte from the underlying reader, updating the checksum
+func (hr *hashingReader) ReadByte() (byte, error) {
+	b, err := hr.reader.ReadByte()
+	if err != nil {
+		return 0, err
+	}
+	_, _ = hr.checksum.Write([]byte{b})
+
+	return b, nil
+}
+
+// readArchive reads the snapshot archive found at the provided path, calling the handler (if provided) for each entry.
+// The archive checksum is verified when reaching its end, so the entries passed to the handler should only be
+// trusted after readArchive returns without error
+func readArchive(path string, handler func(entry Entry) error) (*Manifest, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	buffer := bufio.NewReader(file)
+	reader := &hashingReader{
+		reader:   buffer,
+		checksum: sha256.New(),
+	}
+
+	err = readArchiveHeader(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest, err := readRecords(reader, handler)
+	if err != nil {
+		return nil, err
+	}
+
+	computedChecksum := reader.checksum.Sum(nil)
+	savedChecksum := make([]byte, len(computedChecksum))
+	_, err = io.ReadFull(buffer, savedChecksum)
+	if err != nil {
+		return nil, fmt.Errorf("%w: missing checksum", ErrInvalidArchive)
+	}
+	if !bytes.Equal(computedChecksum, savedChecksum) {
+		return nil, ErrChecksumMismatch
+	}
+	_, err = buffer.ReadByte()
+	if err != io.EOF {
+		return nil, fmt.Errorf("%w: unexpected data after checksum", ErrInvalidArchive)
+	}
+
+	return manifest, nil
+}
+
+func readArchiveHeader(reader io.Reader) error {
+	header := make([]byte, len(archiveMagic)+4)
+	_, err := io.ReadFull(reader, header)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidArchive, err)
+	}
+	if string(header[:len(archiveMagic)]) != archiveMagic {
+		return fmt.Errorf("%w: wrong magic", ErrInvalidArchive)
+	}
+
+	version := binary.BigEndian.Uint32(header[len(archiveMagic):])
+	if version != archiveVersion {
+		return fmt.Errorf("%w: %d", ErrUnsupportedArchiveVersion, version)
+	}
+
+	return nil
+}
+
+func readRecords(reader *hashingReader, handler func(entry Entry) error) (*Manifest, error) {
+	for {
+		recordType, err := reader.ReadByte()
+		if err != nil {
+			return nil, fmt.Errorf("%w: missing manifest", ErrInvalidArchive)
+		}
+
+		switch recordType {
+		case recordTypeEntry:
+			entry, errRead := readEntry(reader)
+			if errRead != nil {
+				return nil, errRead
+			}
+			if handler == nil {
+				continue
+			}
+
+			errRead = handler(entry)
+			if errRead != nil {
+				return nil, errRead
+			}
+		case recordTypeManifest:
+			return readManifest(reader)
+		default:
+			return nil, fmt.Errorf("%w: unknown record type %d", ErrInvalidArchive, recordType)
+		}
+	}
+}
+
+func readEntry(reader *hashingReader) (Entry, error) {
+	unit, err := reader.ReadByte()
+	if err != nil {
+		return Entry{}, fmt.Errorf("%w: %v", ErrInvalidArchive, err)
+	}
+
+	entry := Entry{
+		Unit: dataRetriever.UnitType(unit),
+	}
+	entry.Key, err = readField(reader)
+	if err != nil {
+		return Entry{}, err
+	}
+	entry.Value, err = readField(reader)
+	if err != nil {
+		return Entry{}, err
+	}
+
+	return entry, nil
+}
+
+func readManifest(reader *hashingReader) (*Manifest, error) {
+	manifestBytes, err := readField(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest := &Manifest{}
+	err = json.Unmarshal(manifestBytes, manifest)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidArchive, err)
+	}
+	if manifest.Version != archiveVersion {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedArchiveVersion, manifest.Version)
+	}
+
+	return manifest, nil
+}
+
+func readField(reader *hashingReader) ([]byte, error) {
+	length, err := binary.ReadUvarint(reader)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidArchive, err)
+	}
+	if length > maxFieldLength {
+		return nil, fmt.Errorf("%w: field length %d too large", ErrInvalidArchive, length)
+	}
+
+	field := make([]byte, length)
+	_, err = io.ReadFull(reader, field)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidArchive, err)
+	}
+
+	return field, nil
+}
@@ -0,0 +1,161 @@
+package snapshot
+
+import (
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeTestArchive(t *testing.T, path string, entries []Entry, manifest *Manifest) {
+	aw, err := newArchiveWriter(path)
+	require.Nil(t, err)
+
+	for _, entry := range entries {
+		err = aw.writeEntry(entry)
+		require.Nil(t, err)
+	}
+
+	err = aw.finalize(manifest)
+	require.Nil(t, err)
+}
+
+func readAllEntries(t *testing.T, path string) ([]Entry, *Manifest) {
+	entries := make([]Entry, 0)
+	manifest, err := readArchive(path, func(entry Entry) error {
+		entries = append(entries, entry)
+		return nil
+	})
+	require.Nil(t, err)
+
+	return entries, manifest
+}
+
+func TestArchive_WriteAndReadShouldWork(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "snapshot")
+	entries := []Entry{
+		{Unit: dataRetriever.BootstrapUnit, Key: []byte("key1"), Value: []byte("value1")},
+		{Unit: dataRetriever.UserAccountsUnit, Key: []byte("key2"), Value: make([]byte, 0)},
+		{Unit: dataRetriever.ShardHdrNonceHashDataUnit + 1, Key: []byte("key3"), Value: []byte("value3")},
+	}
+	manifest := &Manifest{
+		Version:    archiveVersion,
+		ChainID:    "chain",
+		ShardID:    1,
+		Epoch:      7,
+		RootHash:   []byte("root hash"),
+		NumEntries: uint64(len(entries)),
+	}
+	writeTestArchive(t, path, entries, manifest)
+
+	readEntries, readManifest := readAllEntries(t, path)
+	assert.Equal(t, entries, readEntries)
+	assert.Equal(t, manifest, readManifest)
+}
+
+func TestArchive_WriteOnExistingFileShouldErr(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "snapshot")
+	err := ioutil.WriteFile(path, []byte("data"), 0644)
+	require.Nil(t, err)
+
+	aw, err := newArchiveWriter(path)
+	assert.NotNil(t, err)
+	assert.Nil(t, aw)
+}
+
+func TestArchive_ReadCorruptedArchiveShouldErr(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "snapshot")
+	entries := []Entry{
+		{Unit: dataRetriever.BootstrapUnit, Key: []byte("key"), Value: []byte("value")},
+	}
+	writeTestArchive(t, path, entries, &Manifest{Version: archiveVersion})
+	archiveBytes, err := ioutil.ReadFile(path)
+	require.Nil(t, err)
+
+	t.Run("modified value", func(t *testing.T) {
+		t.Parallel()
+
+		corrupted := append([]byte{}, archiveBytes...)
+		corrupted[len(archiveMagic)+4+5] ^= 0xFF
+		corruptedPath := filepath.Join(t.TempDir(), "snapshot")
+		err := ioutil.WriteFile(corruptedPath, corrupted, 0644)
+		require.Nil(t, err)
+
+		_, err = readArchive(corruptedPath, nil)
+		assert.True(t, errors.Is(err, ErrChecksumMismatch))
+	})
+	t.Run("truncated", func(t *testing.T) {
+		t.Parallel()
+
+		truncatedPath := filepath.Join(t.TempDir(), "snapshot")
+		err := ioutil.WriteFile(truncatedPath, archiveBytes[:len(archiveBytes)-10], 0644)
+		require.Nil(t, err)
+
+		_, err = readArchive(truncatedPath, nil)
+		assert.True(t, errors.Is(err, ErrInvalidArchive))
+	})
+	t.Run("trailing data", func(t *testing.T) {
+		t.Parallel()
+
+		trailingPath := filepath.Join(t.TempDir(), "snapshot")
+		err := ioutil.WriteFile(trailingPath, append(append([]byte{}, archiveBytes...), 0), 0644)
+		require.Nil(t, err)
+
+		_, err = readArchive(trailingPath, nil)
+		assert.True(t, errors.Is(err, ErrInvalidArchive))
+	})
+	t.Run("wrong magic", func(t *testing.T) {
+		t.Parallel()
+
+		wrongMagic := append([]byte{}, archiveBytes...)
+		wrongMagic[0] = 'X'
+		wrongMagicPath := filepath.Join(t.TempDir(), "snapshot")
+		err := ioutil.WriteFile(wrongMagicPath, wrongMagic, 0644)
+		require.Nil(t, err)
+
+		_, err = readArchive(wrongMagicPath, nil)
+		assert.True(t, errors.Is(err, ErrInvalidArchive))
+	})
+	t.Run("unsupported version", func(t *testing.T) {
+		t.Parallel()
+
+		wrongVersion := append([]byte{}, archiveBytes...)
+		wrongVersion[len(archiveMagic)+3] = 2
+		wrongVersionPath := filepath.Join(t.TempDir(), "snapshot")
+		err := ioutil.WriteFile(wrongVersionPath, wrongVersion, 0644)
+		require.Nil(t, err)
+
+		_, err = readArchive(wrongVersionPath, nil)
+		assert.True(t, errors.Is(err, ErrUnsupportedArchiveVersion))
+	})
+}
+
+func TestArchive_HandlerErrorShouldStopReading(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "snapshot")
+	entries := []Entry{
+		{Unit: dataRetriever.BootstrapUnit, Key: []byte("key1"), Value: []byte("value1")},
+		{Unit: dataRetriever.BootstrapUnit, Key: []byte("key2"), Value: []byte("value2")},
+	}
+	writeTestArchive(t, path, entries, &Manifest{Version: archiveVersion})
+
+	expectedErr := errors.New("expected error")
+	numCalls := 0
+	_, err := readArchive(path, func(entry Entry) error {
+		numCalls++
+		return expectedErr
+	})
+	assert.Equal(t, expectedErr, err)
+	assert.Equal(t, 1, numCalls)
+}
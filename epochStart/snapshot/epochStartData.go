@@ -0,0 +1,145 @@
+package snapshot
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/block/bootstrapStorage"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// epochStartData holds the epoch start headers of a shard, as saved by the node, together with the bootstrap data
+// saved when the shard's epoch start header was committed
+type epochStartData struct {
+	metaBlock      *block.MetaBlock
+	metaBlockBytes []byte
+	metaBlockHash  []byte
+	header         data.HeaderHandler
+	headerBytes    []byte
+	headerHash     []byte
+	bootstrapData  *bootstrapStorage.BootstrapData
+}
+
+func getEpochStartData(
+	persisters *persistersHolder,
+	shardID uint32,
+	epoch uint32,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+) (*epochStartData, error) {
+	bootstrapPersister, err := persisters.get(dataRetriever.BootstrapUnit, epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	epochStartIdentifier := []byte(core.EpochStartIdentifier(epoch))
+	metaBlockBytes, err := bootstrapPersister.Get(epochStartIdentifier)
+	if err != nil {
+		return nil, fmt.Errorf("%w while reading the epoch start meta block of epoch %d", err, epoch)
+	}
+
+	metaBlock := &block.MetaBlock{}
+	err = marshalizer.Unmarshal(metaBlock, metaBlockBytes)
+	if err != nil {
+		return nil, err
+	}
+	if metaBlock.GetEpoch() != epoch || !metaBlock.IsStartOfEpochBlock() {
+		return nil, fmt.Errorf("%w: meta block nonce %d, epoch %d", ErrInvalidEpochStartHeader, metaBlock.GetNonce(), metaBlock.GetEpoch())
+	}
+
+	esd := &epochStartData{
+		metaBlock:      metaBlock,
+		metaBlockBytes: metaBlockBytes,
+		metaBlockHash:  hasher.Compute(string(metaBlockBytes)),
+		header:         metaBlock,
+		headerBytes:    metaBlockBytes,
+	}
+	esd.headerHash = esd.metaBlockHash
+
+	if shardID != core.MetachainShardId {
+		err = esd.setShardHeader(persisters, shardID, epoch, marshalizer, hasher)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	esd.bootstrapData, err = getBootstrapData(bootstrapPersister, int64(esd.header.GetRound()), marshalizer)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(esd.bootstrapData.LastHeader.Hash, esd.headerHash) {
+		return nil, fmt.Errorf("%w: round %d", ErrBootstrapDataMismatch, esd.header.GetRound())
+	}
+
+	return esd, nil
+}
+
+func (esd *epochStartData) setShardHeader(
+	persisters *persistersHolder,
+	shardID uint32,
+	epoch uint32,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+) error {
+	headersPersister, err := persisters.get(dataRetriever.BlockHeaderUnit, epoch)
+	if err != nil {
+		return err
+	}
+
+	headerBytes, err := headersPersister.Get([]byte(core.EpochStartIdentifier(epoch)))
+	if err != nil {
+		return fmt.Errorf("%w while reading the epoch start header of shard %d, epoch %d", err, shardID, epoch)
+	}
+
+	header, err := process.CreateShardHeader(marshalizer, headerBytes)
+	if err != nil {
+		return err
+	}
+
+	isValidHeader := header.GetEpoch() == epoch &&
+		header.GetShardID() == shardID &&
+		header.IsStartOfEpochBlock() &&
+		bytes.Equal(header.GetEpochStartMetaHash(), esd.metaBlockHash)
+	if !isValidHeader {
+		return fmt.Errorf("%w: shard %d header nonce %d, epoch %d", ErrInvalidEpochStartHeader, header.GetShardID(), header.GetNonce(), header.GetEpoch())
+	}
+
+	esd.header = header
+	esd.headerBytes = headerBytes
+	esd.headerHash = hasher.Compute(string(headerBytes))
+
+	return nil
+}
+
+// rootHashes returns the state root hashes the snapshot must contain: the accounts root hash and, for the metachain,
+// the validators statistics root hash
+func (esd *epochStartData) rootHashes() (accountsRootHash []byte, validatorStatsRootHash []byte) {
+	if esd.header.GetShardID() != core.MetachainShardId {
+		return esd.header.GetRootHash(), nil
+	}
+
+	return esd.metaBlock.GetRootHash(), esd.metaBlock.GetValidatorStatsRootHash()
+}
+
+func getBootstrapData(bootstrapPersister storage.Persister, round int64, marshalizer marshal.Marshalizer) (*bootstrapStorage.BootstrapData, error) {
+	bootstrapDataBytes, err := bootstrapPersister.Get([]byte(strconv.FormatInt(round, 10)))
+	if err != nil {
+		return nil, fmt.Errorf("%w while reading the bootstrap data of round %d", err, round)
+	}
+
+	bootstrapData := &bootstrapStorage.BootstrapData{}
+	err = marshalizer.Unmarshal(bootstrapData, bootstrapDataBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return bootstrapData, nil
+}
@@ -0,0 +1,54 @@
+package snapshot
+
+import "errors"
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilHasher signals that a nil hasher has been provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrEmptyDatabasePath signals that an empty databases path has been provided
+var ErrEmptyDatabasePath = errors.New("empty databases path")
+
+// ErrEmptyArchivePath signals that an empty snapshot archive path has been provided
+var ErrEmptyArchivePath = errors.New("empty snapshot archive path")
+
+// ErrInvalidArchive signals that the snapshot archive is malformed
+var ErrInvalidArchive = errors.New("invalid snapshot archive")
+
+// ErrUnsupportedArchiveVersion signals that the snapshot archive was written with an unsupported format version
+var ErrUnsupportedArchiveVersion = errors.New("unsupported snapshot archive version")
+
+// ErrChecksumMismatch signals that the checksum of the snapshot archive does not match its content
+var ErrChecksumMismatch = errors.New("snapshot archive checksum mismatch")
+
+// ErrChainIDMismatch signals that the snapshot archive was exported from another chain
+var ErrChainIDMismatch = errors.New("snapshot archive chain ID mismatch")
+
+// ErrDestinationNotEmpty signals that the databases directory the snapshot should be imported in already holds data
+var ErrDestinationNotEmpty = errors.New("databases directory is not empty")
+
+// ErrMissingStorageUnit signals that a storage unit needed for the snapshot was not found on disk
+var ErrMissingStorageUnit = errors.New("missing storage unit")
+
+// ErrUnsupportedUnit signals that a storage unit can not be part of a snapshot archive
+var ErrUnsupportedUnit = errors.New("unsupported storage unit")
+
+// ErrInvalidEpochStartHeader signals that the epoch start header is not valid for the requested epoch
+var ErrInvalidEpochStartHeader = errors.New("invalid epoch start header")
+
+// ErrHeaderHashMismatch signals that a header does not match the hash it is referenced with
+var ErrHeaderHashMismatch = errors.New("header hash mismatch")
+
+// ErrBootstrapDataMismatch signals that the bootstrap data does not reference the epoch start header
+var ErrBootstrapDataMismatch = errors.New("bootstrap data does not reference the epoch start header")
+
+// ErrRootHashMismatch signals that a root hash does not match the one from the epoch start header
+var ErrRootHashMismatch = errors.New("root hash mismatch")
+
+// ErrMissingTrieNode signals that a trie node reachable from the root hash was not found
+var ErrMissingTrieNode = errors.New("missing trie node")
+
+// ErrTrieNodeHashMismatch signals that a trie node does not match the hash it is referenced with
+var ErrTrieNodeHashMismatch = errors.New("trie node hash mismatch")
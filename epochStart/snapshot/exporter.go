@@ -0,0 +1,416 @@
+package snapshot
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/typeConverters"
+	"github.com/ElrondNetwork/elrond-go-core/data/typeConverters/uint64ByteSlice"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/process/block/bootstrapStorage"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var log = logger.GetOrCreate("epochStart/snapshot")
+
+// ArgsExporter holds the arguments needed for creating a new snapshot exporter
+type ArgsExporter struct {
+	DbPath        string
+	GeneralConfig config.Config
+	Marshalizer   marshal.Marshalizer
+	Hasher        hashing.Hasher
+}
+
+type exporter struct {
+	dbPath          string
+	generalConfig   config.Config
+	marshalizer     marshal.Marshalizer
+	hasher          hashing.Hasher
+	uint64Converter typeConverters.Uint64ByteSliceConverter
+}
+
+// snapshotWriter writes the entries in the archive, keeping the manifest counters up to date. The header and bootstrap
+// entries, which can be referenced more than once, are written only once, while the trie nodes are written as they are
+// walked: they are content addressed, so writing one again is harmless and tracking them would not fit in memory
+type snapshotWriter struct {
+	archive  *archiveWriter
+	manifest *Manifest
+	written  map[string]struct{}
+}
+
+func (sw *snapshotWriter) write(entry Entry) error {
+	writtenKey := string(append([]byte{byte(entry.Unit)}, entry.Key...))
+	_, alreadyWritten := sw.written[writtenKey]
+	if alreadyWritten {
+		return nil
+	}
+
+	err := sw.archive.writeEntry(entry)
+	if err != nil {
+		return err
+	}
+	sw.written[writtenKey] = struct{}{}
+	sw.manifest.NumEntries++
+
+	return nil
+}
+
+func (sw *snapshotWriter) writeTrieNode(entry Entry) error {
+	err := sw.archive.writeEntry(entry)
+	if err != nil {
+		return err
+	}
+	sw.manifest.NumEntries++
+	sw.manifest.NumTrieNodes++
+
+	return nil
+}
+
+// NewExporter creates a component able to export the state of a node's databases at an epoch start
+// in a snapshot archive
+func NewExporter(args ArgsExporter) (*exporter, error) {
+	if len(args.DbPath) == 0 {
+		return nil, ErrEmptyDatabasePath
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+
+	return &exporter{
+		dbPath:          args.DbPath,
+		generalConfig:   args.GeneralConfig,
+		marshalizer:     args.Marshalizer,
+		hasher:          args.Hasher,
+		uint64Converter: uint64ByteSlice.NewBigEndianConverter(),
+	}, nil
+}
+
+// Export writes, at the provided path, the snapshot archive of the provided shard's state at the start of the
+// provided epoch: the epoch start headers, the bootstrap data saved when the shard's epoch start header was committed
+// (with the nodes coordinator and epoch start trigger registries and the headers it references) and all the trie
+// nodes reachable from the epoch start root hashes. The node must be stopped while exporting
+func (e *exporter) Export(shardID uint32, epoch uint32, archivePath string) (*Manifest, error) {
+	if len(archivePath) == 0 {
+		return nil, ErrEmptyArchivePath
+	}
+
+	persisters, err := newPersistersHolder(e.dbPath, e.generalConfig, shardID, false)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = persisters.closeAll()
+	}()
+
+	esd, err := getEpochStartData(persisters, shardID, epoch, e.marshalizer, e.hasher)
+	if err != nil {
+		return nil, err
+	}
+
+	rootHash, validatorStatsRootHash := esd.rootHashes()
+	manifest := &Manifest{
+		Version:                 archiveVersion,
+		ChainID:                 e.generalConfig.GeneralSettings.ChainID,
+		ShardID:                 shardID,
+		Epoch:                   epoch,
+		Round:                   int64(esd.header.GetRound()),
+		EpochStartMetaBlockHash: esd.metaBlockHash,
+		EpochStartHeaderHash:    esd.headerHash,
+		RootHash:                rootHash,
+		ValidatorStatsRootHash:  validatorStatsRootHash,
+	}
+
+	archive, err := newArchiveWriter(archivePath)
+	if err != nil {
+		return nil, err
+	}
+	writer := &snapshotWriter{
+		archive:  archive,
+		manifest: manifest,
+		written:  make(map[string]struct{}),
+	}
+
+	err = e.exportEntries(writer, persisters, esd)
+	if err == nil {
+		err = archive.finalize(manifest)
+	}
+	if err != nil {
+		archive.abort()
+		return nil, err
+	}
+
+	return manifest, nil
+}
+
+func (e *exporter) exportEntries(writer *snapshotWriter, persisters *persistersHolder, esd *epochStartData) error {
+	err := e.exportEpochStartHeaders(writer, esd)
+	if err != nil {
+		return err
+	}
+
+	err = e.exportBootstrapData(writer, persisters, esd)
+	if err != nil {
+		return err
+	}
+
+	for _, headerInfo := range getReferencedHeaders(esd.bootstrapData) {
+		err = e.exportHeader(writer, persisters, headerInfo)
+		if err != nil {
+			return err
+		}
+	}
+
+	rootHash, validatorStatsRootHash := esd.rootHashes()
+	err = e.exportTrie(writer, persisters, dataRetriever.UserAccountsUnit, rootHash, true)
+	if err != nil {
+		return err
+	}
+	if esd.header.GetShardID() != core.MetachainShardId {
+		return nil
+	}
+
+	return e.exportTrie(writer, persisters, dataRetriever.PeerAccountsUnit, validatorStatsRootHash, false)
+}
+
+func (e *exporter) exportEpochStartHeaders(writer *snapshotWriter, esd *epochStartData) error {
+	epochStartIdentifier := []byte(core.EpochStartIdentifier(esd.metaBlock.GetEpoch()))
+	entries := []Entry{
+		{Unit: dataRetriever.BootstrapUnit, Key: epochStartIdentifier, Value: esd.metaBlockBytes},
+		{Unit: dataRetriever.MetaBlockUnit, Key: epochStartIdentifier, Value: esd.metaBlockBytes},
+		{Unit: dataRetriever.MetaBlockUnit, Key: esd.metaBlockHash, Value: esd.metaBlockBytes},
+		{Unit: dataRetriever.MetaHdrNonceHashDataUnit, Key: e.uint64Converter.ToByteSlice(esd.metaBlock.GetNonce()), Value: esd.metaBlockHash},
+	}
+
+	shardID := esd.header.GetShardID()
+	if shardID != core.MetachainShardId {
+		entries = append(entries,
+			Entry{Unit: dataRetriever.BlockHeaderUnit, Key: epochStartIdentifier, Value: esd.headerBytes},
+			Entry{Unit: dataRetriever.BlockHeaderUnit, Key: esd.headerHash, Value: esd.headerBytes},
+			Entry{Unit: nonceHashUnit(shardID), Key: e.uint64Converter.ToByteSlice(esd.header.GetNonce()), Value: esd.headerHash},
+		)
+	}
+
+	return writeEntries(writer, entries)
+}
+
+func (e *exporter) exportBootstrapData(writer *snapshotWriter, persisters *persistersHolder, esd *epochStartData) error {
+	bootstrapPersister, err := persisters.get(dataRetriever.BootstrapUnit, esd.metaBlock.GetEpoch())
+	if err != nil {
+		return err
+	}
+
+	round := int64(esd.header.GetRound())
+	roundNumBytes, err := e.marshalizer.Marshal(&bootstrapStorage.RoundNum{Num: round})
+	if err != nil {
+		return err
+	}
+	entries := []Entry{
+		{Unit: dataRetriever.BootstrapUnit, Key: []byte(common.HighestRoundFromBootStorage), Value: roundNumBytes},
+	}
+
+	keys := [][]byte{
+		[]byte(strconv.FormatInt(round, 10)),
+		append([]byte(common.NodesCoordinatorRegistryKeyPrefix), esd.bootstrapData.NodesCoordinatorConfigKey...),
+		append([]byte(common.TriggerRegistryKeyPrefix), esd.bootstrapData.EpochStartTriggerConfigKey...),
+	}
+	for _, key := range keys {
+		value, errGet := bootstrapPersister.Get(key)
+		if errGet != nil {
+			return fmt.Errorf("%w while reading the bootstrap unit key %s", errGet, key)
+		}
+
+		entries = append(entries, Entry{Unit: dataRetriever.BootstrapUnit, Key: key, Value: value})
+	}
+
+	return writeEntries(writer, entries)
+}
+
+func (e *exporter) exportHeader(
+	writer *snapshotWriter,
+	persisters *persistersHolder,
+	headerInfo bootstrapStorage.BootstrapHeaderInfo,
+) error {
+	if len(headerInfo.Hash) == 0 {
+		return nil
+	}
+
+	headersUnit := dataRetriever.BlockHeaderUnit
+	if headerInfo.ShardId == core.MetachainShardId {
+		headersUnit = dataRetriever.MetaBlockUnit
+	}
+
+	// a header can be saved in the next epoch's storer if it was committed after the epoch change
+	for _, epoch := range []uint32{headerInfo.Epoch, headerInfo.Epoch + 1} {
+		persister, err := persisters.get(headersUnit, epoch)
+		if errors.Is(err, ErrMissingStorageUnit) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		headerBytes, err := persister.Get(headerInfo.Hash)
+		if err != nil {
+			continue
+		}
+		if !bytes.Equal(e.hasher.Compute(string(headerBytes)), headerInfo.Hash) {
+			return fmt.Errorf("%w: shard %d, nonce %d, hash %x", ErrHeaderHashMismatch, headerInfo.ShardId, headerInfo.Nonce, headerInfo.Hash)
+		}
+
+		return writeEntries(writer, []Entry{
+			{Unit: headersUnit, Key: headerInfo.Hash, Value: headerBytes},
+			{Unit: nonceHashUnit(headerInfo.ShardId), Key: e.uint64Converter.ToByteSlice(headerInfo.Nonce), Value: headerInfo.Hash},
+		})
+	}
+
+	return fmt.Errorf("%w: shard %d, nonce %d, hash %x", storage.ErrKeyNotFound, headerInfo.ShardId, headerInfo.Nonce, headerInfo.Hash)
+}
+
+func (e *exporter) exportTrie(
+	writer *snapshotWriter,
+	persisters *persistersHolder,
+	unit dataRetriever.UnitType,
+	rootHash []byte,
+	withDataTries bool,
+) error {
+	triePersisters, err := e.getTriePersisters(persisters, unit, writer.manifest.Epoch)
+	if err != nil {
+		return err
+	}
+
+	reader := &trieNodesReader{
+		persisters: triePersisters,
+		hasher:     e.hasher,
+		handler: func(key []byte, value []byte) error {
+			return writer.writeTrieNode(Entry{Unit: unit, Key: key, Value: value})
+		},
+	}
+
+	maxTrieLevelInMemory := e.generalConfig.StateTriesConfig.MaxStateTrieLevelInMemory
+	if unit == dataRetriever.PeerAccountsUnit {
+		maxTrieLevelInMemory = e.generalConfig.StateTriesConfig.MaxPeerTrieLevelInMemory
+	}
+
+	err = walkTrie(reader, e.marshalizer, maxTrieLevelInMemory, rootHash, withDataTries)
+	if err != nil {
+		return fmt.Errorf("%w while exporting the %s trie with root hash %x", err, unit.String(), rootHash)
+	}
+
+	log.Debug("trie exported", "unit", unit.String(), "root hash", rootHash, "num trie nodes", writer.manifest.NumTrieNodes)
+
+	return nil
+}
+
+// getTriePersisters returns the provided trie unit's persisters from all the epochs found on disk. The epoch start
+// root hash is snapshotted in the exported epoch's storer, so it is searched first, followed by the older epochs
+func (e *exporter) getTriePersisters(
+	persisters *persistersHolder,
+	unit dataRetriever.UnitType,
+	exportedEpoch uint32,
+) ([]storage.Persister, error) {
+	epochs, err := e.epochsOnDisk(exportedEpoch)
+	if err != nil {
+		return nil, err
+	}
+
+	triePersisters := make([]storage.Persister, 0, len(epochs))
+	for _, epoch := range epochs {
+		persister, errGet := persisters.get(unit, epoch)
+		if errors.Is(errGet, ErrMissingStorageUnit) {
+			continue
+		}
+		if errGet != nil {
+			return nil, errGet
+		}
+
+		triePersisters = append(triePersisters, persister)
+	}
+
+	return triePersisters, nil
+}
+
+// epochsOnDisk returns the epochs found in the databases directory, starting with the provided one, followed by the
+// older epochs in descending order and by the newer epochs in ascending order
+func (e *exporter) epochsOnDisk(exportedEpoch uint32) ([]uint32, error) {
+	files, err := ioutil.ReadDir(e.dbPath)
+	if err != nil {
+		return nil, err
+	}
+
+	epochs := []uint32{exportedEpoch}
+	for _, file := range files {
+		if !file.IsDir() || !strings.HasPrefix(file.Name(), common.DefaultEpochString+"_") {
+			continue
+		}
+
+		epoch, errParse := strconv.ParseUint(strings.TrimPrefix(file.Name(), common.DefaultEpochString+"_"), 10, 32)
+		if errParse != nil || uint32(epoch) == exportedEpoch {
+			continue
+		}
+		epochs = append(epochs, uint32(epoch))
+	}
+
+	others := epochs[1:]
+	sort.Slice(others, func(i, j int) bool {
+		isOlderI := others[i] < exportedEpoch
+		isOlderJ := others[j] < exportedEpoch
+		if isOlderI != isOlderJ {
+			return isOlderI
+		}
+		if isOlderI {
+			return others[i] > others[j]
+		}
+
+		return others[i] < others[j]
+	})
+
+	return epochs, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (e *exporter) IsInterfaceNil() bool {
+	return e == nil
+}
+
+func writeEntries(writer *snapshotWriter, entries []Entry) error {
+	for _, entry := range entries {
+		err := writer.write(entry)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func getReferencedHeaders(bootstrapData *bootstrapStorage.BootstrapData) []bootstrapStorage.BootstrapHeaderInfo {
+	headers := make([]bootstrapStorage.BootstrapHeaderInfo, 0, 1+len(bootstrapData.LastCrossNotarizedHeaders)+len(bootstrapData.LastSelfNotarizedHeaders))
+	headers = append(headers, bootstrapData.LastHeader)
+	headers = append(headers, bootstrapData.LastCrossNotarizedHeaders...)
+	headers = append(headers, bootstrapData.LastSelfNotarizedHeaders...)
+
+	return headers
+}
+
+func nonceHashUnit(shardID uint32) dataRetriever.UnitType {
+	if shardID == core.MetachainShardId {
+		return dataRetriever.MetaHdrNonceHashDataUnit
+	}
+
+	return dataRetriever.ShardHdrNonceHashDataUnit + dataRetriever.UnitType(shardID)
+}
@@ -0,0 +1,412 @@
+package snapshot
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/hashing/sha256"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/process/block/bootstrapStorage"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testChainID = "test chain"
+	testEpoch   = uint32(2)
+)
+
+var (
+	testMarshalizer = &marshal.GogoProtoMarshalizer{}
+	testHasher      = sha256.NewSha256()
+)
+
+// testNodeDatabases holds the information about the databases directory created by createTestNodeDatabases
+type testNodeDatabases struct {
+	dbPath                 string
+	shardID                uint32
+	epochStartHeaderHash   []byte
+	rootHash               []byte
+	validatorStatsRootHash []byte
+	trieNodes              map[string][]byte
+	numTrieNodes           int
+}
+
+func createTestGeneralConfig() config.Config {
+	dbConfig := func(filePath string) config.DBConfig {
+		return config.DBConfig{
+			FilePath:          filePath,
+			Type:              string(storageUnit.LvlDBSerial),
+			BatchDelaySeconds: 1,
+			MaxBatchSize:      100,
+			MaxOpenFiles:      10,
+		}
+	}
+
+	cfg := config.Config{}
+	cfg.GeneralSettings.ChainID = testChainID
+	cfg.BootstrapStorage.DB = dbConfig("BootstrapData")
+	cfg.MetaBlockStorage.DB = dbConfig("MetaBlock")
+	cfg.BlockHeaderStorage.DB = dbConfig("BlockHeaders")
+	cfg.MetaHdrNonceHashStorage.DB = dbConfig("MetaHdrHashNonce")
+	cfg.ShardHdrNonceHashStorage.DB = dbConfig("ShardHdrHashNonce")
+	cfg.AccountsTrieStorage.DB = dbConfig("AccountsTrie")
+	cfg.PeerAccountsTrieStorage.DB = dbConfig("PeerAccountsTrie")
+	cfg.StateTriesConfig.MaxStateTrieLevelInMemory = 5
+	cfg.StateTriesConfig.MaxPeerTrieLevelInMemory = 5
+
+	return cfg
+}
+
+func createTestTrie(t *testing.T, db *memorydb.DB, values map[string][]byte) []byte {
+	storageManager, err := trie.NewTrieStorageManagerWithoutPruning(db)
+	require.Nil(t, err)
+	tr, err := trie.NewTrie(storageManager, testMarshalizer, testHasher, 5)
+	require.Nil(t, err)
+
+	for key, value := range values {
+		err = tr.Update([]byte(key), value)
+		require.Nil(t, err)
+	}
+	err = tr.Commit()
+	require.Nil(t, err)
+
+	rootHash, err := tr.RootHash()
+	require.Nil(t, err)
+
+	return rootHash
+}
+
+func createTestAccountsTrie(t *testing.T, db *memorydb.DB) []byte {
+	dataTrieRootHash := createTestTrie(t, db, map[string][]byte{
+		"data key 1": []byte("data value 1"),
+		"data key 2": []byte("data value 2"),
+		"data key 3": []byte("data value 3"),
+	})
+
+	accounts := make(map[string][]byte)
+	for i := 0; i < 20; i++ {
+		account := state.NewEmptyUserAccount()
+		account.Nonce = uint64(i)
+		if i%5 == 0 {
+			account.RootHash = dataTrieRootHash
+		}
+
+		accountBytes, err := testMarshalizer.Marshal(account)
+		require.Nil(t, err)
+		accounts["address"+strconv.Itoa(i)] = accountBytes
+	}
+
+	return createTestTrie(t, db, accounts)
+}
+
+func putTestEntries(t *testing.T, persisters *persistersHolder, unit dataRetriever.UnitType, epoch uint32, entries map[string][]byte) {
+	persister, err := persisters.get(unit, epoch)
+	require.Nil(t, err)
+
+	for key, value := range entries {
+		err = persister.Put([]byte(key), value)
+		require.Nil(t, err)
+	}
+}
+
+func marshalAndHash(t *testing.T, obj interface{}) ([]byte, []byte) {
+	objBytes, err := testMarshalizer.Marshal(obj)
+	require.Nil(t, err)
+
+	return objBytes, testHasher.Compute(string(objBytes))
+}
+
+// createTestNodeDatabases writes the databases of a node that has committed the epoch start block of testEpoch
+// and a later block, having the trie nodes spread over the previous and the current epoch storers
+func createTestNodeDatabases(t *testing.T, shardID uint32) *testNodeDatabases {
+	tnd := &testNodeDatabases{
+		dbPath:  filepath.Join(t.TempDir(), "db", testChainID),
+		shardID: shardID,
+	}
+
+	accountsTrieDB := memorydb.New()
+	tnd.rootHash = createTestAccountsTrie(t, accountsTrieDB)
+	peerAccountsTrieDB := memorydb.New()
+	if shardID == core.MetachainShardId {
+		tnd.validatorStatsRootHash = createTestTrie(t, peerAccountsTrieDB, map[string][]byte{
+			"validator 1": []byte("rating 1"),
+			"validator 2": []byte("rating 2"),
+		})
+	}
+
+	metaBlock := &block.MetaBlock{
+		Nonce: 20,
+		Round: 22,
+		Epoch: testEpoch,
+		EpochStart: block.EpochStart{
+			LastFinalizedHeaders: []block.EpochStartShardData{{ShardID: 0}},
+		},
+		RootHash:               tnd.rootHash,
+		ValidatorStatsRootHash: tnd.validatorStatsRootHash,
+	}
+	metaBlockBytes, metaBlockHash := marshalAndHash(t, metaBlock)
+
+	persisters, err := newPersistersHolder(tnd.dbPath, createTestGeneralConfig(), shardID, true)
+	require.Nil(t, err)
+	defer func() {
+		require.Nil(t, persisters.closeAll())
+	}()
+
+	epochStartIdentifier := core.EpochStartIdentifier(testEpoch)
+	putTestEntries(t, persisters, dataRetriever.MetaBlockUnit, testEpoch, map[string][]byte{
+		epochStartIdentifier:  metaBlockBytes,
+		string(metaBlockHash): metaBlockBytes,
+	})
+
+	epochStartHeaderInfo := bootstrapStorage.BootstrapHeaderInfo{
+		ShardId: core.MetachainShardId,
+		Epoch:   testEpoch,
+		Nonce:   metaBlock.Nonce,
+		Hash:    metaBlockHash,
+	}
+	epochStartRound := metaBlock.Round
+	lastCrossNotarizedHeaders := make([]bootstrapStorage.BootstrapHeaderInfo, 0)
+	if shardID != core.MetachainShardId {
+		header := &block.Header{
+			Nonce:              30,
+			Round:              23,
+			Epoch:              testEpoch,
+			ShardID:            shardID,
+			EpochStartMetaHash: metaBlockHash,
+			RootHash:           tnd.rootHash,
+		}
+		headerBytes, headerHash := marshalAndHash(t, header)
+		putTestEntries(t, persisters, dataRetriever.BlockHeaderUnit, testEpoch, map[string][]byte{
+			epochStartIdentifier: headerBytes,
+			string(headerHash):   headerBytes,
+		})
+
+		lastCrossNotarizedHeaders = append(lastCrossNotarizedHeaders, epochStartHeaderInfo)
+		epochStartHeaderInfo = bootstrapStorage.BootstrapHeaderInfo{
+			ShardId: shardID,
+			Epoch:   testEpoch,
+			Nonce:   header.Nonce,
+			Hash:    headerHash,
+		}
+		epochStartRound = header.Round
+	}
+	tnd.epochStartHeaderHash = epochStartHeaderInfo.Hash
+
+	bootstrapData := &bootstrapStorage.BootstrapData{
+		LastHeader:                 epochStartHeaderInfo,
+		LastCrossNotarizedHeaders:  lastCrossNotarizedHeaders,
+		LastSelfNotarizedHeaders:   []bootstrapStorage.BootstrapHeaderInfo{epochStartHeaderInfo},
+		NodesCoordinatorConfigKey:  []byte("nodes coordinator key"),
+		EpochStartTriggerConfigKey: []byte("trigger key"),
+	}
+	bootstrapDataBytes, _ := marshalAndHash(t, bootstrapData)
+	laterBootstrapData := &bootstrapStorage.BootstrapData{
+		LastHeader: bootstrapStorage.BootstrapHeaderInfo{ShardId: shardID, Epoch: testEpoch, Nonce: 100, Hash: []byte("later hash")},
+	}
+	laterBootstrapDataBytes, _ := marshalAndHash(t, laterBootstrapData)
+	highestRoundBytes, _ := marshalAndHash(t, &bootstrapStorage.RoundNum{Num: 100})
+	putTestEntries(t, persisters, dataRetriever.BootstrapUnit, testEpoch, map[string][]byte{
+		epochStartIdentifier:                    metaBlockBytes,
+		strconv.FormatUint(epochStartRound, 10): bootstrapDataBytes,
+		"100":                                   laterBootstrapDataBytes,
+		common.HighestRoundFromBootStorage:      highestRoundBytes,
+		common.NodesCoordinatorRegistryKeyPrefix + "nodes coordinator key": []byte("nodes coordinator registry"),
+		common.TriggerRegistryKeyPrefix + "trigger key":                    []byte("trigger registry"),
+	})
+
+	tnd.trieNodes = make(map[string][]byte)
+	tnd.putTrieNodes(t, persisters, dataRetriever.UserAccountsUnit, accountsTrieDB)
+	if shardID == core.MetachainShardId {
+		tnd.putTrieNodes(t, persisters, dataRetriever.PeerAccountsUnit, peerAccountsTrieDB)
+	}
+
+	return tnd
+}
+
+// putTrieNodes spreads the trie nodes between the previous and the current epoch storers
+func (tnd *testNodeDatabases) putTrieNodes(t *testing.T, persisters *persistersHolder, unit dataRetriever.UnitType, db *memorydb.DB) {
+	index := 0
+	db.RangeKeys(func(key []byte, value []byte) bool {
+		epoch := testEpoch
+		if index%2 == 0 {
+			epoch = testEpoch - 1
+		}
+		index++
+
+		putTestEntries(t, persisters, unit, epoch, map[string][]byte{string(key): value})
+		tnd.trieNodes[string(key)] = value
+		tnd.numTrieNodes++
+
+		return true
+	})
+}
+
+func createTestExporter(dbPath string, hasher hashing.Hasher) *exporter {
+	e, _ := NewExporter(ArgsExporter{
+		DbPath:        dbPath,
+		GeneralConfig: createTestGeneralConfig(),
+		Marshalizer:   testMarshalizer,
+		Hasher:        hasher,
+	})
+
+	return e
+}
+
+func TestNewExporter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty db path should err", func(t *testing.T) {
+		t.Parallel()
+
+		e, err := NewExporter(ArgsExporter{Marshalizer: testMarshalizer, Hasher: testHasher})
+		assert.Nil(t, e)
+		assert.Equal(t, ErrEmptyDatabasePath, err)
+	})
+	t.Run("nil marshalizer should err", func(t *testing.T) {
+		t.Parallel()
+
+		e, err := NewExporter(ArgsExporter{DbPath: "db", Hasher: testHasher})
+		assert.Nil(t, e)
+		assert.Equal(t, ErrNilMarshalizer, err)
+	})
+	t.Run("nil hasher should err", func(t *testing.T) {
+		t.Parallel()
+
+		e, err := NewExporter(ArgsExporter{DbPath: "db", Marshalizer: testMarshalizer})
+		assert.Nil(t, e)
+		assert.Equal(t, ErrNilHasher, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		e := createTestExporter("db", testHasher)
+		assert.False(t, check.IfNil(e))
+	})
+}
+
+func TestExporter_ExportShouldWriteTheEpochStartState(t *testing.T) {
+	t.Parallel()
+
+	for _, shardID := range []uint32{0, core.MetachainShardId} {
+		tnd := createTestNodeDatabases(t, shardID)
+		archivePath := filepath.Join(t.TempDir(), "snapshot")
+
+		manifest, err := createTestExporter(tnd.dbPath, testHasher).Export(shardID, testEpoch, archivePath)
+		require.Nil(t, err)
+		assert.Equal(t, testChainID, manifest.ChainID)
+		assert.Equal(t, shardID, manifest.ShardID)
+		assert.Equal(t, testEpoch, manifest.Epoch)
+		assert.Equal(t, tnd.epochStartHeaderHash, manifest.EpochStartHeaderHash)
+		assert.Equal(t, tnd.rootHash, manifest.RootHash)
+		assert.Equal(t, tnd.validatorStatsRootHash, manifest.ValidatorStatsRootHash)
+
+		entries, readManifest := readAllEntries(t, archivePath)
+		assert.Equal(t, manifest, readManifest)
+		assert.Equal(t, int(manifest.NumEntries), len(entries))
+
+		// the trie nodes shared by several tries are written once for each of them
+		numTrieNodes := 0
+		writtenTrieNodes := make(map[string]struct{})
+		foundHighestRound := false
+		for _, entry := range entries {
+			if entry.Unit == dataRetriever.UserAccountsUnit || entry.Unit == dataRetriever.PeerAccountsUnit {
+				assert.Equal(t, tnd.trieNodes[string(entry.Key)], entry.Value)
+				writtenTrieNodes[string(entry.Key)] = struct{}{}
+				numTrieNodes++
+			}
+			if entry.Unit == dataRetriever.BootstrapUnit && string(entry.Key) == common.HighestRoundFromBootStorage {
+				roundNum := &bootstrapStorage.RoundNum{}
+				err = testMarshalizer.Unmarshal(roundNum, entry.Value)
+				require.Nil(t, err)
+				assert.Equal(t, manifest.Round, roundNum.Num)
+				foundHighestRound = true
+			}
+			assert.NotEqual(t, "100", string(entry.Key))
+		}
+		assert.Equal(t, tnd.numTrieNodes, len(writtenTrieNodes))
+		assert.Equal(t, int(manifest.NumTrieNodes), numTrieNodes)
+		assert.True(t, foundHighestRound)
+	}
+}
+
+func TestExporter_ExportMissingEpochShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tnd := createTestNodeDatabases(t, 0)
+	archivePath := filepath.Join(t.TempDir(), "snapshot")
+
+	manifest, err := createTestExporter(tnd.dbPath, testHasher).Export(0, testEpoch+1, archivePath)
+	assert.Nil(t, manifest)
+	assert.True(t, errors.Is(err, ErrMissingStorageUnit))
+}
+
+func TestExporter_ExportMissingTrieNodeShouldErrAndRemoveTheArchive(t *testing.T) {
+	t.Parallel()
+
+	tnd := createTestNodeDatabases(t, 0)
+	persisters, err := newPersistersHolder(tnd.dbPath, createTestGeneralConfig(), 0, false)
+	require.Nil(t, err)
+	for _, epoch := range []uint32{testEpoch - 1, testEpoch} {
+		persister, errGet := persisters.get(dataRetriever.UserAccountsUnit, epoch)
+		require.Nil(t, errGet)
+		for key := range tnd.trieNodes {
+			if key != string(tnd.rootHash) {
+				_ = persister.Remove([]byte(key))
+			}
+		}
+	}
+	require.Nil(t, persisters.closeAll())
+
+	archivePath := filepath.Join(t.TempDir(), "snapshot")
+	manifest, err := createTestExporter(tnd.dbPath, testHasher).Export(0, testEpoch, archivePath)
+	assert.Nil(t, manifest)
+	assert.True(t, errors.Is(err, ErrMissingTrieNode))
+
+	_, err = os.Stat(archivePath)
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestExporter_ExportDifferentHasherShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tnd := createTestNodeDatabases(t, 0)
+	archivePath := filepath.Join(t.TempDir(), "snapshot")
+
+	// the epoch start header hash computed with another hasher is not referenced by the epoch start shard header
+	manifest, err := createTestExporter(tnd.dbPath, &testscommon.HasherStub{
+		ComputeCalled: func(s string) []byte {
+			return []byte("hash")
+		},
+	}).Export(0, testEpoch, archivePath)
+	assert.Nil(t, manifest)
+	assert.True(t, errors.Is(err, ErrInvalidEpochStartHeader))
+}
+
+func TestExporter_EpochsOnDisk(t *testing.T) {
+	t.Parallel()
+
+	dbPath := t.TempDir()
+	for _, dir := range []string{"Epoch_0", "Epoch_1", "Epoch_3", "Epoch_4", "Epoch_5", "Static", "Epoch_x"} {
+		require.Nil(t, os.Mkdir(filepath.Join(dbPath, dir), os.ModePerm))
+	}
+
+	epochs, err := createTestExporter(dbPath, testHasher).epochsOnDisk(3)
+	require.Nil(t, err)
+	assert.Equal(t, []uint32{3, 1, 0, 4, 5}, epochs)
+}
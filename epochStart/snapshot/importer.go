@@ -0,0 +1,271 @@
+package snapshot
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/process/block/bootstrapStorage"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// ArgsImporter holds the arguments needed for creating a new snapshot importer
+type ArgsImporter struct {
+	DbPath        string
+	GeneralConfig config.Config
+	Marshalizer   marshal.Marshalizer
+	Hasher        hashing.Hasher
+}
+
+type importer struct {
+	dbPath        string
+	generalConfig config.Config
+	marshalizer   marshal.Marshalizer
+	hasher        hashing.Hasher
+}
+
+// NewImporter creates a component able to import a snapshot archive in an empty databases directory,
+// so that the node can bootstrap from its local storage
+func NewImporter(args ArgsImporter) (*importer, error) {
+	if len(args.DbPath) == 0 {
+		return nil, ErrEmptyDatabasePath
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+
+	return &importer{
+		dbPath:        args.DbPath,
+		generalConfig: args.GeneralConfig,
+		marshalizer:   args.Marshalizer,
+		hasher:        args.Hasher,
+	}, nil
+}
+
+// Import verifies the checksum of the provided snapshot archive, writes its entries in the databases directory and
+// then checks the imported data: the epoch start headers must match the archive's manifest, the bootstrap data must
+// reference the epoch start header and the imported tries must be complete and match the epoch start root hashes.
+// The databases directory must not exist or be empty and it is removed if the import fails
+func (imp *importer) Import(archivePath string) (*Manifest, error) {
+	if len(archivePath) == 0 {
+		return nil, ErrEmptyArchivePath
+	}
+
+	err := checkDirectoryIsEmpty(imp.dbPath)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest, err := readArchive(archivePath, nil)
+	if err != nil {
+		return nil, err
+	}
+	if manifest.ChainID != imp.generalConfig.GeneralSettings.ChainID {
+		return nil, fmt.Errorf("%w: archive chain ID %s, node chain ID %s",
+			ErrChainIDMismatch, manifest.ChainID, imp.generalConfig.GeneralSettings.ChainID)
+	}
+
+	log.Info("importing snapshot",
+		"path", archivePath,
+		"shard", manifest.ShardID,
+		"epoch", manifest.Epoch,
+		"epoch start meta block hash", manifest.EpochStartMetaBlockHash,
+		"num entries", manifest.NumEntries)
+
+	err = imp.writeEntries(archivePath, manifest)
+	if err == nil {
+		err = imp.verify(manifest)
+	}
+	if err != nil {
+		log.LogIfError(os.RemoveAll(imp.dbPath), "path", imp.dbPath)
+		return nil, err
+	}
+
+	return manifest, nil
+}
+
+func (imp *importer) writeEntries(archivePath string, manifest *Manifest) error {
+	persisters, err := newPersistersHolder(imp.dbPath, imp.generalConfig, manifest.ShardID, true)
+	if err != nil {
+		return err
+	}
+
+	_, err = readArchive(archivePath, func(entry Entry) error {
+		persister, errGet := persisters.get(entry.Unit, manifest.Epoch)
+		if errGet != nil {
+			return errGet
+		}
+
+		return persister.Put(entry.Key, entry.Value)
+	})
+	if err == nil {
+		err = imp.markTriesAsComplete(persisters, manifest)
+	}
+
+	// closing the persisters writes the last batches
+	errClose := persisters.closeAll()
+	if err != nil {
+		return err
+	}
+
+	return errClose
+}
+
+// markTriesAsComplete saves the markers the node writes in a trie storer holding a complete state
+func (imp *importer) markTriesAsComplete(persisters *persistersHolder, manifest *Manifest) error {
+	units := []dataRetriever.UnitType{dataRetriever.UserAccountsUnit}
+	if manifest.ShardID == core.MetachainShardId {
+		units = append(units, dataRetriever.PeerAccountsUnit)
+	}
+
+	for _, unit := range units {
+		persister, err := persisters.get(unit, manifest.Epoch)
+		if err != nil {
+			return err
+		}
+
+		err = persister.Put([]byte(common.ActiveDBKey), []byte(common.ActiveDBVal))
+		if err != nil {
+			return err
+		}
+		err = persister.Put([]byte(common.TrieSyncedKey), []byte(common.TrieSyncedVal))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (imp *importer) verify(manifest *Manifest) error {
+	persisters, err := newPersistersHolder(imp.dbPath, imp.generalConfig, manifest.ShardID, false)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = persisters.closeAll()
+	}()
+
+	esd, err := getEpochStartData(persisters, manifest.ShardID, manifest.Epoch, imp.marshalizer, imp.hasher)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(esd.metaBlockHash, manifest.EpochStartMetaBlockHash) || !bytes.Equal(esd.headerHash, manifest.EpochStartHeaderHash) {
+		return fmt.Errorf("%w: epoch start header %x", ErrHeaderHashMismatch, esd.headerHash)
+	}
+
+	err = imp.verifyHighestRound(persisters, manifest, int64(esd.header.GetRound()))
+	if err != nil {
+		return err
+	}
+
+	rootHash, validatorStatsRootHash := esd.rootHashes()
+	err = imp.verifyTrie(persisters, manifest, dataRetriever.UserAccountsUnit, rootHash, manifest.RootHash)
+	if err != nil {
+		return err
+	}
+	if manifest.ShardID == core.MetachainShardId {
+		err = imp.verifyTrie(persisters, manifest, dataRetriever.PeerAccountsUnit, validatorStatsRootHash, manifest.ValidatorStatsRootHash)
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Info("snapshot imported and verified",
+		"shard", manifest.ShardID,
+		"epoch", manifest.Epoch,
+		"round", manifest.Round,
+		"epoch start header hash", esd.headerHash,
+		"root hash", rootHash)
+
+	return nil
+}
+
+func (imp *importer) verifyHighestRound(persisters *persistersHolder, manifest *Manifest, epochStartRound int64) error {
+	bootstrapPersister, err := persisters.get(dataRetriever.BootstrapUnit, manifest.Epoch)
+	if err != nil {
+		return err
+	}
+
+	roundNumBytes, err := bootstrapPersister.Get([]byte(common.HighestRoundFromBootStorage))
+	if err != nil {
+		return err
+	}
+	roundNum := &bootstrapStorage.RoundNum{}
+	err = imp.marshalizer.Unmarshal(roundNum, roundNumBytes)
+	if err != nil {
+		return err
+	}
+
+	if roundNum.Num != epochStartRound || roundNum.Num != manifest.Round {
+		return fmt.Errorf("%w: highest round %d, epoch start round %d", ErrBootstrapDataMismatch, roundNum.Num, epochStartRound)
+	}
+
+	return nil
+}
+
+func (imp *importer) verifyTrie(
+	persisters *persistersHolder,
+	manifest *Manifest,
+	unit dataRetriever.UnitType,
+	headerRootHash []byte,
+	manifestRootHash []byte,
+) error {
+	if !bytes.Equal(headerRootHash, manifestRootHash) {
+		return fmt.Errorf("%w for %s: header root hash %x, archive root hash %x",
+			ErrRootHashMismatch, unit.String(), headerRootHash, manifestRootHash)
+	}
+
+	persister, err := persisters.get(unit, manifest.Epoch)
+	if err != nil {
+		return err
+	}
+
+	reader := &trieNodesReader{
+		persisters: []storage.Persister{persister},
+		hasher:     imp.hasher,
+	}
+	maxTrieLevelInMemory := imp.generalConfig.StateTriesConfig.MaxStateTrieLevelInMemory
+	if unit == dataRetriever.PeerAccountsUnit {
+		maxTrieLevelInMemory = imp.generalConfig.StateTriesConfig.MaxPeerTrieLevelInMemory
+	}
+
+	err = walkTrie(reader, imp.marshalizer, maxTrieLevelInMemory, headerRootHash, unit == dataRetriever.UserAccountsUnit)
+	if err != nil {
+		return fmt.Errorf("%w while verifying the imported %s trie with root hash %x", err, unit.String(), headerRootHash)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (imp *importer) IsInterfaceNil() bool {
+	return imp == nil
+}
+
+func checkDirectoryIsEmpty(path string) error {
+	if !directoryExists(path) {
+		return nil
+	}
+
+	files, err := ioutil.ReadDir(path)
+	if err != nil {
+		return err
+	}
+	if len(files) > 0 {
+		return fmt.Errorf("%w: %s", ErrDestinationNotEmpty, path)
+	}
+
+	return nil
+}
@@ -0,0 +1,222 @@
+package snapshot
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestImporter(dbPath string) *importer {
+	imp, _ := NewImporter(ArgsImporter{
+		DbPath:        dbPath,
+		GeneralConfig: createTestGeneralConfig(),
+		Marshalizer:   testMarshalizer,
+		Hasher:        testHasher,
+	})
+
+	return imp
+}
+
+func exportTestArchive(t *testing.T, shardID uint32) (*testNodeDatabases, string) {
+	tnd := createTestNodeDatabases(t, shardID)
+	archivePath := filepath.Join(t.TempDir(), "snapshot")
+
+	_, err := createTestExporter(tnd.dbPath, testHasher).Export(shardID, testEpoch, archivePath)
+	require.Nil(t, err)
+
+	return tnd, archivePath
+}
+
+// rewriteTestArchive writes a new, correctly checksummed, archive with the entries of the provided one,
+// as modified by the handler
+func rewriteTestArchive(t *testing.T, archivePath string, handler func(entry Entry) Entry) string {
+	entries, manifest := readAllEntries(t, archivePath)
+	rewrittenPath := filepath.Join(t.TempDir(), "rewritten")
+
+	modifiedEntries := make([]Entry, 0, len(entries))
+	for _, entry := range entries {
+		modifiedEntries = append(modifiedEntries, handler(entry))
+	}
+	writeTestArchive(t, rewrittenPath, modifiedEntries, manifest)
+
+	return rewrittenPath
+}
+
+func TestNewImporter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty db path should err", func(t *testing.T) {
+		t.Parallel()
+
+		imp, err := NewImporter(ArgsImporter{Marshalizer: testMarshalizer, Hasher: testHasher})
+		assert.Nil(t, imp)
+		assert.Equal(t, ErrEmptyDatabasePath, err)
+	})
+	t.Run("nil marshalizer should err", func(t *testing.T) {
+		t.Parallel()
+
+		imp, err := NewImporter(ArgsImporter{DbPath: "db", Hasher: testHasher})
+		assert.Nil(t, imp)
+		assert.Equal(t, ErrNilMarshalizer, err)
+	})
+	t.Run("nil hasher should err", func(t *testing.T) {
+		t.Parallel()
+
+		imp, err := NewImporter(ArgsImporter{DbPath: "db", Marshalizer: testMarshalizer})
+		assert.Nil(t, imp)
+		assert.Equal(t, ErrNilHasher, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		imp := createTestImporter("db")
+		assert.False(t, check.IfNil(imp))
+	})
+}
+
+func TestImporter_ImportShouldWriteTheSnapshotForBootstrapping(t *testing.T) {
+	t.Parallel()
+
+	for _, shardID := range []uint32{1, core.MetachainShardId} {
+		tnd, archivePath := exportTestArchive(t, shardID)
+		dbPath := filepath.Join(t.TempDir(), "db", testChainID)
+
+		manifest, err := createTestImporter(dbPath).Import(archivePath)
+		require.Nil(t, err)
+		assert.Equal(t, tnd.epochStartHeaderHash, manifest.EpochStartHeaderHash)
+
+		persisters, err := newPersistersHolder(dbPath, createTestGeneralConfig(), shardID, false)
+		require.Nil(t, err)
+
+		// the node would bootstrap from the epoch start round, the later rounds are not part of the snapshot
+		esd, err := getEpochStartData(persisters, shardID, testEpoch, testMarshalizer, testHasher)
+		require.Nil(t, err)
+		assert.Equal(t, tnd.epochStartHeaderHash, esd.headerHash)
+		bootstrapPersister, err := persisters.get(dataRetriever.BootstrapUnit, testEpoch)
+		require.Nil(t, err)
+		assert.NotNil(t, bootstrapPersister.Has([]byte("100")))
+
+		// all the trie nodes are in the imported epoch storer, marked as a complete state
+		accountsPersister, err := persisters.get(dataRetriever.UserAccountsUnit, testEpoch)
+		require.Nil(t, err)
+		val, err := accountsPersister.Get([]byte(common.ActiveDBKey))
+		require.Nil(t, err)
+		assert.Equal(t, []byte(common.ActiveDBVal), val)
+		assert.Nil(t, accountsPersister.Has(tnd.rootHash))
+
+		require.Nil(t, persisters.closeAll())
+	}
+}
+
+func TestImporter_ImportNotEmptyDestinationShouldErr(t *testing.T) {
+	t.Parallel()
+
+	_, archivePath := exportTestArchive(t, 0)
+	dbPath := t.TempDir()
+	err := ioutil.WriteFile(filepath.Join(dbPath, "file"), []byte("data"), 0644)
+	require.Nil(t, err)
+
+	manifest, err := createTestImporter(dbPath).Import(archivePath)
+	assert.Nil(t, manifest)
+	assert.True(t, errors.Is(err, ErrDestinationNotEmpty))
+
+	_, err = os.Stat(filepath.Join(dbPath, "file"))
+	assert.Nil(t, err)
+}
+
+func TestImporter_ImportChainIDMismatchShouldErr(t *testing.T) {
+	t.Parallel()
+
+	_, archivePath := exportTestArchive(t, 0)
+	dbPath := filepath.Join(t.TempDir(), "db")
+	imp := createTestImporter(dbPath)
+	imp.generalConfig.GeneralSettings.ChainID = "another chain"
+
+	manifest, err := imp.Import(archivePath)
+	assert.Nil(t, manifest)
+	assert.True(t, errors.Is(err, ErrChainIDMismatch))
+	assert.False(t, directoryExists(dbPath))
+}
+
+func TestImporter_ImportCorruptedArchiveShouldErrBeforeWriting(t *testing.T) {
+	t.Parallel()
+
+	_, archivePath := exportTestArchive(t, 0)
+	archiveBytes, err := ioutil.ReadFile(archivePath)
+	require.Nil(t, err)
+	archiveBytes[len(archiveBytes)/2] ^= 0xFF
+	err = ioutil.WriteFile(archivePath, archiveBytes, 0644)
+	require.Nil(t, err)
+
+	dbPath := filepath.Join(t.TempDir(), "db")
+	manifest, err := createTestImporter(dbPath).Import(archivePath)
+	assert.Nil(t, manifest)
+	assert.NotNil(t, err)
+	assert.False(t, directoryExists(dbPath))
+}
+
+func TestImporter_ImportTamperedTrieNodeShouldErrAndRemoveTheDatabases(t *testing.T) {
+	t.Parallel()
+
+	tnd, archivePath := exportTestArchive(t, 0)
+	tamperedPath := rewriteTestArchive(t, archivePath, func(entry Entry) Entry {
+		if entry.Unit == dataRetriever.UserAccountsUnit && !bytes.Equal(entry.Key, tnd.rootHash) {
+			entry.Value = append(entry.Value, 0)
+		}
+
+		return entry
+	})
+
+	dbPath := filepath.Join(t.TempDir(), "db")
+	manifest, err := createTestImporter(dbPath).Import(tamperedPath)
+	assert.Nil(t, manifest)
+	assert.True(t, errors.Is(err, ErrTrieNodeHashMismatch))
+	assert.False(t, directoryExists(dbPath))
+}
+
+func TestImporter_ImportMissingTrieNodeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tnd, archivePath := exportTestArchive(t, 0)
+	removedOne := false
+	tamperedPath := rewriteTestArchive(t, archivePath, func(entry Entry) Entry {
+		if !removedOne && entry.Unit == dataRetriever.UserAccountsUnit && !bytes.Equal(entry.Key, tnd.rootHash) {
+			removedOne = true
+			entry.Unit = dataRetriever.BlockHeaderUnit
+		}
+
+		return entry
+	})
+
+	dbPath := filepath.Join(t.TempDir(), "db")
+	manifest, err := createTestImporter(dbPath).Import(tamperedPath)
+	assert.Nil(t, manifest)
+	assert.True(t, errors.Is(err, ErrMissingTrieNode))
+	assert.False(t, directoryExists(dbPath))
+}
+
+func TestImporter_ImportRootHashMismatchShouldErr(t *testing.T) {
+	t.Parallel()
+
+	_, archivePath := exportTestArchive(t, 0)
+	entries, manifest := readAllEntries(t, archivePath)
+	manifest.RootHash = []byte("another root hash")
+	tamperedPath := filepath.Join(t.TempDir(), "tampered")
+	writeTestArchive(t, tamperedPath, entries, manifest)
+
+	dbPath := filepath.Join(t.TempDir(), "db")
+	importedManifest, err := createTestImporter(dbPath).Import(tamperedPath)
+	assert.Nil(t, importedManifest)
+	assert.True(t, errors.Is(err, ErrRootHashMismatch))
+	assert.False(t, directoryExists(dbPath))
+}
@@ -0,0 +1,132 @@
+package snapshot
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
+)
+
+// persistersHolder opens (and caches) the persisters of the storage units a snapshot is made of, following the
+// node's databases directory layout
+type persistersHolder struct {
+	generalConfig   config.Config
+	pathManager     storage.PathManagerHandler
+	shardID         string
+	createIfMissing bool
+	persisters      map[string]storage.Persister
+}
+
+func newPersistersHolder(
+	dbPath string,
+	generalConfig config.Config,
+	shardID uint32,
+	createIfMissing bool,
+) (*persistersHolder, error) {
+	pathManager, err := storageFactory.CreatePathManagerFromSinglePathString(dbPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return &persistersHolder{
+		generalConfig:   generalConfig,
+		pathManager:     pathManager,
+		shardID:         core.GetShardIDString(shardID),
+		createIfMissing: createIfMissing,
+		persisters:      make(map[string]storage.Persister),
+	}, nil
+}
+
+// get returns the persister of the provided unit in the provided epoch. The epoch is ignored for static units
+func (ph *persistersHolder) get(unit dataRetriever.UnitType, epoch uint32) (storage.Persister, error) {
+	dbConfig, err := unitDBConfig(ph.generalConfig, unit)
+	if err != nil {
+		return nil, err
+	}
+
+	path := ph.pathManager.PathForEpoch(ph.shardID, epoch, dbConfig.FilePath)
+	if isStaticUnit(unit) {
+		path = ph.pathManager.PathForStatic(ph.shardID, dbConfig.FilePath) + staticUnitSuffix(unit)
+	}
+
+	persister, found := ph.persisters[path]
+	if found {
+		return persister, nil
+	}
+
+	if !ph.createIfMissing && !directoryExists(path) {
+		return nil, fmt.Errorf("%w: %s", ErrMissingStorageUnit, path)
+	}
+
+	persister, err = storageFactory.NewPersisterFactory(dbConfig).Create(path)
+	if err != nil {
+		return nil, err
+	}
+	ph.persisters[path] = persister
+
+	return persister, nil
+}
+
+// closeAll closes all the opened persisters, returning the last encountered error
+func (ph *persistersHolder) closeAll() error {
+	var lastErr error
+	for path, persister := range ph.persisters {
+		err := persister.Close()
+		if err != nil {
+			log.Warn("can not close persister", "path", path, "error", err)
+			lastErr = err
+		}
+	}
+	ph.persisters = make(map[string]storage.Persister)
+
+	return lastErr
+}
+
+func unitDBConfig(generalConfig config.Config, unit dataRetriever.UnitType) (config.DBConfig, error) {
+	switch unit {
+	case dataRetriever.BootstrapUnit:
+		return generalConfig.BootstrapStorage.DB, nil
+	case dataRetriever.MetaBlockUnit:
+		return generalConfig.MetaBlockStorage.DB, nil
+	case dataRetriever.BlockHeaderUnit:
+		return generalConfig.BlockHeaderStorage.DB, nil
+	case dataRetriever.MetaHdrNonceHashDataUnit:
+		return generalConfig.MetaHdrNonceHashStorage.DB, nil
+	case dataRetriever.UserAccountsUnit:
+		return generalConfig.AccountsTrieStorage.DB, nil
+	case dataRetriever.PeerAccountsUnit:
+		return generalConfig.PeerAccountsTrieStorage.DB, nil
+	}
+
+	if unit >= dataRetriever.ShardHdrNonceHashDataUnit {
+		return generalConfig.ShardHdrNonceHashStorage.DB, nil
+	}
+
+	return config.DBConfig{}, fmt.Errorf("%w: %s", ErrUnsupportedUnit, unit.String())
+}
+
+func isStaticUnit(unit dataRetriever.UnitType) bool {
+	return unit == dataRetriever.MetaHdrNonceHashDataUnit || unit >= dataRetriever.ShardHdrNonceHashDataUnit
+}
+
+// staticUnitSuffix returns the suffix the node appends to the path of the shard headers nonce-hash units
+func staticUnitSuffix(unit dataRetriever.UnitType) string {
+	if unit < dataRetriever.ShardHdrNonceHashDataUnit {
+		return ""
+	}
+
+	return fmt.Sprintf("%d", unit-dataRetriever.ShardHdrNonceHashDataUnit)
+}
+
+func directoryExists(path string) bool {
+	info, err := os.Stat(path)
+	if err != nil {
+		return false
+	}
+
+	return info.IsDir()
+}
@@ -0,0 +1,144 @@
+package snapshot
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/trie"
+)
+
+// trieNodesReader is a read only trie database which searches the trie nodes in the provided persisters (in order),
+// verifying that every node read matches the hash it was requested with. The trie walk goes on after a node can not be
+// read, so the first error is kept, and returned for every read that follows
+type trieNodesReader struct {
+	persisters []storage.Persister
+	hasher     hashing.Hasher
+	handler    func(key []byte, value []byte) error
+	err        error
+}
+
+// Get returns the trie node stored at the provided hash, after verifying it and passing it to the handler
+func (tnr *trieNodesReader) Get(key []byte) ([]byte, error) {
+	if tnr.err != nil {
+		return nil, tnr.err
+	}
+
+	value, err := tnr.get(key)
+	if err != nil {
+		tnr.err = err
+		return nil, err
+	}
+
+	return value, nil
+}
+
+func (tnr *trieNodesReader) get(key []byte) ([]byte, error) {
+	for _, persister := range tnr.persisters {
+		value, err := persister.Get(key)
+		if err != nil {
+			continue
+		}
+
+		if !bytes.Equal(tnr.hasher.Compute(string(value)), key) {
+			return nil, fmt.Errorf("%w: %x", ErrTrieNodeHashMismatch, key)
+		}
+		if tnr.handler != nil {
+			err = tnr.handler(key, value)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return value, nil
+	}
+
+	return nil, fmt.Errorf("%w: %x", ErrMissingTrieNode, key)
+}
+
+// Put returns ErrReadOnlyPersister
+func (tnr *trieNodesReader) Put(_ []byte, _ []byte) error {
+	return storage.ErrReadOnlyPersister
+}
+
+// Remove returns ErrReadOnlyPersister
+func (tnr *trieNodesReader) Remove(_ []byte) error {
+	return storage.ErrReadOnlyPersister
+}
+
+// Close does nothing as the persisters are owned by the caller
+func (tnr *trieNodesReader) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tnr *trieNodesReader) IsInterfaceNil() bool {
+	return tnr == nil
+}
+
+// walkTrie reads all the nodes of the trie with the provided root hash, and, if required, all the nodes of the
+// accounts data tries. The trie is walked depth first, without keeping the read nodes, and each data trie is walked
+// when its account leaf is reached. An error is returned if any node is missing or does not match its hash
+func walkTrie(
+	reader *trieNodesReader,
+	marshalizer marshal.Marshalizer,
+	maxTrieLevelInMemory uint,
+	rootHash []byte,
+	withDataTries bool,
+) error {
+	storageManager, err := trie.NewTrieStorageManagerWithoutPruning(reader)
+	if err != nil {
+		return err
+	}
+	tr, err := trie.NewTrie(storageManager, marshalizer, reader.hasher, maxTrieLevelInMemory)
+	if err != nil {
+		return err
+	}
+
+	var leafHandler func(key []byte, value []byte) error
+	if withDataTries {
+		leafHandler = func(_ []byte, value []byte) error {
+			account := state.NewEmptyUserAccount()
+			errUnmarshal := marshalizer.Unmarshal(account, value)
+			if errUnmarshal != nil {
+				// leaf holding code
+				return nil
+			}
+
+			errWalk := checkTrieNodes(tr, reader, account.RootHash, nil)
+			if errWalk != nil {
+				return fmt.Errorf("%w for data trie %x", errWalk, account.RootHash)
+			}
+
+			return nil
+		}
+	}
+
+	return checkTrieNodes(tr, reader, rootHash, leafHandler)
+}
+
+func checkTrieNodes(tr common.Trie, reader *trieNodesReader, rootHash []byte, leafHandler func(key []byte, value []byte) error) error {
+	if len(rootHash) == 0 || bytes.Equal(rootHash, trie.EmptyTrieHash) {
+		return nil
+	}
+
+	report, err := tr.CheckIntegrity(rootHash, leafHandler)
+	if err != nil {
+		return err
+	}
+	if reader.err != nil {
+		return reader.err
+	}
+	if len(report.MissingNodes) > 0 {
+		return fmt.Errorf("%w: %x", ErrMissingTrieNode, report.MissingNodes[0])
+	}
+	if len(report.CorruptNodes) > 0 {
+		return fmt.Errorf("%w: %x", ErrTrieNodeHashMismatch, report.CorruptNodes[0])
+	}
+
+	return nil
+}
@@ -152,6 +152,9 @@ var ErrNilEconomicsHandler = errors.New("nil economics handler")
 // ErrNilOutportHandler signals that a nil outport handler has been provided
 var ErrNilOutportHandler = errors.New("nil outport handler")
 
+// ErrNilEventsSubscriptions signals that a nil events subscriptions handler has been provided
+var ErrNilEventsSubscriptions = errors.New("nil events subscriptions handler")
+
 // ErrNilEpochNotifier signals that a nil epoch notifier has been provided
 var ErrNilEpochNotifier = errors.New("nil epoch notifier")
 
@@ -460,3 +463,12 @@ var ErrNilScheduledProcessor = errors.New("nil scheduled processor")
 
 // ErrContextClosing signals that the parent context requested the closing of its children
 var ErrContextClosing = errors.New("context closing")
+
+// ErrNilStorageStatistics signals that a nil storage statistics handler has been provided
+var ErrNilStorageStatistics = errors.New("nil storage statistics")
+
+// ErrNilStateChangesCollector signals that a nil state changes collector has been provided
+var ErrNilStateChangesCollector = errors.New("nil state changes collector")
+
+// ErrNilBlockWitnessHandler signals that a nil block witness handler has been provided
+var ErrNilBlockWitnessHandler = errors.New("nil block witness handler")
@@ -14,9 +14,11 @@ import (
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/ntp"
+	"github.com/ElrondNetwork/elrond-go/outport/events"
 	"github.com/ElrondNetwork/elrond-go/process"
 	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
 	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/storage"
 )
 
 var errNodeStarting = errors.New("node is starting")
@@ -48,6 +50,16 @@ func (inf *initialNodeFacade) GetProofDataTrie(_ string, _ string, _ string) (*c
 	return nil, nil, errNodeStarting
 }
 
+// GetProofDataTrieKeys -
+func (inf *initialNodeFacade) GetProofDataTrieKeys(_ string, _ string, _ []string) (*common.GetProofResponse, *common.GetMultiProofResponse, error) {
+	return nil, nil, errNodeStarting
+}
+
+// GetRangeProofDataTrie -
+func (inf *initialNodeFacade) GetRangeProofDataTrie(_ string, _ string, _ string) (*common.GetProofResponse, *common.GetMultiProofResponse, error) {
+	return nil, nil, errNodeStarting
+}
+
 // GetProofCurrentRootHash -
 func (inf *initialNodeFacade) GetProofCurrentRootHash(_ string) (*common.GetProofResponse, error) {
 	return nil, errNodeStarting
@@ -74,17 +86,17 @@ func (inf *initialNodeFacade) RestApiInterface() string {
 }
 
 // GetBalance returns nil and error
-func (inf *initialNodeFacade) GetBalance(_ string) (*big.Int, error) {
+func (inf *initialNodeFacade) GetBalance(_ string, _ common.AccountQueryOptions) (*big.Int, error) {
 	return nil, errNodeStarting
 }
 
 // GetUsername returns empty string and error
-func (inf *initialNodeFacade) GetUsername(_ string) (string, error) {
+func (inf *initialNodeFacade) GetUsername(_ string, _ common.AccountQueryOptions) (string, error) {
 	return emptyString, errNodeStarting
 }
 
 // GetValueForKey returns an empty string and error
-func (inf *initialNodeFacade) GetValueForKey(_ string, _ string) (string, error) {
+func (inf *initialNodeFacade) GetValueForKey(_ string, _ string, _ common.AccountQueryOptions) (string, error) {
 	return emptyString, errNodeStarting
 }
 
@@ -94,17 +106,22 @@ func (inf *initialNodeFacade) GetESDTBalance(_ string, _ string) (string, string
 }
 
 // GetAllESDTTokens returns nil and error
-func (inf *initialNodeFacade) GetAllESDTTokens(_ string) (map[string]*esdt.ESDigitalToken, error) {
+func (inf *initialNodeFacade) GetAllESDTTokens(_ string, _ common.AccountQueryOptions) (map[string]*esdt.ESDigitalToken, error) {
 	return nil, errNodeStarting
 }
 
 // GetNFTTokenIDsRegisteredByAddress returns nil and error
-func (inf *initialNodeFacade) GetNFTTokenIDsRegisteredByAddress(_ string) ([]string, error) {
+func (inf *initialNodeFacade) GetNFTTokenIDsRegisteredByAddress(_ string, _ common.AccountQueryOptions) ([]string, error) {
+	return nil, errNodeStarting
+}
+
+// GetAccountTransactions returns nil and error
+func (inf *initialNodeFacade) GetAccountTransactions(_ string, _ uint64, _ int) (*common.AccountTransactionsResponse, error) {
 	return nil, errNodeStarting
 }
 
 // GetESDTsWithRole returns nil and error
-func (inf *initialNodeFacade) GetESDTsWithRole(_ string, _ string) ([]string, error) {
+func (inf *initialNodeFacade) GetESDTsWithRole(_ string, _ string, _ common.AccountQueryOptions) ([]string, error) {
 	return nil, errNodeStarting
 }
 
@@ -162,7 +179,7 @@ func (inf *initialNodeFacade) ComputeTransactionGasLimit(_ *transaction.Transact
 }
 
 // GetAccount returns nil and error
-func (inf *initialNodeFacade) GetAccount(_ string) (api.AccountResponse, error) {
+func (inf *initialNodeFacade) GetAccount(_ string, _ common.AccountQueryOptions) (api.AccountResponse, error) {
 	return api.AccountResponse{}, errNodeStarting
 }
 
@@ -231,6 +248,11 @@ func (inf *initialNodeFacade) GetPeerInfo(_ string) ([]core.QueryP2PPeerInfo, er
 	return nil, errNodeStarting
 }
 
+// GetStorageStatistics returns nil and error
+func (inf *initialNodeFacade) GetStorageStatistics(_ bool) ([]storage.UnitStatistics, error) {
+	return nil, errNodeStarting
+}
+
 // GetThrottlerForEndpoint returns nil and false
 func (inf *initialNodeFacade) GetThrottlerForEndpoint(_ string) (core.Throttler, bool) {
 	return nil, false
@@ -251,6 +273,26 @@ func (inf *initialNodeFacade) GetBlockByRound(_ uint64, _ bool) (*api.Block, err
 	return nil, errNodeStarting
 }
 
+// GetBlocksByNonceRange returns nil and error
+func (inf *initialNodeFacade) GetBlocksByNonceRange(_ uint64, _ uint64, _ common.BlocksQueryOptions) (*common.BlocksRangeResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetBlocksByEpoch returns nil and error
+func (inf *initialNodeFacade) GetBlocksByEpoch(_ uint32, _ uint64, _ common.BlocksQueryOptions) (*common.BlocksRangeResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetBlockStateChangesByNonce returns nil and error
+func (inf *initialNodeFacade) GetBlockStateChangesByNonce(_ uint64) (*common.BlockStateChangesResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetStateDiff returns nil and error
+func (inf *initialNodeFacade) GetStateDiff(_ string, _ string, _ string) (*common.StateDiffResponse, error) {
+	return nil, errNodeStarting
+}
+
 // Close returns error
 func (inf *initialNodeFacade) Close() error {
 	return errNodeStarting
@@ -267,7 +309,7 @@ func (inf *initialNodeFacade) GetNumCheckpointsFromPeerState() uint32 {
 }
 
 // GetKeyValuePairs nil map
-func (inf *initialNodeFacade) GetKeyValuePairs(_ string) (map[string]string, error) {
+func (inf *initialNodeFacade) GetKeyValuePairs(_ string, _ common.AccountQueryOptions) (map[string]string, error) {
 	return nil, errNodeStarting
 }
 
@@ -282,12 +324,12 @@ func (inf *initialNodeFacade) GetDelegatorsList() ([]*api.Delegator, error) {
 }
 
 // GetESDTData returns nil and error
-func (inf *initialNodeFacade) GetESDTData(_ string, _ string, _ uint64) (*esdt.ESDigitalToken, error) {
+func (inf *initialNodeFacade) GetESDTData(_ string, _ string, _ uint64, _ common.AccountQueryOptions) (*esdt.ESDigitalToken, error) {
 	return nil, errNodeStarting
 }
 
 // GetESDTsRoles return nil and error
-func (inf *initialNodeFacade) GetESDTsRoles(_ string) (map[string][]string, error) {
+func (inf *initialNodeFacade) GetESDTsRoles(_ string, _ common.AccountQueryOptions) (map[string][]string, error) {
 	return nil, errNodeStarting
 }
 
@@ -301,6 +343,30 @@ func (inf *initialNodeFacade) GetTokenSupply(_ string) (*api.ESDTSupply, error)
 	return nil, errNodeStarting
 }
 
+// GetTransactionsPoolCounters returns nil and error
+func (inf *initialNodeFacade) GetTransactionsPoolCounters() (*common.TxPoolCountersResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetTransactionsPoolForSender returns nil and error
+func (inf *initialNodeFacade) GetTransactionsPoolForSender(_ string) (*common.TxPoolSenderResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetTransactionFromPool returns nil and error
+func (inf *initialNodeFacade) GetTransactionFromPool(_ string) (*common.TxPoolTransaction, error) {
+	return nil, errNodeStarting
+}
+
+// SubscribeToEvents returns nil and error
+func (inf *initialNodeFacade) SubscribeToEvents(_ events.SubscriptionFilter) (*events.Subscription, error) {
+	return nil, errNodeStarting
+}
+
+// UnsubscribeFromEvents does nothing
+func (inf *initialNodeFacade) UnsubscribeFromEvents(_ *events.Subscription) {
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (inf *initialNodeFacade) IsInterfaceNil() bool {
 	return inf == nil
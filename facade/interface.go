@@ -11,43 +11,48 @@ import (
 	"github.com/ElrondNetwork/elrond-go/debug"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/ElrondNetwork/elrond-go/outport/events"
 	"github.com/ElrondNetwork/elrond-go/process"
 	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
 	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/storage"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 )
 
 // NodeHandler contains all functions that a node should contain.
 type NodeHandler interface {
 	// GetBalance returns the balance for a specific address
-	GetBalance(address string) (*big.Int, error)
+	GetBalance(address string, options common.AccountQueryOptions) (*big.Int, error)
 
 	// GetUsername returns the username for a specific address
-	GetUsername(address string) (string, error)
+	GetUsername(address string, options common.AccountQueryOptions) (string, error)
 
 	// GetValueForKey returns the value of a key from a given account
-	GetValueForKey(address string, key string) (string, error)
+	GetValueForKey(address string, key string, options common.AccountQueryOptions) (string, error)
 
 	// GetKeyValuePairs returns the key-value pairs under a given address
-	GetKeyValuePairs(address string) (map[string]string, error)
+	GetKeyValuePairs(address string, options common.AccountQueryOptions) (map[string]string, error)
 
 	// GetAllIssuedESDTs returns all the issued esdt tokens from esdt system smart contract
 	GetAllIssuedESDTs(tokenType string) ([]string, error)
 
 	// GetESDTData returns the esdt data from a given account, given key and given nonce
-	GetESDTData(address, tokenID string, nonce uint64) (*esdt.ESDigitalToken, error)
+	GetESDTData(address, tokenID string, nonce uint64, options common.AccountQueryOptions) (*esdt.ESDigitalToken, error)
 
 	// GetESDTsRoles returns the the token identifiers and the roles for a given address
-	GetESDTsRoles(address string) (map[string][]string, error)
+	GetESDTsRoles(address string, options common.AccountQueryOptions) (map[string][]string, error)
 
 	// GetNFTTokenIDsRegisteredByAddress returns all the token identifiers for semi or non fungible tokens registered by the address
-	GetNFTTokenIDsRegisteredByAddress(address string) ([]string, error)
+	GetNFTTokenIDsRegisteredByAddress(address string, options common.AccountQueryOptions) ([]string, error)
+
+	// GetAccountTransactions returns the transactions in which the given address was involved, newest first
+	GetAccountTransactions(address string, cursor uint64, limit int) (*common.AccountTransactionsResponse, error)
 
 	// GetESDTsWithRole returns the token identifiers where the specified address has the given role
-	GetESDTsWithRole(address string, role string) ([]string, error)
+	GetESDTsWithRole(address string, role string, options common.AccountQueryOptions) ([]string, error)
 
 	// GetAllESDTTokens returns the value of a key from a given account
-	GetAllESDTTokens(address string) (map[string]*esdt.ESDigitalToken, error)
+	GetAllESDTTokens(address string, options common.AccountQueryOptions) (map[string]*esdt.ESDigitalToken, error)
 
 	// GetTokenSupply returns the provided token supply from current shard
 	GetTokenSupply(token string) (*api.ESDTSupply, error)
@@ -68,7 +73,7 @@ type NodeHandler interface {
 
 	// GetAccount returns an accountResponse containing information
 	//  about the account correlated with provided address
-	GetAccount(address string) (api.AccountResponse, error)
+	GetAccount(address string, options common.AccountQueryOptions) (api.AccountResponse, error)
 
 	// GetCode returns the code for the given code hash
 	GetCode(codeHash []byte) []byte
@@ -77,6 +82,11 @@ type NodeHandler interface {
 	GetHeartbeats() []data.PubKeyHeartbeat
 
 	// IsInterfaceNil returns true if there is no value under the interface
+	GetTransactionsPoolCounters() (*common.TxPoolCountersResponse, error)
+	GetTransactionsPoolForSender(sender string) (*common.TxPoolSenderResponse, error)
+	GetTransactionFromPool(hash string) (*common.TxPoolTransaction, error)
+	SubscribeToEvents(filter events.SubscriptionFilter) (*events.Subscription, error)
+	UnsubscribeFromEvents(subscription *events.Subscription)
 	IsInterfaceNil() bool
 
 	// ValidatorStatisticsApi return the statistics for all the validators
@@ -89,13 +99,20 @@ type NodeHandler interface {
 
 	GetQueryHandler(name string) (debug.QueryHandler, error)
 	GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error)
+	GetStorageStatistics(computeDiskUsage bool) ([]storage.UnitStatistics, error)
 
 	GetBlockByHash(hash string, withTxs bool) (*api.Block, error)
 	GetBlockByNonce(nonce uint64, withTxs bool) (*api.Block, error)
 	GetBlockByRound(round uint64, withTxs bool) (*api.Block, error)
+	GetBlocksByNonceRange(fromNonce uint64, toNonce uint64, options common.BlocksQueryOptions) (*common.BlocksRangeResponse, error)
+	GetBlocksByEpoch(epoch uint32, fromNonce uint64, options common.BlocksQueryOptions) (*common.BlocksRangeResponse, error)
+	GetBlockStateChangesByNonce(nonce uint64) (*common.BlockStateChangesResponse, error)
+	GetStateDiff(fromRootHash string, toRootHash string, cursor string) (*common.StateDiffResponse, error)
 
 	GetProof(rootHash string, key string) (*common.GetProofResponse, error)
 	GetProofDataTrie(rootHash string, address string, key string) (*common.GetProofResponse, *common.GetProofResponse, error)
+	GetProofDataTrieKeys(rootHash string, address string, keys []string) (*common.GetProofResponse, *common.GetMultiProofResponse, error)
+	GetRangeProofDataTrie(rootHash string, address string, keySuffix string) (*common.GetProofResponse, *common.GetMultiProofResponse, error)
 	VerifyProof(rootHash string, address string, proof [][]byte) (bool, error)
 }
 
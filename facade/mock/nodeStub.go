@@ -11,14 +11,16 @@ import (
 	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/debug"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
+	"github.com/ElrondNetwork/elrond-go/outport/events"
 	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/storage"
 )
 
 // NodeStub -
 type NodeStub struct {
 	AddressHandler             func() (string, error)
 	ConnectToAddressesHandler  func([]string) error
-	GetBalanceHandler          func(address string) (*big.Int, error)
+	GetBalanceHandler          func(address string, options common.AccountQueryOptions) (*big.Int, error)
 	GenerateTransactionHandler func(sender string, receiver string, amount string, code string) (*transaction.Transaction, error)
 	CreateTransactionHandler   func(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64,
 		gasLimit uint64, data []byte, signatureHex string, chainID string, version, options uint32) (*transaction.Transaction, []byte, error)
@@ -26,7 +28,7 @@ type NodeStub struct {
 	ValidateTransactionForSimulationCalled         func(tx *transaction.Transaction, bypassSignature bool) error
 	GetTransactionHandler                          func(hash string, withEvents bool) (*transaction.ApiTransactionResult, error)
 	SendBulkTransactionsHandler                    func(txs []*transaction.Transaction) (uint64, error)
-	GetAccountHandler                              func(address string) (api.AccountResponse, error)
+	GetAccountHandler                              func(address string, options common.AccountQueryOptions) (api.AccountResponse, error)
 	GetCodeCalled                                  func(codeHash []byte) []byte
 	GetCurrentPublicKeyHandler                     func() string
 	GenerateAndSendBulkTransactionsHandler         func(destination string, value *big.Int, nrTransactions uint64) error
@@ -36,22 +38,35 @@ type NodeStub struct {
 	DirectTriggerCalled                            func(epoch uint32, withEarlyEndOfEpoch bool) error
 	IsSelfTriggerCalled                            func() bool
 	GetQueryHandlerCalled                          func(name string) (debug.QueryHandler, error)
-	GetValueForKeyCalled                           func(address string, key string) (string, error)
+	GetValueForKeyCalled                           func(address string, key string, options common.AccountQueryOptions) (string, error)
 	GetPeerInfoCalled                              func(pid string) ([]core.QueryP2PPeerInfo, error)
+	GetStorageStatisticsCalled                     func(computeDiskUsage bool) ([]storage.UnitStatistics, error)
 	GetBlockByHashCalled                           func(hash string, withTxs bool) (*api.Block, error)
 	GetBlockByNonceCalled                          func(nonce uint64, withTxs bool) (*api.Block, error)
 	GetBlockByRoundCalled                          func(round uint64, withTxs bool) (*api.Block, error)
-	GetUsernameCalled                              func(address string) (string, error)
-	GetESDTDataCalled                              func(address string, key string, nonce uint64) (*esdt.ESDigitalToken, error)
-	GetAllESDTTokensCalled                         func(address string) (map[string]*esdt.ESDigitalToken, error)
-	GetNFTTokenIDsRegisteredByAddressCalled        func(address string) ([]string, error)
-	GetESDTsWithRoleCalled                         func(address string, role string) ([]string, error)
-	GetESDTsRolesCalled                            func(address string) (map[string][]string, error)
-	GetKeyValuePairsCalled                         func(address string) (map[string]string, error)
+	GetBlocksByNonceRangeCalled                    func(fromNonce uint64, toNonce uint64, options common.BlocksQueryOptions) (*common.BlocksRangeResponse, error)
+	GetBlocksByEpochCalled                         func(epoch uint32, fromNonce uint64, options common.BlocksQueryOptions) (*common.BlocksRangeResponse, error)
+	GetBlockStateChangesByNonceCalled              func(nonce uint64) (*common.BlockStateChangesResponse, error)
+	GetStateDiffCalled                             func(fromRootHash string, toRootHash string, cursor string) (*common.StateDiffResponse, error)
+	GetUsernameCalled                              func(address string, options common.AccountQueryOptions) (string, error)
+	GetESDTDataCalled                              func(address string, key string, nonce uint64, options common.AccountQueryOptions) (*esdt.ESDigitalToken, error)
+	GetAllESDTTokensCalled                         func(address string, options common.AccountQueryOptions) (map[string]*esdt.ESDigitalToken, error)
+	GetNFTTokenIDsRegisteredByAddressCalled        func(address string, options common.AccountQueryOptions) ([]string, error)
+	GetAccountTransactionsCalled                   func(address string, cursor uint64, limit int) (*common.AccountTransactionsResponse, error)
+	GetESDTsWithRoleCalled                         func(address string, role string, options common.AccountQueryOptions) ([]string, error)
+	GetESDTsRolesCalled                            func(address string, options common.AccountQueryOptions) (map[string][]string, error)
+	GetKeyValuePairsCalled                         func(address string, options common.AccountQueryOptions) (map[string]string, error)
 	GetAllIssuedESDTsCalled                        func(tokenType string) ([]string, error)
 	GetProofCalled                                 func(rootHash string, key string) (*common.GetProofResponse, error)
 	GetProofDataTrieCalled                         func(rootHash string, address string, key string) (*common.GetProofResponse, *common.GetProofResponse, error)
+	GetProofDataTrieKeysCalled                     func(rootHash string, address string, keys []string) (*common.GetProofResponse, *common.GetMultiProofResponse, error)
+	GetRangeProofDataTrieCalled                    func(rootHash string, address string, keySuffix string) (*common.GetProofResponse, *common.GetMultiProofResponse, error)
 	VerifyProofCalled                              func(rootHash string, address string, proof [][]byte) (bool, error)
+	GetTransactionsPoolCountersCalled              func() (*common.TxPoolCountersResponse, error)
+	GetTransactionsPoolForSenderCalled             func(sender string) (*common.TxPoolSenderResponse, error)
+	GetTransactionFromPoolCalled                   func(hash string) (*common.TxPoolTransaction, error)
+	SubscribeToEventsCalled                        func(filter events.SubscriptionFilter) (*events.Subscription, error)
+	UnsubscribeFromEventsCalled                    func(subscription *events.Subscription)
 }
 
 // GetProof -
@@ -72,6 +87,24 @@ func (ns *NodeStub) GetProofDataTrie(rootHash string, address string, key string
 	return nil, nil, nil
 }
 
+// GetProofDataTrieKeys -
+func (ns *NodeStub) GetProofDataTrieKeys(rootHash string, address string, keys []string) (*common.GetProofResponse, *common.GetMultiProofResponse, error) {
+	if ns.GetProofDataTrieKeysCalled != nil {
+		return ns.GetProofDataTrieKeysCalled(rootHash, address, keys)
+	}
+
+	return nil, nil, nil
+}
+
+// GetRangeProofDataTrie -
+func (ns *NodeStub) GetRangeProofDataTrie(rootHash string, address string, keySuffix string) (*common.GetProofResponse, *common.GetMultiProofResponse, error) {
+	if ns.GetRangeProofDataTrieCalled != nil {
+		return ns.GetRangeProofDataTrieCalled(rootHash, address, keySuffix)
+	}
+
+	return nil, nil, nil
+}
+
 // VerifyProof -
 func (ns *NodeStub) VerifyProof(rootHash string, address string, proof [][]byte) (bool, error) {
 	if ns.VerifyProofCalled != nil {
@@ -82,27 +115,27 @@ func (ns *NodeStub) VerifyProof(rootHash string, address string, proof [][]byte)
 }
 
 // GetUsername -
-func (ns *NodeStub) GetUsername(address string) (string, error) {
+func (ns *NodeStub) GetUsername(address string, options common.AccountQueryOptions) (string, error) {
 	if ns.GetUsernameCalled != nil {
-		return ns.GetUsernameCalled(address)
+		return ns.GetUsernameCalled(address, options)
 	}
 
 	return "", nil
 }
 
 // GetKeyValuePairs -
-func (ns *NodeStub) GetKeyValuePairs(address string) (map[string]string, error) {
+func (ns *NodeStub) GetKeyValuePairs(address string, options common.AccountQueryOptions) (map[string]string, error) {
 	if ns.GetKeyValuePairsCalled != nil {
-		return ns.GetKeyValuePairsCalled(address)
+		return ns.GetKeyValuePairsCalled(address, options)
 	}
 
 	return nil, nil
 }
 
 // GetValueForKey -
-func (ns *NodeStub) GetValueForKey(address string, key string) (string, error) {
+func (ns *NodeStub) GetValueForKey(address string, key string, options common.AccountQueryOptions) (string, error) {
 	if ns.GetValueForKeyCalled != nil {
-		return ns.GetValueForKeyCalled(address, key)
+		return ns.GetValueForKeyCalled(address, key, options)
 	}
 
 	return "", nil
@@ -131,14 +164,46 @@ func (ns *NodeStub) GetBlockByRound(round uint64, withTxs bool) (*api.Block, err
 	return nil, nil
 }
 
+// GetBlocksByNonceRange -
+func (ns *NodeStub) GetBlocksByNonceRange(fromNonce uint64, toNonce uint64, options common.BlocksQueryOptions) (*common.BlocksRangeResponse, error) {
+	if ns.GetBlocksByNonceRangeCalled != nil {
+		return ns.GetBlocksByNonceRangeCalled(fromNonce, toNonce, options)
+	}
+	return nil, nil
+}
+
+// GetBlocksByEpoch -
+func (ns *NodeStub) GetBlocksByEpoch(epoch uint32, fromNonce uint64, options common.BlocksQueryOptions) (*common.BlocksRangeResponse, error) {
+	if ns.GetBlocksByEpochCalled != nil {
+		return ns.GetBlocksByEpochCalled(epoch, fromNonce, options)
+	}
+	return nil, nil
+}
+
+// GetBlockStateChangesByNonce -
+func (ns *NodeStub) GetBlockStateChangesByNonce(nonce uint64) (*common.BlockStateChangesResponse, error) {
+	if ns.GetBlockStateChangesByNonceCalled != nil {
+		return ns.GetBlockStateChangesByNonceCalled(nonce)
+	}
+	return nil, nil
+}
+
+// GetStateDiff -
+func (ns *NodeStub) GetStateDiff(fromRootHash string, toRootHash string, cursor string) (*common.StateDiffResponse, error) {
+	if ns.GetStateDiffCalled != nil {
+		return ns.GetStateDiffCalled(fromRootHash, toRootHash, cursor)
+	}
+	return nil, nil
+}
+
 // DecodeAddressPubkey -
 func (ns *NodeStub) DecodeAddressPubkey(pk string) ([]byte, error) {
 	return hex.DecodeString(pk)
 }
 
 // GetBalance -
-func (ns *NodeStub) GetBalance(address string) (*big.Int, error) {
-	return ns.GetBalanceHandler(address)
+func (ns *NodeStub) GetBalance(address string, options common.AccountQueryOptions) (*big.Int, error) {
+	return ns.GetBalanceHandler(address, options)
 }
 
 // CreateTransaction -
@@ -169,8 +234,8 @@ func (ns *NodeStub) SendBulkTransactions(txs []*transaction.Transaction) (uint64
 }
 
 // GetAccount -
-func (ns *NodeStub) GetAccount(address string) (api.AccountResponse, error) {
-	return ns.GetAccountHandler(address)
+func (ns *NodeStub) GetAccount(address string, options common.AccountQueryOptions) (api.AccountResponse, error) {
+	return ns.GetAccountHandler(address, options)
 }
 
 // GetCode -
@@ -220,37 +285,46 @@ func (ns *NodeStub) GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error) {
 	return make([]core.QueryP2PPeerInfo, 0), nil
 }
 
+// GetStorageStatistics -
+func (ns *NodeStub) GetStorageStatistics(computeDiskUsage bool) ([]storage.UnitStatistics, error) {
+	if ns.GetStorageStatisticsCalled != nil {
+		return ns.GetStorageStatisticsCalled(computeDiskUsage)
+	}
+
+	return make([]storage.UnitStatistics, 0), nil
+}
+
 // GetESDTData -
-func (ns *NodeStub) GetESDTData(address, tokenID string, nonce uint64) (*esdt.ESDigitalToken, error) {
+func (ns *NodeStub) GetESDTData(address, tokenID string, nonce uint64, options common.AccountQueryOptions) (*esdt.ESDigitalToken, error) {
 	if ns.GetESDTDataCalled != nil {
-		return ns.GetESDTDataCalled(address, tokenID, nonce)
+		return ns.GetESDTDataCalled(address, tokenID, nonce, options)
 	}
 
 	return &esdt.ESDigitalToken{Value: big.NewInt(0)}, nil
 }
 
 // GetESDTsRoles -
-func (ns *NodeStub) GetESDTsRoles(address string) (map[string][]string, error) {
+func (ns *NodeStub) GetESDTsRoles(address string, options common.AccountQueryOptions) (map[string][]string, error) {
 	if ns.GetESDTsRolesCalled != nil {
-		return ns.GetESDTsRolesCalled(address)
+		return ns.GetESDTsRolesCalled(address, options)
 	}
 
 	return map[string][]string{}, nil
 }
 
 // GetESDTsWithRole -
-func (ns *NodeStub) GetESDTsWithRole(address string, role string) ([]string, error) {
+func (ns *NodeStub) GetESDTsWithRole(address string, role string, options common.AccountQueryOptions) ([]string, error) {
 	if ns.GetESDTsWithRoleCalled != nil {
-		return ns.GetESDTsWithRoleCalled(address, role)
+		return ns.GetESDTsWithRoleCalled(address, role, options)
 	}
 
 	return make([]string, 0), nil
 }
 
 // GetAllESDTTokens -
-func (ns *NodeStub) GetAllESDTTokens(address string) (map[string]*esdt.ESDigitalToken, error) {
+func (ns *NodeStub) GetAllESDTTokens(address string, options common.AccountQueryOptions) (map[string]*esdt.ESDigitalToken, error) {
 	if ns.GetAllESDTTokensCalled != nil {
-		return ns.GetAllESDTTokensCalled(address)
+		return ns.GetAllESDTTokensCalled(address, options)
 	}
 
 	return make(map[string]*esdt.ESDigitalToken), nil
@@ -270,14 +344,62 @@ func (ns *NodeStub) GetAllIssuedESDTs(tokenType string) ([]string, error) {
 }
 
 // GetNFTTokenIDsRegisteredByAddress -
-func (ns *NodeStub) GetNFTTokenIDsRegisteredByAddress(address string) ([]string, error) {
+func (ns *NodeStub) GetNFTTokenIDsRegisteredByAddress(address string, options common.AccountQueryOptions) ([]string, error) {
 	if ns.GetNFTTokenIDsRegisteredByAddressCalled != nil {
-		return ns.GetNFTTokenIDsRegisteredByAddressCalled(address)
+		return ns.GetNFTTokenIDsRegisteredByAddressCalled(address, options)
 	}
 
 	return make([]string, 0), nil
 }
 
+// GetAccountTransactions -
+func (ns *NodeStub) GetAccountTransactions(address string, cursor uint64, limit int) (*common.AccountTransactionsResponse, error) {
+	if ns.GetAccountTransactionsCalled != nil {
+		return ns.GetAccountTransactionsCalled(address, cursor, limit)
+	}
+
+	return &common.AccountTransactionsResponse{}, nil
+}
+
+// GetTransactionsPoolCounters -
+func (ns *NodeStub) GetTransactionsPoolCounters() (*common.TxPoolCountersResponse, error) {
+	if ns.GetTransactionsPoolCountersCalled != nil {
+		return ns.GetTransactionsPoolCountersCalled()
+	}
+	return nil, nil
+}
+
+// GetTransactionsPoolForSender -
+func (ns *NodeStub) GetTransactionsPoolForSender(sender string) (*common.TxPoolSenderResponse, error) {
+	if ns.GetTransactionsPoolForSenderCalled != nil {
+		return ns.GetTransactionsPoolForSenderCalled(sender)
+	}
+	return nil, nil
+}
+
+// GetTransactionFromPool -
+func (ns *NodeStub) GetTransactionFromPool(hash string) (*common.TxPoolTransaction, error) {
+	if ns.GetTransactionFromPoolCalled != nil {
+		return ns.GetTransactionFromPoolCalled(hash)
+	}
+	return nil, nil
+}
+
+// SubscribeToEvents -
+func (ns *NodeStub) SubscribeToEvents(filter events.SubscriptionFilter) (*events.Subscription, error) {
+	if ns.SubscribeToEventsCalled != nil {
+		return ns.SubscribeToEventsCalled(filter)
+	}
+	return nil, nil
+}
+
+// UnsubscribeFromEvents -
+func (ns *NodeStub) UnsubscribeFromEvents(subscription *events.Subscription) {
+	if ns.UnsubscribeFromEventsCalled != nil {
+		ns.UnsubscribeFromEventsCalled(subscription)
+	}
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ns *NodeStub) IsInterfaceNil() bool {
 	return ns == nil
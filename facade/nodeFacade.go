@@ -22,9 +22,11 @@ import (
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/ntp"
+	"github.com/ElrondNetwork/elrond-go/outport/events"
 	"github.com/ElrondNetwork/elrond-go/process"
 	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
 	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/storage"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 )
 
@@ -162,48 +164,53 @@ func (nf *nodeFacade) RestApiInterface() string {
 }
 
 // GetBalance gets the current balance for a specified address
-func (nf *nodeFacade) GetBalance(address string) (*big.Int, error) {
-	return nf.node.GetBalance(address)
+func (nf *nodeFacade) GetBalance(address string, options common.AccountQueryOptions) (*big.Int, error) {
+	return nf.node.GetBalance(address, options)
 }
 
 // GetUsername gets the username for a specified address
-func (nf *nodeFacade) GetUsername(address string) (string, error) {
-	return nf.node.GetUsername(address)
+func (nf *nodeFacade) GetUsername(address string, options common.AccountQueryOptions) (string, error) {
+	return nf.node.GetUsername(address, options)
 }
 
 // GetValueForKey gets the value for a key in a given address
-func (nf *nodeFacade) GetValueForKey(address string, key string) (string, error) {
-	return nf.node.GetValueForKey(address, key)
+func (nf *nodeFacade) GetValueForKey(address string, key string, options common.AccountQueryOptions) (string, error) {
+	return nf.node.GetValueForKey(address, key, options)
 }
 
 // GetESDTData returns the ESDT data for the given address, tokenID and nonce
-func (nf *nodeFacade) GetESDTData(address string, key string, nonce uint64) (*esdt.ESDigitalToken, error) {
-	return nf.node.GetESDTData(address, key, nonce)
+func (nf *nodeFacade) GetESDTData(address string, key string, nonce uint64, options common.AccountQueryOptions) (*esdt.ESDigitalToken, error) {
+	return nf.node.GetESDTData(address, key, nonce, options)
 }
 
 // GetESDTsRoles returns all the tokens identifiers and roles for the given address
-func (nf *nodeFacade) GetESDTsRoles(address string) (map[string][]string, error) {
-	return nf.node.GetESDTsRoles(address)
+func (nf *nodeFacade) GetESDTsRoles(address string, options common.AccountQueryOptions) (map[string][]string, error) {
+	return nf.node.GetESDTsRoles(address, options)
 }
 
 // GetNFTTokenIDsRegisteredByAddress returns all the token identifiers for semi or non fungible tokens registered by the address
-func (nf *nodeFacade) GetNFTTokenIDsRegisteredByAddress(address string) ([]string, error) {
-	return nf.node.GetNFTTokenIDsRegisteredByAddress(address)
+func (nf *nodeFacade) GetNFTTokenIDsRegisteredByAddress(address string, options common.AccountQueryOptions) ([]string, error) {
+	return nf.node.GetNFTTokenIDsRegisteredByAddress(address, options)
+}
+
+// GetAccountTransactions returns the transactions in which the given address was involved, newest first
+func (nf *nodeFacade) GetAccountTransactions(address string, cursor uint64, limit int) (*common.AccountTransactionsResponse, error) {
+	return nf.node.GetAccountTransactions(address, cursor, limit)
 }
 
 // GetESDTsWithRole returns all the tokens with the given role for the given address
-func (nf *nodeFacade) GetESDTsWithRole(address string, role string) ([]string, error) {
-	return nf.node.GetESDTsWithRole(address, role)
+func (nf *nodeFacade) GetESDTsWithRole(address string, role string, options common.AccountQueryOptions) ([]string, error) {
+	return nf.node.GetESDTsWithRole(address, role, options)
 }
 
 // GetKeyValuePairs returns all the key-value pairs under the provided address
-func (nf *nodeFacade) GetKeyValuePairs(address string) (map[string]string, error) {
-	return nf.node.GetKeyValuePairs(address)
+func (nf *nodeFacade) GetKeyValuePairs(address string, options common.AccountQueryOptions) (map[string]string, error) {
+	return nf.node.GetKeyValuePairs(address, options)
 }
 
 // GetAllESDTTokens returns all the esdt tokens for a given address
-func (nf *nodeFacade) GetAllESDTTokens(address string) (map[string]*esdt.ESDigitalToken, error) {
-	return nf.node.GetAllESDTTokens(address)
+func (nf *nodeFacade) GetAllESDTTokens(address string, options common.AccountQueryOptions) (map[string]*esdt.ESDigitalToken, error) {
+	return nf.node.GetAllESDTTokens(address, options)
 }
 
 // GetTokenSupply returns the provided token supply
@@ -272,8 +279,8 @@ func (nf *nodeFacade) ComputeTransactionGasLimit(tx *transaction.Transaction) (*
 }
 
 // GetAccount returns a response containing information about the account correlated with provided address
-func (nf *nodeFacade) GetAccount(address string) (apiData.AccountResponse, error) {
-	accountResponse, err := nf.node.GetAccount(address)
+func (nf *nodeFacade) GetAccount(address string, options common.AccountQueryOptions) (apiData.AccountResponse, error) {
+	accountResponse, err := nf.node.GetAccount(address, options)
 	if err != nil {
 		return apiData.AccountResponse{}, err
 	}
@@ -359,6 +366,11 @@ func (nf *nodeFacade) GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error) {
 	return nf.node.GetPeerInfo(pid)
 }
 
+// GetStorageStatistics returns the operations statistics of the storage units
+func (nf *nodeFacade) GetStorageStatistics(computeDiskUsage bool) ([]storage.UnitStatistics, error) {
+	return nf.node.GetStorageStatistics(computeDiskUsage)
+}
+
 // GetThrottlerForEndpoint returns the throttler for a given endpoint if found
 func (nf *nodeFacade) GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool) {
 	throttlerForEndpoint, ok := nf.endpointsThrottlers[endpoint]
@@ -382,6 +394,27 @@ func (nf *nodeFacade) GetBlockByRound(round uint64, withTxs bool) (*apiData.Bloc
 	return nf.node.GetBlockByRound(round, withTxs)
 }
 
+// GetBlocksByNonceRange returns the blocks between the provided nonces
+func (nf *nodeFacade) GetBlocksByNonceRange(fromNonce uint64, toNonce uint64, options common.BlocksQueryOptions) (*common.BlocksRangeResponse, error) {
+	return nf.node.GetBlocksByNonceRange(fromNonce, toNonce, options)
+}
+
+// GetBlocksByEpoch returns the blocks of the provided epoch, starting with the given nonce
+func (nf *nodeFacade) GetBlocksByEpoch(epoch uint32, fromNonce uint64, options common.BlocksQueryOptions) (*common.BlocksRangeResponse, error) {
+	return nf.node.GetBlocksByEpoch(epoch, fromNonce, options)
+}
+
+// GetBlockStateChangesByNonce returns the changes done on the accounts by the block with the provided nonce
+func (nf *nodeFacade) GetBlockStateChangesByNonce(nonce uint64) (*common.BlockStateChangesResponse, error) {
+	return nf.node.GetBlockStateChangesByNonce(nonce)
+}
+
+// GetStateDiff returns the accounts changed between the two provided accounts trie root hashes, starting after the
+// provided cursor
+func (nf *nodeFacade) GetStateDiff(fromRootHash string, toRootHash string, cursor string) (*common.StateDiffResponse, error) {
+	return nf.node.GetStateDiff(fromRootHash, toRootHash, cursor)
+}
+
 // Close will cleanup started go routines
 func (nf *nodeFacade) Close() error {
 	log.LogIfError(nf.apiResolver.Close())
@@ -407,6 +440,18 @@ func (nf *nodeFacade) GetProofDataTrie(rootHash string, address string, key stri
 	return nf.node.GetProofDataTrie(rootHash, address, key)
 }
 
+// GetProofDataTrieKeys returns the Merkle Proof for the given address, and a single Merkle Proof for all the given
+// keys of the address data trie
+func (nf *nodeFacade) GetProofDataTrieKeys(rootHash string, address string, keys []string) (*common.GetProofResponse, *common.GetMultiProofResponse, error) {
+	return nf.node.GetProofDataTrieKeys(rootHash, address, keys)
+}
+
+// GetRangeProofDataTrie returns the Merkle Proof for the given address, and a Merkle Proof for all the keys of the
+// address data trie that end with the given suffix
+func (nf *nodeFacade) GetRangeProofDataTrie(rootHash string, address string, keySuffix string) (*common.GetProofResponse, *common.GetMultiProofResponse, error) {
+	return nf.node.GetRangeProofDataTrie(rootHash, address, keySuffix)
+}
+
 // GetProofCurrentRootHash returns the Merkle proof for the given address and current root hash
 func (nf *nodeFacade) GetProofCurrentRootHash(address string) (*common.GetProofResponse, error) {
 	rootHash := nf.blockchain.GetCurrentBlockRootHash()
@@ -511,6 +556,31 @@ func (nf *nodeFacade) convertVmOutputToApiResponse(input *vmcommon.VMOutput) *vm
 	}
 }
 
+// GetTransactionsPoolCounters returns the counters of the transactions pool
+func (nf *nodeFacade) GetTransactionsPoolCounters() (*common.TxPoolCountersResponse, error) {
+	return nf.node.GetTransactionsPoolCounters()
+}
+
+// GetTransactionsPoolForSender returns the state of a sender in the transactions pool
+func (nf *nodeFacade) GetTransactionsPoolForSender(sender string) (*common.TxPoolSenderResponse, error) {
+	return nf.node.GetTransactionsPoolForSender(sender)
+}
+
+// GetTransactionFromPool returns the transaction with the given hash, if found in the transactions pool
+func (nf *nodeFacade) GetTransactionFromPool(hash string) (*common.TxPoolTransaction, error) {
+	return nf.node.GetTransactionFromPool(hash)
+}
+
+// SubscribeToEvents registers a new subscription for the events matching the provided filter
+func (nf *nodeFacade) SubscribeToEvents(filter events.SubscriptionFilter) (*events.Subscription, error) {
+	return nf.node.SubscribeToEvents(filter)
+}
+
+// UnsubscribeFromEvents ends the provided events subscription
+func (nf *nodeFacade) UnsubscribeFromEvents(subscription *events.Subscription) {
+	nf.node.UnsubscribeFromEvents(subscription)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (nf *nodeFacade) IsInterfaceNil() bool {
 	return nf == nil
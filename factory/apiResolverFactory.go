@@ -23,8 +23,11 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/transaction"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/state"
+	factoryState "github.com/ElrondNetwork/elrond-go/state/factory"
+	disabledPruning "github.com/ElrondNetwork/elrond-go/state/storagePruningManager/disabled"
 	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	trieFactory "github.com/ElrondNetwork/elrond-go/trie/factory"
 	"github.com/ElrondNetwork/elrond-go/vm"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 	vmcommonBuiltInFunctions "github.com/ElrondNetwork/elrond-vm-common/builtInFunctions"
@@ -238,10 +241,15 @@ func createScQueryElement(
 	var vmFactory process.VirtualMachinesContainerFactory
 	var err error
 
+	accountsWithHistory, historicalStateSelector, err := createAccountsWithHistory(args)
+	if err != nil {
+		return nil, err
+	}
+
 	builtInFuncs, nftStorageHandler, err := createBuiltinFuncs(
 		args.gasScheduleNotifier,
 		args.coreComponents.InternalMarshalizer(),
-		args.stateComponents.AccountsAdapter(),
+		accountsWithHistory,
 		args.processComponents.ShardCoordinator(),
 		args.coreComponents.EpochNotifier(),
 		args.epochConfig.EnableEpochs.ESDTMultiTransferEnableEpoch,
@@ -263,7 +271,7 @@ func createScQueryElement(
 	scStorage := args.generalConfig.SmartContractsStorageForSCQuery
 	scStorage.DB.FilePath += fmt.Sprintf("%d", args.index)
 	argsHook := hooks.ArgBlockChainHook{
-		Accounts:           args.stateComponents.AccountsAdapter(),
+		Accounts:           accountsWithHistory,
 		PubkeyConv:         args.coreComponents.AddressPubKeyConverter(),
 		StorageService:     args.dataComponents.StorageService(),
 		BlockChain:         args.dataComponents.Blockchain(),
@@ -346,11 +354,48 @@ func createScQueryElement(
 		ArwenChangeLocker:        args.coreComponents.ArwenChangeLocker(),
 		Bootstrapper:             args.bootstrapper,
 		AllowExternalQueriesChan: args.allowVMQueriesChan,
+		HistoricalStateSelector:  historicalStateSelector,
 	}
 
 	return smartContract.NewSCQueryService(argsNewSCQueryService)
 }
 
+// createAccountsWithHistory creates the accounts adapter used by the queries, which can switch to the state of a past
+// block. The past states are recreated over the user accounts trie in a dedicated accounts adapter, so the processing
+// accounts adapter is never touched
+func createAccountsWithHistory(args *scQueryElementArgs) (state.AccountsAdapter, process.HistoricalStateSelector, error) {
+	historicalAccountsAdapter, err := state.NewAccountsDB(
+		args.stateComponents.TriesContainer().Get([]byte(trieFactory.UserAccountTrie)),
+		args.coreComponents.Hasher(),
+		args.coreComponents.InternalMarshalizer(),
+		factoryState.NewAccountCreator(),
+		disabledPruning.NewDisabledStoragePruningManager(),
+		common.Normal,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	accountsWithHistory, err := state.NewAccountsDBWithHistory(args.stateComponents.AccountsAdapter(), historicalAccountsAdapter)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	historicalStateSelector, err := smartContract.NewHistoricalStateSelector(smartContract.ArgsHistoricalStateSelector{
+		AccountsStateSelector: accountsWithHistory,
+		StorageService:        args.dataComponents.StorageService(),
+		HistoryRepository:     args.processComponents.HistoryRepository(),
+		Marshalizer:           args.coreComponents.InternalMarshalizer(),
+		Uint64Converter:       args.coreComponents.Uint64ByteSliceConverter(),
+		ShardCoordinator:      args.processComponents.ShardCoordinator(),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return accountsWithHistory, historicalStateSelector, nil
+}
+
 func createBuiltinFuncs(
 	gasScheduleNotifier core.GasScheduleNotifier,
 	marshalizer marshal.Marshalizer,
@@ -389,6 +389,8 @@ func (pcf *processComponentsFactory) newShardBlockProcessor(
 		FeeHandler:                     txFeeHandler,
 		BlockSizeThrottler:             blockSizeThrottler,
 		HistoryRepository:              pcf.historyRepo,
+		StateChangesCollector:          pcf.state.StateChangesCollector(),
+		BlockWitnessHandler:            pcf.state.BlockWitnessHandler(),
 		EpochNotifier:                  pcf.epochNotifier,
 		RoundNotifier:                  pcf.coreData.RoundNotifier(),
 		VMContainersFactory:            vmFactory,
@@ -776,6 +778,8 @@ func (pcf *processComponentsFactory) newMetaBlockProcessor(
 		FeeHandler:                     txFeeHandler,
 		BlockSizeThrottler:             blockSizeThrottler,
 		HistoryRepository:              pcf.historyRepo,
+		StateChangesCollector:          pcf.state.StateChangesCollector(),
+		BlockWitnessHandler:            pcf.state.BlockWitnessHandler(),
 		EpochNotifier:                  pcf.epochNotifier,
 		RoundNotifier:                  pcf.coreData.RoundNotifier(),
 		VMContainersFactory:            vmFactory,
@@ -428,6 +428,8 @@ func getDefaultStateComponents() *testscommon.StateComponentsMock {
 			trieFactory.UserAccountTrie: &testscommon.StorageManagerStub{},
 			trieFactory.PeerAccountTrie: &testscommon.StorageManagerStub{},
 		},
+		StateChanges: &stateMock.StateChangesCollectorStub{},
+		BlockWitness: &stateMock.BlockWitnessHandlerStub{},
 	}
 }
 
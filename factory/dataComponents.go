@@ -13,7 +13,9 @@ import (
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/provider"
 	"github.com/ElrondNetwork/elrond-go/errors"
 	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/storage/factory"
+	"github.com/ElrondNetwork/elrond-go/storage/statistics"
 )
 
 // DataComponentsFactoryArgs holds the arguments needed for creating a data components factory
@@ -43,6 +45,7 @@ type dataComponents struct {
 	store              dataRetriever.StorageService
 	datapool           dataRetriever.PoolsHolder
 	miniBlocksProvider MiniBlockProvider
+	storageStatistics  storage.StorageStatisticsHandler
 }
 
 // NewDataComponentsFactory will return a new instance of dataComponentsFactory
@@ -82,7 +85,8 @@ func (dcf *dataComponentsFactory) Create() (*dataComponents, error) {
 		return nil, err
 	}
 
-	store, err := dcf.createDataStoreFromConfig()
+	storageStatistics := statistics.NewStorageStatistics()
+	store, err := dcf.createDataStoreFromConfig(storageStatistics)
 	if err != nil {
 		return nil, err
 	}
@@ -121,6 +125,7 @@ func (dcf *dataComponentsFactory) Create() (*dataComponents, error) {
 		store:              store,
 		datapool:           datapool,
 		miniBlocksProvider: miniBlocksProvider,
+		storageStatistics:  storageStatistics,
 	}, nil
 }
 
@@ -142,7 +147,9 @@ func (dcf *dataComponentsFactory) createBlockChainFromConfig() (data.ChainHandle
 	return nil, errors.ErrBlockchainCreation
 }
 
-func (dcf *dataComponentsFactory) createDataStoreFromConfig() (dataRetriever.StorageService, error) {
+func (dcf *dataComponentsFactory) createDataStoreFromConfig(
+	storageStatistics storage.StorageStatisticsHandler,
+) (dataRetriever.StorageService, error) {
 	storageServiceFactory, err := factory.NewStorageServiceFactory(
 		&dcf.config,
 		&dcf.prefsConfig,
@@ -152,6 +159,7 @@ func (dcf *dataComponentsFactory) createDataStoreFromConfig() (dataRetriever.Sto
 		dcf.core.NodeTypeProvider(),
 		dcf.currentEpoch,
 		dcf.createTrieEpochRootHashStorer,
+		storageStatistics,
 	)
 	if err != nil {
 		return nil, err
@@ -8,6 +8,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/errors"
+	"github.com/ElrondNetwork/elrond-go/storage"
 )
 
 var _ ComponentHandler = (*managedDataComponents)(nil)
@@ -85,6 +86,9 @@ func (mdc *managedDataComponents) CheckSubcomponents() error {
 	if check.IfNil(mdc.miniBlocksProvider) {
 		return errors.ErrNilMiniBlocksProvider
 	}
+	if check.IfNil(mdc.storageStatistics) {
+		return errors.ErrNilStorageStatistics
+	}
 
 	return nil
 }
@@ -144,6 +148,18 @@ func (mdc *managedDataComponents) MiniBlocksProvider() MiniBlockProvider {
 	return mdc.dataComponents.miniBlocksProvider
 }
 
+// StorageStatistics returns the collector of the storage units statistics
+func (mdc *managedDataComponents) StorageStatistics() storage.StorageStatisticsHandler {
+	mdc.mutDataComponents.RLock()
+	defer mdc.mutDataComponents.RUnlock()
+
+	if mdc.dataComponents == nil {
+		return nil
+	}
+
+	return mdc.dataComponents.storageStatistics
+}
+
 // Clone creates a shallow clone of a managedDataComponents
 func (mdc *managedDataComponents) Clone() interface{} {
 	dataComps := (*dataComponents)(nil)
@@ -153,6 +169,7 @@ func (mdc *managedDataComponents) Clone() interface{} {
 			store:              mdc.StorageService(),
 			datapool:           mdc.Datapool(),
 			miniBlocksProvider: mdc.MiniBlocksProvider(),
+			storageStatistics:  mdc.StorageStatistics(),
 		}
 	}
 
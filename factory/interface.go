@@ -26,6 +26,7 @@ import (
 	heartbeatData "github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/ntp"
 	"github.com/ElrondNetwork/elrond-go/outport"
+	"github.com/ElrondNetwork/elrond-go/outport/events"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process"
 	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
@@ -186,6 +187,7 @@ type DataComponentsHolder interface {
 	StorageService() dataRetriever.StorageService
 	Datapool() dataRetriever.PoolsHolder
 	MiniBlocksProvider() MiniBlockProvider
+	StorageStatistics() storage.StorageStatisticsHandler
 	Clone() interface{}
 	IsInterfaceNil() bool
 }
@@ -283,12 +285,15 @@ type StateComponentsHolder interface {
 	AccountsAdapterAPI() state.AccountsAdapter
 	TriesContainer() common.TriesHolder
 	TrieStorageManagers() map[string]common.StorageManager
+	StateChangesCollector() state.StateChangesCollector
+	BlockWitnessHandler() state.BlockWitnessHandler
 	IsInterfaceNil() bool
 }
 
 // StatusComponentsHolder holds the status components
 type StatusComponentsHolder interface {
 	OutportHandler() outport.OutportHandler
+	EventsSubscriptions() events.SubscriptionsHandler
 	SoftwareVersionChecker() statistics.SoftwareVersionChecker
 	IsInterfaceNil() bool
 }
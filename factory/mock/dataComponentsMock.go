@@ -4,6 +4,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/factory"
+	"github.com/ElrondNetwork/elrond-go/storage"
 )
 
 // DataComponentsMock -
@@ -13,6 +14,7 @@ type DataComponentsMock struct {
 	DataPool          dataRetriever.PoolsHolder
 	MiniBlockProvider factory.MiniBlockProvider
 	EconomicsData     factory.EconomicsHandler
+	StorageStats      storage.StorageStatisticsHandler
 }
 
 // StorageService -
@@ -44,6 +46,11 @@ func (dcm *DataComponentsMock) MiniBlocksProvider() factory.MiniBlockProvider {
 	return dcm.MiniBlockProvider
 }
 
+// StorageStatistics -
+func (dcm *DataComponentsMock) StorageStatistics() storage.StorageStatisticsHandler {
+	return dcm.StorageStats
+}
+
 // EconomicsHandler -
 func (dcm *DataComponentsMock) EconomicsHandler() factory.EconomicsHandler {
 	return dcm.EconomicsData
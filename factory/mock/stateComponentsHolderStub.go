@@ -7,11 +7,13 @@ import (
 
 // StateComponentsHolderStub -
 type StateComponentsHolderStub struct {
-	PeerAccountsCalled        func() state.AccountsAdapter
-	AccountsAdapterCalled     func() state.AccountsAdapter
-	AccountsAdapterAPICalled  func() state.AccountsAdapter
-	TriesContainerCalled      func() common.TriesHolder
-	TrieStorageManagersCalled func() map[string]common.StorageManager
+	PeerAccountsCalled          func() state.AccountsAdapter
+	AccountsAdapterCalled       func() state.AccountsAdapter
+	AccountsAdapterAPICalled    func() state.AccountsAdapter
+	TriesContainerCalled        func() common.TriesHolder
+	TrieStorageManagersCalled   func() map[string]common.StorageManager
+	StateChangesCollectorCalled func() state.StateChangesCollector
+	BlockWitnessHandlerCalled   func() state.BlockWitnessHandler
 }
 
 // PeerAccounts -
@@ -59,6 +61,24 @@ func (s *StateComponentsHolderStub) TrieStorageManagers() map[string]common.Stor
 	return nil
 }
 
+// StateChangesCollector -
+func (s *StateComponentsHolderStub) StateChangesCollector() state.StateChangesCollector {
+	if s.StateChangesCollectorCalled != nil {
+		return s.StateChangesCollectorCalled()
+	}
+
+	return state.NewDisabledStateChangesCollector()
+}
+
+// BlockWitnessHandler -
+func (s *StateComponentsHolderStub) BlockWitnessHandler() state.BlockWitnessHandler {
+	if s.BlockWitnessHandlerCalled != nil {
+		return s.BlockWitnessHandlerCalled()
+	}
+
+	return state.NewDisabledBlockWitnessHandler()
+}
+
 // IsInterfaceNil -
 func (s *StateComponentsHolderStub) IsInterfaceNil() bool {
 	return s == nil
@@ -11,9 +11,12 @@ import (
 	"github.com/ElrondNetwork/elrond-go/errors"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/state/blockWitness"
 	factoryState "github.com/ElrondNetwork/elrond-go/state/factory"
+	"github.com/ElrondNetwork/elrond-go/state/stateChanges"
 	"github.com/ElrondNetwork/elrond-go/state/storagePruningManager"
 	"github.com/ElrondNetwork/elrond-go/state/storagePruningManager/evictionWaitingList"
+	"github.com/ElrondNetwork/elrond-go/trie"
 	trieFactory "github.com/ElrondNetwork/elrond-go/trie/factory"
 )
 
@@ -28,6 +31,7 @@ type StateComponentsFactoryArgs struct {
 	StorageService   dataRetriever.StorageService
 	ProcessingMode   common.NodeProcessingMode
 	ChainHandler     chainData.ChainHandler
+	BlockWitnessDB   state.BlockWitnessDB
 }
 
 type stateComponentsFactory struct {
@@ -38,15 +42,18 @@ type stateComponentsFactory struct {
 	enableEpochs     config.EnableEpochs
 	processingMode   common.NodeProcessingMode
 	chainHandler     chainData.ChainHandler
+	blockWitnessDB   state.BlockWitnessDB
 }
 
 // stateComponents struct holds the state components of the Elrond protocol
 type stateComponents struct {
-	peerAccounts        state.AccountsAdapter
-	accountsAdapter     state.AccountsAdapter
-	accountsAdapterAPI  state.AccountsAdapter
-	triesContainer      common.TriesHolder
-	trieStorageManagers map[string]common.StorageManager
+	peerAccounts          state.AccountsAdapter
+	accountsAdapter       state.AccountsAdapter
+	accountsAdapterAPI    state.AccountsAdapter
+	triesContainer        common.TriesHolder
+	trieStorageManagers   map[string]common.StorageManager
+	stateChangesCollector state.StateChangesCollector
+	blockWitnessHandler   state.BlockWitnessHandler
 }
 
 // NewStateComponentsFactory will return a new instance of stateComponentsFactory
@@ -81,6 +88,7 @@ func NewStateComponentsFactory(args StateComponentsFactoryArgs) (*stateComponent
 		enableEpochs:     args.EnableEpochs,
 		processingMode:   args.ProcessingMode,
 		chainHandler:     args.ChainHandler,
+		blockWitnessDB:   args.BlockWitnessDB,
 	}, nil
 }
 
@@ -98,26 +106,96 @@ func (scf *stateComponentsFactory) Create() (*stateComponents, error) {
 		return nil, err
 	}
 
-	accountsAdapter, accountsAdapterAPI, err := scf.createAccountsAdapters(triesContainer)
+	stateChangesCollector, err := scf.createStateChangesCollector()
 	if err != nil {
 		return nil, err
 	}
 
-	peerAdapter, err := scf.createPeerAdapter(triesContainer)
+	blockWitnessHandler, err := scf.createBlockWitnessHandler()
+	if err != nil {
+		return nil, err
+	}
+
+	accountsAdapter, accountsAdapterAPI, err := scf.createAccountsAdapters(triesContainer, stateChangesCollector, blockWitnessHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	peerAdapter, err := scf.createPeerAdapter(triesContainer, blockWitnessHandler)
 	if err != nil {
 		return nil, err
 	}
 
 	return &stateComponents{
-		peerAccounts:        peerAdapter,
-		accountsAdapter:     accountsAdapter,
-		accountsAdapterAPI:  accountsAdapterAPI,
-		triesContainer:      triesContainer,
-		trieStorageManagers: trieStorageManagers,
+		peerAccounts:          peerAdapter,
+		accountsAdapter:       accountsAdapter,
+		accountsAdapterAPI:    accountsAdapterAPI,
+		triesContainer:        triesContainer,
+		trieStorageManagers:   trieStorageManagers,
+		stateChangesCollector: stateChangesCollector,
+		blockWitnessHandler:   blockWitnessHandler,
 	}, nil
 }
 
-func (scf *stateComponentsFactory) createAccountsAdapters(triesContainer common.TriesHolder) (state.AccountsAdapter, state.AccountsAdapter, error) {
+func (scf *stateComponentsFactory) createStateChangesCollector() (state.StateChangesCollector, error) {
+	stateChangesConfig := scf.config.StateChanges
+	if !stateChangesConfig.Enabled {
+		return state.NewDisabledStateChangesCollector(), nil
+	}
+
+	return stateChanges.NewStateChangesCollector(stateChanges.ArgsStateChangesCollector{
+		Storer:                 scf.storageService.GetStorer(dataRetriever.BlockStateChangesUnit),
+		Marshalizer:            scf.core.InternalMarshalizer(),
+		AddressPubkeyConverter: scf.core.AddressPubKeyConverter(),
+		SaveInOutportEnabled:   stateChangesConfig.SaveInOutportEnabled,
+	})
+}
+
+func (scf *stateComponentsFactory) createBlockWitnessHandler() (state.BlockWitnessHandler, error) {
+	if !scf.config.BlockWitness.Enabled || !check.IfNil(scf.blockWitnessDB) {
+		return state.NewDisabledBlockWitnessHandler(), nil
+	}
+
+	return blockWitness.NewBlockWitnessHandler(blockWitness.ArgsBlockWitnessHandler{
+		Storer:      scf.storageService.GetStorer(dataRetriever.BlockWitnessUnit),
+		Marshalizer: scf.core.InternalMarshalizer(),
+	})
+}
+
+// createProcessingTrie returns the trie used by the accounts adapter that processes the blocks. When the block
+// witness is enabled, the trie reads its nodes through the block witness handler, so that the API accounts adapter
+// reads are not recorded. When a block witness database is provided, the trie reads its nodes only from the loaded
+// block witnesses
+func (scf *stateComponentsFactory) createProcessingTrie(
+	merkleTrie common.Trie,
+	blockWitnessHandler state.BlockWitnessHandler,
+	maxTrieLevelInMemory uint,
+) (common.Trie, error) {
+	if !check.IfNil(scf.blockWitnessDB) {
+		witnessStorageManager, err := trie.NewTrieStorageManagerWithoutPruning(scf.blockWitnessDB)
+		if err != nil {
+			return nil, err
+		}
+
+		return trie.NewTrie(witnessStorageManager, scf.core.InternalMarshalizer(), scf.core.Hasher(), maxTrieLevelInMemory)
+	}
+	if !blockWitnessHandler.IsEnabled() {
+		return merkleTrie, nil
+	}
+
+	return trie.NewTrie(
+		blockWitnessHandler.WrapStorageManager(merkleTrie.GetStorageManager()),
+		scf.core.InternalMarshalizer(),
+		scf.core.Hasher(),
+		maxTrieLevelInMemory,
+	)
+}
+
+func (scf *stateComponentsFactory) createAccountsAdapters(
+	triesContainer common.TriesHolder,
+	stateChangesCollector state.StateChangesCollector,
+	blockWitnessHandler state.BlockWitnessHandler,
+) (state.AccountsAdapter, state.AccountsAdapter, error) {
 	accountFactory := factoryState.NewAccountCreator()
 	merkleTrie := triesContainer.Get([]byte(trieFactory.UserAccountTrie))
 	storagePruning, err := scf.newStoragePruningManager()
@@ -125,8 +203,13 @@ func (scf *stateComponentsFactory) createAccountsAdapters(triesContainer common.
 		return nil, nil, err
 	}
 
+	processingTrie, err := scf.createProcessingTrie(merkleTrie, blockWitnessHandler, scf.config.StateTriesConfig.MaxStateTrieLevelInMemory)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %s", errors.ErrAccountsAdapterCreation, err.Error())
+	}
+
 	accountsAdapter, err := state.NewAccountsDB(
-		merkleTrie,
+		processingTrie,
 		scf.core.Hasher(),
 		scf.core.InternalMarshalizer(),
 		accountFactory,
@@ -137,6 +220,11 @@ func (scf *stateComponentsFactory) createAccountsAdapters(triesContainer common.
 		return nil, nil, fmt.Errorf("%w: %s", errors.ErrAccountsAdapterCreation, err.Error())
 	}
 
+	err = accountsAdapter.SetStateChangesCollector(stateChangesCollector)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %s", errors.ErrAccountsAdapterCreation, err.Error())
+	}
+
 	accountsAdapterAPI, err := state.NewAccountsDB(
 		merkleTrie,
 		scf.core.Hasher(),
@@ -157,7 +245,10 @@ func (scf *stateComponentsFactory) createAccountsAdapters(triesContainer common.
 	return accountsAdapter, wrapper, nil
 }
 
-func (scf *stateComponentsFactory) createPeerAdapter(triesContainer common.TriesHolder) (state.AccountsAdapter, error) {
+func (scf *stateComponentsFactory) createPeerAdapter(
+	triesContainer common.TriesHolder,
+	blockWitnessHandler state.BlockWitnessHandler,
+) (state.AccountsAdapter, error) {
 	accountFactory := factoryState.NewPeerAccountCreator()
 	merkleTrie := triesContainer.Get([]byte(trieFactory.PeerAccountTrie))
 	storagePruning, err := scf.newStoragePruningManager()
@@ -165,8 +256,13 @@ func (scf *stateComponentsFactory) createPeerAdapter(triesContainer common.Tries
 		return nil, err
 	}
 
+	processingTrie, err := scf.createProcessingTrie(merkleTrie, blockWitnessHandler, scf.config.StateTriesConfig.MaxPeerTrieLevelInMemory)
+	if err != nil {
+		return nil, err
+	}
+
 	peerAdapter, err := state.NewPeerAccountsDB(
-		merkleTrie,
+		processingTrie,
 		scf.core.Hasher(),
 		scf.core.InternalMarshalizer(),
 		accountFactory,
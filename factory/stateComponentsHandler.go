@@ -78,6 +78,12 @@ func (msc *managedStateComponents) CheckSubcomponents() error {
 	if check.IfNil(msc.accountsAdapter) {
 		return errors.ErrNilAccountsAdapter
 	}
+	if check.IfNil(msc.stateChangesCollector) {
+		return errors.ErrNilStateChangesCollector
+	}
+	if check.IfNil(msc.blockWitnessHandler) {
+		return errors.ErrNilBlockWitnessHandler
+	}
 	if check.IfNil(msc.triesContainer) {
 		return errors.ErrNilTriesContainer
 	}
@@ -129,6 +135,30 @@ func (msc *managedStateComponents) AccountsAdapterAPI() state.AccountsAdapter {
 	return msc.stateComponents.accountsAdapterAPI
 }
 
+// StateChangesCollector returns the component collecting the state changes done by each committed block
+func (msc *managedStateComponents) StateChangesCollector() state.StateChangesCollector {
+	msc.mutStateComponents.RLock()
+	defer msc.mutStateComponents.RUnlock()
+
+	if msc.stateComponents == nil {
+		return nil
+	}
+
+	return msc.stateComponents.stateChangesCollector
+}
+
+// BlockWitnessHandler returns the component recording the witness of each executed block
+func (msc *managedStateComponents) BlockWitnessHandler() state.BlockWitnessHandler {
+	msc.mutStateComponents.RLock()
+	defer msc.mutStateComponents.RUnlock()
+
+	if msc.stateComponents == nil {
+		return nil
+	}
+
+	return msc.stateComponents.blockWitnessHandler
+}
+
 // TriesContainer returns the tries container
 func (msc *managedStateComponents) TriesContainer() common.TriesHolder {
 	msc.mutStateComponents.RLock()
@@ -3,6 +3,8 @@ package factory
 import (
 	"context"
 	"fmt"
+	"path/filepath"
+	"time"
 
 	covalentFactory "github.com/ElrondNetwork/covalent-indexer-go/factory"
 	indexerFactory "github.com/ElrondNetwork/elastic-indexer-go/factory"
@@ -17,22 +19,28 @@ import (
 	"github.com/ElrondNetwork/elrond-go/epochStart/notifier"
 	"github.com/ElrondNetwork/elrond-go/errors"
 	"github.com/ElrondNetwork/elrond-go/outport"
+	"github.com/ElrondNetwork/elrond-go/outport/disabled"
+	"github.com/ElrondNetwork/elrond-go/outport/events"
 	outportDriverFactory "github.com/ElrondNetwork/elrond-go/outport/factory"
+	"github.com/ElrondNetwork/elrond-go/outport/files"
+	"github.com/ElrondNetwork/elrond-go/outport/webhook"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/storage"
+	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
 	notifierFactory "github.com/ElrondNetwork/notifier-go/factory"
 )
 
 // TODO: move app status handler initialization here
 
 type statusComponents struct {
-	nodesCoordinator sharding.NodesCoordinator
-	statusHandler    core.AppStatusHandler
-	outportHandler   outport.OutportHandler
-	softwareVersion  statistics.SoftwareVersionChecker
-	resourceMonitor  statistics.ResourceMonitorHandler
-	cancelFunc       func()
+	nodesCoordinator    sharding.NodesCoordinator
+	statusHandler       core.AppStatusHandler
+	outportHandler      outport.OutportHandler
+	eventsSubscriptions events.SubscriptionsHandler
+	softwareVersion     statistics.SoftwareVersionChecker
+	resourceMonitor     statistics.ResourceMonitorHandler
+	cancelFunc          func()
 }
 
 // StatusComponentsFactoryArgs redefines the arguments structure needed for the status components factory
@@ -47,6 +55,7 @@ type StatusComponentsFactoryArgs struct {
 	DataComponents     DataComponentsHolder
 	NetworkComponents  NetworkComponentsHolder
 	StateComponents    StateComponentsHolder
+	WorkingDir         string
 	IsInImportMode     bool
 }
 
@@ -62,6 +71,7 @@ type statusComponentsFactory struct {
 	dataComponents     DataComponentsHolder
 	networkComponents  NetworkComponentsHolder
 	stateComponents    StateComponentsHolder
+	workingDir         string
 	isInImportMode     bool
 }
 
@@ -103,6 +113,7 @@ func NewStatusComponentsFactory(args StatusComponentsFactoryArgs) (*statusCompon
 		dataComponents:     args.DataComponents,
 		networkComponents:  args.NetworkComponents,
 		stateComponents:    args.StateComponents,
+		workingDir:         args.WorkingDir,
 		isInImportMode:     args.IsInImportMode,
 	}, nil
 }
@@ -143,7 +154,12 @@ func (scf *statusComponentsFactory) Create() (*statusComponents, error) {
 		return nil, errors.ErrInvalidRoundDuration
 	}
 
-	outportHandler, err := scf.createOutportDriver()
+	eventsSubscriptions, eventsDriver, err := scf.createEventsSubscriptions()
+	if err != nil {
+		return nil, err
+	}
+
+	outportHandler, err := scf.createOutportDriver(eventsDriver)
 	if err != nil {
 		return nil, err
 	}
@@ -151,12 +167,13 @@ func (scf *statusComponentsFactory) Create() (*statusComponents, error) {
 	_, cancelFunc := context.WithCancel(context.Background())
 
 	statusComponentsInstance := &statusComponents{
-		nodesCoordinator: scf.nodesCoordinator,
-		softwareVersion:  softwareVersionChecker,
-		outportHandler:   outportHandler,
-		statusHandler:    scf.coreComponents.StatusHandler(),
-		resourceMonitor:  resMon,
-		cancelFunc:       cancelFunc,
+		nodesCoordinator:    scf.nodesCoordinator,
+		softwareVersion:     softwareVersionChecker,
+		outportHandler:      outportHandler,
+		eventsSubscriptions: eventsSubscriptions,
+		statusHandler:       scf.coreComponents.StatusHandler(),
+		resourceMonitor:     resMon,
+		cancelFunc:          cancelFunc,
 	}
 
 	if scf.shardCoordinator.SelfId() == core.MetachainShardId {
@@ -205,18 +222,44 @@ func (pc *statusComponents) Close() error {
 
 // createOutportDriver creates a new outport.OutportHandler which is used to register outport drivers
 // once a driver is subscribed it will receive data through the implemented outport.Driver methods
-func (scf *statusComponentsFactory) createOutportDriver() (outport.OutportHandler, error) {
+func (scf *statusComponentsFactory) createOutportDriver(eventsDriver outport.Driver) (outport.OutportHandler, error) {
 
 	outportFactoryArgs := &outportDriverFactory.OutportFactoryArgs{
 		RetrialInterval:            common.RetrialIntervalForOutportDriver,
 		ElasticIndexerFactoryArgs:  scf.makeElasticIndexerArgs(),
 		EventNotifierFactoryArgs:   scf.makeEventNotifierArgs(),
 		CovalentIndexerFactoryArgs: scf.makeCovalentIndexerArgs(),
+		WebhookDriverFactoryArgs:   scf.makeWebhookDriverArgs(),
+		FilesDriverFactoryArgs:     scf.makeFilesDriverArgs(),
+		EventsDriver:               eventsDriver,
+		QueueFactoryArgs:           scf.makeQueueFactoryArgs(),
 	}
 
 	return outportDriverFactory.CreateOutport(outportFactoryArgs)
 }
 
+// createEventsSubscriptions creates the events subscriptions handler along with the outport driver feeding it.
+// The returned driver is nil if the events subscriptions are disabled
+func (scf *statusComponentsFactory) createEventsSubscriptions() (events.SubscriptionsHandler, outport.Driver, error) {
+	eventsSubscriptionsConfig := scf.externalConfig.EventsSubscriptions
+	if !eventsSubscriptionsConfig.Enabled {
+		return disabled.NewDisabledEventsSubscriptions(), nil, nil
+	}
+
+	eventsDriver, err := events.NewEventsDriver(events.ArgsEventsDriver{
+		Marshalizer:            scf.coreComponents.InternalMarshalizer(),
+		Hasher:                 scf.coreComponents.Hasher(),
+		AddressPubKeyConverter: scf.coreComponents.AddressPubKeyConverter(),
+		MaxSubscribers:         eventsSubscriptionsConfig.MaxSubscribers,
+		SubscriptionBufferSize: eventsSubscriptionsConfig.SubscriptionBufferSize,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return eventsDriver, eventsDriver, nil
+}
+
 func (scf *statusComponentsFactory) makeElasticIndexerArgs() *indexerFactory.ArgsIndexerFactory {
 	elasticSearchConfig := scf.externalConfig.ElasticSearchConnector
 	return &indexerFactory.ArgsIndexerFactory{
@@ -266,6 +309,54 @@ func (scf *statusComponentsFactory) makeCovalentIndexerArgs() *covalentFactory.A
 	}
 }
 
+func (scf *statusComponentsFactory) makeWebhookDriverArgs() *outportDriverFactory.WebhookDriverFactoryArgs {
+	webhookConfig := scf.externalConfig.WebhookConnector
+	return &outportDriverFactory.WebhookDriverFactoryArgs{
+		Enabled: webhookConfig.Enabled,
+		ArgsWebhookDriver: webhook.ArgsWebhookDriver{
+			Endpoints:              webhookConfig.Endpoints,
+			HMACSecret:             webhookConfig.HMACSecret,
+			BatchSize:              webhookConfig.BatchSize,
+			RequestTimeout:         time.Duration(webhookConfig.RequestTimeoutInSeconds) * time.Second,
+			MaxRetries:             webhookConfig.MaxRetries,
+			InitialBackoff:         time.Duration(webhookConfig.InitialBackoffInMilliseconds) * time.Millisecond,
+			MaxBackoff:             time.Duration(webhookConfig.MaxBackoffInMilliseconds) * time.Millisecond,
+			FlushInterval:          time.Duration(webhookConfig.FlushIntervalInSeconds) * time.Second,
+			Marshalizer:            scf.coreComponents.InternalMarshalizer(),
+			Hasher:                 scf.coreComponents.Hasher(),
+			AddressPubKeyConverter: scf.coreComponents.AddressPubKeyConverter(),
+		},
+	}
+}
+
+func (scf *statusComponentsFactory) makeFilesDriverArgs() *outportDriverFactory.FilesDriverFactoryArgs {
+	filesConfig := scf.externalConfig.OutportFiles
+	return &outportDriverFactory.FilesDriverFactoryArgs{
+		Enabled: filesConfig.Enabled,
+		ArgsFilesDriver: files.ArgsFilesDriver{
+			Directory:          filepath.Join(scf.workingDir, filesConfig.Directory),
+			Format:             filesConfig.Format,
+			MaxFileSizeInBytes: filesConfig.MaxFileSizeInMB * 1024 * 1024,
+			Marshalizer:        scf.coreComponents.InternalMarshalizer(),
+		},
+	}
+}
+
+func (scf *statusComponentsFactory) makeQueueFactoryArgs() *outportDriverFactory.QueueFactoryArgs {
+	queueConfig := scf.externalConfig.OutportQueue
+	shardID := core.GetShardIDString(scf.shardCoordinator.SelfId())
+
+	return &outportDriverFactory.QueueFactoryArgs{
+		Enabled: queueConfig.Enabled,
+		PersisterCreator: func(driverName string) (storage.Persister, error) {
+			path := filepath.Join(scf.coreComponents.PathHandler().PathForStatic(shardID, queueConfig.DB.FilePath), driverName)
+			return storageFactory.NewPersisterFactory(queueConfig.DB).Create(path)
+		},
+		Marshalizer:   scf.coreComponents.InternalMarshalizer(),
+		StatusHandler: scf.coreComponents.StatusHandler(),
+	}
+}
+
 func startStatisticsMonitor(
 	generalConfig *config.Config,
 	pathManager storage.PathManagerHandler,
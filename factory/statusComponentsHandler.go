@@ -19,9 +19,11 @@ import (
 	"github.com/ElrondNetwork/elrond-go/epochStart/notifier"
 	"github.com/ElrondNetwork/elrond-go/errors"
 	"github.com/ElrondNetwork/elrond-go/outport"
+	"github.com/ElrondNetwork/elrond-go/outport/events"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage"
 )
 
 var _ ComponentHandler = (*managedStatusComponents)(nil)
@@ -95,6 +97,9 @@ func (msc *managedStatusComponents) CheckSubcomponents() error {
 	if check.IfNil(msc.outportHandler) {
 		return errors.ErrNilOutportHandler
 	}
+	if check.IfNil(msc.eventsSubscriptions) {
+		return errors.ErrNilEventsSubscriptions
+	}
 	if check.IfNil(msc.softwareVersion) {
 		return errors.ErrNilSoftwareVersion
 	}
@@ -146,6 +151,18 @@ func (msc *managedStatusComponents) OutportHandler() outport.OutportHandler {
 	return msc.statusComponents.outportHandler
 }
 
+// EventsSubscriptions returns the events subscriptions handler
+func (msc *managedStatusComponents) EventsSubscriptions() events.SubscriptionsHandler {
+	msc.mutStatusComponents.RLock()
+	defer msc.mutStatusComponents.RUnlock()
+
+	if msc.statusComponents == nil {
+		return nil
+	}
+
+	return msc.statusComponents.eventsSubscriptions
+}
+
 // SoftwareVersionChecker returns the software version checker handler
 func (msc *managedStatusComponents) SoftwareVersionChecker() statistics.SoftwareVersionChecker {
 	msc.mutStatusComponents.RLock()
@@ -189,11 +206,35 @@ func (msc *managedStatusComponents) startStatusPolling(ctx context.Context) erro
 		return err
 	}
 
+	err = registerStorageStatistics(appStatusPollingHandler, msc.statusComponentsFactory.dataComponents.StorageStatistics())
+	if err != nil {
+		return err
+	}
+
 	appStatusPollingHandler.Poll(ctx)
 
 	return nil
 }
 
+func registerStorageStatistics(
+	appStatusPollingHandler *appStatusPolling.AppStatusPolling,
+	storageStatistics storage.StorageStatisticsHandler,
+) error {
+	if check.IfNil(storageStatistics) {
+		return nil
+	}
+
+	return appStatusPollingHandler.RegisterPollingFunc(func(appStatusHandler core.AppStatusHandler) {
+		for _, unitStatistics := range storageStatistics.GetStatistics(false) {
+			appStatusHandler.SetUInt64Value(common.MetricStorageNumPutsPrefix+unitStatistics.Unit, unitStatistics.NumPuts)
+			appStatusHandler.SetUInt64Value(common.MetricStorageNumGetsPrefix+unitStatistics.Unit, unitStatistics.NumGets)
+			appStatusHandler.SetUInt64Value(common.MetricStorageNumMissesPrefix+unitStatistics.Unit, unitStatistics.NumMisses)
+			appStatusHandler.SetUInt64Value(common.MetricStorageBytesWrittenPrefix+unitStatistics.Unit, unitStatistics.BytesWritten)
+			appStatusHandler.SetUInt64Value(common.MetricStorageBytesReadPrefix+unitStatistics.Unit, unitStatistics.BytesRead)
+		}
+	})
+}
+
 func registerPollConnectedPeers(
 	appStatusPollingHandler *appStatusPolling.AppStatusPolling,
 	networkComponents NetworkComponentsHolder,
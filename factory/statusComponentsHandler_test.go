@@ -35,11 +35,13 @@ func TestManagedStatusComponents_Create_ShouldWork(t *testing.T) {
 	managedStatusComponents, err := factory.NewManagedStatusComponents(statusComponentsFactory)
 	require.NoError(t, err)
 	require.Nil(t, managedStatusComponents.OutportHandler())
+	require.Nil(t, managedStatusComponents.EventsSubscriptions())
 	require.Nil(t, managedStatusComponents.SoftwareVersionChecker())
 
 	err = managedStatusComponents.Create()
 	require.NoError(t, err)
 	require.NotNil(t, managedStatusComponents.OutportHandler())
+	require.NotNil(t, managedStatusComponents.EventsSubscriptions())
 	require.NotNil(t, managedStatusComponents.SoftwareVersionChecker())
 }
 
@@ -6,6 +6,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/factory"
+	"github.com/ElrondNetwork/elrond-go/storage"
 )
 
 // DataComponentsStub -
@@ -15,6 +16,7 @@ type DataComponentsStub struct {
 	DataPool      dataRetriever.PoolsHolder
 	MbProvider    factory.MiniBlockProvider
 	EconomicsData factory.EconomicsHandler
+	StorageStats  storage.StorageStatisticsHandler
 	mutDcm        sync.RWMutex
 }
 
@@ -63,6 +65,11 @@ func (dcs *DataComponentsStub) MiniBlocksProvider() factory.MiniBlockProvider {
 	return dcs.MbProvider
 }
 
+// StorageStatistics -
+func (dcs *DataComponentsStub) StorageStatistics() storage.StorageStatisticsHandler {
+	return dcs.StorageStats
+}
+
 // EconomicsHandler -
 func (dcs *DataComponentsStub) EconomicsHandler() factory.EconomicsHandler {
 	return dcs.EconomicsData
@@ -74,7 +81,8 @@ func (dcs *DataComponentsStub) Clone() interface{} {
 		BlockChain: dcs.BlockChain,
 		Store:      dcs.Store,
 		DataPool:   dcs.DataPool,
-		MbProvider: dcs.MbProvider,
+		MbProvider:   dcs.MbProvider,
+		StorageStats: dcs.StorageStats,
 	}
 }
 
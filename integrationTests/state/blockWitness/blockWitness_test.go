@@ -0,0 +1,166 @@
+package blockWitness
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/integrationTests"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/witnessVerifier"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const numTxsPerBlock = 5
+
+func TestBlockWitness_ShouldReExecuteTheBlockOnASeparateNode(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	recorder := integrationTests.NewTestProcessorNodeWithBlockWitness(1, 0, 0)
+	verifier := integrationTests.NewTestProcessorNodeWithBlockWitnessDB(1, 0, 0)
+	defer func() {
+		_ = recorder.Messenger.Close()
+		_ = verifier.Messenger.Close()
+	}()
+
+	integrationTests.MintAllNodes([]*integrationTests.TestProcessorNode{recorder}, big.NewInt(1000000000000000000))
+
+	_, firstHeaderHash := proposeAndCommitBlockWithTransfers(t, recorder, 1, 1)
+	header, headerHash := proposeAndCommitBlockWithTransfers(t, recorder, 2, 2)
+	require.NotEmpty(t, header.GetMiniBlockHeaderHandlers())
+
+	blockWitnessVerifier := createBlockWitnessVerifier(t, verifier, recorder.Storage)
+	witnessStorer := recorder.Storage.GetStorer(dataRetriever.BlockWitnessUnit)
+	witness := getWitness(t, witnessStorer, headerHash)
+	firstHeader, err := process.GetShardHeaderFromStorage(firstHeaderHash, integrationTests.TestMarshalizer, recorder.Storage)
+	require.Nil(t, err)
+	assert.Equal(t, firstHeader.GetRootHash(), witness.RootHash)
+
+	t.Run("the recorded witness should re-execute the block", func(t *testing.T) {
+		err := blockWitnessVerifier.Verify(headerHash)
+		assert.Nil(t, err)
+		assert.True(t, check.IfNil(verifier.BlockChain.GetCurrentBlockHeader()))
+	})
+	t.Run("a witness with a missing trie node should not re-execute the block", func(t *testing.T) {
+		tamperedWitness := *witness
+		tamperedWitness.TrieNodes = removeTrieNode(witness.TrieNodes, witness.RootHash)
+		putWitness(t, witnessStorer, headerHash, &tamperedWitness)
+		defer putWitness(t, witnessStorer, headerHash, witness)
+
+		err := blockWitnessVerifier.Verify(headerHash)
+		assert.True(t, errors.Is(err, state.ErrMissingWitnessNode))
+	})
+	t.Run("a witness of another block should not re-execute the block", func(t *testing.T) {
+		putWitness(t, witnessStorer, headerHash, getWitness(t, witnessStorer, firstHeaderHash))
+		defer putWitness(t, witnessStorer, headerHash, witness)
+
+		err := blockWitnessVerifier.Verify(headerHash)
+		assert.True(t, errors.Is(err, process.ErrBlockWitnessRootHashMismatch))
+	})
+	t.Run("the recording node state should be unchanged", func(t *testing.T) {
+		rootHash, err := recorder.AccntState.RootHash()
+		assert.Nil(t, err)
+		assert.Equal(t, header.GetRootHash(), rootHash)
+	})
+}
+
+func proposeAndCommitBlockWithTransfers(
+	t *testing.T,
+	node *integrationTests.TestProcessorNode,
+	round uint64,
+	nonce uint64,
+) (data.HeaderHandler, []byte) {
+	addTransfersInPool(node)
+
+	body, header, _ := node.ProposeBlock(round, nonce)
+	require.NotNil(t, header)
+	require.Nil(t, node.BlockProcessor.CommitBlock(header, body))
+
+	return header, node.BlockChain.GetCurrentBlockHeaderHash()
+}
+
+func addTransfersInPool(node *integrationTests.TestProcessorNode) {
+	cacheID := process.ShardCacherIdentifier(node.ShardCoordinator.SelfId(), node.ShardCoordinator.SelfId())
+	for i := 0; i < numTxsPerBlock; i++ {
+		receiver := integrationTests.CreateTestWalletAccount(node.ShardCoordinator, node.ShardCoordinator.SelfId())
+		tx := integrationTests.GenerateTransferTx(
+			node.OwnAccount.Nonce,
+			node.OwnAccount.SkTxSign,
+			receiver.PkTxSign,
+			big.NewInt(1000),
+			integrationTests.MinTxGasPrice,
+			integrationTests.MinTxGasLimit,
+			integrationTests.ChainID,
+			integrationTests.MinTransactionVersion,
+		)
+		node.OwnAccount.Nonce++
+
+		txHash, _ := core.CalculateHash(integrationTests.TestMarshalizer, integrationTests.TestHasher, tx)
+		node.DataPool.Transactions().AddData(txHash, tx, tx.Size(), cacheID)
+	}
+}
+
+func createBlockWitnessVerifier(
+	t *testing.T,
+	node *integrationTests.TestProcessorNode,
+	store dataRetriever.StorageService,
+) witnessVerifierHandler {
+	verifier, err := witnessVerifier.NewBlockWitnessVerifier(witnessVerifier.ArgsBlockWitnessVerifier{
+		BlockProcessor:   node.BlockProcessor,
+		BlockChain:       node.BlockChain,
+		BlockTracker:     node.BlockTracker,
+		DataPool:         node.DataPool,
+		Store:            store,
+		BootStorer:       node.BootstrapStorer,
+		WitnessDB:        node.BlockWitnessDB,
+		Marshalizer:      integrationTests.TestMarshalizer,
+		Uint64Converter:  integrationTests.TestUint64Converter,
+		ShardCoordinator: node.ShardCoordinator,
+	})
+	require.Nil(t, err)
+
+	return verifier
+}
+
+type witnessVerifierHandler interface {
+	Verify(headerHash []byte) error
+}
+
+func getWitness(t *testing.T, witnessStorer storage.Storer, headerHash []byte) *state.BlockWitness {
+	buff, err := witnessStorer.Get(headerHash)
+	require.Nil(t, err)
+
+	witness := &state.BlockWitness{}
+	require.Nil(t, integrationTests.TestMarshalizer.Unmarshal(witness, buff))
+
+	return witness
+}
+
+func putWitness(t *testing.T, witnessStorer storage.Storer, headerHash []byte, witness *state.BlockWitness) {
+	buff, err := integrationTests.TestMarshalizer.Marshal(witness)
+	require.Nil(t, err)
+	require.Nil(t, witnessStorer.Put(headerHash, buff))
+}
+
+func removeTrieNode(trieNodes [][]byte, hash []byte) [][]byte {
+	remainingNodes := make([][]byte, 0, len(trieNodes))
+	for _, node := range trieNodes {
+		if bytes.Equal(integrationTests.TestHasher.Compute(string(node)), hash) {
+			continue
+		}
+
+		remainingNodes = append(remainingNodes, node)
+	}
+
+	return remainingNodes
+}
@@ -458,6 +458,7 @@ func createTriePruningStorer(coordinator sharding.Coordinator, notifier pruning.
 		CacheConf:              cacheConf,
 		DbPath:                 dbConf.FilePath,
 		PersisterFactory:       persisterFactory,
+		ColdStorageHandler:     &storageMock.ColdStorageHandlerStub{},
 		NumOfEpochsToKeep:      4,
 		NumOfActivePersisters:  4,
 		Notifier:               notifier,
@@ -74,6 +74,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/scToProtocol"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/builtInFunctions"
+	scDisabled "github.com/ElrondNetwork/elrond-go/process/smartContract/disabled"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
 	processSync "github.com/ElrondNetwork/elrond-go/process/sync"
 	"github.com/ElrondNetwork/elrond-go/process/track"
@@ -81,6 +82,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/transactionLog"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/state/blockWitness"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 	"github.com/ElrondNetwork/elrond-go/storage/timecache"
@@ -96,6 +98,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/testscommon/p2pmocks"
 	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
 	statusHandlerMock "github.com/ElrondNetwork/elrond-go/testscommon/statusHandler"
+	"github.com/ElrondNetwork/elrond-go/trie"
 	trieFactory "github.com/ElrondNetwork/elrond-go/trie/factory"
 	"github.com/ElrondNetwork/elrond-go/update"
 	"github.com/ElrondNetwork/elrond-go/update/trigger"
@@ -248,6 +251,9 @@ type TestProcessorNode struct {
 	BlockChain          data.ChainHandler
 	GenesisBlocks       map[uint32]data.HeaderHandler
 
+	BlockWitnessHandler state.BlockWitnessHandler
+	BlockWitnessDB      state.BlockWitnessDB
+
 	EconomicsData *economics.TestEconomicsData
 	RatingsData   *rating.RatingsData
 
@@ -446,6 +452,39 @@ func NewTestProcessorNode(
 	return tpn
 }
 
+// NewTestProcessorNodeWithBlockWitness returns a new TestProcessorNode instance which saves the witness of each
+// committed block in its storage
+func NewTestProcessorNodeWithBlockWitness(
+	maxShards uint32,
+	nodeShardId uint32,
+	txSignPrivKeyShardId uint32,
+) *TestProcessorNode {
+	tpn := newBaseTestProcessorNode(maxShards, nodeShardId, txSignPrivKeyShardId)
+	witnessStorer := CreateMemUnit()
+	tpn.BlockWitnessHandler, _ = blockWitness.NewBlockWitnessHandler(blockWitness.ArgsBlockWitnessHandler{
+		Storer:      witnessStorer,
+		Marshalizer: TestMarshalizer,
+	})
+	tpn.initTestNode()
+	tpn.Storage.AddStorer(dataRetriever.BlockWitnessUnit, witnessStorer)
+
+	return tpn
+}
+
+// NewTestProcessorNodeWithBlockWitnessDB returns a new TestProcessorNode instance whose accounts adapters read the
+// trie nodes only from the block witnesses loaded in its witness database
+func NewTestProcessorNodeWithBlockWitnessDB(
+	maxShards uint32,
+	nodeShardId uint32,
+	txSignPrivKeyShardId uint32,
+) *TestProcessorNode {
+	tpn := newBaseTestProcessorNode(maxShards, nodeShardId, txSignPrivKeyShardId)
+	tpn.BlockWitnessDB, _ = blockWitness.NewWitnessDB(TestHasher)
+	tpn.initTestNode()
+
+	return tpn
+}
+
 // NewTestProcessorNodeWithStorageTrieAndGasModel returns a new TestProcessorNode instance with a storage-based trie
 // and gas model
 func NewTestProcessorNodeWithStorageTrieAndGasModel(
@@ -548,6 +587,7 @@ func NewTestProcessorNodeWithFullGenesis(
 		ArwenChangeLocker:        tpn.ArwenChangeLocker,
 		Bootstrapper:             tpn.Bootstrapper,
 		AllowExternalQueriesChan: common.GetClosedUnbufferedChannel(),
+		HistoricalStateSelector:  scDisabled.NewHistoricalStateSelector(),
 	}
 	tpn.SCQueryService, _ = smartContract.NewSCQueryService(argsNewScQueryService)
 	tpn.initBlockProcessor(stateCheckpointModulus)
@@ -640,13 +680,14 @@ func (tpn *TestProcessorNode) initAccountDBsWithPruningStorer(
 	store storage.Storer,
 ) {
 	trieStorageManager := CreateTrieStorageManagerWithPruningStorer(store, tpn.ShardCoordinator, tpn.EpochStartNotifier)
+	processingStorageManager := tpn.createProcessingStorageManager(trieStorageManager)
 	tpn.TrieContainer = state.NewDataTriesHolder()
 	var stateTrie common.Trie
-	tpn.AccntState, stateTrie = CreateAccountsDB(UserAccount, trieStorageManager)
+	tpn.AccntState, stateTrie = CreateAccountsDB(UserAccount, processingStorageManager)
 	tpn.TrieContainer.Put([]byte(trieFactory.UserAccountTrie), stateTrie)
 
 	var peerTrie common.Trie
-	tpn.PeerState, peerTrie = CreateAccountsDB(ValidatorAccount, trieStorageManager)
+	tpn.PeerState, peerTrie = CreateAccountsDB(ValidatorAccount, processingStorageManager)
 	tpn.TrieContainer.Put([]byte(trieFactory.PeerAccountTrie), peerTrie)
 
 	tpn.TrieStorageManagers = make(map[string]common.StorageManager)
@@ -654,6 +695,25 @@ func (tpn *TestProcessorNode) initAccountDBsWithPruningStorer(
 	tpn.TrieStorageManagers[trieFactory.PeerAccountTrie] = trieStorageManager
 }
 
+// createProcessingStorageManager returns the storage manager the accounts adapters read the trie nodes from: the
+// witness database if one is set, otherwise the provided storage manager, recorded by the block witness handler
+func (tpn *TestProcessorNode) createProcessingStorageManager(trieStorageManager common.StorageManager) common.StorageManager {
+	if !check.IfNil(tpn.BlockWitnessDB) {
+		witnessStorageManager, _ := trie.NewTrieStorageManagerWithoutPruning(tpn.BlockWitnessDB)
+		return witnessStorageManager
+	}
+
+	return tpn.getBlockWitnessHandler().WrapStorageManager(trieStorageManager)
+}
+
+func (tpn *TestProcessorNode) getBlockWitnessHandler() state.BlockWitnessHandler {
+	if check.IfNil(tpn.BlockWitnessHandler) {
+		return state.NewDisabledBlockWitnessHandler()
+	}
+
+	return tpn.BlockWitnessHandler
+}
+
 func (tpn *TestProcessorNode) initAccountDBs(store storage.Storer) {
 	trieStorageManager, _ := CreateTrieStorageManager(store)
 	tpn.TrieContainer = state.NewDataTriesHolder()
@@ -744,6 +804,7 @@ func (tpn *TestProcessorNode) initTestNode() {
 		ArwenChangeLocker:        tpn.ArwenChangeLocker,
 		Bootstrapper:             tpn.Bootstrapper,
 		AllowExternalQueriesChan: common.GetClosedUnbufferedChannel(),
+		HistoricalStateSelector:  scDisabled.NewHistoricalStateSelector(),
 	}
 	tpn.SCQueryService, _ = smartContract.NewSCQueryService(argsNewScQueryService)
 	tpn.initBlockProcessor(stateCheckpointModulus)
@@ -947,6 +1008,7 @@ func (tpn *TestProcessorNode) createFullSCQueryService() {
 		ArwenChangeLocker:        tpn.ArwenChangeLocker,
 		Bootstrapper:             tpn.Bootstrapper,
 		AllowExternalQueriesChan: common.GetClosedUnbufferedChannel(),
+		HistoricalStateSelector:  scDisabled.NewHistoricalStateSelector(),
 	}
 	tpn.SCQueryService, _ = smartContract.NewSCQueryService(argsNewScQueryService)
 }
@@ -964,6 +1026,7 @@ func (tpn *TestProcessorNode) InitializeProcessors(gasMap map[string]map[string]
 		ArwenChangeLocker:        tpn.ArwenChangeLocker,
 		Bootstrapper:             tpn.Bootstrapper,
 		AllowExternalQueriesChan: common.GetClosedUnbufferedChannel(),
+		HistoricalStateSelector:  scDisabled.NewHistoricalStateSelector(),
 	}
 	tpn.SCQueryService, _ = smartContract.NewSCQueryService(argsNewScQueryService)
 	tpn.initBlockProcessor(stateCheckpointModulus)
@@ -1991,6 +2054,8 @@ func (tpn *TestProcessorNode) initBlockProcessor(stateCheckpointModulus uint) {
 		BlockTracker:                   tpn.BlockTracker,
 		BlockSizeThrottler:             TestBlockSizeThrottler,
 		HistoryRepository:              tpn.HistoryRepository,
+		StateChangesCollector:          state.NewDisabledStateChangesCollector(),
+		BlockWitnessHandler:            tpn.getBlockWitnessHandler(),
 		EpochNotifier:                  tpn.EpochNotifier,
 		RoundNotifier:                  coreComponents.RoundNotifier(),
 		GasHandler:                     tpn.GasHandler,
@@ -2999,6 +3064,8 @@ func GetDefaultStateComponents() *testscommon.StateComponentsMock {
 			trieFactory.UserAccountTrie: &testscommon.StorageManagerStub{},
 			trieFactory.PeerAccountTrie: &testscommon.StorageManagerStub{},
 		},
+		StateChanges: &stateMock.StateChangesCollectorStub{},
+		BlockWitness: &stateMock.BlockWitnessHandlerStub{},
 	}
 }
 
@@ -2,18 +2,23 @@ package blockAPI
 
 import (
 	"encoding/hex"
+	"errors"
 	"fmt"
-	"github.com/ElrondNetwork/elrond-go-core/data"
+	"math"
 	"time"
 
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go-core/data/api"
 	"github.com/ElrondNetwork/elrond-go-core/data/block"
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
 	"github.com/ElrondNetwork/elrond-go-core/data/typeConverters"
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/dblookupext"
+	"github.com/ElrondNetwork/elrond-go/storage"
 )
 
 // BlockStatus is the status of a block
@@ -38,6 +43,9 @@ type baseAPIBlockProcessor struct {
 	txStatusComputer transaction.StatusComputerHandler
 }
 
+// MaxBlocksInRange is the maximum number of blocks returned by a single range query
+const MaxBlocksInRange = 100
+
 var log = logger.GetOrCreate("node/blockAPI")
 
 func (bap *baseAPIBlockProcessor) getTxsByMb(mbHeader data.MiniBlockHeaderHandler, epoch uint32) []*transaction.ApiTransactionResult {
@@ -174,3 +182,89 @@ func (bap *baseAPIBlockProcessor) getBlockHeaderHashAndBytesByRound(round uint64
 
 	return headerHash, blockBytes, nil
 }
+
+// getBlocksInRange fetches, in ascending nonce order, at most MaxBlocksInRange blocks between the provided nonces.
+// The iteration stops early if a nonce is not found (end of chain) or, if a filter is provided, when the filter
+// rejects a block. Any other error fetching a block is returned if no block was fetched yet, otherwise the fetched
+// blocks are returned with the HasMore flag set and NextNonce pointing to the failed block, so the client can resume
+func (bap *baseAPIBlockProcessor) getBlocksInRange(
+	fromNonce uint64,
+	toNonce uint64,
+	options common.BlocksQueryOptions,
+	getBlockByNonce func(nonce uint64, withTxs bool) (*api.Block, error),
+	shouldContinue func(blockAPI *api.Block) bool,
+) (*common.BlocksRangeResponse, error) {
+	if toNonce < fromNonce {
+		return nil, ErrInvalidNonceRange
+	}
+
+	withTxs := options.WithTxs && !options.HeadersOnly
+	response := &common.BlocksRangeResponse{
+		Blocks: make([]*api.Block, 0),
+	}
+
+	for nonce := fromNonce; nonce <= toNonce; nonce++ {
+		if len(response.Blocks) == MaxBlocksInRange {
+			response.NextNonce = nonce
+			response.HasMore = true
+			return response, nil
+		}
+
+		blockAPI, err := getBlockByNonce(nonce, withTxs)
+		if errors.Is(err, storage.ErrKeyNotFound) {
+			log.Trace("getBlocksInRange: block not found, stopping", "nonce", nonce, "error", err.Error())
+			return response, nil
+		}
+		if err != nil {
+			log.Debug("getBlocksInRange: cannot get block", "nonce", nonce, "error", err.Error())
+			if len(response.Blocks) == 0 {
+				return nil, err
+			}
+
+			response.NextNonce = nonce
+			response.HasMore = true
+			return response, nil
+		}
+		if shouldContinue != nil && !shouldContinue(blockAPI) {
+			return response, nil
+		}
+		if options.HeadersOnly {
+			blockAPI.MiniBlocks = nil
+		}
+
+		response.Blocks = append(response.Blocks, blockAPI)
+
+		if nonce == math.MaxUint64 {
+			break
+		}
+	}
+
+	return response, nil
+}
+
+// getBlocksInEpoch fetches the blocks of an epoch, starting with the epoch start block or with the provided nonce,
+// if this one is higher
+func (bap *baseAPIBlockProcessor) getBlocksInEpoch(
+	epoch uint32,
+	fromNonce uint64,
+	options common.BlocksQueryOptions,
+	epochStartNonce uint64,
+	getBlockByNonce func(nonce uint64, withTxs bool) (*api.Block, error),
+) (*common.BlocksRangeResponse, error) {
+	if fromNonce < epochStartNonce {
+		fromNonce = epochStartNonce
+	}
+
+	isInEpoch := func(blockAPI *api.Block) bool {
+		return blockAPI.Epoch == epoch
+	}
+
+	return bap.getBlocksInRange(fromNonce, math.MaxUint64, options, getBlockByNonce, isInEpoch)
+}
+
+func (bap *baseAPIBlockProcessor) getEpochStartBlockBytes(unit dataRetriever.UnitType, epoch uint32) ([]byte, error) {
+	epochStartIdentifier := []byte(core.EpochStartIdentifier(epoch))
+	storer := bap.store.GetStorer(unit)
+
+	return storer.GetFromEpoch(epochStartIdentifier, epoch)
+}
@@ -1,10 +1,13 @@
 package blockAPI
 
 import (
+	"errors"
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-go-core/data/api"
 	"github.com/ElrondNetwork/elrond-go-core/data/block"
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/node/mock"
 	"github.com/ElrondNetwork/elrond-go/process/txstatus"
@@ -268,3 +271,135 @@ func TestBaseApiBlockProcessor_GetInvalidTxFromMiniBlock(t *testing.T) {
 	assert.EqualValues(t, mbTxs[0].Type, txType)
 	assert.EqualValues(t, mbTxs[0].Receiver, recvAddress)
 }
+
+func createBlockByNonceGetter(lastNonce uint64, epochOfNonce func(nonce uint64) uint32) func(nonce uint64, withTxs bool) (*api.Block, error) {
+	return func(nonce uint64, withTxs bool) (*api.Block, error) {
+		if nonce > lastNonce {
+			return nil, storage.ErrKeyNotFound
+		}
+
+		return &api.Block{
+			Nonce:      nonce,
+			Epoch:      epochOfNonce(nonce),
+			MiniBlocks: []*api.MiniBlock{{Hash: "mb"}},
+		}, nil
+	}
+}
+
+func TestBaseAPIBlockProcessor_GetBlocksInRange(t *testing.T) {
+	t.Parallel()
+
+	bap := &baseAPIBlockProcessor{}
+	getBlockByNonce := createBlockByNonceGetter(1000, func(_ uint64) uint32 { return 0 })
+
+	t.Run("invalid range should err", func(t *testing.T) {
+		t.Parallel()
+
+		response, err := bap.getBlocksInRange(10, 9, common.BlocksQueryOptions{}, getBlockByNonce, nil)
+		assert.Equal(t, ErrInvalidNonceRange, err)
+		assert.Nil(t, response)
+	})
+
+	t.Run("small range should return all blocks", func(t *testing.T) {
+		t.Parallel()
+
+		response, err := bap.getBlocksInRange(10, 14, common.BlocksQueryOptions{}, getBlockByNonce, nil)
+		assert.Nil(t, err)
+		assert.Len(t, response.Blocks, 5)
+		assert.Equal(t, uint64(10), response.Blocks[0].Nonce)
+		assert.Equal(t, uint64(14), response.Blocks[4].Nonce)
+		assert.False(t, response.HasMore)
+		assert.NotNil(t, response.Blocks[0].MiniBlocks)
+	})
+
+	t.Run("large range should be capped", func(t *testing.T) {
+		t.Parallel()
+
+		response, err := bap.getBlocksInRange(1, 500, common.BlocksQueryOptions{}, getBlockByNonce, nil)
+		assert.Nil(t, err)
+		assert.Len(t, response.Blocks, MaxBlocksInRange)
+		assert.True(t, response.HasMore)
+		assert.Equal(t, uint64(MaxBlocksInRange+1), response.NextNonce)
+	})
+
+	t.Run("range past the end of chain should stop", func(t *testing.T) {
+		t.Parallel()
+
+		response, err := bap.getBlocksInRange(990, 1020, common.BlocksQueryOptions{}, getBlockByNonce, nil)
+		assert.Nil(t, err)
+		assert.Len(t, response.Blocks, 11)
+		assert.False(t, response.HasMore)
+	})
+
+	t.Run("error on the first block should err", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		getter := func(_ uint64, _ bool) (*api.Block, error) {
+			return nil, expectedErr
+		}
+
+		response, err := bap.getBlocksInRange(1, 3, common.BlocksQueryOptions{}, getter, nil)
+		assert.Equal(t, expectedErr, err)
+		assert.Nil(t, response)
+	})
+
+	t.Run("error after some blocks should return them with the next nonce", func(t *testing.T) {
+		t.Parallel()
+
+		getter := func(nonce uint64, withTxs bool) (*api.Block, error) {
+			if nonce == 13 {
+				return nil, errors.New("expected error")
+			}
+
+			return getBlockByNonce(nonce, withTxs)
+		}
+
+		response, err := bap.getBlocksInRange(10, 20, common.BlocksQueryOptions{}, getter, nil)
+		assert.Nil(t, err)
+		assert.Len(t, response.Blocks, 3)
+		assert.True(t, response.HasMore)
+		assert.Equal(t, uint64(13), response.NextNonce)
+	})
+
+	t.Run("headers only should skip miniblocks", func(t *testing.T) {
+		t.Parallel()
+
+		options := common.BlocksQueryOptions{WithTxs: true, HeadersOnly: true}
+		withTxsRequested := false
+		getter := func(nonce uint64, withTxs bool) (*api.Block, error) {
+			withTxsRequested = withTxsRequested || withTxs
+			return getBlockByNonce(nonce, withTxs)
+		}
+
+		response, err := bap.getBlocksInRange(1, 3, options, getter, nil)
+		assert.Nil(t, err)
+		assert.Len(t, response.Blocks, 3)
+		assert.False(t, withTxsRequested)
+		for _, blockAPI := range response.Blocks {
+			assert.Nil(t, blockAPI.MiniBlocks)
+		}
+	})
+}
+
+func TestBaseAPIBlockProcessor_GetBlocksInEpoch(t *testing.T) {
+	t.Parallel()
+
+	bap := &baseAPIBlockProcessor{}
+	epochOfNonce := func(nonce uint64) uint32 {
+		return uint32(nonce / 50)
+	}
+	getBlockByNonce := createBlockByNonceGetter(1000, epochOfNonce)
+
+	response, err := bap.getBlocksInEpoch(2, 0, common.BlocksQueryOptions{}, 100, getBlockByNonce)
+	assert.Nil(t, err)
+	assert.Len(t, response.Blocks, 50)
+	assert.Equal(t, uint64(100), response.Blocks[0].Nonce)
+	assert.Equal(t, uint64(149), response.Blocks[49].Nonce)
+	assert.False(t, response.HasMore)
+
+	response, err = bap.getBlocksInEpoch(2, 140, common.BlocksQueryOptions{}, 100, getBlockByNonce)
+	assert.Nil(t, err)
+	assert.Len(t, response.Blocks, 10)
+	assert.Equal(t, uint64(140), response.Blocks[0].Nonce)
+}
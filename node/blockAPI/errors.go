@@ -0,0 +1,6 @@
+package blockAPI
+
+import "errors"
+
+// ErrInvalidNonceRange signals that an invalid nonce range has been provided
+var ErrInvalidNonceRange = errors.New("invalid nonce range")
@@ -7,6 +7,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/data/api"
 	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 )
 
@@ -75,6 +76,27 @@ func (mbp *metaAPIBlockProcessor) GetBlockByRound(round uint64, withTxs bool) (*
 	return mbp.convertMetaBlockBytesToAPIBlock(headerHash, blockBytes, withTxs)
 }
 
+// GetBlocksByNonceRange will return the meta APIBlocks between the provided nonces (inclusive)
+func (mbp *metaAPIBlockProcessor) GetBlocksByNonceRange(fromNonce uint64, toNonce uint64, options common.BlocksQueryOptions) (*common.BlocksRangeResponse, error) {
+	return mbp.getBlocksInRange(fromNonce, toNonce, options, mbp.GetBlockByNonce, nil)
+}
+
+// GetBlocksByEpoch will return the meta APIBlocks of the provided epoch, starting with the given nonce
+func (mbp *metaAPIBlockProcessor) GetBlocksByEpoch(epoch uint32, fromNonce uint64, options common.BlocksQueryOptions) (*common.BlocksRangeResponse, error) {
+	blockBytes, err := mbp.getEpochStartBlockBytes(dataRetriever.MetaBlockUnit, epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	epochStartHeader := &block.MetaBlock{}
+	err = mbp.marshalizer.Unmarshal(epochStartHeader, blockBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return mbp.getBlocksInEpoch(epoch, fromNonce, options, epochStartHeader.GetNonce(), mbp.GetBlockByNonce)
+}
+
 func (mbp *metaAPIBlockProcessor) convertMetaBlockBytesToAPIBlock(hash []byte, blockBytes []byte, withTxs bool) (*api.Block, error) {
 	blockHeader := &block.MetaBlock{}
 	err := mbp.marshalizer.Unmarshal(blockHeader, blockBytes)
@@ -6,6 +6,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go-core/data/api"
 	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/node/filters"
 	"github.com/ElrondNetwork/elrond-go/process"
@@ -78,6 +79,26 @@ func (sbp *shardAPIBlockProcessor) GetBlockByRound(round uint64, withTxs bool) (
 	return sbp.convertShardBlockBytesToAPIBlock(headerHash, blockBytes, withTxs)
 }
 
+// GetBlocksByNonceRange will return the shard APIBlocks between the provided nonces (inclusive)
+func (sbp *shardAPIBlockProcessor) GetBlocksByNonceRange(fromNonce uint64, toNonce uint64, options common.BlocksQueryOptions) (*common.BlocksRangeResponse, error) {
+	return sbp.getBlocksInRange(fromNonce, toNonce, options, sbp.GetBlockByNonce, nil)
+}
+
+// GetBlocksByEpoch will return the shard APIBlocks of the provided epoch, starting with the given nonce
+func (sbp *shardAPIBlockProcessor) GetBlocksByEpoch(epoch uint32, fromNonce uint64, options common.BlocksQueryOptions) (*common.BlocksRangeResponse, error) {
+	blockBytes, err := sbp.getEpochStartBlockBytes(dataRetriever.BlockHeaderUnit, epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	epochStartHeader, err := process.CreateShardHeader(sbp.marshalizer, blockBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return sbp.getBlocksInEpoch(epoch, fromNonce, options, epochStartHeader.GetNonce(), sbp.GetBlockByNonce)
+}
+
 func (sbp *shardAPIBlockProcessor) convertShardBlockBytesToAPIBlock(hash []byte, blockBytes []byte, withTxs bool) (*api.Block, error) {
 	blockHeader, err := process.CreateShardHeader(sbp.marshalizer, blockBytes)
 	if err != nil {
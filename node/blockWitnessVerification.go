@@ -0,0 +1,62 @@
+package node
+
+import (
+	"encoding/hex"
+
+	mainFactory "github.com/ElrondNetwork/elrond-go/factory"
+	"github.com/ElrondNetwork/elrond-go/process/witnessVerifier"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/state/blockWitness"
+)
+
+// createBlockWitnessDB returns the database the processing accounts adapters read the trie nodes from when the node
+// verifies a block witness, or nil when the node runs normally
+func (nr *nodeRunner) createBlockWitnessDB(coreComponents mainFactory.CoreComponentsHolder) (state.BlockWitnessDB, error) {
+	if len(nr.configs.FlagsConfig.VerifyBlockWitness) == 0 {
+		return nil, nil
+	}
+
+	return blockWitness.NewWitnessDB(coreComponents.Hasher())
+}
+
+// verifyBlockWitness re-executes the committed block with the provided header hash, reading the state trie nodes only
+// from the block witness saved in the storage. The processing accounts adapters of this node are built over the
+// provided witness database, so the node neither reads nor alters its own state tries
+func (nr *nodeRunner) verifyBlockWitness(
+	blockWitnessDB state.BlockWitnessDB,
+	coreComponents mainFactory.CoreComponentsHolder,
+	dataComponents mainFactory.DataComponentsHolder,
+	processComponents mainFactory.ProcessComponentsHolder,
+) error {
+	headerHash, err := hex.DecodeString(nr.configs.FlagsConfig.VerifyBlockWitness)
+	if err != nil {
+		return err
+	}
+
+	verifier, err := witnessVerifier.NewBlockWitnessVerifier(witnessVerifier.ArgsBlockWitnessVerifier{
+		BlockProcessor:   processComponents.BlockProcessor(),
+		BlockChain:       dataComponents.Blockchain(),
+		BlockTracker:     processComponents.BlockTracker(),
+		DataPool:         dataComponents.Datapool(),
+		Store:            dataComponents.StorageService(),
+		BootStorer:       processComponents.BootStorer(),
+		WitnessDB:        blockWitnessDB,
+		Marshalizer:      coreComponents.InternalMarshalizer(),
+		Uint64Converter:  coreComponents.Uint64ByteSliceConverter(),
+		ShardCoordinator: processComponents.ShardCoordinator(),
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Info("verifying the block witness", "header hash", headerHash)
+
+	err = verifier.Verify(headerHash)
+	if err != nil {
+		return err
+	}
+
+	log.Info("the block was successfully re-executed from its witness", "header hash", headerHash)
+
+	return nil
+}
@@ -150,3 +150,18 @@ var ErrCannotCastAccountHandlerToUserAccountHandler = errors.New("cannot cast ac
 
 // ErrCannotCastUserAccountHandlerToVmCommonUserAccountHandler signals that an user account handler cannot be cast to vm common user account handler
 var ErrCannotCastUserAccountHandlerToVmCommonUserAccountHandler = errors.New("cannot cast user account handler to vm common user account handler")
+
+// ErrDbLookupExtensionsNotEnabled signals that the db lookup extensions are not enabled
+var ErrDbLookupExtensionsNotEnabled = errors.New("db lookup extensions are not enabled")
+
+// ErrTxPoolInspectionNotSupported signals that the transactions pool does not support inspection
+var ErrTxPoolInspectionNotSupported = errors.New("transactions pool does not support inspection")
+
+// ErrNilStorageStatistics signals that a nil storage statistics collector has been provided
+var ErrNilStorageStatistics = errors.New("nil storage statistics")
+
+// ErrStateChangesNotEnabled signals that the recording of the state changes is not enabled
+var ErrStateChangesNotEnabled = errors.New("state changes recording is not enabled")
+
+// ErrInvalidStateDiffCursor signals that an invalid state diff cursor has been provided
+var ErrInvalidStateDiffCursor = errors.New("invalid state diff cursor")
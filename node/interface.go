@@ -7,6 +7,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/process"
 	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/storage/txcache"
 	"github.com/ElrondNetwork/elrond-go/update"
 )
 
@@ -74,3 +75,10 @@ type HeartbeatHandler interface {
 	Sender() *process.Sender
 	IsInterfaceNil() bool
 }
+
+// TxPoolInspector defines the methods of a transactions pool able to expose its internal state
+type TxPoolInspector interface {
+	InspectCaches() []txcache.CacheInspection
+	InspectSender(sender []byte) []*txcache.SenderInspection
+	InspectTransaction(txHash []byte) (*txcache.WrappedTransaction, string, bool)
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/factory"
+	"github.com/ElrondNetwork/elrond-go/storage"
 )
 
 // DataComponentsMock -
@@ -15,6 +16,7 @@ type DataComponentsMock struct {
 	DataPool      dataRetriever.PoolsHolder
 	MbProvider    factory.MiniBlockProvider
 	EconomicsData factory.EconomicsHandler
+	StorageStats  storage.StorageStatisticsHandler
 	mutDcm        sync.RWMutex
 }
 
@@ -63,6 +65,11 @@ func (dcm *DataComponentsMock) MiniBlocksProvider() factory.MiniBlockProvider {
 	return dcm.MbProvider
 }
 
+// StorageStatistics -
+func (dcm *DataComponentsMock) StorageStatistics() storage.StorageStatisticsHandler {
+	return dcm.StorageStats
+}
+
 // EconomicsHandler -
 func (dcm *DataComponentsMock) EconomicsHandler() factory.EconomicsHandler {
 	return dcm.EconomicsData
@@ -74,7 +81,8 @@ func (dcm *DataComponentsMock) Clone() interface{} {
 		BlockChain: dcm.BlockChain,
 		Store:      dcm.Store,
 		DataPool:   dcm.DataPool,
-		MbProvider: dcm.MbProvider,
+		MbProvider:   dcm.MbProvider,
+		StorageStats: dcm.StorageStats,
 	}
 }
 
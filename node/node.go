@@ -36,6 +36,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
 	procTx "github.com/ElrondNetwork/elrond-go/process/transaction"
 	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/trie"
 	"github.com/ElrondNetwork/elrond-go/vm"
 	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts"
@@ -48,6 +49,9 @@ const (
 
 	// esdtTickerNumChars represents the number of hex-encoded characters of a ticker
 	esdtTickerNumChars = 6
+
+	defaultMaxTrieNodesInRangeProof      = 10000
+	defaultMaxDataTrieChangesInStateDiff = 10000
 )
 
 var log = logger.GetOrCreate("node")
@@ -108,6 +112,8 @@ type Node struct {
 	closableComponents        []mainFactory.Closer
 	enableSignTxWithHashEpoch uint32
 	isInImportMode            bool
+	maxTrieNodesInRangeProof  uint32
+	maxDataTrieChangesInDiff  uint32
 }
 
 // ApplyOptions can set up different configurable options of a Node instance
@@ -129,6 +135,8 @@ func NewNode(opts ...Option) (*Node, error) {
 		cancelFunc:               cancelFunc,
 		currentSendingGoRoutines: 0,
 		queryHandlers:            make(map[string]debug.QueryHandler),
+		maxTrieNodesInRangeProof: defaultMaxTrieNodesInRangeProof,
+		maxDataTrieChangesInDiff: defaultMaxDataTrieChangesInStateDiff,
 	}
 
 	node.closableComponents = make([]mainFactory.Closer, 0)
@@ -175,8 +183,8 @@ func (n *Node) GetConsensusGroupSize() int {
 }
 
 // GetBalance gets the balance for a specific address
-func (n *Node) GetBalance(address string) (*big.Int, error) {
-	userAccount, err := n.getAccountHandlerAPIAccounts(address)
+func (n *Node) GetBalance(address string, options common.AccountQueryOptions) (*big.Int, error) {
+	userAccount, err := n.getAccountHandlerAPIAccounts(address, options)
 	if err != nil {
 		if err == ErrCannotCastAccountHandlerToUserAccountHandler {
 			return big.NewInt(0), nil
@@ -188,8 +196,8 @@ func (n *Node) GetBalance(address string) (*big.Int, error) {
 }
 
 // GetUsername gets the username for a specific address
-func (n *Node) GetUsername(address string) (string, error) {
-	userAccount, err := n.getAccountHandlerAPIAccounts(address)
+func (n *Node) GetUsername(address string, options common.AccountQueryOptions) (string, error) {
+	userAccount, err := n.getAccountHandlerAPIAccounts(address, options)
 	if err != nil {
 		return "", err
 	}
@@ -204,7 +212,7 @@ func (n *Node) GetAllIssuedESDTs(tokenType string) ([]string, error) {
 		return nil, ErrMetachainOnlyEndpoint
 	}
 
-	userAccount, err := n.getAccountHandlerForPubKey(vm.ESDTSCAddress)
+	userAccount, err := n.getAccountHandlerForPubKey(vm.ESDTSCAddress, common.AccountQueryOptions{})
 	if err != nil {
 		return nil, err
 	}
@@ -267,8 +275,8 @@ func (n *Node) getEsdtDataFromLeaf(leaf core.KeyValueHolder, userAccount state.U
 }
 
 // GetKeyValuePairs returns all the key-value pairs under the address
-func (n *Node) GetKeyValuePairs(address string) (map[string]string, error) {
-	userAccount, err := n.getAccountHandlerAPIAccounts(address)
+func (n *Node) GetKeyValuePairs(address string, options common.AccountQueryOptions) (map[string]string, error) {
+	userAccount, err := n.getAccountHandlerAPIAccounts(address, options)
 	if err != nil {
 		return nil, err
 	}
@@ -303,13 +311,13 @@ func (n *Node) GetKeyValuePairs(address string) (map[string]string, error) {
 }
 
 // GetValueForKey will return the value for a key from a given account
-func (n *Node) GetValueForKey(address string, key string) (string, error) {
+func (n *Node) GetValueForKey(address string, key string, options common.AccountQueryOptions) (string, error) {
 	keyBytes, err := hex.DecodeString(key)
 	if err != nil {
 		return "", fmt.Errorf("invalid key: %w", err)
 	}
 
-	userAccount, err := n.getAccountHandlerAPIAccounts(address)
+	userAccount, err := n.getAccountHandlerAPIAccounts(address, options)
 	if err != nil {
 		return "", err
 	}
@@ -323,8 +331,8 @@ func (n *Node) GetValueForKey(address string, key string) (string, error) {
 }
 
 // GetESDTData returns the esdt balance and properties from a given account
-func (n *Node) GetESDTData(address, tokenID string, nonce uint64) (*esdt.ESDigitalToken, error) {
-	userAccount, err := n.getAccountHandlerAPIAccounts(address)
+func (n *Node) GetESDTData(address, tokenID string, nonce uint64, options common.AccountQueryOptions) (*esdt.ESDigitalToken, error) {
+	userAccount, err := n.getAccountHandlerAPIAccounts(address, options)
 	if err != nil {
 		return nil, err
 	}
@@ -349,12 +357,13 @@ func (n *Node) GetESDTData(address, tokenID string, nonce uint64) (*esdt.ESDigit
 
 func (n *Node) getTokensIDsWithFilter(
 	f filter,
+	options common.AccountQueryOptions,
 ) ([]string, error) {
 	if n.processComponents.ShardCoordinator().SelfId() != core.MetachainShardId {
 		return nil, ErrMetachainOnlyEndpoint
 	}
 
-	userAccount, err := n.getAccountHandlerForPubKey(vm.ESDTSCAddress)
+	userAccount, err := n.getAccountHandlerForPubKey(vm.ESDTSCAddress, options)
 	if err != nil {
 		return nil, err
 	}
@@ -394,7 +403,7 @@ func (n *Node) getTokensIDsWithFilter(
 }
 
 // GetNFTTokenIDsRegisteredByAddress returns all the token identifiers for semi or non fungible tokens registered by the address
-func (n *Node) GetNFTTokenIDsRegisteredByAddress(address string) ([]string, error) {
+func (n *Node) GetNFTTokenIDsRegisteredByAddress(address string, options common.AccountQueryOptions) ([]string, error) {
 	addressBytes, err := n.coreComponents.AddressPubKeyConverter().Decode(address)
 	if err != nil {
 		return nil, err
@@ -403,11 +412,11 @@ func (n *Node) GetNFTTokenIDsRegisteredByAddress(address string) ([]string, erro
 	f := &getRegisteredNftsFilter{
 		addressBytes: addressBytes,
 	}
-	return n.getTokensIDsWithFilter(f)
+	return n.getTokensIDsWithFilter(f, options)
 }
 
 // GetESDTsWithRole returns all the tokens with the given role for the given address
-func (n *Node) GetESDTsWithRole(address string, role string) ([]string, error) {
+func (n *Node) GetESDTsWithRole(address string, role string, options common.AccountQueryOptions) ([]string, error) {
 	if !core.IsValidESDTRole(role) {
 		return nil, ErrInvalidESDTRole
 	}
@@ -421,11 +430,11 @@ func (n *Node) GetESDTsWithRole(address string, role string) ([]string, error) {
 		addressBytes: addressBytes,
 		role:         role,
 	}
-	return n.getTokensIDsWithFilter(f)
+	return n.getTokensIDsWithFilter(f, options)
 }
 
 // GetESDTsRoles returns all the tokens identifiers and roles for the given address
-func (n *Node) GetESDTsRoles(address string) (map[string][]string, error) {
+func (n *Node) GetESDTsRoles(address string, options common.AccountQueryOptions) (map[string][]string, error) {
 	addressBytes, err := n.coreComponents.AddressPubKeyConverter().Decode(address)
 	if err != nil {
 		return nil, err
@@ -437,7 +446,7 @@ func (n *Node) GetESDTsRoles(address string) (map[string][]string, error) {
 		addressBytes: addressBytes,
 		outputRoles:  tokensRoles,
 	}
-	_, err = n.getTokensIDsWithFilter(f)
+	_, err = n.getTokensIDsWithFilter(f, options)
 	if err != nil {
 		return nil, err
 	}
@@ -467,8 +476,8 @@ func bigToString(bigValue *big.Int) string {
 }
 
 // GetAllESDTTokens returns all the ESDTs that the given address interacted with
-func (n *Node) GetAllESDTTokens(address string) (map[string]*esdt.ESDigitalToken, error) {
-	userAccount, err := n.getAccountHandlerAPIAccounts(address)
+func (n *Node) GetAllESDTTokens(address string, options common.AccountQueryOptions) (map[string]*esdt.ESDigitalToken, error) {
+	userAccount, err := n.getAccountHandlerAPIAccounts(address, options)
 	if err != nil {
 		return nil, err
 	}
@@ -544,7 +553,7 @@ func adjustNftTokenIdentifier(token string, nonce uint64) string {
 	return formattedTokenIdentifier
 }
 
-func (n *Node) getAccountHandlerAPIAccounts(address string) (state.UserAccountHandler, error) {
+func (n *Node) getAccountHandlerAPIAccounts(address string, options common.AccountQueryOptions) (state.UserAccountHandler, error) {
 	componentsNotInitialized := check.IfNil(n.coreComponents.AddressPubKeyConverter()) ||
 		check.IfNil(n.stateComponents.AccountsAdapterAPI())
 	if componentsNotInitialized {
@@ -556,11 +565,11 @@ func (n *Node) getAccountHandlerAPIAccounts(address string) (state.UserAccountHa
 		return nil, errors.New("invalid address, could not decode from: " + err.Error())
 	}
 
-	return n.getAccountHandlerForPubKey(addr)
+	return n.getAccountHandlerForPubKey(addr, options)
 }
 
-func (n *Node) getAccountHandlerForPubKey(address []byte) (state.UserAccountHandler, error) {
-	account, err := n.stateComponents.AccountsAdapterAPI().GetExistingAccount(address)
+func (n *Node) getAccountHandlerForPubKey(address []byte, options common.AccountQueryOptions) (state.UserAccountHandler, error) {
+	account, err := n.getExistingAccount(address, options)
 	if err != nil {
 		return nil, err
 	}
@@ -573,6 +582,49 @@ func (n *Node) getAccountHandlerForPubKey(address []byte) (state.UserAccountHand
 	return userAccount, nil
 }
 
+// getExistingAccount returns the account from the current state or, if a block was selected, from the state of that
+// block. The state of a past block is read from the trie found under the root hash of its header, which is only
+// available if its trie nodes were not pruned
+func (n *Node) getExistingAccount(address []byte, options common.AccountQueryOptions) (vmcommon.AccountHandler, error) {
+	accountsAdapter := n.stateComponents.AccountsAdapterAPI()
+	if !options.IsBlockSelected() {
+		return accountsAdapter.GetExistingAccount(address)
+	}
+
+	header, _, err := process.GetHeaderFromStorageWithOptions(
+		options,
+		n.processComponents.ShardCoordinator().SelfId(),
+		n.dataComponents.StorageService(),
+		n.processComponents.HistoryRepository(),
+		n.coreComponents.Uint64ByteSliceConverter(),
+		n.coreComponents.InternalMarshalizer(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", process.ErrMissingHeader, err.Error())
+	}
+
+	rootHash := header.GetRootHash()
+	mainTrie, err := accountsAdapter.GetTrie(rootHash)
+	if err != nil {
+		return nil, fmt.Errorf("%w for root hash %x: %s", state.ErrStateNotAvailable, rootHash, err.Error())
+	}
+
+	accountBytes, err := mainTrie.Get(address)
+	if err != nil {
+		return nil, fmt.Errorf("%w for root hash %x: %s", state.ErrStateNotAvailable, rootHash, err.Error())
+	}
+	if len(accountBytes) == 0 {
+		return nil, state.ErrAccNotFound
+	}
+
+	account, err := accountsAdapter.GetAccountFromBytes(address, accountBytes)
+	if err != nil {
+		return nil, fmt.Errorf("%w for root hash %x: %s", state.ErrStateNotAvailable, rootHash, err.Error())
+	}
+
+	return account, nil
+}
+
 func (n *Node) castAccountToUserAccount(ah vmcommon.AccountHandler) (state.UserAccountHandler, bool) {
 	if check.IfNil(ah) {
 		return nil, false
@@ -943,7 +995,7 @@ func (n *Node) CreateTransaction(
 }
 
 // GetAccount will return account details for a given address
-func (n *Node) GetAccount(address string) (api.AccountResponse, error) {
+func (n *Node) GetAccount(address string, options common.AccountQueryOptions) (api.AccountResponse, error) {
 	if check.IfNil(n.coreComponents.AddressPubKeyConverter()) {
 		return api.AccountResponse{}, ErrNilPubkeyConverter
 	}
@@ -956,7 +1008,7 @@ func (n *Node) GetAccount(address string) (api.AccountResponse, error) {
 		return api.AccountResponse{}, err
 	}
 
-	accWrp, err := n.stateComponents.AccountsAdapterAPI().GetExistingAccount(addr)
+	accWrp, err := n.getExistingAccount(addr, options)
 	if err != nil {
 		if err == state.ErrAccNotFound {
 			return api.AccountResponse{
@@ -1078,6 +1130,17 @@ func (n *Node) GetQueryHandler(name string) (debug.QueryHandler, error) {
 	return qh, nil
 }
 
+// GetStorageStatistics returns the operations statistics of the storage units, optionally along with the
+// size on disk of each persister
+func (n *Node) GetStorageStatistics(computeDiskUsage bool) ([]storage.UnitStatistics, error) {
+	storageStatistics := n.dataComponents.StorageStatistics()
+	if check.IfNil(storageStatistics) {
+		return nil, ErrNilStorageStatistics
+	}
+
+	return storageStatistics.GetStatistics(computeDiskUsage), nil
+}
+
 // GetPeerInfo returns information about a peer id
 func (n *Node) GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error) {
 	peers := n.networkComponents.NetworkMessenger().Peers()
@@ -1264,6 +1327,98 @@ func (n *Node) GetProofDataTrie(rootHash string, address string, key string) (*c
 	return mainProofResponse, dataTrieProofResponse, nil
 }
 
+// GetProofDataTrieKeys returns the Merkle Proof for the given address, and a single Merkle Proof for all the given
+// keys of the address data trie. The proof also attests the absence of the keys that are not in the data trie
+func (n *Node) GetProofDataTrieKeys(rootHash string, address string, keys []string) (*common.GetProofResponse, *common.GetMultiProofResponse, error) {
+	keysBytes := make([][]byte, 0, len(keys))
+	for _, key := range keys {
+		keyBytes, err := hex.DecodeString(key)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		keysBytes = append(keysBytes, keyBytes)
+	}
+
+	mainProofResponse, dataTrie, dataTrieRootHash, addressBytes, err := n.getProofAndDataTrie(rootHash, address)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	proof, values, err := dataTrie.GetMultiProof(keysBytes)
+	if err != nil {
+		return nil, nil, err
+	}
+	for i := range values {
+		values[i] = state.TrimDataTrieValue(values[i], keysBytes[i], addressBytes)
+	}
+
+	return mainProofResponse, &common.GetMultiProofResponse{
+		Proof:    proof,
+		Keys:     keysBytes,
+		Values:   values,
+		RootHash: hex.EncodeToString(dataTrieRootHash),
+	}, nil
+}
+
+// GetRangeProofDataTrie returns the Merkle Proof for the given address, and a Merkle Proof for all the keys of the
+// address data trie that end with the given suffix. Only key suffix ranges are supported, as the data trie paths
+// are built from the reversed keys. The proof is rejected if it would hold more than the configured number of trie nodes
+func (n *Node) GetRangeProofDataTrie(rootHash string, address string, keySuffix string) (*common.GetProofResponse, *common.GetMultiProofResponse, error) {
+	keySuffixBytes, err := hex.DecodeString(keySuffix)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	mainProofResponse, dataTrie, dataTrieRootHash, addressBytes, err := n.getProofAndDataTrie(rootHash, address)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	proof, leaves, err := dataTrie.GetRangeProof(keySuffixBytes, n.maxTrieNodesInRangeProof)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	multiProofResponse := &common.GetMultiProofResponse{
+		Proof:    proof,
+		Keys:     make([][]byte, 0, len(leaves)),
+		Values:   make([][]byte, 0, len(leaves)),
+		RootHash: hex.EncodeToString(dataTrieRootHash),
+	}
+	for _, leaf := range leaves {
+		multiProofResponse.Keys = append(multiProofResponse.Keys, leaf.Key())
+		multiProofResponse.Values = append(multiProofResponse.Values, state.TrimDataTrieValue(leaf.Value(), leaf.Key(), addressBytes))
+	}
+
+	return mainProofResponse, multiProofResponse, nil
+}
+
+func (n *Node) getProofAndDataTrie(rootHash string, address string) (*common.GetProofResponse, common.Trie, []byte, []byte, error) {
+	rootHashBytes, addressBytes, err := n.getRootHashAndAddressAsBytes(rootHash, address)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	mainProofResponse, err := n.getProof(rootHashBytes, addressBytes)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	userAccount, err := n.getUserAccountFromBytes(addressBytes, mainProofResponse.Value)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	dataTrieRootHash := userAccount.GetRootHash()
+	dataTrie, err := n.stateComponents.AccountsAdapterAPI().GetTrie(dataTrieRootHash)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	return mainProofResponse, dataTrie, dataTrieRootHash, addressBytes, nil
+}
+
 // VerifyProof verifies the given Merkle proof
 func (n *Node) VerifyProof(rootHash string, address string, proof [][]byte) (bool, error) {
 	rootHashBytes, err := hex.DecodeString(rootHash)
@@ -1299,27 +1454,35 @@ func (n *Node) getRootHashAndAddressAsBytes(rootHash string, address string) ([]
 }
 
 func (n *Node) getAccountRootHashAndVal(address []byte, accBytes []byte, key []byte) ([]byte, []byte, error) {
-	account, err := n.stateComponents.AccountsAdapterAPI().GetAccountFromBytes(address, accBytes)
+	userAccount, err := n.getUserAccountFromBytes(address, accBytes)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	userAccount, ok := account.(state.UserAccountHandler)
-	if !ok {
-		return nil, nil, fmt.Errorf("the address does not belong to a user account")
+	retrievedVal, err := userAccount.RetrieveValueFromDataTrieTracker(key)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	dataTrieRootHash := userAccount.GetRootHash()
-	if len(dataTrieRootHash) == 0 {
-		return nil, nil, fmt.Errorf("empty dataTrie rootHash")
-	}
+	return userAccount.GetRootHash(), retrievedVal, nil
+}
 
-	retrievedVal, err := userAccount.RetrieveValueFromDataTrieTracker(key)
+func (n *Node) getUserAccountFromBytes(address []byte, accBytes []byte) (state.UserAccountHandler, error) {
+	account, err := n.stateComponents.AccountsAdapterAPI().GetAccountFromBytes(address, accBytes)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
-	return dataTrieRootHash, retrievedVal, nil
+	userAccount, ok := account.(state.UserAccountHandler)
+	if !ok {
+		return nil, fmt.Errorf("the address does not belong to a user account")
+	}
+
+	if len(userAccount.GetRootHash()) == 0 {
+		return nil, fmt.Errorf("empty dataTrie rootHash")
+	}
+
+	return userAccount, nil
 }
 
 func (n *Node) getProof(rootHash []byte, key []byte) (*common.GetProofResponse, error) {
@@ -0,0 +1,42 @@
+package node
+
+import (
+	"encoding/hex"
+
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+// GetAccountTransactions returns the transactions in which the given address was involved, newest first.
+// The cursor returned in the response can be provided in a subsequent call in order to fetch older transactions
+func (n *Node) GetAccountTransactions(address string, cursor uint64, limit int) (*common.AccountTransactionsResponse, error) {
+	historyRepository := n.processComponents.HistoryRepository()
+	if !historyRepository.IsEnabled() {
+		return nil, ErrDbLookupExtensionsNotEnabled
+	}
+
+	addressBytes, err := n.coreComponents.AddressPubKeyConverter().Decode(address)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, nextCursor, err := historyRepository.GetAccountTransactions(addressBytes, cursor, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	transactions := make([]*common.AccountTransaction, 0, len(entries))
+	for _, entry := range entries {
+		transactions = append(transactions, &common.AccountTransaction{
+			Hash:       hex.EncodeToString(entry.TxHash),
+			BlockHash:  hex.EncodeToString(entry.HeaderHash),
+			BlockNonce: entry.HeaderNonce,
+			Round:      entry.Round,
+			Epoch:      entry.Epoch,
+		})
+	}
+
+	return &common.AccountTransactionsResponse{
+		Transactions: transactions,
+		NextCursor:   nextCursor,
+	}, nil
+}
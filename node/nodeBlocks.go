@@ -6,6 +6,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/data/api"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/node/blockAPI"
 	"github.com/ElrondNetwork/elrond-go/process/txstatus"
 )
@@ -44,6 +45,26 @@ func (n *Node) GetBlockByRound(round uint64, withTxs bool) (*api.Block, error) {
 	return apiBlockProcessor.GetBlockByRound(round, withTxs)
 }
 
+// GetBlocksByNonceRange returns the blocks between the provided nonces (inclusive), capped at blockAPI.MaxBlocksInRange
+func (n *Node) GetBlocksByNonceRange(fromNonce uint64, toNonce uint64, options common.BlocksQueryOptions) (*common.BlocksRangeResponse, error) {
+	apiBlockProcessor, err := n.createAPIBlockProcessor()
+	if err != nil {
+		return nil, err
+	}
+
+	return apiBlockProcessor.GetBlocksByNonceRange(fromNonce, toNonce, options)
+}
+
+// GetBlocksByEpoch returns the blocks of the provided epoch, starting with the given nonce
+func (n *Node) GetBlocksByEpoch(epoch uint32, fromNonce uint64, options common.BlocksQueryOptions) (*common.BlocksRangeResponse, error) {
+	apiBlockProcessor, err := n.createAPIBlockProcessor()
+	if err != nil {
+		return nil, err
+	}
+
+	return apiBlockProcessor.GetBlocksByEpoch(epoch, fromNonce, options)
+}
+
 func (n *Node) createAPIBlockProcessor() (blockAPI.APIBlockHandler, error) {
 	statusComputer, err := txstatus.NewStatusComputer(n.processComponents.ShardCoordinator().SelfId(), n.coreComponents.Uint64ByteSliceConverter(), n.dataComponents.StorageService())
 	if err != nil {
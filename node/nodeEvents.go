@@ -0,0 +1,39 @@
+package node
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/outport/events"
+)
+
+// SubscribeToEvents registers a new subscription for the blocks, transactions and logs events matching the provided filter
+func (n *Node) SubscribeToEvents(filter events.SubscriptionFilter) (*events.Subscription, error) {
+	eventsSubscriptions, err := n.getEventsSubscriptions()
+	if err != nil {
+		return nil, err
+	}
+
+	return eventsSubscriptions.Subscribe(filter)
+}
+
+// UnsubscribeFromEvents ends the provided events subscription
+func (n *Node) UnsubscribeFromEvents(subscription *events.Subscription) {
+	eventsSubscriptions, err := n.getEventsSubscriptions()
+	if err != nil {
+		return
+	}
+
+	eventsSubscriptions.Unsubscribe(subscription)
+}
+
+func (n *Node) getEventsSubscriptions() (events.SubscriptionsHandler, error) {
+	if check.IfNil(n.statusComponents) {
+		return nil, events.ErrEventsSubscriptionsDisabled
+	}
+
+	eventsSubscriptions := n.statusComponents.EventsSubscriptions()
+	if check.IfNil(eventsSubscriptions) {
+		return nil, events.ErrEventsSubscriptionsDisabled
+	}
+
+	return eventsSubscriptions, nil
+}
@@ -0,0 +1,64 @@
+package node_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/outport/disabled"
+	"github.com/ElrondNetwork/elrond-go/outport/events"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/hashingMocks"
+	"github.com/ElrondNetwork/elrond-go/testscommon/mainFactoryMocks"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNode_SubscribeToEventsWithoutStatusComponentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode()
+
+	subscription, err := n.SubscribeToEvents(events.SubscriptionFilter{Blocks: true})
+	require.Equal(t, events.ErrEventsSubscriptionsDisabled, err)
+	require.Nil(t, subscription)
+
+	n.UnsubscribeFromEvents(subscription)
+}
+
+func TestNode_SubscribeToEventsDisabledShouldErr(t *testing.T) {
+	t.Parallel()
+
+	statusComponents := &mainFactoryMocks.StatusComponentsStub{
+		EventsSubscriptionsHandler: disabled.NewDisabledEventsSubscriptions(),
+	}
+	n, _ := node.NewNode()
+	n.SetStatusComponents(statusComponents)
+
+	subscription, err := n.SubscribeToEvents(events.SubscriptionFilter{Blocks: true})
+	require.Equal(t, events.ErrEventsSubscriptionsDisabled, err)
+	require.Nil(t, subscription)
+}
+
+func TestNode_SubscribeAndUnsubscribeFromEvents(t *testing.T) {
+	t.Parallel()
+
+	driver, _ := events.NewEventsDriver(events.ArgsEventsDriver{
+		Marshalizer:            &testscommon.MarshalizerMock{},
+		Hasher:                 &hashingMocks.HasherMock{},
+		AddressPubKeyConverter: testscommon.NewPubkeyConverterMock(32),
+		MaxSubscribers:         1,
+		SubscriptionBufferSize: 10,
+	})
+	statusComponents := &mainFactoryMocks.StatusComponentsStub{
+		EventsSubscriptionsHandler: driver,
+	}
+	n, _ := node.NewNode()
+	n.SetStatusComponents(statusComponents)
+
+	subscription, err := n.SubscribeToEvents(events.SubscriptionFilter{Blocks: true})
+	require.Nil(t, err)
+	require.NotNil(t, subscription)
+
+	n.UnsubscribeFromEvents(subscription)
+	_, ok := <-subscription.Events()
+	require.False(t, ok)
+}
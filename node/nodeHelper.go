@@ -228,6 +228,8 @@ func CreateNode(
 		WithNodeStopChannel(coreComponents.ChanStopNodeProcess()),
 		WithImportMode(isInImportMode),
 		WithESDTNFTStorageHandler(esdtNftStorage),
+		WithMaxTrieNodesInRangeProof(config.Antiflood.WebServer.RangeProofMaxTrieNodes),
+		WithMaxDataTrieChangesInStateDiff(config.Antiflood.WebServer.StateDiffMaxDataTrieChanges),
 	)
 	if err != nil {
 		return nil, errors.New("error creating node: " + err.Error())
@@ -16,6 +16,8 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/closing"
 	"github.com/ElrondNetwork/elrond-go-core/data/endProcess"
+	hasherFactory "github.com/ElrondNetwork/elrond-go-core/hashing/factory"
+	marshalizerFactory "github.com/ElrondNetwork/elrond-go-core/marshal/factory"
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/api/gin"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
@@ -28,6 +30,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/consensus/spos"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	dbLookupFactory "github.com/ElrondNetwork/elrond-go/dblookupext/factory"
+	"github.com/ElrondNetwork/elrond-go/epochStart/snapshot"
 	"github.com/ElrondNetwork/elrond-go/facade"
 	"github.com/ElrondNetwork/elrond-go/facade/initial"
 	mainFactory "github.com/ElrondNetwork/elrond-go/factory"
@@ -36,10 +39,12 @@ import (
 	"github.com/ElrondNetwork/elrond-go/health"
 	"github.com/ElrondNetwork/elrond-go/node/metrics"
 	"github.com/ElrondNetwork/elrond-go/outport"
+	"github.com/ElrondNetwork/elrond-go/outport/backfill"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/interceptors"
 	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/state"
 	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 	"github.com/ElrondNetwork/elrond-go/storage/timecache"
@@ -99,6 +104,11 @@ func (nr *nodeRunner) Start() error {
 		return err
 	}
 
+	err = importSnapshotIfNecessary(flagsConfig.WorkingDir, flagsConfig.ImportSnapshotFile, configs.GeneralConfig)
+	if err != nil {
+		return err
+	}
+
 	printEnableEpochs(nr.configs)
 
 	core.DumpGoRoutinesToLog(0, log)
@@ -249,6 +259,10 @@ func (nr *nodeRunner) executeOneComponentCreationCycle(
 		return true, err
 	}
 
+	if configs.GeneralConfig.SecondaryMode.Enabled {
+		return true, nr.runSecondaryMode(managedCoreComponents, goRoutinesNumberStart)
+	}
+
 	log.Debug("creating crypto components")
 	managedCryptoComponents, err := nr.CreateManagedCryptoComponents(managedCoreComponents)
 	if err != nil {
@@ -281,11 +295,17 @@ func (nr *nodeRunner) executeOneComponentCreationCycle(
 		return true, err
 	}
 
+	blockWitnessDB, err := nr.createBlockWitnessDB(managedCoreComponents)
+	if err != nil {
+		return true, err
+	}
+
 	log.Debug("creating state components")
 	managedStateComponents, err := nr.CreateManagedStateComponents(
 		managedCoreComponents,
 		managedBootstrapComponents,
 		managedDataComponents,
+		blockWitnessDB,
 	)
 	if err != nil {
 		return true, err
@@ -347,6 +367,30 @@ func (nr *nodeRunner) executeOneComponentCreationCycle(
 		return true, err
 	}
 
+	if configs.OutportBackfillConfig.IsBackfillMode {
+		err = nr.runOutportBackfill(
+			managedCoreComponents,
+			managedBootstrapComponents,
+			managedDataComponents,
+			managedStatusComponents,
+			nodesCoordinator,
+		)
+
+		closeOneShotModeComponents(
+			healthService,
+			webServerHandler,
+			managedStatusComponents,
+			managedStateComponents,
+			managedDataComponents,
+			managedBootstrapComponents,
+			managedNetworkComponents,
+			managedCryptoComponents,
+			managedCoreComponents,
+		)
+
+		return true, err
+	}
+
 	argsGasScheduleNotifier := forking.ArgsNewGasScheduleNotifier{
 		GasScheduleConfig: configs.EpochConfig.GasSchedule,
 		ConfigDir:         configurationPaths.GasScheduleDirectoryName,
@@ -380,6 +424,40 @@ func (nr *nodeRunner) executeOneComponentCreationCycle(
 		return true, err
 	}
 
+	if len(flagsConfig.VerifyBlockWitness) > 0 {
+		err = nr.verifyBlockWitness(
+			blockWitnessDB,
+			managedCoreComponents,
+			managedDataComponents,
+			managedProcessComponents,
+		)
+
+		closeOneShotModeComponents(
+			healthService,
+			webServerHandler,
+			managedProcessComponents,
+			managedStatusComponents,
+			managedStateComponents,
+			managedDataComponents,
+			managedBootstrapComponents,
+			managedNetworkComponents,
+			managedCryptoComponents,
+			managedCoreComponents,
+		)
+
+		return true, err
+	}
+
+	err = nr.checkStateIntegrityIfNecessary(
+		managedCoreComponents,
+		managedDataComponents,
+		managedStateComponents,
+		managedProcessComponents,
+	)
+	if err != nil {
+		return true, err
+	}
+
 	log.Debug("starting node... executeOneComponentCreationCycle")
 
 	managedConsensusComponents, err := nr.CreateManagedConsensusComponents(
@@ -478,6 +556,83 @@ func (nr *nodeRunner) executeOneComponentCreationCycle(
 	return false, nil
 }
 
+// runOutportBackfill re-emits the blocks saved in the storage through the outport drivers. The node does not
+// create the process and consensus components in this mode, so it does not take part in the network activity
+func (nr *nodeRunner) runOutportBackfill(
+	coreComponents mainFactory.CoreComponentsHolder,
+	bootstrapComponents mainFactory.BootstrapComponentsHolder,
+	dataComponents mainFactory.DataComponentsHolder,
+	statusComponents mainFactory.StatusComponentsHandler,
+	nodesCoordinator sharding.NodesCoordinator,
+) error {
+	backfillConfig := nr.configs.OutportBackfillConfig
+	backfiller, err := backfill.NewBackfiller(backfill.ArgsBackfiller{
+		Store:                    dataComponents.StorageService(),
+		Marshalizer:              coreComponents.InternalMarshalizer(),
+		Uint64ByteSliceConverter: coreComponents.Uint64ByteSliceConverter(),
+		ShardCoordinator:         bootstrapComponents.ShardCoordinator(),
+		NodesCoordinator:         nodesCoordinator,
+		OutportHandler:           statusComponents.OutportHandler(),
+		StartEpoch:               backfillConfig.StartEpoch,
+		FromNonce:                backfillConfig.FromNonce,
+		ToNonce:                  backfillConfig.ToNonce,
+	})
+	if err != nil {
+		return err
+	}
+
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+	defer func() {
+		signal.Stop(sigs)
+		close(sigs)
+	}()
+
+	go func() {
+		_, ok := <-sigs
+		if !ok {
+			return
+		}
+
+		log.Info("terminating the outport backfill at user's signal...")
+		log.LogIfError(backfiller.Close())
+		// closing the outport handler unblocks a save block call that keeps retrying on a failing driver
+		log.LogIfError(statusComponents.Close())
+	}()
+
+	stats, err := backfiller.Run()
+	if stats != nil {
+		log.Info("outport backfill ended",
+			"first nonce", stats.FirstNonce,
+			"last nonce", stats.LastNonce,
+			"num blocks", stats.NumBlocks,
+			"num transactions", stats.NumTxs,
+			"completed", stats.WasCompleted,
+			"interrupted", stats.WasInterrupted,
+		)
+	}
+
+	return err
+}
+
+// closeOneShotModeComponents closes the components created by the node modes that stop after doing their work
+func closeOneShotModeComponents(
+	healthService io.Closer,
+	httpServer shared.UpgradeableHttpServerHandler,
+	components ...mainFactory.Closer,
+) {
+	log.Debug("closing health service...")
+	log.LogIfError(healthService.Close())
+
+	log.Debug("closing http server")
+	log.LogIfError(httpServer.Close())
+
+	log.Debug("closing the components used by the one shot mode")
+	for _, component := range components {
+		log.LogIfError(component.Close())
+	}
+}
+
 func (nr *nodeRunner) createApiFacade(
 	currentNode *Node,
 	upgradableHttpServer shared.UpgradeableHttpServerHandler,
@@ -506,6 +661,16 @@ func (nr *nodeRunner) createApiFacade(
 		return nil, err
 	}
 
+	return nr.createNodeFacade(currentNode, apiResolver, upgradableHttpServer)
+}
+
+func (nr *nodeRunner) createNodeFacade(
+	currentNode *Node,
+	apiResolver facade.ApiResolver,
+	upgradableHttpServer shared.UpgradeableHttpServerHandler,
+) (closing.Closer, error) {
+	configs := nr.configs
+
 	log.Debug("creating elrond node facade")
 
 	flagsConfig := configs.FlagsConfig
@@ -570,11 +735,25 @@ func (nr *nodeRunner) createMetrics(
 	cryptoComponents mainFactory.CryptoComponentsHolder,
 	bootstrapComponents mainFactory.BootstrapComponentsHolder,
 ) error {
-	err := metrics.InitMetrics(
-		coreComponents.StatusHandlerUtils(),
+	return nr.initMetrics(
+		coreComponents,
 		cryptoComponents.PublicKeyString(),
 		bootstrapComponents.NodeType(),
 		bootstrapComponents.ShardCoordinator(),
+	)
+}
+
+func (nr *nodeRunner) initMetrics(
+	coreComponents mainFactory.CoreComponentsHolder,
+	pubKeyString string,
+	nodeType core.NodeType,
+	shardCoordinator sharding.Coordinator,
+) error {
+	err := metrics.InitMetrics(
+		coreComponents.StatusHandlerUtils(),
+		pubKeyString,
+		nodeType,
+		shardCoordinator,
 		coreComponents.GenesisNodesSetup(),
 		nr.configs.FlagsConfig.Version,
 		nr.configs.EconomicsConfig,
@@ -855,6 +1034,7 @@ func (nr *nodeRunner) CreateManagedStatusComponents(
 		DataComponents:     managedDataComponents,
 		NetworkComponents:  managedNetworkComponents,
 		StateComponents:    managedStateComponents,
+		WorkingDir:         nr.configs.FlagsConfig.WorkingDir,
 		IsInImportMode:     isInImportMode,
 	}
 
@@ -1090,6 +1270,7 @@ func (nr *nodeRunner) CreateManagedStateComponents(
 	coreComponents mainFactory.CoreComponentsHolder,
 	bootstrapComponents mainFactory.BootstrapComponentsHolder,
 	dataComponents mainFactory.DataComponentsHandler,
+	blockWitnessDB state.BlockWitnessDB,
 ) (mainFactory.StateComponentsHandler, error) {
 	processingMode := common.Normal
 	if nr.configs.ImportDbConfig.IsImportDBMode {
@@ -1103,6 +1284,7 @@ func (nr *nodeRunner) CreateManagedStateComponents(
 		StorageService:   dataComponents.StorageService(),
 		ProcessingMode:   processingMode,
 		ChainHandler:     dataComponents.Blockchain(),
+		BlockWitnessDB:   blockWitnessDB,
 	}
 
 	stateComponentsFactory, err := mainFactory.NewStateComponentsFactory(stateArgs)
@@ -1339,6 +1521,43 @@ func cleanupStorageIfNecessary(workingDir string, cleanupStorage bool) error {
 	return os.RemoveAll(dbPath)
 }
 
+func importSnapshotIfNecessary(workingDir string, snapshotFile string, generalConfig *config.Config) error {
+	if len(snapshotFile) == 0 {
+		return nil
+	}
+
+	marshalizer, err := marshalizerFactory.NewMarshalizer(generalConfig.Marshalizer.Type)
+	if err != nil {
+		return err
+	}
+	hasher, err := hasherFactory.NewHasher(generalConfig.Hasher.Type)
+	if err != nil {
+		return err
+	}
+
+	importer, err := snapshot.NewImporter(snapshot.ArgsImporter{
+		DbPath:        filepath.Join(workingDir, common.DefaultDBPath, generalConfig.GeneralSettings.ChainID),
+		GeneralConfig: *generalConfig,
+		Marshalizer:   marshalizer,
+		Hasher:        hasher,
+	})
+	if err != nil {
+		return err
+	}
+
+	manifest, err := importer.Import(snapshotFile)
+	if err != nil {
+		return fmt.Errorf("%w while importing the snapshot %s", err, snapshotFile)
+	}
+
+	log.Info("the node will bootstrap from the imported snapshot",
+		"shard", manifest.ShardID,
+		"epoch", manifest.Epoch,
+		"round", manifest.Round)
+
+	return nil
+}
+
 func copyConfigToStatsFolder(statsFolder string, gasScheduleFolder string, configs []string) {
 	err := os.MkdirAll(statsFolder, os.ModePerm)
 	log.LogIfError(err)
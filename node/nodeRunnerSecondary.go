@@ -0,0 +1,286 @@
+package node
+
+import (
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/common"
+	dbLookupFactory "github.com/ElrondNetwork/elrond-go/dblookupext/factory"
+	mainFactory "github.com/ElrondNetwork/elrond-go/factory"
+	nodeDisabled "github.com/ElrondNetwork/elrond-go/node/disabled"
+	"github.com/ElrondNetwork/elrond-go/node/secondary"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
+	"github.com/ElrondNetwork/elrond-go/storage/factory/directoryhandler"
+	"github.com/ElrondNetwork/elrond-go/storage/latestData"
+	storageSecondary "github.com/ElrondNetwork/elrond-go/storage/secondary"
+	"github.com/ElrondNetwork/elrond-vm-common/builtInFunctions"
+)
+
+// runSecondaryMode serves the API queries from the databases of the primary node, following the blocks it commits.
+// The node neither connects to the network nor processes blocks in this mode, so only the components reading the
+// storage are created
+func (nr *nodeRunner) runSecondaryMode(
+	coreComponents mainFactory.CoreComponentsHandler,
+	goRoutinesNumberStart int,
+) error {
+	configs := nr.configs
+	secondaryConfig := configs.GeneralConfig.SecondaryMode
+	primaryDbPath := filepath.Join(secondaryConfig.PrimaryWorkingDirectory, common.DefaultDBPath, coreComponents.ChainID())
+	refreshInterval := time.Duration(secondaryConfig.RefreshIntervalInMilliseconds) * time.Millisecond
+
+	log.Info("starting in secondary mode", "primary db path", primaryDbPath)
+
+	latestDataFromPrimary, err := nr.getLatestDataFromPrimary(coreComponents, primaryDbPath, refreshInterval)
+	if err != nil {
+		return err
+	}
+
+	shardCoordinator, err := sharding.NewMultiShardCoordinator(
+		coreComponents.GenesisNodesSetup().NumberOfShards(),
+		latestDataFromPrimary.ShardID,
+	)
+	if err != nil {
+		return err
+	}
+
+	log.Trace("creating metrics")
+	err = nr.initMetrics(coreComponents, "", core.NodeTypeObserver, shardCoordinator)
+	if err != nil {
+		return err
+	}
+
+	log.Debug("creating data components")
+	dataComponents, err := nr.createSecondaryDataComponents(coreComponents, shardCoordinator, latestDataFromPrimary.Epoch)
+	if err != nil {
+		return err
+	}
+
+	log.Debug("creating state components")
+	stateComponents, err := nr.createSecondaryStateComponents(coreComponents, shardCoordinator, dataComponents)
+	if err != nil {
+		return err
+	}
+
+	healthService := nr.createHealthService(configs.FlagsConfig, dataComponents)
+
+	primaryFollower, err := secondary.NewFollower(secondary.ArgsFollower{
+		Store:              dataComponents.StorageService(),
+		Marshalizer:        coreComponents.InternalMarshalizer(),
+		ShardCoordinator:   shardCoordinator,
+		Blockchain:         dataComponents.Blockchain(),
+		EpochStartNotifier: coreComponents.EpochStartNotifierWithConfirm(),
+		EpochNotifier:      coreComponents.EpochNotifier(),
+		AppStatusHandler:   coreComponents.StatusHandler(),
+		PrimaryDbPath:      primaryDbPath,
+		StartEpoch:         latestDataFromPrimary.Epoch,
+		RefreshInterval:    refreshInterval,
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Debug("creating secondary process components")
+	historyRepositoryFactory, err := dbLookupFactory.NewHistoryRepositoryFactory(&dbLookupFactory.ArgsHistoryRepositoryFactory{
+		SelfShardID:              shardCoordinator.SelfId(),
+		Config:                   configs.GeneralConfig.DbLookupExtensions,
+		Hasher:                   coreComponents.Hasher(),
+		Marshalizer:              coreComponents.InternalMarshalizer(),
+		Store:                    dataComponents.StorageService(),
+		Uint64ByteSliceConverter: coreComponents.Uint64ByteSliceConverter(),
+	})
+	if err != nil {
+		return err
+	}
+	historyRepository, err := historyRepositoryFactory.Create()
+	if err != nil {
+		return err
+	}
+	processComponents, err := secondary.NewProcessComponents(shardCoordinator, historyRepository, primaryFollower)
+	if err != nil {
+		return err
+	}
+
+	log.Trace("creating node structure")
+	currentNode, err := nr.createSecondaryNode(coreComponents, dataComponents, stateComponents, processComponents)
+	if err != nil {
+		return err
+	}
+
+	apiResolver, err := secondary.NewApiResolver(coreComponents.StatusHandlerUtils().Metrics())
+	if err != nil {
+		return err
+	}
+
+	webServerHandler, err := nr.createHttpServer()
+	if err != nil {
+		return err
+	}
+
+	log.Debug("updating the API service after creating the node facade")
+	ef, err := nr.createNodeFacade(currentNode, apiResolver, webServerHandler)
+	if err != nil {
+		return err
+	}
+
+	primaryFollower.StartFollowing()
+
+	log.Info("application is now running in secondary mode")
+
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+
+	// a secondary node is never shuffled out, so it stops once its components are closed
+	_ = waitForSignal(
+		sigs,
+		coreComponents.ChanStopNodeProcess(),
+		healthService,
+		ef,
+		webServerHandler,
+		currentNode,
+		goRoutinesNumberStart,
+	)
+
+	return nil
+}
+
+// getLatestDataFromPrimary reads the epoch and the shard of the primary node from its bootstrap storage
+func (nr *nodeRunner) getLatestDataFromPrimary(
+	coreComponents mainFactory.CoreComponentsHolder,
+	primaryDbPath string,
+	refreshInterval time.Duration,
+) (storage.LatestDataFromStorage, error) {
+	generalConfig := nr.configs.GeneralConfig
+	persisterFactory, err := storageSecondary.NewPersisterFactory(storageSecondary.ArgsPersisterFactory{
+		PersisterFactory: storageFactory.NewPersisterFactory(generalConfig.BootstrapStorage.DB),
+		PrimaryDbPath:    primaryDbPath,
+		DbPath:           filepath.Join(nr.configs.FlagsConfig.WorkingDir, common.DefaultDBPath, coreComponents.ChainID()),
+		RefreshInterval:  refreshInterval,
+	})
+	if err != nil {
+		return storage.LatestDataFromStorage{}, err
+	}
+
+	bootstrapDataProvider, err := storageFactory.NewBootstrapDataProvider(coreComponents.InternalMarshalizer())
+	if err != nil {
+		return storage.LatestDataFromStorage{}, err
+	}
+
+	latestDataProvider, err := latestData.NewLatestDataProvider(latestData.ArgsLatestDataProvider{
+		GeneralConfig:         *generalConfig,
+		BootstrapDataProvider: bootstrapDataProvider,
+		DirectoryReader:       directoryhandler.NewDirectoryReader(),
+		ParentDir:             primaryDbPath,
+		DefaultEpochString:    common.DefaultEpochString,
+		DefaultShardString:    common.DefaultShardString,
+		PersisterFactory:      persisterFactory,
+	})
+	if err != nil {
+		return storage.LatestDataFromStorage{}, err
+	}
+
+	return latestDataProvider.Get()
+}
+
+func (nr *nodeRunner) createSecondaryDataComponents(
+	coreComponents mainFactory.CoreComponentsHolder,
+	shardCoordinator sharding.Coordinator,
+	epoch uint32,
+) (mainFactory.DataComponentsHandler, error) {
+	configs := nr.configs
+	storerEpoch := epoch
+	if !configs.GeneralConfig.StoragePruning.Enabled {
+		storerEpoch = 0
+	}
+
+	dataComponentsFactory, err := mainFactory.NewDataComponentsFactory(mainFactory.DataComponentsFactoryArgs{
+		Config:             *configs.GeneralConfig,
+		PrefsConfig:        configs.PreferencesConfig.Preferences,
+		ShardCoordinator:   shardCoordinator,
+		Core:               coreComponents,
+		EpochStartNotifier: coreComponents.EpochStartNotifierWithConfirm(),
+		CurrentEpoch:       storerEpoch,
+	})
+	if err != nil {
+		return nil, err
+	}
+	managedDataComponents, err := mainFactory.NewManagedDataComponents(dataComponentsFactory)
+	if err != nil {
+		return nil, err
+	}
+
+	err = managedDataComponents.Create()
+	if err != nil {
+		return nil, err
+	}
+
+	// the status metrics are not loaded from the storage nor saved into it, as it belongs to the primary node
+	return managedDataComponents, nil
+}
+
+func (nr *nodeRunner) createSecondaryStateComponents(
+	coreComponents mainFactory.CoreComponentsHolder,
+	shardCoordinator sharding.Coordinator,
+	dataComponents mainFactory.DataComponentsHolder,
+) (mainFactory.StateComponentsHandler, error) {
+	stateComponentsFactory, err := mainFactory.NewStateComponentsFactory(mainFactory.StateComponentsFactoryArgs{
+		Config:           *nr.configs.GeneralConfig,
+		EnableEpochs:     nr.configs.EpochConfig.EnableEpochs,
+		ShardCoordinator: shardCoordinator,
+		Core:             coreComponents,
+		StorageService:   dataComponents.StorageService(),
+		ProcessingMode:   common.Normal,
+		ChainHandler:     dataComponents.Blockchain(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	managedStateComponents, err := mainFactory.NewManagedStateComponents(stateComponentsFactory)
+	if err != nil {
+		return nil, err
+	}
+
+	err = managedStateComponents.Create()
+	if err != nil {
+		return nil, err
+	}
+
+	return managedStateComponents, nil
+}
+
+func (nr *nodeRunner) createSecondaryNode(
+	coreComponents mainFactory.CoreComponentsHandler,
+	dataComponents mainFactory.DataComponentsHandler,
+	stateComponents mainFactory.StateComponentsHandler,
+	processComponents mainFactory.ProcessComponentsHandler,
+) (*Node, error) {
+	generalConfig := nr.configs.GeneralConfig
+	esdtNftStorage, err := builtInFunctions.NewESDTDataStorage(builtInFunctions.ArgsNewESDTDataStorage{
+		Accounts:                stateComponents.AccountsAdapterAPI(),
+		GlobalSettingsHandler:   nodeDisabled.NewDisabledGlobalSettingHandler(),
+		Marshalizer:             coreComponents.InternalMarshalizer(),
+		SaveToSystemEnableEpoch: nr.configs.EpochConfig.EnableEpochs.OptimizeNFTStoreEnableEpoch,
+		EpochNotifier:           coreComponents.EpochNotifier(),
+		ShardCoordinator:        processComponents.ShardCoordinator(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return NewNode(
+		WithCoreComponents(coreComponents),
+		WithDataComponents(dataComponents),
+		WithStateComponents(stateComponents),
+		WithProcessComponents(processComponents),
+		WithAddressSignatureSize(generalConfig.AddressPubkeyConverter.SignatureLength),
+		WithValidatorSignatureSize(generalConfig.ValidatorPubkeyConverter.SignatureLength),
+		WithPublicKeySize(generalConfig.ValidatorPubkeyConverter.Length),
+		WithNodeStopChannel(coreComponents.ChanStopNodeProcess()),
+		WithESDTNFTStorageHandler(esdtNftStorage),
+	)
+}
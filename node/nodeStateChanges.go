@@ -0,0 +1,62 @@
+package node
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/state"
+)
+
+// GetBlockStateChangesByNonce returns the changes done on the accounts by the block with the provided nonce
+func (n *Node) GetBlockStateChangesByNonce(nonce uint64) (*common.BlockStateChangesResponse, error) {
+	if !n.stateComponents.StateChangesCollector().IsEnabled() {
+		return nil, ErrStateChangesNotEnabled
+	}
+
+	options := common.AccountQueryOptions{
+		BlockNonce:    nonce,
+		HasBlockNonce: true,
+	}
+	header, headerHash, err := process.GetHeaderFromStorageWithOptions(
+		options,
+		n.processComponents.ShardCoordinator().SelfId(),
+		n.dataComponents.StorageService(),
+		n.processComponents.HistoryRepository(),
+		n.coreComponents.Uint64ByteSliceConverter(),
+		n.coreComponents.InternalMarshalizer(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", process.ErrMissingHeader, err.Error())
+	}
+
+	storer := n.dataComponents.StorageService().GetStorer(dataRetriever.BlockStateChangesUnit)
+	buff, err := storer.GetFromEpoch(headerHash, header.GetEpoch())
+	if err != nil {
+		return nil, err
+	}
+
+	stateChanges := &state.BlockStateChanges{}
+	err = n.coreComponents.InternalMarshalizer().Unmarshal(stateChanges, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return &common.BlockStateChangesResponse{
+		BlockHash:  hex.EncodeToString(headerHash),
+		BlockNonce: header.GetNonce(),
+		Accounts:   n.prepareAccountStateChanges(stateChanges.Accounts),
+	}, nil
+}
+
+func (n *Node) prepareAccountStateChanges(changes []*state.AccountStateChange) []*common.AccountStateChangeResponse {
+	pubKeyConverter := n.coreComponents.AddressPubKeyConverter()
+	responses := make([]*common.AccountStateChangeResponse, 0, len(changes))
+	for _, change := range changes {
+		responses = append(responses, state.NewAccountStateChangeResponse(change, pubKeyConverter))
+	}
+
+	return responses
+}
@@ -0,0 +1,147 @@
+package node_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/testscommon/dblookupext"
+	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
+	storageStubs "github.com/ElrondNetwork/elrond-go/testscommon/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNode_GetBlockStateChangesByNonce(t *testing.T) {
+	t.Parallel()
+
+	blockHash := []byte("block hash")
+	coreComponents := getDefaultCoreComponents()
+	headerBytes, _ := coreComponents.IntMarsh.Marshal(&block.Header{Nonce: 7, Epoch: 2})
+	stateChangesBytes, _ := coreComponents.IntMarsh.Marshal(&state.BlockStateChanges{
+		Accounts: []*state.AccountStateChange{
+			{
+				Address:    []byte("alice"),
+				OldBalance: big.NewInt(10),
+				NewBalance: big.NewInt(7),
+				OldNonce:   1,
+				NewNonce:   2,
+				DataTrieChanges: []*state.DataTrieChange{
+					{Key: []byte("key"), NewValue: []byte("value")},
+				},
+			},
+		},
+	})
+	dataComponents := getDefaultDataComponents()
+	dataComponents.Store = &mock.ChainStorerStub{
+		GetStorerCalled: func(unitType dataRetriever.UnitType) storage.Storer {
+			return &storageStubs.StorerStub{
+				GetCalled: func(key []byte) ([]byte, error) {
+					// the header is saved in the storer of an old epoch, not in the active ones
+					require.NotEqual(t, dataRetriever.BlockHeaderUnit, unitType)
+					return blockHash, nil
+				},
+				GetFromEpochCalled: func(key []byte, epoch uint32) ([]byte, error) {
+					assert.Equal(t, blockHash, key)
+					assert.Equal(t, uint32(2), epoch)
+					if unitType == dataRetriever.BlockHeaderUnit {
+						return headerBytes, nil
+					}
+
+					assert.Equal(t, dataRetriever.BlockStateChangesUnit, unitType)
+					return stateChangesBytes, nil
+				},
+			}
+		},
+	}
+	processComponents := getDefaultProcessComponents()
+	processComponents.HistoryRepositoryInternal = &dblookupext.HistoryRepositoryStub{
+		GetEpochByHashCalled: func(hash []byte) (uint32, error) {
+			if bytes.Equal(hash, blockHash) {
+				return 2, nil
+			}
+
+			return 0, errors.New("epoch not found")
+		},
+	}
+
+	createNode := func(collector state.StateChangesCollector) *node.Node {
+		stateComponents := getDefaultStateComponents()
+		stateComponents.StateChanges = collector
+		n, _ := node.NewNode(
+			node.WithCoreComponents(coreComponents),
+			node.WithDataComponents(dataComponents),
+			node.WithStateComponents(stateComponents),
+			node.WithProcessComponents(processComponents),
+		)
+
+		return n
+	}
+	enabledCollector := &stateMock.StateChangesCollectorStub{
+		IsEnabledCalled: func() bool {
+			return true
+		},
+	}
+
+	t.Run("state changes not enabled should error", func(t *testing.T) {
+		t.Parallel()
+
+		response, err := createNode(&stateMock.StateChangesCollectorStub{}).GetBlockStateChangesByNonce(7)
+		assert.Nil(t, response)
+		assert.Equal(t, node.ErrStateChangesNotEnabled, err)
+	})
+	t.Run("missing header should error", func(t *testing.T) {
+		t.Parallel()
+
+		dataComponentsMissingHeader := getDefaultDataComponents()
+		dataComponentsMissingHeader.Store = &mock.ChainStorerStub{
+			GetStorerCalled: func(_ dataRetriever.UnitType) storage.Storer {
+				return &storageStubs.StorerStub{
+					GetCalled: func(_ []byte) ([]byte, error) {
+						return nil, errors.New("key not found")
+					},
+				}
+			},
+		}
+		stateComponents := getDefaultStateComponents()
+		stateComponents.StateChanges = enabledCollector
+		n, _ := node.NewNode(
+			node.WithCoreComponents(coreComponents),
+			node.WithDataComponents(dataComponentsMissingHeader),
+			node.WithStateComponents(stateComponents),
+			node.WithProcessComponents(getDefaultProcessComponents()),
+		)
+
+		response, err := n.GetBlockStateChangesByNonce(7)
+		assert.Nil(t, response)
+		assert.True(t, errors.Is(err, process.ErrMissingHeader))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		response, err := createNode(enabledCollector).GetBlockStateChangesByNonce(7)
+		require.Nil(t, err)
+		assert.Equal(t, hex.EncodeToString(blockHash), response.BlockHash)
+		assert.Equal(t, uint64(7), response.BlockNonce)
+		require.Len(t, response.Accounts, 1)
+
+		accountChange := response.Accounts[0]
+		assert.Equal(t, coreComponents.AddrPubKeyConv.Encode([]byte("alice")), accountChange.Address)
+		assert.Equal(t, "10", accountChange.OldBalance)
+		assert.Equal(t, "7", accountChange.NewBalance)
+		assert.Equal(t, uint64(2), accountChange.NewNonce)
+		require.Len(t, accountChange.DataTrieChanges, 1)
+		assert.Equal(t, hex.EncodeToString([]byte("key")), accountChange.DataTrieChanges[0].Key)
+		assert.Equal(t, "", accountChange.DataTrieChanges[0].OldValue)
+		assert.Equal(t, hex.EncodeToString([]byte("value")), accountChange.DataTrieChanges[0].NewValue)
+	})
+}
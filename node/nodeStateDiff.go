@@ -0,0 +1,111 @@
+package node
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/state"
+)
+
+const (
+	maxNumAccountsInStateDiff = 1000
+	stateDiffCursorSeparator  = "-"
+)
+
+// GetStateDiff returns the accounts changed between the two provided accounts trie root hashes, starting after the
+// provided cursor, if any. At most maxNumAccountsInStateDiff accounts and maxDataTrieChangesInDiff data trie changes
+// are returned: if the diff holds more, the HasMore flag is set and the response cursor resumes the diff
+func (n *Node) GetStateDiff(fromRootHash string, toRootHash string, cursor string) (*common.StateDiffResponse, error) {
+	fromRootHashBytes, err := hex.DecodeString(fromRootHash)
+	if err != nil {
+		return nil, err
+	}
+	toRootHashBytes, err := hex.DecodeString(toRootHash)
+	if err != nil {
+		return nil, err
+	}
+	startAfter, err := decodeStateDiffCursor(cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	tr, err := n.stateComponents.AccountsAdapterAPI().GetTrie(toRootHashBytes)
+	if err != nil {
+		return nil, err
+	}
+	differ, err := state.NewAccountsDiffer(tr, n.coreComponents.InternalMarshalizer())
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]*state.AccountStateChange, 0)
+	nextCursor, err := differ.GetAccountsDiff(
+		fromRootHashBytes,
+		toRootHashBytes,
+		startAfter,
+		int(n.maxDataTrieChangesInDiff),
+		func(change *state.AccountStateChange) bool {
+			if len(changes) == maxNumAccountsInStateDiff {
+				return false
+			}
+
+			changes = append(changes, change)
+			return true
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &common.StateDiffResponse{
+		FromRootHash: fromRootHash,
+		ToRootHash:   toRootHash,
+		Accounts:     n.prepareAccountStateChanges(changes),
+		HasMore:      nextCursor != nil,
+		Cursor:       encodeStateDiffCursor(nextCursor),
+	}, nil
+}
+
+// encodeStateDiffCursor returns the hex encoded address of the cursor, followed by the hex encoded data trie key
+// if the account data trie changes were not all returned
+func encodeStateDiffCursor(cursor *state.AccountsDiffCursor) string {
+	if cursor == nil {
+		return ""
+	}
+
+	encodedCursor := hex.EncodeToString(cursor.Address)
+	if len(cursor.DataTrieKey) > 0 {
+		encodedCursor += stateDiffCursorSeparator + hex.EncodeToString(cursor.DataTrieKey)
+	}
+
+	return encodedCursor
+}
+
+func decodeStateDiffCursor(encodedCursor string) (*state.AccountsDiffCursor, error) {
+	if len(encodedCursor) == 0 {
+		return nil, nil
+	}
+
+	parts := strings.Split(encodedCursor, stateDiffCursorSeparator)
+	if len(parts) > 2 {
+		return nil, ErrInvalidStateDiffCursor
+	}
+
+	address, err := hex.DecodeString(parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidStateDiffCursor, err.Error())
+	}
+	cursor := &state.AccountsDiffCursor{Address: address}
+	if len(parts) == 1 {
+		return cursor, nil
+	}
+
+	cursor.DataTrieKey, err = hex.DecodeString(parts[1])
+	if err != nil || len(cursor.DataTrieKey) == 0 {
+		return nil, ErrInvalidStateDiffCursor
+	}
+
+	return cursor, nil
+}
@@ -0,0 +1,137 @@
+package node_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/state"
+	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
+	trieMock "github.com/ElrondNetwork/elrond-go/testscommon/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createNodeForStateDiff(tr common.Trie, getTrieErr error) *node.Node {
+	stateComponents := getDefaultStateComponents()
+	stateComponents.AccountsAPI = &stateMock.AccountsStub{
+		GetTrieCalled: func(_ []byte) (common.Trie, error) {
+			return tr, getTrieErr
+		},
+	}
+	n, _ := node.NewNode(
+		node.WithCoreComponents(getDefaultCoreComponents()),
+		node.WithStateComponents(stateComponents),
+	)
+
+	return n
+}
+
+func TestNode_GetStateDiff(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid root hashes should error", func(t *testing.T) {
+		t.Parallel()
+
+		n := createNodeForStateDiff(&trieMock.TrieStub{}, nil)
+		response, err := n.GetStateDiff("not hex", "00", "")
+		assert.Nil(t, response)
+		assert.NotNil(t, err)
+
+		response, err = n.GetStateDiff("00", "not hex", "")
+		assert.Nil(t, response)
+		assert.NotNil(t, err)
+	})
+	t.Run("invalid cursor should error", func(t *testing.T) {
+		t.Parallel()
+
+		n := createNodeForStateDiff(&trieMock.TrieStub{}, nil)
+		for _, cursor := range []string{"not hex", "aa-not hex", "aa-", "aa-bb-cc"} {
+			response, err := n.GetStateDiff("00", "01", cursor)
+			assert.Nil(t, response)
+			assert.True(t, errors.Is(err, node.ErrInvalidStateDiffCursor))
+		}
+	})
+	t.Run("missing trie should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("missing trie")
+		n := createNodeForStateDiff(nil, expectedErr)
+		response, err := n.GetStateDiff("00", "01", "")
+		assert.Nil(t, response)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		marshalizer := getDefaultCoreComponents().IntMarsh
+		oldAccount, _ := state.NewUserAccount([]byte("alice"))
+		_ = oldAccount.AddToBalance(big.NewInt(10))
+		oldAccountBytes, _ := marshalizer.Marshal(oldAccount)
+		newAccount, _ := state.NewUserAccount([]byte("alice"))
+		_ = newAccount.AddToBalance(big.NewInt(7))
+		newAccount.IncreaseNonce(1)
+		newAccountBytes, _ := marshalizer.Marshal(newAccount)
+
+		tr := &trieMock.TrieStub{
+			GetLeavesDiffCalled: func(fromRootHash []byte, toRootHash []byte, _ []byte, handler func(diff common.TrieLeafDiff) bool) error {
+				assert.Equal(t, []byte{0xaa}, fromRootHash)
+				assert.Equal(t, []byte{0xbb}, toRootHash)
+				handler(common.TrieLeafDiff{Key: []byte("alice"), OldValue: oldAccountBytes, NewValue: newAccountBytes})
+				return nil
+			},
+		}
+		n := createNodeForStateDiff(tr, nil)
+
+		response, err := n.GetStateDiff("aa", "bb", "")
+		require.Nil(t, err)
+		assert.Equal(t, "aa", response.FromRootHash)
+		assert.Equal(t, "bb", response.ToRootHash)
+		assert.False(t, response.HasMore)
+		assert.Empty(t, response.Cursor)
+		require.Len(t, response.Accounts, 1)
+		assert.Equal(t, hex.EncodeToString([]byte("alice")), response.Accounts[0].Address)
+		assert.Equal(t, "10", response.Accounts[0].OldBalance)
+		assert.Equal(t, "7", response.Accounts[0].NewBalance)
+		assert.Equal(t, uint64(0), response.Accounts[0].OldNonce)
+		assert.Equal(t, uint64(1), response.Accounts[0].NewNonce)
+	})
+	t.Run("too many accounts should set the has more flag", func(t *testing.T) {
+		t.Parallel()
+
+		marshalizer := getDefaultCoreComponents().IntMarsh
+		tr := &trieMock.TrieStub{
+			GetLeavesDiffCalled: func(_ []byte, _ []byte, startKey []byte, handler func(diff common.TrieLeafDiff) bool) error {
+				for i := 0; ; i++ {
+					address := []byte(fmt.Sprintf("address%d", i))
+					if i == 0 && len(startKey) > 0 {
+						assert.Equal(t, []byte("address999"), startKey)
+						address = startKey
+					}
+					account, _ := state.NewUserAccount(address)
+					accountBytes, _ := marshalizer.Marshal(account)
+					if !handler(common.TrieLeafDiff{Key: address, NewValue: accountBytes}) {
+						return nil
+					}
+				}
+			},
+		}
+		n := createNodeForStateDiff(tr, nil)
+
+		response, err := n.GetStateDiff("aa", "bb", "")
+		require.Nil(t, err)
+		assert.True(t, response.HasMore)
+		assert.Len(t, response.Accounts, 1000)
+		assert.Equal(t, hex.EncodeToString([]byte("address999")), response.Cursor)
+
+		response, err = n.GetStateDiff("aa", "bb", response.Cursor)
+		require.Nil(t, err)
+		assert.True(t, response.HasMore)
+		require.Len(t, response.Accounts, 1000)
+		assert.Equal(t, hex.EncodeToString([]byte("address1")), response.Accounts[0].Address)
+	})
+}
@@ -410,6 +410,8 @@ func getDefaultStateComponents() *testscommon.StateComponentsMock {
 		AccountsAPI:     &stateMock.AccountsStub{},
 		Tries:           &mock.TriesHolderStub{},
 		StorageManagers: map[string]common.StorageManager{"0": &testscommon.StorageManagerStub{}},
+		StateChanges:    &stateMock.StateChangesCollectorStub{},
+		BlockWitness:    &stateMock.BlockWitnessHandlerStub{},
 	}
 }
 
@@ -0,0 +1,140 @@
+package node
+
+import (
+	"encoding/hex"
+
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/storage/txcache"
+)
+
+// GetTransactionsPoolCounters returns the counters of the transactions pool, both aggregated and for each internal cache
+func (n *Node) GetTransactionsPoolCounters() (*common.TxPoolCountersResponse, error) {
+	inspector, err := n.getTxPoolInspector()
+	if err != nil {
+		return nil, err
+	}
+
+	inspections := inspector.InspectCaches()
+	response := &common.TxPoolCountersResponse{
+		Caches: make([]*common.TxPoolCacheCounters, 0, len(inspections)),
+	}
+	for _, inspection := range inspections {
+		response.NumTxs += inspection.NumTxs
+		response.NumSenders += inspection.NumSenders
+		response.NumBytes += inspection.NumBytes
+		response.Caches = append(response.Caches, &common.TxPoolCacheCounters{
+			Name:                 inspection.Name,
+			NumTxs:               inspection.NumTxs,
+			NumSenders:           inspection.NumSenders,
+			NumBytes:             inspection.NumBytes,
+			IsEvictionEnabled:    inspection.IsEvictionEnabled,
+			IsEvictionInProgress: inspection.IsEvictionInProgress,
+			IsCapacityExceeded:   inspection.IsCapacityExceeded,
+		})
+	}
+
+	return response, nil
+}
+
+// GetTransactionsPoolForSender returns the transactions of a sender, as found in the transactions pool, along with
+// the nonce gaps, the score and the eviction status of the sender
+func (n *Node) GetTransactionsPoolForSender(sender string) (*common.TxPoolSenderResponse, error) {
+	inspector, err := n.getTxPoolInspector()
+	if err != nil {
+		return nil, err
+	}
+
+	senderBytes, err := n.coreComponents.AddressPubKeyConverter().Decode(sender)
+	if err != nil {
+		return nil, err
+	}
+
+	inspections := inspector.InspectSender(senderBytes)
+	response := &common.TxPoolSenderResponse{
+		Sender: sender,
+		Caches: make([]*common.TxPoolSenderCache, 0, len(inspections)),
+	}
+	for _, inspection := range inspections {
+		senderCache := &common.TxPoolSenderCache{
+			Cache:               inspection.CacheName,
+			AccountNonce:        inspection.AccountNonce,
+			IsAccountNonceKnown: inspection.IsAccountNonceKnown,
+			NonceGaps:           make([]*common.TxPoolNonceGap, 0, len(inspection.NonceGaps)),
+			Score:               inspection.Score,
+			TotalBytes:          inspection.TotalBytes,
+			TotalGas:            inspection.TotalGas,
+			NumFailedSelections: inspection.NumFailedSelections,
+			IsInGracePeriod:     inspection.IsInGracePeriod,
+			IsSweepable:         inspection.IsSweepable,
+			IsCapacityExceeded:  inspection.IsCapacityExceeded,
+			Transactions:        make([]*common.TxPoolTransaction, 0, len(inspection.Transactions)),
+		}
+		for _, gap := range inspection.NonceGaps {
+			senderCache.NonceGaps = append(senderCache.NonceGaps, &common.TxPoolNonceGap{
+				FromNonce: gap.FromNonce,
+				ToNonce:   gap.ToNonce,
+			})
+		}
+		for _, wrappedTx := range inspection.Transactions {
+			senderCache.Transactions = append(senderCache.Transactions, n.prepareTxPoolTransaction(wrappedTx, ""))
+		}
+
+		response.Caches = append(response.Caches, senderCache)
+	}
+
+	return response, nil
+}
+
+// GetTransactionFromPool returns the transaction with the given hash, if found in the transactions pool
+func (n *Node) GetTransactionFromPool(hash string) (*common.TxPoolTransaction, error) {
+	inspector, err := n.getTxPoolInspector()
+	if err != nil {
+		return nil, err
+	}
+
+	hashBytes, err := hex.DecodeString(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	wrappedTx, cacheID, found := inspector.InspectTransaction(hashBytes)
+	if !found {
+		return nil, ErrTransactionNotFound
+	}
+
+	return n.prepareTxPoolTransaction(wrappedTx, cacheID), nil
+}
+
+func (n *Node) getTxPoolInspector() (TxPoolInspector, error) {
+	inspector, ok := n.dataComponents.Datapool().Transactions().(TxPoolInspector)
+	if !ok {
+		return nil, ErrTxPoolInspectionNotSupported
+	}
+
+	return inspector, nil
+}
+
+func (n *Node) prepareTxPoolTransaction(wrappedTx *txcache.WrappedTransaction, cacheID string) *common.TxPoolTransaction {
+	tx := wrappedTx.Tx
+	pubKeyConverter := n.coreComponents.AddressPubKeyConverter()
+
+	value := "0"
+	if tx.GetValue() != nil {
+		value = tx.GetValue().String()
+	}
+
+	return &common.TxPoolTransaction{
+		Hash:          hex.EncodeToString(wrappedTx.TxHash),
+		Cache:         cacheID,
+		Nonce:         tx.GetNonce(),
+		Sender:        pubKeyConverter.Encode(tx.GetSndAddr()),
+		Receiver:      pubKeyConverter.Encode(tx.GetRcvAddr()),
+		Value:         value,
+		GasPrice:      tx.GetGasPrice(),
+		GasLimit:      tx.GetGasLimit(),
+		Data:          tx.GetData(),
+		SenderShard:   wrappedTx.SenderShardID,
+		ReceiverShard: wrappedTx.ReceiverShardID,
+		Size:          wrappedTx.Size,
+	}
+}
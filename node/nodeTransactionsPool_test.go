@@ -0,0 +1,69 @@
+package node_test
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	dataRetrieverMock "github.com/ElrondNetwork/elrond-go/testscommon/dataRetriever"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNode_TransactionsPoolInspectionNotSupportedShouldErr(t *testing.T) {
+	t.Parallel()
+
+	dataComponents := getDefaultDataComponents()
+	dataComponents.DataPool = &dataRetrieverMock.PoolsHolderStub{
+		TransactionsCalled: func() dataRetriever.ShardedDataCacherNotifier {
+			return testscommon.NewShardedDataStub()
+		},
+	}
+	n, _ := node.NewNode(node.WithDataComponents(dataComponents))
+
+	response, err := n.GetTransactionsPoolCounters()
+	require.Equal(t, node.ErrTxPoolInspectionNotSupported, err)
+	require.Nil(t, response)
+}
+
+func TestNode_GetTransactionsPool(t *testing.T) {
+	t.Parallel()
+
+	n, _, dataPool, _ := createNode(t, 0, false)
+
+	alice := []byte("alice")
+	bob := []byte("bob")
+	dataPool.Transactions().AddData([]byte("tx-alice-1"), &transaction.Transaction{SndAddr: alice, RcvAddr: bob, Nonce: 1, Value: big.NewInt(10)}, 100, "0")
+	dataPool.Transactions().AddData([]byte("tx-alice-4"), &transaction.Transaction{SndAddr: alice, RcvAddr: bob, Nonce: 4, Value: big.NewInt(10)}, 100, "0_1")
+	dataPool.Transactions().AddData([]byte("tx-bob-7"), &transaction.Transaction{SndAddr: bob, RcvAddr: alice, Nonce: 7}, 100, "0")
+
+	counters, err := n.GetTransactionsPoolCounters()
+	require.Nil(t, err)
+	require.Equal(t, uint64(3), counters.NumTxs)
+	require.Equal(t, uint64(2), counters.NumSenders)
+	require.Len(t, counters.Caches, 1)
+	require.Equal(t, "0", counters.Caches[0].Name)
+
+	senderPool, err := n.GetTransactionsPoolForSender(hex.EncodeToString(alice))
+	require.Nil(t, err)
+	require.Len(t, senderPool.Caches, 1)
+	require.Len(t, senderPool.Caches[0].Transactions, 2)
+	require.Equal(t, hex.EncodeToString([]byte("tx-alice-1")), senderPool.Caches[0].Transactions[0].Hash)
+	require.Equal(t, "10", senderPool.Caches[0].Transactions[0].Value)
+	require.Len(t, senderPool.Caches[0].NonceGaps, 1)
+	require.Equal(t, uint64(2), senderPool.Caches[0].NonceGaps[0].FromNonce)
+	require.Equal(t, uint64(3), senderPool.Caches[0].NonceGaps[0].ToNonce)
+
+	tx, err := n.GetTransactionFromPool(hex.EncodeToString([]byte("tx-bob-7")))
+	require.Nil(t, err)
+	require.Equal(t, "0", tx.Cache)
+	require.Equal(t, uint64(7), tx.Nonce)
+	require.Equal(t, hex.EncodeToString(bob), tx.Sender)
+
+	tx, err = n.GetTransactionFromPool(hex.EncodeToString([]byte("missing")))
+	require.Equal(t, node.ErrTransactionNotFound, err)
+	require.Nil(t, tx)
+}
@@ -38,6 +38,8 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/state"
 	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/ElrondNetwork/elrond-go/storage/statistics"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
 	dataRetrieverMock "github.com/ElrondNetwork/elrond-go/testscommon/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/testscommon/dblookupext"
@@ -45,6 +47,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/testscommon/p2pmocks"
 	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
 	statusHandlerMock "github.com/ElrondNetwork/elrond-go/testscommon/statusHandler"
+	storageStubs "github.com/ElrondNetwork/elrond-go/testscommon/storage"
 	trieMock "github.com/ElrondNetwork/elrond-go/testscommon/trie"
 	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
@@ -130,7 +133,7 @@ func TestGetBalance_NoAddrConverterShouldError(t *testing.T) {
 		node.WithCoreComponents(coreComponents),
 		node.WithStateComponents(stateComponents),
 	)
-	_, err := n.GetBalance("address")
+	_, err := n.GetBalance("address", common.AccountQueryOptions{})
 	assert.NotNil(t, err)
 	assert.Equal(t, "initialize AccountsAdapterAPI, PubkeyConverter first", err.Error())
 }
@@ -145,7 +148,7 @@ func TestGetBalance_NoAccAdapterShouldError(t *testing.T) {
 	n, _ := node.NewNode(
 		node.WithCoreComponents(coreComponents),
 	)
-	_, err := n.GetBalance("address")
+	_, err := n.GetBalance("address", common.AccountQueryOptions{})
 	assert.NotNil(t, err)
 	assert.Equal(t, "initialize AccountsAdapterAPI, PubkeyConverter first", err.Error())
 }
@@ -175,7 +178,7 @@ func TestGetBalance_GetAccountFailsShouldError(t *testing.T) {
 		node.WithCoreComponents(coreComponents),
 		node.WithStateComponents(stateComponents),
 	)
-	_, err := n.GetBalance(createDummyHexAddress(64))
+	_, err := n.GetBalance(createDummyHexAddress(64), common.AccountQueryOptions{})
 	assert.Equal(t, expectedErr, err)
 }
 
@@ -214,7 +217,7 @@ func TestGetBalance_GetAccountReturnsNil(t *testing.T) {
 		node.WithCoreComponents(coreComponents),
 		node.WithStateComponents(stateComponents),
 	)
-	balance, err := n.GetBalance(createDummyHexAddress(64))
+	balance, err := n.GetBalance(createDummyHexAddress(64), common.AccountQueryOptions{})
 	assert.Nil(t, err)
 	assert.Equal(t, big.NewInt(0), balance)
 }
@@ -237,7 +240,7 @@ func TestGetBalance(t *testing.T) {
 		node.WithCoreComponents(coreComponents),
 		node.WithStateComponents(stateComponents),
 	)
-	balance, err := n.GetBalance(createDummyHexAddress(64))
+	balance, err := n.GetBalance(createDummyHexAddress(64), common.AccountQueryOptions{})
 	assert.Nil(t, err)
 	assert.Equal(t, big.NewInt(100), balance)
 }
@@ -271,7 +274,7 @@ func TestGetUsername(t *testing.T) {
 		node.WithCoreComponents(coreComponents),
 		node.WithStateComponents(stateComponents),
 	)
-	username, err := n.GetUsername(createDummyHexAddress(64))
+	username, err := n.GetUsername(createDummyHexAddress(64), common.AccountQueryOptions{})
 	assert.Nil(t, err)
 	assert.Equal(t, string(expectedUsername), username)
 }
@@ -329,7 +332,7 @@ func TestNode_GetKeyValuePairs(t *testing.T) {
 		node.WithDataComponents(dataComponents),
 	)
 
-	pairs, err := n.GetKeyValuePairs(createDummyHexAddress(64))
+	pairs, err := n.GetKeyValuePairs(createDummyHexAddress(64), common.AccountQueryOptions{})
 	assert.Nil(t, err)
 	resV1, ok := pairs[hex.EncodeToString(k1)]
 	assert.True(t, ok)
@@ -370,7 +373,7 @@ func TestNode_GetValueForKey(t *testing.T) {
 		node.WithStateComponents(stateComponents),
 	)
 
-	value, err := n.GetValueForKey(createDummyHexAddress(64), hex.EncodeToString(k1))
+	value, err := n.GetValueForKey(createDummyHexAddress(64), hex.EncodeToString(k1), common.AccountQueryOptions{})
 	assert.NoError(t, err)
 	assert.Equal(t, hex.EncodeToString(v1), value)
 }
@@ -410,7 +413,7 @@ func TestNode_GetESDTData(t *testing.T) {
 		node.WithESDTNFTStorageHandler(esdtStorageStub),
 	)
 
-	esdtTokenData, err := n.GetESDTData(createDummyHexAddress(64), esdtToken, 0)
+	esdtTokenData, err := n.GetESDTData(createDummyHexAddress(64), esdtToken, 0, common.AccountQueryOptions{})
 	assert.Nil(t, err)
 	assert.Equal(t, esdtData.Value.String(), esdtTokenData.Value.String())
 }
@@ -445,7 +448,7 @@ func TestNode_GetESDTDataForNFT(t *testing.T) {
 		node.WithESDTNFTStorageHandler(esdtStorageStub),
 	)
 
-	esdtTokenData, err := n.GetESDTData(createDummyHexAddress(64), esdtToken, uint64(nonce))
+	esdtTokenData, err := n.GetESDTData(createDummyHexAddress(64), esdtToken, uint64(nonce), common.AccountQueryOptions{})
 	assert.Nil(t, err)
 	assert.Equal(t, esdtData.Value.String(), esdtTokenData.Value.String())
 }
@@ -509,7 +512,7 @@ func TestNode_GetAllESDTTokens(t *testing.T) {
 		node.WithESDTNFTStorageHandler(esdtStorageStub),
 	)
 
-	value, err := n.GetAllESDTTokens(hexAddress)
+	value, err := n.GetAllESDTTokens(hexAddress, common.AccountQueryOptions{})
 	assert.Nil(t, err)
 	assert.Equal(t, 1, len(value))
 	assert.Equal(t, esdtData, value[esdtToken])
@@ -596,7 +599,7 @@ func TestNode_GetAllESDTTokensShouldReturnEsdtAndFormattedNft(t *testing.T) {
 		node.WithESDTNFTStorageHandler(esdtStorageStub),
 	)
 
-	tokens, err := n.GetAllESDTTokens(hexAddress)
+	tokens, err := n.GetAllESDTTokens(hexAddress, common.AccountQueryOptions{})
 	assert.Nil(t, err)
 	assert.Equal(t, 2, len(tokens))
 	assert.Equal(t, esdtData, tokens[esdtToken])
@@ -758,17 +761,17 @@ func TestNode_GetESDTsWithRole(t *testing.T) {
 		node.WithProcessComponents(processComponents),
 	)
 
-	tokenResult, err := n.GetESDTsWithRole(hex.EncodeToString(addrBytes), core.ESDTRoleNFTAddQuantity)
+	tokenResult, err := n.GetESDTsWithRole(hex.EncodeToString(addrBytes), core.ESDTRoleNFTAddQuantity, common.AccountQueryOptions{})
 	require.NoError(t, err)
 	require.Equal(t, 1, len(tokenResult))
 	require.Equal(t, string(esdtToken), tokenResult[0])
 
-	tokenResult, err = n.GetESDTsWithRole(hex.EncodeToString(addrBytes), core.ESDTRoleLocalMint)
+	tokenResult, err = n.GetESDTsWithRole(hex.EncodeToString(addrBytes), core.ESDTRoleLocalMint, common.AccountQueryOptions{})
 	require.NoError(t, err)
 	require.Equal(t, 1, len(tokenResult))
 	require.Equal(t, string(esdtToken), tokenResult[0])
 
-	tokenResult, err = n.GetESDTsWithRole(hex.EncodeToString(addrBytes), core.ESDTRoleNFTCreate)
+	tokenResult, err = n.GetESDTsWithRole(hex.EncodeToString(addrBytes), core.ESDTRoleNFTCreate, common.AccountQueryOptions{})
 	require.NoError(t, err)
 	require.Len(t, tokenResult, 0)
 }
@@ -833,7 +836,7 @@ func TestNode_GetESDTsRoles(t *testing.T) {
 		node.WithProcessComponents(processComponents),
 	)
 
-	tokenResult, err := n.GetESDTsRoles(hex.EncodeToString(addrBytes))
+	tokenResult, err := n.GetESDTsRoles(hex.EncodeToString(addrBytes), common.AccountQueryOptions{})
 	require.NoError(t, err)
 	require.Equal(t, map[string][]string{
 		string(esdtToken): {core.ESDTRoleNFTAddQuantity, core.ESDTRoleLocalMint},
@@ -894,7 +897,7 @@ func TestNode_GetNFTTokenIDsRegisteredByAddress(t *testing.T) {
 		node.WithProcessComponents(processComponents),
 	)
 
-	tokenResult, err := n.GetNFTTokenIDsRegisteredByAddress(hex.EncodeToString(addrBytes))
+	tokenResult, err := n.GetNFTTokenIDsRegisteredByAddress(hex.EncodeToString(addrBytes), common.AccountQueryOptions{})
 	require.NoError(t, err)
 	require.Equal(t, 1, len(tokenResult))
 	require.Equal(t, string(esdtToken), tokenResult[0])
@@ -2519,7 +2522,7 @@ func TestNode_GetAccountWithNilAccountsAdapterShouldErr(t *testing.T) {
 	)
 
 	stateComponents.AccountsAPI = nil
-	recovAccnt, err := n.GetAccount(createDummyHexAddress(64))
+	recovAccnt, err := n.GetAccount(createDummyHexAddress(64), common.AccountQueryOptions{})
 
 	assert.Empty(t, recovAccnt)
 	assert.Equal(t, node.ErrNilAccountsAdapter, err)
@@ -2543,7 +2546,7 @@ func TestNode_GetAccountWithNilPubkeyConverterShouldErr(t *testing.T) {
 	)
 
 	coreComponents.AddrPubKeyConv = nil
-	recovAccnt, err := n.GetAccount(createDummyHexAddress(64))
+	recovAccnt, err := n.GetAccount(createDummyHexAddress(64), common.AccountQueryOptions{})
 
 	assert.Empty(t, recovAccnt)
 	assert.Equal(t, node.ErrNilPubkeyConverter, err)
@@ -2573,7 +2576,7 @@ func TestNode_GetAccountPubkeyConverterFailsShouldErr(t *testing.T) {
 		node.WithCoreComponents(coreComponents),
 	)
 
-	recovAccnt, err := n.GetAccount(createDummyHexAddress(64))
+	recovAccnt, err := n.GetAccount(createDummyHexAddress(64), common.AccountQueryOptions{})
 
 	assert.Empty(t, recovAccnt)
 	assert.Equal(t, errExpected, err)
@@ -2597,7 +2600,7 @@ func TestNode_GetAccountAccountDoesNotExistsShouldRetEmpty(t *testing.T) {
 		node.WithStateComponents(stateComponents),
 	)
 
-	recovAccnt, err := n.GetAccount(createDummyHexAddress(64))
+	recovAccnt, err := n.GetAccount(createDummyHexAddress(64), common.AccountQueryOptions{})
 
 	assert.Nil(t, err)
 	assert.Equal(t, uint64(0), recovAccnt.Nonce)
@@ -2626,7 +2629,7 @@ func TestNode_GetAccountAccountsAdapterFailsShouldErr(t *testing.T) {
 		node.WithStateComponents(stateComponents),
 	)
 
-	recovAccnt, err := n.GetAccount(createDummyHexAddress(64))
+	recovAccnt, err := n.GetAccount(createDummyHexAddress(64), common.AccountQueryOptions{})
 
 	assert.Empty(t, recovAccnt)
 	assert.NotNil(t, err)
@@ -2660,7 +2663,7 @@ func TestNode_GetAccountAccountExistsShouldReturn(t *testing.T) {
 		node.WithStateComponents(stateComponents),
 	)
 
-	recovAccnt, err := n.GetAccount(createDummyHexAddress(64))
+	recovAccnt, err := n.GetAccount(createDummyHexAddress(64), common.AccountQueryOptions{})
 
 	assert.Nil(t, err)
 	assert.Equal(t, uint64(2), recovAccnt.Nonce)
@@ -2671,6 +2674,164 @@ func TestNode_GetAccountAccountExistsShouldReturn(t *testing.T) {
 	assert.Equal(t, hex.EncodeToString([]byte("owner address")), recovAccnt.OwnerAddress)
 }
 
+func TestNode_GetAccountHistoricalState(t *testing.T) {
+	t.Parallel()
+
+	blockHash := []byte("block hash")
+	historicalRootHash := []byte("historical root hash")
+	coreComponents := getDefaultCoreComponents()
+	coreComponents.AddrPubKeyConv = createMockPubkeyConverter()
+	headerBytes, _ := coreComponents.IntMarsh.Marshal(&block.Header{Nonce: 7, RootHash: historicalRootHash})
+	dataComponents := getDefaultDataComponents()
+	dataComponents.Store = &mock.ChainStorerStub{
+		GetStorerCalled: func(unitType dataRetriever.UnitType) storage.Storer {
+			return &storageStubs.StorerStub{
+				GetCalled: func(key []byte) ([]byte, error) {
+					if unitType == dataRetriever.BlockHeaderUnit && bytes.Equal(key, blockHash) {
+						return headerBytes, nil
+					}
+
+					return nil, errors.New("key not found")
+				},
+			}
+		},
+	}
+	options := common.AccountQueryOptions{BlockHash: blockHash}
+
+	createNode := func(accDB state.AccountsAdapter) *node.Node {
+		stateComponents := getDefaultStateComponents()
+		stateComponents.AccountsAPI = accDB
+		n, _ := node.NewNode(
+			node.WithCoreComponents(coreComponents),
+			node.WithDataComponents(dataComponents),
+			node.WithStateComponents(stateComponents),
+			node.WithProcessComponents(getDefaultProcessComponents()),
+		)
+
+		return n
+	}
+
+	t.Run("account read from the state of the block", func(t *testing.T) {
+		t.Parallel()
+
+		accnt, _ := state.NewUserAccount([]byte("1234"))
+		_ = accnt.AddToBalance(big.NewInt(37))
+		accDB := &stateMock.AccountsStub{
+			GetExistingAccountCalled: func(_ []byte) (vmcommon.AccountHandler, error) {
+				require.Fail(t, "should have not read the current state")
+				return nil, nil
+			},
+			GetTrieCalled: func(rootHash []byte) (common.Trie, error) {
+				assert.Equal(t, historicalRootHash, rootHash)
+				return &trieMock.TrieStub{
+					GetCalled: func(_ []byte) ([]byte, error) {
+						return []byte("account bytes"), nil
+					},
+				}, nil
+			},
+			GetAccountFromBytesCalled: func(_ []byte, accountBytes []byte) (vmcommon.AccountHandler, error) {
+				assert.Equal(t, []byte("account bytes"), accountBytes)
+				return accnt, nil
+			},
+		}
+
+		recovAccnt, err := createNode(accDB).GetAccount(createDummyHexAddress(64), options)
+		assert.Nil(t, err)
+		assert.Equal(t, "37", recovAccnt.Balance)
+	})
+	t.Run("account missing from the state of the block", func(t *testing.T) {
+		t.Parallel()
+
+		accDB := &stateMock.AccountsStub{
+			GetTrieCalled: func(_ []byte) (common.Trie, error) {
+				return &trieMock.TrieStub{
+					GetCalled: func(_ []byte) ([]byte, error) {
+						return nil, nil
+					},
+				}, nil
+			},
+		}
+
+		recovAccnt, err := createNode(accDB).GetAccount(createDummyHexAddress(64), options)
+		assert.Nil(t, err)
+		assert.Equal(t, "0", recovAccnt.Balance)
+	})
+	t.Run("pruned state should error", func(t *testing.T) {
+		t.Parallel()
+
+		accDB := &stateMock.AccountsStub{
+			GetTrieCalled: func(_ []byte) (common.Trie, error) {
+				return nil, errors.New("missing trie node")
+			},
+		}
+
+		balance, err := createNode(accDB).GetBalance(createDummyHexAddress(64), options)
+		assert.Nil(t, balance)
+		assert.True(t, errors.Is(err, state.ErrStateNotAvailable))
+	})
+	t.Run("account read from the state of a block saved in an old epoch", func(t *testing.T) {
+		t.Parallel()
+
+		oldEpochDataComponents := getDefaultDataComponents()
+		oldEpochDataComponents.Store = &mock.ChainStorerStub{
+			GetStorerCalled: func(unitType dataRetriever.UnitType) storage.Storer {
+				return &storageStubs.StorerStub{
+					GetCalled: func(_ []byte) ([]byte, error) {
+						return nil, errors.New("key not found in the active epochs")
+					},
+					GetFromEpochCalled: func(key []byte, epoch uint32) ([]byte, error) {
+						if unitType == dataRetriever.BlockHeaderUnit && bytes.Equal(key, blockHash) && epoch == 1 {
+							return headerBytes, nil
+						}
+
+						return nil, errors.New("key not found")
+					},
+				}
+			},
+		}
+		processComponents := getDefaultProcessComponents()
+		processComponents.HistoryRepositoryInternal = &dblookupext.HistoryRepositoryStub{
+			GetEpochByHashCalled: func(_ []byte) (uint32, error) {
+				return 1, nil
+			},
+		}
+		accnt, _ := state.NewUserAccount([]byte("1234"))
+		_ = accnt.AddToBalance(big.NewInt(37))
+		stateComponents := getDefaultStateComponents()
+		stateComponents.AccountsAPI = &stateMock.AccountsStub{
+			GetTrieCalled: func(rootHash []byte) (common.Trie, error) {
+				assert.Equal(t, historicalRootHash, rootHash)
+				return &trieMock.TrieStub{
+					GetCalled: func(_ []byte) ([]byte, error) {
+						return []byte("account bytes"), nil
+					},
+				}, nil
+			},
+			GetAccountFromBytesCalled: func(_ []byte, _ []byte) (vmcommon.AccountHandler, error) {
+				return accnt, nil
+			},
+		}
+		n, _ := node.NewNode(
+			node.WithCoreComponents(coreComponents),
+			node.WithDataComponents(oldEpochDataComponents),
+			node.WithStateComponents(stateComponents),
+			node.WithProcessComponents(processComponents),
+		)
+
+		recovAccnt, err := n.GetAccount(createDummyHexAddress(64), options)
+		assert.Nil(t, err)
+		assert.Equal(t, "37", recovAccnt.Balance)
+	})
+	t.Run("unknown block should error", func(t *testing.T) {
+		t.Parallel()
+
+		unknownBlockOptions := common.AccountQueryOptions{BlockHash: []byte("unknown block hash")}
+		balance, err := createNode(&stateMock.AccountsStub{}).GetBalance(createDummyHexAddress(64), unknownBlockOptions)
+		assert.Nil(t, balance)
+		assert.True(t, errors.Is(err, process.ErrMissingHeader))
+	})
+}
+
 func TestNode_AppStatusHandlersShouldIncrement(t *testing.T) {
 	t.Parallel()
 
@@ -3115,6 +3276,40 @@ func TestNode_GetQueryHandlerShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestNode_GetStorageStatisticsNilCollectorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithDataComponents(getDefaultDataComponents()),
+	)
+
+	unitsStatistics, err := n.GetStorageStatistics(false)
+
+	assert.Nil(t, unitsStatistics)
+	assert.Equal(t, node.ErrNilStorageStatistics, err)
+}
+
+func TestNode_GetStorageStatisticsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	storageStatistics := statistics.NewStorageStatistics()
+	persister := storageStatistics.WrapPersister(memorydb.New(), "TransactionUnit", "0", "Epoch_0/Shard_0/Transactions")
+	_ = persister.Put([]byte("key"), []byte("value"))
+
+	dataComponents := getDefaultDataComponents()
+	dataComponents.StorageStats = storageStatistics
+	n, _ := node.NewNode(
+		node.WithDataComponents(dataComponents),
+	)
+
+	unitsStatistics, err := n.GetStorageStatistics(false)
+
+	assert.Nil(t, err)
+	require.Equal(t, 1, len(unitsStatistics))
+	assert.Equal(t, "TransactionUnit", unitsStatistics[0].Unit)
+	assert.Equal(t, uint64(1), unitsStatistics[0].NumPuts)
+}
+
 func TestNode_GetPeerInfoUnknownPeerShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -3280,7 +3475,7 @@ func TestGetKeyValuePairs_CannotDecodeAddress(t *testing.T) {
 		node.WithCoreComponents(coreComponents),
 	)
 
-	res, err := n.GetKeyValuePairs("addr")
+	res, err := n.GetKeyValuePairs("addr", common.AccountQueryOptions{})
 	require.Nil(t, res)
 	require.True(t, strings.Contains(fmt.Sprintf("%v", err), expectedErr.Error()))
 }
@@ -3555,6 +3750,113 @@ func TestNode_GetProofDataTrieShouldWork(t *testing.T) {
 	assert.Equal(t, hex.EncodeToString(dataTrieRootHash), dataTrieResponse.RootHash)
 }
 
+func TestNode_GetProofDataTrieKeysInvalidKey(t *testing.T) {
+	t.Parallel()
+
+	stateComponents := getDefaultStateComponents()
+	n, _ := node.NewNode(
+		node.WithStateComponents(stateComponents),
+		node.WithCoreComponents(getDefaultCoreComponents()),
+	)
+
+	responseMainTrie, responseDataTrie, err := n.GetProofDataTrieKeys("deadbeef", "0123", []string{"4567", "key"})
+	assert.Nil(t, responseMainTrie)
+	assert.Nil(t, responseDataTrie)
+	assert.NotNil(t, err)
+}
+
+func createStateComponentsForMultiProofs(mainTrieKey string, mainTrieProof [][]byte, dataTrie common.Trie, dataTrieRootHash []byte) *testscommon.StateComponentsMock {
+	stateComponents := getDefaultStateComponents()
+	stateComponents.AccountsAPI = &stateMock.AccountsStub{
+		GetTrieCalled: func(rootHash []byte) (common.Trie, error) {
+			if bytes.Equal(rootHash, dataTrieRootHash) {
+				return dataTrie, nil
+			}
+
+			return &trieMock.TrieStub{
+				GetProofCalled: func(key []byte) ([][]byte, []byte, error) {
+					if hex.EncodeToString(key) == mainTrieKey {
+						return mainTrieProof, []byte("mainValue"), nil
+					}
+
+					return nil, nil, nil
+				},
+			}, nil
+		},
+		GetAccountFromBytesCalled: func(address []byte, accountBytes []byte) (vmcommon.AccountHandler, error) {
+			acc := &mock.AccountWrapMock{}
+			acc.SetRootHash(dataTrieRootHash)
+			return acc, nil
+		},
+	}
+
+	return stateComponents
+}
+
+func TestNode_GetProofDataTrieKeysShouldWork(t *testing.T) {
+	t.Parallel()
+
+	mainTrieKey := "0123"
+	mainTrieProof := [][]byte{[]byte("valid"), []byte("proof"), []byte("mainTrie")}
+	addressBytes := []byte{0x01, 0x23}
+	dataTrieProof := [][]byte{[]byte("valid"), []byte("multi"), []byte("proof")}
+	dataTrieRootHash := []byte("dataTrieRoot")
+	dataTrie := &trieMock.TrieStub{
+		GetMultiProofCalled: func(keys [][]byte) ([][]byte, [][]byte, error) {
+			assert.Equal(t, [][]byte{{0x45, 0x67}, {0x89}}, keys)
+			storedValue := append(append([]byte("value"), keys[0]...), addressBytes...)
+			return dataTrieProof, [][]byte{storedValue, nil}, nil
+		},
+	}
+	stateComponents := createStateComponentsForMultiProofs(mainTrieKey, mainTrieProof, dataTrie, dataTrieRootHash)
+	n, _ := node.NewNode(
+		node.WithStateComponents(stateComponents),
+		node.WithCoreComponents(getDefaultCoreComponents()),
+	)
+
+	mainTrieResponse, dataTrieResponse, err := n.GetProofDataTrieKeys("deadbeef", mainTrieKey, []string{"4567", "89"})
+	assert.Nil(t, err)
+	assert.Equal(t, mainTrieProof, mainTrieResponse.Proof)
+	assert.Equal(t, dataTrieProof, dataTrieResponse.Proof)
+	assert.Equal(t, [][]byte{{0x45, 0x67}, {0x89}}, dataTrieResponse.Keys)
+	assert.Equal(t, [][]byte{[]byte("value"), nil}, dataTrieResponse.Values)
+	assert.Equal(t, hex.EncodeToString(dataTrieRootHash), dataTrieResponse.RootHash)
+}
+
+func TestNode_GetRangeProofDataTrieShouldWork(t *testing.T) {
+	t.Parallel()
+
+	mainTrieKey := "0123"
+	mainTrieProof := [][]byte{[]byte("valid"), []byte("proof"), []byte("mainTrie")}
+	addressBytes := []byte{0x01, 0x23}
+	dataTrieProof := [][]byte{[]byte("valid"), []byte("range"), []byte("proof")}
+	dataTrieRootHash := []byte("dataTrieRoot")
+	dataTrie := &trieMock.TrieStub{
+		GetRangeProofCalled: func(keySuffix []byte, maxNumNodes uint32) ([][]byte, []core.KeyValueHolder, error) {
+			assert.Equal(t, []byte{0x45}, keySuffix)
+			assert.Equal(t, uint32(50), maxNumNodes)
+			return dataTrieProof, []core.KeyValueHolder{
+				keyValStorage.NewKeyValStorage([]byte{0x01, 0x45}, append([]byte{'v', 'a', 'l', 'u', 'e', '1', 0x01, 0x45}, addressBytes...)),
+				keyValStorage.NewKeyValStorage([]byte{0x02, 0x45}, append([]byte{'v', 'a', 'l', 'u', 'e', '2', 0x02, 0x45}, addressBytes...)),
+			}, nil
+		},
+	}
+	stateComponents := createStateComponentsForMultiProofs(mainTrieKey, mainTrieProof, dataTrie, dataTrieRootHash)
+	n, _ := node.NewNode(
+		node.WithStateComponents(stateComponents),
+		node.WithCoreComponents(getDefaultCoreComponents()),
+		node.WithMaxTrieNodesInRangeProof(50),
+	)
+
+	mainTrieResponse, dataTrieResponse, err := n.GetRangeProofDataTrie("deadbeef", mainTrieKey, "45")
+	assert.Nil(t, err)
+	assert.Equal(t, mainTrieProof, mainTrieResponse.Proof)
+	assert.Equal(t, dataTrieProof, dataTrieResponse.Proof)
+	assert.Equal(t, [][]byte{{0x01, 0x45}, {0x02, 0x45}}, dataTrieResponse.Keys)
+	assert.Equal(t, [][]byte{[]byte("value1"), []byte("value2")}, dataTrieResponse.Values)
+	assert.Equal(t, hex.EncodeToString(dataTrieRootHash), dataTrieResponse.RootHash)
+}
+
 func TestNode_VerifyProofInvalidRootHash(t *testing.T) {
 	t.Parallel()
 
@@ -342,6 +342,30 @@ func WithImportMode(importMode bool) Option {
 	}
 }
 
+// WithMaxTrieNodesInRangeProof sets up the maximum number of data trie nodes held by a range proof. A zero value
+// keeps the default
+func WithMaxTrieNodesInRangeProof(maxNumNodes uint32) Option {
+	return func(n *Node) error {
+		if maxNumNodes > 0 {
+			n.maxTrieNodesInRangeProof = maxNumNodes
+		}
+
+		return nil
+	}
+}
+
+// WithMaxDataTrieChangesInStateDiff sets up the maximum number of data trie changes returned by a state diff
+// request. A zero value keeps the default
+func WithMaxDataTrieChangesInStateDiff(maxNumChanges uint32) Option {
+	return func(n *Node) error {
+		if maxNumChanges > 0 {
+			n.maxDataTrieChangesInDiff = maxNumChanges
+		}
+
+		return nil
+	}
+}
+
 // WithESDTNFTStorageHandler sets the esdt nft storage handler
 func WithESDTNFTStorageHandler(storageHandler vmcommon.ESDTNFTStorageHandler) Option {
 	return func(node *Node) error {
@@ -324,3 +324,29 @@ func TestWithESDTNFTStorageHandler(t *testing.T) {
 		assert.Equal(t, esdtStorer, node.esdtStorageHandler)
 	})
 }
+
+func TestWithMaxTrieNodesInRangeProof(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+	err := WithMaxTrieNodesInRangeProof(0)(node)
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(defaultMaxTrieNodesInRangeProof), node.maxTrieNodesInRangeProof)
+
+	err = WithMaxTrieNodesInRangeProof(50)(node)
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(50), node.maxTrieNodesInRangeProof)
+}
+
+func TestWithMaxDataTrieChangesInStateDiff(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+	err := WithMaxDataTrieChangesInStateDiff(0)(node)
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(defaultMaxDataTrieChangesInStateDiff), node.maxDataTrieChangesInDiff)
+
+	err = WithMaxDataTrieChangesInStateDiff(50)(node)
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(50), node.maxDataTrieChangesInDiff)
+}
@@ -0,0 +1,67 @@
+package secondary
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/api"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/ElrondNetwork/elrond-go/process"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+// apiResolver resolves the API requests of a secondary node. The requests needing a virtual machine, such as the
+// smart contract queries and the transactions cost estimations, are not supported as the node does not create one
+type apiResolver struct {
+	statusMetrics external.StatusMetricsHandler
+}
+
+// NewApiResolver creates a new api resolver for a secondary node
+func NewApiResolver(statusMetrics external.StatusMetricsHandler) (*apiResolver, error) {
+	if check.IfNil(statusMetrics) {
+		return nil, ErrNilStatusMetrics
+	}
+
+	return &apiResolver{
+		statusMetrics: statusMetrics,
+	}, nil
+}
+
+// ExecuteSCQuery returns ErrOperationNotSupported
+func (ar *apiResolver) ExecuteSCQuery(_ *process.SCQuery) (*vmcommon.VMOutput, error) {
+	return nil, ErrOperationNotSupported
+}
+
+// StatusMetrics returns the status metrics of the node
+func (ar *apiResolver) StatusMetrics() external.StatusMetricsHandler {
+	return ar.statusMetrics
+}
+
+// ComputeTransactionGasLimit returns ErrOperationNotSupported
+func (ar *apiResolver) ComputeTransactionGasLimit(_ *transaction.Transaction) (*transaction.CostResponse, error) {
+	return nil, ErrOperationNotSupported
+}
+
+// GetTotalStakedValue returns ErrOperationNotSupported
+func (ar *apiResolver) GetTotalStakedValue() (*api.StakeValues, error) {
+	return nil, ErrOperationNotSupported
+}
+
+// GetDirectStakedList returns ErrOperationNotSupported
+func (ar *apiResolver) GetDirectStakedList() ([]*api.DirectStakedValue, error) {
+	return nil, ErrOperationNotSupported
+}
+
+// GetDelegatorsList returns ErrOperationNotSupported
+func (ar *apiResolver) GetDelegatorsList() ([]*api.Delegator, error) {
+	return nil, ErrOperationNotSupported
+}
+
+// Close returns nil
+func (ar *apiResolver) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ar *apiResolver) IsInterfaceNil() bool {
+	return ar == nil
+}
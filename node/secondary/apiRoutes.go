@@ -0,0 +1,56 @@
+package secondary
+
+import (
+	"github.com/ElrondNetwork/elrond-go/config"
+)
+
+// allRoutes marks the packages whose routes only read the databases, so they are all supported
+const allRoutes = "*"
+
+// supportedRoutes holds, for each API package, the routes a secondary node can answer from the primary's databases.
+// The routes needing the network, the transactions pool, a virtual machine or the validators data are not supported
+var supportedRoutes = map[string][]string{
+	"address": {allRoutes},
+	"block":   {allRoutes},
+	"proof":   {allRoutes},
+	"state":   {allRoutes},
+	"batch":   {allRoutes},
+	"graphql": {allRoutes},
+	"log":     {allRoutes},
+	"node":    {"/status", "/metrics", "/storage"},
+	"network": {
+		"/status",
+		"/economics",
+		"/config",
+		"/enable-epochs",
+		"/esdts",
+		"/esdt/fungible-tokens",
+		"/esdt/semi-fungible-tokens",
+		"/esdt/non-fungible-tokens",
+		"/esdt/supply/:token",
+	},
+	"transaction": {"/:txhash"},
+}
+
+// RestrictApiRoutes closes the API routes a secondary node does not support
+func RestrictApiRoutes(routesConfig *config.ApiRoutesConfig) {
+	for packageName, packageConfig := range routesConfig.APIPackages {
+		for i := range packageConfig.Routes {
+			route := &packageConfig.Routes[i]
+			if route.Open && !isRouteSupported(packageName, route.Name) {
+				log.Debug("secondary: closing unsupported API route", "package", packageName, "route", route.Name)
+				route.Open = false
+			}
+		}
+	}
+}
+
+func isRouteSupported(packageName string, routeName string) bool {
+	for _, supportedRoute := range supportedRoutes[packageName] {
+		if supportedRoute == allRoutes || supportedRoute == routeName {
+			return true
+		}
+	}
+
+	return false
+}
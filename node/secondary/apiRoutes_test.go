@@ -0,0 +1,67 @@
+package secondary
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRestrictApiRoutes(t *testing.T) {
+	t.Parallel()
+
+	routesConfig := &config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"address": {Routes: []config.RouteConfig{
+				{Name: "/:address", Open: true},
+				{Name: "/:address/transactions", Open: true},
+			}},
+			"node": {Routes: []config.RouteConfig{
+				{Name: "/status", Open: true},
+				{Name: "/heartbeatstatus", Open: true},
+				{Name: "/storage", Open: false},
+			}},
+			"network": {Routes: []config.RouteConfig{
+				{Name: "/config", Open: true},
+				{Name: "/direct-staked-info", Open: true},
+			}},
+			"transaction": {Routes: []config.RouteConfig{
+				{Name: "/send", Open: true},
+				{Name: "/:txhash", Open: true},
+				{Name: "/pool", Open: true},
+			}},
+			"vm-values": {Routes: []config.RouteConfig{
+				{Name: "/query", Open: true},
+			}},
+		},
+	}
+
+	RestrictApiRoutes(routesConfig)
+
+	expectedConfig := map[string][]config.RouteConfig{
+		"address": {
+			{Name: "/:address", Open: true},
+			{Name: "/:address/transactions", Open: true},
+		},
+		"node": {
+			{Name: "/status", Open: true},
+			{Name: "/heartbeatstatus", Open: false},
+			{Name: "/storage", Open: false},
+		},
+		"network": {
+			{Name: "/config", Open: true},
+			{Name: "/direct-staked-info", Open: false},
+		},
+		"transaction": {
+			{Name: "/send", Open: false},
+			{Name: "/:txhash", Open: true},
+			{Name: "/pool", Open: false},
+		},
+		"vm-values": {
+			{Name: "/query", Open: false},
+		},
+	}
+	for packageName, routes := range expectedConfig {
+		assert.Equal(t, routes, routesConfig.APIPackages[packageName].Routes, packageName)
+	}
+}
@@ -0,0 +1,42 @@
+package secondary
+
+import "errors"
+
+// ErrNilStorageService signals that a nil storage service has been provided
+var ErrNilStorageService = errors.New("nil storage service")
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilShardCoordinator signals that a nil shard coordinator has been provided
+var ErrNilShardCoordinator = errors.New("nil shard coordinator")
+
+// ErrNilBlockchain signals that a nil blockchain has been provided
+var ErrNilBlockchain = errors.New("nil blockchain")
+
+// ErrNilEpochStartNotifier signals that a nil epoch start notifier has been provided
+var ErrNilEpochStartNotifier = errors.New("nil epoch start notifier")
+
+// ErrNilEpochNotifier signals that a nil epoch notifier has been provided
+var ErrNilEpochNotifier = errors.New("nil epoch notifier")
+
+// ErrNilAppStatusHandler signals that a nil app status handler has been provided
+var ErrNilAppStatusHandler = errors.New("nil app status handler")
+
+// ErrNilStatusMetrics signals that a nil status metrics handler has been provided
+var ErrNilStatusMetrics = errors.New("nil status metrics handler")
+
+// ErrNilPrimaryFollower signals that a nil primary follower has been provided
+var ErrNilPrimaryFollower = errors.New("nil primary follower")
+
+// ErrEmptyPrimaryDbPath signals that an empty path for the primary node's databases has been provided
+var ErrEmptyPrimaryDbPath = errors.New("empty primary database path")
+
+// ErrInvalidRefreshInterval signals that an invalid refresh interval has been provided
+var ErrInvalidRefreshInterval = errors.New("invalid refresh interval")
+
+// ErrMissingRootHash signals that the state of a block was not yet found in the primary node's databases
+var ErrMissingRootHash = errors.New("missing root hash")
+
+// ErrOperationNotSupported signals that the operation is not supported by a secondary node
+var ErrOperationNotSupported = errors.New("operation not supported in secondary mode")
@@ -0,0 +1,291 @@
+package secondary
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/block/bootstrapStorage"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/trie"
+)
+
+var log = logger.GetOrCreate("node/secondary")
+
+// ArgsFollower holds the arguments needed to create a new follower
+type ArgsFollower struct {
+	Store              dataRetriever.StorageService
+	Marshalizer        marshal.Marshalizer
+	ShardCoordinator   sharding.Coordinator
+	Blockchain         data.ChainHandler
+	EpochStartNotifier EpochStartNotifier
+	EpochNotifier      EpochNotifier
+	AppStatusHandler   core.AppStatusHandler
+	PrimaryDbPath      string
+	StartEpoch         uint32
+	RefreshInterval    time.Duration
+}
+
+// follower keeps the blockchain of a secondary node in sync with the blocks committed by the primary node. The highest
+// block is read from the bootstrap storage the primary saves after each commit and it is only used once its state is
+// found in the primary's databases. When the primary starts a new epoch, the storers are moved to the new epoch too
+type follower struct {
+	store              dataRetriever.StorageService
+	marshalizer        marshal.Marshalizer
+	shardCoordinator   sharding.Coordinator
+	blockchain         data.ChainHandler
+	epochStartNotifier EpochStartNotifier
+	epochNotifier      EpochNotifier
+	appStatusHandler   core.AppStatusHandler
+	primaryDbPath      string
+	refreshInterval    time.Duration
+	headerUnit         dataRetriever.UnitType
+
+	mutRefresh   sync.Mutex
+	currentEpoch uint32
+	lastRound    int64
+	cancelFunc   context.CancelFunc
+}
+
+// NewFollower creates a new follower
+func NewFollower(args ArgsFollower) (*follower, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	f := &follower{
+		store:              args.Store,
+		marshalizer:        args.Marshalizer,
+		shardCoordinator:   args.ShardCoordinator,
+		blockchain:         args.Blockchain,
+		epochStartNotifier: args.EpochStartNotifier,
+		epochNotifier:      args.EpochNotifier,
+		appStatusHandler:   args.AppStatusHandler,
+		primaryDbPath:      args.PrimaryDbPath,
+		refreshInterval:    args.RefreshInterval,
+		headerUnit:         dataRetriever.BlockHeaderUnit,
+		currentEpoch:       args.StartEpoch,
+		cancelFunc:         func() {},
+	}
+	if args.ShardCoordinator.SelfId() == core.MetachainShardId {
+		f.headerUnit = dataRetriever.MetaBlockUnit
+	}
+
+	return f, nil
+}
+
+func checkArgs(args ArgsFollower) error {
+	if check.IfNil(args.Store) {
+		return ErrNilStorageService
+	}
+	if check.IfNil(args.Marshalizer) {
+		return ErrNilMarshalizer
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return ErrNilShardCoordinator
+	}
+	if check.IfNil(args.Blockchain) {
+		return ErrNilBlockchain
+	}
+	if check.IfNil(args.EpochStartNotifier) {
+		return ErrNilEpochStartNotifier
+	}
+	if check.IfNil(args.EpochNotifier) {
+		return ErrNilEpochNotifier
+	}
+	if check.IfNil(args.AppStatusHandler) {
+		return ErrNilAppStatusHandler
+	}
+	if len(args.PrimaryDbPath) == 0 {
+		return ErrEmptyPrimaryDbPath
+	}
+	if args.RefreshInterval <= 0 {
+		return ErrInvalidRefreshInterval
+	}
+
+	return nil
+}
+
+// StartFollowing reads the highest block of the primary node and then keeps following it, once every refresh interval
+func (f *follower) StartFollowing() {
+	err := f.Refresh()
+	if err != nil {
+		log.Warn("secondary: can not read the highest block of the primary node", "error", err)
+	}
+
+	var ctx context.Context
+	f.mutRefresh.Lock()
+	ctx, f.cancelFunc = context.WithCancel(context.Background())
+	f.mutRefresh.Unlock()
+
+	go f.followPrimary(ctx)
+}
+
+func (f *follower) followPrimary(ctx context.Context) {
+	timer := time.NewTimer(f.refreshInterval)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Debug("secondary: stopped following the primary node")
+			return
+		case <-timer.C:
+		}
+
+		err := f.Refresh()
+		if err != nil {
+			log.Debug("secondary: can not follow the primary node", "error", err)
+		}
+		timer.Reset(f.refreshInterval)
+	}
+}
+
+// Refresh moves the secondary node to the highest block committed by the primary node, if it changed
+func (f *follower) Refresh() error {
+	f.mutRefresh.Lock()
+	defer f.mutRefresh.Unlock()
+
+	f.changeEpochIfNeeded()
+
+	bootStorer := f.store.GetStorer(dataRetriever.BootstrapUnit)
+	bootStorer.ClearCache()
+	bootstrapStorer, err := bootstrapStorage.NewBootstrapStorer(f.marshalizer, bootStorer)
+	if err != nil {
+		return err
+	}
+
+	round := bootstrapStorer.GetHighestRound()
+	if round == f.lastRound {
+		return nil
+	}
+
+	bootstrapData, err := bootstrapStorer.Get(round)
+	if err != nil {
+		return fmt.Errorf("%w while reading the bootstrap data of round %d", err, round)
+	}
+	headerHash := bootstrapData.LastHeader.Hash
+	if bytes.Equal(headerHash, f.blockchain.GetCurrentBlockHeaderHash()) {
+		f.lastRound = round
+		return nil
+	}
+
+	// values saved under the same key are overwritten by the primary, so the cached ones can not be trusted anymore
+	f.clearCaches()
+
+	header, err := f.getHeader(headerHash)
+	if err != nil {
+		return fmt.Errorf("%w while reading the header of round %d", err, round)
+	}
+
+	rootHash := header.GetRootHash()
+	err = f.checkRootHash(rootHash)
+	if err != nil {
+		return err
+	}
+
+	f.epochNotifier.CheckEpoch(header)
+	err = f.blockchain.SetCurrentBlockHeaderAndRootHash(header, rootHash)
+	if err != nil {
+		return err
+	}
+	f.blockchain.SetCurrentBlockHeaderHash(headerHash)
+	f.lastRound = round
+
+	// the nonce and the synchronized round metrics are set by the blockchain
+	f.appStatusHandler.SetUInt64Value(common.MetricCurrentRound, header.GetRound())
+	f.appStatusHandler.SetUInt64Value(common.MetricEpochNumber, uint64(header.GetEpoch()))
+
+	log.Debug("secondary: following the primary node",
+		"epoch", header.GetEpoch(),
+		"round", header.GetRound(),
+		"nonce", header.GetNonce(),
+		"hash", headerHash,
+	)
+
+	return nil
+}
+
+// changeEpochIfNeeded moves the storers into the epochs started by the primary node, as the primary creates a new
+// directory for each epoch it starts
+func (f *follower) changeEpochIfNeeded() {
+	for {
+		nextEpochPath := filepath.Join(f.primaryDbPath, fmt.Sprintf("%s_%d", common.DefaultEpochString, f.currentEpoch+1))
+		_, err := os.Stat(nextEpochPath)
+		if err != nil {
+			return
+		}
+
+		f.currentEpoch++
+		log.Debug("secondary: the primary node started a new epoch", "epoch", f.currentEpoch)
+		f.epochStartNotifier.NotifyAll(f.createEpochStartHeader(f.currentEpoch))
+	}
+}
+
+func (f *follower) createEpochStartHeader(epoch uint32) data.HeaderHandler {
+	if f.shardCoordinator.SelfId() == core.MetachainShardId {
+		return &block.MetaBlock{Epoch: epoch}
+	}
+
+	return &block.Header{Epoch: epoch, ShardID: f.shardCoordinator.SelfId()}
+}
+
+func (f *follower) clearCaches() {
+	for unitType, storer := range f.store.GetAllStorers() {
+		// the trie nodes are saved under the hash of their content, so they never change
+		if unitType == dataRetriever.UserAccountsUnit || unitType == dataRetriever.PeerAccountsUnit {
+			continue
+		}
+
+		storer.ClearCache()
+	}
+}
+
+func (f *follower) getHeader(headerHash []byte) (data.HeaderHandler, error) {
+	if f.headerUnit == dataRetriever.MetaBlockUnit {
+		return process.GetMetaHeaderFromStorage(headerHash, f.marshalizer, f.store)
+	}
+
+	return process.GetShardHeaderFromStorage(headerHash, f.marshalizer, f.store)
+}
+
+func (f *follower) checkRootHash(rootHash []byte) error {
+	if len(rootHash) == 0 || bytes.Equal(rootHash, trie.EmptyTrieHash) {
+		return nil
+	}
+
+	err := f.store.GetStorer(dataRetriever.UserAccountsUnit).Has(rootHash)
+	if err != nil {
+		return fmt.Errorf("%w: %x", ErrMissingRootHash, rootHash)
+	}
+
+	return nil
+}
+
+// Close stops following the primary node
+func (f *follower) Close() error {
+	f.mutRefresh.Lock()
+	f.cancelFunc()
+	f.mutRefresh.Unlock()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (f *follower) IsInterfaceNil() bool {
+	return f == nil
+}
@@ -0,0 +1,294 @@
+package secondary
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/blockchain"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/process/block/bootstrapStorage"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/epochNotifier"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericMocks"
+	"github.com/ElrondNetwork/elrond-go/testscommon/statusHandler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createStore() dataRetriever.StorageService {
+	store := dataRetriever.NewChainStorer()
+	store.AddStorer(dataRetriever.BootstrapUnit, genericMocks.NewStorerMock("Bootstrap", 0))
+	store.AddStorer(dataRetriever.BlockHeaderUnit, genericMocks.NewStorerMock("BlockHeaders", 0))
+	store.AddStorer(dataRetriever.MetaBlockUnit, genericMocks.NewStorerMock("MetaBlocks", 0))
+	store.AddStorer(dataRetriever.UserAccountsUnit, genericMocks.NewStorerMock("UserAccounts", 0))
+
+	return store
+}
+
+func createMockArgsFollower(t *testing.T) ArgsFollower {
+	chain, _ := blockchain.NewBlockChain(&statusHandler.AppStatusHandlerStub{})
+
+	return ArgsFollower{
+		Store:              createStore(),
+		Marshalizer:        &marshal.GogoProtoMarshalizer{},
+		ShardCoordinator:   testscommon.NewMultiShardsCoordinatorMock(2),
+		Blockchain:         chain,
+		EpochStartNotifier: &mock.EpochStartNotifierStub{},
+		EpochNotifier:      &epochNotifier.EpochNotifierStub{},
+		AppStatusHandler:   &statusHandler.AppStatusHandlerStub{},
+		PrimaryDbPath:      t.TempDir(),
+		RefreshInterval:    time.Second,
+	}
+}
+
+// commitBlock saves a block in the storage the way the primary node does after committing it
+func commitBlock(t *testing.T, args ArgsFollower, header data.HeaderHandler, headerHash []byte, withState bool) {
+	headerBytes, err := args.Marshalizer.Marshal(header)
+	require.Nil(t, err)
+	headerUnit := dataRetriever.BlockHeaderUnit
+	if header.GetShardID() == core.MetachainShardId {
+		headerUnit = dataRetriever.MetaBlockUnit
+	}
+	require.Nil(t, args.Store.Put(headerUnit, headerHash, headerBytes))
+
+	if withState {
+		require.Nil(t, args.Store.Put(dataRetriever.UserAccountsUnit, header.GetRootHash(), []byte("root node")))
+	}
+
+	bootStorer, err := bootstrapStorage.NewBootstrapStorer(args.Marshalizer, args.Store.GetStorer(dataRetriever.BootstrapUnit))
+	require.Nil(t, err)
+	err = bootStorer.Put(int64(header.GetRound()), bootstrapStorage.BootstrapData{
+		LastHeader: bootstrapStorage.BootstrapHeaderInfo{
+			ShardId: header.GetShardID(),
+			Epoch:   header.GetEpoch(),
+			Nonce:   header.GetNonce(),
+			Hash:    headerHash,
+		},
+	})
+	require.Nil(t, err)
+}
+
+func TestNewFollower(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil storage service should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFollower(t)
+		args.Store = nil
+		f, err := NewFollower(args)
+		assert.Nil(t, f)
+		assert.Equal(t, ErrNilStorageService, err)
+	})
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFollower(t)
+		args.Marshalizer = nil
+		f, err := NewFollower(args)
+		assert.Nil(t, f)
+		assert.Equal(t, ErrNilMarshalizer, err)
+	})
+	t.Run("nil shard coordinator should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFollower(t)
+		args.ShardCoordinator = nil
+		f, err := NewFollower(args)
+		assert.Nil(t, f)
+		assert.Equal(t, ErrNilShardCoordinator, err)
+	})
+	t.Run("nil blockchain should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFollower(t)
+		args.Blockchain = nil
+		f, err := NewFollower(args)
+		assert.Nil(t, f)
+		assert.Equal(t, ErrNilBlockchain, err)
+	})
+	t.Run("nil epoch start notifier should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFollower(t)
+		args.EpochStartNotifier = nil
+		f, err := NewFollower(args)
+		assert.Nil(t, f)
+		assert.Equal(t, ErrNilEpochStartNotifier, err)
+	})
+	t.Run("nil epoch notifier should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFollower(t)
+		args.EpochNotifier = nil
+		f, err := NewFollower(args)
+		assert.Nil(t, f)
+		assert.Equal(t, ErrNilEpochNotifier, err)
+	})
+	t.Run("nil app status handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFollower(t)
+		args.AppStatusHandler = nil
+		f, err := NewFollower(args)
+		assert.Nil(t, f)
+		assert.Equal(t, ErrNilAppStatusHandler, err)
+	})
+	t.Run("empty primary db path should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFollower(t)
+		args.PrimaryDbPath = ""
+		f, err := NewFollower(args)
+		assert.Nil(t, f)
+		assert.Equal(t, ErrEmptyPrimaryDbPath, err)
+	})
+	t.Run("invalid refresh interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFollower(t)
+		args.RefreshInterval = 0
+		f, err := NewFollower(args)
+		assert.Nil(t, f)
+		assert.Equal(t, ErrInvalidRefreshInterval, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		f, err := NewFollower(createMockArgsFollower(t))
+		assert.Nil(t, err)
+		assert.False(t, f.IsInterfaceNil())
+	})
+}
+
+func TestFollower_RefreshShouldFollowTheHighestBlockWithState(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsFollower(t)
+	checkedEpochs := make([]uint32, 0)
+	args.EpochNotifier = &epochNotifier.EpochNotifierStub{
+		CheckEpochCalled: func(header data.HeaderHandler) {
+			checkedEpochs = append(checkedEpochs, header.GetEpoch())
+		},
+	}
+	f, _ := NewFollower(args)
+
+	err := f.Refresh()
+	assert.Nil(t, err, "the primary did not commit any block yet")
+	assert.Nil(t, args.Blockchain.GetCurrentBlockHeader())
+
+	firstHeader := &block.Header{Nonce: 1, Round: 1, RootHash: []byte("root hash 1")}
+	commitBlock(t, args, firstHeader, []byte("hash 1"), true)
+	err = f.Refresh()
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("hash 1"), args.Blockchain.GetCurrentBlockHeaderHash())
+	assert.Equal(t, []byte("root hash 1"), args.Blockchain.GetCurrentBlockRootHash())
+	assert.Equal(t, uint64(1), args.Blockchain.GetCurrentBlockHeader().GetNonce())
+
+	secondHeader := &block.Header{Nonce: 2, Round: 3, RootHash: []byte("root hash 2")}
+	commitBlock(t, args, secondHeader, []byte("hash 2"), false)
+	err = f.Refresh()
+	assert.True(t, errors.Is(err, ErrMissingRootHash))
+	assert.Equal(t, []byte("hash 1"), args.Blockchain.GetCurrentBlockHeaderHash())
+
+	require.Nil(t, args.Store.Put(dataRetriever.UserAccountsUnit, secondHeader.RootHash, []byte("root node")))
+	err = f.Refresh()
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("hash 2"), args.Blockchain.GetCurrentBlockHeaderHash())
+	assert.Equal(t, []byte("root hash 2"), args.Blockchain.GetCurrentBlockRootHash())
+	assert.Equal(t, []uint32{0, 0}, checkedEpochs)
+}
+
+func TestFollower_RefreshShouldReadTheMetachainHeaders(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsFollower(t)
+	shardCoordinator := testscommon.NewMultiShardsCoordinatorMock(2)
+	shardCoordinator.CurrentShard = core.MetachainShardId
+	args.ShardCoordinator = shardCoordinator
+	args.Blockchain, _ = blockchain.NewMetaChain(&statusHandler.AppStatusHandlerStub{})
+	f, _ := NewFollower(args)
+
+	header := &block.MetaBlock{Nonce: 5, Round: 7, RootHash: []byte("meta root hash")}
+	commitBlock(t, args, header, []byte("meta hash"), true)
+	err := f.Refresh()
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("meta hash"), args.Blockchain.GetCurrentBlockHeaderHash())
+	assert.Equal(t, uint64(5), args.Blockchain.GetCurrentBlockHeader().GetNonce())
+}
+
+func TestFollower_RefreshShouldNotifyTheEpochsStartedByThePrimary(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsFollower(t)
+	args.StartEpoch = 3
+	notifiedEpochs := make([]uint32, 0)
+	args.EpochStartNotifier = &mock.EpochStartNotifierStub{
+		NotifyAllCalled: func(hdr data.HeaderHandler) {
+			_, isShardHeader := hdr.(*block.Header)
+			assert.True(t, isShardHeader)
+			notifiedEpochs = append(notifiedEpochs, hdr.GetEpoch())
+		},
+	}
+	f, _ := NewFollower(args)
+
+	for _, epoch := range []string{"Epoch_3", "Epoch_4", "Epoch_5"} {
+		require.Nil(t, os.MkdirAll(filepath.Join(args.PrimaryDbPath, epoch), os.ModePerm))
+	}
+
+	_ = f.Refresh()
+	assert.Equal(t, []uint32{4, 5}, notifiedEpochs)
+
+	_ = f.Refresh()
+	assert.Equal(t, []uint32{4, 5}, notifiedEpochs)
+
+	require.Nil(t, os.MkdirAll(filepath.Join(args.PrimaryDbPath, "Epoch_6"), os.ModePerm))
+	_ = f.Refresh()
+	assert.Equal(t, []uint32{4, 5, 6}, notifiedEpochs)
+}
+
+func TestFollower_StartFollowingShouldFollowThePrimaryUntilClosed(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsFollower(t)
+	args.RefreshInterval = time.Millisecond * 10
+	mutRounds := sync.Mutex{}
+	rounds := make([]uint64, 0)
+	args.AppStatusHandler = &statusHandler.AppStatusHandlerStub{
+		SetUInt64ValueHandler: func(key string, value uint64) {
+			if key != common.MetricCurrentRound {
+				return
+			}
+
+			mutRounds.Lock()
+			rounds = append(rounds, value)
+			mutRounds.Unlock()
+		},
+	}
+	f, _ := NewFollower(args)
+
+	commitBlock(t, args, &block.Header{Nonce: 1, Round: 1, RootHash: []byte("root hash 1")}, []byte("hash 1"), true)
+	f.StartFollowing()
+
+	commitBlock(t, args, &block.Header{Nonce: 2, Round: 2, RootHash: []byte("root hash 2")}, []byte("hash 2"), true)
+	time.Sleep(args.RefreshInterval * 10)
+	assert.Nil(t, f.Close())
+	time.Sleep(args.RefreshInterval * 2)
+
+	commitBlock(t, args, &block.Header{Nonce: 3, Round: 3, RootHash: []byte("root hash 3")}, []byte("hash 3"), true)
+	time.Sleep(args.RefreshInterval * 5)
+
+	mutRounds.Lock()
+	assert.Equal(t, []uint64{1, 2}, rounds)
+	mutRounds.Unlock()
+}
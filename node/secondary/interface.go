@@ -0,0 +1,25 @@
+package secondary
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/data"
+)
+
+// EpochStartNotifier defines the component notifying the storers about the epoch changes
+type EpochStartNotifier interface {
+	NotifyAll(hdr data.HeaderHandler)
+	IsInterfaceNil() bool
+}
+
+// EpochNotifier defines the component activating the flags of the current epoch
+type EpochNotifier interface {
+	CheckEpoch(header data.HeaderHandler)
+	IsInterfaceNil() bool
+}
+
+// PrimaryFollower defines the component keeping a secondary node in sync with the blocks committed by its primary node
+type PrimaryFollower interface {
+	StartFollowing()
+	Refresh() error
+	Close() error
+	IsInterfaceNil() bool
+}
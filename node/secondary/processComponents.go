@@ -0,0 +1,236 @@
+package secondary
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dblookupext"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+	mainFactory "github.com/ElrondNetwork/elrond-go/factory"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/update"
+)
+
+var _ mainFactory.ProcessComponentsHandler = (*processComponents)(nil)
+
+// processComponents holds the few process components a secondary node uses to answer the API requests. A secondary
+// node does not process blocks, so the other components are not created and their getters return nil
+type processComponents struct {
+	shardCoordinator  sharding.Coordinator
+	historyRepository dblookupext.HistoryRepository
+	primaryFollower   PrimaryFollower
+	txSimulator       mainFactory.TransactionSimulatorProcessor
+}
+
+// NewProcessComponents creates the process components of a secondary node
+func NewProcessComponents(
+	shardCoordinator sharding.Coordinator,
+	historyRepository dblookupext.HistoryRepository,
+	primaryFollower PrimaryFollower,
+) (*processComponents, error) {
+	pc := &processComponents{
+		shardCoordinator:  shardCoordinator,
+		historyRepository: historyRepository,
+		primaryFollower:   primaryFollower,
+		txSimulator:       NewDisabledTxSimulator(),
+	}
+
+	err := pc.CheckSubcomponents()
+	if err != nil {
+		return nil, err
+	}
+
+	return pc, nil
+}
+
+// Create does nothing as the components are provided on construction
+func (pc *processComponents) Create() error {
+	return nil
+}
+
+// Close stops following the primary node. The process components are closed before the data components, so the
+// follower does not read the storers after they are closed
+func (pc *processComponents) Close() error {
+	return pc.primaryFollower.Close()
+}
+
+// CheckSubcomponents verifies all subcomponents
+func (pc *processComponents) CheckSubcomponents() error {
+	if check.IfNil(pc.shardCoordinator) {
+		return ErrNilShardCoordinator
+	}
+	if check.IfNil(pc.historyRepository) {
+		return process.ErrNilHistoryRepository
+	}
+	if check.IfNil(pc.primaryFollower) {
+		return ErrNilPrimaryFollower
+	}
+
+	return nil
+}
+
+// ShardCoordinator returns the shard coordinator
+func (pc *processComponents) ShardCoordinator() sharding.Coordinator {
+	return pc.shardCoordinator
+}
+
+// HistoryRepository returns the history repository reading the primary node's databases
+func (pc *processComponents) HistoryRepository() dblookupext.HistoryRepository {
+	return pc.historyRepository
+}
+
+// TransactionSimulatorProcessor returns a disabled transaction simulator
+func (pc *processComponents) TransactionSimulatorProcessor() mainFactory.TransactionSimulatorProcessor {
+	return pc.txSimulator
+}
+
+// NodesCoordinator returns nil
+func (pc *processComponents) NodesCoordinator() sharding.NodesCoordinator {
+	return nil
+}
+
+// InterceptorsContainer returns nil
+func (pc *processComponents) InterceptorsContainer() process.InterceptorsContainer {
+	return nil
+}
+
+// ResolversFinder returns nil
+func (pc *processComponents) ResolversFinder() dataRetriever.ResolversFinder {
+	return nil
+}
+
+// RoundHandler returns nil
+func (pc *processComponents) RoundHandler() consensus.RoundHandler {
+	return nil
+}
+
+// EpochStartTrigger returns nil
+func (pc *processComponents) EpochStartTrigger() epochStart.TriggerHandler {
+	return nil
+}
+
+// EpochStartNotifier returns nil
+func (pc *processComponents) EpochStartNotifier() mainFactory.EpochStartNotifier {
+	return nil
+}
+
+// ForkDetector returns nil
+func (pc *processComponents) ForkDetector() process.ForkDetector {
+	return nil
+}
+
+// BlockProcessor returns nil
+func (pc *processComponents) BlockProcessor() process.BlockProcessor {
+	return nil
+}
+
+// BlackListHandler returns nil
+func (pc *processComponents) BlackListHandler() process.TimeCacher {
+	return nil
+}
+
+// BootStorer returns nil
+func (pc *processComponents) BootStorer() process.BootStorer {
+	return nil
+}
+
+// HeaderSigVerifier returns nil
+func (pc *processComponents) HeaderSigVerifier() process.InterceptedHeaderSigVerifier {
+	return nil
+}
+
+// HeaderIntegrityVerifier returns nil
+func (pc *processComponents) HeaderIntegrityVerifier() process.HeaderIntegrityVerifier {
+	return nil
+}
+
+// ValidatorsStatistics returns nil
+func (pc *processComponents) ValidatorsStatistics() process.ValidatorStatisticsProcessor {
+	return nil
+}
+
+// ValidatorsProvider returns nil
+func (pc *processComponents) ValidatorsProvider() process.ValidatorsProvider {
+	return nil
+}
+
+// BlockTracker returns nil
+func (pc *processComponents) BlockTracker() process.BlockTracker {
+	return nil
+}
+
+// PendingMiniBlocksHandler returns nil
+func (pc *processComponents) PendingMiniBlocksHandler() process.PendingMiniBlocksHandler {
+	return nil
+}
+
+// RequestHandler returns nil
+func (pc *processComponents) RequestHandler() process.RequestHandler {
+	return nil
+}
+
+// TxLogsProcessor returns nil
+func (pc *processComponents) TxLogsProcessor() process.TransactionLogProcessorDatabase {
+	return nil
+}
+
+// HeaderConstructionValidator returns nil
+func (pc *processComponents) HeaderConstructionValidator() process.HeaderConstructionValidator {
+	return nil
+}
+
+// PeerShardMapper returns nil
+func (pc *processComponents) PeerShardMapper() process.NetworkShardingCollector {
+	return nil
+}
+
+// FallbackHeaderValidator returns nil
+func (pc *processComponents) FallbackHeaderValidator() process.FallbackHeaderValidator {
+	return nil
+}
+
+// WhiteListHandler returns nil
+func (pc *processComponents) WhiteListHandler() process.WhiteListHandler {
+	return nil
+}
+
+// WhiteListerVerifiedTxs returns nil
+func (pc *processComponents) WhiteListerVerifiedTxs() process.WhiteListHandler {
+	return nil
+}
+
+// ImportStartHandler returns nil
+func (pc *processComponents) ImportStartHandler() update.ImportStartHandler {
+	return nil
+}
+
+// RequestedItemsHandler returns nil
+func (pc *processComponents) RequestedItemsHandler() dataRetriever.RequestedItemsHandler {
+	return nil
+}
+
+// NodeRedundancyHandler returns nil
+func (pc *processComponents) NodeRedundancyHandler() consensus.NodeRedundancyHandler {
+	return nil
+}
+
+// CurrentEpochProvider returns nil
+func (pc *processComponents) CurrentEpochProvider() process.CurrentNetworkEpochProviderHandler {
+	return nil
+}
+
+// ScheduledTxsExecutionHandler returns nil
+func (pc *processComponents) ScheduledTxsExecutionHandler() process.ScheduledTxsExecutionHandler {
+	return nil
+}
+
+// String returns the name of the component
+func (pc *processComponents) String() string {
+	return "secondaryProcessComponents"
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pc *processComponents) IsInterfaceNil() bool {
+	return pc == nil
+}
@@ -0,0 +1,25 @@
+package secondary
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
+)
+
+type disabledTxSimulator struct {
+}
+
+// NewDisabledTxSimulator returns a transaction simulator that does not simulate anything, as a secondary node does
+// not create a virtual machine
+func NewDisabledTxSimulator() *disabledTxSimulator {
+	return &disabledTxSimulator{}
+}
+
+// ProcessTx returns ErrOperationNotSupported
+func (dts *disabledTxSimulator) ProcessTx(_ *transaction.Transaction) (*txSimData.SimulationResults, error) {
+	return nil, ErrOperationNotSupported
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dts *disabledTxSimulator) IsInterfaceNil() bool {
+	return dts == nil
+}
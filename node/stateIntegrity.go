@@ -0,0 +1,130 @@
+package node
+
+import (
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/errors"
+	mainFactory "github.com/ElrondNetwork/elrond-go/factory"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/state/syncer"
+	trieFactory "github.com/ElrondNetwork/elrond-go/trie/factory"
+)
+
+// checkStateIntegrityIfNecessary walks the accounts trie of the last committed block and all its data tries, before
+// the node starts processing blocks. If the repair flag is set, the missing and corrupt trie nodes are requested from
+// the peers. The node is stopped if the state is still broken afterwards
+func (nr *nodeRunner) checkStateIntegrityIfNecessary(
+	coreComponents mainFactory.CoreComponentsHolder,
+	dataComponents mainFactory.DataComponentsHolder,
+	stateComponents mainFactory.StateComponentsHolder,
+	processComponents mainFactory.ProcessComponentsHolder,
+) error {
+	flagsConfig := nr.configs.FlagsConfig
+	if !flagsConfig.CheckStateIntegrity && !flagsConfig.RepairState {
+		return nil
+	}
+
+	rootHash, err := getLastCommittedRootHash(coreComponents, dataComponents, processComponents)
+	if err != nil {
+		return err
+	}
+
+	trieStorageManager, ok := stateComponents.TrieStorageManagers()[trieFactory.UserAccountTrie]
+	if !ok {
+		return errors.ErrNilTrieStorageManager
+	}
+
+	generalConfig := nr.configs.GeneralConfig
+	repairer, err := syncer.NewStateRepairer(syncer.ArgsNewStateRepairer{
+		ArgsNewBaseAccountsSyncer: syncer.ArgsNewBaseAccountsSyncer{
+			Hasher:                    coreComponents.Hasher(),
+			Marshalizer:               coreComponents.InternalMarshalizer(),
+			TrieStorageManager:        trieStorageManager,
+			RequestHandler:            processComponents.RequestHandler(),
+			Timeout:                   common.TimeoutGettingTrieNodes,
+			Cacher:                    dataComponents.Datapool().TrieNodes(),
+			MaxTrieLevelInMemory:      generalConfig.StateTriesConfig.MaxStateTrieLevelInMemory,
+			MaxHardCapForMissingNodes: generalConfig.TrieSync.MaxHardCapForMissingNodes,
+			TrieSyncerVersion:         generalConfig.TrieSync.TrieSyncerVersion,
+		},
+		ShardId: processComponents.ShardCoordinator().SelfId(),
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Info("checking the state integrity", "root hash", rootHash, "repair", flagsConfig.RepairState)
+
+	var report *state.IntegrityReport
+	if flagsConfig.RepairState {
+		report, err = repairer.RepairState(rootHash)
+	} else {
+		report, err = repairer.CheckIntegrity(rootHash)
+	}
+	if err != nil {
+		return err
+	}
+
+	logIntegrityReport(report)
+	if !report.IsIntact() {
+		return fmt.Errorf("the state with root hash %x has %d missing and %d corrupt trie nodes",
+			report.RootHash, len(report.MissingNodes), len(report.CorruptNodes))
+	}
+
+	return nil
+}
+
+// getLastCommittedRootHash returns the accounts trie root hash of the last block saved in the bootstrap storage,
+// the same block the storage bootstrapper will resume from
+func getLastCommittedRootHash(
+	coreComponents mainFactory.CoreComponentsHolder,
+	dataComponents mainFactory.DataComponentsHolder,
+	processComponents mainFactory.ProcessComponentsHolder,
+) ([]byte, error) {
+	bootStorer := processComponents.BootStorer()
+	highestRound := bootStorer.GetHighestRound()
+	if highestRound == 0 {
+		return dataComponents.Blockchain().GetGenesisHeader().GetRootHash(), nil
+	}
+
+	bootstrapData, err := bootStorer.Get(highestRound)
+	if err != nil {
+		return nil, err
+	}
+
+	var header data.HeaderHandler
+	lastHeader := bootstrapData.LastHeader
+	marshalizer := coreComponents.InternalMarshalizer()
+	storageService := dataComponents.StorageService()
+	if lastHeader.ShardId == core.MetachainShardId {
+		header, err = process.GetMetaHeaderFromStorage(lastHeader.Hash, marshalizer, storageService)
+	} else {
+		header, err = process.GetShardHeaderFromStorage(lastHeader.Hash, marshalizer, storageService)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return header.GetRootHash(), nil
+}
+
+func logIntegrityReport(report *state.IntegrityReport) {
+	for _, hash := range report.MissingNodes {
+		log.Error("missing trie node", "hash", hash)
+	}
+	for _, hash := range report.CorruptNodes {
+		log.Error("corrupt trie node", "hash", hash)
+	}
+
+	log.Info("state integrity check ended",
+		"root hash", report.RootHash,
+		"data tries", report.NumDataTries,
+		"trie nodes", report.NumNodes,
+		"leaves", report.NumLeaves,
+		"missing nodes", len(report.MissingNodes),
+		"corrupt nodes", len(report.CorruptNodes))
+}
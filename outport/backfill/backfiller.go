@@ -0,0 +1,586 @@
+package backfill
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/batch"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go-core/data/receipt"
+	"github.com/ElrondNetwork/elrond-go-core/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go-core/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go-core/data/typeConverters"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/outport"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var log = logger.GetOrCreate("outport/backfill")
+
+const logProgressEveryNumBlocks = 1000
+
+// ArgsBackfiller holds the arguments needed to create a new backfiller
+type ArgsBackfiller struct {
+	Store                    dataRetriever.StorageService
+	Marshalizer              marshal.Marshalizer
+	Uint64ByteSliceConverter typeConverters.Uint64ByteSliceConverter
+	ShardCoordinator         sharding.Coordinator
+	NodesCoordinator         sharding.NodesCoordinator
+	OutportHandler           outport.OutportHandler
+	StartEpoch               uint32
+	FromNonce                uint64
+	ToNonce                  uint64
+}
+
+// Statistics holds the results of a backfill run
+type Statistics struct {
+	FirstNonce     uint64
+	LastNonce      uint64
+	NumBlocks      uint64
+	NumTxs         uint64
+	WasCompleted   bool
+	WasInterrupted bool
+}
+
+// backfiller re-emits the blocks already saved in the storage through the outport handler, without processing
+// them again. The data that is not kept in the storage is not re-emitted: the gas consumption of the blocks is
+// left empty, while the signers indexes are only set for the epochs still known by the nodes coordinator
+type backfiller struct {
+	store                    dataRetriever.StorageService
+	marshalizer              marshal.Marshalizer
+	uint64ByteSliceConverter typeConverters.Uint64ByteSliceConverter
+	shardCoordinator         sharding.Coordinator
+	nodesCoordinator         sharding.NodesCoordinator
+	outportHandler           outport.OutportHandler
+	startEpoch               uint32
+	fromNonce                uint64
+	toNonce                  uint64
+	headerUnit               dataRetriever.UnitType
+	nonceHashUnit            dataRetriever.UnitType
+	currentEpoch             uint32
+	stopped                  uint32
+}
+
+// NewBackfiller creates a new backfiller
+func NewBackfiller(args ArgsBackfiller) (*backfiller, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	b := &backfiller{
+		store:                    args.Store,
+		marshalizer:              args.Marshalizer,
+		uint64ByteSliceConverter: args.Uint64ByteSliceConverter,
+		shardCoordinator:         args.ShardCoordinator,
+		nodesCoordinator:         args.NodesCoordinator,
+		outportHandler:           args.OutportHandler,
+		startEpoch:               args.StartEpoch,
+		fromNonce:                args.FromNonce,
+		toNonce:                  args.ToNonce,
+		headerUnit:               dataRetriever.BlockHeaderUnit,
+		nonceHashUnit:            dataRetriever.ShardHdrNonceHashDataUnit + dataRetriever.UnitType(args.ShardCoordinator.SelfId()),
+		currentEpoch:             args.StartEpoch,
+	}
+	if args.ShardCoordinator.SelfId() == core.MetachainShardId {
+		b.headerUnit = dataRetriever.MetaBlockUnit
+		b.nonceHashUnit = dataRetriever.MetaHdrNonceHashDataUnit
+	}
+
+	return b, nil
+}
+
+func checkArgs(args ArgsBackfiller) error {
+	if check.IfNil(args.Store) {
+		return ErrNilStorageService
+	}
+	if check.IfNil(args.Marshalizer) {
+		return ErrNilMarshalizer
+	}
+	if check.IfNil(args.Uint64ByteSliceConverter) {
+		return ErrNilUint64ByteSliceConverter
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return ErrNilShardCoordinator
+	}
+	if check.IfNil(args.NodesCoordinator) {
+		return ErrNilNodesCoordinator
+	}
+	if check.IfNil(args.OutportHandler) {
+		return ErrNilOutportHandler
+	}
+	if !args.OutportHandler.HasDrivers() {
+		return ErrNoDrivers
+	}
+	if args.ToNonce != 0 && args.ToNonce < args.FromNonce {
+		return fmt.Errorf("%w, from nonce: %d, to nonce: %d", ErrInvalidNoncesRange, args.FromNonce, args.ToNonce)
+	}
+
+	return nil
+}
+
+// Run re-emits the stored blocks, in order, starting with the configured nonce or, if this one is not set, with the
+// start of epoch block of the configured epoch. It ends with the configured last nonce or, if this one is not set,
+// with the last block found in the storage
+func (b *backfiller) Run() (*Statistics, error) {
+	nonce, err := b.getFirstNonce()
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &Statistics{
+		FirstNonce: nonce,
+	}
+
+	log.Info("outport backfill started", "shard", b.shardCoordinator.SelfId(), "from nonce", nonce, "to nonce", b.toNonce)
+	for ; b.toNonce == 0 || nonce <= b.toNonce; nonce++ {
+		if b.isStopped() {
+			stats.WasInterrupted = true
+			return stats, nil
+		}
+
+		var numTxs int
+		numTxs, err = b.backfillBlock(nonce)
+		if errors.Is(err, ErrMissingBlock) && b.toNonce == 0 {
+			stats.WasCompleted = true
+			return stats, nil
+		}
+		if err != nil {
+			return stats, fmt.Errorf("%w while backfilling the block with nonce %d", err, nonce)
+		}
+
+		stats.LastNonce = nonce
+		stats.NumBlocks++
+		stats.NumTxs += uint64(numTxs)
+		if stats.NumBlocks%logProgressEveryNumBlocks == 0 {
+			log.Info("outport backfill in progress", "nonce", nonce, "epoch", b.currentEpoch, "num blocks", stats.NumBlocks)
+		}
+	}
+
+	stats.WasCompleted = true
+
+	return stats, nil
+}
+
+// getFirstNonce returns the nonce of the first re-emitted block and sets the epoch in which its data is searched
+func (b *backfiller) getFirstNonce() (uint64, error) {
+	if b.fromNonce > 0 {
+		epoch, err := b.getEpochOfNonce(b.fromNonce)
+		if err != nil {
+			return 0, err
+		}
+
+		b.currentEpoch = epoch
+		return b.fromNonce, nil
+	}
+	if b.startEpoch == 0 {
+		// the genesis block is not re-emitted, as its transactions are not saved in the storage as the ones of the
+		// regular blocks
+		return 1, nil
+	}
+
+	header, err := b.getEpochStartHeader(b.startEpoch)
+	if err != nil {
+		return 0, fmt.Errorf("%w while getting the start of epoch block for epoch %d", err, b.startEpoch)
+	}
+
+	return header.GetNonce(), nil
+}
+
+// getEpochOfNonce returns the epoch of the block with the provided nonce, walking the start of epoch blocks from the
+// configured start epoch onwards, as the nonce to hash index does not hold the blocks epochs
+func (b *backfiller) getEpochOfNonce(nonce uint64) (uint32, error) {
+	epoch := b.startEpoch
+	for {
+		header, err := b.getEpochStartHeader(epoch + 1)
+		if errors.Is(err, storage.ErrKeyNotFound) {
+			return epoch, nil
+		}
+		if err != nil {
+			return 0, fmt.Errorf("%w while getting the start of epoch block for epoch %d", err, epoch+1)
+		}
+		if header.GetNonce() > nonce {
+			return epoch, nil
+		}
+
+		epoch++
+	}
+}
+
+func (b *backfiller) getEpochStartHeader(epoch uint32) (data.HeaderHandler, error) {
+	epochStartIdentifier := []byte(core.EpochStartIdentifier(epoch))
+	headerBytes, err := b.store.GetStorer(b.headerUnit).GetFromEpoch(epochStartIdentifier, epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	return b.unmarshalHeader(headerBytes)
+}
+
+func (b *backfiller) backfillBlock(nonce uint64) (int, error) {
+	// the nonce to hash index is a static storer, holding the nonces of all the epochs
+	nonceBytes := b.uint64ByteSliceConverter.ToByteSlice(nonce)
+	headerHash, err := b.store.Get(b.nonceHashUnit, nonceBytes)
+	if errors.Is(err, storage.ErrKeyNotFound) {
+		return 0, ErrMissingBlock
+	}
+	if err != nil {
+		return 0, fmt.Errorf("%w while getting the hash of the header", err)
+	}
+
+	headerBytes, err := b.getFromEpochs(b.headerUnit, headerHash)
+	if err != nil {
+		headerBytes, err = b.store.GetStorer(b.headerUnit).SearchFirst(headerHash)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("%w while getting the header with hash %s", err, hex.EncodeToString(headerHash))
+	}
+
+	header, err := b.unmarshalHeader(headerBytes)
+	if err != nil {
+		return 0, err
+	}
+	b.currentEpoch = header.GetEpoch()
+
+	body, err := b.getBody(header)
+	if err != nil {
+		return 0, err
+	}
+
+	pool, err := b.getPool(header, body)
+	if err != nil {
+		return 0, err
+	}
+
+	args := &indexer.ArgsSaveBlockData{
+		HeaderHash:             headerHash,
+		Body:                   body,
+		Header:                 header,
+		SignersIndexes:         b.getSignersIndexes(header, headerHash),
+		NotarizedHeadersHashes: getNotarizedHeadersHashes(header),
+		TransactionsPool:       pool,
+	}
+	b.outportHandler.SaveBlock(args)
+	b.outportHandler.FinalizedBlock(headerHash)
+
+	log.Debug("backfilled block", "hash", headerHash, "nonce", nonce, "round", header.GetRound())
+
+	return len(pool.Txs) + len(pool.Scrs) + len(pool.Rewards) + len(pool.Invalid) + len(pool.Receipts), nil
+}
+
+func (b *backfiller) unmarshalHeader(headerBytes []byte) (data.HeaderHandler, error) {
+	if b.shardCoordinator.SelfId() != core.MetachainShardId {
+		return process.CreateShardHeader(b.marshalizer, headerBytes)
+	}
+
+	metaBlock := &block.MetaBlock{}
+	err := b.marshalizer.Unmarshal(metaBlock, headerBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return metaBlock, nil
+}
+
+func (b *backfiller) getBody(header data.HeaderHandler) (*block.Body, error) {
+	miniBlockHeaders := header.GetMiniBlockHeaderHandlers()
+	hashes := make([][]byte, 0, len(miniBlockHeaders))
+	for _, miniBlockHeader := range miniBlockHeaders {
+		hashes = append(hashes, miniBlockHeader.GetHash())
+	}
+
+	miniBlocksBytes := b.getBulkFromEpochs(dataRetriever.MiniBlockUnit, hashes)
+	if len(miniBlocksBytes) != len(hashes) {
+		return nil, fmt.Errorf("%w, found %d out of %d", ErrMissingMiniBlocks, len(miniBlocksBytes), len(hashes))
+	}
+
+	body := &block.Body{
+		MiniBlocks: make([]*block.MiniBlock, 0, len(hashes)),
+	}
+	for _, hash := range hashes {
+		miniBlock := &block.MiniBlock{}
+		err := b.marshalizer.Unmarshal(miniBlock, miniBlocksBytes[string(hash)])
+		if err != nil {
+			return nil, err
+		}
+
+		body.MiniBlocks = append(body.MiniBlocks, miniBlock)
+	}
+
+	return body, nil
+}
+
+func (b *backfiller) getPool(header data.HeaderHandler, body *block.Body) (*indexer.Pool, error) {
+	pool := &indexer.Pool{
+		Txs:      make(map[string]data.TransactionHandler),
+		Scrs:     make(map[string]data.TransactionHandler),
+		Rewards:  make(map[string]data.TransactionHandler),
+		Invalid:  make(map[string]data.TransactionHandler),
+		Receipts: make(map[string]data.TransactionHandler),
+	}
+
+	miniBlocks, err := b.getCreatedInShardMiniBlocks(header)
+	if err != nil {
+		return nil, err
+	}
+	miniBlocks = append(miniBlocks, body.MiniBlocks...)
+
+	for _, miniBlock := range miniBlocks {
+		err = b.addMiniBlockTxs(pool, miniBlock)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	pool.Logs, err = b.getLogs(pool)
+	if err != nil {
+		return nil, err
+	}
+
+	return pool, nil
+}
+
+// getCreatedInShardMiniBlocks returns the receipts and the intra shard smart contract results miniblocks, which are
+// not part of the block body, being saved separately under the receipts hash
+func (b *backfiller) getCreatedInShardMiniBlocks(header data.HeaderHandler) ([]*block.MiniBlock, error) {
+	if len(header.GetReceiptsHash()) == 0 {
+		return nil, nil
+	}
+
+	receiptsBytes, err := b.getFromEpochs(dataRetriever.ReceiptsUnit, header.GetReceiptsHash())
+	if err != nil {
+		// nothing is saved when the block did not create any receipts or intra shard smart contract results
+		return nil, nil
+	}
+
+	receiptsBatch := &batch.Batch{}
+	err = b.marshalizer.Unmarshal(receiptsBatch, receiptsBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	miniBlocks := make([]*block.MiniBlock, 0, len(receiptsBatch.Data))
+	for _, miniBlockBytes := range receiptsBatch.Data {
+		miniBlock := &block.MiniBlock{}
+		err = b.marshalizer.Unmarshal(miniBlock, miniBlockBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		miniBlocks = append(miniBlocks, miniBlock)
+	}
+
+	return miniBlocks, nil
+}
+
+func (b *backfiller) addMiniBlockTxs(pool *indexer.Pool, miniBlock *block.MiniBlock) error {
+	var unit dataRetriever.UnitType
+	var txs map[string]data.TransactionHandler
+	var createTx func() data.TransactionHandler
+
+	switch miniBlock.Type {
+	case block.TxBlock:
+		unit, txs = dataRetriever.TransactionUnit, pool.Txs
+		createTx = func() data.TransactionHandler { return &transaction.Transaction{} }
+	case block.InvalidBlock:
+		unit, txs = dataRetriever.TransactionUnit, pool.Invalid
+		createTx = func() data.TransactionHandler { return &transaction.Transaction{} }
+	case block.SmartContractResultBlock:
+		unit, txs = dataRetriever.UnsignedTransactionUnit, pool.Scrs
+		createTx = func() data.TransactionHandler { return &smartContractResult.SmartContractResult{} }
+	case block.ReceiptBlock:
+		unit, txs = dataRetriever.UnsignedTransactionUnit, pool.Receipts
+		createTx = func() data.TransactionHandler { return &receipt.Receipt{} }
+	case block.RewardsBlock:
+		unit, txs = dataRetriever.RewardTransactionUnit, pool.Rewards
+		createTx = func() data.TransactionHandler { return &rewardTx.RewardTx{} }
+	default:
+		// the peer changes miniblocks do not hold transactions
+		return nil
+	}
+
+	txsBytes := b.getBulkFromEpochs(unit, miniBlock.TxHashes)
+	if len(txsBytes) != len(miniBlock.TxHashes) {
+		return fmt.Errorf("%w, found %d out of %d in a miniblock of type %s",
+			ErrMissingTransactions, len(txsBytes), len(miniBlock.TxHashes), miniBlock.Type.String())
+	}
+
+	for _, txHash := range miniBlock.TxHashes {
+		tx := createTx()
+		err := b.marshalizer.Unmarshal(tx, txsBytes[string(txHash)])
+		if err != nil {
+			return err
+		}
+
+		txs[string(txHash)] = tx
+	}
+
+	return nil
+}
+
+func (b *backfiller) getLogs(pool *indexer.Pool) ([]*data.LogData, error) {
+	hashes := make([][]byte, 0, len(pool.Txs)+len(pool.Scrs))
+	for txHash := range pool.Txs {
+		hashes = append(hashes, []byte(txHash))
+	}
+	for scrHash := range pool.Scrs {
+		hashes = append(hashes, []byte(scrHash))
+	}
+
+	logsBytes := b.getBulkFromEpochs(dataRetriever.TxLogsUnit, hashes)
+	logs := make([]*data.LogData, 0, len(logsBytes))
+	for _, hash := range hashes {
+		logBytes, found := logsBytes[string(hash)]
+		if !found {
+			continue
+		}
+
+		txLog := &transaction.Log{}
+		err := b.marshalizer.Unmarshal(txLog, logBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		logs = append(logs, &data.LogData{
+			LogHandler: txLog,
+			TxHash:     string(hash),
+		})
+	}
+
+	return logs, nil
+}
+
+func (b *backfiller) getSignersIndexes(header data.HeaderHandler, headerHash []byte) []uint64 {
+	shardID := b.shardCoordinator.SelfId()
+	epoch := header.GetEpoch()
+	if shardID != core.MetachainShardId && header.IsStartOfEpochBlock() && epoch > 0 {
+		epoch = epoch - 1
+	}
+
+	publicKeys, err := b.nodesCoordinator.GetConsensusValidatorsPublicKeys(header.GetPrevRandSeed(), header.GetRound(), shardID, epoch)
+	if err != nil {
+		log.Trace("backfiller.getSignersIndexes: GetConsensusValidatorsPublicKeys",
+			"hash", headerHash,
+			"epoch", epoch,
+			"error", err.Error())
+		return nil
+	}
+
+	signersIndexes, err := b.nodesCoordinator.GetValidatorsIndexes(publicKeys, epoch)
+	if err != nil {
+		log.Trace("backfiller.getSignersIndexes: GetValidatorsIndexes",
+			"hash", headerHash,
+			"epoch", epoch,
+			"error", err.Error())
+		return nil
+	}
+
+	return signersIndexes
+}
+
+func getNotarizedHeadersHashes(header data.HeaderHandler) []string {
+	metaBlock, ok := header.(*block.MetaBlock)
+	if !ok {
+		return nil
+	}
+
+	notarizedHeadersHashes := make([]string, 0, len(metaBlock.ShardInfo))
+	for _, shardData := range metaBlock.ShardInfo {
+		notarizedHeadersHashes = append(notarizedHeadersHashes, hex.EncodeToString(shardData.HeaderHash))
+	}
+
+	return notarizedHeadersHashes
+}
+
+// candidateEpochs returns the epochs in which the data of the current block can be found: the data of the blocks
+// processed around an epoch change can be saved in the storers of the neighbouring epochs
+func (b *backfiller) candidateEpochs() []uint32 {
+	epochs := []uint32{b.currentEpoch, b.currentEpoch + 1}
+	if b.currentEpoch > 0 {
+		epochs = append(epochs, b.currentEpoch-1)
+	}
+
+	return epochs
+}
+
+func (b *backfiller) getFromEpochs(unit dataRetriever.UnitType, key []byte) ([]byte, error) {
+	storer := b.store.GetStorer(unit)
+
+	var err error
+	var buff []byte
+	for _, epoch := range b.candidateEpochs() {
+		buff, err = storer.GetFromEpoch(key, epoch)
+		if err == nil {
+			return buff, nil
+		}
+	}
+
+	return nil, err
+}
+
+func (b *backfiller) getBulkFromEpochs(unit dataRetriever.UnitType, keys [][]byte) map[string][]byte {
+	results := make(map[string][]byte, len(keys))
+	if len(keys) == 0 {
+		return results
+	}
+
+	storer := b.store.GetStorer(unit)
+	missingKeys := keys
+	for _, epoch := range b.candidateEpochs() {
+		found, err := storer.GetBulkFromEpoch(missingKeys, epoch)
+		if err != nil {
+			log.Trace("backfiller.getBulkFromEpochs", "unit", unit.String(), "epoch", epoch, "error", err.Error())
+			continue
+		}
+
+		for key, value := range found {
+			results[key] = value
+		}
+
+		missingKeys = getMissingKeys(missingKeys, results)
+		if len(missingKeys) == 0 {
+			break
+		}
+	}
+
+	return results
+}
+
+func getMissingKeys(keys [][]byte, found map[string][]byte) [][]byte {
+	missingKeys := make([][]byte, 0)
+	for _, key := range keys {
+		_, ok := found[string(key)]
+		if !ok {
+			missingKeys = append(missingKeys, key)
+		}
+	}
+
+	return missingKeys
+}
+
+func (b *backfiller) isStopped() bool {
+	return atomic.LoadUint32(&b.stopped) == 1
+}
+
+// Close signals the backfiller to stop after the block currently re-emitted
+func (b *backfiller) Close() error {
+	atomic.StoreUint32(&b.stopped, 1)
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (b *backfiller) IsInterfaceNil() bool {
+	return b == nil
+}
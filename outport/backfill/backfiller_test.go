@@ -0,0 +1,404 @@
+package backfill
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/batch"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go-core/data/receipt"
+	"github.com/ElrondNetwork/elrond-go-core/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go-core/data/typeConverters/uint64ByteSlice"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericMocks"
+	storageStubs "github.com/ElrondNetwork/elrond-go/testscommon/storage"
+	"github.com/stretchr/testify/require"
+)
+
+var testUnits = []dataRetriever.UnitType{
+	dataRetriever.TransactionUnit,
+	dataRetriever.MiniBlockUnit,
+	dataRetriever.BlockHeaderUnit,
+	dataRetriever.UnsignedTransactionUnit,
+	dataRetriever.RewardTransactionUnit,
+	dataRetriever.TxLogsUnit,
+	dataRetriever.ReceiptsUnit,
+	dataRetriever.ShardHdrNonceHashDataUnit,
+}
+
+type testStorage struct {
+	t           *testing.T
+	store       *dataRetriever.ChainStorer
+	marshalizer *testscommon.MarshalizerMock
+}
+
+func newTestStorage(t *testing.T) *testStorage {
+	store := dataRetriever.NewChainStorer()
+	for _, unit := range testUnits {
+		store.AddStorer(unit, genericMocks.NewStorerMock(unit.String(), 0))
+	}
+
+	return &testStorage{
+		t:           t,
+		store:       store,
+		marshalizer: &testscommon.MarshalizerMock{},
+	}
+}
+
+func (ts *testStorage) put(unit dataRetriever.UnitType, key []byte, value interface{}, epoch uint32) {
+	buff, err := ts.marshalizer.Marshal(value)
+	require.Nil(ts.t, err)
+
+	storer := ts.store.GetStorer(unit).(*genericMocks.StorerMock)
+	_ = storer.PutInEpoch(key, buff, epoch)
+}
+
+func (ts *testStorage) moveToEpoch(unit dataRetriever.UnitType, key []byte, fromEpoch uint32, toEpoch uint32) {
+	storer := ts.store.GetStorer(unit).(*genericMocks.StorerMock)
+	value, err := storer.GetFromEpoch(key, fromEpoch)
+	require.Nil(ts.t, err)
+
+	storer.GetEpochData(fromEpoch).Remove(string(key))
+	_ = storer.PutInEpoch(key, value, toEpoch)
+}
+
+func (ts *testStorage) putBlock(nonce uint64, epoch uint32, headerHash []byte, header *block.Header, miniBlocks map[string]*block.MiniBlock) {
+	for hash, miniBlock := range miniBlocks {
+		ts.put(dataRetriever.MiniBlockUnit, []byte(hash), miniBlock, epoch)
+		header.MiniBlockHeaders = append(header.MiniBlockHeaders, block.MiniBlockHeader{
+			Hash:            []byte(hash),
+			Type:            miniBlock.Type,
+			TxCount:         uint32(len(miniBlock.TxHashes)),
+			SenderShardID:   miniBlock.SenderShardID,
+			ReceiverShardID: miniBlock.ReceiverShardID,
+		})
+	}
+	header.Nonce = nonce
+	header.Epoch = epoch
+	ts.put(dataRetriever.BlockHeaderUnit, headerHash, header, epoch)
+
+	nonceStorer := ts.store.GetStorer(dataRetriever.ShardHdrNonceHashDataUnit).(*genericMocks.StorerMock)
+	_ = nonceStorer.PutInEpoch(uint64ByteSlice.NewBigEndianConverter().ToByteSlice(nonce), headerHash, 0)
+}
+
+func createMockArgsBackfiller(store dataRetriever.StorageService, outportHandler *testscommon.OutportStub) ArgsBackfiller {
+	return ArgsBackfiller{
+		Store:                    store,
+		Marshalizer:              &testscommon.MarshalizerMock{},
+		Uint64ByteSliceConverter: uint64ByteSlice.NewBigEndianConverter(),
+		ShardCoordinator:         testscommon.NewMultiShardsCoordinatorMock(2),
+		NodesCoordinator:         mock.NewNodesCoordinatorMock(),
+		OutportHandler:           outportHandler,
+	}
+}
+
+func createOutportStub(savedBlocks *[]*indexer.ArgsSaveBlockData) *testscommon.OutportStub {
+	return &testscommon.OutportStub{
+		HasDriversCalled: func() bool {
+			return true
+		},
+		SaveBlockCalled: func(args *indexer.ArgsSaveBlockData) {
+			*savedBlocks = append(*savedBlocks, args)
+		},
+	}
+}
+
+func TestNewBackfiller(t *testing.T) {
+	t.Parallel()
+
+	store := dataRetriever.NewChainStorer()
+	tests := []struct {
+		name     string
+		argsFunc func() ArgsBackfiller
+		exError  error
+	}{
+		{
+			name: "NilStorageService",
+			argsFunc: func() ArgsBackfiller {
+				args := createMockArgsBackfiller(store, createOutportStub(nil))
+				args.Store = nil
+				return args
+			},
+			exError: ErrNilStorageService,
+		},
+		{
+			name: "NilMarshalizer",
+			argsFunc: func() ArgsBackfiller {
+				args := createMockArgsBackfiller(store, createOutportStub(nil))
+				args.Marshalizer = nil
+				return args
+			},
+			exError: ErrNilMarshalizer,
+		},
+		{
+			name: "NilUint64ByteSliceConverter",
+			argsFunc: func() ArgsBackfiller {
+				args := createMockArgsBackfiller(store, createOutportStub(nil))
+				args.Uint64ByteSliceConverter = nil
+				return args
+			},
+			exError: ErrNilUint64ByteSliceConverter,
+		},
+		{
+			name: "NilShardCoordinator",
+			argsFunc: func() ArgsBackfiller {
+				args := createMockArgsBackfiller(store, createOutportStub(nil))
+				args.ShardCoordinator = nil
+				return args
+			},
+			exError: ErrNilShardCoordinator,
+		},
+		{
+			name: "NilNodesCoordinator",
+			argsFunc: func() ArgsBackfiller {
+				args := createMockArgsBackfiller(store, createOutportStub(nil))
+				args.NodesCoordinator = nil
+				return args
+			},
+			exError: ErrNilNodesCoordinator,
+		},
+		{
+			name: "NilOutportHandler",
+			argsFunc: func() ArgsBackfiller {
+				args := createMockArgsBackfiller(store, createOutportStub(nil))
+				args.OutportHandler = nil
+				return args
+			},
+			exError: ErrNilOutportHandler,
+		},
+		{
+			name: "NoDrivers",
+			argsFunc: func() ArgsBackfiller {
+				return createMockArgsBackfiller(store, &testscommon.OutportStub{})
+			},
+			exError: ErrNoDrivers,
+		},
+		{
+			name: "InvalidNoncesRange",
+			argsFunc: func() ArgsBackfiller {
+				args := createMockArgsBackfiller(store, createOutportStub(nil))
+				args.FromNonce = 10
+				args.ToNonce = 5
+				return args
+			},
+			exError: ErrInvalidNoncesRange,
+		},
+		{
+			name: "AllOkShouldWork",
+			argsFunc: func() ArgsBackfiller {
+				return createMockArgsBackfiller(store, createOutportStub(nil))
+			},
+			exError: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := NewBackfiller(tt.argsFunc())
+			require.True(t, errors.Is(err, tt.exError))
+			require.Equal(t, tt.exError == nil, !check.IfNil(b))
+		})
+	}
+}
+
+func TestBackfiller_RunShouldReemitTheStoredBlocks(t *testing.T) {
+	t.Parallel()
+
+	ts := newTestStorage(t)
+
+	tx := &transaction.Transaction{Nonce: 1, Data: []byte("tx")}
+	scr := &smartContractResult.SmartContractResult{Nonce: 2, Data: []byte("scr")}
+	rcpt := &receipt.Receipt{Data: []byte("receipt")}
+	txLog := &transaction.Log{Address: []byte("address")}
+	ts.put(dataRetriever.TransactionUnit, []byte("tx"), tx, 0)
+	ts.put(dataRetriever.UnsignedTransactionUnit, []byte("scr"), scr, 0)
+	ts.put(dataRetriever.UnsignedTransactionUnit, []byte("receipt"), rcpt, 0)
+	ts.put(dataRetriever.TxLogsUnit, []byte("tx"), txLog, 0)
+
+	receiptsMiniBlock, _ := ts.marshalizer.Marshal(&block.MiniBlock{TxHashes: [][]byte{[]byte("receipt")}, Type: block.ReceiptBlock})
+	ts.put(dataRetriever.ReceiptsUnit, []byte("receipts hash"), &batch.Batch{Data: [][]byte{receiptsMiniBlock}}, 0)
+
+	ts.putBlock(1, 0, []byte("hash1"), &block.Header{ReceiptsHash: []byte("receipts hash")}, map[string]*block.MiniBlock{
+		"txs mb":  {TxHashes: [][]byte{[]byte("tx")}, Type: block.TxBlock},
+		"scrs mb": {TxHashes: [][]byte{[]byte("scr")}, Type: block.SmartContractResultBlock, ReceiverShardID: 1},
+	})
+	// the second block is saved in the storers of the next epoch, as it happens around an epoch change
+	ts.put(dataRetriever.TransactionUnit, []byte("tx2"), &transaction.Transaction{Nonce: 2}, 1)
+	ts.putBlock(2, 0, []byte("hash2"), &block.Header{}, map[string]*block.MiniBlock{
+		"txs mb 2": {TxHashes: [][]byte{[]byte("tx2")}, Type: block.TxBlock},
+	})
+	ts.moveToEpoch(dataRetriever.BlockHeaderUnit, []byte("hash2"), 0, 1)
+	ts.moveToEpoch(dataRetriever.MiniBlockUnit, []byte("txs mb 2"), 0, 1)
+
+	savedBlocks := make([]*indexer.ArgsSaveBlockData, 0)
+	b, _ := NewBackfiller(createMockArgsBackfiller(ts.store, createOutportStub(&savedBlocks)))
+
+	stats, err := b.Run()
+	require.Nil(t, err)
+	require.Equal(t, &Statistics{
+		FirstNonce:   1,
+		LastNonce:    2,
+		NumBlocks:    2,
+		NumTxs:       4,
+		WasCompleted: true,
+	}, stats)
+	require.Len(t, savedBlocks, 2)
+
+	first := savedBlocks[0]
+	require.Equal(t, []byte("hash1"), first.HeaderHash)
+	require.Equal(t, uint64(1), first.Header.GetNonce())
+	require.Len(t, first.Body.(*block.Body).MiniBlocks, 2)
+	require.Equal(t, tx, first.TransactionsPool.Txs["tx"])
+	require.Equal(t, scr, first.TransactionsPool.Scrs["scr"])
+	require.Equal(t, rcpt, first.TransactionsPool.Receipts["receipt"])
+	require.Len(t, first.TransactionsPool.Logs, 1)
+	require.Equal(t, "tx", first.TransactionsPool.Logs[0].TxHash)
+	require.Equal(t, txLog, first.TransactionsPool.Logs[0].LogHandler)
+
+	second := savedBlocks[1]
+	require.Equal(t, []byte("hash2"), second.HeaderHash)
+	require.Equal(t, &transaction.Transaction{Nonce: 2}, second.TransactionsPool.Txs["tx2"])
+}
+
+func TestBackfiller_RunShouldStartWithTheEpochStartBlock(t *testing.T) {
+	t.Parallel()
+
+	ts := newTestStorage(t)
+	for nonce := uint64(1); nonce <= 5; nonce++ {
+		ts.putBlock(nonce, 1, []byte{byte(nonce)}, &block.Header{}, nil)
+	}
+	ts.put(dataRetriever.BlockHeaderUnit, []byte(core.EpochStartIdentifier(1)), &block.Header{Nonce: 3, Epoch: 1}, 1)
+
+	savedBlocks := make([]*indexer.ArgsSaveBlockData, 0)
+	args := createMockArgsBackfiller(ts.store, createOutportStub(&savedBlocks))
+	args.StartEpoch = 1
+	args.ToNonce = 4
+	b, _ := NewBackfiller(args)
+
+	stats, err := b.Run()
+	require.Nil(t, err)
+	require.Equal(t, uint64(3), stats.FirstNonce)
+	require.Equal(t, uint64(4), stats.LastNonce)
+	require.Len(t, savedBlocks, 2)
+	require.Equal(t, uint64(3), savedBlocks[0].Header.GetNonce())
+	require.Equal(t, uint64(4), savedBlocks[1].Header.GetNonce())
+}
+
+func TestBackfiller_RunFromNonceInAnOldEpochShouldWork(t *testing.T) {
+	t.Parallel()
+
+	ts := newTestStorage(t)
+	epochOfNonce := []uint32{0, 0, 0, 1, 1, 2, 2, 3, 3}
+	for nonce := uint64(1); nonce <= uint64(len(epochOfNonce)); nonce++ {
+		ts.putBlock(nonce, epochOfNonce[nonce-1], []byte{byte(nonce)}, &block.Header{}, nil)
+	}
+	ts.put(dataRetriever.BlockHeaderUnit, []byte(core.EpochStartIdentifier(1)), &block.Header{Nonce: 4, Epoch: 1}, 1)
+	ts.put(dataRetriever.BlockHeaderUnit, []byte(core.EpochStartIdentifier(2)), &block.Header{Nonce: 6, Epoch: 2}, 2)
+	ts.put(dataRetriever.BlockHeaderUnit, []byte(core.EpochStartIdentifier(3)), &block.Header{Nonce: 8, Epoch: 3}, 3)
+
+	savedBlocks := make([]*indexer.ArgsSaveBlockData, 0)
+	args := createMockArgsBackfiller(ts.store, createOutportStub(&savedBlocks))
+	args.FromNonce = 7
+	b, _ := NewBackfiller(args)
+
+	stats, err := b.Run()
+	require.Nil(t, err)
+	require.Equal(t, &Statistics{
+		FirstNonce:   7,
+		LastNonce:    9,
+		NumBlocks:    3,
+		WasCompleted: true,
+	}, stats)
+	require.Len(t, savedBlocks, 3)
+	require.Equal(t, uint32(2), savedBlocks[0].Header.GetEpoch())
+	require.Equal(t, uint32(3), savedBlocks[2].Header.GetEpoch())
+}
+
+func TestBackfiller_RunStorageErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ts := newTestStorage(t)
+	ts.putBlock(1, 0, []byte("hash1"), &block.Header{}, nil)
+	expectedErr := errors.New("expected error")
+	nonceStorer := ts.store.GetStorer(dataRetriever.ShardHdrNonceHashDataUnit)
+	ts.store.AddStorer(dataRetriever.ShardHdrNonceHashDataUnit, &storageStubs.StorerStub{
+		GetCalled: func(key []byte) ([]byte, error) {
+			if key[len(key)-1] == 2 {
+				return nil, expectedErr
+			}
+
+			return nonceStorer.Get(key)
+		},
+	})
+
+	savedBlocks := make([]*indexer.ArgsSaveBlockData, 0)
+	b, _ := NewBackfiller(createMockArgsBackfiller(ts.store, createOutportStub(&savedBlocks)))
+
+	stats, err := b.Run()
+	require.True(t, errors.Is(err, expectedErr))
+	require.False(t, stats.WasCompleted)
+	require.Equal(t, uint64(1), stats.NumBlocks)
+}
+
+func TestBackfiller_RunMissingBlockInRangeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ts := newTestStorage(t)
+	ts.putBlock(1, 0, []byte("hash1"), &block.Header{}, nil)
+
+	savedBlocks := make([]*indexer.ArgsSaveBlockData, 0)
+	args := createMockArgsBackfiller(ts.store, createOutportStub(&savedBlocks))
+	args.ToNonce = 2
+	b, _ := NewBackfiller(args)
+
+	stats, err := b.Run()
+	require.NotNil(t, err)
+	require.False(t, stats.WasCompleted)
+	require.Equal(t, uint64(1), stats.NumBlocks)
+}
+
+func TestBackfiller_RunMissingTransactionsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ts := newTestStorage(t)
+	ts.putBlock(1, 0, []byte("hash1"), &block.Header{}, map[string]*block.MiniBlock{
+		"txs mb": {TxHashes: [][]byte{[]byte("missing tx")}, Type: block.TxBlock},
+	})
+
+	savedBlocks := make([]*indexer.ArgsSaveBlockData, 0)
+	b, _ := NewBackfiller(createMockArgsBackfiller(ts.store, createOutportStub(&savedBlocks)))
+
+	_, err := b.Run()
+	require.True(t, errors.Is(err, ErrMissingTransactions))
+	require.Len(t, savedBlocks, 0)
+}
+
+func TestBackfiller_CloseShouldInterruptTheRun(t *testing.T) {
+	t.Parallel()
+
+	ts := newTestStorage(t)
+	for nonce := uint64(1); nonce <= 5; nonce++ {
+		ts.putBlock(nonce, 0, []byte{byte(nonce)}, &block.Header{}, nil)
+	}
+
+	var b *backfiller
+	savedBlocks := make([]*indexer.ArgsSaveBlockData, 0)
+	outportHandler := createOutportStub(&savedBlocks)
+	outportHandler.SaveBlockCalled = func(args *indexer.ArgsSaveBlockData) {
+		savedBlocks = append(savedBlocks, args)
+		_ = b.Close()
+	}
+	b, _ = NewBackfiller(createMockArgsBackfiller(ts.store, outportHandler))
+
+	stats, err := b.Run()
+	require.Nil(t, err)
+	require.True(t, stats.WasInterrupted)
+	require.False(t, stats.WasCompleted)
+	require.Len(t, savedBlocks, 1)
+}
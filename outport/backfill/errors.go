@@ -0,0 +1,36 @@
+package backfill
+
+import "errors"
+
+// ErrNilStorageService signals that a nil storage service has been provided
+var ErrNilStorageService = errors.New("nil storage service")
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilUint64ByteSliceConverter signals that a nil uint64 byte slice converter has been provided
+var ErrNilUint64ByteSliceConverter = errors.New("nil uint64 byte slice converter")
+
+// ErrNilShardCoordinator signals that a nil shard coordinator has been provided
+var ErrNilShardCoordinator = errors.New("nil shard coordinator")
+
+// ErrNilNodesCoordinator signals that a nil nodes coordinator has been provided
+var ErrNilNodesCoordinator = errors.New("nil nodes coordinator")
+
+// ErrNilOutportHandler signals that a nil outport handler has been provided
+var ErrNilOutportHandler = errors.New("nil outport handler")
+
+// ErrNoDrivers signals that the provided outport handler has no subscribed drivers
+var ErrNoDrivers = errors.New("the outport handler has no drivers")
+
+// ErrInvalidNoncesRange signals that an invalid nonces range has been provided
+var ErrInvalidNoncesRange = errors.New("invalid nonces range")
+
+// ErrMissingTransactions signals that some of the transactions of a block were not found in the storage
+var ErrMissingTransactions = errors.New("missing transactions")
+
+// ErrMissingMiniBlocks signals that some of the miniblocks of a block were not found in the storage
+var ErrMissingMiniBlocks = errors.New("missing miniblocks")
+
+// ErrMissingBlock signals that no block with the requested nonce was found in the storage
+var ErrMissingBlock = errors.New("missing block")
@@ -0,0 +1,26 @@
+package disabled
+
+import (
+	"github.com/ElrondNetwork/elrond-go/outport/events"
+)
+
+type disabledEventsSubscriptions struct{}
+
+// NewDisabledEventsSubscriptions will create a new instance of disabledEventsSubscriptions
+func NewDisabledEventsSubscriptions() *disabledEventsSubscriptions {
+	return new(disabledEventsSubscriptions)
+}
+
+// Subscribe returns ErrEventsSubscriptionsDisabled
+func (d *disabledEventsSubscriptions) Subscribe(_ events.SubscriptionFilter) (*events.Subscription, error) {
+	return nil, events.ErrEventsSubscriptionsDisabled
+}
+
+// Unsubscribe does nothing
+func (d *disabledEventsSubscriptions) Unsubscribe(_ *events.Subscription) {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (d *disabledEventsSubscriptions) IsInterfaceNil() bool {
+	return d == nil
+}
@@ -10,3 +10,6 @@ var ErrNilArgsOutportFactory = errors.New("nil args outport driver factory")
 
 // ErrInvalidRetrialInterval signals that an invalid retrial interval was provided
 var ErrInvalidRetrialInterval = errors.New("invalid retrial interval")
+
+// ErrNilPersisterCreator signals that a nil persister creator has been provided
+var ErrNilPersisterCreator = errors.New("nil persister creator")
@@ -0,0 +1,75 @@
+package events
+
+const (
+	// FinalizedBlockEvent is the type of the event emitted when a block becomes final
+	FinalizedBlockEvent = "finalizedBlock"
+	// RevertedBlockEvent is the type of the event emitted when an indexed block is reverted
+	RevertedBlockEvent = "revertedBlock"
+	// TransactionEvent is the type of the event emitted when the status of a transaction changes
+	TransactionEvent = "transaction"
+	// LogEvent is the type of the event emitted when a smart contract log event is generated
+	LogEvent = "log"
+)
+
+const (
+	// TransactionStatusExecuted signals that the transaction has been executed in a block which is not final yet
+	TransactionStatusExecuted = "executed"
+	// TransactionStatusInvalid signals that the transaction has been included in a block as invalid
+	TransactionStatusInvalid = "invalid"
+	// TransactionStatusFinalized signals that the block holding the transaction became final
+	TransactionStatusFinalized = "finalized"
+	// TransactionStatusReverted signals that the block holding the transaction has been reverted
+	TransactionStatusReverted = "reverted"
+)
+
+// SubscriptionFilter defines the events a subscriber is interested in
+type SubscriptionFilter struct {
+	Blocks       bool         `json:"blocks"`
+	Transactions []string     `json:"transactions"`
+	Logs         []*LogFilter `json:"logs"`
+}
+
+// LogFilter selects smart contract log events. Empty fields (and empty topics) match anything
+type LogFilter struct {
+	Address    string   `json:"address"`
+	Identifier string   `json:"identifier"`
+	Topics     [][]byte `json:"topics"`
+}
+
+// Event is the message delivered to subscribers
+type Event struct {
+	Type        string           `json:"type"`
+	Block       *BlockData       `json:"block,omitempty"`
+	Transaction *TransactionData `json:"transaction,omitempty"`
+	Log         *LogData         `json:"log,omitempty"`
+}
+
+// BlockData holds the details of a block event
+type BlockData struct {
+	Hash      string `json:"hash"`
+	Nonce     uint64 `json:"nonce"`
+	Round     uint64 `json:"round"`
+	Epoch     uint32 `json:"epoch"`
+	Shard     uint32 `json:"shard"`
+	Timestamp uint64 `json:"timestamp"`
+	NumTxs    uint32 `json:"numTxs"`
+}
+
+// TransactionData holds the details of a transaction status change
+type TransactionData struct {
+	Hash       string `json:"hash"`
+	Status     string `json:"status"`
+	BlockHash  string `json:"blockHash"`
+	BlockNonce uint64 `json:"blockNonce"`
+}
+
+// LogData holds the details of a smart contract log event
+type LogData struct {
+	TxHash     string   `json:"txHash"`
+	Address    string   `json:"address"`
+	Identifier string   `json:"identifier"`
+	Topics     [][]byte `json:"topics"`
+	Data       []byte   `json:"data"`
+	BlockHash  string   `json:"blockHash"`
+	BlockNonce uint64   `json:"blockNonce"`
+}
@@ -0,0 +1,36 @@
+package events
+
+import "errors"
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilHasher signals that a nil hasher has been provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrNilPubKeyConverter signals that a nil public key converter has been provided
+var ErrNilPubKeyConverter = errors.New("nil public key converter")
+
+// ErrInvalidMaxSubscribers signals that an invalid maximum number of subscribers has been provided
+var ErrInvalidMaxSubscribers = errors.New("invalid maximum number of subscribers")
+
+// ErrInvalidSubscriptionBufferSize signals that an invalid subscription buffer size has been provided
+var ErrInvalidSubscriptionBufferSize = errors.New("invalid subscription buffer size")
+
+// ErrTooManySubscribers signals that the maximum number of subscribers has been reached
+var ErrTooManySubscribers = errors.New("too many subscribers")
+
+// ErrEmptySubscriptionFilter signals that a subscription filter without any criteria has been provided
+var ErrEmptySubscriptionFilter = errors.New("empty subscription filter")
+
+// ErrTooManyTransactionsInFilter signals that too many transaction hashes have been provided in a subscription filter
+var ErrTooManyTransactionsInFilter = errors.New("too many transactions in subscription filter")
+
+// ErrTooManyLogFilters signals that too many log filters have been provided in a subscription filter
+var ErrTooManyLogFilters = errors.New("too many log filters in subscription filter")
+
+// ErrInvalidTransactionHash signals that an invalid transaction hash has been provided in a subscription filter
+var ErrInvalidTransactionHash = errors.New("invalid transaction hash")
+
+// ErrEventsSubscriptionsDisabled signals that the events subscriptions are not enabled
+var ErrEventsSubscriptionsDisabled = errors.New("events subscriptions are not enabled")
@@ -0,0 +1,373 @@
+package events
+
+import (
+	"encoding/hex"
+	"sort"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+)
+
+var log = logger.GetOrCreate("outport/events")
+
+// maxPendingBlocks bounds the number of saved, not yet finalized blocks that are kept in memory
+const maxPendingBlocks = 1000
+
+// ArgsEventsDriver holds the arguments needed to create a new events driver
+type ArgsEventsDriver struct {
+	Marshalizer            marshal.Marshalizer
+	Hasher                 hashing.Hasher
+	AddressPubKeyConverter core.PubkeyConverter
+	MaxSubscribers         int
+	SubscriptionBufferSize int
+}
+
+type pendingBlock struct {
+	block    *BlockData
+	txHashes [][]byte
+}
+
+// eventsDriver is an in-process outport driver that streams blocks, transactions and logs events to its subscribers.
+// Slow subscribers are dropped, so that the outport is never blocked.
+type eventsDriver struct {
+	marshalizer            marshal.Marshalizer
+	hasher                 hashing.Hasher
+	addressPubKeyConverter core.PubkeyConverter
+	maxSubscribers         int
+	subscriptionBufferSize int
+
+	mutSubscriptions sync.Mutex
+	subscriptions    map[uint64]*Subscription
+	lastID           uint64
+
+	mutPendingBlocks sync.Mutex
+	pendingBlocks    map[string]*pendingBlock
+}
+
+// NewEventsDriver creates a new events driver
+func NewEventsDriver(args ArgsEventsDriver) (*eventsDriver, error) {
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+	if check.IfNil(args.AddressPubKeyConverter) {
+		return nil, ErrNilPubKeyConverter
+	}
+	if args.MaxSubscribers < 1 {
+		return nil, ErrInvalidMaxSubscribers
+	}
+	if args.SubscriptionBufferSize < 1 {
+		return nil, ErrInvalidSubscriptionBufferSize
+	}
+
+	return &eventsDriver{
+		marshalizer:            args.Marshalizer,
+		hasher:                 args.Hasher,
+		addressPubKeyConverter: args.AddressPubKeyConverter,
+		maxSubscribers:         args.MaxSubscribers,
+		subscriptionBufferSize: args.SubscriptionBufferSize,
+		subscriptions:          make(map[uint64]*Subscription),
+		pendingBlocks:          make(map[string]*pendingBlock),
+	}, nil
+}
+
+// Subscribe registers a new subscription for the events matching the provided filter
+func (ed *eventsDriver) Subscribe(filter SubscriptionFilter) (*Subscription, error) {
+	ed.mutSubscriptions.Lock()
+	defer ed.mutSubscriptions.Unlock()
+
+	if len(ed.subscriptions) >= ed.maxSubscribers {
+		return nil, ErrTooManySubscribers
+	}
+
+	subscription, err := newSubscription(ed.lastID+1, filter, ed.subscriptionBufferSize)
+	if err != nil {
+		return nil, err
+	}
+
+	ed.lastID++
+	ed.subscriptions[subscription.id] = subscription
+	log.Debug("eventsDriver: new subscription", "id", subscription.id, "num subscriptions", len(ed.subscriptions))
+
+	return subscription, nil
+}
+
+// Unsubscribe ends the provided subscription
+func (ed *eventsDriver) Unsubscribe(subscription *Subscription) {
+	if subscription == nil {
+		return
+	}
+
+	ed.mutSubscriptions.Lock()
+	delete(ed.subscriptions, subscription.id)
+	ed.mutSubscriptions.Unlock()
+
+	subscription.close()
+}
+
+// SaveBlock emits the transactions and logs events of the provided block and keeps the block until it is finalized
+func (ed *eventsDriver) SaveBlock(args *indexer.ArgsSaveBlockData) error {
+	if args == nil || check.IfNil(args.Header) {
+		return nil
+	}
+
+	block := ed.createBlockData(args.HeaderHash, args.Header)
+	pool := args.TransactionsPool
+	if pool == nil {
+		pool = &indexer.Pool{}
+	}
+
+	txHashes := make([][]byte, 0, len(pool.Txs)+len(pool.Scrs)+len(pool.Rewards)+len(pool.Invalid))
+	for _, txs := range []map[string]data.TransactionHandler{pool.Txs, pool.Scrs, pool.Rewards} {
+		for txHash := range txs {
+			txHashes = append(txHashes, []byte(txHash))
+		}
+	}
+	ed.emitTransactionsEvents(txHashes, block, TransactionStatusExecuted)
+
+	invalidTxHashes := make([][]byte, 0, len(pool.Invalid))
+	for txHash := range pool.Invalid {
+		invalidTxHashes = append(invalidTxHashes, []byte(txHash))
+	}
+	ed.emitTransactionsEvents(invalidTxHashes, block, TransactionStatusInvalid)
+
+	ed.emitLogsEvents(pool.Logs, block)
+
+	ed.addPendingBlock(&pendingBlock{
+		block:    block,
+		txHashes: append(txHashes, invalidTxHashes...),
+	})
+
+	return nil
+}
+
+// RevertIndexedBlock emits the reverted block event, along with the reverted status of the block's transactions
+func (ed *eventsDriver) RevertIndexedBlock(header data.HeaderHandler, _ data.BodyHandler) error {
+	if check.IfNil(header) {
+		return nil
+	}
+
+	headerHash, err := core.CalculateHash(ed.marshalizer, ed.hasher, header)
+	if err != nil {
+		return err
+	}
+
+	ed.mutPendingBlocks.Lock()
+	pending, ok := ed.pendingBlocks[hex.EncodeToString(headerHash)]
+	delete(ed.pendingBlocks, hex.EncodeToString(headerHash))
+	ed.mutPendingBlocks.Unlock()
+
+	if !ok {
+		pending = &pendingBlock{
+			block: ed.createBlockData(headerHash, header),
+		}
+	}
+
+	ed.emitBlockEvent(RevertedBlockEvent, pending.block)
+	ed.emitTransactionsEvents(pending.txHashes, pending.block, TransactionStatusReverted)
+
+	return nil
+}
+
+// FinalizedBlock emits the finalized block event for the provided block and for all the pending blocks with
+// a lower nonce, along with the finalized status of their transactions
+func (ed *eventsDriver) FinalizedBlock(headerHash []byte) error {
+	finalizedBlocks := ed.extractFinalizedBlocks(headerHash)
+	for _, pending := range finalizedBlocks {
+		ed.emitBlockEvent(FinalizedBlockEvent, pending.block)
+		ed.emitTransactionsEvents(pending.txHashes, pending.block, TransactionStatusFinalized)
+	}
+
+	return nil
+}
+
+func (ed *eventsDriver) extractFinalizedBlocks(headerHash []byte) []*pendingBlock {
+	ed.mutPendingBlocks.Lock()
+	defer ed.mutPendingBlocks.Unlock()
+
+	finalized, ok := ed.pendingBlocks[hex.EncodeToString(headerHash)]
+	if !ok {
+		log.Trace("eventsDriver.FinalizedBlock: unknown block", "hash", headerHash)
+		return nil
+	}
+
+	finalizedBlocks := make([]*pendingBlock, 0)
+	for hash, pending := range ed.pendingBlocks {
+		if pending.block.Nonce > finalized.block.Nonce {
+			continue
+		}
+
+		finalizedBlocks = append(finalizedBlocks, pending)
+		delete(ed.pendingBlocks, hash)
+	}
+
+	sort.Slice(finalizedBlocks, func(i, j int) bool {
+		return finalizedBlocks[i].block.Nonce < finalizedBlocks[j].block.Nonce
+	})
+
+	return finalizedBlocks
+}
+
+func (ed *eventsDriver) addPendingBlock(pending *pendingBlock) {
+	ed.mutPendingBlocks.Lock()
+	defer ed.mutPendingBlocks.Unlock()
+
+	if len(ed.pendingBlocks) >= maxPendingBlocks {
+		ed.removeOldestPendingBlock()
+	}
+
+	ed.pendingBlocks[pending.block.Hash] = pending
+}
+
+// removeOldestPendingBlock should be called under mutPendingBlocks
+func (ed *eventsDriver) removeOldestPendingBlock() {
+	oldestHash := ""
+	oldestNonce := uint64(0)
+	for hash, pending := range ed.pendingBlocks {
+		if oldestHash == "" || pending.block.Nonce < oldestNonce {
+			oldestHash = hash
+			oldestNonce = pending.block.Nonce
+		}
+	}
+
+	delete(ed.pendingBlocks, oldestHash)
+}
+
+func (ed *eventsDriver) createBlockData(headerHash []byte, header data.HeaderHandler) *BlockData {
+	return &BlockData{
+		Hash:      hex.EncodeToString(headerHash),
+		Nonce:     header.GetNonce(),
+		Round:     header.GetRound(),
+		Epoch:     header.GetEpoch(),
+		Shard:     header.GetShardID(),
+		Timestamp: header.GetTimeStamp(),
+		NumTxs:    header.GetTxCount(),
+	}
+}
+
+func (ed *eventsDriver) emitBlockEvent(eventType string, block *BlockData) {
+	event := &Event{
+		Type:  eventType,
+		Block: block,
+	}
+
+	ed.broadcast(event, func(subscription *Subscription) bool {
+		return subscription.blocks
+	})
+}
+
+func (ed *eventsDriver) emitTransactionsEvents(txHashes [][]byte, block *BlockData, status string) {
+	for _, txHash := range txHashes {
+		event := &Event{
+			Type: TransactionEvent,
+			Transaction: &TransactionData{
+				Hash:       hex.EncodeToString(txHash),
+				Status:     status,
+				BlockHash:  block.Hash,
+				BlockNonce: block.Nonce,
+			},
+		}
+
+		hash := txHash
+		ed.broadcast(event, func(subscription *Subscription) bool {
+			return subscription.isInterestedInTransaction(hash)
+		})
+	}
+}
+
+func (ed *eventsDriver) emitLogsEvents(logs []*data.LogData, block *BlockData) {
+	for _, logData := range logs {
+		if logData == nil || check.IfNil(logData.LogHandler) {
+			continue
+		}
+
+		for _, logEvent := range logData.GetLogEvents() {
+			if check.IfNil(logEvent) {
+				continue
+			}
+
+			address := ed.addressPubKeyConverter.Encode(logEvent.GetAddress())
+			identifier := logEvent.GetIdentifier()
+			topics := logEvent.GetTopics()
+			event := &Event{
+				Type: LogEvent,
+				Log: &LogData{
+					TxHash:     hex.EncodeToString([]byte(logData.TxHash)),
+					Address:    address,
+					Identifier: string(identifier),
+					Topics:     topics,
+					Data:       logEvent.GetData(),
+					BlockHash:  block.Hash,
+					BlockNonce: block.Nonce,
+				},
+			}
+
+			ed.broadcast(event, func(subscription *Subscription) bool {
+				return subscription.isInterestedInLog(address, identifier, topics)
+			})
+		}
+	}
+}
+
+func (ed *eventsDriver) broadcast(event *Event, isInterested func(subscription *Subscription) bool) {
+	ed.mutSubscriptions.Lock()
+	defer ed.mutSubscriptions.Unlock()
+
+	for id, subscription := range ed.subscriptions {
+		if !isInterested(subscription) {
+			continue
+		}
+
+		if !subscription.send(event) {
+			log.Debug("eventsDriver: dropping slow subscriber", "id", id)
+			delete(ed.subscriptions, id)
+			subscription.close()
+		}
+	}
+}
+
+// SaveRoundsInfo does nothing
+func (ed *eventsDriver) SaveRoundsInfo(_ []*indexer.RoundInfo) error {
+	return nil
+}
+
+// SaveValidatorsPubKeys does nothing
+func (ed *eventsDriver) SaveValidatorsPubKeys(_ map[uint32][][]byte, _ uint32) error {
+	return nil
+}
+
+// SaveValidatorsRating does nothing
+func (ed *eventsDriver) SaveValidatorsRating(_ string, _ []*indexer.ValidatorRatingInfo) error {
+	return nil
+}
+
+// SaveAccounts does nothing
+func (ed *eventsDriver) SaveAccounts(_ uint64, _ []data.UserAccountHandler) error {
+	return nil
+}
+
+// Close ends all the subscriptions
+func (ed *eventsDriver) Close() error {
+	ed.mutSubscriptions.Lock()
+	defer ed.mutSubscriptions.Unlock()
+
+	for id, subscription := range ed.subscriptions {
+		delete(ed.subscriptions, id)
+		subscription.close()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ed *eventsDriver) IsInterfaceNil() bool {
+	return ed == nil
+}
@@ -0,0 +1,272 @@
+package events
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go-core/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/hashingMocks"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsEventsDriver() ArgsEventsDriver {
+	return ArgsEventsDriver{
+		Marshalizer:            &testscommon.MarshalizerMock{},
+		Hasher:                 &hashingMocks.HasherMock{},
+		AddressPubKeyConverter: testscommon.NewPubkeyConverterMock(32),
+		MaxSubscribers:         2,
+		SubscriptionBufferSize: 10,
+	}
+}
+
+func createSaveBlockArgs(headerHash []byte, nonce uint64) *indexer.ArgsSaveBlockData {
+	return &indexer.ArgsSaveBlockData{
+		HeaderHash: headerHash,
+		Header:     &block.Header{Nonce: nonce, Round: nonce, TxCount: 2},
+		TransactionsPool: &indexer.Pool{
+			Txs: map[string]data.TransactionHandler{
+				string(headerHash) + "_tx": &transaction.Transaction{},
+			},
+			Rewards: map[string]data.TransactionHandler{
+				string(headerHash) + "_reward": &rewardTx.RewardTx{},
+			},
+			Invalid: map[string]data.TransactionHandler{
+				string(headerHash) + "_invalid": &transaction.Transaction{},
+			},
+			Logs: []*data.LogData{
+				{
+					TxHash: string(headerHash) + "_tx",
+					LogHandler: &transaction.Log{
+						Events: []*transaction.Event{
+							{
+								Address:    []byte("sc"),
+								Identifier: []byte("transfer"),
+								Topics:     [][]byte{[]byte("token")},
+								Data:       []byte("data"),
+							},
+							{
+								Address:    []byte("sc"),
+								Identifier: []byte("burn"),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func readEvents(subscription *Subscription) []*Event {
+	events := make([]*Event, 0)
+	for {
+		select {
+		case event := <-subscription.Events():
+			events = append(events, event)
+		default:
+			return events
+		}
+	}
+}
+
+func TestNewEventsDriver(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		args := createMockArgsEventsDriver()
+		args.Marshalizer = nil
+		driver, err := NewEventsDriver(args)
+		require.Equal(t, ErrNilMarshalizer, err)
+		require.Nil(t, driver)
+	})
+
+	t.Run("nil hasher should error", func(t *testing.T) {
+		args := createMockArgsEventsDriver()
+		args.Hasher = nil
+		driver, err := NewEventsDriver(args)
+		require.Equal(t, ErrNilHasher, err)
+		require.Nil(t, driver)
+	})
+
+	t.Run("nil pubkey converter should error", func(t *testing.T) {
+		args := createMockArgsEventsDriver()
+		args.AddressPubKeyConverter = nil
+		driver, err := NewEventsDriver(args)
+		require.Equal(t, ErrNilPubKeyConverter, err)
+		require.Nil(t, driver)
+	})
+
+	t.Run("invalid max subscribers should error", func(t *testing.T) {
+		args := createMockArgsEventsDriver()
+		args.MaxSubscribers = 0
+		driver, err := NewEventsDriver(args)
+		require.Equal(t, ErrInvalidMaxSubscribers, err)
+		require.Nil(t, driver)
+	})
+
+	t.Run("invalid buffer size should error", func(t *testing.T) {
+		args := createMockArgsEventsDriver()
+		args.SubscriptionBufferSize = 0
+		driver, err := NewEventsDriver(args)
+		require.Equal(t, ErrInvalidSubscriptionBufferSize, err)
+		require.Nil(t, driver)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		driver, err := NewEventsDriver(createMockArgsEventsDriver())
+		require.Nil(t, err)
+		require.False(t, driver.IsInterfaceNil())
+	})
+}
+
+func TestEventsDriver_SubscribeAndUnsubscribe(t *testing.T) {
+	t.Parallel()
+
+	driver, _ := NewEventsDriver(createMockArgsEventsDriver())
+
+	subscription, err := driver.Subscribe(SubscriptionFilter{})
+	require.Equal(t, ErrEmptySubscriptionFilter, err)
+	require.Nil(t, subscription)
+
+	first, err := driver.Subscribe(SubscriptionFilter{Blocks: true})
+	require.Nil(t, err)
+	second, err := driver.Subscribe(SubscriptionFilter{Blocks: true})
+	require.Nil(t, err)
+	require.NotEqual(t, first.ID(), second.ID())
+
+	_, err = driver.Subscribe(SubscriptionFilter{Blocks: true})
+	require.Equal(t, ErrTooManySubscribers, err)
+
+	driver.Unsubscribe(first)
+	_, ok := <-first.Events()
+	require.False(t, ok)
+
+	_, err = driver.Subscribe(SubscriptionFilter{Blocks: true})
+	require.Nil(t, err)
+
+	require.Nil(t, driver.Close())
+	_, ok = <-second.Events()
+	require.False(t, ok)
+}
+
+func TestEventsDriver_SaveBlockAndFinalize(t *testing.T) {
+	t.Parallel()
+
+	driver, _ := NewEventsDriver(createMockArgsEventsDriver())
+
+	blocksSubscription, _ := driver.Subscribe(SubscriptionFilter{Blocks: true})
+	txsSubscription, _ := driver.Subscribe(SubscriptionFilter{
+		Transactions: []string{
+			hex.EncodeToString([]byte("hashA_tx")),
+			hex.EncodeToString([]byte("hashB_invalid")),
+		},
+		Logs: []*LogFilter{
+			{Identifier: "transfer", Topics: [][]byte{[]byte("token")}},
+		},
+	})
+
+	require.Nil(t, driver.SaveBlock(createSaveBlockArgs([]byte("hashA"), 1)))
+	require.Nil(t, driver.SaveBlock(createSaveBlockArgs([]byte("hashB"), 2)))
+	require.Nil(t, driver.SaveBlock(createSaveBlockArgs([]byte("hashC"), 3)))
+
+	require.Empty(t, readEvents(blocksSubscription))
+	events := readEvents(txsSubscription)
+	require.Len(t, events, 5)
+	require.Equal(t, &Event{
+		Type: TransactionEvent,
+		Transaction: &TransactionData{
+			Hash:       hex.EncodeToString([]byte("hashA_tx")),
+			Status:     TransactionStatusExecuted,
+			BlockHash:  hex.EncodeToString([]byte("hashA")),
+			BlockNonce: 1,
+		},
+	}, events[0])
+	require.Equal(t, &Event{
+		Type: LogEvent,
+		Log: &LogData{
+			TxHash:     hex.EncodeToString([]byte("hashA_tx")),
+			Address:    hex.EncodeToString([]byte("sc")),
+			Identifier: "transfer",
+			Topics:     [][]byte{[]byte("token")},
+			Data:       []byte("data"),
+			BlockHash:  hex.EncodeToString([]byte("hashA")),
+			BlockNonce: 1,
+		},
+	}, events[1])
+	require.Equal(t, TransactionStatusInvalid, events[2].Transaction.Status)
+	require.Equal(t, LogEvent, events[3].Type)
+	require.Equal(t, uint64(3), events[4].Log.BlockNonce)
+
+	// finalizing block B also finalizes block A
+	require.Nil(t, driver.FinalizedBlock([]byte("hashB")))
+	events = readEvents(blocksSubscription)
+	require.Len(t, events, 2)
+	require.Equal(t, FinalizedBlockEvent, events[0].Type)
+	require.Equal(t, &BlockData{Hash: hex.EncodeToString([]byte("hashA")), Nonce: 1, Round: 1, NumTxs: 2}, events[0].Block)
+	require.Equal(t, uint64(2), events[1].Block.Nonce)
+
+	events = readEvents(txsSubscription)
+	require.Len(t, events, 2)
+	require.Equal(t, TransactionStatusFinalized, events[0].Transaction.Status)
+	require.Equal(t, TransactionStatusFinalized, events[1].Transaction.Status)
+
+	// already finalized or unknown blocks are ignored
+	require.Nil(t, driver.FinalizedBlock([]byte("hashA")))
+	require.Empty(t, readEvents(blocksSubscription))
+	require.Len(t, driver.pendingBlocks, 1)
+}
+
+func TestEventsDriver_RevertIndexedBlock(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEventsDriver()
+	driver, _ := NewEventsDriver(args)
+
+	header := &block.Header{Nonce: 5, Round: 6}
+	headerHash, _ := core.CalculateHash(args.Marshalizer, args.Hasher, header)
+	saveBlockArgs := createSaveBlockArgs(headerHash, 5)
+	saveBlockArgs.Header = header
+	require.Nil(t, driver.SaveBlock(saveBlockArgs))
+
+	subscription, _ := driver.Subscribe(SubscriptionFilter{
+		Blocks:       true,
+		Transactions: []string{hex.EncodeToString(append(headerHash, []byte("_reward")...))},
+	})
+
+	require.Nil(t, driver.RevertIndexedBlock(header, &block.Body{}))
+	events := readEvents(subscription)
+	require.Len(t, events, 2)
+	require.Equal(t, RevertedBlockEvent, events[0].Type)
+	require.Equal(t, hex.EncodeToString(headerHash), events[0].Block.Hash)
+	require.Equal(t, TransactionStatusReverted, events[1].Transaction.Status)
+	require.Empty(t, driver.pendingBlocks)
+
+	// a reverted block is not finalized anymore
+	require.Nil(t, driver.FinalizedBlock(headerHash))
+	require.Empty(t, readEvents(subscription))
+}
+
+func TestEventsDriver_SlowSubscriberShouldBeDropped(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEventsDriver()
+	args.SubscriptionBufferSize = 1
+	driver, _ := NewEventsDriver(args)
+
+	slow, _ := driver.Subscribe(SubscriptionFilter{Blocks: true})
+	require.Nil(t, driver.SaveBlock(createSaveBlockArgs([]byte("hashA"), 1)))
+	require.Nil(t, driver.SaveBlock(createSaveBlockArgs([]byte("hashB"), 2)))
+	require.Nil(t, driver.FinalizedBlock([]byte("hashB")))
+
+	event, ok := <-slow.Events()
+	require.True(t, ok)
+	require.Equal(t, uint64(1), event.Block.Nonce)
+	_, ok = <-slow.Events()
+	require.False(t, ok)
+	require.Empty(t, driver.subscriptions)
+}
@@ -0,0 +1,8 @@
+package events
+
+// SubscriptionsHandler defines the behavior of a component able to stream outport events to in-process subscribers
+type SubscriptionsHandler interface {
+	Subscribe(filter SubscriptionFilter) (*Subscription, error)
+	Unsubscribe(subscription *Subscription)
+	IsInterfaceNil() bool
+}
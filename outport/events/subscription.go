@@ -0,0 +1,121 @@
+package events
+
+import (
+	"bytes"
+	"encoding/hex"
+	"sync"
+)
+
+const (
+	maxTransactionsPerSubscription = 1000
+	maxLogFiltersPerSubscription   = 100
+)
+
+// Subscription holds the stream of events delivered to a subscriber. The events channel is closed when the
+// subscription ends, either because it was explicitly ended or because the subscriber could not keep up
+type Subscription struct {
+	id           uint64
+	blocks       bool
+	transactions map[string]struct{}
+	logs         []*LogFilter
+	chanEvents   chan *Event
+	closeOnce    sync.Once
+}
+
+func newSubscription(id uint64, filter SubscriptionFilter, bufferSize int) (*Subscription, error) {
+	if !filter.Blocks && len(filter.Transactions) == 0 && len(filter.Logs) == 0 {
+		return nil, ErrEmptySubscriptionFilter
+	}
+	if len(filter.Transactions) > maxTransactionsPerSubscription {
+		return nil, ErrTooManyTransactionsInFilter
+	}
+	if len(filter.Logs) > maxLogFiltersPerSubscription {
+		return nil, ErrTooManyLogFilters
+	}
+
+	transactions := make(map[string]struct{}, len(filter.Transactions))
+	for _, txHash := range filter.Transactions {
+		txHashBytes, err := hex.DecodeString(txHash)
+		if err != nil || len(txHashBytes) == 0 {
+			return nil, ErrInvalidTransactionHash
+		}
+
+		transactions[string(txHashBytes)] = struct{}{}
+	}
+
+	logs := make([]*LogFilter, 0, len(filter.Logs))
+	for _, logFilter := range filter.Logs {
+		if logFilter != nil {
+			logs = append(logs, logFilter)
+		}
+	}
+
+	return &Subscription{
+		id:           id,
+		blocks:       filter.Blocks,
+		transactions: transactions,
+		logs:         logs,
+		chanEvents:   make(chan *Event, bufferSize),
+	}, nil
+}
+
+// ID returns the identifier of the subscription
+func (s *Subscription) ID() uint64 {
+	return s.id
+}
+
+// Events returns the channel on which the events are delivered
+func (s *Subscription) Events() <-chan *Event {
+	return s.chanEvents
+}
+
+// send delivers the event without blocking. It returns false if the subscriber's buffer is full
+func (s *Subscription) send(event *Event) bool {
+	select {
+	case s.chanEvents <- event:
+		return true
+	default:
+		return false
+	}
+}
+
+func (s *Subscription) close() {
+	s.closeOnce.Do(func() {
+		close(s.chanEvents)
+	})
+}
+
+func (s *Subscription) isInterestedInTransaction(txHash []byte) bool {
+	_, ok := s.transactions[string(txHash)]
+	return ok
+}
+
+func (s *Subscription) isInterestedInLog(address string, identifier []byte, topics [][]byte) bool {
+	for _, logFilter := range s.logs {
+		if logFilter.matches(address, identifier, topics) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (lf *LogFilter) matches(address string, identifier []byte, topics [][]byte) bool {
+	if len(lf.Address) > 0 && lf.Address != address {
+		return false
+	}
+	if len(lf.Identifier) > 0 && lf.Identifier != string(identifier) {
+		return false
+	}
+	if len(lf.Topics) > len(topics) {
+		return false
+	}
+
+	for i, topic := range lf.Topics {
+		if len(topic) > 0 && !bytes.Equal(topic, topics[i]) {
+			return false
+		}
+	}
+
+	return true
+}
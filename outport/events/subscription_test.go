@@ -0,0 +1,92 @@
+package events
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSubscription(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty filter should error", func(t *testing.T) {
+		subscription, err := newSubscription(1, SubscriptionFilter{}, 10)
+		require.Equal(t, ErrEmptySubscriptionFilter, err)
+		require.Nil(t, subscription)
+	})
+
+	t.Run("too many transactions should error", func(t *testing.T) {
+		filter := SubscriptionFilter{
+			Transactions: make([]string, maxTransactionsPerSubscription+1),
+		}
+		subscription, err := newSubscription(1, filter, 10)
+		require.Equal(t, ErrTooManyTransactionsInFilter, err)
+		require.Nil(t, subscription)
+	})
+
+	t.Run("too many log filters should error", func(t *testing.T) {
+		filter := SubscriptionFilter{
+			Logs: make([]*LogFilter, maxLogFiltersPerSubscription+1),
+		}
+		subscription, err := newSubscription(1, filter, 10)
+		require.Equal(t, ErrTooManyLogFilters, err)
+		require.Nil(t, subscription)
+	})
+
+	t.Run("invalid transaction hash should error", func(t *testing.T) {
+		filter := SubscriptionFilter{
+			Transactions: []string{"not hex"},
+		}
+		subscription, err := newSubscription(1, filter, 10)
+		require.Equal(t, ErrInvalidTransactionHash, err)
+		require.Nil(t, subscription)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		filter := SubscriptionFilter{
+			Blocks:       true,
+			Transactions: []string{hex.EncodeToString([]byte("tx1"))},
+			Logs:         []*LogFilter{nil, {Identifier: "transfer"}},
+		}
+		subscription, err := newSubscription(7, filter, 10)
+		require.Nil(t, err)
+		require.Equal(t, uint64(7), subscription.ID())
+		require.True(t, subscription.isInterestedInTransaction([]byte("tx1")))
+		require.False(t, subscription.isInterestedInTransaction([]byte("tx2")))
+		require.Len(t, subscription.logs, 1)
+	})
+}
+
+func TestSubscription_SendShouldNotBlock(t *testing.T) {
+	t.Parallel()
+
+	subscription, _ := newSubscription(1, SubscriptionFilter{Blocks: true}, 1)
+	require.True(t, subscription.send(&Event{}))
+	require.False(t, subscription.send(&Event{}))
+
+	subscription.close()
+	subscription.close()
+
+	numEvents := 0
+	for range subscription.Events() {
+		numEvents++
+	}
+	require.Equal(t, 1, numEvents)
+}
+
+func TestLogFilter_Matches(t *testing.T) {
+	t.Parallel()
+
+	topics := [][]byte{[]byte("token"), []byte("nonce"), []byte("value")}
+
+	require.True(t, (&LogFilter{}).matches("erd1", []byte("transfer"), topics))
+	require.True(t, (&LogFilter{Address: "erd1", Identifier: "transfer"}).matches("erd1", []byte("transfer"), topics))
+	require.False(t, (&LogFilter{Address: "erd2"}).matches("erd1", []byte("transfer"), topics))
+	require.False(t, (&LogFilter{Identifier: "burn"}).matches("erd1", []byte("transfer"), topics))
+
+	// topics are matched positionally, an empty topic matching anything
+	require.True(t, (&LogFilter{Topics: [][]byte{nil, []byte("nonce")}}).matches("erd1", []byte("transfer"), topics))
+	require.False(t, (&LogFilter{Topics: [][]byte{[]byte("nonce")}}).matches("erd1", []byte("transfer"), topics))
+	require.False(t, (&LogFilter{Topics: make([][]byte, 4)}).matches("erd1", []byte("transfer"), topics))
+}
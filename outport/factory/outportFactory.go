@@ -5,16 +5,55 @@ import (
 
 	covalentFactory "github.com/ElrondNetwork/covalent-indexer-go/factory"
 	indexerFactory "github.com/ElrondNetwork/elastic-indexer-go/factory"
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	"github.com/ElrondNetwork/elrond-go/outport"
+	"github.com/ElrondNetwork/elrond-go/outport/files"
+	"github.com/ElrondNetwork/elrond-go/outport/queue"
+	"github.com/ElrondNetwork/elrond-go/outport/webhook"
+	"github.com/ElrondNetwork/elrond-go/storage"
 	notifierFactory "github.com/ElrondNetwork/notifier-go/factory"
 )
 
+const (
+	elasticDriverName  = "elastic"
+	notifierDriverName = "notifier"
+	covalentDriverName = "covalent"
+	webhookDriverName  = "webhook"
+	filesDriverName    = "files"
+)
+
 // OutportFactoryArgs holds the factory arguments of different outport drivers
 type OutportFactoryArgs struct {
 	RetrialInterval            time.Duration
 	ElasticIndexerFactoryArgs  *indexerFactory.ArgsIndexerFactory
 	EventNotifierFactoryArgs   *notifierFactory.EventNotifierFactoryArgs
 	CovalentIndexerFactoryArgs *covalentFactory.ArgsCovalentIndexerFactory
+	WebhookDriverFactoryArgs   *WebhookDriverFactoryArgs
+	FilesDriverFactoryArgs     *FilesDriverFactoryArgs
+	EventsDriver               outport.Driver
+	QueueFactoryArgs           *QueueFactoryArgs
+}
+
+// WebhookDriverFactoryArgs holds the arguments needed to create the webhook driver
+type WebhookDriverFactoryArgs struct {
+	Enabled bool
+	webhook.ArgsWebhookDriver
+}
+
+// QueueFactoryArgs holds the arguments needed to wrap the external drivers in persistent queues
+type QueueFactoryArgs struct {
+	Enabled          bool
+	PersisterCreator func(driverName string) (storage.Persister, error)
+	Marshalizer      marshal.Marshalizer
+	StatusHandler    core.AppStatusHandler
+}
+
+// FilesDriverFactoryArgs holds the arguments needed to create the files driver
+type FilesDriverFactoryArgs struct {
+	Enabled bool
+	files.ArgsFilesDriver
 }
 
 // CreateOutport will create a new instance of OutportHandler
@@ -38,76 +77,165 @@ func CreateOutport(args *OutportFactoryArgs) (outport.OutportHandler, error) {
 }
 
 func createAndSubscribeDrivers(outport outport.OutportHandler, args *OutportFactoryArgs) error {
-	err := createAndSubscribeElasticDriverIfNeeded(outport, args.ElasticIndexerFactoryArgs)
+	err := createAndSubscribeElasticDriverIfNeeded(outport, args)
 	if err != nil {
 		return err
 	}
 
-	err = createAndSubscribeEventNotifierIfNeeded(outport, args.EventNotifierFactoryArgs)
+	err = createAndSubscribeEventNotifierIfNeeded(outport, args)
 	if err != nil {
 		return err
 	}
 
-	err = createAndSubscribeCovalentDriverIfNeeded(outport, args.CovalentIndexerFactoryArgs)
+	err = createAndSubscribeCovalentDriverIfNeeded(outport, args)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	err = createAndSubscribeWebhookDriverIfNeeded(outport, args)
+	if err != nil {
+		return err
+	}
+
+	err = createAndSubscribeFilesDriverIfNeeded(outport, args)
+	if err != nil {
+		return err
+	}
+
+	return subscribeEventsDriverIfNeeded(outport, args.EventsDriver)
+}
+
+func subscribeEventsDriverIfNeeded(
+	outport outport.OutportHandler,
+	eventsDriver outport.Driver,
+) error {
+	if check.IfNil(eventsDriver) {
+		return nil
+	}
+
+	return outport.SubscribeDriver(eventsDriver)
 }
 
 func createAndSubscribeCovalentDriverIfNeeded(
 	outport outport.OutportHandler,
-	args *covalentFactory.ArgsCovalentIndexerFactory,
+	args *OutportFactoryArgs,
 ) error {
-	if !args.Enabled {
+	if !args.CovalentIndexerFactoryArgs.Enabled {
 		return nil
 	}
 
-	covalentDriver, err := covalentFactory.CreateCovalentIndexer(args)
+	covalentDriver, err := covalentFactory.CreateCovalentIndexer(args.CovalentIndexerFactoryArgs)
 	if err != nil {
 		return err
 	}
 
-	return outport.SubscribeDriver(covalentDriver)
+	return subscribeDriver(outport, covalentDriver, covalentDriverName, args)
+}
+
+func createAndSubscribeWebhookDriverIfNeeded(
+	outport outport.OutportHandler,
+	args *OutportFactoryArgs,
+) error {
+	if args.WebhookDriverFactoryArgs == nil || !args.WebhookDriverFactoryArgs.Enabled {
+		return nil
+	}
+
+	webhookDriver, err := webhook.NewWebhookDriver(args.WebhookDriverFactoryArgs.ArgsWebhookDriver)
+	if err != nil {
+		return err
+	}
+
+	return subscribeDriver(outport, webhookDriver, webhookDriverName, args)
+}
+
+func createAndSubscribeFilesDriverIfNeeded(
+	outport outport.OutportHandler,
+	args *OutportFactoryArgs,
+) error {
+	if args.FilesDriverFactoryArgs == nil || !args.FilesDriverFactoryArgs.Enabled {
+		return nil
+	}
+
+	filesDriver, err := files.NewFilesDriver(args.FilesDriverFactoryArgs.ArgsFilesDriver)
+	if err != nil {
+		return err
+	}
+
+	return subscribeDriver(outport, filesDriver, filesDriverName, args)
 }
 
 func createAndSubscribeElasticDriverIfNeeded(
 	outport outport.OutportHandler,
-	args *indexerFactory.ArgsIndexerFactory,
+	args *OutportFactoryArgs,
 ) error {
-	if !args.Enabled {
+	if !args.ElasticIndexerFactoryArgs.Enabled {
 		return nil
 	}
 
-	elasticDriver, err := indexerFactory.NewIndexer(args)
+	elasticDriver, err := indexerFactory.NewIndexer(args.ElasticIndexerFactoryArgs)
 	if err != nil {
 		return err
 	}
 
-	return outport.SubscribeDriver(elasticDriver)
+	return subscribeDriver(outport, elasticDriver, elasticDriverName, args)
 }
 
 func createAndSubscribeEventNotifierIfNeeded(
 	outport outport.OutportHandler,
-	args *notifierFactory.EventNotifierFactoryArgs,
+	args *OutportFactoryArgs,
 ) error {
-	if !args.Enabled {
+	if !args.EventNotifierFactoryArgs.Enabled {
 		return nil
 	}
 
-	eventNotifier, err := notifierFactory.CreateEventNotifier(args)
+	eventNotifier, err := notifierFactory.CreateEventNotifier(args.EventNotifierFactoryArgs)
 	if err != nil {
 		return err
 	}
 
-	return outport.SubscribeDriver(eventNotifier)
+	return subscribeDriver(outport, eventNotifier, notifierDriverName, args)
+}
+
+// subscribeDriver subscribes the driver, wrapping it in a persistent queue if needed
+func subscribeDriver(
+	outport outport.OutportHandler,
+	driver outport.Driver,
+	driverName string,
+	args *OutportFactoryArgs,
+) error {
+	queueArgs := args.QueueFactoryArgs
+	if queueArgs == nil || !queueArgs.Enabled {
+		return outport.SubscribeDriver(driver)
+	}
+
+	persister, err := queueArgs.PersisterCreator(driverName)
+	if err != nil {
+		return err
+	}
+
+	queuedDriver, err := queue.NewQueuedDriver(queue.ArgsQueuedDriver{
+		Name:            driverName,
+		Driver:          driver,
+		Persister:       persister,
+		Marshalizer:     queueArgs.Marshalizer,
+		StatusHandler:   queueArgs.StatusHandler,
+		RetrialInterval: args.RetrialInterval,
+	})
+	if err != nil {
+		_ = persister.Close()
+		return err
+	}
+
+	return outport.SubscribeDriver(queuedDriver)
 }
 
 func checkArguments(args *OutportFactoryArgs) error {
 	if args == nil {
 		return outport.ErrNilArgsOutportFactory
 	}
+	if args.QueueFactoryArgs != nil && args.QueueFactoryArgs.Enabled && args.QueueFactoryArgs.PersisterCreator == nil {
+		return outport.ErrNilPersisterCreator
+	}
 
 	return nil
 }
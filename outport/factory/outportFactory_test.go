@@ -9,9 +9,15 @@ import (
 	indexerFactory "github.com/ElrondNetwork/elastic-indexer-go/factory"
 	"github.com/ElrondNetwork/elrond-go/outport"
 	"github.com/ElrondNetwork/elrond-go/outport/factory"
+	"github.com/ElrondNetwork/elrond-go/outport/files"
+	outportMock "github.com/ElrondNetwork/elrond-go/outport/mock"
+	"github.com/ElrondNetwork/elrond-go/outport/webhook"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
 	"github.com/ElrondNetwork/elrond-go/testscommon/hashingMocks"
 	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
+	"github.com/ElrondNetwork/elrond-go/testscommon/statusHandler"
 	notifierFactory "github.com/ElrondNetwork/notifier-go/factory"
 	"github.com/stretchr/testify/require"
 )
@@ -135,3 +141,105 @@ func TestCreateOutport_SubscribeNotifierDriver(t *testing.T) {
 	require.True(t, outPort.HasDrivers())
 	require.Nil(t, err)
 }
+
+func TestCreateOutport_SubscribeWebhookDriver(t *testing.T) {
+	args := createMockArgsOutportHandler(false, false, false)
+	args.WebhookDriverFactoryArgs = &factory.WebhookDriverFactoryArgs{
+		Enabled: true,
+		ArgsWebhookDriver: webhook.ArgsWebhookDriver{
+			Endpoints:              []string{"http://localhost:5000/events"},
+			BatchSize:              1,
+			RequestTimeout:         time.Second,
+			MaxRetries:             1,
+			InitialBackoff:         time.Millisecond,
+			MaxBackoff:             time.Millisecond,
+			FlushInterval:          time.Second,
+			Marshalizer:            &mock.MarshalizerMock{},
+			Hasher:                 &hashingMocks.HasherMock{},
+			AddressPubKeyConverter: &mock.PubkeyConverterStub{},
+		},
+	}
+
+	outPort, err := factory.CreateOutport(args)
+
+	defer func(c outport.OutportHandler) {
+		_ = c.Close()
+	}(outPort)
+
+	require.True(t, outPort.HasDrivers())
+	require.Nil(t, err)
+}
+
+func TestCreateOutport_SubscribeFilesDriver(t *testing.T) {
+	args := createMockArgsOutportHandler(false, false, false)
+	args.FilesDriverFactoryArgs = &factory.FilesDriverFactoryArgs{
+		Enabled: true,
+		ArgsFilesDriver: files.ArgsFilesDriver{
+			Directory:          t.TempDir(),
+			Format:             files.ProtobufFormat,
+			MaxFileSizeInBytes: 1024,
+			Marshalizer:        &mock.MarshalizerMock{},
+		},
+	}
+
+	outPort, err := factory.CreateOutport(args)
+
+	defer func(c outport.OutportHandler) {
+		_ = c.Close()
+	}(outPort)
+
+	require.True(t, outPort.HasDrivers())
+	require.Nil(t, err)
+}
+
+func TestCreateOutport_SubscribeEventsDriver(t *testing.T) {
+	args := createMockArgsOutportHandler(false, false, false)
+	args.EventsDriver = &outportMock.DriverStub{}
+
+	outPort, err := factory.CreateOutport(args)
+
+	defer func(c outport.OutportHandler) {
+		_ = c.Close()
+	}(outPort)
+
+	require.True(t, outPort.HasDrivers())
+	require.Nil(t, err)
+}
+
+func TestCreateOutport_QueueEnabledWithNilPersisterCreatorShouldErr(t *testing.T) {
+	args := createMockArgsOutportHandler(false, false, false)
+	args.QueueFactoryArgs = &factory.QueueFactoryArgs{
+		Enabled: true,
+	}
+
+	outPort, err := factory.CreateOutport(args)
+	require.Equal(t, outport.ErrNilPersisterCreator, err)
+	require.Nil(t, outPort)
+}
+
+func TestCreateOutport_SubscribeQueuedNotifierDriver(t *testing.T) {
+	args := createMockArgsOutportHandler(false, true, false)
+
+	args.EventNotifierFactoryArgs.Marshalizer = &mock.MarshalizerMock{}
+	args.EventNotifierFactoryArgs.Hasher = &hashingMocks.HasherMock{}
+	persisterCreatorCalled := false
+	args.QueueFactoryArgs = &factory.QueueFactoryArgs{
+		Enabled: true,
+		PersisterCreator: func(driverName string) (storage.Persister, error) {
+			persisterCreatorCalled = true
+			require.Equal(t, "notifier", driverName)
+			return memorydb.New(), nil
+		},
+		Marshalizer:   &mock.MarshalizerMock{},
+		StatusHandler: statusHandler.NewAppStatusHandlerMock(),
+	}
+	outPort, err := factory.CreateOutport(args)
+
+	defer func(c outport.OutportHandler) {
+		_ = c.Close()
+	}(outPort)
+
+	require.Nil(t, err)
+	require.True(t, outPort.HasDrivers())
+	require.True(t, persisterCreatorCalled)
+}
@@ -0,0 +1,21 @@
+package files
+
+import "errors"
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilDriver signals that a nil driver has been provided
+var ErrNilDriver = errors.New("nil driver")
+
+// ErrEmptyDirectory signals that an empty directory has been provided
+var ErrEmptyDirectory = errors.New("empty directory")
+
+// ErrInvalidFormat signals that an invalid format has been provided
+var ErrInvalidFormat = errors.New("invalid format")
+
+// ErrInvalidMaxFileSize signals that an invalid maximum file size has been provided
+var ErrInvalidMaxFileSize = errors.New("invalid maximum file size")
+
+// ErrRecordTooLarge signals that a record exceeds the maximum record size
+var ErrRecordTooLarge = errors.New("record too large")
@@ -0,0 +1,192 @@
+package files
+
+import (
+	"bufio"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/outport"
+	"github.com/ElrondNetwork/elrond-go/outport/queue"
+)
+
+var log = logger.GetOrCreate("outport/files")
+
+const filesPermissions = 0644
+
+// ArgsFilesDriver holds the arguments needed to create a new files driver
+type ArgsFilesDriver struct {
+	Directory          string
+	Format             string
+	MaxFileSizeInBytes uint64
+	Marshalizer        marshal.Marshalizer
+}
+
+// filesDriver is an outport driver that writes the driver calls in gzip compressed files, so that they can be
+// replayed later into other drivers with the files reader. The calls are stored as queued operations, the same
+// way the queued driver does. A new file is started on each node start and each time the current file exceeds
+// the maximum size. Each record is flushed to the file as soon as it is written.
+type filesDriver struct {
+	outport.Driver
+	directory          string
+	format             string
+	maxFileSizeInBytes uint64
+	marshalizer        marshal.Marshalizer
+
+	mutFile      sync.Mutex
+	nextSequence uint64
+	file         *os.File
+	gzipWriter   *gzip.Writer
+	bufWriter    *bufio.Writer
+	fileSize     uint64
+}
+
+// NewFilesDriver creates a new files driver
+func NewFilesDriver(args ArgsFilesDriver) (*filesDriver, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	err = os.MkdirAll(args.Directory, os.ModePerm)
+	if err != nil {
+		return nil, err
+	}
+
+	existingFiles, err := listRecordsFiles(args.Directory)
+	if err != nil {
+		return nil, err
+	}
+
+	fd := &filesDriver{
+		directory:          args.Directory,
+		format:             args.Format,
+		maxFileSizeInBytes: args.MaxFileSizeInBytes,
+		marshalizer:        args.Marshalizer,
+	}
+	if len(existingFiles) > 0 {
+		fd.nextSequence = existingFiles[len(existingFiles)-1].sequence + 1
+	}
+
+	fd.Driver, err = queue.NewOperationsRecorder(args.Marshalizer, fd.write)
+	if err != nil {
+		return nil, err
+	}
+
+	return fd, nil
+}
+
+func checkArgs(args ArgsFilesDriver) error {
+	if check.IfNil(args.Marshalizer) {
+		return ErrNilMarshalizer
+	}
+	if len(args.Directory) == 0 {
+		return ErrEmptyDirectory
+	}
+	if _, ok := extensions[args.Format]; !ok {
+		return ErrInvalidFormat
+	}
+	if args.MaxFileSizeInBytes == 0 {
+		return ErrInvalidMaxFileSize
+	}
+
+	return nil
+}
+
+func (fd *filesDriver) write(operation *queue.QueuedOperation) error {
+	record, err := encodeRecord(fd.marshalizer, fd.format, operation)
+	if err != nil {
+		return err
+	}
+
+	fd.mutFile.Lock()
+	defer fd.mutFile.Unlock()
+
+	if fd.file == nil {
+		err = fd.openNextFile()
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = fd.bufWriter.Write(record)
+	if err != nil {
+		return err
+	}
+	err = fd.flush()
+	if err != nil {
+		return err
+	}
+
+	fd.fileSize += uint64(len(record))
+	if fd.fileSize < fd.maxFileSizeInBytes {
+		return nil
+	}
+
+	return fd.closeFile()
+}
+
+func (fd *filesDriver) openNextFile() error {
+	path := filepath.Join(fd.directory, createFileName(fd.nextSequence, fd.format))
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, filesPermissions)
+	if err != nil {
+		return err
+	}
+
+	log.Debug("files driver: new file", "path", path)
+
+	fd.nextSequence++
+	fd.file = file
+	fd.gzipWriter = gzip.NewWriter(file)
+	fd.bufWriter = bufio.NewWriter(fd.gzipWriter)
+	fd.fileSize = 0
+
+	return nil
+}
+
+func (fd *filesDriver) flush() error {
+	err := fd.bufWriter.Flush()
+	if err != nil {
+		return err
+	}
+
+	return fd.gzipWriter.Flush()
+}
+
+func (fd *filesDriver) closeFile() error {
+	if fd.file == nil {
+		return nil
+	}
+
+	err := fd.flush()
+	if err == nil {
+		err = fd.gzipWriter.Close()
+	}
+	errClose := fd.file.Close()
+	if err == nil {
+		err = errClose
+	}
+
+	fd.file = nil
+	fd.gzipWriter = nil
+	fd.bufWriter = nil
+
+	return err
+}
+
+// Close closes the current file
+func (fd *filesDriver) Close() error {
+	fd.mutFile.Lock()
+	defer fd.mutFile.Unlock()
+
+	return fd.closeFile()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (fd *filesDriver) IsInterfaceNil() bool {
+	return fd == nil
+}
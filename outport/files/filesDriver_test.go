@@ -0,0 +1,242 @@
+package files
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/outport/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsFilesDriver(directory string, format string) ArgsFilesDriver {
+	return ArgsFilesDriver{
+		Directory:          directory,
+		Format:             format,
+		MaxFileSizeInBytes: 1024 * 1024,
+		Marshalizer:        &marshal.GogoProtoMarshalizer{},
+	}
+}
+
+func createSaveBlockArgs(nonce uint64) *indexer.ArgsSaveBlockData {
+	return &indexer.ArgsSaveBlockData{
+		HeaderHash: []byte{byte(nonce)},
+		Header:     &block.Header{Nonce: nonce},
+		Body:       &block.Body{},
+		TransactionsPool: &indexer.Pool{
+			Txs: map[string]data.TransactionHandler{
+				"tx": &transaction.Transaction{Nonce: nonce},
+			},
+		},
+	}
+}
+
+type replayedCalls struct {
+	nonces          []uint64
+	finalizedHashes [][]byte
+}
+
+func replay(t *testing.T, directory string) (*replayedCalls, *ReplayStatistics) {
+	calls := &replayedCalls{}
+	driver := &mock.DriverStub{
+		SaveBlockCalled: func(args *indexer.ArgsSaveBlockData) error {
+			require.NotNil(t, args.TransactionsPool.Txs["tx"])
+			calls.nonces = append(calls.nonces, args.Header.GetNonce())
+			return nil
+		},
+		FinalizedBlockCalled: func(headerHash []byte) error {
+			calls.finalizedHashes = append(calls.finalizedHashes, headerHash)
+			return nil
+		},
+	}
+
+	reader, err := NewFilesReader(ArgsFilesReader{Directory: directory, Marshalizer: &marshal.GogoProtoMarshalizer{}})
+	require.Nil(t, err)
+	statistics, err := reader.Replay(driver)
+	require.Nil(t, err)
+
+	return calls, statistics
+}
+
+func TestNewFilesDriver(t *testing.T) {
+	t.Parallel()
+
+	directory := t.TempDir()
+	tests := []struct {
+		name        string
+		modify      func(args *ArgsFilesDriver)
+		expectedErr error
+	}{
+		{"nil marshalizer", func(args *ArgsFilesDriver) { args.Marshalizer = nil }, ErrNilMarshalizer},
+		{"empty directory", func(args *ArgsFilesDriver) { args.Directory = "" }, ErrEmptyDirectory},
+		{"invalid format", func(args *ArgsFilesDriver) { args.Format = "xml" }, ErrInvalidFormat},
+		{"invalid max file size", func(args *ArgsFilesDriver) { args.MaxFileSizeInBytes = 0 }, ErrInvalidMaxFileSize},
+		{"should work", func(args *ArgsFilesDriver) {}, nil},
+	}
+
+	for _, tt := range tests {
+		args := createMockArgsFilesDriver(directory, ProtobufFormat)
+		tt.modify(&args)
+
+		driver, err := NewFilesDriver(args)
+		require.Equal(t, tt.expectedErr, err, tt.name)
+		require.Equal(t, tt.expectedErr == nil, driver != nil, tt.name)
+	}
+}
+
+func TestFilesDriver_WrittenCallsShouldBeReplayed(t *testing.T) {
+	t.Parallel()
+
+	for _, format := range []string{ProtobufFormat, NDJSONFormat} {
+		directory := t.TempDir()
+		driver, _ := NewFilesDriver(createMockArgsFilesDriver(directory, format))
+		for nonce := uint64(1); nonce <= 3; nonce++ {
+			require.Nil(t, driver.SaveBlock(createSaveBlockArgs(nonce)))
+			require.Nil(t, driver.FinalizedBlock([]byte{byte(nonce)}))
+		}
+		require.Nil(t, driver.SaveAccounts(0, nil))
+		require.Nil(t, driver.Close())
+
+		calls, statistics := replay(t, directory)
+		require.Equal(t, []uint64{1, 2, 3}, calls.nonces, format)
+		require.Equal(t, [][]byte{{1}, {2}, {3}}, calls.finalizedHashes, format)
+		require.Equal(t, &ReplayStatistics{NumFiles: 1, NumOperations: 6, NumBlocks: 3}, statistics, format)
+	}
+}
+
+func TestFilesDriver_NDJSONRecordsShouldHoldTheDecodedArguments(t *testing.T) {
+	t.Parallel()
+
+	directory := t.TempDir()
+	driver, _ := NewFilesDriver(createMockArgsFilesDriver(directory, NDJSONFormat))
+	require.Nil(t, driver.SaveBlock(createSaveBlockArgs(7)))
+	require.Nil(t, driver.Close())
+
+	infos, _ := listRecordsFiles(directory)
+	require.Len(t, infos, 1)
+	file, err := os.Open(infos[0].path)
+	require.Nil(t, err)
+	defer func() {
+		_ = file.Close()
+	}()
+	gzipReader, err := gzip.NewReader(file)
+	require.Nil(t, err)
+	line, err := bufio.NewReader(gzipReader).ReadBytes('\n')
+	require.Nil(t, err)
+
+	record := &struct {
+		Type string
+		Args struct {
+			Header struct {
+				Type string
+				Data struct {
+					Nonce uint64
+				}
+			}
+		}
+	}{}
+	require.Nil(t, json.Unmarshal(line, record))
+	require.Equal(t, "saveBlock", record.Type)
+	require.Equal(t, "shardHeader", record.Args.Header.Type)
+	require.Equal(t, uint64(7), record.Args.Header.Data.Nonce)
+}
+
+func TestFilesDriver_FilesShouldBeRotated(t *testing.T) {
+	t.Parallel()
+
+	directory := t.TempDir()
+	args := createMockArgsFilesDriver(directory, ProtobufFormat)
+	args.MaxFileSizeInBytes = 1
+	driver, _ := NewFilesDriver(args)
+	for nonce := uint64(1); nonce <= 3; nonce++ {
+		require.Nil(t, driver.SaveBlock(createSaveBlockArgs(nonce)))
+	}
+	require.Nil(t, driver.Close())
+
+	infos, _ := listRecordsFiles(directory)
+	require.Len(t, infos, 3)
+
+	// a restarted driver should continue with a new file
+	driver, _ = NewFilesDriver(createMockArgsFilesDriver(directory, NDJSONFormat))
+	require.Nil(t, driver.SaveBlock(createSaveBlockArgs(4)))
+	require.Nil(t, driver.Close())
+
+	infos, _ = listRecordsFiles(directory)
+	require.Len(t, infos, 4)
+	require.Equal(t, uint64(3), infos[3].sequence)
+	require.Equal(t, NDJSONFormat, infos[3].format)
+
+	calls, statistics := replay(t, directory)
+	require.Equal(t, []uint64{1, 2, 3, 4}, calls.nonces)
+	require.Equal(t, 4, statistics.NumFiles)
+}
+
+func TestFilesDriver_UnclosedFileShouldBeReplayed(t *testing.T) {
+	t.Parallel()
+
+	directory := t.TempDir()
+	driver, _ := NewFilesDriver(createMockArgsFilesDriver(directory, ProtobufFormat))
+	require.Nil(t, driver.SaveBlock(createSaveBlockArgs(1)))
+	require.Nil(t, driver.SaveBlock(createSaveBlockArgs(2)))
+
+	calls, _ := replay(t, directory)
+	require.Equal(t, []uint64{1, 2}, calls.nonces)
+
+	_ = driver.Close()
+}
+
+func TestFilesReader_UnknownFilesShouldBeIgnored(t *testing.T) {
+	t.Parallel()
+
+	directory := t.TempDir()
+	require.Nil(t, ioutil.WriteFile(filepath.Join(directory, "outport_abc.pb.gz"), []byte("data"), filesPermissions))
+	require.Nil(t, ioutil.WriteFile(filepath.Join(directory, "other.txt"), []byte("data"), filesPermissions))
+
+	calls, statistics := replay(t, directory)
+	require.Len(t, calls.nonces, 0)
+	require.Equal(t, 0, statistics.NumFiles)
+}
+
+func TestFilesReader_DriverErrorShouldStopReplay(t *testing.T) {
+	t.Parallel()
+
+	directory := t.TempDir()
+	driver, _ := NewFilesDriver(createMockArgsFilesDriver(directory, ProtobufFormat))
+	require.Nil(t, driver.SaveBlock(createSaveBlockArgs(1)))
+	require.Nil(t, driver.SaveBlock(createSaveBlockArgs(2)))
+	require.Nil(t, driver.Close())
+
+	expectedErr := os.ErrInvalid
+	reader, _ := NewFilesReader(ArgsFilesReader{Directory: directory, Marshalizer: &marshal.GogoProtoMarshalizer{}})
+	statistics, err := reader.Replay(&mock.DriverStub{
+		SaveBlockCalled: func(args *indexer.ArgsSaveBlockData) error {
+			if args.Header.GetNonce() == 2 {
+				return expectedErr
+			}
+			return nil
+		},
+	})
+	require.ErrorIs(t, err, expectedErr)
+	require.Equal(t, uint64(1), statistics.NumOperations)
+}
+
+func TestFilesReader_MissingDirectoryShouldErr(t *testing.T) {
+	t.Parallel()
+
+	reader, _ := NewFilesReader(ArgsFilesReader{
+		Directory:   filepath.Join(t.TempDir(), "missing"),
+		Marshalizer: &marshal.GogoProtoMarshalizer{},
+	})
+	statistics, err := reader.Replay(&mock.DriverStub{})
+	require.True(t, os.IsNotExist(err))
+	require.Nil(t, statistics)
+}
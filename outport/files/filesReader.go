@@ -0,0 +1,125 @@
+package files
+
+import (
+	"bufio"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/outport"
+	"github.com/ElrondNetwork/elrond-go/outport/queue"
+)
+
+// ArgsFilesReader holds the arguments needed to create a new files reader
+type ArgsFilesReader struct {
+	Directory   string
+	Marshalizer marshal.Marshalizer
+}
+
+// ReplayStatistics holds the number of replayed operations
+type ReplayStatistics struct {
+	NumFiles      int
+	NumOperations uint64
+	NumBlocks     uint64
+}
+
+// FilesReader reads the files written by the files driver, replaying the recorded calls into another driver
+type FilesReader struct {
+	directory   string
+	marshalizer marshal.Marshalizer
+}
+
+// NewFilesReader creates a new files reader
+func NewFilesReader(args ArgsFilesReader) (*FilesReader, error) {
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if len(args.Directory) == 0 {
+		return nil, ErrEmptyDirectory
+	}
+
+	return &FilesReader{
+		directory:   args.Directory,
+		marshalizer: args.Marshalizer,
+	}, nil
+}
+
+// Replay makes, on the provided driver, all the recorded calls, in the order they were written. A truncated
+// record at the end of a file (written when the node stopped unexpectedly) is skipped. The replay stops
+// on the first driver error
+func (fr *FilesReader) Replay(driver outport.Driver) (*ReplayStatistics, error) {
+	if check.IfNil(driver) {
+		return nil, ErrNilDriver
+	}
+
+	_, err := os.Stat(fr.directory)
+	if err != nil {
+		return nil, err
+	}
+
+	infos, err := listRecordsFiles(fr.directory)
+	if err != nil {
+		return nil, err
+	}
+
+	statistics := &ReplayStatistics{}
+	for _, info := range infos {
+		err = fr.replayFile(info, driver, statistics)
+		if err != nil {
+			return statistics, fmt.Errorf("%w while replaying file %s", err, info.path)
+		}
+		statistics.NumFiles++
+	}
+
+	return statistics, nil
+}
+
+func (fr *FilesReader) replayFile(info *recordsFileInfo, driver outport.Driver, statistics *ReplayStatistics) error {
+	file, err := os.Open(info.path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	gzipReader, err := gzip.NewReader(file)
+	if err == io.EOF {
+		log.Warn("files reader: empty file", "path", info.path)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = gzipReader.Close()
+	}()
+
+	reader := bufio.NewReader(gzipReader)
+	for {
+		operation, errDecode := decodeRecord(fr.marshalizer, info.format, reader)
+		if errDecode == io.EOF {
+			return nil
+		}
+		if errDecode == io.ErrUnexpectedEOF {
+			log.Warn("files reader: truncated file, skipping its remaining data", "path", info.path)
+			return nil
+		}
+		if errDecode != nil {
+			return errDecode
+		}
+
+		err = queue.ApplyOperation(fr.marshalizer, operation, driver)
+		if err != nil {
+			return err
+		}
+
+		statistics.NumOperations++
+		if operation.IsSaveBlock() {
+			statistics.NumBlocks++
+		}
+	}
+}
@@ -0,0 +1,155 @@
+package files
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/outport/queue"
+)
+
+const (
+	// ProtobufFormat stores each record as an uvarint length prefix, followed by the protobuf encoded operation
+	ProtobufFormat = "protobuf"
+	// NDJSONFormat stores each record on its own line, as a JSON object holding the name of the driver call in the
+	// type field and its decoded arguments (e.g. the block header, body and transactions) in the args field
+	NDJSONFormat = "ndjson"
+
+	filePrefix          = "outport_"
+	compressedExtension = ".gz"
+	maxRecordSize       = 1 << 30
+)
+
+var extensions = map[string]string{
+	ProtobufFormat: ".pb",
+	NDJSONFormat:   ".ndjson",
+}
+
+// recordsFileInfo describes an exported file. Files are named outport_<sequence>.<format extension>.gz
+type recordsFileInfo struct {
+	path     string
+	sequence uint64
+	format   string
+}
+
+func createFileName(sequence uint64, format string) string {
+	return fmt.Sprintf("%s%010d%s%s", filePrefix, sequence, extensions[format], compressedExtension)
+}
+
+func parseFileName(name string) (uint64, string, bool) {
+	if !strings.HasPrefix(name, filePrefix) || !strings.HasSuffix(name, compressedExtension) {
+		return 0, "", false
+	}
+
+	name = strings.TrimSuffix(strings.TrimPrefix(name, filePrefix), compressedExtension)
+	for format, extension := range extensions {
+		if !strings.HasSuffix(name, extension) {
+			continue
+		}
+
+		sequence, err := strconv.ParseUint(strings.TrimSuffix(name, extension), 10, 64)
+		if err != nil {
+			return 0, "", false
+		}
+
+		return sequence, format, true
+	}
+
+	return 0, "", false
+}
+
+// listRecordsFiles returns the exported files found in the directory, sorted by their sequence
+func listRecordsFiles(directory string) ([]*recordsFileInfo, error) {
+	paths, err := filepath.Glob(filepath.Join(directory, filePrefix+"*"))
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make([]*recordsFileInfo, 0, len(paths))
+	for _, path := range paths {
+		sequence, format, ok := parseFileName(filepath.Base(path))
+		if !ok {
+			continue
+		}
+
+		infos = append(infos, &recordsFileInfo{
+			path:     path,
+			sequence: sequence,
+			format:   format,
+		})
+	}
+
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].sequence < infos[j].sequence
+	})
+
+	return infos, nil
+}
+
+// encodeRecord returns the bytes to be written for the operation, in the provided format
+func encodeRecord(marshalizer marshal.Marshalizer, format string, operation *queue.QueuedOperation) ([]byte, error) {
+	if format == NDJSONFormat {
+		buff, err := queue.MarshalOperationToJSON(marshalizer, operation)
+		if err != nil {
+			return nil, err
+		}
+
+		return append(buff, '\n'), nil
+	}
+
+	buff, err := marshalizer.Marshal(operation)
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := make([]byte, binary.MaxVarintLen64)
+	prefixLen := binary.PutUvarint(prefix, uint64(len(buff)))
+
+	return append(prefix[:prefixLen], buff...), nil
+}
+
+// decodeRecord reads the next operation. It returns io.EOF if there are no more records
+func decodeRecord(marshalizer marshal.Marshalizer, format string, reader *bufio.Reader) (*queue.QueuedOperation, error) {
+	if format == NDJSONFormat {
+		line, err := reader.ReadBytes('\n')
+		if err == io.EOF && len(line) > 0 {
+			return nil, io.ErrUnexpectedEOF
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		return queue.UnmarshalOperationFromJSON(marshalizer, line)
+	}
+
+	size, err := binary.ReadUvarint(reader)
+	if err != nil {
+		return nil, err
+	}
+	if size > maxRecordSize {
+		return nil, ErrRecordTooLarge
+	}
+
+	operation := &queue.QueuedOperation{}
+	buff := make([]byte, size)
+	_, err = io.ReadFull(reader, buff)
+	if err == io.EOF {
+		return nil, io.ErrUnexpectedEOF
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = marshalizer.Unmarshal(operation, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return operation, nil
+}
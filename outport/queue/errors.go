@@ -0,0 +1,30 @@
+package queue
+
+import "errors"
+
+// ErrNilDriver signals that a nil driver has been provided
+var ErrNilDriver = errors.New("nil driver")
+
+// ErrNilPersister signals that a nil persister has been provided
+var ErrNilPersister = errors.New("nil persister")
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilStatusHandler signals that a nil status handler has been provided
+var ErrNilStatusHandler = errors.New("nil status handler")
+
+// ErrEmptyDriverName signals that an empty driver name has been provided
+var ErrEmptyDriverName = errors.New("empty driver name")
+
+// ErrInvalidRetrialInterval signals that an invalid retrial interval has been provided
+var ErrInvalidRetrialInterval = errors.New("invalid retrial interval")
+
+// ErrUnsupportedType signals that an object of an unsupported type cannot be queued
+var ErrUnsupportedType = errors.New("unsupported type")
+
+// ErrUnknownOperation signals that a queued operation of an unknown type was found
+var ErrUnknownOperation = errors.New("unknown operation")
+
+// ErrNilOperationHandler signals that a nil operation handler has been provided
+var ErrNilOperationHandler = errors.New("nil operation handler")
@@ -0,0 +1,399 @@
+package queue
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+)
+
+var operationNames = map[uint32]string{
+	operationSaveBlock:             "saveBlock",
+	operationRevertIndexedBlock:    "revertIndexedBlock",
+	operationSaveRoundsInfo:        "saveRoundsInfo",
+	operationSaveValidatorsPubKeys: "saveValidatorsPubKeys",
+	operationSaveValidatorsRating:  "saveValidatorsRating",
+	operationFinalizedBlock:        "finalizedBlock",
+}
+
+var objectNames = map[uint32]string{
+	objectShardHeader:         "shardHeader",
+	objectShardHeaderV2:       "shardHeaderV2",
+	objectMetaBlock:           "metaBlock",
+	objectTransaction:         "transaction",
+	objectSmartContractResult: "smartContractResult",
+	objectRewardTx:            "rewardTx",
+	objectReceipt:             "receipt",
+}
+
+// jsonOperation is the JSON form of a queued operation: the name of the driver call, along with its decoded arguments
+type jsonOperation struct {
+	Type string          `json:"type"`
+	Args json.RawMessage `json:"args"`
+}
+
+// jsonObject holds an object given as an interface, along with the name of its concrete type
+type jsonObject struct {
+	Hash []byte          `json:"hash,omitempty"`
+	Type string          `json:"type"`
+	Data json.RawMessage `json:"data"`
+}
+
+type jsonLog struct {
+	TxHash []byte           `json:"txHash"`
+	Log    *transaction.Log `json:"log"`
+}
+
+type jsonSaveBlockArgs struct {
+	HeaderHash             []byte                             `json:"headerHash"`
+	Header                 *jsonObject                        `json:"header"`
+	Body                   *block.Body                        `json:"body"`
+	SignersIndexes         []uint64                           `json:"signersIndexes"`
+	NotarizedHeadersHashes []string                           `json:"notarizedHeadersHashes"`
+	HeaderGasConsumption   indexer.HeaderGasConsumption       `json:"headerGasConsumption"`
+	Txs                    []*jsonObject                      `json:"txs"`
+	Scrs                   []*jsonObject                      `json:"scrs"`
+	Rewards                []*jsonObject                      `json:"rewards"`
+	Invalid                []*jsonObject                      `json:"invalid"`
+	Receipts               []*jsonObject                      `json:"receipts"`
+	Logs                   []*jsonLog                         `json:"logs"`
+	AlteredAccounts        map[string]*indexer.AlteredAccount `json:"alteredAccounts,omitempty"`
+}
+
+type jsonRevertIndexedBlockArgs struct {
+	Header *jsonObject `json:"header"`
+	Body   *block.Body `json:"body"`
+}
+
+// marshalOperationToJSON decodes the payload of the operation and returns it as a JSON object, tagged with the
+// name of the driver call
+func (s *serializer) marshalOperationToJSON(operation *QueuedOperation) ([]byte, error) {
+	name, ok := operationNames[operation.Type]
+	if !ok {
+		return nil, fmt.Errorf("%w: %d", ErrUnknownOperation, operation.Type)
+	}
+
+	args, err := s.decodeOperationArgs(operation)
+	if err != nil {
+		return nil, err
+	}
+
+	argsBytes, err := json.Marshal(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&jsonOperation{
+		Type: name,
+		Args: argsBytes,
+	})
+}
+
+func (s *serializer) decodeOperationArgs(operation *QueuedOperation) (interface{}, error) {
+	switch operation.Type {
+	case operationSaveBlock:
+		args, err := s.deserializeSaveBlock(operation.Payload)
+		if err != nil {
+			return nil, err
+		}
+
+		return createJSONSaveBlockArgs(args)
+	case operationRevertIndexedBlock:
+		header, body, err := s.deserializeRevertIndexedBlock(operation.Payload)
+		if err != nil {
+			return nil, err
+		}
+
+		jsonHeader, err := createJSONObject(nil, header)
+		if err != nil {
+			return nil, err
+		}
+
+		return &jsonRevertIndexedBlockArgs{
+			Header: jsonHeader,
+			Body:   body.(*block.Body),
+		}, nil
+	default:
+		payload, err := createEmptyPayload(operation.Type)
+		if err != nil {
+			return nil, err
+		}
+
+		err = s.marshalizer.Unmarshal(payload, operation.Payload)
+		if err != nil {
+			return nil, err
+		}
+
+		return payload, nil
+	}
+}
+
+func createJSONSaveBlockArgs(args *indexer.ArgsSaveBlockData) (*jsonSaveBlockArgs, error) {
+	header, err := createJSONObject(nil, args.Header)
+	if err != nil {
+		return nil, err
+	}
+
+	jsonArgs := &jsonSaveBlockArgs{
+		HeaderHash:             args.HeaderHash,
+		Header:                 header,
+		Body:                   args.Body.(*block.Body),
+		SignersIndexes:         args.SignersIndexes,
+		NotarizedHeadersHashes: args.NotarizedHeadersHashes,
+		HeaderGasConsumption:   args.HeaderGasConsumption,
+		AlteredAccounts:        args.AlteredAccounts,
+	}
+
+	pool := args.TransactionsPool
+	for _, objects := range []struct {
+		objects map[string]data.TransactionHandler
+		dest    *[]*jsonObject
+	}{
+		{pool.Txs, &jsonArgs.Txs},
+		{pool.Scrs, &jsonArgs.Scrs},
+		{pool.Rewards, &jsonArgs.Rewards},
+		{pool.Invalid, &jsonArgs.Invalid},
+		{pool.Receipts, &jsonArgs.Receipts},
+	} {
+		*objects.dest, err = createJSONObjects(objects.objects)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	jsonArgs.Logs = make([]*jsonLog, 0, len(pool.Logs))
+	for _, logData := range pool.Logs {
+		jsonArgs.Logs = append(jsonArgs.Logs, &jsonLog{
+			TxHash: []byte(logData.TxHash),
+			Log:    logData.LogHandler.(*transaction.Log),
+		})
+	}
+
+	return jsonArgs, nil
+}
+
+func createJSONObjects(objects map[string]data.TransactionHandler) ([]*jsonObject, error) {
+	jsonObjects := make([]*jsonObject, 0, len(objects))
+	for hash, object := range objects {
+		jsonObj, err := createJSONObject([]byte(hash), object)
+		if err != nil {
+			return nil, err
+		}
+
+		jsonObjects = append(jsonObjects, jsonObj)
+	}
+
+	// keep the written records deterministic
+	sort.Slice(jsonObjects, func(i, j int) bool {
+		return string(jsonObjects[i].Hash) < string(jsonObjects[j].Hash)
+	})
+
+	return jsonObjects, nil
+}
+
+func createJSONObject(hash []byte, object interface{}) (*jsonObject, error) {
+	objectType, err := getObjectType(object)
+	if err != nil {
+		return nil, err
+	}
+
+	objectBytes, err := json.Marshal(object)
+	if err != nil {
+		return nil, err
+	}
+
+	return &jsonObject{
+		Hash: hash,
+		Type: objectNames[objectType],
+		Data: objectBytes,
+	}, nil
+}
+
+func createEmptyPayload(operationType uint32) (interface{}, error) {
+	switch operationType {
+	case operationSaveRoundsInfo:
+		return &SaveRoundsInfoPayload{}, nil
+	case operationSaveValidatorsPubKeys:
+		return &SaveValidatorsPubKeysPayload{}, nil
+	case operationSaveValidatorsRating:
+		return &SaveValidatorsRatingPayload{}, nil
+	case operationFinalizedBlock:
+		return &FinalizedBlockPayload{}, nil
+	default:
+		return nil, fmt.Errorf("%w: %d", ErrUnknownOperation, operationType)
+	}
+}
+
+// unmarshalOperationFromJSON rebuilds the queued operation out of its JSON form
+func (s *serializer) unmarshalOperationFromJSON(buff []byte) (*QueuedOperation, error) {
+	record := &jsonOperation{}
+	err := json.Unmarshal(buff, record)
+	if err != nil {
+		return nil, err
+	}
+
+	operationType, ok := getTypeByName(operationNames, record.Type)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownOperation, record.Type)
+	}
+
+	switch operationType {
+	case operationSaveBlock:
+		jsonArgs := &jsonSaveBlockArgs{}
+		err = json.Unmarshal(record.Args, jsonArgs)
+		if err != nil {
+			return nil, err
+		}
+
+		args, errCreate := createSaveBlockArgs(jsonArgs)
+		if errCreate != nil {
+			return nil, errCreate
+		}
+
+		return s.serializeSaveBlock(args)
+	case operationRevertIndexedBlock:
+		jsonArgs := &jsonRevertIndexedBlockArgs{}
+		err = json.Unmarshal(record.Args, jsonArgs)
+		if err != nil {
+			return nil, err
+		}
+
+		header, errCreate := createHeaderFromJSON(jsonArgs.Header)
+		if errCreate != nil {
+			return nil, errCreate
+		}
+
+		return s.serializeRevertIndexedBlock(header, jsonArgs.Body)
+	default:
+		payload, errCreate := createEmptyPayload(operationType)
+		if errCreate != nil {
+			return nil, errCreate
+		}
+
+		err = json.Unmarshal(record.Args, payload)
+		if err != nil {
+			return nil, err
+		}
+
+		return s.createOperation(operationType, payload)
+	}
+}
+
+func createSaveBlockArgs(jsonArgs *jsonSaveBlockArgs) (*indexer.ArgsSaveBlockData, error) {
+	header, err := createHeaderFromJSON(jsonArgs.Header)
+	if err != nil {
+		return nil, err
+	}
+
+	args := &indexer.ArgsSaveBlockData{
+		HeaderHash:             jsonArgs.HeaderHash,
+		Body:                   jsonArgs.Body,
+		Header:                 header,
+		SignersIndexes:         jsonArgs.SignersIndexes,
+		NotarizedHeadersHashes: jsonArgs.NotarizedHeadersHashes,
+		HeaderGasConsumption:   jsonArgs.HeaderGasConsumption,
+		TransactionsPool:       &indexer.Pool{},
+		AlteredAccounts:        jsonArgs.AlteredAccounts,
+	}
+
+	pool := args.TransactionsPool
+	for _, objects := range []struct {
+		jsonObjects []*jsonObject
+		dest        *map[string]data.TransactionHandler
+	}{
+		{jsonArgs.Txs, &pool.Txs},
+		{jsonArgs.Scrs, &pool.Scrs},
+		{jsonArgs.Rewards, &pool.Rewards},
+		{jsonArgs.Invalid, &pool.Invalid},
+		{jsonArgs.Receipts, &pool.Receipts},
+	} {
+		*objects.dest, err = createObjectsMapFromJSON(objects.jsonObjects)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	pool.Logs = make([]*data.LogData, 0, len(jsonArgs.Logs))
+	for _, logRecord := range jsonArgs.Logs {
+		if logRecord == nil || logRecord.Log == nil {
+			return nil, fmt.Errorf("%w for log: missing log", ErrUnsupportedType)
+		}
+
+		pool.Logs = append(pool.Logs, &data.LogData{
+			LogHandler: logRecord.Log,
+			TxHash:     string(logRecord.TxHash),
+		})
+	}
+
+	return args, nil
+}
+
+func createObjectsMapFromJSON(jsonObjects []*jsonObject) (map[string]data.TransactionHandler, error) {
+	objects := make(map[string]data.TransactionHandler, len(jsonObjects))
+	for _, jsonObj := range jsonObjects {
+		object, err := createObjectFromJSON(jsonObj)
+		if err != nil {
+			return nil, err
+		}
+
+		tx, ok := object.(data.TransactionHandler)
+		if !ok {
+			return nil, fmt.Errorf("%w for transaction: %T", ErrUnsupportedType, object)
+		}
+
+		objects[string(jsonObj.Hash)] = tx
+	}
+
+	return objects, nil
+}
+
+func createHeaderFromJSON(jsonObj *jsonObject) (data.HeaderHandler, error) {
+	object, err := createObjectFromJSON(jsonObj)
+	if err != nil {
+		return nil, err
+	}
+
+	header, ok := object.(data.HeaderHandler)
+	if !ok {
+		return nil, fmt.Errorf("%w for header: %T", ErrUnsupportedType, object)
+	}
+
+	return header, nil
+}
+
+func createObjectFromJSON(jsonObj *jsonObject) (interface{}, error) {
+	if jsonObj == nil {
+		return nil, fmt.Errorf("%w: missing object", ErrUnsupportedType)
+	}
+
+	objectType, ok := getTypeByName(objectNames, jsonObj.Type)
+	if !ok {
+		return nil, fmt.Errorf("%w: object type %s", ErrUnsupportedType, jsonObj.Type)
+	}
+
+	object, err := createEmptyObject(objectType)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(jsonObj.Data, object)
+	if err != nil {
+		return nil, err
+	}
+
+	return object, nil
+}
+
+func getTypeByName(names map[uint32]string, name string) (uint32, bool) {
+	for typeID, typeName := range names {
+		if typeName == name {
+			return typeID, true
+		}
+	}
+
+	return 0, false
+}
@@ -0,0 +1,146 @@
+package queue
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/outport"
+)
+
+// OperationHandler receives the operations created by an operations recorder
+type OperationHandler func(operation *QueuedOperation) error
+
+// operationsRecorder is an outport driver that converts each call into a queued operation and hands it over to
+// a handler. It allows other components (e.g. file exporters) to store the driver calls in the same format
+// as the queued driver does, so that they can be replayed later with ApplyOperation
+type operationsRecorder struct {
+	serializer *serializer
+	handler    OperationHandler
+}
+
+// NewOperationsRecorder creates a new operations recorder
+func NewOperationsRecorder(marshalizer marshal.Marshalizer, handler OperationHandler) (*operationsRecorder, error) {
+	if check.IfNil(marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if handler == nil {
+		return nil, ErrNilOperationHandler
+	}
+
+	return &operationsRecorder{
+		serializer: &serializer{marshalizer: marshalizer},
+		handler:    handler,
+	}, nil
+}
+
+// SaveBlock records the save block call
+func (or *operationsRecorder) SaveBlock(args *indexer.ArgsSaveBlockData) error {
+	if args == nil || check.IfNil(args.Header) {
+		return nil
+	}
+
+	return or.record(or.serializer.serializeSaveBlock(args))
+}
+
+// RevertIndexedBlock records the revert indexed block call
+func (or *operationsRecorder) RevertIndexedBlock(header data.HeaderHandler, body data.BodyHandler) error {
+	if check.IfNil(header) {
+		return nil
+	}
+
+	return or.record(or.serializer.serializeRevertIndexedBlock(header, body))
+}
+
+// SaveRoundsInfo records the save rounds info call
+func (or *operationsRecorder) SaveRoundsInfo(roundsInfos []*indexer.RoundInfo) error {
+	return or.record(or.serializer.serializeSaveRoundsInfo(roundsInfos))
+}
+
+// SaveValidatorsPubKeys records the save validators public keys call
+func (or *operationsRecorder) SaveValidatorsPubKeys(validatorsPubKeys map[uint32][][]byte, epoch uint32) error {
+	return or.record(or.serializer.serializeSaveValidatorsPubKeys(validatorsPubKeys, epoch))
+}
+
+// SaveValidatorsRating records the save validators rating call
+func (or *operationsRecorder) SaveValidatorsRating(indexID string, infoRating []*indexer.ValidatorRatingInfo) error {
+	return or.record(or.serializer.serializeSaveValidatorsRating(indexID, infoRating))
+}
+
+// SaveAccounts does nothing, as the accounts cannot be serialized
+func (or *operationsRecorder) SaveAccounts(_ uint64, _ []data.UserAccountHandler) error {
+	return nil
+}
+
+// FinalizedBlock records the finalized block call
+func (or *operationsRecorder) FinalizedBlock(headerHash []byte) error {
+	return or.record(or.serializer.serializeFinalizedBlock(headerHash))
+}
+
+func (or *operationsRecorder) record(operation *QueuedOperation, err error) error {
+	if err != nil {
+		return err
+	}
+
+	return or.handler(operation)
+}
+
+// Close does nothing
+func (or *operationsRecorder) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (or *operationsRecorder) IsInterfaceNil() bool {
+	return or == nil
+}
+
+// ApplyOperation makes, on the provided driver, the call recorded in the operation
+func ApplyOperation(marshalizer marshal.Marshalizer, operation *QueuedOperation, driver outport.Driver) error {
+	if check.IfNil(marshalizer) {
+		return ErrNilMarshalizer
+	}
+	if check.IfNil(driver) {
+		return ErrNilDriver
+	}
+	if operation == nil {
+		return ErrUnknownOperation
+	}
+
+	s := &serializer{marshalizer: marshalizer}
+	call, err := s.createCall(operation)
+	if err != nil {
+		return err
+	}
+
+	return call(driver)
+}
+
+// MarshalOperationToJSON returns the JSON form of the operation: an object holding the name of the recorded driver
+// call in the type field and its decoded arguments in the args field
+func MarshalOperationToJSON(marshalizer marshal.Marshalizer, operation *QueuedOperation) ([]byte, error) {
+	if check.IfNil(marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if operation == nil {
+		return nil, ErrUnknownOperation
+	}
+
+	s := &serializer{marshalizer: marshalizer}
+	return s.marshalOperationToJSON(operation)
+}
+
+// UnmarshalOperationFromJSON rebuilds the operation out of its JSON form, as returned by MarshalOperationToJSON
+func UnmarshalOperationFromJSON(marshalizer marshal.Marshalizer, buff []byte) (*QueuedOperation, error) {
+	if check.IfNil(marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+
+	s := &serializer{marshalizer: marshalizer}
+	return s.unmarshalOperationFromJSON(buff)
+}
+
+// IsSaveBlock returns true if the operation records a save block call
+func (m *QueuedOperation) IsSaveBlock() bool {
+	return m != nil && m.Type == operationSaveBlock
+}
@@ -0,0 +1,155 @@
+package queue
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/outport/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewOperationsRecorder(t *testing.T) {
+	t.Parallel()
+
+	recorder, err := NewOperationsRecorder(nil, func(_ *QueuedOperation) error { return nil })
+	require.Equal(t, ErrNilMarshalizer, err)
+	require.True(t, recorder.IsInterfaceNil())
+
+	recorder, err = NewOperationsRecorder(&marshal.GogoProtoMarshalizer{}, nil)
+	require.Equal(t, ErrNilOperationHandler, err)
+	require.True(t, recorder.IsInterfaceNil())
+
+	recorder, err = NewOperationsRecorder(&marshal.GogoProtoMarshalizer{}, func(_ *QueuedOperation) error { return nil })
+	require.Nil(t, err)
+	require.False(t, recorder.IsInterfaceNil())
+}
+
+func TestOperationsRecorder_RecordedOperationsShouldBeApplied(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &marshal.GogoProtoMarshalizer{}
+	operations := make([]*QueuedOperation, 0)
+	recorder, _ := NewOperationsRecorder(marshalizer, func(operation *QueuedOperation) error {
+		operations = append(operations, operation)
+		return nil
+	})
+
+	require.Nil(t, recorder.SaveBlock(createTestSaveBlockArgs()))
+	require.Nil(t, recorder.SaveRoundsInfo([]*indexer.RoundInfo{{Index: 1}}))
+	require.Nil(t, recorder.SaveAccounts(0, []data.UserAccountHandler{nil}))
+	require.Nil(t, recorder.FinalizedBlock([]byte("header hash")))
+	require.Len(t, operations, 3)
+	require.True(t, operations[0].IsSaveBlock())
+	require.False(t, operations[1].IsSaveBlock())
+
+	calls := make([]string, 0)
+	driver := &mock.DriverStub{
+		SaveBlockCalled: func(args *indexer.ArgsSaveBlockData) error {
+			require.Equal(t, []byte("header hash"), args.HeaderHash)
+			calls = append(calls, "saveBlock")
+			return nil
+		},
+		SaveRoundsInfoCalled: func(roundsInfos []*indexer.RoundInfo) error {
+			require.Equal(t, uint64(1), roundsInfos[0].Index)
+			calls = append(calls, "saveRoundsInfo")
+			return nil
+		},
+		FinalizedBlockCalled: func(headerHash []byte) error {
+			require.Equal(t, []byte("header hash"), headerHash)
+			calls = append(calls, "finalizedBlock")
+			return nil
+		},
+	}
+	for _, operation := range operations {
+		require.Nil(t, ApplyOperation(marshalizer, operation, driver))
+	}
+	require.Equal(t, []string{"saveBlock", "saveRoundsInfo", "finalizedBlock"}, calls)
+}
+
+func TestApplyOperation_InvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &marshal.GogoProtoMarshalizer{}
+	require.Equal(t, ErrNilMarshalizer, ApplyOperation(nil, &QueuedOperation{}, &mock.DriverStub{}))
+	require.Equal(t, ErrNilDriver, ApplyOperation(marshalizer, &QueuedOperation{}, nil))
+	require.Equal(t, ErrUnknownOperation, ApplyOperation(marshalizer, nil, &mock.DriverStub{}))
+}
+
+func TestOperationsJSON_ShouldHoldTheDecodedArgumentsAndRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &marshal.GogoProtoMarshalizer{}
+	operations := make([]*QueuedOperation, 0)
+	recorder, _ := NewOperationsRecorder(marshalizer, func(operation *QueuedOperation) error {
+		operations = append(operations, operation)
+		return nil
+	})
+
+	require.Nil(t, recorder.SaveBlock(createTestSaveBlockArgs()))
+	require.Nil(t, recorder.RevertIndexedBlock(&block.Header{Nonce: 5}, &block.Body{}))
+	require.Nil(t, recorder.SaveRoundsInfo([]*indexer.RoundInfo{{Index: 1, SignersIndexes: []uint64{2}}}))
+	require.Nil(t, recorder.SaveValidatorsPubKeys(map[uint32][][]byte{0: {[]byte("pk0")}, 1: {[]byte("pk1")}}, 3))
+	require.Nil(t, recorder.SaveValidatorsRating("0_1", []*indexer.ValidatorRatingInfo{{PublicKey: "pk", Rating: 50}}))
+	require.Nil(t, recorder.FinalizedBlock([]byte("header hash")))
+
+	expectedTypes := []string{"saveBlock", "revertIndexedBlock", "saveRoundsInfo", "saveValidatorsPubKeys",
+		"saveValidatorsRating", "finalizedBlock"}
+	for i, operation := range operations {
+		buff, err := MarshalOperationToJSON(marshalizer, operation)
+		require.Nil(t, err)
+
+		record := make(map[string]interface{})
+		require.Nil(t, json.Unmarshal(buff, &record))
+		require.Equal(t, expectedTypes[i], record["type"])
+		require.NotNil(t, record["args"])
+
+		rebuilt, err := UnmarshalOperationFromJSON(marshalizer, buff)
+		require.Nil(t, err)
+		require.Equal(t, operation, rebuilt)
+	}
+
+	buff, _ := MarshalOperationToJSON(marshalizer, operations[0])
+	record := &struct {
+		Args struct {
+			Header struct {
+				Type string
+				Data struct {
+					Nonce uint64
+				}
+			}
+			Txs []struct {
+				Type string
+			}
+		}
+	}{}
+	require.Nil(t, json.Unmarshal(buff, record))
+	require.Equal(t, "metaBlock", record.Args.Header.Type)
+	require.Equal(t, uint64(10), record.Args.Header.Data.Nonce)
+	require.Len(t, record.Args.Txs, 1)
+	require.Equal(t, "transaction", record.Args.Txs[0].Type)
+}
+
+func TestOperationsJSON_InvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &marshal.GogoProtoMarshalizer{}
+
+	_, err := MarshalOperationToJSON(nil, &QueuedOperation{})
+	require.Equal(t, ErrNilMarshalizer, err)
+	_, err = MarshalOperationToJSON(marshalizer, nil)
+	require.Equal(t, ErrUnknownOperation, err)
+	_, err = MarshalOperationToJSON(marshalizer, &QueuedOperation{Type: 100})
+	require.True(t, errors.Is(err, ErrUnknownOperation))
+
+	_, err = UnmarshalOperationFromJSON(nil, []byte("{}"))
+	require.Equal(t, ErrNilMarshalizer, err)
+	_, err = UnmarshalOperationFromJSON(marshalizer, []byte(`{"type":"unknown","args":{}}`))
+	require.True(t, errors.Is(err, ErrUnknownOperation))
+	_, err = UnmarshalOperationFromJSON(marshalizer, []byte(`{"type":"revertIndexedBlock","args":{"header":{"type":"unknown"}}}`))
+	require.True(t, errors.Is(err, ErrUnsupportedType))
+}
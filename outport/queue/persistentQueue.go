@@ -0,0 +1,121 @@
+package queue
+
+import (
+	"encoding/binary"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+const sequenceSize = 8
+
+// persistentQueue is a FIFO queue backed by a persister. Each item is stored under its big endian sequence number,
+// so that the queue can be rebuilt after a restart
+type persistentQueue struct {
+	persister   storage.Persister
+	mutex       sync.Mutex
+	head        uint64
+	tail        uint64
+	chanNewItem chan struct{}
+}
+
+// newPersistentQueue creates a queue over the provided persister, calling the handler for each of the already
+// stored items, in no particular order
+func newPersistentQueue(persister storage.Persister, existingItemHandler func(item []byte)) *persistentQueue {
+	pq := &persistentQueue{
+		persister:   persister,
+		chanNewItem: make(chan struct{}, 1),
+	}
+
+	isEmpty := true
+	persister.RangeKeys(func(key []byte, val []byte) bool {
+		if len(key) != sequenceSize {
+			return true
+		}
+
+		sequence := binary.BigEndian.Uint64(key)
+		if isEmpty || sequence < pq.head {
+			pq.head = sequence
+		}
+		if isEmpty || sequence >= pq.tail {
+			pq.tail = sequence + 1
+		}
+		isEmpty = false
+
+		existingItemHandler(val)
+
+		return true
+	})
+
+	return pq
+}
+
+func (pq *persistentQueue) push(item []byte) error {
+	pq.mutex.Lock()
+	err := pq.persister.Put(sequenceKey(pq.tail), item)
+	if err == nil {
+		pq.tail++
+	}
+	pq.mutex.Unlock()
+
+	if err != nil {
+		return err
+	}
+
+	select {
+	case pq.chanNewItem <- struct{}{}:
+	default:
+	}
+
+	return nil
+}
+
+// peek returns the oldest item of the queue, without removing it
+func (pq *persistentQueue) peek() ([]byte, bool, error) {
+	pq.mutex.Lock()
+	defer pq.mutex.Unlock()
+
+	if pq.head == pq.tail {
+		return nil, false, nil
+	}
+
+	item, err := pq.persister.Get(sequenceKey(pq.head))
+	if err != nil {
+		return nil, false, err
+	}
+
+	return item, true, nil
+}
+
+// pop removes the oldest item of the queue
+func (pq *persistentQueue) pop() error {
+	pq.mutex.Lock()
+	defer pq.mutex.Unlock()
+
+	if pq.head == pq.tail {
+		return nil
+	}
+
+	err := pq.persister.Remove(sequenceKey(pq.head))
+	if err != nil {
+		return err
+	}
+
+	pq.head++
+
+	return nil
+}
+
+func (pq *persistentQueue) len() uint64 {
+	pq.mutex.Lock()
+	defer pq.mutex.Unlock()
+
+	return pq.tail - pq.head
+}
+
+func sequenceKey(sequence uint64) []byte {
+	key := make([]byte, sequenceSize)
+	binary.BigEndian.PutUint64(key, sequence)
+
+	return key
+}
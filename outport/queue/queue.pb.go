@@ -0,0 +1,4558 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: queue.proto
+
+package queue
+
+import (
+	bytes "bytes"
+	encoding_binary "encoding/binary"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueuedOperation is used to store a driver call, until it is delivered to the driver
+type QueuedOperation struct {
+	Type    uint32 `protobuf:"varint,1,opt,name=Type,proto3" json:"Type,omitempty"`
+	Payload []byte `protobuf:"bytes,2,opt,name=Payload,proto3" json:"Payload,omitempty"`
+}
+
+func (m *QueuedOperation) Reset()      { *m = QueuedOperation{} }
+func (*QueuedOperation) ProtoMessage() {}
+func (*QueuedOperation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_96e4d7d76a734cd8, []int{0}
+}
+func (m *QueuedOperation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueuedOperation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *QueuedOperation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueuedOperation.Merge(m, src)
+}
+func (m *QueuedOperation) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueuedOperation) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueuedOperation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueuedOperation proto.InternalMessageInfo
+
+func (m *QueuedOperation) GetType() uint32 {
+	if m != nil {
+		return m.Type
+	}
+	return 0
+}
+
+func (m *QueuedOperation) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+// SerializedObject is used to store an object given as an interface, along with its concrete type
+type SerializedObject struct {
+	Hash []byte `protobuf:"bytes,1,opt,name=Hash,proto3" json:"Hash,omitempty"`
+	Type uint32 `protobuf:"varint,2,opt,name=Type,proto3" json:"Type,omitempty"`
+	Data []byte `protobuf:"bytes,3,opt,name=Data,proto3" json:"Data,omitempty"`
+}
+
+func (m *SerializedObject) Reset()      { *m = SerializedObject{} }
+func (*SerializedObject) ProtoMessage() {}
+func (*SerializedObject) Descriptor() ([]byte, []int) {
+	return fileDescriptor_96e4d7d76a734cd8, []int{1}
+}
+func (m *SerializedObject) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SerializedObject) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SerializedObject) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SerializedObject.Merge(m, src)
+}
+func (m *SerializedObject) XXX_Size() int {
+	return m.Size()
+}
+func (m *SerializedObject) XXX_DiscardUnknown() {
+	xxx_messageInfo_SerializedObject.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SerializedObject proto.InternalMessageInfo
+
+func (m *SerializedObject) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *SerializedObject) GetType() uint32 {
+	if m != nil {
+		return m.Type
+	}
+	return 0
+}
+
+func (m *SerializedObject) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+// SerializedLog is used to store a transaction log
+type SerializedLog struct {
+	TxHash []byte `protobuf:"bytes,1,opt,name=TxHash,proto3" json:"TxHash,omitempty"`
+	Log    []byte `protobuf:"bytes,2,opt,name=Log,proto3" json:"Log,omitempty"`
+}
+
+func (m *SerializedLog) Reset()      { *m = SerializedLog{} }
+func (*SerializedLog) ProtoMessage() {}
+func (*SerializedLog) Descriptor() ([]byte, []int) {
+	return fileDescriptor_96e4d7d76a734cd8, []int{2}
+}
+func (m *SerializedLog) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SerializedLog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SerializedLog) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SerializedLog.Merge(m, src)
+}
+func (m *SerializedLog) XXX_Size() int {
+	return m.Size()
+}
+func (m *SerializedLog) XXX_DiscardUnknown() {
+	xxx_messageInfo_SerializedLog.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SerializedLog proto.InternalMessageInfo
+
+func (m *SerializedLog) GetTxHash() []byte {
+	if m != nil {
+		return m.TxHash
+	}
+	return nil
+}
+
+func (m *SerializedLog) GetLog() []byte {
+	if m != nil {
+		return m.Log
+	}
+	return nil
+}
+
+// SaveBlockPayload is used to store the arguments of a SaveBlock call
+type SaveBlockPayload struct {
+	HeaderHash             []byte              `protobuf:"bytes,1,opt,name=HeaderHash,proto3" json:"HeaderHash,omitempty"`
+	Header                 *SerializedObject   `protobuf:"bytes,2,opt,name=Header,proto3" json:"Header,omitempty"`
+	Body                   []byte              `protobuf:"bytes,3,opt,name=Body,proto3" json:"Body,omitempty"`
+	SignersIndexes         []uint64            `protobuf:"varint,4,rep,packed,name=SignersIndexes,proto3" json:"SignersIndexes,omitempty"`
+	NotarizedHeadersHashes []string            `protobuf:"bytes,5,rep,name=NotarizedHeadersHashes,proto3" json:"NotarizedHeadersHashes,omitempty"`
+	GasProvided            uint64              `protobuf:"varint,6,opt,name=GasProvided,proto3" json:"GasProvided,omitempty"`
+	GasRefunded            uint64              `protobuf:"varint,7,opt,name=GasRefunded,proto3" json:"GasRefunded,omitempty"`
+	GasPenalized           uint64              `protobuf:"varint,8,opt,name=GasPenalized,proto3" json:"GasPenalized,omitempty"`
+	MaxGasPerBlock         uint64              `protobuf:"varint,9,opt,name=MaxGasPerBlock,proto3" json:"MaxGasPerBlock,omitempty"`
+	Txs                    []*SerializedObject `protobuf:"bytes,10,rep,name=Txs,proto3" json:"Txs,omitempty"`
+	Scrs                   []*SerializedObject `protobuf:"bytes,11,rep,name=Scrs,proto3" json:"Scrs,omitempty"`
+	Rewards                []*SerializedObject `protobuf:"bytes,12,rep,name=Rewards,proto3" json:"Rewards,omitempty"`
+	Invalid                []*SerializedObject `protobuf:"bytes,13,rep,name=Invalid,proto3" json:"Invalid,omitempty"`
+	Receipts               []*SerializedObject `protobuf:"bytes,14,rep,name=Receipts,proto3" json:"Receipts,omitempty"`
+	Logs                   []*SerializedLog    `protobuf:"bytes,15,rep,name=Logs,proto3" json:"Logs,omitempty"`
+	AlteredAccounts        []byte              `protobuf:"bytes,16,opt,name=AlteredAccounts,proto3" json:"AlteredAccounts,omitempty"`
+}
+
+func (m *SaveBlockPayload) Reset()      { *m = SaveBlockPayload{} }
+func (*SaveBlockPayload) ProtoMessage() {}
+func (*SaveBlockPayload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_96e4d7d76a734cd8, []int{3}
+}
+func (m *SaveBlockPayload) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SaveBlockPayload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SaveBlockPayload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SaveBlockPayload.Merge(m, src)
+}
+func (m *SaveBlockPayload) XXX_Size() int {
+	return m.Size()
+}
+func (m *SaveBlockPayload) XXX_DiscardUnknown() {
+	xxx_messageInfo_SaveBlockPayload.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SaveBlockPayload proto.InternalMessageInfo
+
+func (m *SaveBlockPayload) GetHeaderHash() []byte {
+	if m != nil {
+		return m.HeaderHash
+	}
+	return nil
+}
+
+func (m *SaveBlockPayload) GetHeader() *SerializedObject {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *SaveBlockPayload) GetBody() []byte {
+	if m != nil {
+		return m.Body
+	}
+	return nil
+}
+
+func (m *SaveBlockPayload) GetSignersIndexes() []uint64 {
+	if m != nil {
+		return m.SignersIndexes
+	}
+	return nil
+}
+
+func (m *SaveBlockPayload) GetNotarizedHeadersHashes() []string {
+	if m != nil {
+		return m.NotarizedHeadersHashes
+	}
+	return nil
+}
+
+func (m *SaveBlockPayload) GetGasProvided() uint64 {
+	if m != nil {
+		return m.GasProvided
+	}
+	return 0
+}
+
+func (m *SaveBlockPayload) GetGasRefunded() uint64 {
+	if m != nil {
+		return m.GasRefunded
+	}
+	return 0
+}
+
+func (m *SaveBlockPayload) GetGasPenalized() uint64 {
+	if m != nil {
+		return m.GasPenalized
+	}
+	return 0
+}
+
+func (m *SaveBlockPayload) GetMaxGasPerBlock() uint64 {
+	if m != nil {
+		return m.MaxGasPerBlock
+	}
+	return 0
+}
+
+func (m *SaveBlockPayload) GetTxs() []*SerializedObject {
+	if m != nil {
+		return m.Txs
+	}
+	return nil
+}
+
+func (m *SaveBlockPayload) GetScrs() []*SerializedObject {
+	if m != nil {
+		return m.Scrs
+	}
+	return nil
+}
+
+func (m *SaveBlockPayload) GetRewards() []*SerializedObject {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+func (m *SaveBlockPayload) GetInvalid() []*SerializedObject {
+	if m != nil {
+		return m.Invalid
+	}
+	return nil
+}
+
+func (m *SaveBlockPayload) GetReceipts() []*SerializedObject {
+	if m != nil {
+		return m.Receipts
+	}
+	return nil
+}
+
+func (m *SaveBlockPayload) GetLogs() []*SerializedLog {
+	if m != nil {
+		return m.Logs
+	}
+	return nil
+}
+
+func (m *SaveBlockPayload) GetAlteredAccounts() []byte {
+	if m != nil {
+		return m.AlteredAccounts
+	}
+	return nil
+}
+
+// RevertIndexedBlockPayload is used to store the arguments of a RevertIndexedBlock call
+type RevertIndexedBlockPayload struct {
+	Header *SerializedObject `protobuf:"bytes,1,opt,name=Header,proto3" json:"Header,omitempty"`
+	Body   []byte            `protobuf:"bytes,2,opt,name=Body,proto3" json:"Body,omitempty"`
+}
+
+func (m *RevertIndexedBlockPayload) Reset()      { *m = RevertIndexedBlockPayload{} }
+func (*RevertIndexedBlockPayload) ProtoMessage() {}
+func (*RevertIndexedBlockPayload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_96e4d7d76a734cd8, []int{4}
+}
+func (m *RevertIndexedBlockPayload) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RevertIndexedBlockPayload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RevertIndexedBlockPayload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevertIndexedBlockPayload.Merge(m, src)
+}
+func (m *RevertIndexedBlockPayload) XXX_Size() int {
+	return m.Size()
+}
+func (m *RevertIndexedBlockPayload) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevertIndexedBlockPayload.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevertIndexedBlockPayload proto.InternalMessageInfo
+
+func (m *RevertIndexedBlockPayload) GetHeader() *SerializedObject {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *RevertIndexedBlockPayload) GetBody() []byte {
+	if m != nil {
+		return m.Body
+	}
+	return nil
+}
+
+// RoundInfoRecord is used to store a round info
+type RoundInfoRecord struct {
+	Index            uint64   `protobuf:"varint,1,opt,name=Index,proto3" json:"Index,omitempty"`
+	SignersIndexes   []uint64 `protobuf:"varint,2,rep,packed,name=SignersIndexes,proto3" json:"SignersIndexes,omitempty"`
+	BlockWasProposed bool     `protobuf:"varint,3,opt,name=BlockWasProposed,proto3" json:"BlockWasProposed,omitempty"`
+	ShardId          uint32   `protobuf:"varint,4,opt,name=ShardId,proto3" json:"ShardId,omitempty"`
+	Epoch            uint32   `protobuf:"varint,5,opt,name=Epoch,proto3" json:"Epoch,omitempty"`
+	Timestamp        int64    `protobuf:"varint,6,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
+}
+
+func (m *RoundInfoRecord) Reset()      { *m = RoundInfoRecord{} }
+func (*RoundInfoRecord) ProtoMessage() {}
+func (*RoundInfoRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_96e4d7d76a734cd8, []int{5}
+}
+func (m *RoundInfoRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RoundInfoRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RoundInfoRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoundInfoRecord.Merge(m, src)
+}
+func (m *RoundInfoRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *RoundInfoRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoundInfoRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoundInfoRecord proto.InternalMessageInfo
+
+func (m *RoundInfoRecord) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *RoundInfoRecord) GetSignersIndexes() []uint64 {
+	if m != nil {
+		return m.SignersIndexes
+	}
+	return nil
+}
+
+func (m *RoundInfoRecord) GetBlockWasProposed() bool {
+	if m != nil {
+		return m.BlockWasProposed
+	}
+	return false
+}
+
+func (m *RoundInfoRecord) GetShardId() uint32 {
+	if m != nil {
+		return m.ShardId
+	}
+	return 0
+}
+
+func (m *RoundInfoRecord) GetEpoch() uint32 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *RoundInfoRecord) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+// SaveRoundsInfoPayload is used to store the arguments of a SaveRoundsInfo call
+type SaveRoundsInfoPayload struct {
+	RoundsInfo []*RoundInfoRecord `protobuf:"bytes,1,rep,name=RoundsInfo,proto3" json:"RoundsInfo,omitempty"`
+}
+
+func (m *SaveRoundsInfoPayload) Reset()      { *m = SaveRoundsInfoPayload{} }
+func (*SaveRoundsInfoPayload) ProtoMessage() {}
+func (*SaveRoundsInfoPayload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_96e4d7d76a734cd8, []int{6}
+}
+func (m *SaveRoundsInfoPayload) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SaveRoundsInfoPayload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SaveRoundsInfoPayload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SaveRoundsInfoPayload.Merge(m, src)
+}
+func (m *SaveRoundsInfoPayload) XXX_Size() int {
+	return m.Size()
+}
+func (m *SaveRoundsInfoPayload) XXX_DiscardUnknown() {
+	xxx_messageInfo_SaveRoundsInfoPayload.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SaveRoundsInfoPayload proto.InternalMessageInfo
+
+func (m *SaveRoundsInfoPayload) GetRoundsInfo() []*RoundInfoRecord {
+	if m != nil {
+		return m.RoundsInfo
+	}
+	return nil
+}
+
+// ShardValidatorsPubKeys is used to store the validators public keys of a shard
+type ShardValidatorsPubKeys struct {
+	ShardID uint32   `protobuf:"varint,1,opt,name=ShardID,proto3" json:"ShardID,omitempty"`
+	PubKeys [][]byte `protobuf:"bytes,2,rep,name=PubKeys,proto3" json:"PubKeys,omitempty"`
+}
+
+func (m *ShardValidatorsPubKeys) Reset()      { *m = ShardValidatorsPubKeys{} }
+func (*ShardValidatorsPubKeys) ProtoMessage() {}
+func (*ShardValidatorsPubKeys) Descriptor() ([]byte, []int) {
+	return fileDescriptor_96e4d7d76a734cd8, []int{7}
+}
+func (m *ShardValidatorsPubKeys) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShardValidatorsPubKeys) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ShardValidatorsPubKeys) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShardValidatorsPubKeys.Merge(m, src)
+}
+func (m *ShardValidatorsPubKeys) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShardValidatorsPubKeys) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShardValidatorsPubKeys.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShardValidatorsPubKeys proto.InternalMessageInfo
+
+func (m *ShardValidatorsPubKeys) GetShardID() uint32 {
+	if m != nil {
+		return m.ShardID
+	}
+	return 0
+}
+
+func (m *ShardValidatorsPubKeys) GetPubKeys() [][]byte {
+	if m != nil {
+		return m.PubKeys
+	}
+	return nil
+}
+
+// SaveValidatorsPubKeysPayload is used to store the arguments of a SaveValidatorsPubKeys call
+type SaveValidatorsPubKeysPayload struct {
+	ValidatorsPubKeys []*ShardValidatorsPubKeys `protobuf:"bytes,1,rep,name=ValidatorsPubKeys,proto3" json:"ValidatorsPubKeys,omitempty"`
+	Epoch             uint32                    `protobuf:"varint,2,opt,name=Epoch,proto3" json:"Epoch,omitempty"`
+}
+
+func (m *SaveValidatorsPubKeysPayload) Reset()      { *m = SaveValidatorsPubKeysPayload{} }
+func (*SaveValidatorsPubKeysPayload) ProtoMessage() {}
+func (*SaveValidatorsPubKeysPayload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_96e4d7d76a734cd8, []int{8}
+}
+func (m *SaveValidatorsPubKeysPayload) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SaveValidatorsPubKeysPayload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SaveValidatorsPubKeysPayload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SaveValidatorsPubKeysPayload.Merge(m, src)
+}
+func (m *SaveValidatorsPubKeysPayload) XXX_Size() int {
+	return m.Size()
+}
+func (m *SaveValidatorsPubKeysPayload) XXX_DiscardUnknown() {
+	xxx_messageInfo_SaveValidatorsPubKeysPayload.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SaveValidatorsPubKeysPayload proto.InternalMessageInfo
+
+func (m *SaveValidatorsPubKeysPayload) GetValidatorsPubKeys() []*ShardValidatorsPubKeys {
+	if m != nil {
+		return m.ValidatorsPubKeys
+	}
+	return nil
+}
+
+func (m *SaveValidatorsPubKeysPayload) GetEpoch() uint32 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+// ValidatorRatingRecord is used to store the rating of a validator
+type ValidatorRatingRecord struct {
+	PublicKey string  `protobuf:"bytes,1,opt,name=PublicKey,proto3" json:"PublicKey,omitempty"`
+	Rating    float32 `protobuf:"fixed32,2,opt,name=Rating,proto3" json:"Rating,omitempty"`
+}
+
+func (m *ValidatorRatingRecord) Reset()      { *m = ValidatorRatingRecord{} }
+func (*ValidatorRatingRecord) ProtoMessage() {}
+func (*ValidatorRatingRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_96e4d7d76a734cd8, []int{9}
+}
+func (m *ValidatorRatingRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorRatingRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ValidatorRatingRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorRatingRecord.Merge(m, src)
+}
+func (m *ValidatorRatingRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorRatingRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorRatingRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorRatingRecord proto.InternalMessageInfo
+
+func (m *ValidatorRatingRecord) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
+	}
+	return ""
+}
+
+func (m *ValidatorRatingRecord) GetRating() float32 {
+	if m != nil {
+		return m.Rating
+	}
+	return 0
+}
+
+// SaveValidatorsRatingPayload is used to store the arguments of a SaveValidatorsRating call
+type SaveValidatorsRatingPayload struct {
+	IndexID    string                   `protobuf:"bytes,1,opt,name=IndexID,proto3" json:"IndexID,omitempty"`
+	InfoRating []*ValidatorRatingRecord `protobuf:"bytes,2,rep,name=InfoRating,proto3" json:"InfoRating,omitempty"`
+}
+
+func (m *SaveValidatorsRatingPayload) Reset()      { *m = SaveValidatorsRatingPayload{} }
+func (*SaveValidatorsRatingPayload) ProtoMessage() {}
+func (*SaveValidatorsRatingPayload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_96e4d7d76a734cd8, []int{10}
+}
+func (m *SaveValidatorsRatingPayload) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SaveValidatorsRatingPayload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SaveValidatorsRatingPayload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SaveValidatorsRatingPayload.Merge(m, src)
+}
+func (m *SaveValidatorsRatingPayload) XXX_Size() int {
+	return m.Size()
+}
+func (m *SaveValidatorsRatingPayload) XXX_DiscardUnknown() {
+	xxx_messageInfo_SaveValidatorsRatingPayload.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SaveValidatorsRatingPayload proto.InternalMessageInfo
+
+func (m *SaveValidatorsRatingPayload) GetIndexID() string {
+	if m != nil {
+		return m.IndexID
+	}
+	return ""
+}
+
+func (m *SaveValidatorsRatingPayload) GetInfoRating() []*ValidatorRatingRecord {
+	if m != nil {
+		return m.InfoRating
+	}
+	return nil
+}
+
+// FinalizedBlockPayload is used to store the arguments of a FinalizedBlock call
+type FinalizedBlockPayload struct {
+	HeaderHash []byte `protobuf:"bytes,1,opt,name=HeaderHash,proto3" json:"HeaderHash,omitempty"`
+}
+
+func (m *FinalizedBlockPayload) Reset()      { *m = FinalizedBlockPayload{} }
+func (*FinalizedBlockPayload) ProtoMessage() {}
+func (*FinalizedBlockPayload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_96e4d7d76a734cd8, []int{11}
+}
+func (m *FinalizedBlockPayload) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FinalizedBlockPayload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *FinalizedBlockPayload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalizedBlockPayload.Merge(m, src)
+}
+func (m *FinalizedBlockPayload) XXX_Size() int {
+	return m.Size()
+}
+func (m *FinalizedBlockPayload) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalizedBlockPayload.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalizedBlockPayload proto.InternalMessageInfo
+
+func (m *FinalizedBlockPayload) GetHeaderHash() []byte {
+	if m != nil {
+		return m.HeaderHash
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueuedOperation)(nil), "proto.QueuedOperation")
+	proto.RegisterType((*SerializedObject)(nil), "proto.SerializedObject")
+	proto.RegisterType((*SerializedLog)(nil), "proto.SerializedLog")
+	proto.RegisterType((*SaveBlockPayload)(nil), "proto.SaveBlockPayload")
+	proto.RegisterType((*RevertIndexedBlockPayload)(nil), "proto.RevertIndexedBlockPayload")
+	proto.RegisterType((*RoundInfoRecord)(nil), "proto.RoundInfoRecord")
+	proto.RegisterType((*SaveRoundsInfoPayload)(nil), "proto.SaveRoundsInfoPayload")
+	proto.RegisterType((*ShardValidatorsPubKeys)(nil), "proto.ShardValidatorsPubKeys")
+	proto.RegisterType((*SaveValidatorsPubKeysPayload)(nil), "proto.SaveValidatorsPubKeysPayload")
+	proto.RegisterType((*ValidatorRatingRecord)(nil), "proto.ValidatorRatingRecord")
+	proto.RegisterType((*SaveValidatorsRatingPayload)(nil), "proto.SaveValidatorsRatingPayload")
+	proto.RegisterType((*FinalizedBlockPayload)(nil), "proto.FinalizedBlockPayload")
+}
+
+func init() { proto.RegisterFile("queue.proto", fileDescriptor_96e4d7d76a734cd8) }
+
+var fileDescriptor_96e4d7d76a734cd8 = []byte{
+	// 840 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x41, 0x6f, 0xe3, 0x44,
+	0x14, 0xce, 0x24, 0x4e, 0xdb, 0xbc, 0xa6, 0xdb, 0x30, 0xda, 0x96, 0x01, 0x8a, 0x15, 0xf9, 0x80,
+	0x02, 0x88, 0x56, 0xb0, 0xd2, 0x22, 0x24, 0xa4, 0xd5, 0x56, 0x0b, 0x6c, 0xd5, 0xec, 0xb6, 0x4c,
+	0x2a, 0x90, 0x38, 0x31, 0xf1, 0x4c, 0x5d, 0x43, 0xea, 0x09, 0x1e, 0xbb, 0x24, 0x9c, 0xe0, 0x1f,
+	0xf0, 0x33, 0x38, 0xf1, 0x3b, 0xe0, 0xd6, 0x63, 0x8f, 0x34, 0xbd, 0x70, 0xdc, 0x9f, 0x80, 0xe6,
+	0xd9, 0xae, 0xdd, 0xa4, 0x74, 0xd5, 0x53, 0xdf, 0xfb, 0xfc, 0xbd, 0xaf, 0xdf, 0x7b, 0xf3, 0x66,
+	0x02, 0xab, 0x3f, 0xa5, 0x2a, 0x55, 0xdb, 0xe3, 0x58, 0x27, 0x9a, 0x36, 0xf1, 0xcf, 0xdb, 0x1f,
+	0x05, 0x61, 0x72, 0x92, 0x0e, 0xb7, 0x7d, 0x7d, 0xba, 0x13, 0xe8, 0x40, 0xef, 0x20, 0x3c, 0x4c,
+	0x8f, 0x31, 0xc3, 0x04, 0xa3, 0xac, 0xca, 0x7b, 0x02, 0xeb, 0x5f, 0x5b, 0x11, 0x79, 0x30, 0x56,
+	0xb1, 0x48, 0x42, 0x1d, 0x51, 0x0a, 0xce, 0xd1, 0x74, 0xac, 0x18, 0xe9, 0x92, 0xde, 0x1a, 0xc7,
+	0x98, 0x32, 0x58, 0x3e, 0x14, 0xd3, 0x91, 0x16, 0x92, 0xd5, 0xbb, 0xa4, 0xd7, 0xe6, 0x45, 0xea,
+	0xbd, 0x84, 0xce, 0x40, 0xc5, 0xa1, 0x18, 0x85, 0xbf, 0x28, 0x79, 0x30, 0xfc, 0x41, 0xf9, 0x89,
+	0x55, 0x78, 0x2e, 0xcc, 0x09, 0x2a, 0xb4, 0x39, 0xc6, 0xd7, 0xaa, 0xf5, 0x8a, 0x2a, 0x05, 0xe7,
+	0x99, 0x48, 0x04, 0x6b, 0x64, 0x3c, 0x1b, 0x7b, 0x9f, 0xc1, 0x5a, 0xa9, 0xd7, 0xd7, 0x01, 0xdd,
+	0x84, 0xa5, 0xa3, 0x49, 0x45, 0x2e, 0xcf, 0x68, 0x07, 0x1a, 0x7d, 0x1d, 0xe4, 0x76, 0x6c, 0xe8,
+	0xfd, 0xd9, 0x84, 0xce, 0x40, 0x9c, 0xa9, 0xdd, 0x91, 0xf6, 0x7f, 0xcc, 0xfd, 0x51, 0x17, 0xe0,
+	0xb9, 0x12, 0x52, 0xc5, 0x15, 0x89, 0x0a, 0x42, 0x77, 0x60, 0x29, 0xcb, 0x50, 0x69, 0xf5, 0x93,
+	0x37, 0xb3, 0xc1, 0x6c, 0xcf, 0x37, 0xc5, 0x73, 0x9a, 0x35, 0xbd, 0xab, 0xe5, 0xb4, 0x30, 0x6d,
+	0x63, 0xfa, 0x1e, 0x3c, 0x18, 0x84, 0x41, 0xa4, 0x62, 0xb3, 0x17, 0x49, 0x35, 0x51, 0x86, 0x39,
+	0xdd, 0x46, 0xcf, 0xe1, 0x73, 0x28, 0x7d, 0x0c, 0x9b, 0x2f, 0x75, 0x22, 0x62, 0x2b, 0x9b, 0xc9,
+	0x19, 0x6b, 0x42, 0x19, 0xd6, 0xec, 0x36, 0x7a, 0x2d, 0xfe, 0x3f, 0x5f, 0x69, 0x17, 0x56, 0xbf,
+	0x12, 0xe6, 0x30, 0xd6, 0x67, 0xa1, 0x54, 0x92, 0x2d, 0x75, 0x49, 0xcf, 0xe1, 0x55, 0x28, 0x67,
+	0x70, 0x75, 0x9c, 0x46, 0x96, 0xb1, 0x7c, 0xcd, 0x28, 0x20, 0xea, 0x41, 0xdb, 0x16, 0xa8, 0x28,
+	0xeb, 0x8a, 0xad, 0x20, 0xe5, 0x06, 0x66, 0xfb, 0x78, 0x21, 0x26, 0x08, 0xc5, 0x38, 0x45, 0xd6,
+	0x42, 0xd6, 0x1c, 0x4a, 0xdf, 0x87, 0xc6, 0xd1, 0xc4, 0x30, 0xe8, 0x36, 0xee, 0x9a, 0x98, 0xe5,
+	0xd0, 0x0f, 0xc1, 0x19, 0xf8, 0xb1, 0x61, 0xab, 0x77, 0x73, 0x91, 0x44, 0x3f, 0x86, 0x65, 0xae,
+	0x7e, 0x16, 0xb1, 0x34, 0xac, 0x7d, 0x37, 0xbf, 0xe0, 0xd9, 0x92, 0xbd, 0xe8, 0x4c, 0x8c, 0x42,
+	0xc9, 0xd6, 0x5e, 0x53, 0x92, 0xf3, 0xe8, 0x23, 0x58, 0xe1, 0xca, 0x57, 0xe1, 0x38, 0x31, 0xec,
+	0xc1, 0xdd, 0x35, 0xd7, 0x44, 0xda, 0x03, 0xa7, 0xaf, 0x03, 0xc3, 0xd6, 0xb1, 0xe0, 0xe1, 0x42,
+	0x41, 0x5f, 0x07, 0x1c, 0x19, 0xb4, 0x07, 0xeb, 0x4f, 0x47, 0x89, 0x8a, 0x95, 0x7c, 0xea, 0xfb,
+	0x3a, 0x8d, 0x12, 0xc3, 0x3a, 0xb8, 0x2b, 0xf3, 0xb0, 0xf7, 0x3d, 0xbc, 0xc5, 0xd5, 0x99, 0x8a,
+	0x93, 0x6c, 0x3f, 0xe4, 0x8d, 0xc5, 0x2d, 0x17, 0x93, 0xdc, 0x6f, 0x31, 0xeb, 0xe5, 0x62, 0x7a,
+	0x7f, 0x13, 0x58, 0xe7, 0x3a, 0x8d, 0xe4, 0x5e, 0x74, 0xac, 0xb9, 0xf2, 0x75, 0x2c, 0xe9, 0x43,
+	0x68, 0xe2, 0xff, 0x43, 0x5d, 0x87, 0x67, 0xc9, 0x2d, 0x2b, 0x5c, 0xbf, 0x75, 0x85, 0x3f, 0x80,
+	0x0e, 0xda, 0xfc, 0x16, 0x97, 0x6f, 0xac, 0x8d, 0x92, 0x78, 0x15, 0x56, 0xf8, 0x02, 0x6e, 0x5f,
+	0x8d, 0xc1, 0x89, 0x88, 0xe5, 0x9e, 0x64, 0x0e, 0x5e, 0xfb, 0x22, 0xb5, 0x1e, 0xbe, 0x18, 0x6b,
+	0xff, 0x84, 0x35, 0x11, 0xcf, 0x12, 0xba, 0x05, 0xad, 0xa3, 0xf0, 0x54, 0x99, 0x44, 0x9c, 0x8e,
+	0x71, 0xc9, 0x1b, 0xbc, 0x04, 0xbc, 0x03, 0xd8, 0xb0, 0xb7, 0x1b, 0xdb, 0x31, 0xb6, 0x9f, 0x62,
+	0x52, 0x8f, 0x01, 0x4a, 0x90, 0x11, 0x3c, 0xa0, 0xcd, 0x7c, 0x5a, 0x73, 0xcd, 0xf3, 0x0a, 0xd3,
+	0xeb, 0xc3, 0x26, 0xfa, 0xf9, 0xc6, 0x6e, 0x85, 0x48, 0x74, 0x6c, 0x0e, 0xd3, 0xe1, 0xbe, 0x9a,
+	0x9a, 0xd2, 0xf8, 0xb3, 0xfc, 0x15, 0x2c, 0x52, 0xfb, 0x25, 0x27, 0xe1, 0x7c, 0xda, 0xbc, 0x48,
+	0xbd, 0xdf, 0x08, 0x6c, 0x59, 0x7f, 0x0b, 0x6a, 0x85, 0xcd, 0x7d, 0x78, 0x63, 0xe1, 0x5b, 0xee,
+	0xf6, 0xdd, 0xe2, 0x6c, 0x6f, 0xb5, 0xc3, 0x17, 0xeb, 0xca, 0x01, 0xd6, 0x2b, 0x03, 0xf4, 0x5e,
+	0xc0, 0xc6, 0x35, 0x95, 0x8b, 0x24, 0x8c, 0x82, 0xfc, 0xcc, 0xb7, 0xa0, 0x75, 0x98, 0x0e, 0x47,
+	0xa1, 0xbf, 0xaf, 0xa6, 0xd8, 0x52, 0x8b, 0x97, 0x80, 0x7d, 0x62, 0x33, 0x36, 0xaa, 0xd5, 0x79,
+	0x9e, 0x79, 0x29, 0xbc, 0x73, 0xb3, 0xa3, 0x0c, 0x2f, 0x1a, 0x62, 0xf6, 0xea, 0x49, 0x35, 0xc9,
+	0xa7, 0xd4, 0xe2, 0x45, 0x4a, 0x3f, 0x07, 0xc0, 0x99, 0x17, 0xa2, 0xb6, 0xc7, 0xad, 0xbc, 0xc7,
+	0x5b, 0x0d, 0xf2, 0x0a, 0xdf, 0xfb, 0x14, 0x36, 0xbe, 0x0c, 0xf3, 0x27, 0xe9, 0x3e, 0x6f, 0xf9,
+	0xee, 0x93, 0xf3, 0x4b, 0xb7, 0x76, 0x71, 0xe9, 0xd6, 0x5e, 0x5d, 0xba, 0xe4, 0xd7, 0x99, 0x4b,
+	0xfe, 0x98, 0xb9, 0xe4, 0xaf, 0x99, 0x4b, 0xce, 0x67, 0x2e, 0xb9, 0x98, 0xb9, 0xe4, 0x9f, 0x99,
+	0x4b, 0xfe, 0x9d, 0xb9, 0xb5, 0x57, 0x33, 0x97, 0xfc, 0x7e, 0xe5, 0xd6, 0xce, 0xaf, 0xdc, 0xda,
+	0xc5, 0x95, 0x5b, 0xfb, 0xae, 0x89, 0xbf, 0xa4, 0xc3, 0x25, 0xb4, 0xf8, 0xe8, 0xbf, 0x01, 0x00,
+	0x2b, 0x51, 0x6a, 0x9f, 0x59, 0x07, 0x00, 0x00,
+}
+
+func (this *QueuedOperation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*QueuedOperation)
+	if !ok {
+		that2, ok := that.(QueuedOperation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if !bytes.Equal(this.Payload, that1.Payload) {
+		return false
+	}
+	return true
+}
+func (this *SerializedObject) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SerializedObject)
+	if !ok {
+		that2, ok := that.(SerializedObject)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Hash, that1.Hash) {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if !bytes.Equal(this.Data, that1.Data) {
+		return false
+	}
+	return true
+}
+func (this *SerializedLog) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SerializedLog)
+	if !ok {
+		that2, ok := that.(SerializedLog)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.TxHash, that1.TxHash) {
+		return false
+	}
+	if !bytes.Equal(this.Log, that1.Log) {
+		return false
+	}
+	return true
+}
+func (this *SaveBlockPayload) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SaveBlockPayload)
+	if !ok {
+		that2, ok := that.(SaveBlockPayload)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.HeaderHash, that1.HeaderHash) {
+		return false
+	}
+	if !this.Header.Equal(that1.Header) {
+		return false
+	}
+	if !bytes.Equal(this.Body, that1.Body) {
+		return false
+	}
+	if len(this.SignersIndexes) != len(that1.SignersIndexes) {
+		return false
+	}
+	for i := range this.SignersIndexes {
+		if this.SignersIndexes[i] != that1.SignersIndexes[i] {
+			return false
+		}
+	}
+	if len(this.NotarizedHeadersHashes) != len(that1.NotarizedHeadersHashes) {
+		return false
+	}
+	for i := range this.NotarizedHeadersHashes {
+		if this.NotarizedHeadersHashes[i] != that1.NotarizedHeadersHashes[i] {
+			return false
+		}
+	}
+	if this.GasProvided != that1.GasProvided {
+		return false
+	}
+	if this.GasRefunded != that1.GasRefunded {
+		return false
+	}
+	if this.GasPenalized != that1.GasPenalized {
+		return false
+	}
+	if this.MaxGasPerBlock != that1.MaxGasPerBlock {
+		return false
+	}
+	if len(this.Txs) != len(that1.Txs) {
+		return false
+	}
+	for i := range this.Txs {
+		if !this.Txs[i].Equal(that1.Txs[i]) {
+			return false
+		}
+	}
+	if len(this.Scrs) != len(that1.Scrs) {
+		return false
+	}
+	for i := range this.Scrs {
+		if !this.Scrs[i].Equal(that1.Scrs[i]) {
+			return false
+		}
+	}
+	if len(this.Rewards) != len(that1.Rewards) {
+		return false
+	}
+	for i := range this.Rewards {
+		if !this.Rewards[i].Equal(that1.Rewards[i]) {
+			return false
+		}
+	}
+	if len(this.Invalid) != len(that1.Invalid) {
+		return false
+	}
+	for i := range this.Invalid {
+		if !this.Invalid[i].Equal(that1.Invalid[i]) {
+			return false
+		}
+	}
+	if len(this.Receipts) != len(that1.Receipts) {
+		return false
+	}
+	for i := range this.Receipts {
+		if !this.Receipts[i].Equal(that1.Receipts[i]) {
+			return false
+		}
+	}
+	if len(this.Logs) != len(that1.Logs) {
+		return false
+	}
+	for i := range this.Logs {
+		if !this.Logs[i].Equal(that1.Logs[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.AlteredAccounts, that1.AlteredAccounts) {
+		return false
+	}
+	return true
+}
+func (this *RevertIndexedBlockPayload) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RevertIndexedBlockPayload)
+	if !ok {
+		that2, ok := that.(RevertIndexedBlockPayload)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Header.Equal(that1.Header) {
+		return false
+	}
+	if !bytes.Equal(this.Body, that1.Body) {
+		return false
+	}
+	return true
+}
+func (this *RoundInfoRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RoundInfoRecord)
+	if !ok {
+		that2, ok := that.(RoundInfoRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Index != that1.Index {
+		return false
+	}
+	if len(this.SignersIndexes) != len(that1.SignersIndexes) {
+		return false
+	}
+	for i := range this.SignersIndexes {
+		if this.SignersIndexes[i] != that1.SignersIndexes[i] {
+			return false
+		}
+	}
+	if this.BlockWasProposed != that1.BlockWasProposed {
+		return false
+	}
+	if this.ShardId != that1.ShardId {
+		return false
+	}
+	if this.Epoch != that1.Epoch {
+		return false
+	}
+	if this.Timestamp != that1.Timestamp {
+		return false
+	}
+	return true
+}
+func (this *SaveRoundsInfoPayload) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SaveRoundsInfoPayload)
+	if !ok {
+		that2, ok := that.(SaveRoundsInfoPayload)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.RoundsInfo) != len(that1.RoundsInfo) {
+		return false
+	}
+	for i := range this.RoundsInfo {
+		if !this.RoundsInfo[i].Equal(that1.RoundsInfo[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *ShardValidatorsPubKeys) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ShardValidatorsPubKeys)
+	if !ok {
+		that2, ok := that.(ShardValidatorsPubKeys)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ShardID != that1.ShardID {
+		return false
+	}
+	if len(this.PubKeys) != len(that1.PubKeys) {
+		return false
+	}
+	for i := range this.PubKeys {
+		if !bytes.Equal(this.PubKeys[i], that1.PubKeys[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *SaveValidatorsPubKeysPayload) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SaveValidatorsPubKeysPayload)
+	if !ok {
+		that2, ok := that.(SaveValidatorsPubKeysPayload)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.ValidatorsPubKeys) != len(that1.ValidatorsPubKeys) {
+		return false
+	}
+	for i := range this.ValidatorsPubKeys {
+		if !this.ValidatorsPubKeys[i].Equal(that1.ValidatorsPubKeys[i]) {
+			return false
+		}
+	}
+	if this.Epoch != that1.Epoch {
+		return false
+	}
+	return true
+}
+func (this *ValidatorRatingRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ValidatorRatingRecord)
+	if !ok {
+		that2, ok := that.(ValidatorRatingRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PublicKey != that1.PublicKey {
+		return false
+	}
+	if this.Rating != that1.Rating {
+		return false
+	}
+	return true
+}
+func (this *SaveValidatorsRatingPayload) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SaveValidatorsRatingPayload)
+	if !ok {
+		that2, ok := that.(SaveValidatorsRatingPayload)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.IndexID != that1.IndexID {
+		return false
+	}
+	if len(this.InfoRating) != len(that1.InfoRating) {
+		return false
+	}
+	for i := range this.InfoRating {
+		if !this.InfoRating[i].Equal(that1.InfoRating[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *FinalizedBlockPayload) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FinalizedBlockPayload)
+	if !ok {
+		that2, ok := that.(FinalizedBlockPayload)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.HeaderHash, that1.HeaderHash) {
+		return false
+	}
+	return true
+}
+func (this *QueuedOperation) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&queue.QueuedOperation{")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	s = append(s, "Payload: "+fmt.Sprintf("%#v", this.Payload)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SerializedObject) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&queue.SerializedObject{")
+	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SerializedLog) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&queue.SerializedLog{")
+	s = append(s, "TxHash: "+fmt.Sprintf("%#v", this.TxHash)+",\n")
+	s = append(s, "Log: "+fmt.Sprintf("%#v", this.Log)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SaveBlockPayload) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 20)
+	s = append(s, "&queue.SaveBlockPayload{")
+	s = append(s, "HeaderHash: "+fmt.Sprintf("%#v", this.HeaderHash)+",\n")
+	if this.Header != nil {
+		s = append(s, "Header: "+fmt.Sprintf("%#v", this.Header)+",\n")
+	}
+	s = append(s, "Body: "+fmt.Sprintf("%#v", this.Body)+",\n")
+	s = append(s, "SignersIndexes: "+fmt.Sprintf("%#v", this.SignersIndexes)+",\n")
+	s = append(s, "NotarizedHeadersHashes: "+fmt.Sprintf("%#v", this.NotarizedHeadersHashes)+",\n")
+	s = append(s, "GasProvided: "+fmt.Sprintf("%#v", this.GasProvided)+",\n")
+	s = append(s, "GasRefunded: "+fmt.Sprintf("%#v", this.GasRefunded)+",\n")
+	s = append(s, "GasPenalized: "+fmt.Sprintf("%#v", this.GasPenalized)+",\n")
+	s = append(s, "MaxGasPerBlock: "+fmt.Sprintf("%#v", this.MaxGasPerBlock)+",\n")
+	if this.Txs != nil {
+		s = append(s, "Txs: "+fmt.Sprintf("%#v", this.Txs)+",\n")
+	}
+	if this.Scrs != nil {
+		s = append(s, "Scrs: "+fmt.Sprintf("%#v", this.Scrs)+",\n")
+	}
+	if this.Rewards != nil {
+		s = append(s, "Rewards: "+fmt.Sprintf("%#v", this.Rewards)+",\n")
+	}
+	if this.Invalid != nil {
+		s = append(s, "Invalid: "+fmt.Sprintf("%#v", this.Invalid)+",\n")
+	}
+	if this.Receipts != nil {
+		s = append(s, "Receipts: "+fmt.Sprintf("%#v", this.Receipts)+",\n")
+	}
+	if this.Logs != nil {
+		s = append(s, "Logs: "+fmt.Sprintf("%#v", this.Logs)+",\n")
+	}
+	s = append(s, "AlteredAccounts: "+fmt.Sprintf("%#v", this.AlteredAccounts)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RevertIndexedBlockPayload) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&queue.RevertIndexedBlockPayload{")
+	if this.Header != nil {
+		s = append(s, "Header: "+fmt.Sprintf("%#v", this.Header)+",\n")
+	}
+	s = append(s, "Body: "+fmt.Sprintf("%#v", this.Body)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RoundInfoRecord) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&queue.RoundInfoRecord{")
+	s = append(s, "Index: "+fmt.Sprintf("%#v", this.Index)+",\n")
+	s = append(s, "SignersIndexes: "+fmt.Sprintf("%#v", this.SignersIndexes)+",\n")
+	s = append(s, "BlockWasProposed: "+fmt.Sprintf("%#v", this.BlockWasProposed)+",\n")
+	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
+	s = append(s, "Epoch: "+fmt.Sprintf("%#v", this.Epoch)+",\n")
+	s = append(s, "Timestamp: "+fmt.Sprintf("%#v", this.Timestamp)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SaveRoundsInfoPayload) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&queue.SaveRoundsInfoPayload{")
+	if this.RoundsInfo != nil {
+		s = append(s, "RoundsInfo: "+fmt.Sprintf("%#v", this.RoundsInfo)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ShardValidatorsPubKeys) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&queue.ShardValidatorsPubKeys{")
+	s = append(s, "ShardID: "+fmt.Sprintf("%#v", this.ShardID)+",\n")
+	s = append(s, "PubKeys: "+fmt.Sprintf("%#v", this.PubKeys)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SaveValidatorsPubKeysPayload) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&queue.SaveValidatorsPubKeysPayload{")
+	if this.ValidatorsPubKeys != nil {
+		s = append(s, "ValidatorsPubKeys: "+fmt.Sprintf("%#v", this.ValidatorsPubKeys)+",\n")
+	}
+	s = append(s, "Epoch: "+fmt.Sprintf("%#v", this.Epoch)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ValidatorRatingRecord) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&queue.ValidatorRatingRecord{")
+	s = append(s, "PublicKey: "+fmt.Sprintf("%#v", this.PublicKey)+",\n")
+	s = append(s, "Rating: "+fmt.Sprintf("%#v", this.Rating)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SaveValidatorsRatingPayload) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&queue.SaveValidatorsRatingPayload{")
+	s = append(s, "IndexID: "+fmt.Sprintf("%#v", this.IndexID)+",\n")
+	if this.InfoRating != nil {
+		s = append(s, "InfoRating: "+fmt.Sprintf("%#v", this.InfoRating)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *FinalizedBlockPayload) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&queue.FinalizedBlockPayload{")
+	s = append(s, "HeaderHash: "+fmt.Sprintf("%#v", this.HeaderHash)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringQueue(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *QueuedOperation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueuedOperation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueuedOperation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintQueue(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintQueue(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SerializedObject) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SerializedObject) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SerializedObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintQueue(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Type != 0 {
+		i = encodeVarintQueue(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintQueue(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SerializedLog) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SerializedLog) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SerializedLog) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Log) > 0 {
+		i -= len(m.Log)
+		copy(dAtA[i:], m.Log)
+		i = encodeVarintQueue(dAtA, i, uint64(len(m.Log)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintQueue(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SaveBlockPayload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SaveBlockPayload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SaveBlockPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AlteredAccounts) > 0 {
+		i -= len(m.AlteredAccounts)
+		copy(dAtA[i:], m.AlteredAccounts)
+		i = encodeVarintQueue(dAtA, i, uint64(len(m.AlteredAccounts)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.Logs) > 0 {
+		for iNdEx := len(m.Logs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Logs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQueue(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.Receipts) > 0 {
+		for iNdEx := len(m.Receipts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Receipts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQueue(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.Invalid) > 0 {
+		for iNdEx := len(m.Invalid) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Invalid[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQueue(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQueue(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.Scrs) > 0 {
+		for iNdEx := len(m.Scrs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Scrs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQueue(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.Txs) > 0 {
+		for iNdEx := len(m.Txs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Txs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQueue(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.MaxGasPerBlock != 0 {
+		i = encodeVarintQueue(dAtA, i, uint64(m.MaxGasPerBlock))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.GasPenalized != 0 {
+		i = encodeVarintQueue(dAtA, i, uint64(m.GasPenalized))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.GasRefunded != 0 {
+		i = encodeVarintQueue(dAtA, i, uint64(m.GasRefunded))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.GasProvided != 0 {
+		i = encodeVarintQueue(dAtA, i, uint64(m.GasProvided))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.NotarizedHeadersHashes) > 0 {
+		for iNdEx := len(m.NotarizedHeadersHashes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.NotarizedHeadersHashes[iNdEx])
+			copy(dAtA[i:], m.NotarizedHeadersHashes[iNdEx])
+			i = encodeVarintQueue(dAtA, i, uint64(len(m.NotarizedHeadersHashes[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.SignersIndexes) > 0 {
+		dAtA2 := make([]byte, len(m.SignersIndexes)*10)
+		var j1 int
+		for _, num := range m.SignersIndexes {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintQueue(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Body) > 0 {
+		i -= len(m.Body)
+		copy(dAtA[i:], m.Body)
+		i = encodeVarintQueue(dAtA, i, uint64(len(m.Body)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Header != nil {
+		{
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQueue(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.HeaderHash) > 0 {
+		i -= len(m.HeaderHash)
+		copy(dAtA[i:], m.HeaderHash)
+		i = encodeVarintQueue(dAtA, i, uint64(len(m.HeaderHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RevertIndexedBlockPayload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RevertIndexedBlockPayload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RevertIndexedBlockPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Body) > 0 {
+		i -= len(m.Body)
+		copy(dAtA[i:], m.Body)
+		i = encodeVarintQueue(dAtA, i, uint64(len(m.Body)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Header != nil {
+		{
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQueue(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RoundInfoRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RoundInfoRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RoundInfoRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		i = encodeVarintQueue(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintQueue(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ShardId != 0 {
+		i = encodeVarintQueue(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BlockWasProposed {
+		i--
+		if m.BlockWasProposed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.SignersIndexes) > 0 {
+		dAtA6 := make([]byte, len(m.SignersIndexes)*10)
+		var j5 int
+		for _, num := range m.SignersIndexes {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintQueue(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Index != 0 {
+		i = encodeVarintQueue(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SaveRoundsInfoPayload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SaveRoundsInfoPayload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SaveRoundsInfoPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RoundsInfo) > 0 {
+		for iNdEx := len(m.RoundsInfo) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RoundsInfo[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQueue(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ShardValidatorsPubKeys) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ShardValidatorsPubKeys) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShardValidatorsPubKeys) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PubKeys) > 0 {
+		for iNdEx := len(m.PubKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PubKeys[iNdEx])
+			copy(dAtA[i:], m.PubKeys[iNdEx])
+			i = encodeVarintQueue(dAtA, i, uint64(len(m.PubKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.ShardID != 0 {
+		i = encodeVarintQueue(dAtA, i, uint64(m.ShardID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SaveValidatorsPubKeysPayload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SaveValidatorsPubKeysPayload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SaveValidatorsPubKeysPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		i = encodeVarintQueue(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorsPubKeys) > 0 {
+		for iNdEx := len(m.ValidatorsPubKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorsPubKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQueue(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorRatingRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorRatingRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorRatingRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Rating != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Rating))))
+		i--
+		dAtA[i] = 0x15
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintQueue(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SaveValidatorsRatingPayload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SaveValidatorsRatingPayload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SaveValidatorsRatingPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.InfoRating) > 0 {
+		for iNdEx := len(m.InfoRating) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InfoRating[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQueue(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.IndexID) > 0 {
+		i -= len(m.IndexID)
+		copy(dAtA[i:], m.IndexID)
+		i = encodeVarintQueue(dAtA, i, uint64(len(m.IndexID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FinalizedBlockPayload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FinalizedBlockPayload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FinalizedBlockPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HeaderHash) > 0 {
+		i -= len(m.HeaderHash)
+		copy(dAtA[i:], m.HeaderHash)
+		i = encodeVarintQueue(dAtA, i, uint64(len(m.HeaderHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQueue(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQueue(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueuedOperation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovQueue(uint64(m.Type))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovQueue(uint64(l))
+	}
+	return n
+}
+
+func (m *SerializedObject) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovQueue(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovQueue(uint64(m.Type))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovQueue(uint64(l))
+	}
+	return n
+}
+
+func (m *SerializedLog) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovQueue(uint64(l))
+	}
+	l = len(m.Log)
+	if l > 0 {
+		n += 1 + l + sovQueue(uint64(l))
+	}
+	return n
+}
+
+func (m *SaveBlockPayload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.HeaderHash)
+	if l > 0 {
+		n += 1 + l + sovQueue(uint64(l))
+	}
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovQueue(uint64(l))
+	}
+	l = len(m.Body)
+	if l > 0 {
+		n += 1 + l + sovQueue(uint64(l))
+	}
+	if len(m.SignersIndexes) > 0 {
+		l = 0
+		for _, e := range m.SignersIndexes {
+			l += sovQueue(uint64(e))
+		}
+		n += 1 + sovQueue(uint64(l)) + l
+	}
+	if len(m.NotarizedHeadersHashes) > 0 {
+		for _, s := range m.NotarizedHeadersHashes {
+			l = len(s)
+			n += 1 + l + sovQueue(uint64(l))
+		}
+	}
+	if m.GasProvided != 0 {
+		n += 1 + sovQueue(uint64(m.GasProvided))
+	}
+	if m.GasRefunded != 0 {
+		n += 1 + sovQueue(uint64(m.GasRefunded))
+	}
+	if m.GasPenalized != 0 {
+		n += 1 + sovQueue(uint64(m.GasPenalized))
+	}
+	if m.MaxGasPerBlock != 0 {
+		n += 1 + sovQueue(uint64(m.MaxGasPerBlock))
+	}
+	if len(m.Txs) > 0 {
+		for _, e := range m.Txs {
+			l = e.Size()
+			n += 1 + l + sovQueue(uint64(l))
+		}
+	}
+	if len(m.Scrs) > 0 {
+		for _, e := range m.Scrs {
+			l = e.Size()
+			n += 1 + l + sovQueue(uint64(l))
+		}
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQueue(uint64(l))
+		}
+	}
+	if len(m.Invalid) > 0 {
+		for _, e := range m.Invalid {
+			l = e.Size()
+			n += 1 + l + sovQueue(uint64(l))
+		}
+	}
+	if len(m.Receipts) > 0 {
+		for _, e := range m.Receipts {
+			l = e.Size()
+			n += 1 + l + sovQueue(uint64(l))
+		}
+	}
+	if len(m.Logs) > 0 {
+		for _, e := range m.Logs {
+			l = e.Size()
+			n += 1 + l + sovQueue(uint64(l))
+		}
+	}
+	l = len(m.AlteredAccounts)
+	if l > 0 {
+		n += 2 + l + sovQueue(uint64(l))
+	}
+	return n
+}
+
+func (m *RevertIndexedBlockPayload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovQueue(uint64(l))
+	}
+	l = len(m.Body)
+	if l > 0 {
+		n += 1 + l + sovQueue(uint64(l))
+	}
+	return n
+}
+
+func (m *RoundInfoRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovQueue(uint64(m.Index))
+	}
+	if len(m.SignersIndexes) > 0 {
+		l = 0
+		for _, e := range m.SignersIndexes {
+			l += sovQueue(uint64(e))
+		}
+		n += 1 + sovQueue(uint64(l)) + l
+	}
+	if m.BlockWasProposed {
+		n += 2
+	}
+	if m.ShardId != 0 {
+		n += 1 + sovQueue(uint64(m.ShardId))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovQueue(uint64(m.Epoch))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovQueue(uint64(m.Timestamp))
+	}
+	return n
+}
+
+func (m *SaveRoundsInfoPayload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RoundsInfo) > 0 {
+		for _, e := range m.RoundsInfo {
+			l = e.Size()
+			n += 1 + l + sovQueue(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ShardValidatorsPubKeys) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShardID != 0 {
+		n += 1 + sovQueue(uint64(m.ShardID))
+	}
+	if len(m.PubKeys) > 0 {
+		for _, b := range m.PubKeys {
+			l = len(b)
+			n += 1 + l + sovQueue(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SaveValidatorsPubKeysPayload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ValidatorsPubKeys) > 0 {
+		for _, e := range m.ValidatorsPubKeys {
+			l = e.Size()
+			n += 1 + l + sovQueue(uint64(l))
+		}
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovQueue(uint64(m.Epoch))
+	}
+	return n
+}
+
+func (m *ValidatorRatingRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovQueue(uint64(l))
+	}
+	if m.Rating != 0 {
+		n += 5
+	}
+	return n
+}
+
+func (m *SaveValidatorsRatingPayload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.IndexID)
+	if l > 0 {
+		n += 1 + l + sovQueue(uint64(l))
+	}
+	if len(m.InfoRating) > 0 {
+		for _, e := range m.InfoRating {
+			l = e.Size()
+			n += 1 + l + sovQueue(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FinalizedBlockPayload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.HeaderHash)
+	if l > 0 {
+		n += 1 + l + sovQueue(uint64(l))
+	}
+	return n
+}
+
+func sovQueue(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQueue(x uint64) (n int) {
+	return sovQueue(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *QueuedOperation) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&QueuedOperation{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Payload:` + fmt.Sprintf("%v", this.Payload) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SerializedObject) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SerializedObject{`,
+		`Hash:` + fmt.Sprintf("%v", this.Hash) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SerializedLog) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SerializedLog{`,
+		`TxHash:` + fmt.Sprintf("%v", this.TxHash) + `,`,
+		`Log:` + fmt.Sprintf("%v", this.Log) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SaveBlockPayload) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForTxs := "[]*SerializedObject{"
+	for _, f := range this.Txs {
+		repeatedStringForTxs += strings.Replace(f.String(), "SerializedObject", "SerializedObject", 1) + ","
+	}
+	repeatedStringForTxs += "}"
+	repeatedStringForScrs := "[]*SerializedObject{"
+	for _, f := range this.Scrs {
+		repeatedStringForScrs += strings.Replace(f.String(), "SerializedObject", "SerializedObject", 1) + ","
+	}
+	repeatedStringForScrs += "}"
+	repeatedStringForRewards := "[]*SerializedObject{"
+	for _, f := range this.Rewards {
+		repeatedStringForRewards += strings.Replace(f.String(), "SerializedObject", "SerializedObject", 1) + ","
+	}
+	repeatedStringForRewards += "}"
+	repeatedStringForInvalid := "[]*SerializedObject{"
+	for _, f := range this.Invalid {
+		repeatedStringForInvalid += strings.Replace(f.String(), "SerializedObject", "SerializedObject", 1) + ","
+	}
+	repeatedStringForInvalid += "}"
+	repeatedStringForReceipts := "[]*SerializedObject{"
+	for _, f := range this.Receipts {
+		repeatedStringForReceipts += strings.Replace(f.String(), "SerializedObject", "SerializedObject", 1) + ","
+	}
+	repeatedStringForReceipts += "}"
+	repeatedStringForLogs := "[]*SerializedLog{"
+	for _, f := range this.Logs {
+		repeatedStringForLogs += strings.Replace(f.String(), "SerializedLog", "SerializedLog", 1) + ","
+	}
+	repeatedStringForLogs += "}"
+	s := strings.Join([]string{`&SaveBlockPayload{`,
+		`HeaderHash:` + fmt.Sprintf("%v", this.HeaderHash) + `,`,
+		`Header:` + strings.Replace(this.Header.String(), "SerializedObject", "SerializedObject", 1) + `,`,
+		`Body:` + fmt.Sprintf("%v", this.Body) + `,`,
+		`SignersIndexes:` + fmt.Sprintf("%v", this.SignersIndexes) + `,`,
+		`NotarizedHeadersHashes:` + fmt.Sprintf("%v", this.NotarizedHeadersHashes) + `,`,
+		`GasProvided:` + fmt.Sprintf("%v", this.GasProvided) + `,`,
+		`GasRefunded:` + fmt.Sprintf("%v", this.GasRefunded) + `,`,
+		`GasPenalized:` + fmt.Sprintf("%v", this.GasPenalized) + `,`,
+		`MaxGasPerBlock:` + fmt.Sprintf("%v", this.MaxGasPerBlock) + `,`,
+		`Txs:` + repeatedStringForTxs + `,`,
+		`Scrs:` + repeatedStringForScrs + `,`,
+		`Rewards:` + repeatedStringForRewards + `,`,
+		`Invalid:` + repeatedStringForInvalid + `,`,
+		`Receipts:` + repeatedStringForReceipts + `,`,
+		`Logs:` + repeatedStringForLogs + `,`,
+		`AlteredAccounts:` + fmt.Sprintf("%v", this.AlteredAccounts) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RevertIndexedBlockPayload) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RevertIndexedBlockPayload{`,
+		`Header:` + strings.Replace(this.Header.String(), "SerializedObject", "SerializedObject", 1) + `,`,
+		`Body:` + fmt.Sprintf("%v", this.Body) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RoundInfoRecord) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RoundInfoRecord{`,
+		`Index:` + fmt.Sprintf("%v", this.Index) + `,`,
+		`SignersIndexes:` + fmt.Sprintf("%v", this.SignersIndexes) + `,`,
+		`BlockWasProposed:` + fmt.Sprintf("%v", this.BlockWasProposed) + `,`,
+		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
+		`Epoch:` + fmt.Sprintf("%v", this.Epoch) + `,`,
+		`Timestamp:` + fmt.Sprintf("%v", this.Timestamp) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SaveRoundsInfoPayload) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForRoundsInfo := "[]*RoundInfoRecord{"
+	for _, f := range this.RoundsInfo {
+		repeatedStringForRoundsInfo += strings.Replace(f.String(), "RoundInfoRecord", "RoundInfoRecord", 1) + ","
+	}
+	repeatedStringForRoundsInfo += "}"
+	s := strings.Join([]string{`&SaveRoundsInfoPayload{`,
+		`RoundsInfo:` + repeatedStringForRoundsInfo + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ShardValidatorsPubKeys) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ShardValidatorsPubKeys{`,
+		`ShardID:` + fmt.Sprintf("%v", this.ShardID) + `,`,
+		`PubKeys:` + fmt.Sprintf("%v", this.PubKeys) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SaveValidatorsPubKeysPayload) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForValidatorsPubKeys := "[]*ShardValidatorsPubKeys{"
+	for _, f := range this.ValidatorsPubKeys {
+		repeatedStringForValidatorsPubKeys += strings.Replace(f.String(), "ShardValidatorsPubKeys", "ShardValidatorsPubKeys", 1) + ","
+	}
+	repeatedStringForValidatorsPubKeys += "}"
+	s := strings.Join([]string{`&SaveValidatorsPubKeysPayload{`,
+		`ValidatorsPubKeys:` + repeatedStringForValidatorsPubKeys + `,`,
+		`Epoch:` + fmt.Sprintf("%v", this.Epoch) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ValidatorRatingRecord) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ValidatorRatingRecord{`,
+		`PublicKey:` + fmt.Sprintf("%v", this.PublicKey) + `,`,
+		`Rating:` + fmt.Sprintf("%v", this.Rating) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SaveValidatorsRatingPayload) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForInfoRating := "[]*ValidatorRatingRecord{"
+	for _, f := range this.InfoRating {
+		repeatedStringForInfoRating += strings.Replace(f.String(), "ValidatorRatingRecord", "ValidatorRatingRecord", 1) + ","
+	}
+	repeatedStringForInfoRating += "}"
+	s := strings.Join([]string{`&SaveValidatorsRatingPayload{`,
+		`IndexID:` + fmt.Sprintf("%v", this.IndexID) + `,`,
+		`InfoRating:` + repeatedStringForInfoRating + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FinalizedBlockPayload) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FinalizedBlockPayload{`,
+		`HeaderHash:` + fmt.Sprintf("%v", this.HeaderHash) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringQueue(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *QueuedOperation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueue
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueuedOperation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueuedOperation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQueue
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueue(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SerializedObject) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueue
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SerializedObject: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SerializedObject: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQueue
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQueue
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueue(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SerializedLog) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueue
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SerializedLog: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SerializedLog: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQueue
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = append(m.TxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxHash == nil {
+				m.TxHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Log", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQueue
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Log = append(m.Log[:0], dAtA[iNdEx:postIndex]...)
+			if m.Log == nil {
+				m.Log = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueue(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SaveBlockPayload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueue
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SaveBlockPayload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SaveBlockPayload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeaderHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQueue
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HeaderHash = append(m.HeaderHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.HeaderHash == nil {
+				m.HeaderHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueue
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &SerializedObject{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQueue
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Body = append(m.Body[:0], dAtA[iNdEx:postIndex]...)
+			if m.Body == nil {
+				m.Body = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQueue
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SignersIndexes = append(m.SignersIndexes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQueue
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQueue
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQueue
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.SignersIndexes) == 0 {
+					m.SignersIndexes = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQueue
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.SignersIndexes = append(m.SignersIndexes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignersIndexes", wireType)
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotarizedHeadersHashes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NotarizedHeadersHashes = append(m.NotarizedHeadersHashes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasProvided", wireType)
+			}
+			m.GasProvided = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasProvided |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasRefunded", wireType)
+			}
+			m.GasRefunded = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasRefunded |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPenalized", wireType)
+			}
+			m.GasPenalized = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasPenalized |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasPerBlock", wireType)
+			}
+			m.MaxGasPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGasPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueue
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txs = append(m.Txs, &SerializedObject{})
+			if err := m.Txs[len(m.Txs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scrs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueue
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scrs = append(m.Scrs, &SerializedObject{})
+			if err := m.Scrs[len(m.Scrs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueue
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, &SerializedObject{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Invalid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueue
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Invalid = append(m.Invalid, &SerializedObject{})
+			if err := m.Invalid[len(m.Invalid)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receipts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueue
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receipts = append(m.Receipts, &SerializedObject{})
+			if err := m.Receipts[len(m.Receipts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueue
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Logs = append(m.Logs, &SerializedLog{})
+			if err := m.Logs[len(m.Logs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AlteredAccounts", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQueue
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AlteredAccounts = append(m.AlteredAccounts[:0], dAtA[iNdEx:postIndex]...)
+			if m.AlteredAccounts == nil {
+				m.AlteredAccounts = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueue(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RevertIndexedBlockPayload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueue
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RevertIndexedBlockPayload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RevertIndexedBlockPayload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueue
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &SerializedObject{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQueue
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Body = append(m.Body[:0], dAtA[iNdEx:postIndex]...)
+			if m.Body == nil {
+				m.Body = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueue(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RoundInfoRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueue
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RoundInfoRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RoundInfoRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQueue
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SignersIndexes = append(m.SignersIndexes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQueue
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQueue
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQueue
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.SignersIndexes) == 0 {
+					m.SignersIndexes = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQueue
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.SignersIndexes = append(m.SignersIndexes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignersIndexes", wireType)
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockWasProposed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BlockWasProposed = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueue(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SaveRoundsInfoPayload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueue
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SaveRoundsInfoPayload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SaveRoundsInfoPayload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoundsInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueue
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoundsInfo = append(m.RoundsInfo, &RoundInfoRecord{})
+			if err := m.RoundsInfo[len(m.RoundsInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueue(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ShardValidatorsPubKeys) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueue
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShardValidatorsPubKeys: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShardValidatorsPubKeys: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardID", wireType)
+			}
+			m.ShardID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKeys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQueue
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKeys = append(m.PubKeys, make([]byte, postIndex-iNdEx))
+			copy(m.PubKeys[len(m.PubKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueue(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SaveValidatorsPubKeysPayload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueue
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SaveValidatorsPubKeysPayload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SaveValidatorsPubKeysPayload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsPubKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueue
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorsPubKeys = append(m.ValidatorsPubKeys, &ShardValidatorsPubKeys{})
+			if err := m.ValidatorsPubKeys[len(m.ValidatorsPubKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueue(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorRatingRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueue
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorRatingRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorRatingRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rating", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Rating = float32(math.Float32frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueue(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SaveValidatorsRatingPayload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueue
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SaveValidatorsRatingPayload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SaveValidatorsRatingPayload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IndexID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InfoRating", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueue
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InfoRating = append(m.InfoRating, &ValidatorRatingRecord{})
+			if err := m.InfoRating[len(m.InfoRating)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueue(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FinalizedBlockPayload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueue
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FinalizedBlockPayload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FinalizedBlockPayload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeaderHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQueue
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HeaderHash = append(m.HeaderHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.HeaderHash == nil {
+				m.HeaderHash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueue(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQueue(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQueue
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQueue
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQueue
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQueue
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQueue        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQueue          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQueue = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,343 @@
+package queue
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/outport"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var log = logger.GetOrCreate("outport/queue")
+
+const minimumRetrialInterval = time.Millisecond * 10
+
+// ArgsQueuedDriver holds the arguments needed to create a new queued driver
+type ArgsQueuedDriver struct {
+	Name            string
+	Driver          outport.Driver
+	Persister       storage.Persister
+	Marshalizer     marshal.Marshalizer
+	StatusHandler   core.AppStatusHandler
+	RetrialInterval time.Duration
+}
+
+// queuedDriver wraps an outport driver with a persistent write-ahead queue. The calls are acknowledged as soon as
+// they are written in the queue and are delivered to the wrapped driver, in order, on a separate go routine.
+// The undelivered calls survive a node restart. A call interrupted by closing the node is delivered again on the
+// next start, so the wrapped drivers should handle the same call more than once
+type queuedDriver struct {
+	name            string
+	driver          outport.Driver
+	persister       storage.Persister
+	marshalizer     marshal.Marshalizer
+	statusHandler   core.AppStatusHandler
+	retrialInterval time.Duration
+	serializer      *serializer
+	queue           *persistentQueue
+
+	mutPendingBlocks sync.Mutex
+	numPendingBlocks uint64
+
+	cancelFunc              func()
+	chanStopped             chan struct{}
+	closeOnce               sync.Once
+	pendingOperationsMetric string
+	pendingBlocksMetric     string
+}
+
+// NewQueuedDriver creates a new queued driver and starts delivering the already queued operations, if any
+func NewQueuedDriver(args ArgsQueuedDriver) (*queuedDriver, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	qd := &queuedDriver{
+		name:                    args.Name,
+		driver:                  args.Driver,
+		persister:               args.Persister,
+		marshalizer:             args.Marshalizer,
+		statusHandler:           args.StatusHandler,
+		retrialInterval:         args.RetrialInterval,
+		serializer:              &serializer{marshalizer: args.Marshalizer},
+		chanStopped:             make(chan struct{}),
+		pendingOperationsMetric: fmt.Sprintf("%s_%s", common.MetricOutportQueuePendingOperations, args.Name),
+		pendingBlocksMetric:     fmt.Sprintf("%s_%s", common.MetricOutportQueuePendingBlocks, args.Name),
+	}
+
+	qd.queue = newPersistentQueue(args.Persister, qd.countExistingOperation)
+	qd.updateMetrics()
+
+	log.Debug("queued driver created", "driver", qd.name, "pending operations", qd.queue.len())
+
+	var ctx context.Context
+	ctx, qd.cancelFunc = context.WithCancel(context.Background())
+	go qd.processQueue(ctx)
+
+	return qd, nil
+}
+
+func checkArgs(args ArgsQueuedDriver) error {
+	if len(args.Name) == 0 {
+		return ErrEmptyDriverName
+	}
+	if check.IfNil(args.Driver) {
+		return ErrNilDriver
+	}
+	if check.IfNil(args.Persister) {
+		return ErrNilPersister
+	}
+	if check.IfNil(args.Marshalizer) {
+		return ErrNilMarshalizer
+	}
+	if check.IfNil(args.StatusHandler) {
+		return ErrNilStatusHandler
+	}
+	if args.RetrialInterval < minimumRetrialInterval {
+		return fmt.Errorf("%w, provided: %d, minimum: %d", ErrInvalidRetrialInterval, args.RetrialInterval, minimumRetrialInterval)
+	}
+
+	return nil
+}
+
+func (qd *queuedDriver) countExistingOperation(item []byte) {
+	operation := &QueuedOperation{}
+	err := qd.marshalizer.Unmarshal(operation, item)
+	if err != nil {
+		return
+	}
+
+	if operation.IsSaveBlock() {
+		qd.numPendingBlocks++
+	}
+}
+
+// SaveBlock queues the save block call
+func (qd *queuedDriver) SaveBlock(args *indexer.ArgsSaveBlockData) error {
+	if args == nil || check.IfNil(args.Header) {
+		return nil
+	}
+
+	operation, err := qd.serializer.serializeSaveBlock(args)
+	if err != nil {
+		return err
+	}
+
+	return qd.push(operation)
+}
+
+// RevertIndexedBlock queues the revert indexed block call
+func (qd *queuedDriver) RevertIndexedBlock(header data.HeaderHandler, body data.BodyHandler) error {
+	if check.IfNil(header) {
+		return nil
+	}
+
+	operation, err := qd.serializer.serializeRevertIndexedBlock(header, body)
+	if err != nil {
+		return err
+	}
+
+	return qd.push(operation)
+}
+
+// SaveRoundsInfo queues the save rounds info call
+func (qd *queuedDriver) SaveRoundsInfo(roundsInfos []*indexer.RoundInfo) error {
+	operation, err := qd.serializer.serializeSaveRoundsInfo(roundsInfos)
+	if err != nil {
+		return err
+	}
+
+	return qd.push(operation)
+}
+
+// SaveValidatorsPubKeys queues the save validators public keys call
+func (qd *queuedDriver) SaveValidatorsPubKeys(validatorsPubKeys map[uint32][][]byte, epoch uint32) error {
+	operation, err := qd.serializer.serializeSaveValidatorsPubKeys(validatorsPubKeys, epoch)
+	if err != nil {
+		return err
+	}
+
+	return qd.push(operation)
+}
+
+// SaveValidatorsRating queues the save validators rating call
+func (qd *queuedDriver) SaveValidatorsRating(indexID string, infoRating []*indexer.ValidatorRatingInfo) error {
+	operation, err := qd.serializer.serializeSaveValidatorsRating(indexID, infoRating)
+	if err != nil {
+		return err
+	}
+
+	return qd.push(operation)
+}
+
+// SaveAccounts directly calls the wrapped driver, as the accounts cannot be persisted. It is only called for the
+// genesis accounts, before any block is saved
+func (qd *queuedDriver) SaveAccounts(blockTimestamp uint64, acc []data.UserAccountHandler) error {
+	return qd.driver.SaveAccounts(blockTimestamp, acc)
+}
+
+// FinalizedBlock queues the finalized block call
+func (qd *queuedDriver) FinalizedBlock(headerHash []byte) error {
+	operation, err := qd.serializer.serializeFinalizedBlock(headerHash)
+	if err != nil {
+		return err
+	}
+
+	return qd.push(operation)
+}
+
+func (qd *queuedDriver) push(operation *QueuedOperation) error {
+	item, err := qd.marshalizer.Marshal(operation)
+	if err != nil {
+		return err
+	}
+
+	err = qd.queue.push(item)
+	if err != nil {
+		return err
+	}
+
+	if operation.Type == operationSaveBlock {
+		qd.mutPendingBlocks.Lock()
+		qd.numPendingBlocks++
+		qd.mutPendingBlocks.Unlock()
+	}
+	qd.updateMetrics()
+
+	return nil
+}
+
+func (qd *queuedDriver) processQueue(ctx context.Context) {
+	defer close(qd.chanStopped)
+
+	for {
+		item, ok, err := qd.queue.peek()
+		if err != nil {
+			log.Error("queued driver: cannot read the queue, will retry", "driver", qd.name, "error", err)
+			if qd.shouldStop(ctx) {
+				return
+			}
+			continue
+		}
+		if !ok {
+			select {
+			case <-qd.queue.chanNewItem:
+				continue
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		operation := &QueuedOperation{}
+		err = qd.marshalizer.Unmarshal(operation, item)
+		if err == nil {
+			err = qd.deliver(ctx, operation)
+			if ctx.Err() != nil {
+				return
+			}
+		}
+		if err != nil {
+			log.Error("queued driver: dropping operation that cannot be decoded", "driver", qd.name, "error", err)
+		}
+
+		qd.removeDelivered(ctx, operation)
+	}
+}
+
+// deliver calls the wrapped driver until it succeeds or the queued driver is closed. It only returns the
+// errors of operations that could not be decoded
+func (qd *queuedDriver) deliver(ctx context.Context, operation *QueuedOperation) error {
+	call, err := qd.serializer.createCall(operation)
+	if err != nil {
+		return err
+	}
+
+	for {
+		err = call(qd.driver)
+		if err == nil {
+			return nil
+		}
+
+		log.Error("queued driver: error delivering operation, will retry",
+			"driver", qd.name,
+			"retrial in", qd.retrialInterval,
+			"error", err)
+
+		if qd.shouldStop(ctx) {
+			return nil
+		}
+	}
+}
+
+func (qd *queuedDriver) removeDelivered(ctx context.Context, operation *QueuedOperation) {
+	for {
+		err := qd.queue.pop()
+		if err == nil {
+			break
+		}
+
+		log.Error("queued driver: cannot remove delivered operation, will retry", "driver", qd.name, "error", err)
+		if qd.shouldStop(ctx) {
+			return
+		}
+	}
+
+	if operation.Type == operationSaveBlock {
+		qd.mutPendingBlocks.Lock()
+		if qd.numPendingBlocks > 0 {
+			qd.numPendingBlocks--
+		}
+		qd.mutPendingBlocks.Unlock()
+	}
+	qd.updateMetrics()
+}
+
+func (qd *queuedDriver) shouldStop(ctx context.Context) bool {
+	select {
+	case <-ctx.Done():
+		return true
+	case <-time.After(qd.retrialInterval):
+		return false
+	}
+}
+
+func (qd *queuedDriver) updateMetrics() {
+	qd.mutPendingBlocks.Lock()
+	numPendingBlocks := qd.numPendingBlocks
+	qd.mutPendingBlocks.Unlock()
+
+	qd.statusHandler.SetUInt64Value(qd.pendingOperationsMetric, qd.queue.len())
+	qd.statusHandler.SetUInt64Value(qd.pendingBlocksMetric, numPendingBlocks)
+}
+
+// Close stops the delivery, keeping the undelivered operations for the next start, and closes the wrapped driver
+func (qd *queuedDriver) Close() error {
+	var err error
+	qd.closeOnce.Do(func() {
+		qd.cancelFunc()
+		<-qd.chanStopped
+
+		err = qd.driver.Close()
+		errClose := qd.persister.Close()
+		if err == nil {
+			err = errClose
+		}
+	})
+
+	return err
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (qd *queuedDriver) IsInterfaceNil() bool {
+	return qd == nil
+}
@@ -0,0 +1,245 @@
+package queue
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/outport/mock"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/ElrondNetwork/elrond-go/testscommon/statusHandler"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsQueuedDriver() ArgsQueuedDriver {
+	return ArgsQueuedDriver{
+		Name:            "test",
+		Driver:          &mock.DriverStub{},
+		Persister:       memorydb.New(),
+		Marshalizer:     &marshal.GogoProtoMarshalizer{},
+		StatusHandler:   statusHandler.NewAppStatusHandlerMock(),
+		RetrialInterval: minimumRetrialInterval,
+	}
+}
+
+// recordingDriver records the delivered nonces and fails while isDown is set
+type recordingDriver struct {
+	mock.DriverStub
+	mut            sync.Mutex
+	isDown         bool
+	nonces         []uint64
+	finalizedCalls int
+}
+
+func newRecordingDriver(isDown bool) *recordingDriver {
+	rd := &recordingDriver{isDown: isDown}
+	rd.SaveBlockCalled = func(args *indexer.ArgsSaveBlockData) error {
+		rd.mut.Lock()
+		defer rd.mut.Unlock()
+
+		if rd.isDown {
+			return errors.New("driver down")
+		}
+		rd.nonces = append(rd.nonces, args.Header.GetNonce())
+		return nil
+	}
+	rd.FinalizedBlockCalled = func(headerHash []byte) error {
+		rd.mut.Lock()
+		defer rd.mut.Unlock()
+
+		if rd.isDown {
+			return errors.New("driver down")
+		}
+		rd.finalizedCalls++
+		return nil
+	}
+
+	return rd
+}
+
+func (rd *recordingDriver) setDown(isDown bool) {
+	rd.mut.Lock()
+	rd.isDown = isDown
+	rd.mut.Unlock()
+}
+
+func (rd *recordingDriver) deliveredNonces() []uint64 {
+	rd.mut.Lock()
+	defer rd.mut.Unlock()
+
+	return append([]uint64{}, rd.nonces...)
+}
+
+func saveTestBlocks(t *testing.T, qd *queuedDriver, fromNonce uint64, toNonce uint64) {
+	for nonce := fromNonce; nonce <= toNonce; nonce++ {
+		err := qd.SaveBlock(&indexer.ArgsSaveBlockData{
+			HeaderHash: []byte(fmt.Sprintf("hash%d", nonce)),
+			Header:     &block.Header{Nonce: nonce},
+			Body:       &block.Body{},
+		})
+		require.Nil(t, err)
+	}
+}
+
+func waitForNonces(t *testing.T, driver *recordingDriver, numNonces int) {
+	for i := 0; i < 200; i++ {
+		if len(driver.deliveredNonces()) >= numNonces {
+			return
+		}
+		time.Sleep(time.Millisecond * 10)
+	}
+
+	require.Fail(t, "timeout waiting for the delivery")
+}
+
+func TestNewQueuedDriver(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty name should error", func(t *testing.T) {
+		args := createMockArgsQueuedDriver()
+		args.Name = ""
+		qd, err := NewQueuedDriver(args)
+		require.Equal(t, ErrEmptyDriverName, err)
+		require.Nil(t, qd)
+	})
+
+	t.Run("nil driver should error", func(t *testing.T) {
+		args := createMockArgsQueuedDriver()
+		args.Driver = nil
+		qd, err := NewQueuedDriver(args)
+		require.Equal(t, ErrNilDriver, err)
+		require.Nil(t, qd)
+	})
+
+	t.Run("nil persister should error", func(t *testing.T) {
+		args := createMockArgsQueuedDriver()
+		args.Persister = nil
+		qd, err := NewQueuedDriver(args)
+		require.Equal(t, ErrNilPersister, err)
+		require.Nil(t, qd)
+	})
+
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		args := createMockArgsQueuedDriver()
+		args.Marshalizer = nil
+		qd, err := NewQueuedDriver(args)
+		require.Equal(t, ErrNilMarshalizer, err)
+		require.Nil(t, qd)
+	})
+
+	t.Run("nil status handler should error", func(t *testing.T) {
+		args := createMockArgsQueuedDriver()
+		args.StatusHandler = nil
+		qd, err := NewQueuedDriver(args)
+		require.Equal(t, ErrNilStatusHandler, err)
+		require.Nil(t, qd)
+	})
+
+	t.Run("invalid retrial interval should error", func(t *testing.T) {
+		args := createMockArgsQueuedDriver()
+		args.RetrialInterval = 0
+		qd, err := NewQueuedDriver(args)
+		require.True(t, errors.Is(err, ErrInvalidRetrialInterval))
+		require.Nil(t, qd)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		qd, err := NewQueuedDriver(createMockArgsQueuedDriver())
+		require.Nil(t, err)
+		require.False(t, qd.IsInterfaceNil())
+		require.Nil(t, qd.Close())
+	})
+}
+
+func TestQueuedDriver_ShouldDeliverInOrderAfterTheDriverRecovers(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsQueuedDriver()
+	driver := newRecordingDriver(true)
+	args.Driver = driver
+	appStatusHandler := statusHandler.NewAppStatusHandlerMock()
+	args.StatusHandler = appStatusHandler
+	qd, _ := NewQueuedDriver(args)
+
+	// the calls do not block, even if the driver is down
+	saveTestBlocks(t, qd, 1, 5)
+	require.Nil(t, qd.FinalizedBlock([]byte("hash4")))
+	require.Empty(t, driver.deliveredNonces())
+	require.Equal(t, uint64(5), appStatusHandler.GetUint64(common.MetricOutportQueuePendingBlocks+"_test"))
+
+	driver.setDown(false)
+	waitForNonces(t, driver, 5)
+	require.Equal(t, []uint64{1, 2, 3, 4, 5}, driver.deliveredNonces())
+
+	require.Nil(t, qd.Close())
+	require.Equal(t, 1, driver.finalizedCalls)
+	require.Equal(t, uint64(0), appStatusHandler.GetUint64(common.MetricOutportQueuePendingOperations+"_test"))
+	require.Equal(t, uint64(0), appStatusHandler.GetUint64(common.MetricOutportQueuePendingBlocks+"_test"))
+}
+
+func TestQueuedDriver_UndeliveredOperationsShouldSurviveRestart(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsQueuedDriver()
+	args.Driver = newRecordingDriver(true)
+	qd, _ := NewQueuedDriver(args)
+
+	saveTestBlocks(t, qd, 1, 3)
+	require.Nil(t, qd.Close())
+
+	// the same persister is used after the restart
+	driver := newRecordingDriver(true)
+	args.Driver = driver
+	appStatusHandler := statusHandler.NewAppStatusHandlerMock()
+	args.StatusHandler = appStatusHandler
+	qd, _ = NewQueuedDriver(args)
+	require.Equal(t, uint64(3), appStatusHandler.GetUint64(common.MetricOutportQueuePendingOperations+"_test"))
+	require.Equal(t, uint64(3), appStatusHandler.GetUint64(common.MetricOutportQueuePendingBlocks+"_test"))
+
+	saveTestBlocks(t, qd, 4, 4)
+	driver.setDown(false)
+	waitForNonces(t, driver, 4)
+	require.Equal(t, []uint64{1, 2, 3, 4}, driver.deliveredNonces())
+	require.Nil(t, qd.Close())
+}
+
+func TestQueuedDriver_UndecodableOperationShouldBeDropped(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsQueuedDriver()
+	_ = args.Persister.Put(sequenceKey(0), []byte("not an operation"))
+
+	driver := newRecordingDriver(false)
+	args.Driver = driver
+	qd, _ := NewQueuedDriver(args)
+
+	saveTestBlocks(t, qd, 1, 1)
+	waitForNonces(t, driver, 1)
+	require.Nil(t, qd.Close())
+	require.Equal(t, uint64(0), qd.queue.len())
+}
+
+func TestQueuedDriver_SaveAccountsShouldCallTheDriverDirectly(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsQueuedDriver()
+	called := false
+	args.Driver = &mock.DriverStub{
+		SaveAccountsCalled: func(_ uint64, _ []data.UserAccountHandler) error {
+			called = true
+			return nil
+		},
+	}
+	qd, _ := NewQueuedDriver(args)
+
+	require.Nil(t, qd.SaveAccounts(0, nil))
+	require.True(t, called)
+	require.Nil(t, qd.Close())
+}
@@ -0,0 +1,568 @@
+package queue
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go-core/data/receipt"
+	"github.com/ElrondNetwork/elrond-go-core/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go-core/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/outport"
+)
+
+const (
+	operationSaveBlock uint32 = iota + 1
+	operationRevertIndexedBlock
+	operationSaveRoundsInfo
+	operationSaveValidatorsPubKeys
+	operationSaveValidatorsRating
+	operationFinalizedBlock
+)
+
+const (
+	objectShardHeader uint32 = iota + 1
+	objectShardHeaderV2
+	objectMetaBlock
+	objectTransaction
+	objectSmartContractResult
+	objectRewardTx
+	objectReceipt
+)
+
+// serializer converts the driver calls into queued operations and back. Objects given as interfaces are stored
+// along with their concrete type, so that the driver receives the same types as the ones provided by the node
+type serializer struct {
+	marshalizer marshal.Marshalizer
+}
+
+func (s *serializer) serializeSaveBlock(args *indexer.ArgsSaveBlockData) (*QueuedOperation, error) {
+	header, err := s.serializeObject(nil, args.Header)
+	if err != nil {
+		return nil, err
+	}
+	body, err := s.serializeBody(args.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := &SaveBlockPayload{
+		HeaderHash:             args.HeaderHash,
+		Header:                 header,
+		Body:                   body,
+		SignersIndexes:         args.SignersIndexes,
+		NotarizedHeadersHashes: args.NotarizedHeadersHashes,
+		GasProvided:            args.HeaderGasConsumption.GasProvided,
+		GasRefunded:            args.HeaderGasConsumption.GasRefunded,
+		GasPenalized:           args.HeaderGasConsumption.GasPenalized,
+		MaxGasPerBlock:         args.HeaderGasConsumption.MaxGasPerBlock,
+	}
+
+	if args.TransactionsPool != nil {
+		err = s.serializePool(args.TransactionsPool, payload)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(args.AlteredAccounts) > 0 {
+		payload.AlteredAccounts, err = json.Marshal(args.AlteredAccounts)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return s.createOperation(operationSaveBlock, payload)
+}
+
+func (s *serializer) serializePool(pool *indexer.Pool, payload *SaveBlockPayload) error {
+	var err error
+	payload.Txs, err = s.serializeObjectsMap(pool.Txs)
+	if err != nil {
+		return err
+	}
+	payload.Scrs, err = s.serializeObjectsMap(pool.Scrs)
+	if err != nil {
+		return err
+	}
+	payload.Rewards, err = s.serializeObjectsMap(pool.Rewards)
+	if err != nil {
+		return err
+	}
+	payload.Invalid, err = s.serializeObjectsMap(pool.Invalid)
+	if err != nil {
+		return err
+	}
+	payload.Receipts, err = s.serializeObjectsMap(pool.Receipts)
+	if err != nil {
+		return err
+	}
+
+	payload.Logs = make([]*SerializedLog, 0, len(pool.Logs))
+	for _, logData := range pool.Logs {
+		if logData == nil {
+			continue
+		}
+
+		logHandler, ok := logData.LogHandler.(*transaction.Log)
+		if !ok {
+			return fmt.Errorf("%w for log: %T", ErrUnsupportedType, logData.LogHandler)
+		}
+
+		logBytes, errMarshal := s.marshalizer.Marshal(logHandler)
+		if errMarshal != nil {
+			return errMarshal
+		}
+
+		payload.Logs = append(payload.Logs, &SerializedLog{
+			TxHash: []byte(logData.TxHash),
+			Log:    logBytes,
+		})
+	}
+
+	return nil
+}
+
+func (s *serializer) serializeObjectsMap(objects map[string]data.TransactionHandler) ([]*SerializedObject, error) {
+	serialized := make([]*SerializedObject, 0, len(objects))
+	for hash, object := range objects {
+		serializedObject, err := s.serializeObject([]byte(hash), object)
+		if err != nil {
+			return nil, err
+		}
+
+		serialized = append(serialized, serializedObject)
+	}
+
+	// keep the stored bytes deterministic
+	sort.Slice(serialized, func(i, j int) bool {
+		return string(serialized[i].Hash) < string(serialized[j].Hash)
+	})
+
+	return serialized, nil
+}
+
+func (s *serializer) serializeObject(hash []byte, object interface{}) (*SerializedObject, error) {
+	objectType, err := getObjectType(object)
+	if err != nil {
+		return nil, err
+	}
+
+	objectBytes, err := s.marshalizer.Marshal(object)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SerializedObject{
+		Hash: hash,
+		Type: objectType,
+		Data: objectBytes,
+	}, nil
+}
+
+func getObjectType(object interface{}) (uint32, error) {
+	switch object.(type) {
+	case *block.Header:
+		return objectShardHeader, nil
+	case *block.HeaderV2:
+		return objectShardHeaderV2, nil
+	case *block.MetaBlock:
+		return objectMetaBlock, nil
+	case *transaction.Transaction:
+		return objectTransaction, nil
+	case *smartContractResult.SmartContractResult:
+		return objectSmartContractResult, nil
+	case *rewardTx.RewardTx:
+		return objectRewardTx, nil
+	case *receipt.Receipt:
+		return objectReceipt, nil
+	default:
+		return 0, fmt.Errorf("%w: %T", ErrUnsupportedType, object)
+	}
+}
+
+// createEmptyObject returns an empty object of the provided type, ready to be unmarshalled
+func createEmptyObject(objectType uint32) (interface{}, error) {
+	switch objectType {
+	case objectShardHeader:
+		return &block.Header{}, nil
+	case objectShardHeaderV2:
+		return &block.HeaderV2{}, nil
+	case objectMetaBlock:
+		return &block.MetaBlock{}, nil
+	case objectTransaction:
+		return &transaction.Transaction{}, nil
+	case objectSmartContractResult:
+		return &smartContractResult.SmartContractResult{}, nil
+	case objectRewardTx:
+		return &rewardTx.RewardTx{}, nil
+	case objectReceipt:
+		return &receipt.Receipt{}, nil
+	default:
+		return nil, fmt.Errorf("%w: object type %d", ErrUnsupportedType, objectType)
+	}
+}
+
+func (s *serializer) serializeBody(body data.BodyHandler) ([]byte, error) {
+	if check.IfNil(body) {
+		return nil, nil
+	}
+
+	blockBody, ok := body.(*block.Body)
+	if !ok {
+		return nil, fmt.Errorf("%w for body: %T", ErrUnsupportedType, body)
+	}
+
+	return s.marshalizer.Marshal(blockBody)
+}
+
+func (s *serializer) serializeRevertIndexedBlock(header data.HeaderHandler, body data.BodyHandler) (*QueuedOperation, error) {
+	serializedHeader, err := s.serializeObject(nil, header)
+	if err != nil {
+		return nil, err
+	}
+	serializedBody, err := s.serializeBody(body)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.createOperation(operationRevertIndexedBlock, &RevertIndexedBlockPayload{
+		Header: serializedHeader,
+		Body:   serializedBody,
+	})
+}
+
+func (s *serializer) serializeSaveRoundsInfo(roundsInfo []*indexer.RoundInfo) (*QueuedOperation, error) {
+	payload := &SaveRoundsInfoPayload{
+		RoundsInfo: make([]*RoundInfoRecord, 0, len(roundsInfo)),
+	}
+	for _, roundInfo := range roundsInfo {
+		if roundInfo == nil {
+			continue
+		}
+
+		payload.RoundsInfo = append(payload.RoundsInfo, &RoundInfoRecord{
+			Index:            roundInfo.Index,
+			SignersIndexes:   roundInfo.SignersIndexes,
+			BlockWasProposed: roundInfo.BlockWasProposed,
+			ShardId:          roundInfo.ShardId,
+			Epoch:            roundInfo.Epoch,
+			Timestamp:        int64(roundInfo.Timestamp),
+		})
+	}
+
+	return s.createOperation(operationSaveRoundsInfo, payload)
+}
+
+func (s *serializer) serializeSaveValidatorsPubKeys(validatorsPubKeys map[uint32][][]byte, epoch uint32) (*QueuedOperation, error) {
+	payload := &SaveValidatorsPubKeysPayload{
+		ValidatorsPubKeys: make([]*ShardValidatorsPubKeys, 0, len(validatorsPubKeys)),
+		Epoch:             epoch,
+	}
+	for shardID, pubKeys := range validatorsPubKeys {
+		payload.ValidatorsPubKeys = append(payload.ValidatorsPubKeys, &ShardValidatorsPubKeys{
+			ShardID: shardID,
+			PubKeys: pubKeys,
+		})
+	}
+
+	sort.Slice(payload.ValidatorsPubKeys, func(i, j int) bool {
+		return payload.ValidatorsPubKeys[i].ShardID < payload.ValidatorsPubKeys[j].ShardID
+	})
+
+	return s.createOperation(operationSaveValidatorsPubKeys, payload)
+}
+
+func (s *serializer) serializeSaveValidatorsRating(indexID string, infoRating []*indexer.ValidatorRatingInfo) (*QueuedOperation, error) {
+	payload := &SaveValidatorsRatingPayload{
+		IndexID:    indexID,
+		InfoRating: make([]*ValidatorRatingRecord, 0, len(infoRating)),
+	}
+	for _, rating := range infoRating {
+		if rating == nil {
+			continue
+		}
+
+		payload.InfoRating = append(payload.InfoRating, &ValidatorRatingRecord{
+			PublicKey: rating.PublicKey,
+			Rating:    rating.Rating,
+		})
+	}
+
+	return s.createOperation(operationSaveValidatorsRating, payload)
+}
+
+func (s *serializer) serializeFinalizedBlock(headerHash []byte) (*QueuedOperation, error) {
+	return s.createOperation(operationFinalizedBlock, &FinalizedBlockPayload{
+		HeaderHash: headerHash,
+	})
+}
+
+func (s *serializer) createOperation(operationType uint32, payload interface{}) (*QueuedOperation, error) {
+	payloadBytes, err := s.marshalizer.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return &QueuedOperation{
+		Type:    operationType,
+		Payload: payloadBytes,
+	}, nil
+}
+
+// driverCall defines a call to be made on a driver
+type driverCall func(driver outport.Driver) error
+
+// createCall rebuilds the driver call out of the queued operation
+func (s *serializer) createCall(operation *QueuedOperation) (driverCall, error) {
+	switch operation.Type {
+	case operationSaveBlock:
+		args, err := s.deserializeSaveBlock(operation.Payload)
+		if err != nil {
+			return nil, err
+		}
+
+		return func(driver outport.Driver) error {
+			return driver.SaveBlock(args)
+		}, nil
+	case operationRevertIndexedBlock:
+		header, body, err := s.deserializeRevertIndexedBlock(operation.Payload)
+		if err != nil {
+			return nil, err
+		}
+
+		return func(driver outport.Driver) error {
+			return driver.RevertIndexedBlock(header, body)
+		}, nil
+	case operationSaveRoundsInfo:
+		payload := &SaveRoundsInfoPayload{}
+		err := s.marshalizer.Unmarshal(payload, operation.Payload)
+		if err != nil {
+			return nil, err
+		}
+
+		roundsInfo := createRoundsInfo(payload)
+		return func(driver outport.Driver) error {
+			return driver.SaveRoundsInfo(roundsInfo)
+		}, nil
+	case operationSaveValidatorsPubKeys:
+		payload := &SaveValidatorsPubKeysPayload{}
+		err := s.marshalizer.Unmarshal(payload, operation.Payload)
+		if err != nil {
+			return nil, err
+		}
+
+		validatorsPubKeys := make(map[uint32][][]byte, len(payload.ValidatorsPubKeys))
+		for _, shardPubKeys := range payload.ValidatorsPubKeys {
+			validatorsPubKeys[shardPubKeys.ShardID] = shardPubKeys.PubKeys
+		}
+		return func(driver outport.Driver) error {
+			return driver.SaveValidatorsPubKeys(validatorsPubKeys, payload.Epoch)
+		}, nil
+	case operationSaveValidatorsRating:
+		payload := &SaveValidatorsRatingPayload{}
+		err := s.marshalizer.Unmarshal(payload, operation.Payload)
+		if err != nil {
+			return nil, err
+		}
+
+		infoRating := make([]*indexer.ValidatorRatingInfo, 0, len(payload.InfoRating))
+		for _, rating := range payload.InfoRating {
+			infoRating = append(infoRating, &indexer.ValidatorRatingInfo{
+				PublicKey: rating.PublicKey,
+				Rating:    rating.Rating,
+			})
+		}
+		return func(driver outport.Driver) error {
+			return driver.SaveValidatorsRating(payload.IndexID, infoRating)
+		}, nil
+	case operationFinalizedBlock:
+		payload := &FinalizedBlockPayload{}
+		err := s.marshalizer.Unmarshal(payload, operation.Payload)
+		if err != nil {
+			return nil, err
+		}
+
+		return func(driver outport.Driver) error {
+			return driver.FinalizedBlock(payload.HeaderHash)
+		}, nil
+	default:
+		return nil, fmt.Errorf("%w: %d", ErrUnknownOperation, operation.Type)
+	}
+}
+
+func (s *serializer) deserializeRevertIndexedBlock(payloadBytes []byte) (data.HeaderHandler, data.BodyHandler, error) {
+	payload := &RevertIndexedBlockPayload{}
+	err := s.marshalizer.Unmarshal(payload, payloadBytes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	header, err := s.deserializeHeader(payload.Header)
+	if err != nil {
+		return nil, nil, err
+	}
+	body, err := s.deserializeBody(payload.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return header, body, nil
+}
+
+func createRoundsInfo(payload *SaveRoundsInfoPayload) []*indexer.RoundInfo {
+	roundsInfo := make([]*indexer.RoundInfo, 0, len(payload.RoundsInfo))
+	for _, record := range payload.RoundsInfo {
+		roundsInfo = append(roundsInfo, &indexer.RoundInfo{
+			Index:            record.Index,
+			SignersIndexes:   record.SignersIndexes,
+			BlockWasProposed: record.BlockWasProposed,
+			ShardId:          record.ShardId,
+			Epoch:            record.Epoch,
+			Timestamp:        time.Duration(record.Timestamp),
+		})
+	}
+
+	return roundsInfo
+}
+
+func (s *serializer) deserializeSaveBlock(payloadBytes []byte) (*indexer.ArgsSaveBlockData, error) {
+	payload := &SaveBlockPayload{}
+	err := s.marshalizer.Unmarshal(payload, payloadBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	header, err := s.deserializeHeader(payload.Header)
+	if err != nil {
+		return nil, err
+	}
+	body, err := s.deserializeBody(payload.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	args := &indexer.ArgsSaveBlockData{
+		HeaderHash:             payload.HeaderHash,
+		Body:                   body,
+		Header:                 header,
+		SignersIndexes:         payload.SignersIndexes,
+		NotarizedHeadersHashes: payload.NotarizedHeadersHashes,
+		HeaderGasConsumption: indexer.HeaderGasConsumption{
+			GasProvided:    payload.GasProvided,
+			GasRefunded:    payload.GasRefunded,
+			GasPenalized:   payload.GasPenalized,
+			MaxGasPerBlock: payload.MaxGasPerBlock,
+		},
+		TransactionsPool: &indexer.Pool{},
+	}
+
+	pool := args.TransactionsPool
+	for _, objects := range []struct {
+		serialized []*SerializedObject
+		dest       *map[string]data.TransactionHandler
+	}{
+		{payload.Txs, &pool.Txs},
+		{payload.Scrs, &pool.Scrs},
+		{payload.Rewards, &pool.Rewards},
+		{payload.Invalid, &pool.Invalid},
+		{payload.Receipts, &pool.Receipts},
+	} {
+		*objects.dest, err = s.deserializeObjectsMap(objects.serialized)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	pool.Logs = make([]*data.LogData, 0, len(payload.Logs))
+	for _, serializedLog := range payload.Logs {
+		logHandler := &transaction.Log{}
+		err = s.marshalizer.Unmarshal(logHandler, serializedLog.Log)
+		if err != nil {
+			return nil, err
+		}
+
+		pool.Logs = append(pool.Logs, &data.LogData{
+			LogHandler: logHandler,
+			TxHash:     string(serializedLog.TxHash),
+		})
+	}
+
+	if len(payload.AlteredAccounts) > 0 {
+		err = json.Unmarshal(payload.AlteredAccounts, &args.AlteredAccounts)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return args, nil
+}
+
+func (s *serializer) deserializeObjectsMap(serialized []*SerializedObject) (map[string]data.TransactionHandler, error) {
+	objects := make(map[string]data.TransactionHandler, len(serialized))
+	for _, serializedObject := range serialized {
+		object, err := s.deserializeObject(serializedObject)
+		if err != nil {
+			return nil, err
+		}
+
+		tx, ok := object.(data.TransactionHandler)
+		if !ok {
+			return nil, fmt.Errorf("%w for transaction: %T", ErrUnsupportedType, object)
+		}
+
+		objects[string(serializedObject.Hash)] = tx
+	}
+
+	return objects, nil
+}
+
+func (s *serializer) deserializeHeader(serialized *SerializedObject) (data.HeaderHandler, error) {
+	object, err := s.deserializeObject(serialized)
+	if err != nil {
+		return nil, err
+	}
+
+	header, ok := object.(data.HeaderHandler)
+	if !ok {
+		return nil, fmt.Errorf("%w for header: %T", ErrUnsupportedType, object)
+	}
+
+	return header, nil
+}
+
+func (s *serializer) deserializeObject(serialized *SerializedObject) (interface{}, error) {
+	if serialized == nil {
+		return nil, fmt.Errorf("%w: missing object", ErrUnsupportedType)
+	}
+
+	object, err := createEmptyObject(serialized.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.marshalizer.Unmarshal(object, serialized.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	return object, nil
+}
+
+// deserializeBody always returns a non-nil body, as an empty body is stored without any bytes
+func (s *serializer) deserializeBody(bodyBytes []byte) (data.BodyHandler, error) {
+	body := &block.Body{}
+	err := s.marshalizer.Unmarshal(body, bodyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return body, nil
+}
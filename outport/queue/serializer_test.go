@@ -0,0 +1,205 @@
+package queue
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go-core/data/receipt"
+	"github.com/ElrondNetwork/elrond-go-core/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go-core/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/outport/mock"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestSaveBlockArgs() *indexer.ArgsSaveBlockData {
+	return &indexer.ArgsSaveBlockData{
+		HeaderHash: []byte("header hash"),
+		Body: &block.Body{
+			MiniBlocks: []*block.MiniBlock{{TxHashes: [][]byte{[]byte("tx")}}},
+		},
+		Header:                 &block.MetaBlock{Nonce: 10, Round: 11, Epoch: 1},
+		SignersIndexes:         []uint64{1, 2, 3},
+		NotarizedHeadersHashes: []string{"notarized"},
+		HeaderGasConsumption: indexer.HeaderGasConsumption{
+			GasProvided:    1,
+			GasRefunded:    2,
+			GasPenalized:   3,
+			MaxGasPerBlock: 4,
+		},
+		TransactionsPool: &indexer.Pool{
+			Txs: map[string]data.TransactionHandler{
+				"tx": &transaction.Transaction{Nonce: 1, Value: big.NewInt(10)},
+			},
+			Scrs: map[string]data.TransactionHandler{
+				"scr": &smartContractResult.SmartContractResult{Nonce: 2, Value: big.NewInt(20)},
+			},
+			Rewards: map[string]data.TransactionHandler{
+				"reward": &rewardTx.RewardTx{Round: 3, Value: big.NewInt(30)},
+			},
+			Invalid: map[string]data.TransactionHandler{
+				"invalid": &transaction.Transaction{Nonce: 4, Value: big.NewInt(40)},
+			},
+			Receipts: map[string]data.TransactionHandler{
+				"receipt": &receipt.Receipt{Value: big.NewInt(50), TxHash: []byte("tx")},
+			},
+			Logs: []*data.LogData{
+				{
+					TxHash: "tx",
+					LogHandler: &transaction.Log{
+						Address: []byte("address"),
+						Events:  []*transaction.Event{{Identifier: []byte("transfer")}},
+					},
+				},
+			},
+		},
+		AlteredAccounts: map[string]*indexer.AlteredAccount{
+			"erd1": {Address: "erd1", Balance: "100"},
+		},
+	}
+}
+
+func TestSerializer_SaveBlock(t *testing.T) {
+	t.Parallel()
+
+	s := &serializer{marshalizer: &marshal.GogoProtoMarshalizer{}}
+	args := createTestSaveBlockArgs()
+
+	operation, err := s.serializeSaveBlock(args)
+	require.Nil(t, err)
+	require.Equal(t, operationSaveBlock, operation.Type)
+
+	call, err := s.createCall(operation)
+	require.Nil(t, err)
+
+	var delivered *indexer.ArgsSaveBlockData
+	err = call(&mock.DriverStub{
+		SaveBlockCalled: func(args *indexer.ArgsSaveBlockData) error {
+			delivered = args
+			return nil
+		},
+	})
+	require.Nil(t, err)
+	require.Equal(t, args, delivered)
+}
+
+func TestSerializer_SaveBlockWithoutPoolAndBody(t *testing.T) {
+	t.Parallel()
+
+	s := &serializer{marshalizer: &marshal.GogoProtoMarshalizer{}}
+	args := &indexer.ArgsSaveBlockData{
+		HeaderHash: []byte("header hash"),
+		Header:     &block.HeaderV2{Header: &block.Header{Nonce: 1}},
+	}
+
+	operation, err := s.serializeSaveBlock(args)
+	require.Nil(t, err)
+
+	call, err := s.createCall(operation)
+	require.Nil(t, err)
+
+	var delivered *indexer.ArgsSaveBlockData
+	_ = call(&mock.DriverStub{
+		SaveBlockCalled: func(args *indexer.ArgsSaveBlockData) error {
+			delivered = args
+			return nil
+		},
+	})
+	require.Equal(t, args.Header, delivered.Header)
+	require.Equal(t, &block.Body{}, delivered.Body)
+	require.Empty(t, delivered.TransactionsPool.Txs)
+	require.Empty(t, delivered.TransactionsPool.Logs)
+}
+
+func TestSerializer_UnsupportedTypesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	s := &serializer{marshalizer: &marshal.GogoProtoMarshalizer{}}
+
+	args := createTestSaveBlockArgs()
+	args.Header = &testscommon.HeaderHandlerStub{}
+	_, err := s.serializeSaveBlock(args)
+	require.True(t, errors.Is(err, ErrUnsupportedType))
+
+	args = createTestSaveBlockArgs()
+	args.TransactionsPool.Logs[0].LogHandler = nil
+	_, err = s.serializeSaveBlock(args)
+	require.True(t, errors.Is(err, ErrUnsupportedType))
+
+	_, err = s.createCall(&QueuedOperation{Type: 100})
+	require.True(t, errors.Is(err, ErrUnknownOperation))
+}
+
+func TestSerializer_OtherOperations(t *testing.T) {
+	t.Parallel()
+
+	s := &serializer{marshalizer: &marshal.GogoProtoMarshalizer{}}
+	numCalls := 0
+
+	header := &block.Header{Nonce: 5}
+	body := &block.Body{MiniBlocks: []*block.MiniBlock{{SenderShardID: 1}}}
+	roundsInfo := []*indexer.RoundInfo{{Index: 1, SignersIndexes: []uint64{1}, BlockWasProposed: true, ShardId: 2, Epoch: 3, Timestamp: time.Second}}
+	validatorsPubKeys := map[uint32][][]byte{0: {[]byte("pk0")}, 1: {[]byte("pk1"), []byte("pk2")}}
+	infoRating := []*indexer.ValidatorRatingInfo{{PublicKey: "pk", Rating: 50.5}}
+	driver := &mock.DriverStub{
+		RevertBlockCalled: func(h data.HeaderHandler, b data.BodyHandler) error {
+			require.Equal(t, header, h)
+			require.Equal(t, body, b)
+			numCalls++
+			return nil
+		},
+		SaveRoundsInfoCalled: func(r []*indexer.RoundInfo) error {
+			require.Equal(t, roundsInfo, r)
+			numCalls++
+			return nil
+		},
+		SaveValidatorsPubKeysCalled: func(v map[uint32][][]byte, epoch uint32) error {
+			require.Equal(t, validatorsPubKeys, v)
+			require.Equal(t, uint32(7), epoch)
+			numCalls++
+			return nil
+		},
+		SaveValidatorsRatingCalled: func(indexID string, r []*indexer.ValidatorRatingInfo) error {
+			require.Equal(t, "index", indexID)
+			require.Equal(t, infoRating, r)
+			numCalls++
+			return nil
+		},
+		FinalizedBlockCalled: func(headerHash []byte) error {
+			require.Equal(t, []byte("hash"), headerHash)
+			numCalls++
+			return nil
+		},
+	}
+
+	operations := make([]*QueuedOperation, 0)
+	operation, err := s.serializeRevertIndexedBlock(header, body)
+	require.Nil(t, err)
+	operations = append(operations, operation)
+	operation, err = s.serializeSaveRoundsInfo(roundsInfo)
+	require.Nil(t, err)
+	operations = append(operations, operation)
+	operation, err = s.serializeSaveValidatorsPubKeys(validatorsPubKeys, 7)
+	require.Nil(t, err)
+	operations = append(operations, operation)
+	operation, err = s.serializeSaveValidatorsRating("index", infoRating)
+	require.Nil(t, err)
+	operations = append(operations, operation)
+	operation, err = s.serializeFinalizedBlock([]byte("hash"))
+	require.Nil(t, err)
+	operations = append(operations, operation)
+
+	for _, op := range operations {
+		call, errCreate := s.createCall(op)
+		require.Nil(t, errCreate)
+		require.Nil(t, call(driver))
+	}
+	require.Equal(t, 5, numCalls)
+}
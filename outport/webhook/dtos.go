@@ -0,0 +1,128 @@
+package webhook
+
+import "encoding/json"
+
+// PayloadVersion is the version of the JSON schema of the requests. It is increased on each breaking change
+const PayloadVersion = 1
+
+// Event types, found in the type field of each event
+const (
+	SaveBlockEvent            = "saveBlock"
+	RevertIndexedBlockEvent   = "revertIndexedBlock"
+	FinalizedBlockEvent       = "finalizedBlock"
+	SaveRoundsInfoEvent       = "saveRoundsInfo"
+	SaveValidatorsRatingEvent = "saveValidatorsRating"
+)
+
+// Transaction types, found in the type field of each block transaction
+const (
+	NormalTransaction   = "normal"
+	UnsignedTransaction = "unsigned"
+	RewardTransaction   = "reward"
+	InvalidTransaction  = "invalid"
+	ReceiptTransaction  = "receipt"
+)
+
+// Payload is the body of the requests sent to the endpoints. It holds a batch of events, in the order they occurred
+type Payload struct {
+	Version int      `json:"version"`
+	Events  []*Event `json:"events"`
+}
+
+// Event holds one driver call. The data field holds one of the *Data structures below, depending on the type.
+// The id is the hex encoded hash of the type and data, so an event delivered more than once keeps its id and can be
+// used by the receivers as an idempotency key
+type Event struct {
+	ID   string          `json:"id"`
+	Type string          `json:"type"`
+	Data json.RawMessage `json:"data"`
+}
+
+// BlockData holds the data of a saved block
+type BlockData struct {
+	Hash                   string             `json:"hash"`
+	Nonce                  uint64             `json:"nonce"`
+	Round                  uint64             `json:"round"`
+	Epoch                  uint32             `json:"epoch"`
+	Shard                  uint32             `json:"shard"`
+	Timestamp              uint64             `json:"timestamp"`
+	PrevHash               string             `json:"prevHash"`
+	StateRootHash          string             `json:"stateRootHash"`
+	SignersIndexes         []uint64           `json:"signersIndexes,omitempty"`
+	NotarizedHeadersHashes []string           `json:"notarizedHeadersHashes,omitempty"`
+	GasProvided            uint64             `json:"gasProvided"`
+	GasRefunded            uint64             `json:"gasRefunded"`
+	GasPenalized           uint64             `json:"gasPenalized"`
+	MaxGasPerBlock         uint64             `json:"maxGasPerBlock"`
+	Transactions           []*TransactionData `json:"transactions,omitempty"`
+	Logs                   []*LogData         `json:"logs,omitempty"`
+}
+
+// TransactionData holds a transaction of a saved block
+type TransactionData struct {
+	Hash     string `json:"hash"`
+	Type     string `json:"type"`
+	Nonce    uint64 `json:"nonce"`
+	Value    string `json:"value"`
+	Sender   string `json:"sender"`
+	Receiver string `json:"receiver"`
+	GasPrice uint64 `json:"gasPrice"`
+	GasLimit uint64 `json:"gasLimit"`
+	Data     []byte `json:"data,omitempty"`
+}
+
+// LogData holds the log of a transaction of a saved block
+type LogData struct {
+	TxHash  string          `json:"txHash"`
+	Address string          `json:"address"`
+	Events  []*LogEventData `json:"events"`
+}
+
+// LogEventData holds an event of a transaction log
+type LogEventData struct {
+	Address    string   `json:"address"`
+	Identifier string   `json:"identifier"`
+	Topics     [][]byte `json:"topics,omitempty"`
+	Data       []byte   `json:"data,omitempty"`
+}
+
+// RevertedBlockData holds the data of a reverted block
+type RevertedBlockData struct {
+	Hash  string `json:"hash"`
+	Nonce uint64 `json:"nonce"`
+	Round uint64 `json:"round"`
+	Epoch uint32 `json:"epoch"`
+	Shard uint32 `json:"shard"`
+}
+
+// FinalizedBlockData holds the hash of a finalized block
+type FinalizedBlockData struct {
+	Hash string `json:"hash"`
+}
+
+// RoundsInfoData holds the information of a batch of rounds
+type RoundsInfoData struct {
+	Rounds []*RoundInfoData `json:"rounds"`
+}
+
+// RoundInfoData holds the information of a round
+type RoundInfoData struct {
+	Index            uint64   `json:"index"`
+	SignersIndexes   []uint64 `json:"signersIndexes"`
+	BlockWasProposed bool     `json:"blockWasProposed"`
+	Shard            uint32   `json:"shard"`
+	Epoch            uint32   `json:"epoch"`
+	Timestamp        int64    `json:"timestamp"`
+}
+
+// ValidatorsRatingData holds the ratings of the validators
+type ValidatorsRatingData struct {
+	IndexID string                 `json:"indexID"`
+	Ratings []*ValidatorRatingData `json:"ratings"`
+}
+
+// ValidatorRatingData holds the rating of a validator
+type ValidatorRatingData struct {
+	PublicKey string  `json:"publicKey"`
+	Rating    float32 `json:"rating"`
+}
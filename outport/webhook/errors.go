@@ -0,0 +1,36 @@
+package webhook
+
+import "errors"
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilHasher signals that a nil hasher has been provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrNilPubKeyConverter signals that a nil public key converter has been provided
+var ErrNilPubKeyConverter = errors.New("nil public key converter")
+
+// ErrNoEndpoints signals that no endpoint has been provided
+var ErrNoEndpoints = errors.New("no endpoints")
+
+// ErrInvalidEndpoint signals that an invalid endpoint has been provided
+var ErrInvalidEndpoint = errors.New("invalid endpoint")
+
+// ErrInvalidBatchSize signals that an invalid batch size has been provided
+var ErrInvalidBatchSize = errors.New("invalid batch size")
+
+// ErrInvalidRequestTimeout signals that an invalid request timeout has been provided
+var ErrInvalidRequestTimeout = errors.New("invalid request timeout")
+
+// ErrInvalidBackoff signals that invalid backoff intervals have been provided
+var ErrInvalidBackoff = errors.New("invalid backoff")
+
+// ErrInvalidFlushInterval signals that an invalid flush interval has been provided
+var ErrInvalidFlushInterval = errors.New("invalid flush interval")
+
+// ErrRequestFailed signals that an endpoint did not accept the request
+var ErrRequestFailed = errors.New("request failed")
+
+// ErrDriverClosed signals that the driver was closed
+var ErrDriverClosed = errors.New("driver closed")
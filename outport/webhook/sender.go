@@ -0,0 +1,119 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+)
+
+const (
+	// SignatureHeader is the request header holding the HMAC-SHA256 signature of the request body, in the
+	// "sha256=<hex signature>" format. It is only set when a secret is configured
+	SignatureHeader = "X-Elrond-Signature"
+	// VersionHeader is the request header holding the payload version
+	VersionHeader = "X-Elrond-Payload-Version"
+
+	signaturePrefix = "sha256="
+	contentType     = "application/json"
+)
+
+type argsHTTPSender struct {
+	secret         []byte
+	requestTimeout time.Duration
+	maxRetries     int
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+}
+
+// httpSender posts signed requests to an endpoint, retrying with an exponential backoff
+type httpSender struct {
+	client         *http.Client
+	secret         []byte
+	maxRetries     int
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+}
+
+func newHTTPSender(args argsHTTPSender) *httpSender {
+	return &httpSender{
+		client:         &http.Client{Timeout: args.requestTimeout},
+		secret:         args.secret,
+		maxRetries:     args.maxRetries,
+		initialBackoff: args.initialBackoff,
+		maxBackoff:     args.maxBackoff,
+	}
+}
+
+// send posts the body to the endpoint. Network errors, 5xx and 429 responses are retried up to maxRetries times,
+// other responses are final. The retrials are aborted when the context is done
+func (hs *httpSender) send(ctx context.Context, endpoint string, body []byte) error {
+	backoff := hs.initialBackoff
+	var err error
+	for attempt := 0; ; attempt++ {
+		var shouldRetry bool
+		shouldRetry, err = hs.post(ctx, endpoint, body)
+		if err == nil || !shouldRetry || attempt >= hs.maxRetries {
+			return err
+		}
+
+		log.Debug("webhook: request failed, will retry", "endpoint", endpoint, "attempt", attempt+1,
+			"backoff", backoff, "error", err)
+
+		select {
+		case <-ctx.Done():
+			return ErrDriverClosed
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+		if backoff > hs.maxBackoff {
+			backoff = hs.maxBackoff
+		}
+	}
+}
+
+func (hs *httpSender) post(ctx context.Context, endpoint string, body []byte) (bool, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+
+	request.Header.Set("Content-Type", contentType)
+	request.Header.Set(VersionHeader, fmt.Sprintf("%d", PayloadVersion))
+	if len(hs.secret) > 0 {
+		request.Header.Set(SignatureHeader, signaturePrefix+ComputeSignature(hs.secret, body))
+	}
+
+	response, err := hs.client.Do(request)
+	if err != nil {
+		return ctx.Err() == nil, err
+	}
+	defer func() {
+		_, _ = io.Copy(ioutil.Discard, response.Body)
+		_ = response.Body.Close()
+	}()
+
+	if response.StatusCode >= http.StatusOK && response.StatusCode < http.StatusMultipleChoices {
+		return false, nil
+	}
+
+	shouldRetry := response.StatusCode >= http.StatusInternalServerError || response.StatusCode == http.StatusTooManyRequests
+
+	return shouldRetry, fmt.Errorf("%w: endpoint %s responded with status %d", ErrRequestFailed, endpoint, response.StatusCode)
+}
+
+// ComputeSignature returns the hex encoded HMAC-SHA256 signature of the body. Receivers can use it to check
+// the value of the SignatureHeader
+func ComputeSignature(secret []byte, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	_, _ = mac.Write(body)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,498 @@
+package webhook
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"net/url"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+)
+
+var log = logger.GetOrCreate("outport/webhook")
+
+// ArgsWebhookDriver holds the arguments needed to create a new webhook driver
+type ArgsWebhookDriver struct {
+	Endpoints              []string
+	HMACSecret             string
+	BatchSize              int
+	RequestTimeout         time.Duration
+	MaxRetries             int
+	InitialBackoff         time.Duration
+	MaxBackoff             time.Duration
+	FlushInterval          time.Duration
+	Marshalizer            marshal.Marshalizer
+	Hasher                 hashing.Hasher
+	AddressPubKeyConverter core.PubkeyConverter
+}
+
+// webhookDriver is an outport driver that posts the blocks, reverted and finalized blocks, rounds and ratings
+// as versioned JSON payloads to a set of endpoints. Events are batched, and a batch is posted once it holds
+// BatchSize events or, when partially filled, every FlushInterval. The delivery is tracked per endpoint: when
+// posting fails, the call that completed the batch returns an error and its retrial only posts the batch to the
+// endpoints that did not accept it yet. Each event carries an id derived from its content, so that the receivers
+// can drop the events delivered more than once (e.g. after a restart of the node).
+type webhookDriver struct {
+	endpoints              []string
+	batchSize              int
+	flushInterval          time.Duration
+	sender                 *httpSender
+	marshalizer            marshal.Marshalizer
+	hasher                 hashing.Hasher
+	addressPubKeyConverter core.PubkeyConverter
+
+	mutBatch sync.Mutex
+	batch    []*Event
+	pending  *pendingBatch
+
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// pendingBatch is a batch that was not yet accepted by all the endpoints
+type pendingBatch struct {
+	events          []*Event
+	body            []byte
+	undelivered     []string
+	completedByCall bool
+}
+
+func (pb *pendingBatch) lastEventID() string {
+	return pb.events[len(pb.events)-1].ID
+}
+
+// NewWebhookDriver creates a new webhook driver
+func NewWebhookDriver(args ArgsWebhookDriver) (*webhookDriver, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	wd := &webhookDriver{
+		endpoints:     append([]string(nil), args.Endpoints...),
+		batchSize:     args.BatchSize,
+		flushInterval: args.FlushInterval,
+		sender: newHTTPSender(argsHTTPSender{
+			secret:         []byte(args.HMACSecret),
+			requestTimeout: args.RequestTimeout,
+			maxRetries:     args.MaxRetries,
+			initialBackoff: args.InitialBackoff,
+			maxBackoff:     args.MaxBackoff,
+		}),
+		marshalizer:            args.Marshalizer,
+		hasher:                 args.Hasher,
+		addressPubKeyConverter: args.AddressPubKeyConverter,
+		batch:                  make([]*Event, 0, args.BatchSize),
+		ctx:                    ctx,
+		cancel:                 cancel,
+	}
+
+	go wd.flushPeriodically()
+
+	return wd, nil
+}
+
+func checkArgs(args ArgsWebhookDriver) error {
+	if check.IfNil(args.Marshalizer) {
+		return ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return ErrNilHasher
+	}
+	if check.IfNil(args.AddressPubKeyConverter) {
+		return ErrNilPubKeyConverter
+	}
+	if len(args.Endpoints) == 0 {
+		return ErrNoEndpoints
+	}
+	for _, endpoint := range args.Endpoints {
+		parsed, err := url.Parse(endpoint)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			return ErrInvalidEndpoint
+		}
+	}
+	if args.BatchSize < 1 {
+		return ErrInvalidBatchSize
+	}
+	if args.RequestTimeout <= 0 {
+		return ErrInvalidRequestTimeout
+	}
+	if args.MaxRetries < 0 || args.InitialBackoff <= 0 || args.MaxBackoff < args.InitialBackoff {
+		return ErrInvalidBackoff
+	}
+	if args.FlushInterval <= 0 {
+		return ErrInvalidFlushInterval
+	}
+
+	return nil
+}
+
+// SaveBlock posts the block, along with its transactions and logs
+func (wd *webhookDriver) SaveBlock(args *indexer.ArgsSaveBlockData) error {
+	if args == nil || check.IfNil(args.Header) {
+		return nil
+	}
+
+	return wd.addEvent(SaveBlockEvent, wd.createBlockData(args))
+}
+
+// RevertIndexedBlock posts the reverted block
+func (wd *webhookDriver) RevertIndexedBlock(header data.HeaderHandler, _ data.BodyHandler) error {
+	if check.IfNil(header) {
+		return nil
+	}
+
+	headerHash, err := core.CalculateHash(wd.marshalizer, wd.hasher, header)
+	if err != nil {
+		return err
+	}
+
+	return wd.addEvent(RevertIndexedBlockEvent, &RevertedBlockData{
+		Hash:  hex.EncodeToString(headerHash),
+		Nonce: header.GetNonce(),
+		Round: header.GetRound(),
+		Epoch: header.GetEpoch(),
+		Shard: header.GetShardID(),
+	})
+}
+
+// FinalizedBlock posts the hash of the finalized block
+func (wd *webhookDriver) FinalizedBlock(headerHash []byte) error {
+	return wd.addEvent(FinalizedBlockEvent, &FinalizedBlockData{
+		Hash: hex.EncodeToString(headerHash),
+	})
+}
+
+// SaveRoundsInfo posts the rounds information
+func (wd *webhookDriver) SaveRoundsInfo(roundsInfos []*indexer.RoundInfo) error {
+	rounds := make([]*RoundInfoData, 0, len(roundsInfos))
+	for _, roundInfo := range roundsInfos {
+		if roundInfo == nil {
+			continue
+		}
+
+		rounds = append(rounds, &RoundInfoData{
+			Index:            roundInfo.Index,
+			SignersIndexes:   roundInfo.SignersIndexes,
+			BlockWasProposed: roundInfo.BlockWasProposed,
+			Shard:            roundInfo.ShardId,
+			Epoch:            roundInfo.Epoch,
+			Timestamp:        int64(roundInfo.Timestamp),
+		})
+	}
+
+	return wd.addEvent(SaveRoundsInfoEvent, &RoundsInfoData{Rounds: rounds})
+}
+
+// SaveValidatorsRating posts the validators ratings
+func (wd *webhookDriver) SaveValidatorsRating(indexID string, infoRating []*indexer.ValidatorRatingInfo) error {
+	ratings := make([]*ValidatorRatingData, 0, len(infoRating))
+	for _, rating := range infoRating {
+		if rating == nil {
+			continue
+		}
+
+		ratings = append(ratings, &ValidatorRatingData{
+			PublicKey: rating.PublicKey,
+			Rating:    rating.Rating,
+		})
+	}
+
+	return wd.addEvent(SaveValidatorsRatingEvent, &ValidatorsRatingData{
+		IndexID: indexID,
+		Ratings: ratings,
+	})
+}
+
+// SaveValidatorsPubKeys does nothing
+func (wd *webhookDriver) SaveValidatorsPubKeys(_ map[uint32][][]byte, _ uint32) error {
+	return nil
+}
+
+// SaveAccounts does nothing
+func (wd *webhookDriver) SaveAccounts(_ uint64, _ []data.UserAccountHandler) error {
+	return nil
+}
+
+func (wd *webhookDriver) addEvent(eventType string, eventData interface{}) error {
+	buff, err := json.Marshal(eventData)
+	if err != nil {
+		return err
+	}
+
+	event := &Event{
+		ID:   hex.EncodeToString(wd.hasher.Compute(eventType + string(buff))),
+		Type: eventType,
+		Data: buff,
+	}
+
+	wd.mutBatch.Lock()
+	defer wd.mutBatch.Unlock()
+
+	if wd.pending != nil {
+		isRetrial := wd.pending.completedByCall && wd.pending.lastEventID() == event.ID
+		err = wd.deliverPendingBatch()
+		if err != nil {
+			return err
+		}
+		if isRetrial {
+			return nil
+		}
+	}
+
+	batch := append(wd.batch, event)
+	if len(batch) < wd.batchSize {
+		wd.batch = batch
+		return nil
+	}
+
+	err = wd.setPendingBatch(batch, true)
+	if err != nil {
+		return err
+	}
+
+	return wd.deliverPendingBatch()
+}
+
+func (wd *webhookDriver) setPendingBatch(batch []*Event, completedByCall bool) error {
+	body, err := json.Marshal(&Payload{
+		Version: PayloadVersion,
+		Events:  batch,
+	})
+	if err != nil {
+		return err
+	}
+
+	wd.pending = &pendingBatch{
+		events:          batch,
+		body:            body,
+		undelivered:     append([]string(nil), wd.endpoints...),
+		completedByCall: completedByCall,
+	}
+	wd.batch = make([]*Event, 0, wd.batchSize)
+
+	return nil
+}
+
+// deliverPendingBatch posts the pending batch to the endpoints that did not accept it yet. The endpoints that
+// fail are kept for the next delivery, while the batch is released once all the endpoints accepted it
+func (wd *webhookDriver) deliverPendingBatch() error {
+	var lastErr error
+	undelivered := make([]string, 0)
+	for _, endpoint := range wd.pending.undelivered {
+		err := wd.sender.send(wd.ctx, endpoint, wd.pending.body)
+		if err != nil {
+			log.Debug("webhook: could not send the batch", "endpoint", endpoint, "error", err)
+			undelivered = append(undelivered, endpoint)
+			lastErr = err
+		}
+	}
+
+	wd.pending.undelivered = undelivered
+	if lastErr != nil {
+		return lastErr
+	}
+
+	log.Trace("webhook: batch sent", "num events", len(wd.pending.events))
+	wd.pending = nil
+
+	return nil
+}
+
+func (wd *webhookDriver) flushPeriodically() {
+	ticker := time.NewTicker(wd.flushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-wd.ctx.Done():
+			return
+		case <-ticker.C:
+			wd.flushOnTick()
+		}
+	}
+}
+
+func (wd *webhookDriver) flushOnTick() {
+	wd.mutBatch.Lock()
+	defer wd.mutBatch.Unlock()
+
+	if wd.pending != nil && wd.pending.completedByCall {
+		// the batch is delivered by the retrial of the call that completed it
+		return
+	}
+
+	err := wd.flush()
+	if err != nil {
+		log.Debug("webhook: could not flush the batch, will retry", "error", err)
+	}
+}
+
+// flush posts the pending batch, then the events that are still batched. It must be called under mutBatch
+func (wd *webhookDriver) flush() error {
+	if wd.pending != nil {
+		err := wd.deliverPendingBatch()
+		if err != nil {
+			return err
+		}
+	}
+	if len(wd.batch) == 0 {
+		return nil
+	}
+
+	err := wd.setPendingBatch(wd.batch, false)
+	if err != nil {
+		return err
+	}
+
+	return wd.deliverPendingBatch()
+}
+
+func (wd *webhookDriver) createBlockData(args *indexer.ArgsSaveBlockData) *BlockData {
+	header := args.Header
+	block := &BlockData{
+		Hash:                   hex.EncodeToString(args.HeaderHash),
+		Nonce:                  header.GetNonce(),
+		Round:                  header.GetRound(),
+		Epoch:                  header.GetEpoch(),
+		Shard:                  header.GetShardID(),
+		Timestamp:              header.GetTimeStamp(),
+		PrevHash:               hex.EncodeToString(header.GetPrevHash()),
+		StateRootHash:          hex.EncodeToString(header.GetRootHash()),
+		SignersIndexes:         args.SignersIndexes,
+		NotarizedHeadersHashes: args.NotarizedHeadersHashes,
+		GasProvided:            args.HeaderGasConsumption.GasProvided,
+		GasRefunded:            args.HeaderGasConsumption.GasRefunded,
+		GasPenalized:           args.HeaderGasConsumption.GasPenalized,
+		MaxGasPerBlock:         args.HeaderGasConsumption.MaxGasPerBlock,
+	}
+
+	pool := args.TransactionsPool
+	if pool == nil {
+		return block
+	}
+
+	block.Transactions = wd.createTransactionsData(pool.Txs, NormalTransaction, block.Transactions)
+	block.Transactions = wd.createTransactionsData(pool.Scrs, UnsignedTransaction, block.Transactions)
+	block.Transactions = wd.createTransactionsData(pool.Rewards, RewardTransaction, block.Transactions)
+	block.Transactions = wd.createTransactionsData(pool.Invalid, InvalidTransaction, block.Transactions)
+	block.Transactions = wd.createTransactionsData(pool.Receipts, ReceiptTransaction, block.Transactions)
+	block.Logs = wd.createLogsData(pool.Logs)
+
+	return block
+}
+
+func (wd *webhookDriver) createTransactionsData(
+	txs map[string]data.TransactionHandler,
+	txType string,
+	transactions []*TransactionData,
+) []*TransactionData {
+	txHashes := make([]string, 0, len(txs))
+	for txHash := range txs {
+		txHashes = append(txHashes, txHash)
+	}
+	sort.Strings(txHashes)
+
+	for _, txHash := range txHashes {
+		tx := txs[txHash]
+		if check.IfNil(tx) {
+			continue
+		}
+
+		value := "0"
+		if tx.GetValue() != nil {
+			value = tx.GetValue().String()
+		}
+
+		transactions = append(transactions, &TransactionData{
+			Hash:     hex.EncodeToString([]byte(txHash)),
+			Type:     txType,
+			Nonce:    tx.GetNonce(),
+			Value:    value,
+			Sender:   wd.encodeAddress(tx.GetSndAddr()),
+			Receiver: wd.encodeAddress(tx.GetRcvAddr()),
+			GasPrice: tx.GetGasPrice(),
+			GasLimit: tx.GetGasLimit(),
+			Data:     tx.GetData(),
+		})
+	}
+
+	return transactions
+}
+
+func (wd *webhookDriver) createLogsData(logs []*data.LogData) []*LogData {
+	logsData := make([]*LogData, 0, len(logs))
+	for _, logData := range logs {
+		if logData == nil || check.IfNil(logData.LogHandler) {
+			continue
+		}
+
+		events := make([]*LogEventData, 0, len(logData.GetLogEvents()))
+		for _, logEvent := range logData.GetLogEvents() {
+			if check.IfNil(logEvent) {
+				continue
+			}
+
+			events = append(events, &LogEventData{
+				Address:    wd.encodeAddress(logEvent.GetAddress()),
+				Identifier: string(logEvent.GetIdentifier()),
+				Topics:     logEvent.GetTopics(),
+				Data:       logEvent.GetData(),
+			})
+		}
+
+		logsData = append(logsData, &LogData{
+			TxHash:  hex.EncodeToString([]byte(logData.TxHash)),
+			Address: wd.encodeAddress(logData.GetAddress()),
+			Events:  events,
+		})
+	}
+
+	return logsData
+}
+
+func (wd *webhookDriver) encodeAddress(address []byte) string {
+	if len(address) == 0 {
+		return ""
+	}
+
+	return wd.addressPubKeyConverter.Encode(address)
+}
+
+// Close tries to post the events that are still batched, then stops the driver
+func (wd *webhookDriver) Close() error {
+	wd.mutBatch.Lock()
+	defer wd.mutBatch.Unlock()
+
+	err := wd.flush()
+	if err != nil {
+		numEvents := len(wd.batch)
+		if wd.pending != nil {
+			numEvents += len(wd.pending.events)
+		}
+		log.Warn("webhook: could not send the remaining events on close",
+			"num events", numEvents, "error", err)
+	}
+	wd.batch = nil
+	wd.pending = nil
+
+	wd.cancel()
+
+	return err
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (wd *webhookDriver) IsInterfaceNil() bool {
+	return wd == nil
+}
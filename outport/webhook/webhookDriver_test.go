@@ -0,0 +1,303 @@
+package webhook
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/hashingMocks"
+	"github.com/stretchr/testify/require"
+)
+
+type receivedRequest struct {
+	header http.Header
+	body   []byte
+}
+
+type endpointMock struct {
+	server   *httptest.Server
+	mut      sync.Mutex
+	requests []*receivedRequest
+	failures int32
+}
+
+func newEndpointMock(numFailures int32, failureStatus int) *endpointMock {
+	em := &endpointMock{
+		failures: numFailures,
+	}
+	em.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&em.failures, -1) >= 0 {
+			w.WriteHeader(failureStatus)
+			return
+		}
+
+		body, _ := ioutil.ReadAll(r.Body)
+		em.mut.Lock()
+		em.requests = append(em.requests, &receivedRequest{header: r.Header, body: body})
+		em.mut.Unlock()
+	}))
+
+	return em
+}
+
+func (em *endpointMock) payloads(t *testing.T) []*Payload {
+	em.mut.Lock()
+	defer em.mut.Unlock()
+
+	payloads := make([]*Payload, 0, len(em.requests))
+	for _, request := range em.requests {
+		payload := &Payload{}
+		require.Nil(t, json.Unmarshal(request.body, payload))
+		payloads = append(payloads, payload)
+	}
+
+	return payloads
+}
+
+func createMockArgsWebhookDriver(endpoints ...string) ArgsWebhookDriver {
+	return ArgsWebhookDriver{
+		Endpoints:              endpoints,
+		HMACSecret:             "secret",
+		BatchSize:              1,
+		RequestTimeout:         time.Second,
+		MaxRetries:             2,
+		InitialBackoff:         time.Millisecond,
+		MaxBackoff:             5 * time.Millisecond,
+		FlushInterval:          time.Minute,
+		Marshalizer:            &testscommon.MarshalizerMock{},
+		Hasher:                 &hashingMocks.HasherMock{},
+		AddressPubKeyConverter: testscommon.NewPubkeyConverterMock(32),
+	}
+}
+
+func TestNewWebhookDriver(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		modify      func(args *ArgsWebhookDriver)
+		expectedErr error
+	}{
+		{"nil marshalizer", func(args *ArgsWebhookDriver) { args.Marshalizer = nil }, ErrNilMarshalizer},
+		{"nil hasher", func(args *ArgsWebhookDriver) { args.Hasher = nil }, ErrNilHasher},
+		{"nil pub key converter", func(args *ArgsWebhookDriver) { args.AddressPubKeyConverter = nil }, ErrNilPubKeyConverter},
+		{"no endpoints", func(args *ArgsWebhookDriver) { args.Endpoints = nil }, ErrNoEndpoints},
+		{"invalid endpoint", func(args *ArgsWebhookDriver) { args.Endpoints = []string{"localhost:8080"} }, ErrInvalidEndpoint},
+		{"invalid batch size", func(args *ArgsWebhookDriver) { args.BatchSize = 0 }, ErrInvalidBatchSize},
+		{"invalid request timeout", func(args *ArgsWebhookDriver) { args.RequestTimeout = 0 }, ErrInvalidRequestTimeout},
+		{"invalid max retries", func(args *ArgsWebhookDriver) { args.MaxRetries = -1 }, ErrInvalidBackoff},
+		{"invalid max backoff", func(args *ArgsWebhookDriver) { args.MaxBackoff = 0 }, ErrInvalidBackoff},
+		{"invalid flush interval", func(args *ArgsWebhookDriver) { args.FlushInterval = 0 }, ErrInvalidFlushInterval},
+		{"should work", func(args *ArgsWebhookDriver) {}, nil},
+	}
+
+	for _, tt := range tests {
+		args := createMockArgsWebhookDriver("http://localhost:8080/events")
+		tt.modify(&args)
+
+		driver, err := NewWebhookDriver(args)
+		require.Equal(t, tt.expectedErr, err, tt.name)
+		require.Equal(t, tt.expectedErr == nil, driver != nil, tt.name)
+	}
+}
+
+func TestWebhookDriver_SaveBlockShouldPostSignedPayload(t *testing.T) {
+	t.Parallel()
+
+	endpoint := newEndpointMock(0, 0)
+	defer endpoint.server.Close()
+
+	driver, _ := NewWebhookDriver(createMockArgsWebhookDriver(endpoint.server.URL))
+	err := driver.SaveBlock(&indexer.ArgsSaveBlockData{
+		HeaderHash: []byte("hash"),
+		Header:     &block.Header{Nonce: 7, Round: 8, Epoch: 1, ShardID: 2},
+		TransactionsPool: &indexer.Pool{
+			Txs: map[string]data.TransactionHandler{
+				"tx": &transaction.Transaction{Nonce: 3, Value: big.NewInt(10), SndAddr: []byte("sender"), RcvAddr: []byte("receiver")},
+			},
+			Invalid: map[string]data.TransactionHandler{
+				"invalid": &transaction.Transaction{},
+			},
+			Logs: []*data.LogData{
+				{
+					TxHash: "tx",
+					LogHandler: &transaction.Log{
+						Address: []byte("sc"),
+						Events:  []*transaction.Event{{Address: []byte("sc"), Identifier: []byte("transfer")}},
+					},
+				},
+			},
+		},
+	})
+	require.Nil(t, err)
+
+	require.Len(t, endpoint.requests, 1)
+	request := endpoint.requests[0]
+	require.Equal(t, signaturePrefix+ComputeSignature([]byte("secret"), request.body), request.header.Get(SignatureHeader))
+	require.Equal(t, "1", request.header.Get(VersionHeader))
+
+	payloads := endpoint.payloads(t)
+	require.Equal(t, PayloadVersion, payloads[0].Version)
+	require.Len(t, payloads[0].Events, 1)
+	require.Equal(t, SaveBlockEvent, payloads[0].Events[0].Type)
+
+	blockData := &BlockData{}
+	require.Nil(t, json.Unmarshal(payloads[0].Events[0].Data, blockData))
+	require.Equal(t, hex.EncodeToString([]byte("hash")), blockData.Hash)
+	require.Equal(t, uint64(7), blockData.Nonce)
+	require.Equal(t, uint32(2), blockData.Shard)
+	require.Len(t, blockData.Transactions, 2)
+	require.Equal(t, NormalTransaction, blockData.Transactions[0].Type)
+	require.Equal(t, "10", blockData.Transactions[0].Value)
+	require.Equal(t, hex.EncodeToString([]byte("sender")), blockData.Transactions[0].Sender)
+	require.Equal(t, InvalidTransaction, blockData.Transactions[1].Type)
+	require.Len(t, blockData.Logs, 1)
+	require.Equal(t, "transfer", blockData.Logs[0].Events[0].Identifier)
+}
+
+func TestWebhookDriver_EventsShouldBeBatched(t *testing.T) {
+	t.Parallel()
+
+	firstEndpoint := newEndpointMock(0, 0)
+	defer firstEndpoint.server.Close()
+	secondEndpoint := newEndpointMock(0, 0)
+	defer secondEndpoint.server.Close()
+
+	args := createMockArgsWebhookDriver(firstEndpoint.server.URL, secondEndpoint.server.URL)
+	args.BatchSize = 3
+	driver, _ := NewWebhookDriver(args)
+
+	require.Nil(t, driver.SaveRoundsInfo([]*indexer.RoundInfo{{Index: 1}, {Index: 2}}))
+	require.Nil(t, driver.SaveValidatorsRating("0_1", []*indexer.ValidatorRatingInfo{{PublicKey: "pk", Rating: 50}}))
+	require.Len(t, firstEndpoint.payloads(t), 0)
+
+	require.Nil(t, driver.FinalizedBlock([]byte("hash")))
+	require.Nil(t, driver.RevertIndexedBlock(&block.Header{Nonce: 1}, nil))
+
+	for _, endpoint := range []*endpointMock{firstEndpoint, secondEndpoint} {
+		payloads := endpoint.payloads(t)
+		require.Len(t, payloads, 1)
+		require.Len(t, payloads[0].Events, 3)
+		require.Equal(t, SaveRoundsInfoEvent, payloads[0].Events[0].Type)
+		require.Equal(t, SaveValidatorsRatingEvent, payloads[0].Events[1].Type)
+		require.Equal(t, FinalizedBlockEvent, payloads[0].Events[2].Type)
+	}
+
+	require.Nil(t, driver.Close())
+
+	payloads := firstEndpoint.payloads(t)
+	require.Len(t, payloads, 2)
+	require.Len(t, payloads[1].Events, 1)
+	require.Equal(t, RevertIndexedBlockEvent, payloads[1].Events[0].Type)
+}
+
+func TestWebhookDriver_ShouldRetryServerErrors(t *testing.T) {
+	t.Parallel()
+
+	endpoint := newEndpointMock(2, http.StatusServiceUnavailable)
+	defer endpoint.server.Close()
+
+	driver, _ := NewWebhookDriver(createMockArgsWebhookDriver(endpoint.server.URL))
+	require.Nil(t, driver.FinalizedBlock([]byte("hash")))
+	require.Len(t, endpoint.payloads(t), 1)
+}
+
+func TestWebhookDriver_FailedBatchShouldBeKeptForTheRetrial(t *testing.T) {
+	t.Parallel()
+
+	endpoint := newEndpointMock(1, http.StatusBadRequest)
+	defer endpoint.server.Close()
+
+	args := createMockArgsWebhookDriver(endpoint.server.URL)
+	args.BatchSize = 2
+	driver, _ := NewWebhookDriver(args)
+
+	require.Nil(t, driver.FinalizedBlock([]byte("first")))
+	err := driver.FinalizedBlock([]byte("second"))
+	require.True(t, errors.Is(err, ErrRequestFailed))
+	require.Len(t, endpoint.payloads(t), 0)
+
+	require.Nil(t, driver.FinalizedBlock([]byte("second")))
+	payloads := endpoint.payloads(t)
+	require.Len(t, payloads, 1)
+	require.Len(t, payloads[0].Events, 2)
+}
+
+func TestWebhookDriver_ShouldErrAfterMaxRetries(t *testing.T) {
+	t.Parallel()
+
+	endpoint := newEndpointMock(100, http.StatusInternalServerError)
+	defer endpoint.server.Close()
+
+	driver, _ := NewWebhookDriver(createMockArgsWebhookDriver(endpoint.server.URL))
+	err := driver.FinalizedBlock([]byte("hash"))
+	require.True(t, errors.Is(err, ErrRequestFailed))
+	require.Equal(t, int32(100-3), atomic.LoadInt32(&endpoint.failures))
+}
+
+func TestWebhookDriver_RetrialShouldOnlyPostToTheFailedEndpoints(t *testing.T) {
+	t.Parallel()
+
+	firstEndpoint := newEndpointMock(0, 0)
+	defer firstEndpoint.server.Close()
+	secondEndpoint := newEndpointMock(1, http.StatusBadRequest)
+	defer secondEndpoint.server.Close()
+
+	driver, _ := NewWebhookDriver(createMockArgsWebhookDriver(firstEndpoint.server.URL, secondEndpoint.server.URL))
+
+	err := driver.FinalizedBlock([]byte("hash"))
+	require.True(t, errors.Is(err, ErrRequestFailed))
+	require.Len(t, firstEndpoint.payloads(t), 1)
+	require.Len(t, secondEndpoint.payloads(t), 0)
+
+	require.Nil(t, driver.FinalizedBlock([]byte("hash")))
+	require.Len(t, firstEndpoint.payloads(t), 1)
+	require.Len(t, secondEndpoint.payloads(t), 1)
+
+	firstEvent := firstEndpoint.payloads(t)[0].Events[0]
+	secondEvent := secondEndpoint.payloads(t)[0].Events[0]
+	require.NotEmpty(t, firstEvent.ID)
+	require.Equal(t, firstEvent.ID, secondEvent.ID)
+
+	require.Nil(t, driver.FinalizedBlock([]byte("next hash")))
+	require.Len(t, firstEndpoint.payloads(t), 2)
+	require.Len(t, secondEndpoint.payloads(t), 2)
+	require.NotEqual(t, firstEvent.ID, firstEndpoint.payloads(t)[1].Events[0].ID)
+}
+
+func TestWebhookDriver_PartialBatchShouldBeFlushed(t *testing.T) {
+	t.Parallel()
+
+	endpoint := newEndpointMock(0, 0)
+	defer endpoint.server.Close()
+
+	args := createMockArgsWebhookDriver(endpoint.server.URL)
+	args.BatchSize = 10
+	args.FlushInterval = 10 * time.Millisecond
+	driver, _ := NewWebhookDriver(args)
+	defer func() {
+		_ = driver.Close()
+	}()
+
+	require.Nil(t, driver.FinalizedBlock([]byte("hash")))
+	require.Eventually(t, func() bool {
+		return len(endpoint.payloads(t)) == 1
+	}, time.Second, 5*time.Millisecond)
+
+	payloads := endpoint.payloads(t)
+	require.Len(t, payloads[0].Events, 1)
+	require.Equal(t, FinalizedBlockEvent, payloads[0].Events[0].Type)
+}
@@ -69,6 +69,8 @@ type ArgBaseProcessor struct {
 	BlockSizeThrottler             process.BlockSizeThrottler
 	Version                        string
 	HistoryRepository              dblookupext.HistoryRepository
+	StateChangesCollector          state.StateChangesCollector
+	BlockWitnessHandler            state.BlockWitnessHandler
 	EpochNotifier                  process.EpochNotifier
 	RoundNotifier                  process.RoundNotifier
 	VMContainersFactory            process.VirtualMachinesContainerFactory
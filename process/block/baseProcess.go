@@ -83,14 +83,16 @@ type baseProcessor struct {
 	blockProcessor         blockProcessor
 	txCounter              *transactionCounter
 
-	outportHandler      outport.OutportHandler
-	historyRepo         dblookupext.HistoryRepository
-	epochNotifier       process.EpochNotifier
-	roundNotifier       process.RoundNotifier
-	vmContainerFactory  process.VirtualMachinesContainerFactory
-	vmContainer         process.VirtualMachinesContainer
-	gasConsumedProvider gasConsumedProvider
-	economicsData       process.EconomicsDataHandler
+	outportHandler        outport.OutportHandler
+	historyRepo           dblookupext.HistoryRepository
+	stateChangesCollector state.StateChangesCollector
+	blockWitnessHandler   state.BlockWitnessHandler
+	epochNotifier         process.EpochNotifier
+	roundNotifier         process.RoundNotifier
+	vmContainerFactory    process.VirtualMachinesContainerFactory
+	vmContainer           process.VirtualMachinesContainer
+	gasConsumedProvider   gasConsumedProvider
+	economicsData         process.EconomicsDataHandler
 
 	processDataTriesOnCommitEpoch  bool
 	scheduledMiniBlocksEnableEpoch uint32
@@ -478,6 +480,12 @@ func checkProcessorNilParameters(arguments ArgBaseProcessor) error {
 	if check.IfNil(arguments.HistoryRepository) {
 		return process.ErrNilHistoryRepository
 	}
+	if check.IfNil(arguments.StateChangesCollector) {
+		return process.ErrNilStateChangesCollector
+	}
+	if check.IfNil(arguments.BlockWitnessHandler) {
+		return process.ErrNilBlockWitnessHandler
+	}
 	if check.IfNil(arguments.BootstrapComponents.HeaderIntegrityVerifier()) {
 		return process.ErrNilHeaderIntegrityVerifier
 	}
@@ -1553,12 +1561,91 @@ func (bp *baseProcessor) requestMiniBlocksIfNeeded(headerHandler data.HeaderHand
 	bp.txCoordinator.RequestMiniBlocks(headerHandler)
 }
 
+func (bp *baseProcessor) saveStateChanges(headerHash []byte, header data.HeaderHandler) {
+	err := bp.stateChangesCollector.SaveStateChanges(headerHash)
+	if err != nil {
+		log.Warn("stateChangesCollector.SaveStateChanges()",
+			"hash", headerHash,
+			"nonce", header.GetNonce(),
+			"error", err.Error(),
+		)
+	}
+}
+
+// startBlockWitnessRecording starts recording the trie nodes read while the block is executed. The tries are
+// recreated from their current root hashes so that the nodes already kept in memory are read, and recorded, again
+func (bp *baseProcessor) startBlockWitnessRecording() error {
+	if !bp.blockWitnessHandler.IsEnabled() {
+		return nil
+	}
+
+	rootHashes, err := bp.getAccountsRootHashes()
+	if err != nil {
+		return err
+	}
+
+	bp.blockWitnessHandler.StartRecording(rootHashes[state.UserAccountsState], rootHashes[state.PeerAccountsState])
+
+	return bp.recreateAccountsTries(rootHashes)
+}
+
+func (bp *baseProcessor) saveBlockWitness(headerHash []byte, header data.HeaderHandler) {
+	if !bp.blockWitnessHandler.IsEnabled() {
+		return
+	}
+
+	err := bp.blockWitnessHandler.SaveWitness(headerHash)
+	if err != nil {
+		log.Warn("blockWitnessHandler.SaveWitness()",
+			"hash", headerHash,
+			"nonce", header.GetNonce(),
+			"error", err.Error(),
+		)
+	}
+}
+
+func (bp *baseProcessor) getAccountsRootHashes() (map[state.AccountsDbIdentifier][]byte, error) {
+	rootHashes := make(map[state.AccountsDbIdentifier][]byte, len(bp.accountsDB))
+	for key, accounts := range bp.accountsDB {
+		if accounts.JournalLen() != 0 {
+			return nil, process.ErrAccountStateDirty
+		}
+
+		rootHash, err := accounts.RootHash()
+		if err != nil {
+			return nil, err
+		}
+
+		rootHashes[key] = rootHash
+	}
+
+	return rootHashes, nil
+}
+
+func (bp *baseProcessor) recreateAccountsTries(rootHashes map[state.AccountsDbIdentifier][]byte) error {
+	for key, rootHash := range rootHashes {
+		err := bp.accountsDB[key].RecreateTrie(rootHash)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (bp *baseProcessor) recordBlockInHistory(blockHeaderHash []byte, blockHeader data.HeaderHandler, blockBody data.BodyHandler) {
+	txsFromPool := make(map[string]data.TransactionHandler)
+	for hash, tx := range bp.txCoordinator.GetAllCurrentUsedTxs(block.TxBlock) {
+		txsFromPool[hash] = tx
+	}
+	for hash, tx := range bp.txCoordinator.GetAllCurrentUsedTxs(block.RewardsBlock) {
+		txsFromPool[hash] = tx
+	}
 	scrResultsFromPool := bp.txCoordinator.GetAllCurrentUsedTxs(block.SmartContractResultBlock)
 	receiptsFromPool := bp.txCoordinator.GetAllCurrentUsedTxs(block.ReceiptBlock)
 	logs := bp.txCoordinator.GetAllCurrentLogs()
 
-	err := bp.historyRepo.RecordBlock(blockHeaderHash, blockHeader, blockBody, scrResultsFromPool, receiptsFromPool, logs)
+	err := bp.historyRepo.RecordBlock(blockHeaderHash, blockHeader, blockBody, txsFromPool, scrResultsFromPool, receiptsFromPool, logs)
 	if err != nil {
 		log.Error("historyRepo.RecordBlock()", "blockHeaderHash", blockHeaderHash, "error", err.Error())
 	}
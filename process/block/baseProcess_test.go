@@ -377,6 +377,8 @@ func CreateMockArguments(
 			BlockSizeThrottler:             &mock.BlockSizeThrottlerStub{},
 			Version:                        "softwareVersion",
 			HistoryRepository:              &dblookupext.HistoryRepositoryStub{},
+			StateChangesCollector:          state.NewDisabledStateChangesCollector(),
+			BlockWitnessHandler:            state.NewDisabledBlockWitnessHandler(),
 			EpochNotifier:                  &epochNotifier.EpochNotifierStub{},
 			RoundNotifier:                  &mock.RoundNotifierStub{},
 			GasHandler:                     &mock.GasHandlerMock{},
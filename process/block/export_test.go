@@ -28,6 +28,10 @@ func (bp *baseProcessor) ComputeHeaderHash(hdr data.HeaderHandler) ([]byte, erro
 	return core.CalculateHash(bp.marshalizer, bp.hasher, hdr)
 }
 
+func (bp *baseProcessor) StartBlockWitnessRecording() error {
+	return bp.startBlockWitnessRecording()
+}
+
 func (bp *baseProcessor) VerifyStateRoot(rootHash []byte) bool {
 	return bp.verifyStateRoot(rootHash)
 }
@@ -137,6 +141,8 @@ func NewShardProcessorEmptyWith3shards(
 			BlockSizeThrottler:             &mock.BlockSizeThrottlerStub{},
 			Version:                        "softwareVersion",
 			HistoryRepository:              &dblookupext.HistoryRepositoryStub{},
+			StateChangesCollector:          state.NewDisabledStateChangesCollector(),
+			BlockWitnessHandler:            state.NewDisabledBlockWitnessHandler(),
 			EpochNotifier:                  &epochNotifier.EpochNotifierStub{},
 			RoundNotifier:                  &mock.RoundNotifierStub{},
 			GasHandler:                     &mock.GasHandlerMock{},
@@ -114,6 +114,8 @@ func NewMetaProcessor(arguments ArgMetaProcessor) (*metaProcessor, error) {
 		versionedHeaderFactory:         arguments.BootstrapComponents.VersionedHeaderFactory(),
 		headerIntegrityVerifier:        arguments.BootstrapComponents.HeaderIntegrityVerifier(),
 		historyRepo:                    arguments.HistoryRepository,
+		stateChangesCollector:          arguments.StateChangesCollector,
+		blockWitnessHandler:            arguments.BlockWitnessHandler,
 		epochNotifier:                  arguments.EpochNotifier,
 		roundNotifier:                  arguments.RoundNotifier,
 		vmContainerFactory:             arguments.VMContainersFactory,
@@ -269,6 +271,11 @@ func (mp *metaProcessor) ProcessBlock(
 		return process.ErrAccountStateDirty
 	}
 
+	err = mp.startBlockWitnessRecording()
+	if err != nil {
+		return err
+	}
+
 	err = mp.processIfFirstBlockAfterEpochStart()
 	if err != nil {
 		return err
@@ -666,6 +673,7 @@ func (mp *metaProcessor) indexBlock(
 		},
 		NotarizedHeadersHashes: notarizedHeadersHashes,
 		TransactionsPool:       pool,
+		AlteredAccounts:        mp.stateChangesCollector.GetAlteredAccounts(),
 	}
 	mp.outportHandler.SaveBlock(args)
 	log.Debug("indexed block", "hash", headerHash, "nonce", metaBlock.GetNonce(), "round", metaBlock.GetRound())
@@ -756,7 +764,12 @@ func (mp *metaProcessor) CreateBlock(
 		return nil, nil, process.ErrAccountStateDirty
 	}
 
-	err := mp.processIfFirstBlockAfterEpochStart()
+	err := mp.startBlockWitnessRecording()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = mp.processIfFirstBlockAfterEpochStart()
 	if err != nil {
 		return nil, nil, err
 	}
@@ -1230,6 +1243,9 @@ func (mp *metaProcessor) CommitBlock(
 		return err
 	}
 
+	mp.saveStateChanges(headerHash, header)
+	mp.saveBlockWitness(headerHash, header)
+
 	mp.validatorStatisticsProcessor.DisplayRatings(header.GetEpoch())
 
 	err = mp.saveLastNotarizedHeader(header)
@@ -95,6 +95,8 @@ func NewShardProcessor(arguments ArgShardProcessor) (*shardProcessor, error) {
 		versionedHeaderFactory:         arguments.BootstrapComponents.VersionedHeaderFactory(),
 		headerIntegrityVerifier:        arguments.BootstrapComponents.HeaderIntegrityVerifier(),
 		historyRepo:                    arguments.HistoryRepository,
+		stateChangesCollector:          arguments.StateChangesCollector,
+		blockWitnessHandler:            arguments.BlockWitnessHandler,
 		epochNotifier:                  arguments.EpochNotifier,
 		roundNotifier:                  arguments.RoundNotifier,
 		vmContainerFactory:             arguments.VMContainersFactory,
@@ -265,6 +267,11 @@ func (sp *shardProcessor) ProcessBlock(
 		return process.ErrAccountStateDirty
 	}
 
+	err = sp.startBlockWitnessRecording()
+	if err != nil {
+		return err
+	}
+
 	defer func() {
 		go sp.checkAndRequestIfMetaHeadersMissing()
 	}()
@@ -660,6 +667,7 @@ func (sp *shardProcessor) indexBlockIfNeeded(
 		},
 		NotarizedHeadersHashes: nil,
 		TransactionsPool:       pool,
+		AlteredAccounts:        sp.stateChangesCollector.GetAlteredAccounts(),
 	}
 
 	sp.outportHandler.SaveBlock(args)
@@ -763,6 +771,11 @@ func (sp *shardProcessor) CreateBlock(
 		return nil, nil, err
 	}
 
+	err = sp.startBlockWitnessRecording()
+	if err != nil {
+		return nil, nil, err
+	}
+
 	// placeholder for shardProcessor.CreateBlock script 1
 
 	// placeholder for shardProcessor.CreateBlock script 2
@@ -908,6 +921,9 @@ func (sp *shardProcessor) CommitBlock(
 		return err
 	}
 
+	sp.saveStateChanges(headerHash, header)
+	sp.saveBlockWitness(headerHash, header)
+
 	log.Info("shard block has been committed successfully",
 		"epoch", header.GetEpoch(),
 		"shard", header.GetShardID(),
@@ -312,6 +312,115 @@ func TestNewShardProcessor_NilScheduledTxsExecutionHandlerShouldErr(t *testing.T
 	assert.Nil(t, sp)
 }
 
+func TestNewShardProcessor_NilStateChangesCollectorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arguments := CreateMockArguments(createComponentHolderMocks())
+	arguments.StateChangesCollector = nil
+	sp, err := blproc.NewShardProcessor(arguments)
+
+	assert.Equal(t, process.ErrNilStateChangesCollector, err)
+	assert.Nil(t, sp)
+}
+
+func TestNewShardProcessor_NilBlockWitnessHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arguments := CreateMockArguments(createComponentHolderMocks())
+	arguments.BlockWitnessHandler = nil
+	sp, err := blproc.NewShardProcessor(arguments)
+
+	assert.Equal(t, process.ErrNilBlockWitnessHandler, err)
+	assert.Nil(t, sp)
+}
+
+func TestShardProcessor_StartBlockWitnessRecording(t *testing.T) {
+	t.Parallel()
+
+	t.Run("disabled block witness should not record", func(t *testing.T) {
+		t.Parallel()
+
+		arguments := CreateMockArguments(createComponentHolderMocks())
+		arguments.BlockWitnessHandler = &stateMock.BlockWitnessHandlerStub{
+			StartRecordingCalled: func(rootHash []byte, validatorStatsRootHash []byte) {
+				assert.Fail(t, "should have not started recording")
+			},
+		}
+		sp, _ := blproc.NewShardProcessor(arguments)
+
+		err := sp.StartBlockWitnessRecording()
+		assert.Nil(t, err)
+	})
+	t.Run("dirty accounts state should error", func(t *testing.T) {
+		t.Parallel()
+
+		arguments := CreateMockArguments(createComponentHolderMocks())
+		arguments.BlockWitnessHandler = &stateMock.BlockWitnessHandlerStub{
+			IsEnabledCalled: func() bool {
+				return true
+			},
+			StartRecordingCalled: func(rootHash []byte, validatorStatsRootHash []byte) {
+				assert.Fail(t, "should have not started recording")
+			},
+		}
+		arguments.AccountsDB[state.UserAccountsState] = &stateMock.AccountsStub{
+			JournalLenCalled: func() int {
+				return 1
+			},
+		}
+		sp, _ := blproc.NewShardProcessor(arguments)
+
+		err := sp.StartBlockWitnessRecording()
+		assert.Equal(t, process.ErrAccountStateDirty, err)
+	})
+	t.Run("should record from the current root hashes", func(t *testing.T) {
+		t.Parallel()
+
+		rootHash := []byte("root hash")
+		validatorStatsRootHash := []byte("validator stats root hash")
+		isRecording := false
+		recreatedRootHashes := make([][]byte, 0)
+
+		arguments := CreateMockArguments(createComponentHolderMocks())
+		arguments.BlockWitnessHandler = &stateMock.BlockWitnessHandlerStub{
+			IsEnabledCalled: func() bool {
+				return true
+			},
+			StartRecordingCalled: func(providedRootHash []byte, providedValidatorStatsRootHash []byte) {
+				assert.Equal(t, rootHash, providedRootHash)
+				assert.Equal(t, validatorStatsRootHash, providedValidatorStatsRootHash)
+				isRecording = true
+			},
+		}
+		arguments.AccountsDB[state.UserAccountsState] = &stateMock.AccountsStub{
+			RootHashCalled: func() ([]byte, error) {
+				return rootHash, nil
+			},
+			RecreateTrieCalled: func(providedRootHash []byte) error {
+				assert.True(t, isRecording)
+				recreatedRootHashes = append(recreatedRootHashes, providedRootHash)
+				return nil
+			},
+		}
+		arguments.AccountsDB[state.PeerAccountsState] = &stateMock.AccountsStub{
+			RootHashCalled: func() ([]byte, error) {
+				return validatorStatsRootHash, nil
+			},
+			RecreateTrieCalled: func(providedRootHash []byte) error {
+				assert.True(t, isRecording)
+				recreatedRootHashes = append(recreatedRootHashes, providedRootHash)
+				return nil
+			},
+		}
+		sp, _ := blproc.NewShardProcessor(arguments)
+
+		err := sp.StartBlockWitnessRecording()
+		assert.Nil(t, err)
+		assert.True(t, isRecording)
+		assert.Equal(t, 2, len(recreatedRootHashes))
+	})
+}
+
 func TestNewShardProcessor_OkValsShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -19,6 +19,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/hashing"
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/state"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
@@ -340,6 +341,97 @@ func GetMetaHeaderFromStorageWithNonce(
 	return hdr, hash, nil
 }
 
+// GetHeaderFromStorageWithOptions returns the block header selected by the account query options, either by its hash
+// or by its nonce, together with its hash. If the epoch by hash index is enabled, the header is read from the storer of
+// its epoch, otherwise it is only searched in the active epochs
+func GetHeaderFromStorageWithOptions(
+	options common.AccountQueryOptions,
+	shardId uint32,
+	storageService dataRetriever.StorageService,
+	epochByHashGetter EpochByHashGetter,
+	uint64Converter typeConverters.Uint64ByteSliceConverter,
+	marshalizer marshal.Marshalizer,
+) (data.HeaderHandler, []byte, error) {
+	if check.IfNil(epochByHashGetter) {
+		return nil, nil, ErrNilHistoryRepository
+	}
+
+	hash := options.BlockHash
+	if len(hash) == 0 {
+		nonceHashUnit := dataRetriever.ShardHdrNonceHashDataUnit + dataRetriever.UnitType(shardId)
+		if shardId == core.MetachainShardId {
+			nonceHashUnit = dataRetriever.MetaHdrNonceHashDataUnit
+		}
+
+		var err error
+		hash, err = getHeaderHashFromStorageWithNonce(options.BlockNonce, storageService, uint64Converter, marshalizer, nonceHashUnit)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	headerUnit := dataRetriever.BlockHeaderUnit
+	if shardId == core.MetachainShardId {
+		headerUnit = dataRetriever.MetaBlockUnit
+	}
+
+	buffHdr, err := getMarshalizedHeaderFromEpochStorage(headerUnit, hash, storageService, epochByHashGetter, marshalizer)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if shardId == core.MetachainShardId {
+		metaBlock := &block.MetaBlock{}
+		err = marshalizer.Unmarshal(metaBlock, buffHdr)
+		if err != nil {
+			return nil, nil, ErrUnmarshalWithoutSuccess
+		}
+
+		return metaBlock, hash, nil
+	}
+
+	hdr, err := CreateShardHeader(marshalizer, buffHdr)
+	if err != nil {
+		return nil, nil, ErrUnmarshalWithoutSuccess
+	}
+
+	return hdr, hash, nil
+}
+
+func getMarshalizedHeaderFromEpochStorage(
+	headerUnit dataRetriever.UnitType,
+	hash []byte,
+	storageService dataRetriever.StorageService,
+	epochByHashGetter EpochByHashGetter,
+	marshalizer marshal.Marshalizer,
+) ([]byte, error) {
+	if !epochByHashGetter.IsEnabled() {
+		return GetMarshalizedHeaderFromStorage(headerUnit, hash, marshalizer, storageService)
+	}
+
+	epoch, err := epochByHashGetter.GetEpochByHash(hash)
+	if err != nil {
+		return nil, fmt.Errorf("%w : the epoch of the header was not found, hash = %s, error = %s",
+			ErrMissingHeader, logger.DisplayByteSlice(hash), err.Error())
+	}
+
+	if check.IfNil(storageService) {
+		return nil, ErrNilStorage
+	}
+	hdrStore := storageService.GetStorer(headerUnit)
+	if check.IfNil(hdrStore) {
+		return nil, ErrNilHeadersStorage
+	}
+
+	buffHdr, err := hdrStore.GetFromEpoch(hash, epoch)
+	if err != nil {
+		return nil, fmt.Errorf("%w : getMarshalizedHeaderFromEpochStorage hash = %s, epoch = %d",
+			ErrMissingHeader, logger.DisplayByteSlice(hash), epoch)
+	}
+
+	return buffHdr, nil
+}
+
 // GetTransactionHandler gets the transaction with a given sender/receiver shardId and txHash
 func GetTransactionHandler(
 	senderShardID uint32,
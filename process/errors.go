@@ -1073,3 +1073,24 @@ var ErrNumOfMiniBlocksAndMiniBlocksHeadersMismatch = errors.New("num of mini blo
 
 // ErrNilDoubleTransactionsDetector signals that a nil double transactions detector has been provided
 var ErrNilDoubleTransactionsDetector = errors.New("nil double transactions detector")
+
+// ErrNilHistoricalStateSelector signals that a nil historical state selector has been provided
+var ErrNilHistoricalStateSelector = errors.New("nil historical state selector")
+
+// ErrNilAccountsStateSelector signals that a nil accounts state selector has been provided
+var ErrNilAccountsStateSelector = errors.New("nil accounts state selector")
+
+// ErrHistoricalStateNotSupported signals that the queries on the state of past blocks are not supported
+var ErrHistoricalStateNotSupported = errors.New("queries on the state of past blocks are not supported")
+
+// ErrNilStateChangesCollector signals that a nil state changes collector has been provided
+var ErrNilStateChangesCollector = errors.New("nil state changes collector")
+
+// ErrNilBlockWitnessHandler signals that a nil block witness handler has been provided
+var ErrNilBlockWitnessHandler = errors.New("nil block witness handler")
+
+// ErrNilBlockWitnessDB signals that a nil block witness database has been provided
+var ErrNilBlockWitnessDB = errors.New("nil block witness database")
+
+// ErrBlockWitnessRootHashMismatch signals that the root hashes of a block witness do not match the previous block
+var ErrBlockWitnessRootHashMismatch = errors.New("block witness root hash does not match the previous block")
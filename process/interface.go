@@ -696,6 +696,8 @@ type SCQuery struct {
 	Arguments      [][]byte
 	SameScState    bool
 	ShouldBeSynced bool
+
+	AccountQueryOptions common.AccountQueryOptions
 }
 
 // GasHandler is able to perform some gas calculation
@@ -841,6 +843,20 @@ type SCQueryService interface {
 	IsInterfaceNil() bool
 }
 
+// HistoricalStateSelector selects the state of a past block, on which the smart contract queries are executed
+type HistoricalStateSelector interface {
+	SelectBlock(options common.AccountQueryOptions) (data.HeaderHandler, error)
+	SelectCurrentState()
+	IsInterfaceNil() bool
+}
+
+// AccountsStateSelector selects the state of the accounts by its root hash
+type AccountsStateSelector interface {
+	SelectRootHash(rootHash []byte) error
+	SelectCurrentState()
+	IsInterfaceNil() bool
+}
+
 // EpochStartDataCreator defines the functionality for node to create epoch start data
 type EpochStartDataCreator interface {
 	CreateEpochStartData() (*block.EpochStart, error)
@@ -1165,3 +1181,10 @@ type DoubleTransactionDetector interface {
 	ProcessBlockBody(body *block.Body)
 	IsInterfaceNil() bool
 }
+
+// EpochByHashGetter defines the component able to return the epoch in which a block was saved, by its hash
+type EpochByHashGetter interface {
+	GetEpochByHash(hash []byte) (uint32, error)
+	IsEnabled() bool
+	IsInterfaceNil() bool
+}
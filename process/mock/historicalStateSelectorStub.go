@@ -0,0 +1,33 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+// HistoricalStateSelectorStub -
+type HistoricalStateSelectorStub struct {
+	SelectBlockCalled        func(options common.AccountQueryOptions) (data.HeaderHandler, error)
+	SelectCurrentStateCalled func()
+}
+
+// SelectBlock -
+func (stub *HistoricalStateSelectorStub) SelectBlock(options common.AccountQueryOptions) (data.HeaderHandler, error) {
+	if stub.SelectBlockCalled != nil {
+		return stub.SelectBlockCalled(options)
+	}
+
+	return nil, nil
+}
+
+// SelectCurrentState -
+func (stub *HistoricalStateSelectorStub) SelectCurrentState() {
+	if stub.SelectCurrentStateCalled != nil {
+		stub.SelectCurrentStateCalled()
+	}
+}
+
+// IsInterfaceNil -
+func (stub *HistoricalStateSelectorStub) IsInterfaceNil() bool {
+	return stub == nil
+}
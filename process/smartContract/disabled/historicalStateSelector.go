@@ -0,0 +1,30 @@
+package disabled
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+type historicalStateSelector struct {
+}
+
+// NewHistoricalStateSelector returns a new instance of a historical state selector that does not support any
+// past state
+func NewHistoricalStateSelector() *historicalStateSelector {
+	return &historicalStateSelector{}
+}
+
+// SelectBlock returns ErrHistoricalStateNotSupported
+func (selector *historicalStateSelector) SelectBlock(_ common.AccountQueryOptions) (data.HeaderHandler, error) {
+	return nil, process.ErrHistoricalStateNotSupported
+}
+
+// SelectCurrentState does nothing
+func (selector *historicalStateSelector) SelectCurrentState() {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (selector *historicalStateSelector) IsInterfaceNil() bool {
+	return selector == nil
+}
@@ -0,0 +1,98 @@
+package smartContract
+
+import (
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/typeConverters"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dblookupext"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+// ArgsHistoricalStateSelector defines the arguments needed for the historical state selector
+type ArgsHistoricalStateSelector struct {
+	AccountsStateSelector process.AccountsStateSelector
+	StorageService        dataRetriever.StorageService
+	HistoryRepository     dblookupext.HistoryRepository
+	Marshalizer           marshal.Marshalizer
+	Uint64Converter       typeConverters.Uint64ByteSliceConverter
+	ShardCoordinator      sharding.Coordinator
+}
+
+type historicalStateSelector struct {
+	accountsStateSelector process.AccountsStateSelector
+	storageService        dataRetriever.StorageService
+	historyRepository     dblookupext.HistoryRepository
+	marshalizer           marshal.Marshalizer
+	uint64Converter       typeConverters.Uint64ByteSliceConverter
+	shardCoordinator      sharding.Coordinator
+}
+
+// NewHistoricalStateSelector returns a new instance of historicalStateSelector
+func NewHistoricalStateSelector(args ArgsHistoricalStateSelector) (*historicalStateSelector, error) {
+	if check.IfNil(args.AccountsStateSelector) {
+		return nil, process.ErrNilAccountsStateSelector
+	}
+	if check.IfNil(args.StorageService) {
+		return nil, process.ErrNilStorage
+	}
+	if check.IfNil(args.HistoryRepository) {
+		return nil, process.ErrNilHistoryRepository
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(args.Uint64Converter) {
+		return nil, process.ErrNilUint64Converter
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, process.ErrNilShardCoordinator
+	}
+
+	return &historicalStateSelector{
+		accountsStateSelector: args.AccountsStateSelector,
+		storageService:        args.StorageService,
+		historyRepository:     args.HistoryRepository,
+		marshalizer:           args.Marshalizer,
+		uint64Converter:       args.Uint64Converter,
+		shardCoordinator:      args.ShardCoordinator,
+	}, nil
+}
+
+// SelectBlock reads the header selected by the provided options from the storage and selects the state found under
+// its root hash. The header is returned, so the queries can be executed in its context
+func (selector *historicalStateSelector) SelectBlock(options common.AccountQueryOptions) (data.HeaderHandler, error) {
+	header, _, err := process.GetHeaderFromStorageWithOptions(
+		options,
+		selector.shardCoordinator.SelfId(),
+		selector.storageService,
+		selector.historyRepository,
+		selector.uint64Converter,
+		selector.marshalizer,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", process.ErrMissingHeader, err.Error())
+	}
+
+	err = selector.accountsStateSelector.SelectRootHash(header.GetRootHash())
+	if err != nil {
+		return nil, err
+	}
+
+	return header, nil
+}
+
+// SelectCurrentState selects back the current state of the accounts
+func (selector *historicalStateSelector) SelectCurrentState() {
+	selector.accountsStateSelector.SelectCurrentState()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (selector *historicalStateSelector) IsInterfaceNil() bool {
+	return selector == nil
+}
@@ -0,0 +1,196 @@
+package smartContract
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/dblookupext"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericMocks"
+	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMockArgumentsForHistoricalStateSelector() ArgsHistoricalStateSelector {
+	accountsWithHistory, _ := state.NewAccountsDBWithHistory(&stateMock.AccountsStub{}, &stateMock.AccountsStub{})
+
+	return ArgsHistoricalStateSelector{
+		AccountsStateSelector: accountsWithHistory,
+		StorageService:        genericMocks.NewChainStorerMock(0),
+		HistoryRepository: &dblookupext.HistoryRepositoryStub{
+			IsEnabledCalled: func() bool {
+				return false
+			},
+		},
+		Marshalizer:           &testscommon.MarshalizerMock{},
+		Uint64Converter:       &mock.Uint64ByteSliceConverterMock{},
+		ShardCoordinator:      testscommon.NewMultiShardsCoordinatorMock(1),
+	}
+}
+
+func TestNewHistoricalStateSelector(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil accounts state selector should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgumentsForHistoricalStateSelector()
+		args.AccountsStateSelector = nil
+		selector, err := NewHistoricalStateSelector(args)
+
+		assert.True(t, check.IfNil(selector))
+		assert.Equal(t, process.ErrNilAccountsStateSelector, err)
+	})
+	t.Run("nil storage service should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgumentsForHistoricalStateSelector()
+		args.StorageService = nil
+		selector, err := NewHistoricalStateSelector(args)
+
+		assert.True(t, check.IfNil(selector))
+		assert.Equal(t, process.ErrNilStorage, err)
+	})
+	t.Run("nil history repository should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgumentsForHistoricalStateSelector()
+		args.HistoryRepository = nil
+		selector, err := NewHistoricalStateSelector(args)
+
+		assert.True(t, check.IfNil(selector))
+		assert.Equal(t, process.ErrNilHistoryRepository, err)
+	})
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgumentsForHistoricalStateSelector()
+		args.Marshalizer = nil
+		selector, err := NewHistoricalStateSelector(args)
+
+		assert.True(t, check.IfNil(selector))
+		assert.Equal(t, process.ErrNilMarshalizer, err)
+	})
+	t.Run("nil uint64 converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgumentsForHistoricalStateSelector()
+		args.Uint64Converter = nil
+		selector, err := NewHistoricalStateSelector(args)
+
+		assert.True(t, check.IfNil(selector))
+		assert.Equal(t, process.ErrNilUint64Converter, err)
+	})
+	t.Run("nil shard coordinator should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgumentsForHistoricalStateSelector()
+		args.ShardCoordinator = nil
+		selector, err := NewHistoricalStateSelector(args)
+
+		assert.True(t, check.IfNil(selector))
+		assert.Equal(t, process.ErrNilShardCoordinator, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		selector, err := NewHistoricalStateSelector(createMockArgumentsForHistoricalStateSelector())
+
+		assert.False(t, check.IfNil(selector))
+		assert.Nil(t, err)
+	})
+}
+
+func TestHistoricalStateSelector_SelectBlock(t *testing.T) {
+	t.Parallel()
+
+	blockHash := []byte("block hash")
+	historicalRootHash := []byte("historical root hash")
+
+	t.Run("missing header should error", func(t *testing.T) {
+		t.Parallel()
+
+		selector, _ := NewHistoricalStateSelector(createMockArgumentsForHistoricalStateSelector())
+
+		header, err := selector.SelectBlock(common.AccountQueryOptions{BlockHash: blockHash})
+		assert.Nil(t, header)
+		assert.True(t, errors.Is(err, process.ErrMissingHeader))
+	})
+	t.Run("pruned state should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgumentsForHistoricalStateSelector()
+		historicalAccounts := &stateMock.AccountsStub{
+			RecreateTrieCalled: func(_ []byte) error {
+				return errors.New("missing trie node")
+			},
+		}
+		args.AccountsStateSelector, _ = state.NewAccountsDBWithHistory(&stateMock.AccountsStub{}, historicalAccounts)
+		storageService := genericMocks.NewChainStorerMock(0)
+		_ = storageService.HdrNonce.PutWithMarshalizer(blockHash, &block.Header{RootHash: historicalRootHash}, args.Marshalizer)
+		args.StorageService = storageService
+		selector, _ := NewHistoricalStateSelector(args)
+
+		header, err := selector.SelectBlock(common.AccountQueryOptions{BlockHash: blockHash})
+		assert.Nil(t, header)
+		assert.True(t, errors.Is(err, state.ErrStateNotAvailable))
+	})
+	t.Run("should select the root hash of the block", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgumentsForHistoricalStateSelector()
+		var recreatedRootHash []byte
+		historicalAccounts := &stateMock.AccountsStub{
+			RecreateTrieCalled: func(rootHash []byte) error {
+				recreatedRootHash = rootHash
+				return nil
+			},
+		}
+		args.AccountsStateSelector, _ = state.NewAccountsDBWithHistory(&stateMock.AccountsStub{}, historicalAccounts)
+		storageService := genericMocks.NewChainStorerMock(0)
+		_ = storageService.HdrNonce.PutWithMarshalizer(blockHash, &block.Header{Nonce: 7, RootHash: historicalRootHash}, args.Marshalizer)
+		args.StorageService = storageService
+		selector, _ := NewHistoricalStateSelector(args)
+
+		header, err := selector.SelectBlock(common.AccountQueryOptions{BlockHash: blockHash})
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(7), header.GetNonce())
+		assert.Equal(t, historicalRootHash, recreatedRootHash)
+	})
+	t.Run("should select the block saved in an old epoch", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgumentsForHistoricalStateSelector()
+		var recreatedRootHash []byte
+		historicalAccounts := &stateMock.AccountsStub{
+			RecreateTrieCalled: func(rootHash []byte) error {
+				recreatedRootHash = rootHash
+				return nil
+			},
+		}
+		args.AccountsStateSelector, _ = state.NewAccountsDBWithHistory(&stateMock.AccountsStub{}, historicalAccounts)
+		args.HistoryRepository = &dblookupext.HistoryRepositoryStub{
+			GetEpochByHashCalled: func(hash []byte) (uint32, error) {
+				assert.Equal(t, blockHash, hash)
+				return 2, nil
+			},
+		}
+		// the active epoch of the storer is 5, the header being saved in the storer of epoch 2
+		storageService := genericMocks.NewChainStorerMock(5)
+		headerBytes, _ := args.Marshalizer.Marshal(&block.Header{Nonce: 7, Epoch: 2, RootHash: historicalRootHash})
+		_ = storageService.HdrNonce.PutInEpoch(blockHash, headerBytes, 2)
+		args.StorageService = storageService
+		selector, _ := NewHistoricalStateSelector(args)
+
+		header, err := selector.SelectBlock(common.AccountQueryOptions{BlockHash: blockHash})
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(7), header.GetNonce())
+		assert.Equal(t, historicalRootHash, recreatedRootHash)
+	})
+}
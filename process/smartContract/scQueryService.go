@@ -31,6 +31,7 @@ type SCQueryService struct {
 	arwenChangeLocker        common.Locker
 	bootstrapper             process.Bootstrapper
 	allowExternalQueriesChan chan struct{}
+	historicalStateSelector  process.HistoricalStateSelector
 }
 
 // ArgsNewSCQueryService defines the arguments needed for the sc query service
@@ -42,6 +43,7 @@ type ArgsNewSCQueryService struct {
 	ArwenChangeLocker        common.Locker
 	Bootstrapper             process.Bootstrapper
 	AllowExternalQueriesChan chan struct{}
+	HistoricalStateSelector  process.HistoricalStateSelector
 }
 
 // NewSCQueryService returns a new instance of SCQueryService
@@ -69,6 +71,9 @@ func NewSCQueryService(
 	if args.AllowExternalQueriesChan == nil {
 		return nil, process.ErrNilAllowExternalQueriesChan
 	}
+	if check.IfNil(args.HistoricalStateSelector) {
+		return nil, process.ErrNilHistoricalStateSelector
+	}
 
 	return &SCQueryService{
 		vmContainer:              args.VmContainer,
@@ -79,6 +84,7 @@ func NewSCQueryService(
 		bootstrapper:             args.Bootstrapper,
 		gasForQuery:              math.MaxUint64,
 		allowExternalQueriesChan: args.AllowExternalQueriesChan,
+		historicalStateSelector:  args.HistoricalStateSelector,
 	}, nil
 }
 
@@ -119,14 +125,26 @@ func (service *SCQueryService) executeScCall(query *process.SCQuery, gasPrice ui
 		return nil, process.ErrNodeIsNotSynced
 	}
 
-	shouldCheckRootHashChanges := query.SameScState
+	// the state of a past block does not change while the query is executed
+	isHistoricalQuery := query.AccountQueryOptions.IsBlockSelected()
+	shouldCheckRootHashChanges := query.SameScState && !isHistoricalQuery
 	rootHashBeforeExecution := make([]byte, 0)
 
 	if shouldCheckRootHashChanges {
 		rootHashBeforeExecution = service.blockChain.GetCurrentBlockRootHash()
 	}
 
-	service.blockChainHook.SetCurrentHeader(service.blockChain.GetCurrentBlockHeader())
+	header := service.blockChain.GetCurrentBlockHeader()
+	if isHistoricalQuery {
+		historicalHeader, err := service.historicalStateSelector.SelectBlock(query.AccountQueryOptions)
+		if err != nil {
+			return nil, err
+		}
+		defer service.historicalStateSelector.SelectCurrentState()
+
+		header = historicalHeader
+	}
+	service.blockChainHook.SetCurrentHeader(header)
 
 	service.arwenChangeLocker.RLock()
 	vm, err := findVMByScAddress(service.vmContainer, query.ScAddress)
@@ -152,7 +170,7 @@ func (service *SCQueryService) executeScCall(query *process.SCQuery, gasPrice ui
 		}
 	}
 
-	if query.SameScState {
+	if shouldCheckRootHashChanges {
 		err = service.checkForRootHashChanges(rootHashBeforeExecution)
 		if err != nil {
 			return nil, err
@@ -16,6 +16,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/state"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 	"github.com/stretchr/testify/assert"
@@ -33,6 +34,7 @@ func createMockArgumentsForSCQuery() ArgsNewSCQueryService {
 		ArwenChangeLocker:        &sync.RWMutex{},
 		Bootstrapper:             &mock.BootstrapperStub{},
 		AllowExternalQueriesChan: common.GetClosedUnbufferedChannel(),
+		HistoricalStateSelector:  &mock.HistoricalStateSelectorStub{},
 	}
 }
 
@@ -102,6 +104,17 @@ func TestNewSCQueryService_NilBootstrapperShouldErr(t *testing.T) {
 	assert.Equal(t, process.ErrNilBootstrapper, err)
 }
 
+func TestNewSCQueryService_NilHistoricalStateSelectorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForSCQuery()
+	args.HistoricalStateSelector = nil
+	target, err := NewSCQueryService(args)
+
+	assert.Nil(t, target)
+	assert.Equal(t, process.ErrNilHistoricalStateSelector, err)
+}
+
 func TestNewSCQueryService_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -578,6 +591,86 @@ func TestSCQueryService_ShouldWorkIfStateDidntChange(t *testing.T) {
 	require.NotNil(t, res)
 }
 
+func TestSCQueryService_ShouldExecuteOnHistoricalState(t *testing.T) {
+	t.Parallel()
+
+	currentHeader := &block.Header{Nonce: 10, RootHash: []byte("current root hash")}
+	historicalHeader := &block.Header{Nonce: 5, RootHash: []byte("historical root hash")}
+	args := createMockArgumentsForSCQuery()
+	rootHashCalled := false
+	args.BlockChain = &testscommon.ChainHandlerStub{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return currentHeader
+		},
+		GetCurrentBlockRootHashCalled: func() []byte {
+			if !rootHashCalled {
+				rootHashCalled = true
+				return []byte("first root hash")
+			}
+
+			return []byte("second root hash")
+		},
+	}
+	var headerOfExecution data.HeaderHandler
+	args.BlockChainHook = &mock.BlockChainHookHandlerMock{
+		SetCurrentHeaderCalled: func(hdr data.HeaderHandler) {
+			headerOfExecution = hdr
+		},
+	}
+	options := common.AccountQueryOptions{BlockNonce: 5, HasBlockNonce: true}
+	currentStateSelected := false
+	args.HistoricalStateSelector = &mock.HistoricalStateSelectorStub{
+		SelectBlockCalled: func(selectedOptions common.AccountQueryOptions) (data.HeaderHandler, error) {
+			assert.Equal(t, options, selectedOptions)
+			return historicalHeader, nil
+		},
+		SelectCurrentStateCalled: func() {
+			currentStateSelected = true
+		},
+	}
+
+	qs, _ := NewSCQueryService(args)
+
+	res, err := qs.ExecuteQuery(&process.SCQuery{
+		SameScState:         true,
+		ScAddress:           []byte(DummyScAddress),
+		FuncName:            "function",
+		AccountQueryOptions: options,
+	})
+	require.Nil(t, err)
+	require.NotNil(t, res)
+	assert.Equal(t, historicalHeader, headerOfExecution)
+	assert.True(t, currentStateSelected)
+	assert.False(t, rootHashCalled)
+}
+
+func TestSCQueryService_ShouldFailIfHistoricalStateIsNotAvailable(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForSCQuery()
+	args.HistoricalStateSelector = &mock.HistoricalStateSelectorStub{
+		SelectBlockCalled: func(_ common.AccountQueryOptions) (data.HeaderHandler, error) {
+			return nil, state.ErrStateNotAvailable
+		},
+	}
+	args.VmContainer = &mock.VMContainerMock{
+		GetCalled: func(key []byte) (vmcommon.VMExecutionHandler, error) {
+			require.Fail(t, "should have not executed the query")
+			return nil, nil
+		},
+	}
+
+	qs, _ := NewSCQueryService(args)
+
+	res, err := qs.ExecuteQuery(&process.SCQuery{
+		ScAddress:           []byte(DummyScAddress),
+		FuncName:            "function",
+		AccountQueryOptions: common.AccountQueryOptions{BlockHash: []byte("hash")},
+	})
+	require.Nil(t, res)
+	require.Equal(t, state.ErrStateNotAvailable, err)
+}
+
 func TestSCQueryService_ComputeTxCostScCall(t *testing.T) {
 	t.Parallel()
 
@@ -666,6 +759,7 @@ func TestNewSCQueryService_CloseShouldWork(t *testing.T) {
 		ArwenChangeLocker:        &sync.RWMutex{},
 		Bootstrapper:             &mock.BootstrapperStub{},
 		AllowExternalQueriesChan: common.GetClosedUnbufferedChannel(),
+		HistoricalStateSelector:  &mock.HistoricalStateSelectorStub{},
 	}
 
 	target, _ := NewSCQueryService(argsNewSCQueryService)
@@ -0,0 +1,424 @@
+package witnessVerifier
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go-core/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go-core/data/typeConverters"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/state"
+)
+
+var log = logger.GetOrCreate("process/witnessVerifier")
+
+// maxProcessingTime is the time given to the block processor to re-execute the verified block
+const maxProcessingTime = time.Minute
+
+// ArgsBlockWitnessVerifier holds the arguments needed to create a block witness verifier
+type ArgsBlockWitnessVerifier struct {
+	BlockProcessor   process.BlockProcessor
+	BlockChain       data.ChainHandler
+	BlockTracker     process.BlockTracker
+	DataPool         dataRetriever.PoolsHolder
+	Store            dataRetriever.StorageService
+	BootStorer       process.BootStorer
+	WitnessDB        state.BlockWitnessDB
+	Marshalizer      marshal.Marshalizer
+	Uint64Converter  typeConverters.Uint64ByteSliceConverter
+	ShardCoordinator sharding.Coordinator
+}
+
+type blockWitnessVerifier struct {
+	blockProcessor   process.BlockProcessor
+	blockChain       data.ChainHandler
+	blockTracker     process.BlockTracker
+	dataPool         dataRetriever.PoolsHolder
+	store            dataRetriever.StorageService
+	bootStorer       process.BootStorer
+	witnessDB        state.BlockWitnessDB
+	marshalizer      marshal.Marshalizer
+	uint64Converter  typeConverters.Uint64ByteSliceConverter
+	shardCoordinator sharding.Coordinator
+}
+
+// NewBlockWitnessVerifier creates a component that re-executes committed blocks reading the state trie nodes only
+// from their saved witnesses. The provided block processor must use accounts adapters built over the provided
+// witness database and must not be used by the node for processing or syncing blocks
+func NewBlockWitnessVerifier(args ArgsBlockWitnessVerifier) (*blockWitnessVerifier, error) {
+	if check.IfNil(args.BlockProcessor) {
+		return nil, process.ErrNilBlockProcessor
+	}
+	if check.IfNil(args.BlockChain) {
+		return nil, process.ErrNilBlockChain
+	}
+	if check.IfNil(args.BlockTracker) {
+		return nil, process.ErrNilBlockTracker
+	}
+	if check.IfNil(args.DataPool) {
+		return nil, process.ErrNilPoolsHolder
+	}
+	if check.IfNil(args.Store) {
+		return nil, process.ErrNilStore
+	}
+	if check.IfNil(args.BootStorer) {
+		return nil, process.ErrNilBootStorer
+	}
+	if check.IfNil(args.WitnessDB) {
+		return nil, process.ErrNilBlockWitnessDB
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(args.Uint64Converter) {
+		return nil, process.ErrNilUint64Converter
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, process.ErrNilShardCoordinator
+	}
+
+	return &blockWitnessVerifier{
+		blockProcessor:   args.BlockProcessor,
+		blockChain:       args.BlockChain,
+		blockTracker:     args.BlockTracker,
+		dataPool:         args.DataPool,
+		store:            args.Store,
+		bootStorer:       args.BootStorer,
+		witnessDB:        args.WitnessDB,
+		marshalizer:      args.Marshalizer,
+		uint64Converter:  args.Uint64Converter,
+		shardCoordinator: args.ShardCoordinator,
+	}, nil
+}
+
+// Verify re-executes the committed block with the provided header hash, starting from the root hashes saved in its
+// witness and reading the state trie nodes only from the witness. The block, its transactions and the headers it
+// references are read from the storage
+func (bwv *blockWitnessVerifier) Verify(headerHash []byte) error {
+	header, err := bwv.getHeaderFromStorage(bwv.shardCoordinator.SelfId(), headerHash)
+	if err != nil {
+		return fmt.Errorf("%w while loading the header %x", err, headerHash)
+	}
+	prevHeader, err := bwv.getHeaderFromStorage(bwv.shardCoordinator.SelfId(), header.GetPrevHash())
+	if err != nil {
+		return fmt.Errorf("%w while loading the previous header %x", err, header.GetPrevHash())
+	}
+	body, err := bwv.getBodyFromStorage(header)
+	if err != nil {
+		return err
+	}
+	witness, err := bwv.getWitnessFromStorage(headerHash, header.GetEpoch())
+	if err != nil {
+		return err
+	}
+	err = checkWitnessRootHashes(witness, prevHeader)
+	if err != nil {
+		return err
+	}
+
+	err = bwv.fillPools(header, body)
+	if err != nil {
+		return err
+	}
+	bwv.setCrossNotarizedHeaders(prevHeader)
+
+	log.Debug("verifying block witness",
+		"hash", headerHash,
+		"nonce", header.GetNonce(),
+		"num trie nodes", len(witness.TrieNodes),
+	)
+
+	bwv.witnessDB.LoadWitness(witness.TrieNodes)
+
+	return bwv.processFromWitness(header, body, prevHeader, header.GetPrevHash(), witness.RootHash)
+}
+
+func (bwv *blockWitnessVerifier) processFromWitness(
+	header data.HeaderHandler,
+	body *block.Body,
+	prevHeader data.HeaderHandler,
+	prevHeaderHash []byte,
+	rootHash []byte,
+) error {
+	currentHeader := bwv.blockChain.GetCurrentBlockHeader()
+	currentHeaderHash := bwv.blockChain.GetCurrentBlockHeaderHash()
+	currentRootHash := bwv.blockChain.GetCurrentBlockRootHash()
+	defer func() {
+		bwv.blockProcessor.RevertCurrentBlock()
+		bwv.blockChain.SetCurrentBlockHeaderHash(currentHeaderHash)
+		errRestore := bwv.blockChain.SetCurrentBlockHeaderAndRootHash(currentHeader, currentRootHash)
+		if errRestore != nil {
+			log.Warn("blockWitnessVerifier.processFromWitness - restore current block", "error", errRestore.Error())
+		}
+	}()
+
+	err := bwv.blockChain.SetCurrentBlockHeaderAndRootHash(prevHeader, rootHash)
+	if err != nil {
+		return err
+	}
+	bwv.blockChain.SetCurrentBlockHeaderHash(prevHeaderHash)
+
+	err = bwv.blockProcessor.RevertStateToBlock(prevHeader, rootHash)
+	if err != nil {
+		return fmt.Errorf("%w while recreating the state from the block witness", err)
+	}
+
+	err = bwv.blockProcessor.ProcessBlock(header, body, haveTime())
+	if err != nil {
+		return fmt.Errorf("%w while processing the block from its witness", err)
+	}
+
+	return nil
+}
+
+func haveTime() func() time.Duration {
+	deadline := time.Now().Add(maxProcessingTime)
+
+	return func() time.Duration {
+		return time.Until(deadline)
+	}
+}
+
+func (bwv *blockWitnessVerifier) getHeaderFromStorage(shardID uint32, headerHash []byte) (data.HeaderHandler, error) {
+	if shardID == core.MetachainShardId {
+		return process.GetMetaHeaderFromStorage(headerHash, bwv.marshalizer, bwv.store)
+	}
+
+	return process.GetShardHeaderFromStorage(headerHash, bwv.marshalizer, bwv.store)
+}
+
+func (bwv *blockWitnessVerifier) getBodyFromStorage(header data.HeaderHandler) (*block.Body, error) {
+	storer := bwv.store.GetStorer(dataRetriever.MiniBlockUnit)
+
+	body := &block.Body{}
+	for _, miniBlockHeader := range header.GetMiniBlockHeaderHandlers() {
+		buff, err := storer.GetFromEpoch(miniBlockHeader.GetHash(), header.GetEpoch())
+		if err != nil {
+			return nil, fmt.Errorf("%w while loading the mini block %x", err, miniBlockHeader.GetHash())
+		}
+
+		miniBlock := &block.MiniBlock{}
+		err = bwv.marshalizer.Unmarshal(miniBlock, buff)
+		if err != nil {
+			return nil, err
+		}
+
+		body.MiniBlocks = append(body.MiniBlocks, miniBlock)
+	}
+
+	return body, nil
+}
+
+func (bwv *blockWitnessVerifier) getWitnessFromStorage(headerHash []byte, epoch uint32) (*state.BlockWitness, error) {
+	buff, err := bwv.store.GetStorer(dataRetriever.BlockWitnessUnit).GetFromEpoch(headerHash, epoch)
+	if err != nil {
+		return nil, fmt.Errorf("%w while loading the block witness %x", err, headerHash)
+	}
+
+	witness := &state.BlockWitness{}
+	err = bwv.marshalizer.Unmarshal(witness, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return witness, nil
+}
+
+func checkWitnessRootHashes(witness *state.BlockWitness, prevHeader data.HeaderHandler) error {
+	if !bytes.Equal(witness.RootHash, prevHeader.GetRootHash()) {
+		return fmt.Errorf("%w, witness root hash %x, previous block root hash %x",
+			process.ErrBlockWitnessRootHashMismatch, witness.RootHash, prevHeader.GetRootHash())
+	}
+
+	metaHeader, isMetaHeader := prevHeader.(data.MetaHeaderHandler)
+	if isMetaHeader && !bytes.Equal(witness.ValidatorStatsRootHash, metaHeader.GetValidatorStatsRootHash()) {
+		return fmt.Errorf("%w, witness validator statistics root hash %x, previous block validator statistics root hash %x",
+			process.ErrBlockWitnessRootHashMismatch, witness.ValidatorStatsRootHash, metaHeader.GetValidatorStatsRootHash())
+	}
+
+	return nil
+}
+
+// fillPools adds in the data pools the transactions and the headers the block processor looks for, as the storage is
+// never searched while a block is processed
+func (bwv *blockWitnessVerifier) fillPools(header data.HeaderHandler, body *block.Body) error {
+	for _, miniBlock := range body.MiniBlocks {
+		err := bwv.addMiniBlockTxsInPool(miniBlock, header.GetEpoch())
+		if err != nil {
+			return err
+		}
+	}
+
+	return bwv.addReferencedHeadersInPool(header)
+}
+
+func (bwv *blockWitnessVerifier) addMiniBlockTxsInPool(miniBlock *block.MiniBlock, epoch uint32) error {
+	isCrossShard := miniBlock.SenderShardID != bwv.shardCoordinator.SelfId()
+
+	switch miniBlock.Type {
+	case block.TxBlock, block.InvalidBlock:
+		return bwv.addTxsInPool(miniBlock, epoch, dataRetriever.TransactionUnit, bwv.dataPool.Transactions(), func() data.TransactionHandler {
+			return &transaction.Transaction{}
+		})
+	case block.SmartContractResultBlock:
+		if !isCrossShard {
+			return nil
+		}
+		return bwv.addTxsInPool(miniBlock, epoch, dataRetriever.UnsignedTransactionUnit, bwv.dataPool.UnsignedTransactions(), func() data.TransactionHandler {
+			return &smartContractResult.SmartContractResult{}
+		})
+	case block.RewardsBlock:
+		if !isCrossShard {
+			return nil
+		}
+		return bwv.addTxsInPool(miniBlock, epoch, dataRetriever.RewardTransactionUnit, bwv.dataPool.RewardTransactions(), func() data.TransactionHandler {
+			return &rewardTx.RewardTx{}
+		})
+	default:
+		return nil
+	}
+}
+
+func (bwv *blockWitnessVerifier) addTxsInPool(
+	miniBlock *block.MiniBlock,
+	epoch uint32,
+	unit dataRetriever.UnitType,
+	pool dataRetriever.ShardedDataCacherNotifier,
+	newTx func() data.TransactionHandler,
+) error {
+	storer := bwv.store.GetStorer(unit)
+	cacheID := process.ShardCacherIdentifier(miniBlock.SenderShardID, miniBlock.ReceiverShardID)
+	for _, txHash := range miniBlock.TxHashes {
+		buff, err := storer.GetFromEpoch(txHash, epoch)
+		if err != nil {
+			return fmt.Errorf("%w while loading the transaction %x", err, txHash)
+		}
+
+		tx := newTx()
+		err = bwv.marshalizer.Unmarshal(tx, buff)
+		if err != nil {
+			return err
+		}
+
+		pool.AddData(txHash, tx, len(buff), cacheID)
+	}
+
+	return nil
+}
+
+func (bwv *blockWitnessVerifier) addReferencedHeadersInPool(header data.HeaderHandler) error {
+	metaHeader, isMetaHeader := header.(data.MetaHeaderHandler)
+	if isMetaHeader {
+		return bwv.addShardHeadersInPool(metaHeader)
+	}
+
+	shardHeader, ok := header.(data.ShardHeaderHandler)
+	if !ok {
+		return process.ErrWrongTypeAssertion
+	}
+
+	return bwv.addMetaHeadersInPool(shardHeader)
+}
+
+func (bwv *blockWitnessVerifier) addMetaHeadersInPool(shardHeader data.ShardHeaderHandler) error {
+	highestNonce := uint64(0)
+	for _, metaHeaderHash := range shardHeader.GetMetaBlockHashes() {
+		metaHeader, err := process.GetMetaHeaderFromStorage(metaHeaderHash, bwv.marshalizer, bwv.store)
+		if err != nil {
+			return fmt.Errorf("%w while loading the meta header %x", err, metaHeaderHash)
+		}
+
+		bwv.dataPool.Headers().AddHeader(metaHeaderHash, metaHeader)
+		if metaHeader.GetNonce() > highestNonce {
+			highestNonce = metaHeader.GetNonce()
+		}
+	}
+
+	if len(shardHeader.GetMetaBlockHashes()) > 0 {
+		bwv.addFinalityAttestingHeadersInPool(core.MetachainShardId, highestNonce)
+	}
+
+	return nil
+}
+
+func (bwv *blockWitnessVerifier) addShardHeadersInPool(metaHeader data.MetaHeaderHandler) error {
+	highestNonces := make(map[uint32]uint64)
+	for _, shardData := range metaHeader.GetShardInfoHandlers() {
+		shardHeader, err := process.GetShardHeaderFromStorage(shardData.GetHeaderHash(), bwv.marshalizer, bwv.store)
+		if err != nil {
+			return fmt.Errorf("%w while loading the shard header %x", err, shardData.GetHeaderHash())
+		}
+
+		bwv.dataPool.Headers().AddHeader(shardData.GetHeaderHash(), shardHeader)
+		if shardHeader.GetNonce() > highestNonces[shardHeader.GetShardID()] {
+			highestNonces[shardHeader.GetShardID()] = shardHeader.GetNonce()
+		}
+	}
+
+	for shardID, highestNonce := range highestNonces {
+		bwv.addFinalityAttestingHeadersInPool(shardID, highestNonce)
+	}
+
+	return nil
+}
+
+// addFinalityAttestingHeadersInPool adds in the headers pool the headers following the highest referenced one, as
+// the block processor checks the finality of the referenced headers. A missing header is left to the block processor
+func (bwv *blockWitnessVerifier) addFinalityAttestingHeadersInPool(shardID uint32, highestNonce uint64) {
+	for nonce := highestNonce + 1; nonce <= highestNonce+process.BlockFinality; nonce++ {
+		header, headerHash, err := process.GetHeaderFromStorageWithNonce(nonce, shardID, bwv.store, bwv.uint64Converter, bwv.marshalizer)
+		if err != nil {
+			log.Debug("blockWitnessVerifier.addFinalityAttestingHeadersInPool",
+				"shard", shardID,
+				"nonce", nonce,
+				"error", err.Error(),
+			)
+			return
+		}
+
+		bwv.dataPool.Headers().AddHeader(headerHash, header)
+	}
+}
+
+// setCrossNotarizedHeaders sets in the block tracker the headers which were cross notarized when the previous block
+// was committed, as the block processor checks the referenced headers against them
+func (bwv *blockWitnessVerifier) setCrossNotarizedHeaders(prevHeader data.HeaderHandler) {
+	bootstrapData, err := bwv.bootStorer.Get(int64(prevHeader.GetRound()))
+	if err != nil {
+		log.Debug("blockWitnessVerifier.setCrossNotarizedHeaders - bootstrap data not found",
+			"round", prevHeader.GetRound(),
+			"error", err.Error(),
+		)
+		return
+	}
+
+	for _, headerInfo := range bootstrapData.LastCrossNotarizedHeaders {
+		header, errGet := bwv.getHeaderFromStorage(headerInfo.ShardId, headerInfo.Hash)
+		if errGet != nil {
+			log.Debug("blockWitnessVerifier.setCrossNotarizedHeaders - header not found",
+				"shard", headerInfo.ShardId,
+				"hash", headerInfo.Hash,
+				"error", errGet.Error(),
+			)
+			continue
+		}
+
+		bwv.blockTracker.AddCrossNotarizedHeader(headerInfo.ShardId, header, headerInfo.Hash)
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (bwv *blockWitnessVerifier) IsInterfaceNil() bool {
+	return bwv == nil
+}
@@ -0,0 +1,285 @@
+package witnessVerifier
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/blockchain"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/block/bootstrapStorage"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/state/blockWitness"
+	dataRetrieverMock "github.com/ElrondNetwork/elrond-go/testscommon/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericMocks"
+	"github.com/ElrondNetwork/elrond-go/testscommon/hashingMocks"
+	statusHandlerMock "github.com/ElrondNetwork/elrond-go/testscommon/statusHandler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	prevHeaderHash = []byte("prev header hash")
+	headerHash     = []byte("header hash")
+	prevRootHash   = []byte("prev root hash")
+	txHash         = []byte("tx hash")
+)
+
+func createMockArgsBlockWitnessVerifier() ArgsBlockWitnessVerifier {
+	shardCoordinator := mock.NewOneShardCoordinatorMock()
+	blockChain, _ := blockchain.NewBlockChain(&statusHandlerMock.AppStatusHandlerStub{})
+	witnessDB, _ := blockWitness.NewWitnessDB(&hashingMocks.HasherMock{})
+
+	store := dataRetriever.NewChainStorer()
+	store.AddStorer(dataRetriever.BlockHeaderUnit, genericMocks.NewStorerMock("BlockHeaders", 0))
+	store.AddStorer(dataRetriever.MiniBlockUnit, genericMocks.NewStorerMock("MiniBlocks", 0))
+	store.AddStorer(dataRetriever.TransactionUnit, genericMocks.NewStorerMock("Transactions", 0))
+	store.AddStorer(dataRetriever.BlockWitnessUnit, genericMocks.NewStorerMock("BlockWitnesses", 0))
+
+	return ArgsBlockWitnessVerifier{
+		BlockProcessor: &mock.BlockProcessorMock{
+			RevertStateToBlockCalled: func(header data.HeaderHandler, rootHash []byte) error {
+				return nil
+			},
+			ProcessBlockCalled: func(header data.HeaderHandler, body data.BodyHandler, haveTime func() time.Duration) error {
+				return nil
+			},
+			RevertCurrentBlockCalled: func() {},
+		},
+		BlockChain:   blockChain,
+		BlockTracker: mock.NewBlockTrackerMock(shardCoordinator, make(map[uint32]data.HeaderHandler)),
+		DataPool:     dataRetrieverMock.NewPoolsHolderMock(),
+		Store:        store,
+		BootStorer: &mock.BoostrapStorerMock{
+			GetCalled: func(round int64) (bootstrapStorage.BootstrapData, error) {
+				return bootstrapStorage.BootstrapData{}, nil
+			},
+		},
+		WitnessDB:        witnessDB,
+		Marshalizer:      &mock.MarshalizerMock{},
+		Uint64Converter:  &mock.Uint64ByteSliceConverterMock{},
+		ShardCoordinator: shardCoordinator,
+	}
+}
+
+func saveBlockInStorage(t *testing.T, args ArgsBlockWitnessVerifier, witness *state.BlockWitness) (*block.Header, *block.Header) {
+	prevHeader := &block.Header{Nonce: 1, Round: 1, RootHash: prevRootHash}
+	miniBlock := &block.MiniBlock{TxHashes: [][]byte{txHash}, Type: block.TxBlock}
+	miniBlockHash := []byte("mini block hash")
+	header := &block.Header{
+		Nonce:    2,
+		Round:    2,
+		PrevHash: prevHeaderHash,
+		MiniBlockHeaders: []block.MiniBlockHeader{
+			{Hash: miniBlockHash, TxCount: 1, Type: block.TxBlock},
+		},
+	}
+
+	putMarshalized(t, args, dataRetriever.BlockHeaderUnit, prevHeaderHash, prevHeader)
+	putMarshalized(t, args, dataRetriever.BlockHeaderUnit, headerHash, header)
+	putMarshalized(t, args, dataRetriever.MiniBlockUnit, miniBlockHash, miniBlock)
+	putMarshalized(t, args, dataRetriever.TransactionUnit, txHash, &transaction.Transaction{Nonce: 7})
+	if witness != nil {
+		putMarshalized(t, args, dataRetriever.BlockWitnessUnit, headerHash, witness)
+	}
+
+	return prevHeader, header
+}
+
+func putMarshalized(t *testing.T, args ArgsBlockWitnessVerifier, unit dataRetriever.UnitType, key []byte, obj interface{}) {
+	buff, err := args.Marshalizer.Marshal(obj)
+	require.Nil(t, err)
+	require.Nil(t, args.Store.Put(unit, key, buff))
+}
+
+func TestNewBlockWitnessVerifier(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil block processor should error", func(t *testing.T) {
+		args := createMockArgsBlockWitnessVerifier()
+		args.BlockProcessor = nil
+		bwv, err := NewBlockWitnessVerifier(args)
+		assert.Nil(t, bwv)
+		assert.Equal(t, process.ErrNilBlockProcessor, err)
+	})
+	t.Run("nil block chain should error", func(t *testing.T) {
+		args := createMockArgsBlockWitnessVerifier()
+		args.BlockChain = nil
+		bwv, err := NewBlockWitnessVerifier(args)
+		assert.Nil(t, bwv)
+		assert.Equal(t, process.ErrNilBlockChain, err)
+	})
+	t.Run("nil block tracker should error", func(t *testing.T) {
+		args := createMockArgsBlockWitnessVerifier()
+		args.BlockTracker = nil
+		bwv, err := NewBlockWitnessVerifier(args)
+		assert.Nil(t, bwv)
+		assert.Equal(t, process.ErrNilBlockTracker, err)
+	})
+	t.Run("nil data pool should error", func(t *testing.T) {
+		args := createMockArgsBlockWitnessVerifier()
+		args.DataPool = nil
+		bwv, err := NewBlockWitnessVerifier(args)
+		assert.Nil(t, bwv)
+		assert.Equal(t, process.ErrNilPoolsHolder, err)
+	})
+	t.Run("nil store should error", func(t *testing.T) {
+		args := createMockArgsBlockWitnessVerifier()
+		args.Store = nil
+		bwv, err := NewBlockWitnessVerifier(args)
+		assert.Nil(t, bwv)
+		assert.Equal(t, process.ErrNilStore, err)
+	})
+	t.Run("nil boot storer should error", func(t *testing.T) {
+		args := createMockArgsBlockWitnessVerifier()
+		args.BootStorer = nil
+		bwv, err := NewBlockWitnessVerifier(args)
+		assert.Nil(t, bwv)
+		assert.Equal(t, process.ErrNilBootStorer, err)
+	})
+	t.Run("nil witness db should error", func(t *testing.T) {
+		args := createMockArgsBlockWitnessVerifier()
+		args.WitnessDB = nil
+		bwv, err := NewBlockWitnessVerifier(args)
+		assert.Nil(t, bwv)
+		assert.Equal(t, process.ErrNilBlockWitnessDB, err)
+	})
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		args := createMockArgsBlockWitnessVerifier()
+		args.Marshalizer = nil
+		bwv, err := NewBlockWitnessVerifier(args)
+		assert.Nil(t, bwv)
+		assert.Equal(t, process.ErrNilMarshalizer, err)
+	})
+	t.Run("nil uint64 converter should error", func(t *testing.T) {
+		args := createMockArgsBlockWitnessVerifier()
+		args.Uint64Converter = nil
+		bwv, err := NewBlockWitnessVerifier(args)
+		assert.Nil(t, bwv)
+		assert.Equal(t, process.ErrNilUint64Converter, err)
+	})
+	t.Run("nil shard coordinator should error", func(t *testing.T) {
+		args := createMockArgsBlockWitnessVerifier()
+		args.ShardCoordinator = nil
+		bwv, err := NewBlockWitnessVerifier(args)
+		assert.Nil(t, bwv)
+		assert.Equal(t, process.ErrNilShardCoordinator, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		bwv, err := NewBlockWitnessVerifier(createMockArgsBlockWitnessVerifier())
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(bwv))
+	})
+}
+
+func TestBlockWitnessVerifier_Verify(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing header should error", func(t *testing.T) {
+		args := createMockArgsBlockWitnessVerifier()
+		bwv, _ := NewBlockWitnessVerifier(args)
+
+		err := bwv.Verify(headerHash)
+		assert.NotNil(t, err)
+	})
+	t.Run("missing witness should error", func(t *testing.T) {
+		args := createMockArgsBlockWitnessVerifier()
+		_, _ = saveBlockInStorage(t, args, nil)
+		bwv, _ := NewBlockWitnessVerifier(args)
+
+		err := bwv.Verify(headerHash)
+		assert.NotNil(t, err)
+	})
+	t.Run("witness of another state should error", func(t *testing.T) {
+		args := createMockArgsBlockWitnessVerifier()
+		processBlockCalled := false
+		args.BlockProcessor = &mock.BlockProcessorMock{
+			ProcessBlockCalled: func(header data.HeaderHandler, body data.BodyHandler, haveTime func() time.Duration) error {
+				processBlockCalled = true
+				return nil
+			},
+		}
+		_, _ = saveBlockInStorage(t, args, &state.BlockWitness{RootHash: []byte("another root hash")})
+		bwv, _ := NewBlockWitnessVerifier(args)
+
+		err := bwv.Verify(headerHash)
+		assert.True(t, errors.Is(err, process.ErrBlockWitnessRootHashMismatch))
+		assert.False(t, processBlockCalled)
+	})
+	t.Run("processing error should error and revert", func(t *testing.T) {
+		args := createMockArgsBlockWitnessVerifier()
+		expectedErr := errors.New("expected error")
+		revertCalled := false
+		args.BlockProcessor = &mock.BlockProcessorMock{
+			RevertStateToBlockCalled: func(header data.HeaderHandler, rootHash []byte) error {
+				return nil
+			},
+			ProcessBlockCalled: func(header data.HeaderHandler, body data.BodyHandler, haveTime func() time.Duration) error {
+				return expectedErr
+			},
+			RevertCurrentBlockCalled: func() {
+				revertCalled = true
+			},
+		}
+		_, _ = saveBlockInStorage(t, args, &state.BlockWitness{RootHash: prevRootHash})
+		bwv, _ := NewBlockWitnessVerifier(args)
+
+		err := bwv.Verify(headerHash)
+		assert.True(t, errors.Is(err, expectedErr))
+		assert.True(t, revertCalled)
+	})
+	t.Run("should process the block from the witness root hash", func(t *testing.T) {
+		args := createMockArgsBlockWitnessVerifier()
+		currentHeader := &block.Header{Nonce: 5}
+		currentHeaderHash := []byte("current header hash")
+		_ = args.BlockChain.SetCurrentBlockHeaderAndRootHash(currentHeader, []byte("current root hash"))
+		args.BlockChain.SetCurrentBlockHeaderHash(currentHeaderHash)
+
+		witness := &state.BlockWitness{RootHash: prevRootHash, TrieNodes: [][]byte{[]byte("trie node")}}
+		expectedPrevHeader, expectedHeader := saveBlockInStorage(t, args, witness)
+		revertedRootHash := make([]byte, 0)
+		processedHeader := data.HeaderHandler(nil)
+		revertCalled := false
+		args.BlockProcessor = &mock.BlockProcessorMock{
+			RevertStateToBlockCalled: func(header data.HeaderHandler, rootHash []byte) error {
+				assert.Equal(t, expectedPrevHeader, header)
+				revertedRootHash = rootHash
+				return nil
+			},
+			ProcessBlockCalled: func(header data.HeaderHandler, body data.BodyHandler, haveTime func() time.Duration) error {
+				processedHeader = header
+				assert.Equal(t, prevHeaderHash, args.BlockChain.GetCurrentBlockHeaderHash())
+				assert.Equal(t, 1, len(body.(*block.Body).MiniBlocks))
+				assert.True(t, haveTime() > 0)
+
+				_, found := args.DataPool.Transactions().SearchFirstData(txHash)
+				assert.True(t, found)
+
+				return nil
+			},
+			RevertCurrentBlockCalled: func() {
+				revertCalled = true
+			},
+		}
+		bwv, _ := NewBlockWitnessVerifier(args)
+
+		err := bwv.Verify(headerHash)
+		assert.Nil(t, err)
+		assert.Equal(t, prevRootHash, revertedRootHash)
+		assert.Equal(t, expectedHeader, processedHeader)
+		assert.True(t, revertCalled)
+		assert.Equal(t, currentHeader, args.BlockChain.GetCurrentBlockHeader())
+		assert.Equal(t, currentHeaderHash, args.BlockChain.GetCurrentBlockHeaderHash())
+
+		trieNodeHash := (&hashingMocks.HasherMock{}).Compute("trie node")
+		val, err := args.WitnessDB.Get(trieNodeHash)
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("trie node"), val)
+	})
+}
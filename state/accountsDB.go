@@ -81,10 +81,11 @@ type AccountsDB struct {
 	dataTries    common.TriesHolder
 	entries      []JournalEntry
 	// TODO use mutOp only for critical sections, and refactor to parallelize as much as possible
-	mutOp                sync.RWMutex
-	processingMode       common.NodeProcessingMode
-	numCheckpoints       uint32
-	loadCodeMeasurements *loadingMeasurements
+	mutOp                 sync.RWMutex
+	processingMode        common.NodeProcessingMode
+	numCheckpoints        uint32
+	loadCodeMeasurements  *loadingMeasurements
+	stateChangesCollector StateChangesCollector
 
 	stackDebug []byte
 }
@@ -132,8 +133,9 @@ func NewAccountsDB(
 		loadCodeMeasurements: &loadingMeasurements{
 			identifier: "load code",
 		},
-		processingMode: processingMode,
-		lastSnapshot:   &snapshotInfo{},
+		processingMode:        processingMode,
+		lastSnapshot:          &snapshotInfo{},
+		stateChangesCollector: NewDisabledStateChangesCollector(),
 	}
 
 	val, err := trieStorageManager.GetFromCurrentEpoch([]byte(common.ActiveDBKey))
@@ -850,6 +852,7 @@ func (adb *AccountsDB) Commit() ([]byte, error) {
 
 func (adb *AccountsDB) commit() ([]byte, error) {
 	log.Trace("accountsDB.Commit started")
+	adb.collectStateChangesIfEnabled()
 	adb.entries = make([]JournalEntry, 0)
 
 	oldHashes := make(common.ModifiedHashes)
@@ -936,6 +939,19 @@ func (adb *AccountsDB) commitTrie(tr common.Trie, oldHashes common.ModifiedHashe
 	return tr.Commit()
 }
 
+// SetStateChangesCollector sets the component that will receive the state changes done between two commits
+func (adb *AccountsDB) SetStateChangesCollector(stateChangesCollector StateChangesCollector) error {
+	if check.IfNil(stateChangesCollector) {
+		return ErrNilStateChangesCollector
+	}
+
+	adb.mutOp.Lock()
+	adb.stateChangesCollector = stateChangesCollector
+	adb.mutOp.Unlock()
+
+	return nil
+}
+
 // RootHash returns the main trie's root hash
 func (adb *AccountsDB) RootHash() ([]byte, error) {
 	adb.mutOp.Lock()
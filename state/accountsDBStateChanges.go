@@ -0,0 +1,225 @@
+package state
+
+import (
+	"bytes"
+	"math/big"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/common"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+type accountStateChangeTracker struct {
+	change          *AccountStateChange
+	dataTrieChanges map[string]*DataTrieChange
+}
+
+func (adb *AccountsDB) collectStateChangesIfEnabled() {
+	if !adb.stateChangesCollector.IsEnabled() {
+		return
+	}
+
+	stateChanges, err := adb.collectStateChanges()
+	if err != nil {
+		log.Warn("accountsDB: could not collect the state changes", "error", err.Error())
+		adb.stateChangesCollector.SetStateChanges(nil)
+		return
+	}
+
+	adb.stateChangesCollector.SetStateChanges(stateChanges)
+}
+
+// collectStateChanges builds the changes of the accounts touched since the last commit out of the journal entries.
+// The values before the changes are read from the first journal entry of each account, while the values after the
+// changes are read from the tries, which already hold the state that is about to be committed
+func (adb *AccountsDB) collectStateChanges() (*BlockStateChanges, error) {
+	trackers := make(map[string]*accountStateChangeTracker)
+	orderedAddresses := make([][]byte, 0)
+	getTracker := func(address []byte) (*accountStateChangeTracker, bool) {
+		tracker, found := trackers[string(address)]
+		if found {
+			return tracker, false
+		}
+
+		tracker = &accountStateChangeTracker{
+			change:          &AccountStateChange{Address: address},
+			dataTrieChanges: make(map[string]*DataTrieChange),
+		}
+		trackers[string(address)] = tracker
+		orderedAddresses = append(orderedAddresses, address)
+
+		return tracker, true
+	}
+
+	for _, entry := range adb.entries {
+		switch journalEntry := entry.(type) {
+		case *journalEntryAccountCreation:
+			tracker, isFirstEntry := getTracker(journalEntry.address)
+			tracker.change.IsCreated = isFirstEntry
+		case *journalEntryAccount:
+			tracker, isFirstEntry := getTracker(journalEntry.account.AddressBytes())
+			if isFirstEntry {
+				setOldAccountFields(tracker.change, journalEntry.account)
+			}
+		case *journalEntryDataTrieUpdates:
+			address := journalEntry.account.AddressBytes()
+			tracker, _ := getTracker(address)
+			for key, oldValue := range journalEntry.trieUpdates {
+				_, found := tracker.dataTrieChanges[key]
+				if found {
+					continue
+				}
+
+				tracker.dataTrieChanges[key] = &DataTrieChange{
+					Key:      []byte(key),
+					OldValue: TrimDataTrieValue(oldValue, []byte(key), address),
+				}
+			}
+		}
+	}
+
+	stateChanges := &BlockStateChanges{
+		Accounts: make([]*AccountStateChange, 0, len(orderedAddresses)),
+	}
+	for _, address := range orderedAddresses {
+		tracker := trackers[string(address)]
+		err := adb.setNewAccountState(tracker)
+		if err != nil {
+			return nil, err
+		}
+
+		if !tracker.change.hasChanges() {
+			continue
+		}
+
+		stateChanges.Accounts = append(stateChanges.Accounts, tracker.change)
+	}
+
+	return stateChanges, nil
+}
+
+func (adb *AccountsDB) setNewAccountState(tracker *accountStateChangeTracker) error {
+	change := tracker.change
+	account, err := adb.getAccount(change.Address)
+	if err != nil {
+		return err
+	}
+	if check.IfNil(account) {
+		// an account created and removed between the same commits leaves no trace in the state
+		change.IsRemoved = !change.IsCreated
+		change.IsCreated = false
+		return nil
+	}
+
+	setNewAccountFields(change, account)
+	userAccount, ok := account.(UserAccountHandler)
+	if !ok || len(tracker.dataTrieChanges) == 0 {
+		return nil
+	}
+
+	dataTrie, err := adb.getDataTrieForStateChanges(userAccount)
+	if err != nil {
+		return err
+	}
+
+	keys := make([]string, 0, len(tracker.dataTrieChanges))
+	for key := range tracker.dataTrieChanges {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		dataTrieChange := tracker.dataTrieChanges[key]
+		if !check.IfNil(dataTrie) {
+			newValue, errGet := dataTrie.Get(dataTrieChange.Key)
+			if errGet != nil {
+				return errGet
+			}
+
+			dataTrieChange.NewValue = TrimDataTrieValue(newValue, dataTrieChange.Key, change.Address)
+		}
+
+		if bytes.Equal(dataTrieChange.OldValue, dataTrieChange.NewValue) {
+			continue
+		}
+
+		change.DataTrieChanges = append(change.DataTrieChanges, dataTrieChange)
+	}
+
+	return nil
+}
+
+func (adb *AccountsDB) getDataTrieForStateChanges(account UserAccountHandler) (common.Trie, error) {
+	dataTrie := adb.dataTries.Get(account.AddressBytes())
+	if !check.IfNil(dataTrie) {
+		return dataTrie, nil
+	}
+	if len(account.GetRootHash()) == 0 {
+		return nil, nil
+	}
+
+	dataTrie, err := adb.mainTrie.Recreate(account.GetRootHash())
+	if err != nil {
+		return nil, NewErrMissingTrie(account.GetRootHash())
+	}
+
+	return dataTrie, nil
+}
+
+func setOldAccountFields(change *AccountStateChange, account vmcommon.AccountHandler) {
+	change.OldNonce = account.GetNonce()
+	userAccount, ok := account.(UserAccountHandler)
+	if !ok {
+		return
+	}
+
+	change.OldBalance = userAccount.GetBalance()
+	change.OldCodeHash = userAccount.GetCodeHash()
+	change.OldUserName = userAccount.GetUserName()
+}
+
+func setNewAccountFields(change *AccountStateChange, account vmcommon.AccountHandler) {
+	change.NewNonce = account.GetNonce()
+	userAccount, ok := account.(UserAccountHandler)
+	if !ok {
+		return
+	}
+
+	change.NewBalance = userAccount.GetBalance()
+	change.NewCodeHash = userAccount.GetCodeHash()
+	change.NewUserName = userAccount.GetUserName()
+}
+
+// TrimDataTrieValue removes the key and the address appended to each value saved in a data trie
+func TrimDataTrieValue(value []byte, key []byte, address []byte) []byte {
+	if len(value) == 0 {
+		return nil
+	}
+
+	trimmedValue, err := trimValue(value, len(key)+len(address))
+	if err != nil {
+		return value
+	}
+
+	return trimmedValue
+}
+
+func (change *AccountStateChange) hasChanges() bool {
+	if change.IsCreated || change.IsRemoved || len(change.DataTrieChanges) > 0 {
+		return true
+	}
+
+	return change.OldNonce != change.NewNonce ||
+		!isSameBalance(change.OldBalance, change.NewBalance) ||
+		!bytes.Equal(change.OldCodeHash, change.NewCodeHash) ||
+		!bytes.Equal(change.OldUserName, change.NewUserName)
+}
+
+func isSameBalance(oldBalance *big.Int, newBalance *big.Int) bool {
+	if oldBalance == nil || newBalance == nil {
+		return oldBalance == newBalance
+	}
+
+	return oldBalance.Cmp(newBalance) == 0
+}
@@ -0,0 +1,129 @@
+package state_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/state"
+	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createAccountsDBWithStateChangesCollector(t *testing.T) (*state.AccountsDB, *[]*state.BlockStateChanges) {
+	_, adb := getDefaultTrieAndAccountsDb()
+	collected := make([]*state.BlockStateChanges, 0)
+	err := adb.SetStateChangesCollector(&stateMock.StateChangesCollectorStub{
+		IsEnabledCalled: func() bool {
+			return true
+		},
+		SetStateChangesCalled: func(stateChanges *state.BlockStateChanges) {
+			collected = append(collected, stateChanges)
+		},
+	})
+	require.Nil(t, err)
+
+	return adb, &collected
+}
+
+func TestAccountsDB_SetStateChangesCollector(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil collector should error", func(t *testing.T) {
+		t.Parallel()
+
+		_, adb := getDefaultTrieAndAccountsDb()
+		err := adb.SetStateChangesCollector(nil)
+		assert.Equal(t, state.ErrNilStateChangesCollector, err)
+	})
+	t.Run("disabled collector should not receive the state changes", func(t *testing.T) {
+		t.Parallel()
+
+		_, adb := getDefaultTrieAndAccountsDb()
+		err := adb.SetStateChangesCollector(&stateMock.StateChangesCollectorStub{
+			IsEnabledCalled: func() bool {
+				return false
+			},
+			SetStateChangesCalled: func(_ *state.BlockStateChanges) {
+				assert.Fail(t, "should have not been called")
+			},
+		})
+		require.Nil(t, err)
+
+		generateAccounts(t, 2, adb)
+		_, err = adb.Commit()
+		assert.Nil(t, err)
+	})
+}
+
+func TestAccountsDB_CommitCollectsStateChanges(t *testing.T) {
+	t.Parallel()
+
+	t.Run("created account", func(t *testing.T) {
+		t.Parallel()
+
+		adb, collected := createAccountsDBWithStateChangesCollector(t)
+		address := generateRandomByteArray(32)
+		account, _ := adb.LoadAccount(address)
+		userAccount := account.(state.UserAccountHandler)
+		_ = userAccount.AddToBalance(big.NewInt(10))
+		userAccount.IncreaseNonce(1)
+		_ = userAccount.DataTrieTracker().SaveKeyValue([]byte("key"), []byte("value"))
+		_ = adb.SaveAccount(userAccount)
+
+		_, err := adb.Commit()
+		require.Nil(t, err)
+		require.Len(t, *collected, 1)
+
+		changes := (*collected)[0].Accounts
+		require.Len(t, changes, 1)
+		assert.Equal(t, address, changes[0].Address)
+		assert.True(t, changes[0].IsCreated)
+		assert.False(t, changes[0].IsRemoved)
+		assert.Equal(t, big.NewInt(10), changes[0].NewBalance)
+		assert.Equal(t, uint64(1), changes[0].NewNonce)
+		require.Len(t, changes[0].DataTrieChanges, 1)
+		assert.Equal(t, []byte("key"), changes[0].DataTrieChanges[0].Key)
+		assert.Nil(t, changes[0].DataTrieChanges[0].OldValue)
+		assert.Equal(t, []byte("value"), changes[0].DataTrieChanges[0].NewValue)
+	})
+	t.Run("modified, unchanged and removed accounts", func(t *testing.T) {
+		t.Parallel()
+
+		adb, collected := createAccountsDBWithStateChangesCollector(t)
+		addresses := generateAccounts(t, 3, adb)
+		account, _ := adb.LoadAccount(addresses[0])
+		userAccount := account.(state.UserAccountHandler)
+		_ = userAccount.AddToBalance(big.NewInt(10))
+		_ = userAccount.DataTrieTracker().SaveKeyValue([]byte("key"), []byte("value"))
+		_ = adb.SaveAccount(userAccount)
+		_, err := adb.Commit()
+		require.Nil(t, err)
+
+		account, _ = adb.LoadAccount(addresses[0])
+		userAccount = account.(state.UserAccountHandler)
+		_ = userAccount.SubFromBalance(big.NewInt(3))
+		_ = userAccount.DataTrieTracker().SaveKeyValue([]byte("key"), []byte("new value"))
+		_ = adb.SaveAccount(userAccount)
+		account, _ = adb.LoadAccount(addresses[1])
+		_ = adb.SaveAccount(account)
+		err = adb.RemoveAccount(addresses[2])
+		require.Nil(t, err)
+
+		_, err = adb.Commit()
+		require.Nil(t, err)
+		require.Len(t, *collected, 2)
+
+		changes := (*collected)[1].Accounts
+		require.Len(t, changes, 2)
+		assert.Equal(t, addresses[0], changes[0].Address)
+		assert.False(t, changes[0].IsCreated)
+		assert.Equal(t, big.NewInt(10), changes[0].OldBalance)
+		assert.Equal(t, big.NewInt(7), changes[0].NewBalance)
+		require.Len(t, changes[0].DataTrieChanges, 1)
+		assert.Equal(t, []byte("value"), changes[0].DataTrieChanges[0].OldValue)
+		assert.Equal(t, []byte("new value"), changes[0].DataTrieChanges[0].NewValue)
+		assert.Equal(t, addresses[2], changes[1].Address)
+		assert.True(t, changes[1].IsRemoved)
+	})
+}
@@ -0,0 +1,187 @@
+package state
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/common"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+// accountsDBWithHistory forwards all the calls to the current accounts adapter, unless the state of a past block was
+// selected by its root hash. The past state is recreated in a dedicated accounts adapter, so the current accounts
+// adapter is never moved away from the state it works on
+type accountsDBWithHistory struct {
+	currentAccountsAdapter    AccountsAdapter
+	historicalAccountsAdapter AccountsAdapter
+	mutActive                 sync.RWMutex
+	activeAccountsAdapter     AccountsAdapter
+}
+
+// NewAccountsDBWithHistory will create a new instance of type accountsDBWithHistory
+func NewAccountsDBWithHistory(
+	currentAccountsAdapter AccountsAdapter,
+	historicalAccountsAdapter AccountsAdapter,
+) (*accountsDBWithHistory, error) {
+	if check.IfNil(currentAccountsAdapter) {
+		return nil, fmt.Errorf("%w for the current state", ErrNilAccountsAdapter)
+	}
+	if check.IfNil(historicalAccountsAdapter) {
+		return nil, fmt.Errorf("%w for the historical state", ErrNilAccountsAdapter)
+	}
+
+	return &accountsDBWithHistory{
+		currentAccountsAdapter:    currentAccountsAdapter,
+		historicalAccountsAdapter: historicalAccountsAdapter,
+		activeAccountsAdapter:     currentAccountsAdapter,
+	}, nil
+}
+
+// SelectRootHash recreates the state found under the provided root hash and forwards the next calls to it
+func (accountsDB *accountsDBWithHistory) SelectRootHash(rootHash []byte) error {
+	accountsDB.mutActive.Lock()
+	defer accountsDB.mutActive.Unlock()
+
+	err := accountsDB.historicalAccountsAdapter.RecreateTrie(rootHash)
+	if err != nil {
+		accountsDB.activeAccountsAdapter = accountsDB.currentAccountsAdapter
+		return fmt.Errorf("%w for root hash %x: %s", ErrStateNotAvailable, rootHash, err.Error())
+	}
+
+	accountsDB.activeAccountsAdapter = accountsDB.historicalAccountsAdapter
+
+	return nil
+}
+
+// SelectCurrentState forwards the next calls to the current accounts adapter
+func (accountsDB *accountsDBWithHistory) SelectCurrentState() {
+	accountsDB.mutActive.Lock()
+	accountsDB.activeAccountsAdapter = accountsDB.currentAccountsAdapter
+	accountsDB.mutActive.Unlock()
+}
+
+func (accountsDB *accountsDBWithHistory) getActiveAccountsAdapter() AccountsAdapter {
+	accountsDB.mutActive.RLock()
+	defer accountsDB.mutActive.RUnlock()
+
+	return accountsDB.activeAccountsAdapter
+}
+
+// GetExistingAccount will call the active accountsAdapter method
+func (accountsDB *accountsDBWithHistory) GetExistingAccount(address []byte) (vmcommon.AccountHandler, error) {
+	return accountsDB.getActiveAccountsAdapter().GetExistingAccount(address)
+}
+
+// GetAccountFromBytes will call the active accountsAdapter method
+func (accountsDB *accountsDBWithHistory) GetAccountFromBytes(address []byte, accountBytes []byte) (vmcommon.AccountHandler, error) {
+	return accountsDB.getActiveAccountsAdapter().GetAccountFromBytes(address, accountBytes)
+}
+
+// LoadAccount will call the active accountsAdapter method
+func (accountsDB *accountsDBWithHistory) LoadAccount(address []byte) (vmcommon.AccountHandler, error) {
+	return accountsDB.getActiveAccountsAdapter().LoadAccount(address)
+}
+
+// SaveAccount will call the active accountsAdapter method
+func (accountsDB *accountsDBWithHistory) SaveAccount(account vmcommon.AccountHandler) error {
+	return accountsDB.getActiveAccountsAdapter().SaveAccount(account)
+}
+
+// RemoveAccount will call the active accountsAdapter method
+func (accountsDB *accountsDBWithHistory) RemoveAccount(address []byte) error {
+	return accountsDB.getActiveAccountsAdapter().RemoveAccount(address)
+}
+
+// CommitInEpoch will call the active accountsAdapter method
+func (accountsDB *accountsDBWithHistory) CommitInEpoch(currentEpoch uint32, epochToCommit uint32) ([]byte, error) {
+	return accountsDB.getActiveAccountsAdapter().CommitInEpoch(currentEpoch, epochToCommit)
+}
+
+// Commit will call the active accountsAdapter method
+func (accountsDB *accountsDBWithHistory) Commit() ([]byte, error) {
+	return accountsDB.getActiveAccountsAdapter().Commit()
+}
+
+// JournalLen will call the active accountsAdapter method
+func (accountsDB *accountsDBWithHistory) JournalLen() int {
+	return accountsDB.getActiveAccountsAdapter().JournalLen()
+}
+
+// RevertToSnapshot will call the active accountsAdapter method
+func (accountsDB *accountsDBWithHistory) RevertToSnapshot(snapshot int) error {
+	return accountsDB.getActiveAccountsAdapter().RevertToSnapshot(snapshot)
+}
+
+// GetNumCheckpoints will call the current accountsAdapter method
+func (accountsDB *accountsDBWithHistory) GetNumCheckpoints() uint32 {
+	return accountsDB.currentAccountsAdapter.GetNumCheckpoints()
+}
+
+// GetCode will call the active accountsAdapter method
+func (accountsDB *accountsDBWithHistory) GetCode(codeHash []byte) []byte {
+	return accountsDB.getActiveAccountsAdapter().GetCode(codeHash)
+}
+
+// RootHash will call the active accountsAdapter method
+func (accountsDB *accountsDBWithHistory) RootHash() ([]byte, error) {
+	return accountsDB.getActiveAccountsAdapter().RootHash()
+}
+
+// RecreateTrie is a not permitted operation in this implementation and thus, will return an error. The past states
+// are selected by calling SelectRootHash
+func (accountsDB *accountsDBWithHistory) RecreateTrie(_ []byte) error {
+	return ErrOperationNotPermitted
+}
+
+// PruneTrie is a not permitted operation in this implementation and thus, does nothing
+func (accountsDB *accountsDBWithHistory) PruneTrie(_ []byte, _ TriePruningIdentifier) {
+}
+
+// CancelPrune is a not permitted operation in this implementation and thus, does nothing
+func (accountsDB *accountsDBWithHistory) CancelPrune(_ []byte, _ TriePruningIdentifier) {
+}
+
+// SnapshotState is a not permitted operation in this implementation and thus, does nothing
+func (accountsDB *accountsDBWithHistory) SnapshotState(_ []byte) {
+}
+
+// SetStateCheckpoint is a not permitted operation in this implementation and thus, does nothing
+func (accountsDB *accountsDBWithHistory) SetStateCheckpoint(_ []byte) {
+}
+
+// IsPruningEnabled will call the current accountsAdapter method
+func (accountsDB *accountsDBWithHistory) IsPruningEnabled() bool {
+	return accountsDB.currentAccountsAdapter.IsPruningEnabled()
+}
+
+// GetAllLeaves will call the active accountsAdapter method
+func (accountsDB *accountsDBWithHistory) GetAllLeaves(rootHash []byte) (chan core.KeyValueHolder, error) {
+	return accountsDB.getActiveAccountsAdapter().GetAllLeaves(rootHash)
+}
+
+// RecreateAllTries is a not permitted operation in this implementation and thus, will return an error
+func (accountsDB *accountsDBWithHistory) RecreateAllTries(_ []byte) (map[string]common.Trie, error) {
+	return nil, ErrOperationNotPermitted
+}
+
+// GetTrie will call the active accountsAdapter method
+func (accountsDB *accountsDBWithHistory) GetTrie(rootHash []byte) (common.Trie, error) {
+	return accountsDB.getActiveAccountsAdapter().GetTrie(rootHash)
+}
+
+// GetStackDebugFirstEntry will call the active accountsAdapter method
+func (accountsDB *accountsDBWithHistory) GetStackDebugFirstEntry() []byte {
+	return accountsDB.getActiveAccountsAdapter().GetStackDebugFirstEntry()
+}
+
+// Close does nothing, as the wrapped accounts adapters share their tries with other components, which close them
+func (accountsDB *accountsDBWithHistory) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (accountsDB *accountsDBWithHistory) IsInterfaceNil() bool {
+	return accountsDB == nil
+}
@@ -0,0 +1,105 @@
+package state_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/state"
+	mockState "github.com/ElrondNetwork/elrond-go/testscommon/state"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewAccountsDBWithHistory(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil current accounts adapter should error", func(t *testing.T) {
+		t.Parallel()
+
+		accountsWithHistory, err := state.NewAccountsDBWithHistory(nil, &mockState.AccountsStub{})
+
+		assert.True(t, check.IfNil(accountsWithHistory))
+		assert.True(t, errors.Is(err, state.ErrNilAccountsAdapter))
+	})
+	t.Run("nil historical accounts adapter should error", func(t *testing.T) {
+		t.Parallel()
+
+		accountsWithHistory, err := state.NewAccountsDBWithHistory(&mockState.AccountsStub{}, nil)
+
+		assert.True(t, check.IfNil(accountsWithHistory))
+		assert.True(t, errors.Is(err, state.ErrNilAccountsAdapter))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		accountsWithHistory, err := state.NewAccountsDBWithHistory(&mockState.AccountsStub{}, &mockState.AccountsStub{})
+
+		assert.False(t, check.IfNil(accountsWithHistory))
+		assert.Nil(t, err)
+	})
+}
+
+func TestAccountsDBWithHistory_SelectRootHash(t *testing.T) {
+	t.Parallel()
+
+	currentAccount := mockState.NewAccountWrapMock([]byte("current"))
+	historicalAccount := mockState.NewAccountWrapMock([]byte("historical"))
+	currentAccountsAdapter := &mockState.AccountsStub{
+		GetExistingAccountCalled: func(_ []byte) (vmcommon.AccountHandler, error) {
+			return currentAccount, nil
+		},
+	}
+	recreatedRootHash := []byte("")
+	historicalAccountsAdapter := &mockState.AccountsStub{
+		RecreateTrieCalled: func(rootHash []byte) error {
+			if string(rootHash) == "pruned root hash" {
+				return errors.New("missing trie node")
+			}
+
+			recreatedRootHash = rootHash
+			return nil
+		},
+		GetExistingAccountCalled: func(_ []byte) (vmcommon.AccountHandler, error) {
+			return historicalAccount, nil
+		},
+	}
+
+	accountsWithHistory, _ := state.NewAccountsDBWithHistory(currentAccountsAdapter, historicalAccountsAdapter)
+
+	account, _ := accountsWithHistory.GetExistingAccount([]byte("address"))
+	assert.Equal(t, currentAccount, account)
+
+	err := accountsWithHistory.SelectRootHash([]byte("root hash"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("root hash"), recreatedRootHash)
+	account, _ = accountsWithHistory.GetExistingAccount([]byte("address"))
+	assert.Equal(t, historicalAccount, account)
+
+	accountsWithHistory.SelectCurrentState()
+	account, _ = accountsWithHistory.GetExistingAccount([]byte("address"))
+	assert.Equal(t, currentAccount, account)
+
+	_ = accountsWithHistory.SelectRootHash([]byte("root hash"))
+	err = accountsWithHistory.SelectRootHash([]byte("pruned root hash"))
+	assert.True(t, errors.Is(err, state.ErrStateNotAvailable))
+	account, _ = accountsWithHistory.GetExistingAccount([]byte("address"))
+	assert.Equal(t, currentAccount, account)
+}
+
+func TestAccountsDBWithHistory_NotPermittedOperations(t *testing.T) {
+	t.Parallel()
+
+	historicalAccountsAdapter := &mockState.AccountsStub{
+		RecreateTrieCalled: func(_ []byte) error {
+			assert.Fail(t, "should have not called RecreateTrie")
+			return nil
+		},
+	}
+	accountsWithHistory, _ := state.NewAccountsDBWithHistory(&mockState.AccountsStub{}, historicalAccountsAdapter)
+
+	assert.Equal(t, state.ErrOperationNotPermitted, accountsWithHistory.RecreateTrie(nil))
+	tries, err := accountsWithHistory.RecreateAllTries(nil)
+	assert.Nil(t, tries)
+	assert.Equal(t, state.ErrOperationNotPermitted, err)
+}
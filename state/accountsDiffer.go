@@ -0,0 +1,206 @@
+package state
+
+import (
+	"bytes"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+// AccountsDiffCursor is the position where a paginated accounts diff stopped: the address of the last handled
+// account and, if only a part of its data trie changes were handled, the key of the last handled data trie change
+type AccountsDiffCursor struct {
+	Address     []byte
+	DataTrieKey []byte
+}
+
+// dataTrieChangesLimiter counts the data trie changes gathered by an accounts diff. A zero maximum means no limit
+type dataTrieChangesLimiter struct {
+	maxNumChanges int
+	numChanges    int
+}
+
+func (limiter *dataTrieChangesLimiter) isFull() bool {
+	return limiter.maxNumChanges > 0 && limiter.numChanges >= limiter.maxNumChanges
+}
+
+type accountsDiffer struct {
+	mainTrie    common.Trie
+	marshalizer marshal.Marshalizer
+}
+
+// NewAccountsDiffer creates a component able to compute the changed accounts between two accounts trie root hashes
+func NewAccountsDiffer(mainTrie common.Trie, marshalizer marshal.Marshalizer) (*accountsDiffer, error) {
+	if check.IfNil(mainTrie) {
+		return nil, ErrNilTrie
+	}
+	if check.IfNil(marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+
+	return &accountsDiffer{
+		mainTrie:    mainTrie,
+		marshalizer: marshalizer,
+	}, nil
+}
+
+// GetAccountsDiff calls the handler for each account added, removed or modified between the two accounts trie root
+// hashes, starting after the provided cursor, or with the first change if the cursor is nil. The data tries of the
+// modified accounts are compared as well, so each change holds the altered data trie entries. The walk stops as soon
+// as the handler returns false or once maxNumDataTrieChanges data trie changes were gathered (0 for no limit), case
+// in which the cursor of the last handled change is returned so that the diff can be resumed. A nil cursor is
+// returned if the whole diff was handled
+func (ad *accountsDiffer) GetAccountsDiff(
+	fromRootHash []byte,
+	toRootHash []byte,
+	startAfter *AccountsDiffCursor,
+	maxNumDataTrieChanges int,
+	handler func(change *AccountStateChange) bool,
+) (*AccountsDiffCursor, error) {
+	if handler == nil {
+		return nil, ErrNilAccountsDiffHandler
+	}
+
+	var startKey []byte
+	if startAfter != nil {
+		startKey = startAfter.Address
+	}
+
+	limiter := &dataTrieChangesLimiter{maxNumChanges: maxNumDataTrieChanges}
+	lastCursor := &AccountsDiffCursor{}
+	if startAfter != nil {
+		lastCursor = startAfter
+	}
+	isStopped := false
+	var errProcess error
+	err := ad.mainTrie.GetLeavesDiff(fromRootHash, toRootHash, startKey, func(diff common.TrieLeafDiff) bool {
+		var dataTrieStartAfter []byte
+		if startAfter != nil && bytes.Equal(diff.Key, startAfter.Address) {
+			if len(startAfter.DataTrieKey) == 0 {
+				// handled in full before the cursor was issued
+				return true
+			}
+			dataTrieStartAfter = startAfter.DataTrieKey
+		}
+
+		change, isAccount, isTruncated, errCreate := ad.createAccountStateChange(diff, dataTrieStartAfter, limiter)
+		if errCreate != nil {
+			errProcess = errCreate
+			return false
+		}
+		if !isAccount || !change.hasChanges() {
+			return true
+		}
+		if isTruncated && len(change.DataTrieChanges) == 0 {
+			isStopped = true
+			return false
+		}
+
+		if !handler(change) {
+			isStopped = true
+			return false
+		}
+
+		lastCursor = &AccountsDiffCursor{Address: change.Address}
+		if isTruncated {
+			lastCursor.DataTrieKey = change.DataTrieChanges[len(change.DataTrieChanges)-1].Key
+			isStopped = true
+			return false
+		}
+
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	if errProcess != nil {
+		return nil, errProcess
+	}
+	if isStopped {
+		return lastCursor, nil
+	}
+
+	return nil, nil
+}
+
+// createAccountStateChange builds the account change out of the accounts trie leaf diff. It returns false if the
+// leaf does not hold an account, as the accounts trie also holds the smart contracts code. The data trie changes
+// start after the provided data trie key, if any, and are truncated once the limiter is full
+func (ad *accountsDiffer) createAccountStateChange(
+	diff common.TrieLeafDiff,
+	dataTrieStartAfter []byte,
+	limiter *dataTrieChangesLimiter,
+) (*AccountStateChange, bool, bool, error) {
+	oldAccount, isOldAccount := unmarshalUserAccount(ad.marshalizer, diff.Key, diff.OldValue)
+	newAccount, isNewAccount := unmarshalUserAccount(ad.marshalizer, diff.Key, diff.NewValue)
+	if !isOldAccount && !isNewAccount {
+		return nil, false, false, nil
+	}
+
+	change := &AccountStateChange{
+		Address:   diff.Key,
+		IsCreated: !isOldAccount,
+		IsRemoved: !isNewAccount,
+	}
+
+	oldDataTrieRootHash := make([]byte, 0)
+	if isOldAccount {
+		setOldAccountFields(change, oldAccount)
+		oldDataTrieRootHash = oldAccount.GetRootHash()
+	}
+	newDataTrieRootHash := make([]byte, 0)
+	if isNewAccount {
+		setNewAccountFields(change, newAccount)
+		newDataTrieRootHash = newAccount.GetRootHash()
+	}
+
+	if bytes.Equal(oldDataTrieRootHash, newDataTrieRootHash) {
+		return change, true, false, nil
+	}
+
+	isTruncated := false
+	err := ad.mainTrie.GetLeavesDiff(oldDataTrieRootHash, newDataTrieRootHash, dataTrieStartAfter, func(dataTrieDiff common.TrieLeafDiff) bool {
+		if dataTrieStartAfter != nil && bytes.Equal(dataTrieDiff.Key, dataTrieStartAfter) {
+			return true
+		}
+		if limiter.isFull() {
+			isTruncated = true
+			return false
+		}
+
+		limiter.numChanges++
+		change.DataTrieChanges = append(change.DataTrieChanges, &DataTrieChange{
+			Key:      dataTrieDiff.Key,
+			OldValue: TrimDataTrieValue(dataTrieDiff.OldValue, dataTrieDiff.Key, change.Address),
+			NewValue: TrimDataTrieValue(dataTrieDiff.NewValue, dataTrieDiff.Key, change.Address),
+		})
+
+		return true
+	})
+	if err != nil {
+		return nil, false, false, err
+	}
+
+	return change, true, isTruncated, nil
+}
+
+// unmarshalUserAccount returns false if the accounts trie leaf does not hold the account with the provided address
+func unmarshalUserAccount(marshalizer marshal.Marshalizer, address []byte, value []byte) (*userAccount, bool) {
+	if len(value) == 0 {
+		return nil, false
+	}
+
+	account := NewEmptyUserAccount()
+	err := marshalizer.Unmarshal(account, value)
+	if err != nil || !bytes.Equal(account.Address, address) {
+		return nil, false
+	}
+
+	return account, true
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ad *accountsDiffer) IsInterfaceNil() bool {
+	return ad == nil
+}
@@ -0,0 +1,180 @@
+package state_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	trieMock "github.com/ElrondNetwork/elrond-go/testscommon/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAccountsDiffer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil trie should error", func(t *testing.T) {
+		t.Parallel()
+
+		differ, err := state.NewAccountsDiffer(nil, &testscommon.MarshalizerMock{})
+		assert.Nil(t, differ)
+		assert.Equal(t, state.ErrNilTrie, err)
+	})
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		t.Parallel()
+
+		differ, err := state.NewAccountsDiffer(&trieMock.TrieStub{}, nil)
+		assert.Nil(t, differ)
+		assert.Equal(t, state.ErrNilMarshalizer, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		differ, err := state.NewAccountsDiffer(&trieMock.TrieStub{}, &testscommon.MarshalizerMock{})
+		assert.Nil(t, err)
+		assert.False(t, differ.IsInterfaceNil())
+	})
+}
+
+func TestAccountsDiffer_GetAccountsDiff(t *testing.T) {
+	t.Parallel()
+
+	tr, adb := getDefaultTrieAndAccountsDb()
+	unchangedAddress := generateRandomByteArray(32)
+	modifiedAddress := generateRandomByteArray(32)
+	removedAddress := generateRandomByteArray(32)
+	createdAddress := generateRandomByteArray(32)
+
+	for _, address := range [][]byte{unchangedAddress, modifiedAddress, removedAddress} {
+		account, _ := adb.LoadAccount(address)
+		userAccount := account.(state.UserAccountHandler)
+		_ = userAccount.AddToBalance(big.NewInt(10))
+		_ = userAccount.DataTrieTracker().SaveKeyValue([]byte("key"), []byte("value"))
+		_ = userAccount.DataTrieTracker().SaveKeyValue([]byte("removed key"), []byte("value"))
+		_ = adb.SaveAccount(userAccount)
+	}
+	fromRootHash, err := adb.Commit()
+	require.Nil(t, err)
+
+	account, _ := adb.LoadAccount(modifiedAddress)
+	userAccount := account.(state.UserAccountHandler)
+	_ = userAccount.AddToBalance(big.NewInt(5))
+	userAccount.IncreaseNonce(1)
+	_ = userAccount.DataTrieTracker().SaveKeyValue([]byte("key"), []byte("new value"))
+	_ = userAccount.DataTrieTracker().SaveKeyValue([]byte("removed key"), nil)
+	_ = userAccount.DataTrieTracker().SaveKeyValue([]byte("created key"), []byte("value"))
+	_ = adb.SaveAccount(userAccount)
+	_ = adb.RemoveAccount(removedAddress)
+	account, _ = adb.LoadAccount(createdAddress)
+	_ = adb.SaveAccount(account)
+	toRootHash, err := adb.Commit()
+	require.Nil(t, err)
+
+	differ, _ := state.NewAccountsDiffer(tr, &testscommon.MarshalizerMock{})
+
+	t.Run("nil handler should error", func(t *testing.T) {
+		cursor, errDiff := differ.GetAccountsDiff(fromRootHash, toRootHash, nil, 0, nil)
+		assert.Nil(t, cursor)
+		assert.Equal(t, state.ErrNilAccountsDiffHandler, errDiff)
+	})
+	t.Run("should return the changed accounts", func(t *testing.T) {
+		changes := make(map[string]*state.AccountStateChange)
+		cursor, errDiff := differ.GetAccountsDiff(fromRootHash, toRootHash, nil, 0, func(change *state.AccountStateChange) bool {
+			changes[string(change.Address)] = change
+			return true
+		})
+		require.Nil(t, errDiff)
+		assert.Nil(t, cursor)
+		require.Len(t, changes, 3)
+
+		modified := changes[string(modifiedAddress)]
+		require.NotNil(t, modified)
+		assert.False(t, modified.IsCreated)
+		assert.False(t, modified.IsRemoved)
+		assert.Equal(t, big.NewInt(10), modified.OldBalance)
+		assert.Equal(t, big.NewInt(15), modified.NewBalance)
+		assert.Equal(t, uint64(0), modified.OldNonce)
+		assert.Equal(t, uint64(1), modified.NewNonce)
+
+		dataTrieChanges := make(map[string]*state.DataTrieChange)
+		for _, dataTrieChange := range modified.DataTrieChanges {
+			dataTrieChanges[string(dataTrieChange.Key)] = dataTrieChange
+		}
+		require.Len(t, dataTrieChanges, 3)
+		assert.Equal(t, &state.DataTrieChange{Key: []byte("key"), OldValue: []byte("value"), NewValue: []byte("new value")}, dataTrieChanges["key"])
+		assert.Equal(t, &state.DataTrieChange{Key: []byte("removed key"), OldValue: []byte("value")}, dataTrieChanges["removed key"])
+		assert.Equal(t, &state.DataTrieChange{Key: []byte("created key"), NewValue: []byte("value")}, dataTrieChanges["created key"])
+
+		removed := changes[string(removedAddress)]
+		require.NotNil(t, removed)
+		assert.True(t, removed.IsRemoved)
+		assert.Equal(t, big.NewInt(10), removed.OldBalance)
+		assert.Len(t, removed.DataTrieChanges, 2)
+
+		created := changes[string(createdAddress)]
+		require.NotNil(t, created)
+		assert.True(t, created.IsCreated)
+		assert.Len(t, created.DataTrieChanges, 0)
+	})
+	t.Run("handler returning false should stop the walk", func(t *testing.T) {
+		numCalls := 0
+		cursor, errDiff := differ.GetAccountsDiff(fromRootHash, toRootHash, nil, 0, func(_ *state.AccountStateChange) bool {
+			numCalls++
+			return false
+		})
+		assert.Nil(t, errDiff)
+		assert.Equal(t, 1, numCalls)
+		assert.Equal(t, &state.AccountsDiffCursor{}, cursor)
+	})
+	t.Run("cursor should resume the diff", func(t *testing.T) {
+		var cursor *state.AccountsDiffCursor
+		addresses := make([][]byte, 0)
+		for {
+			var errDiff error
+			numChangesInPage := 0
+			cursor, errDiff = differ.GetAccountsDiff(fromRootHash, toRootHash, cursor, 0, func(change *state.AccountStateChange) bool {
+				if numChangesInPage == 2 {
+					return false
+				}
+
+				numChangesInPage++
+				addresses = append(addresses, change.Address)
+				return true
+			})
+			require.Nil(t, errDiff)
+			if cursor == nil {
+				break
+			}
+			assert.Equal(t, addresses[len(addresses)-1], cursor.Address)
+			assert.Nil(t, cursor.DataTrieKey)
+		}
+
+		require.Len(t, addresses, 3)
+		assert.ElementsMatch(t, [][]byte{modifiedAddress, removedAddress, createdAddress}, addresses)
+	})
+	t.Run("data trie changes limit should split the account changes", func(t *testing.T) {
+		var cursor *state.AccountsDiffCursor
+		numPages := 0
+		dataTrieChanges := make(map[string][]*state.DataTrieChange)
+		for {
+			var errDiff error
+			cursor, errDiff = differ.GetAccountsDiff(fromRootHash, toRootHash, cursor, 1, func(change *state.AccountStateChange) bool {
+				address := string(change.Address)
+				dataTrieChanges[address] = append(dataTrieChanges[address], change.DataTrieChanges...)
+				return true
+			})
+			require.Nil(t, errDiff)
+			numPages++
+			if cursor == nil {
+				break
+			}
+			require.Less(t, numPages, 10)
+		}
+
+		assert.Len(t, dataTrieChanges, 3)
+		assert.Len(t, dataTrieChanges[string(modifiedAddress)], 3)
+		assert.Len(t, dataTrieChanges[string(removedAddress)], 2)
+		assert.Len(t, dataTrieChanges[string(createdAddress)], 0)
+	})
+}
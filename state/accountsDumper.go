@@ -0,0 +1,126 @@
+package state
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+// AccountDump holds the exported fields of an account
+type AccountDump struct {
+	Address  []byte
+	Nonce    uint64
+	Balance  *big.Int
+	CodeHash []byte
+	UserName []byte
+	RootHash []byte
+}
+
+// DumpStatistics holds the number of accounts and data trie entries saved by a state dump
+type DumpStatistics struct {
+	NumAccounts         uint64
+	NumDataTrieEntries  uint64
+	NumCheckedTrieNodes uint64
+}
+
+type accountsDumper struct {
+	mainTrie    common.Trie
+	marshalizer marshal.Marshalizer
+}
+
+// NewAccountsDumper creates a component able to stream all the accounts found under an accounts trie root hash
+func NewAccountsDumper(mainTrie common.Trie, marshalizer marshal.Marshalizer) (*accountsDumper, error) {
+	if check.IfNil(mainTrie) {
+		return nil, ErrNilTrie
+	}
+	if check.IfNil(marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+
+	return &accountsDumper{
+		mainTrie:    mainTrie,
+		marshalizer: marshalizer,
+	}, nil
+}
+
+// Dump walks the accounts trie with the provided root hash and saves each account found using the handler. If the
+// data tries are requested, the entries of each account's data trie are saved right after the account. The trie
+// nodes hashes are verified during the walk, so ErrIncompleteStateDump is returned if any of them is missing or
+// corrupt, case in which the saved accounts are only a part of the state
+func (ad *accountsDumper) Dump(rootHash []byte, withDataTries bool, handler AccountsDumpHandler) (*DumpStatistics, error) {
+	if check.IfNil(handler) {
+		return nil, ErrNilAccountsDumpHandler
+	}
+
+	statistics := &DumpStatistics{}
+	integrityReport := &IntegrityReport{
+		RootHash:     rootHash,
+		MissingNodes: make([][]byte, 0),
+		CorruptNodes: make([][]byte, 0),
+	}
+	reportedNodes := make(map[string]struct{})
+
+	mainTrieReport, err := ad.mainTrie.CheckIntegrity(rootHash, func(key []byte, value []byte) error {
+		account, isAccount := unmarshalUserAccount(ad.marshalizer, key, value)
+		if !isAccount {
+			return nil
+		}
+
+		errSave := handler.SaveAccount(newAccountDump(account))
+		if errSave != nil {
+			return errSave
+		}
+		statistics.NumAccounts++
+
+		if !withDataTries || len(account.RootHash) == 0 {
+			return nil
+		}
+
+		dataTrieReport, errDump := ad.mainTrie.CheckIntegrity(account.RootHash, func(dataKey []byte, dataValue []byte) error {
+			statistics.NumDataTrieEntries++
+			return handler.SaveDataTrieEntry(account.Address, dataKey, TrimDataTrieValue(dataValue, dataKey, account.Address))
+		})
+		if errDump != nil {
+			return errDump
+		}
+		integrityReport.add(dataTrieReport, reportedNodes)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	integrityReport.add(mainTrieReport, reportedNodes)
+	statistics.NumCheckedTrieNodes = integrityReport.NumNodes
+	if !integrityReport.IsIntact() {
+		return statistics, fmt.Errorf("%w, %d missing and %d corrupt trie nodes were found",
+			ErrIncompleteStateDump, len(integrityReport.MissingNodes), len(integrityReport.CorruptNodes))
+	}
+
+	return statistics, nil
+}
+
+func newAccountDump(account *userAccount) *AccountDump {
+	balance := account.GetBalance()
+	if balance == nil {
+		balance = big.NewInt(0)
+	}
+
+	return &AccountDump{
+		Address:  account.Address,
+		Nonce:    account.Nonce,
+		Balance:  balance,
+		CodeHash: account.CodeHash,
+		UserName: account.UserName,
+		RootHash: account.RootHash,
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ad *accountsDumper) IsInterfaceNil() bool {
+	return ad == nil
+}
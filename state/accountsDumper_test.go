@@ -0,0 +1,137 @@
+package state_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
+	trieMock "github.com/ElrondNetwork/elrond-go/testscommon/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAccountsDumper(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil trie should error", func(t *testing.T) {
+		t.Parallel()
+
+		dumper, err := state.NewAccountsDumper(nil, &testscommon.MarshalizerMock{})
+		assert.Nil(t, dumper)
+		assert.Equal(t, state.ErrNilTrie, err)
+	})
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		t.Parallel()
+
+		dumper, err := state.NewAccountsDumper(&trieMock.TrieStub{}, nil)
+		assert.Nil(t, dumper)
+		assert.Equal(t, state.ErrNilMarshalizer, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		dumper, err := state.NewAccountsDumper(&trieMock.TrieStub{}, &testscommon.MarshalizerMock{})
+		assert.Nil(t, err)
+		assert.False(t, dumper.IsInterfaceNil())
+	})
+}
+
+func TestAccountsDumper_Dump(t *testing.T) {
+	t.Parallel()
+
+	tr, adb := getDefaultTrieAndAccountsDb()
+	contractAddress := generateRandomByteArray(32)
+	walletAddress := generateRandomByteArray(32)
+
+	account, _ := adb.LoadAccount(contractAddress)
+	contract := account.(state.UserAccountHandler)
+	contract.SetCode([]byte("code"))
+	contract.IncreaseNonce(3)
+	_ = contract.DataTrieTracker().SaveKeyValue([]byte("key1"), []byte("value1"))
+	_ = contract.DataTrieTracker().SaveKeyValue([]byte("key2"), []byte("value2"))
+	_ = adb.SaveAccount(contract)
+	account, _ = adb.LoadAccount(walletAddress)
+	wallet := account.(state.UserAccountHandler)
+	_ = wallet.AddToBalance(big.NewInt(100))
+	_ = adb.SaveAccount(wallet)
+	rootHash, err := adb.Commit()
+	require.Nil(t, err)
+
+	dumper, _ := state.NewAccountsDumper(tr, &testscommon.MarshalizerMock{})
+
+	t.Run("nil handler should error", func(t *testing.T) {
+		statistics, errDump := dumper.Dump(rootHash, true, nil)
+		assert.Nil(t, statistics)
+		assert.Equal(t, state.ErrNilAccountsDumpHandler, errDump)
+	})
+	t.Run("should save all the accounts without the code leaves", func(t *testing.T) {
+		accounts := make(map[string]*state.AccountDump)
+		numDataTrieEntries := 0
+		statistics, errDump := dumper.Dump(rootHash, false, &stateMock.AccountsDumpHandlerStub{
+			SaveAccountCalled: func(account *state.AccountDump) error {
+				accounts[string(account.Address)] = account
+				return nil
+			},
+			SaveDataTrieEntryCalled: func(_ []byte, _ []byte, _ []byte) error {
+				numDataTrieEntries++
+				return nil
+			},
+		})
+		require.Nil(t, errDump)
+		assert.Equal(t, uint64(2), statistics.NumAccounts)
+		assert.Equal(t, uint64(0), statistics.NumDataTrieEntries)
+		assert.Equal(t, 0, numDataTrieEntries)
+		require.Len(t, accounts, 2)
+
+		assert.Equal(t, uint64(3), accounts[string(contractAddress)].Nonce)
+		assert.Equal(t, big.NewInt(0), accounts[string(contractAddress)].Balance)
+		assert.NotEmpty(t, accounts[string(contractAddress)].CodeHash)
+		assert.NotEmpty(t, accounts[string(contractAddress)].RootHash)
+		assert.Equal(t, big.NewInt(100), accounts[string(walletAddress)].Balance)
+	})
+	t.Run("should save the data trie entries after each account", func(t *testing.T) {
+		var lastSavedAddress []byte
+		entries := make(map[string][]byte)
+		statistics, errDump := dumper.Dump(rootHash, true, &stateMock.AccountsDumpHandlerStub{
+			SaveAccountCalled: func(account *state.AccountDump) error {
+				lastSavedAddress = account.Address
+				return nil
+			},
+			SaveDataTrieEntryCalled: func(address []byte, key []byte, value []byte) error {
+				assert.Equal(t, contractAddress, address)
+				assert.Equal(t, lastSavedAddress, address)
+				entries[string(key)] = value
+				return nil
+			},
+		})
+		require.Nil(t, errDump)
+		assert.Equal(t, uint64(2), statistics.NumDataTrieEntries)
+		assert.Equal(t, map[string][]byte{"key1": []byte("value1"), "key2": []byte("value2")}, entries)
+	})
+	t.Run("handler error should stop the dump", func(t *testing.T) {
+		expectedErr := errors.New("expected error")
+		statistics, errDump := dumper.Dump(rootHash, true, &stateMock.AccountsDumpHandlerStub{
+			SaveAccountCalled: func(_ *state.AccountDump) error {
+				return expectedErr
+			},
+		})
+		assert.Nil(t, statistics)
+		assert.Equal(t, expectedErr, errDump)
+	})
+	t.Run("missing trie node should return an incomplete dump error", func(t *testing.T) {
+		account, _ := adb.GetExistingAccount(contractAddress)
+		dataTrieRootHash := account.(state.UserAccountHandler).GetRootHash()
+		encodedNode, _ := tr.GetStorageManager().Get(dataTrieRootHash)
+		_ = tr.GetStorageManager().Remove(dataTrieRootHash)
+		defer func() {
+			_ = tr.GetStorageManager().Put(dataTrieRootHash, encodedNode)
+		}()
+
+		statistics, errDump := dumper.Dump(rootHash, true, &stateMock.AccountsDumpHandlerStub{})
+		assert.True(t, errors.Is(errDump, state.ErrIncompleteStateDump))
+		assert.Equal(t, uint64(2), statistics.NumAccounts)
+	})
+}
@@ -0,0 +1,113 @@
+package state
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+// IntegrityReport holds the result of an accounts trie integrity check, covering the accounts trie and all the data
+// tries reachable from it. A broken node shared by multiple tries is reported only once
+type IntegrityReport struct {
+	RootHash     []byte
+	NumDataTries uint64
+	NumNodes     uint64
+	NumLeaves    uint64
+	MissingNodes [][]byte
+	CorruptNodes [][]byte
+}
+
+// IsIntact returns true if no missing or corrupt trie node was found
+func (report *IntegrityReport) IsIntact() bool {
+	return len(report.MissingNodes) == 0 && len(report.CorruptNodes) == 0
+}
+
+func (report *IntegrityReport) add(trieReport *common.TrieIntegrityReport, reportedNodes map[string]struct{}) {
+	report.NumNodes += trieReport.NumNodes
+	report.NumLeaves += trieReport.NumLeaves
+	report.MissingNodes = appendUnreportedNodes(report.MissingNodes, trieReport.MissingNodes, reportedNodes)
+	report.CorruptNodes = appendUnreportedNodes(report.CorruptNodes, trieReport.CorruptNodes, reportedNodes)
+}
+
+type accountsIntegrityChecker struct {
+	mainTrie    common.Trie
+	marshalizer marshal.Marshalizer
+}
+
+// NewAccountsIntegrityChecker creates a component able to verify that the accounts trie and all its data tries are
+// completely and correctly stored
+func NewAccountsIntegrityChecker(mainTrie common.Trie, marshalizer marshal.Marshalizer) (*accountsIntegrityChecker, error) {
+	if check.IfNil(mainTrie) {
+		return nil, ErrNilTrie
+	}
+	if check.IfNil(marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+
+	return &accountsIntegrityChecker{
+		mainTrie:    mainTrie,
+		marshalizer: marshalizer,
+	}, nil
+}
+
+// CheckIntegrity walks the accounts trie with the provided root hash and the data trie of each account found,
+// verifying the hash of every node. The data tries of the accounts stored under missing or corrupt nodes can not be
+// reached, so they will only be checked after the accounts trie is repaired
+func (aic *accountsIntegrityChecker) CheckIntegrity(rootHash []byte) (*IntegrityReport, error) {
+	report := &IntegrityReport{
+		RootHash:     rootHash,
+		MissingNodes: make([][]byte, 0),
+		CorruptNodes: make([][]byte, 0),
+	}
+	reportedNodes := make(map[string]struct{})
+
+	mainTrieReport, err := aic.mainTrie.CheckIntegrity(rootHash, func(key []byte, value []byte) error {
+		account, isAccount := unmarshalUserAccount(aic.marshalizer, key, value)
+		if !isAccount || len(account.RootHash) == 0 {
+			return nil
+		}
+
+		dataTrieReport, errCheck := aic.mainTrie.CheckIntegrity(account.RootHash, nil)
+		if errCheck != nil {
+			return errCheck
+		}
+		if len(dataTrieReport.MissingNodes) > 0 || len(dataTrieReport.CorruptNodes) > 0 {
+			log.Warn("broken data trie",
+				"address", key,
+				"data trie root hash", account.RootHash,
+				"missing nodes", len(dataTrieReport.MissingNodes),
+				"corrupt nodes", len(dataTrieReport.CorruptNodes))
+		}
+
+		report.NumDataTries++
+		report.add(dataTrieReport, reportedNodes)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	report.add(mainTrieReport, reportedNodes)
+
+	return report, nil
+}
+
+func appendUnreportedNodes(nodes [][]byte, newNodes [][]byte, reportedNodes map[string]struct{}) [][]byte {
+	for _, hash := range newNodes {
+		_, isReported := reportedNodes[string(hash)]
+		if isReported {
+			continue
+		}
+
+		reportedNodes[string(hash)] = struct{}{}
+		nodes = append(nodes, hash)
+	}
+
+	return nodes
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (aic *accountsIntegrityChecker) IsInterfaceNil() bool {
+	return aic == nil
+}
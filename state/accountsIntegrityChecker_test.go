@@ -0,0 +1,100 @@
+package state_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	trieMock "github.com/ElrondNetwork/elrond-go/testscommon/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAccountsIntegrityChecker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil trie should error", func(t *testing.T) {
+		t.Parallel()
+
+		checker, err := state.NewAccountsIntegrityChecker(nil, &testscommon.MarshalizerMock{})
+		assert.Nil(t, checker)
+		assert.Equal(t, state.ErrNilTrie, err)
+	})
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		t.Parallel()
+
+		checker, err := state.NewAccountsIntegrityChecker(&trieMock.TrieStub{}, nil)
+		assert.Nil(t, checker)
+		assert.Equal(t, state.ErrNilMarshalizer, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		checker, err := state.NewAccountsIntegrityChecker(&trieMock.TrieStub{}, &testscommon.MarshalizerMock{})
+		assert.Nil(t, err)
+		assert.False(t, checker.IsInterfaceNil())
+	})
+}
+
+func TestAccountsIntegrityChecker_CheckIntegrity(t *testing.T) {
+	t.Parallel()
+
+	numAccounts := 10
+	createState := func() (common.Trie, *state.AccountsDB, []byte, [][]byte) {
+		tr, adb := getDefaultTrieAndAccountsDb()
+		addresses := make([][]byte, 0, numAccounts)
+		for i := 0; i < numAccounts; i++ {
+			address := generateRandomByteArray(32)
+			account, _ := adb.LoadAccount(address)
+			userAccount := account.(state.UserAccountHandler)
+			_ = userAccount.AddToBalance(big.NewInt(10))
+			_ = userAccount.DataTrieTracker().SaveKeyValue([]byte("key"), address)
+			_ = adb.SaveAccount(userAccount)
+			addresses = append(addresses, address)
+		}
+		rootHash, err := adb.Commit()
+		require.Nil(t, err)
+
+		return tr, adb, rootHash, addresses
+	}
+
+	t.Run("intact state should report all the tries", func(t *testing.T) {
+		t.Parallel()
+
+		tr, _, rootHash, _ := createState()
+		checker, _ := state.NewAccountsIntegrityChecker(tr, &testscommon.MarshalizerMock{})
+
+		report, err := checker.CheckIntegrity(rootHash)
+		require.Nil(t, err)
+		assert.True(t, report.IsIntact())
+		assert.Equal(t, rootHash, report.RootHash)
+		assert.Equal(t, uint64(numAccounts), report.NumDataTries)
+		assert.Equal(t, uint64(2*numAccounts), report.NumLeaves)
+	})
+	t.Run("broken main trie and data trie nodes should be reported", func(t *testing.T) {
+		t.Parallel()
+
+		tr, adb, rootHash, addresses := createState()
+		account, _ := adb.GetExistingAccount(addresses[0])
+		dataTrieRootHash := account.(state.UserAccountHandler).GetRootHash()
+		_ = tr.GetStorageManager().Remove(dataTrieRootHash)
+		encodedRoot, _ := tr.GetStorageManager().Get(rootHash)
+		_ = tr.GetStorageManager().Put(rootHash, []byte("corrupt node"))
+
+		checker, _ := state.NewAccountsIntegrityChecker(tr, &testscommon.MarshalizerMock{})
+		report, err := checker.CheckIntegrity(rootHash)
+		require.Nil(t, err)
+		assert.False(t, report.IsIntact())
+		assert.Equal(t, [][]byte{rootHash}, report.CorruptNodes)
+		assert.Empty(t, report.MissingNodes)
+
+		_ = tr.GetStorageManager().Put(rootHash, encodedRoot)
+		report, err = checker.CheckIntegrity(rootHash)
+		require.Nil(t, err)
+		assert.Equal(t, [][]byte{dataTrieRootHash}, report.MissingNodes)
+		assert.Empty(t, report.CorruptNodes)
+		assert.Equal(t, uint64(numAccounts), report.NumDataTries)
+	})
+}
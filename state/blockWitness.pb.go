@@ -0,0 +1,510 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: blockWitness.proto
+
+package state
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// BlockWitness holds the trie nodes read while a block was executed, along with the root hashes of the accounts and
+// validators tries the execution started from
+type BlockWitness struct {
+	RootHash               []byte   `protobuf:"bytes,1,opt,name=RootHash,json=rootHash,proto3" json:"rootHash"`
+	ValidatorStatsRootHash []byte   `protobuf:"bytes,2,opt,name=ValidatorStatsRootHash,json=validatorStatsRootHash,proto3" json:"validatorStatsRootHash,omitempty"`
+	TrieNodes              [][]byte `protobuf:"bytes,3,rep,name=TrieNodes,json=trieNodes,proto3" json:"trieNodes"`
+}
+
+func (m *BlockWitness) Reset()      { *m = BlockWitness{} }
+func (*BlockWitness) ProtoMessage() {}
+func (*BlockWitness) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8bbfe9130748094b, []int{0}
+}
+func (m *BlockWitness) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockWitness) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *BlockWitness) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockWitness.Merge(m, src)
+}
+func (m *BlockWitness) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockWitness) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockWitness.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockWitness proto.InternalMessageInfo
+
+func (m *BlockWitness) GetRootHash() []byte {
+	if m != nil {
+		return m.RootHash
+	}
+	return nil
+}
+
+func (m *BlockWitness) GetValidatorStatsRootHash() []byte {
+	if m != nil {
+		return m.ValidatorStatsRootHash
+	}
+	return nil
+}
+
+func (m *BlockWitness) GetTrieNodes() [][]byte {
+	if m != nil {
+		return m.TrieNodes
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*BlockWitness)(nil), "proto.BlockWitness")
+}
+
+func init() { proto.RegisterFile("blockWitness.proto", fileDescriptor_8bbfe9130748094b) }
+
+var fileDescriptor_8bbfe9130748094b = []byte{
+	// 263 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4a, 0xca, 0xc9, 0x4f,
+	0xce, 0x0e, 0xcf, 0x2c, 0xc9, 0x4b, 0x2d, 0x2e, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62,
+	0x05, 0x53, 0x52, 0xba, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0xe9,
+	0xf9, 0xe9, 0xf9, 0xfa, 0x60, 0xe1, 0xa4, 0xd2, 0x34, 0x30, 0x0f, 0xcc, 0x01, 0xb3, 0x20, 0xba,
+	0x94, 0x0e, 0x33, 0x72, 0xf1, 0x38, 0x21, 0x19, 0x26, 0xa4, 0xc1, 0xc5, 0x11, 0x94, 0x9f, 0x5f,
+	0xe2, 0x91, 0x58, 0x9c, 0x21, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0xe3, 0xc4, 0xf3, 0xea, 0x9e, 0x3c,
+	0x47, 0x11, 0x54, 0x2c, 0x08, 0xce, 0x12, 0x8a, 0xe1, 0x12, 0x0b, 0x4b, 0xcc, 0xc9, 0x4c, 0x49,
+	0x2c, 0xc9, 0x2f, 0x0a, 0x2e, 0x49, 0x2c, 0x29, 0x86, 0xeb, 0x63, 0x02, 0xeb, 0x53, 0x79, 0x75,
+	0x4f, 0x5e, 0xa1, 0x0c, 0xab, 0x0a, 0x9d, 0xfc, 0xdc, 0xcc, 0x92, 0xd4, 0xdc, 0x82, 0x92, 0xca,
+	0x20, 0x31, 0xec, 0x2a, 0x84, 0xb4, 0xb9, 0x38, 0x43, 0x8a, 0x32, 0x53, 0xfd, 0xf2, 0x53, 0x52,
+	0x8b, 0x25, 0x98, 0x15, 0x98, 0x35, 0x78, 0x9c, 0x78, 0x5f, 0xdd, 0x93, 0xe7, 0x2c, 0x81, 0x09,
+	0x06, 0x21, 0x98, 0x4e, 0xf6, 0x17, 0x1e, 0xca, 0x31, 0xdc, 0x78, 0x28, 0xc7, 0xf0, 0xe1, 0xa1,
+	0x1c, 0x63, 0xc3, 0x23, 0x39, 0xc6, 0x15, 0x8f, 0xe4, 0x18, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0,
+	0x48, 0x8e, 0xf1, 0xc6, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x5f, 0x3c, 0x92, 0x63, 0xf8,
+	0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63,
+	0x88, 0x62, 0x2d, 0x2e, 0x49, 0x2c, 0x49, 0x4d, 0x62, 0x03, 0x87, 0x86, 0x31, 0x60, 0x00, 0x46,
+	0xe5, 0x64, 0x60, 0x59, 0x01, 0x00, 0x00,
+}
+
+func (this *BlockWitness) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BlockWitness)
+	if !ok {
+		that2, ok := that.(BlockWitness)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.RootHash, that1.RootHash) {
+		return false
+	}
+	if !bytes.Equal(this.ValidatorStatsRootHash, that1.ValidatorStatsRootHash) {
+		return false
+	}
+	if len(this.TrieNodes) != len(that1.TrieNodes) {
+		return false
+	}
+	for i := range this.TrieNodes {
+		if !bytes.Equal(this.TrieNodes[i], that1.TrieNodes[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *BlockWitness) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&state.BlockWitness{")
+	s = append(s, "RootHash: "+fmt.Sprintf("%#v", this.RootHash)+",\n")
+	s = append(s, "ValidatorStatsRootHash: "+fmt.Sprintf("%#v", this.ValidatorStatsRootHash)+",\n")
+	s = append(s, "TrieNodes: "+fmt.Sprintf("%#v", this.TrieNodes)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringBlockWitness(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *BlockWitness) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockWitness) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockWitness) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TrieNodes) > 0 {
+		for iNdEx := len(m.TrieNodes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TrieNodes[iNdEx])
+			copy(dAtA[i:], m.TrieNodes[iNdEx])
+			i = encodeVarintBlockWitness(dAtA, i, uint64(len(m.TrieNodes[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ValidatorStatsRootHash) > 0 {
+		i -= len(m.ValidatorStatsRootHash)
+		copy(dAtA[i:], m.ValidatorStatsRootHash)
+		i = encodeVarintBlockWitness(dAtA, i, uint64(len(m.ValidatorStatsRootHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RootHash) > 0 {
+		i -= len(m.RootHash)
+		copy(dAtA[i:], m.RootHash)
+		i = encodeVarintBlockWitness(dAtA, i, uint64(len(m.RootHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBlockWitness(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBlockWitness(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BlockWitness) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RootHash)
+	if l > 0 {
+		n += 1 + l + sovBlockWitness(uint64(l))
+	}
+	l = len(m.ValidatorStatsRootHash)
+	if l > 0 {
+		n += 1 + l + sovBlockWitness(uint64(l))
+	}
+	if len(m.TrieNodes) > 0 {
+		for _, b := range m.TrieNodes {
+			l = len(b)
+			n += 1 + l + sovBlockWitness(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovBlockWitness(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBlockWitness(x uint64) (n int) {
+	return sovBlockWitness(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *BlockWitness) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BlockWitness{`,
+		`RootHash:` + fmt.Sprintf("%v", this.RootHash) + `,`,
+		`ValidatorStatsRootHash:` + fmt.Sprintf("%v", this.ValidatorStatsRootHash) + `,`,
+		`TrieNodes:` + fmt.Sprintf("%v", this.TrieNodes) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringBlockWitness(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *BlockWitness) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlockWitness
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockWitness: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockWitness: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RootHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlockWitness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlockWitness
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlockWitness
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RootHash = append(m.RootHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.RootHash == nil {
+				m.RootHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorStatsRootHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlockWitness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlockWitness
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlockWitness
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorStatsRootHash = append(m.ValidatorStatsRootHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorStatsRootHash == nil {
+				m.ValidatorStatsRootHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrieNodes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlockWitness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlockWitness
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlockWitness
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrieNodes = append(m.TrieNodes, make([]byte, postIndex-iNdEx))
+			copy(m.TrieNodes[len(m.TrieNodes)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlockWitness(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlockWitness
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBlockWitness
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipBlockWitness(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowBlockWitness
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBlockWitness
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBlockWitness
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthBlockWitness
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupBlockWitness
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthBlockWitness
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthBlockWitness        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowBlockWitness          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupBlockWitness = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,137 @@
+package blockWitness
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var log = logger.GetOrCreate("state/blockWitness")
+
+// ArgsBlockWitnessHandler holds the arguments needed to create a block witness handler
+type ArgsBlockWitnessHandler struct {
+	Storer      storage.Storer
+	Marshalizer marshal.Marshalizer
+}
+
+type blockWitnessHandler struct {
+	storer      storage.Storer
+	marshalizer marshal.Marshalizer
+
+	mutWitness             sync.RWMutex
+	isRecording            bool
+	rootHash               []byte
+	validatorStatsRootHash []byte
+	recordedNodes          map[string][]byte
+}
+
+// NewBlockWitnessHandler creates a component that records the trie nodes read through the wrapped storage managers
+// while a block is executed and saves them in the storage, under the block hash, together with the root hashes the
+// execution started from
+func NewBlockWitnessHandler(args ArgsBlockWitnessHandler) (*blockWitnessHandler, error) {
+	if check.IfNil(args.Storer) {
+		return nil, state.ErrNilStorer
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, state.ErrNilMarshalizer
+	}
+
+	return &blockWitnessHandler{
+		storer:      args.Storer,
+		marshalizer: args.Marshalizer,
+	}, nil
+}
+
+// WrapStorageManager returns a storage manager whose trie nodes reads are recorded by this component. All the other
+// operations are forwarded to the provided storage manager
+func (handler *blockWitnessHandler) WrapStorageManager(storageManager common.StorageManager) common.StorageManager {
+	return &recordingStorageManager{
+		StorageManager: storageManager,
+		handler:        handler,
+	}
+}
+
+// StartRecording drops the trie nodes recorded so far and starts recording the ones read from now on, for a block
+// executed from the provided accounts and validators root hashes
+func (handler *blockWitnessHandler) StartRecording(rootHash []byte, validatorStatsRootHash []byte) {
+	handler.mutWitness.Lock()
+	handler.isRecording = true
+	handler.rootHash = rootHash
+	handler.validatorStatsRootHash = validatorStatsRootHash
+	handler.recordedNodes = make(map[string][]byte)
+	handler.mutWitness.Unlock()
+}
+
+// SaveWitness stops the recording and saves the recorded trie nodes in the storage, under the provided header hash
+func (handler *blockWitnessHandler) SaveWitness(headerHash []byte) error {
+	handler.mutWitness.Lock()
+	isRecording := handler.isRecording
+	witness := &state.BlockWitness{
+		RootHash:               handler.rootHash,
+		ValidatorStatsRootHash: handler.validatorStatsRootHash,
+		TrieNodes:              sortedTrieNodes(handler.recordedNodes),
+	}
+	handler.isRecording = false
+	handler.rootHash = nil
+	handler.validatorStatsRootHash = nil
+	handler.recordedNodes = nil
+	handler.mutWitness.Unlock()
+
+	if !isRecording {
+		return state.ErrBlockWitnessNotRecorded
+	}
+
+	buff, err := handler.marshalizer.Marshal(witness)
+	if err != nil {
+		return err
+	}
+
+	log.Trace("blockWitnessHandler.SaveWitness", "header hash", headerHash, "num trie nodes", len(witness.TrieNodes))
+
+	return handler.storer.Put(headerHash, buff)
+}
+
+// sortedTrieNodes returns the trie nodes sorted by their hashes, so that the saved witness does not depend on the
+// reading order
+func sortedTrieNodes(nodes map[string][]byte) [][]byte {
+	hashes := make([]string, 0, len(nodes))
+	for hash := range nodes {
+		hashes = append(hashes, hash)
+	}
+	sort.Strings(hashes)
+
+	sortedNodes := make([][]byte, 0, len(hashes))
+	for _, hash := range hashes {
+		sortedNodes = append(sortedNodes, nodes[hash])
+	}
+
+	return sortedNodes
+}
+
+func (handler *blockWitnessHandler) recordTrieNode(key []byte, val []byte) {
+	if len(val) == 0 {
+		return
+	}
+
+	handler.mutWitness.Lock()
+	if handler.isRecording {
+		handler.recordedNodes[string(key)] = val
+	}
+	handler.mutWitness.Unlock()
+}
+
+// IsEnabled returns true
+func (handler *blockWitnessHandler) IsEnabled() bool {
+	return true
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (handler *blockWitnessHandler) IsInterfaceNil() bool {
+	return handler == nil
+}
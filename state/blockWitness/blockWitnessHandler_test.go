@@ -0,0 +1,166 @@
+package blockWitness
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/hashingMocks"
+	"github.com/ElrondNetwork/elrond-go/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const maxTrieLevelInMemory = 5
+
+func createMockArgsBlockWitnessHandler() ArgsBlockWitnessHandler {
+	return ArgsBlockWitnessHandler{
+		Storer:      testscommon.CreateMemUnit(),
+		Marshalizer: &testscommon.MarshalizerMock{},
+	}
+}
+
+func createStorageManager(t *testing.T, db common.DBWriteCacher) common.StorageManager {
+	storageManager, err := trie.NewTrieStorageManagerWithoutPruning(db)
+	require.Nil(t, err)
+
+	return storageManager
+}
+
+func createCommittedTrie(t *testing.T, numKeys int) (common.StorageManager, []byte) {
+	storageManager := createStorageManager(t, testscommon.NewMemDbMock())
+	tr := createTrie(t, storageManager)
+	for i := 0; i < numKeys; i++ {
+		require.Nil(t, tr.Update([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i))))
+	}
+	require.Nil(t, tr.Commit())
+	rootHash, err := tr.RootHash()
+	require.Nil(t, err)
+
+	return storageManager, rootHash
+}
+
+func recordWitness(t *testing.T, storageManager common.StorageManager, rootHash []byte) (*state.BlockWitness, []byte) {
+	args := createMockArgsBlockWitnessHandler()
+	handler, _ := NewBlockWitnessHandler(args)
+	processingTrie := createTrie(t, handler.WrapStorageManager(storageManager))
+
+	headerHash := []byte("header hash")
+	handler.StartRecording(rootHash, []byte("validator stats root hash"))
+	newRootHash := executeBlock(t, processingTrie, rootHash)
+	require.Nil(t, handler.SaveWitness(headerHash))
+
+	buff, err := args.Storer.Get(headerHash)
+	require.Nil(t, err)
+	witness := &state.BlockWitness{}
+	require.Nil(t, args.Marshalizer.Unmarshal(witness, buff))
+
+	return witness, newRootHash
+}
+
+func createTrie(t *testing.T, storageManager common.StorageManager) common.Trie {
+	tr, err := trie.NewTrie(storageManager, &testscommon.MarshalizerMock{}, &hashingMocks.HasherMock{}, maxTrieLevelInMemory)
+	require.Nil(t, err)
+
+	return tr
+}
+
+// executeBlock alters the trie found at the provided root hash as a block would, returning the new root hash
+func executeBlock(t *testing.T, tr common.Trie, rootHash []byte) []byte {
+	blockTrie, err := tr.Recreate(rootHash)
+	require.Nil(t, err)
+
+	value, err := blockTrie.Get([]byte("key3"))
+	require.Nil(t, err)
+	require.Equal(t, []byte("value3"), value)
+	require.Nil(t, blockTrie.Update([]byte("key5"), []byte("new value")))
+	require.Nil(t, blockTrie.Update([]byte("new key"), []byte("value")))
+	require.Nil(t, blockTrie.Delete([]byte("key7")))
+
+	newRootHash, err := blockTrie.RootHash()
+	require.Nil(t, err)
+
+	return newRootHash
+}
+
+func TestNewBlockWitnessHandler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBlockWitnessHandler()
+		args.Storer = nil
+		handler, err := NewBlockWitnessHandler(args)
+		assert.True(t, check.IfNil(handler))
+		assert.Equal(t, state.ErrNilStorer, err)
+	})
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBlockWitnessHandler()
+		args.Marshalizer = nil
+		handler, err := NewBlockWitnessHandler(args)
+		assert.True(t, check.IfNil(handler))
+		assert.Equal(t, state.ErrNilMarshalizer, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		handler, err := NewBlockWitnessHandler(createMockArgsBlockWitnessHandler())
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(handler))
+		assert.True(t, handler.IsEnabled())
+	})
+}
+
+func TestBlockWitnessHandler_SaveWitnessWithoutRecordingShouldErr(t *testing.T) {
+	t.Parallel()
+
+	handler, _ := NewBlockWitnessHandler(createMockArgsBlockWitnessHandler())
+	err := handler.SaveWitness([]byte("header hash"))
+	assert.Equal(t, state.ErrBlockWitnessNotRecorded, err)
+}
+
+func TestBlockWitnessHandler_ShouldRecordOnlyTheReadTrieNodes(t *testing.T) {
+	t.Parallel()
+
+	storageManager, rootHash := createCommittedTrie(t, 100)
+	committedTrie, err := createTrie(t, storageManager).Recreate(rootHash)
+	require.Nil(t, err)
+	hashes, _ := committedTrie.GetAllHashes()
+
+	witness, _ := recordWitness(t, storageManager, rootHash)
+	assert.Equal(t, rootHash, witness.RootHash)
+	assert.Equal(t, []byte("validator stats root hash"), witness.ValidatorStatsRootHash)
+	assert.NotEmpty(t, witness.TrieNodes)
+	assert.True(t, len(witness.TrieNodes) < len(hashes))
+
+	hasher := &hashingMocks.HasherMock{}
+	for i := 1; i < len(witness.TrieNodes); i++ {
+		previousHash := hasher.Compute(string(witness.TrieNodes[i-1]))
+		currentHash := hasher.Compute(string(witness.TrieNodes[i]))
+		assert.True(t, string(previousHash) < string(currentHash))
+	}
+}
+
+func TestBlockWitnessHandler_ReadsAfterSavingShouldNotBeRecorded(t *testing.T) {
+	t.Parallel()
+
+	storageManager, rootHash := createCommittedTrie(t, 10)
+	handler, _ := NewBlockWitnessHandler(createMockArgsBlockWitnessHandler())
+	wrappedStorageManager := handler.WrapStorageManager(storageManager)
+
+	handler.StartRecording(rootHash, nil)
+	_, err := wrappedStorageManager.Get(rootHash)
+	require.Nil(t, err)
+	assert.Equal(t, 1, len(handler.recordedNodes))
+	require.Nil(t, handler.SaveWitness([]byte("header hash")))
+
+	_, err = wrappedStorageManager.Get(rootHash)
+	assert.Nil(t, err)
+	assert.Nil(t, handler.recordedNodes)
+}
@@ -0,0 +1,27 @@
+package blockWitness
+
+import "github.com/ElrondNetwork/elrond-go/common"
+
+// recordingStorageManager records, through the block witness handler, the trie nodes read from the wrapped storage
+// manager. All the other operations, including the snapshots, are done directly by the wrapped storage manager
+type recordingStorageManager struct {
+	common.StorageManager
+	handler *blockWitnessHandler
+}
+
+// Get returns the trie node with the provided hash, recording it if a block witness is recorded
+func (rsm *recordingStorageManager) Get(key []byte) ([]byte, error) {
+	val, err := rsm.StorageManager.Get(key)
+	if err != nil {
+		return nil, err
+	}
+
+	rsm.handler.recordTrieNode(key, val)
+
+	return val, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rsm *recordingStorageManager) IsInterfaceNil() bool {
+	return rsm == nil
+}
@@ -0,0 +1,91 @@
+package blockWitness
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go/state"
+)
+
+type witnessDB struct {
+	hasher hashing.Hasher
+
+	mutNodes     sync.RWMutex
+	witnessNodes map[string][]byte
+	writtenNodes map[string][]byte
+}
+
+// NewWitnessDB creates a database that serves the trie nodes only from a loaded block witness. The trie nodes written
+// while the block is executed are kept in memory, until the next witness is loaded
+func NewWitnessDB(hasher hashing.Hasher) (*witnessDB, error) {
+	if check.IfNil(hasher) {
+		return nil, state.ErrNilHasher
+	}
+
+	return &witnessDB{
+		hasher:       hasher,
+		witnessNodes: make(map[string][]byte),
+		writtenNodes: make(map[string][]byte),
+	}, nil
+}
+
+// LoadWitness drops all the trie nodes held so far and loads the provided ones. The trie nodes are indexed by their
+// computed hashes, so a tampered witness only leads to missing trie nodes
+func (db *witnessDB) LoadWitness(trieNodes [][]byte) {
+	witnessNodes := make(map[string][]byte, len(trieNodes))
+	for _, node := range trieNodes {
+		if len(node) == 0 {
+			continue
+		}
+
+		witnessNodes[string(db.hasher.Compute(string(node)))] = node
+	}
+
+	db.mutNodes.Lock()
+	db.witnessNodes = witnessNodes
+	db.writtenNodes = make(map[string][]byte)
+	db.mutNodes.Unlock()
+}
+
+// Put keeps the trie node in memory
+func (db *witnessDB) Put(key, val []byte) error {
+	db.mutNodes.Lock()
+	db.writtenNodes[string(key)] = val
+	db.mutNodes.Unlock()
+
+	return nil
+}
+
+// Get returns the trie node with the provided hash if it was loaded from the witness or written since then
+func (db *witnessDB) Get(key []byte) ([]byte, error) {
+	db.mutNodes.RLock()
+	defer db.mutNodes.RUnlock()
+
+	val, ok := db.witnessNodes[string(key)]
+	if ok {
+		return val, nil
+	}
+	val, ok = db.writtenNodes[string(key)]
+	if ok {
+		return val, nil
+	}
+
+	return nil, fmt.Errorf("%w, hash %x", state.ErrMissingWitnessNode, key)
+}
+
+// Remove does nothing, as the witness trie nodes are dropped only when the next witness is loaded
+func (db *witnessDB) Remove(_ []byte) error {
+	return nil
+}
+
+// Close does nothing
+func (db *witnessDB) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (db *witnessDB) IsInterfaceNil() bool {
+	return db == nil
+}
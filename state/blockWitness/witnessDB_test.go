@@ -0,0 +1,100 @@
+package blockWitness
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/testscommon/hashingMocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewWitnessDB(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		db, err := NewWitnessDB(nil)
+		assert.True(t, check.IfNil(db))
+		assert.Equal(t, state.ErrNilHasher, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		db, err := NewWitnessDB(&hashingMocks.HasherMock{})
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(db))
+	})
+}
+
+func TestWitnessDB_ShouldServeOnlyTheWitnessAndTheWrittenTrieNodes(t *testing.T) {
+	t.Parallel()
+
+	hasher := &hashingMocks.HasherMock{}
+	db, _ := NewWitnessDB(hasher)
+	node := []byte("node")
+	nodeHash := hasher.Compute(string(node))
+
+	_, err := db.Get(nodeHash)
+	assert.True(t, errors.Is(err, state.ErrMissingWitnessNode))
+
+	db.LoadWitness([][]byte{node, nil})
+	value, err := db.Get(nodeHash)
+	assert.Nil(t, err)
+	assert.Equal(t, node, value)
+
+	require.Nil(t, db.Put([]byte("new hash"), []byte("new node")))
+	require.Nil(t, db.Remove(nodeHash))
+	value, err = db.Get([]byte("new hash"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("new node"), value)
+	value, err = db.Get(nodeHash)
+	assert.Nil(t, err)
+	assert.Equal(t, node, value)
+
+	db.LoadWitness(nil)
+	_, err = db.Get(nodeHash)
+	assert.True(t, errors.Is(err, state.ErrMissingWitnessNode))
+	_, err = db.Get([]byte("new hash"))
+	assert.True(t, errors.Is(err, state.ErrMissingWitnessNode))
+}
+
+func TestWitnessDB_RecordedWitnessShouldReExecuteTheBlockStatelessly(t *testing.T) {
+	t.Parallel()
+
+	storageManager, rootHash := createCommittedTrie(t, 100)
+	witness, expectedRootHash := recordWitness(t, storageManager, rootHash)
+
+	db, _ := NewWitnessDB(&hashingMocks.HasherMock{})
+	db.LoadWitness(witness.TrieNodes)
+	verifyingTrie := createTrie(t, createStorageManager(t, db))
+	assert.Equal(t, expectedRootHash, executeBlock(t, verifyingTrie, witness.RootHash))
+}
+
+func TestWitnessDB_IncompleteWitnessShouldNotReExecuteTheBlock(t *testing.T) {
+	t.Parallel()
+
+	storageManager, rootHash := createCommittedTrie(t, 100)
+	witness, _ := recordWitness(t, storageManager, rootHash)
+
+	db, _ := NewWitnessDB(&hashingMocks.HasherMock{})
+	db.LoadWitness(witness.TrieNodes[1:])
+	verifyingTrie := createTrie(t, createStorageManager(t, db))
+	blockTrie, err := verifyingTrie.Recreate(rootHash)
+	if err == nil {
+		_, err = blockTrie.Get([]byte("key3"))
+		if err == nil {
+			err = blockTrie.Update([]byte("key5"), []byte("new value"))
+		}
+		if err == nil {
+			err = blockTrie.Delete([]byte("key7"))
+		}
+		if err == nil {
+			_, err = blockTrie.RootHash()
+		}
+	}
+	assert.True(t, errors.Is(err, state.ErrMissingWitnessNode))
+}
@@ -0,0 +1,35 @@
+package state
+
+import "github.com/ElrondNetwork/elrond-go/common"
+
+type disabledBlockWitnessHandler struct {
+}
+
+// NewDisabledBlockWitnessHandler returns a block witness handler that does not record anything
+func NewDisabledBlockWitnessHandler() *disabledBlockWitnessHandler {
+	return &disabledBlockWitnessHandler{}
+}
+
+// WrapStorageManager returns the provided storage manager
+func (handler *disabledBlockWitnessHandler) WrapStorageManager(storageManager common.StorageManager) common.StorageManager {
+	return storageManager
+}
+
+// StartRecording does nothing
+func (handler *disabledBlockWitnessHandler) StartRecording(_ []byte, _ []byte) {
+}
+
+// SaveWitness does nothing
+func (handler *disabledBlockWitnessHandler) SaveWitness(_ []byte) error {
+	return nil
+}
+
+// IsEnabled returns false
+func (handler *disabledBlockWitnessHandler) IsEnabled() bool {
+	return false
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (handler *disabledBlockWitnessHandler) IsInterfaceNil() bool {
+	return handler == nil
+}
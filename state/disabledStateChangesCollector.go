@@ -0,0 +1,35 @@
+package state
+
+import "github.com/ElrondNetwork/elrond-go-core/data/indexer"
+
+type disabledStateChangesCollector struct {
+}
+
+// NewDisabledStateChangesCollector returns a state changes collector that does not collect anything
+func NewDisabledStateChangesCollector() *disabledStateChangesCollector {
+	return &disabledStateChangesCollector{}
+}
+
+// SetStateChanges does nothing
+func (collector *disabledStateChangesCollector) SetStateChanges(_ *BlockStateChanges) {
+}
+
+// SaveStateChanges does nothing
+func (collector *disabledStateChangesCollector) SaveStateChanges(_ []byte) error {
+	return nil
+}
+
+// GetAlteredAccounts returns nil
+func (collector *disabledStateChangesCollector) GetAlteredAccounts() map[string]*indexer.AlteredAccount {
+	return nil
+}
+
+// IsEnabled returns false
+func (collector *disabledStateChangesCollector) IsEnabled() bool {
+	return false
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (collector *disabledStateChangesCollector) IsInterfaceNil() bool {
+	return collector == nil
+}
@@ -121,3 +121,31 @@ var ErrNilRootHash = errors.New("nil root hash")
 
 // ErrNilChainHandler signals that a nil chain handler was provided
 var ErrNilChainHandler = errors.New("nil chain handler")
+
+// ErrStateNotAvailable signals that the state of the requested block is not available, as its trie nodes might have
+// been pruned. Only a full archive node retains the state of all blocks
+var ErrStateNotAvailable = errors.New("state not available")
+
+// ErrNilStateChangesCollector signals that a nil state changes collector has been provided
+var ErrNilStateChangesCollector = errors.New("nil state changes collector")
+
+// ErrNilStorer signals that a nil storer has been provided
+var ErrNilStorer = errors.New("nil storer")
+
+// ErrNilPubkeyConverter signals that a nil public key converter has been provided
+var ErrNilPubkeyConverter = errors.New("nil pubkey converter")
+
+// ErrNilAccountsDiffHandler signals that a nil accounts diff handler was provided
+var ErrNilAccountsDiffHandler = errors.New("nil accounts diff handler")
+
+// ErrNilAccountsDumpHandler signals that a nil accounts dump handler was provided
+var ErrNilAccountsDumpHandler = errors.New("nil accounts dump handler")
+
+// ErrIncompleteStateDump signals that the state dump is incomplete, as missing or corrupt trie nodes were found
+var ErrIncompleteStateDump = errors.New("incomplete state dump")
+
+// ErrBlockWitnessNotRecorded signals that no block witness was being recorded
+var ErrBlockWitnessNotRecorded = errors.New("block witness not recorded")
+
+// ErrMissingWitnessNode signals that a trie node needed while verifying a block is missing from the block witness
+var ErrMissingWitnessNode = errors.New("trie node missing from the block witness")
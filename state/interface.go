@@ -4,6 +4,7 @@ import (
 	"math/big"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
 	"github.com/ElrondNetwork/elrond-go/common"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 )
@@ -182,3 +183,37 @@ type StoragePruningManager interface {
 	Close() error
 	IsInterfaceNil() bool
 }
+
+// StateChangesCollector defines the behavior of a component able to collect the state changes done by each committed
+// block, to save them in the storage and to provide them as altered accounts to the outport drivers
+type StateChangesCollector interface {
+	SetStateChanges(stateChanges *BlockStateChanges)
+	SaveStateChanges(headerHash []byte) error
+	GetAlteredAccounts() map[string]*indexer.AlteredAccount
+	IsEnabled() bool
+	IsInterfaceNil() bool
+}
+
+// AccountsDumpHandler defines the behavior of a component receiving the accounts streamed by the accounts dumper. The
+// data trie entries of an account, if requested, are saved right after the account
+type AccountsDumpHandler interface {
+	SaveAccount(account *AccountDump) error
+	SaveDataTrieEntry(address []byte, key []byte, value []byte) error
+	IsInterfaceNil() bool
+}
+
+// BlockWitnessHandler defines the behavior of a component able to record the trie nodes read while executing a block,
+// saving them as the block's witness
+type BlockWitnessHandler interface {
+	WrapStorageManager(storageManager common.StorageManager) common.StorageManager
+	StartRecording(rootHash []byte, validatorStatsRootHash []byte)
+	SaveWitness(headerHash []byte) error
+	IsEnabled() bool
+	IsInterfaceNil() bool
+}
+
+// BlockWitnessDB defines a trie nodes database serving only the trie nodes of a loaded block witness
+type BlockWitnessDB interface {
+	common.DBWriteCacher
+	LoadWitness(trieNodes [][]byte)
+}
@@ -57,6 +57,7 @@ func NewPeerAccountsDB(
 			},
 			storagePruningManager: storagePruningManager,
 			lastSnapshot:          &snapshotInfo{},
+			stateChangesCollector: NewDisabledStateChangesCollector(),
 		},
 	}
 
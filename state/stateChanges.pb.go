@@ -0,0 +1,1599 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: stateChanges.proto
+
+package state
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	github_com_ElrondNetwork_elrond_go_core_data "github.com/ElrondNetwork/elrond-go-core/data"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_big "math/big"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DataTrieChange holds a key written in the data trie of an account, along with its value before and after the write
+type DataTrieChange struct {
+	Key      []byte `protobuf:"bytes,1,opt,name=Key,proto3" json:"key"`
+	OldValue []byte `protobuf:"bytes,2,opt,name=OldValue,proto3" json:"oldValue,omitempty"`
+	NewValue []byte `protobuf:"bytes,3,opt,name=NewValue,proto3" json:"newValue,omitempty"`
+}
+
+func (m *DataTrieChange) Reset()      { *m = DataTrieChange{} }
+func (*DataTrieChange) ProtoMessage() {}
+func (*DataTrieChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d33ba349cc24b770, []int{0}
+}
+func (m *DataTrieChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DataTrieChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *DataTrieChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataTrieChange.Merge(m, src)
+}
+func (m *DataTrieChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *DataTrieChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataTrieChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DataTrieChange proto.InternalMessageInfo
+
+func (m *DataTrieChange) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *DataTrieChange) GetOldValue() []byte {
+	if m != nil {
+		return m.OldValue
+	}
+	return nil
+}
+
+func (m *DataTrieChange) GetNewValue() []byte {
+	if m != nil {
+		return m.NewValue
+	}
+	return nil
+}
+
+// AccountStateChange holds the fields of an account before and after the changes done by a block
+type AccountStateChange struct {
+	Address         []byte            `protobuf:"bytes,1,opt,name=Address,proto3" json:"address"`
+	IsCreated       bool              `protobuf:"varint,2,opt,name=IsCreated,proto3" json:"isCreated,omitempty"`
+	IsRemoved       bool              `protobuf:"varint,3,opt,name=IsRemoved,proto3" json:"isRemoved,omitempty"`
+	OldBalance      *math_big.Int     `protobuf:"bytes,4,opt,name=OldBalance,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go-core/data.BigIntCaster" json:"oldBalance,omitempty"`
+	NewBalance      *math_big.Int     `protobuf:"bytes,5,opt,name=NewBalance,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go-core/data.BigIntCaster" json:"newBalance,omitempty"`
+	OldNonce        uint64            `protobuf:"varint,6,opt,name=OldNonce,proto3" json:"oldNonce"`
+	NewNonce        uint64            `protobuf:"varint,7,opt,name=NewNonce,proto3" json:"newNonce"`
+	OldCodeHash     []byte            `protobuf:"bytes,8,opt,name=OldCodeHash,proto3" json:"oldCodeHash,omitempty"`
+	NewCodeHash     []byte            `protobuf:"bytes,9,opt,name=NewCodeHash,proto3" json:"newCodeHash,omitempty"`
+	OldUserName     []byte            `protobuf:"bytes,10,opt,name=OldUserName,proto3" json:"oldUserName,omitempty"`
+	NewUserName     []byte            `protobuf:"bytes,11,opt,name=NewUserName,proto3" json:"newUserName,omitempty"`
+	DataTrieChanges []*DataTrieChange `protobuf:"bytes,12,rep,name=DataTrieChanges,proto3" json:"dataTrieChanges,omitempty"`
+}
+
+func (m *AccountStateChange) Reset()      { *m = AccountStateChange{} }
+func (*AccountStateChange) ProtoMessage() {}
+func (*AccountStateChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d33ba349cc24b770, []int{1}
+}
+func (m *AccountStateChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountStateChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AccountStateChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountStateChange.Merge(m, src)
+}
+func (m *AccountStateChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountStateChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountStateChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountStateChange proto.InternalMessageInfo
+
+func (m *AccountStateChange) GetAddress() []byte {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *AccountStateChange) GetIsCreated() bool {
+	if m != nil {
+		return m.IsCreated
+	}
+	return false
+}
+
+func (m *AccountStateChange) GetIsRemoved() bool {
+	if m != nil {
+		return m.IsRemoved
+	}
+	return false
+}
+
+func (m *AccountStateChange) GetOldBalance() *math_big.Int {
+	if m != nil {
+		return m.OldBalance
+	}
+	return nil
+}
+
+func (m *AccountStateChange) GetNewBalance() *math_big.Int {
+	if m != nil {
+		return m.NewBalance
+	}
+	return nil
+}
+
+func (m *AccountStateChange) GetOldNonce() uint64 {
+	if m != nil {
+		return m.OldNonce
+	}
+	return 0
+}
+
+func (m *AccountStateChange) GetNewNonce() uint64 {
+	if m != nil {
+		return m.NewNonce
+	}
+	return 0
+}
+
+func (m *AccountStateChange) GetOldCodeHash() []byte {
+	if m != nil {
+		return m.OldCodeHash
+	}
+	return nil
+}
+
+func (m *AccountStateChange) GetNewCodeHash() []byte {
+	if m != nil {
+		return m.NewCodeHash
+	}
+	return nil
+}
+
+func (m *AccountStateChange) GetOldUserName() []byte {
+	if m != nil {
+		return m.OldUserName
+	}
+	return nil
+}
+
+func (m *AccountStateChange) GetNewUserName() []byte {
+	if m != nil {
+		return m.NewUserName
+	}
+	return nil
+}
+
+func (m *AccountStateChange) GetDataTrieChanges() []*DataTrieChange {
+	if m != nil {
+		return m.DataTrieChanges
+	}
+	return nil
+}
+
+// BlockStateChanges holds all the account changes done by a block, in the order they were first touched
+type BlockStateChanges struct {
+	Accounts []*AccountStateChange `protobuf:"bytes,1,rep,name=Accounts,proto3" json:"accounts"`
+}
+
+func (m *BlockStateChanges) Reset()      { *m = BlockStateChanges{} }
+func (*BlockStateChanges) ProtoMessage() {}
+func (*BlockStateChanges) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d33ba349cc24b770, []int{2}
+}
+func (m *BlockStateChanges) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockStateChanges) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *BlockStateChanges) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockStateChanges.Merge(m, src)
+}
+func (m *BlockStateChanges) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockStateChanges) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockStateChanges.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockStateChanges proto.InternalMessageInfo
+
+func (m *BlockStateChanges) GetAccounts() []*AccountStateChange {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*DataTrieChange)(nil), "proto.DataTrieChange")
+	proto.RegisterType((*AccountStateChange)(nil), "proto.AccountStateChange")
+	proto.RegisterType((*BlockStateChanges)(nil), "proto.BlockStateChanges")
+}
+
+func init() { proto.RegisterFile("stateChanges.proto", fileDescriptor_d33ba349cc24b770) }
+
+var fileDescriptor_d33ba349cc24b770 = []byte{
+	// 597 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0x4f, 0x6b, 0xd4, 0x40,
+	0x18, 0xc6, 0x77, 0xdc, 0xb6, 0xbb, 0x9d, 0x2d, 0x8a, 0xa3, 0xd5, 0xac, 0xe0, 0xa4, 0x14, 0x84,
+	0x3d, 0xd8, 0x5d, 0xa8, 0x78, 0xea, 0x41, 0x9a, 0x55, 0xb1, 0x08, 0x29, 0xc4, 0x3f, 0x88, 0x07,
+	0x61, 0x76, 0xf3, 0x9a, 0x5d, 0x9a, 0x64, 0x4a, 0x32, 0x6b, 0xe8, 0x4d, 0xfc, 0x04, 0x82, 0x5f,
+	0x42, 0xfc, 0x24, 0x1e, 0x7b, 0x2c, 0x08, 0xd1, 0xa6, 0x17, 0xc9, 0xa9, 0x1f, 0x41, 0x32, 0x99,
+	0xfc, 0x6b, 0x7b, 0xf5, 0x94, 0xcc, 0xf3, 0x3e, 0xbf, 0x79, 0xde, 0x61, 0xf2, 0x06, 0x93, 0x50,
+	0x30, 0x01, 0xe3, 0x19, 0xf3, 0x1d, 0x08, 0x87, 0x87, 0x01, 0x17, 0x9c, 0x2c, 0xcb, 0xc7, 0xbd,
+	0x2d, 0x67, 0x2e, 0x66, 0x8b, 0xc9, 0x70, 0xca, 0xbd, 0x91, 0xc3, 0x1d, 0x3e, 0x92, 0xf2, 0x64,
+	0xf1, 0x51, 0xae, 0xe4, 0x42, 0xbe, 0xe5, 0xd4, 0xe6, 0x37, 0x84, 0xaf, 0x3f, 0x65, 0x82, 0xbd,
+	0x0e, 0xe6, 0x6a, 0x3f, 0xd2, 0xc7, 0xed, 0x97, 0x70, 0xa4, 0xa1, 0x0d, 0x34, 0x58, 0x33, 0x3a,
+	0x69, 0xac, 0xb7, 0x0f, 0xe0, 0xc8, 0xca, 0x34, 0xb2, 0x8d, 0xbb, 0xfb, 0xae, 0xfd, 0x96, 0xb9,
+	0x0b, 0xd0, 0xae, 0xc9, 0xfa, 0x9d, 0x34, 0xd6, 0x09, 0x57, 0xda, 0x43, 0xee, 0xcd, 0x05, 0x78,
+	0x87, 0xe2, 0xc8, 0x2a, 0x7d, 0x19, 0x63, 0x42, 0x94, 0x33, 0xed, 0x8a, 0xf1, 0x21, 0xba, 0xc4,
+	0x14, 0xbe, 0xcd, 0x5f, 0x2b, 0x98, 0xec, 0x4e, 0xa7, 0x7c, 0xe1, 0x8b, 0x57, 0xd5, 0x49, 0xc9,
+	0x03, 0xdc, 0xd9, 0xb5, 0xed, 0x00, 0xc2, 0x50, 0x75, 0xd7, 0x4b, 0x63, 0xbd, 0xc3, 0x72, 0xc9,
+	0x2a, 0x6a, 0xe4, 0x31, 0x5e, 0xdd, 0x0b, 0xc7, 0x01, 0x30, 0x01, 0xb6, 0x6c, 0xb3, 0x6b, 0xdc,
+	0x4d, 0x63, 0xfd, 0xd6, 0xbc, 0x10, 0x6b, 0x99, 0x95, 0x33, 0xc7, 0x2c, 0xf0, 0xf8, 0x27, 0xb0,
+	0xb5, 0x76, 0x1d, 0x53, 0x62, 0x13, 0x53, 0x22, 0xf9, 0x82, 0x30, 0xde, 0x77, 0x6d, 0x83, 0xb9,
+	0xcc, 0x9f, 0x82, 0xb6, 0x24, 0x1b, 0x9b, 0xa4, 0xb1, 0x7e, 0x9b, 0x97, 0x6a, 0x45, 0xfe, 0xf8,
+	0xad, 0x3f, 0xf7, 0x98, 0x98, 0x8d, 0x26, 0x73, 0x67, 0xb8, 0xe7, 0x8b, 0x9d, 0xda, 0x75, 0x3d,
+	0x73, 0x03, 0xee, 0xdb, 0x26, 0x88, 0x88, 0x07, 0x07, 0x23, 0x90, 0xab, 0x2d, 0x87, 0x6f, 0x4d,
+	0x79, 0x00, 0x23, 0x9b, 0x09, 0x36, 0x34, 0xe6, 0xce, 0x9e, 0x2f, 0xc6, 0x2c, 0x14, 0x10, 0x58,
+	0xb5, 0x54, 0xd9, 0x84, 0x09, 0x51, 0xd1, 0xc4, 0x72, 0xd5, 0x84, 0x0f, 0xd1, 0x7f, 0x6d, 0xa2,
+	0x4a, 0x25, 0x03, 0xf9, 0x75, 0x98, 0x3c, 0xeb, 0x60, 0x65, 0x03, 0x0d, 0x96, 0x8c, 0xb5, 0x34,
+	0xd6, 0xbb, 0x5c, 0x69, 0x56, 0x59, 0xcd, 0x9c, 0x26, 0x44, 0xb9, 0xb3, 0x53, 0x39, 0x7d, 0xa5,
+	0x59, 0x65, 0x95, 0xec, 0xe0, 0xde, 0xbe, 0x6b, 0x8f, 0xb9, 0x0d, 0x2f, 0x58, 0x38, 0xd3, 0xba,
+	0xf2, 0x60, 0xfd, 0x34, 0xd6, 0xd7, 0x79, 0x25, 0xd7, 0x2e, 0xa6, 0xee, 0xce, 0x60, 0x13, 0xa2,
+	0x12, 0x5e, 0xad, 0x60, 0x1f, 0xa2, 0xab, 0xe0, 0x9a, 0x5b, 0x25, 0xbf, 0x09, 0x21, 0x30, 0x99,
+	0x07, 0x1a, 0x6e, 0x24, 0x17, 0xf2, 0x85, 0xe4, 0x42, 0x56, 0xc9, 0x25, 0xdc, 0x6b, 0x24, 0x5f,
+	0x05, 0xd7, 0xdc, 0xe4, 0x03, 0xbe, 0xd1, 0x1c, 0xc9, 0x50, 0x5b, 0xdb, 0x68, 0x0f, 0x7a, 0xdb,
+	0xeb, 0xf9, 0xd0, 0x0e, 0x9b, 0x55, 0xe3, 0x7e, 0x1a, 0xeb, 0x7d, 0xbb, 0x49, 0xd4, 0xf6, 0xbe,
+	0xb8, 0xd9, 0xe6, 0x3b, 0x7c, 0xd3, 0x70, 0xf9, 0xf4, 0xa0, 0x36, 0x5a, 0x21, 0x19, 0xe3, 0xae,
+	0x9a, 0xb8, 0x6c, 0xb8, 0xb2, 0xb4, 0xbe, 0x4a, 0xbb, 0x3c, 0x88, 0xf9, 0x6d, 0x31, 0x65, 0xb7,
+	0x4a, 0xd0, 0x78, 0x72, 0x7c, 0x4a, 0x5b, 0x27, 0xa7, 0xb4, 0x75, 0x7e, 0x4a, 0xd1, 0xe7, 0x84,
+	0xa2, 0xef, 0x09, 0x45, 0x3f, 0x13, 0x8a, 0x8e, 0x13, 0x8a, 0x4e, 0x12, 0x8a, 0xfe, 0x24, 0x14,
+	0xfd, 0x4d, 0x68, 0xeb, 0x3c, 0xa1, 0xe8, 0xeb, 0x19, 0x6d, 0x1d, 0x9f, 0xd1, 0xd6, 0xc9, 0x19,
+	0x6d, 0xbd, 0x5f, 0x96, 0x7f, 0xb4, 0xc9, 0x8a, 0x8c, 0x7c, 0xf4, 0x6f, 0x00, 0xb9, 0x88, 0x76,
+	0xf5, 0xe1, 0x04, 0x00, 0x00,
+}
+
+func (this *DataTrieChange) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DataTrieChange)
+	if !ok {
+		that2, ok := that.(DataTrieChange)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Key, that1.Key) {
+		return false
+	}
+	if !bytes.Equal(this.OldValue, that1.OldValue) {
+		return false
+	}
+	if !bytes.Equal(this.NewValue, that1.NewValue) {
+		return false
+	}
+	return true
+}
+func (this *AccountStateChange) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AccountStateChange)
+	if !ok {
+		that2, ok := that.(AccountStateChange)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Address, that1.Address) {
+		return false
+	}
+	if this.IsCreated != that1.IsCreated {
+		return false
+	}
+	if this.IsRemoved != that1.IsRemoved {
+		return false
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_core_data.BigIntCaster{}
+		if !__caster.Equal(this.OldBalance, that1.OldBalance) {
+			return false
+		}
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_core_data.BigIntCaster{}
+		if !__caster.Equal(this.NewBalance, that1.NewBalance) {
+			return false
+		}
+	}
+	if this.OldNonce != that1.OldNonce {
+		return false
+	}
+	if this.NewNonce != that1.NewNonce {
+		return false
+	}
+	if !bytes.Equal(this.OldCodeHash, that1.OldCodeHash) {
+		return false
+	}
+	if !bytes.Equal(this.NewCodeHash, that1.NewCodeHash) {
+		return false
+	}
+	if !bytes.Equal(this.OldUserName, that1.OldUserName) {
+		return false
+	}
+	if !bytes.Equal(this.NewUserName, that1.NewUserName) {
+		return false
+	}
+	if len(this.DataTrieChanges) != len(that1.DataTrieChanges) {
+		return false
+	}
+	for i := range this.DataTrieChanges {
+		if !this.DataTrieChanges[i].Equal(that1.DataTrieChanges[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *BlockStateChanges) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BlockStateChanges)
+	if !ok {
+		that2, ok := that.(BlockStateChanges)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Accounts) != len(that1.Accounts) {
+		return false
+	}
+	for i := range this.Accounts {
+		if !this.Accounts[i].Equal(that1.Accounts[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *DataTrieChange) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&state.DataTrieChange{")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "OldValue: "+fmt.Sprintf("%#v", this.OldValue)+",\n")
+	s = append(s, "NewValue: "+fmt.Sprintf("%#v", this.NewValue)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AccountStateChange) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 16)
+	s = append(s, "&state.AccountStateChange{")
+	s = append(s, "Address: "+fmt.Sprintf("%#v", this.Address)+",\n")
+	s = append(s, "IsCreated: "+fmt.Sprintf("%#v", this.IsCreated)+",\n")
+	s = append(s, "IsRemoved: "+fmt.Sprintf("%#v", this.IsRemoved)+",\n")
+	s = append(s, "OldBalance: "+fmt.Sprintf("%#v", this.OldBalance)+",\n")
+	s = append(s, "NewBalance: "+fmt.Sprintf("%#v", this.NewBalance)+",\n")
+	s = append(s, "OldNonce: "+fmt.Sprintf("%#v", this.OldNonce)+",\n")
+	s = append(s, "NewNonce: "+fmt.Sprintf("%#v", this.NewNonce)+",\n")
+	s = append(s, "OldCodeHash: "+fmt.Sprintf("%#v", this.OldCodeHash)+",\n")
+	s = append(s, "NewCodeHash: "+fmt.Sprintf("%#v", this.NewCodeHash)+",\n")
+	s = append(s, "OldUserName: "+fmt.Sprintf("%#v", this.OldUserName)+",\n")
+	s = append(s, "NewUserName: "+fmt.Sprintf("%#v", this.NewUserName)+",\n")
+	if this.DataTrieChanges != nil {
+		s = append(s, "DataTrieChanges: "+fmt.Sprintf("%#v", this.DataTrieChanges)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *BlockStateChanges) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&state.BlockStateChanges{")
+	if this.Accounts != nil {
+		s = append(s, "Accounts: "+fmt.Sprintf("%#v", this.Accounts)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringStateChanges(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *DataTrieChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DataTrieChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DataTrieChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewValue) > 0 {
+		i -= len(m.NewValue)
+		copy(dAtA[i:], m.NewValue)
+		i = encodeVarintStateChanges(dAtA, i, uint64(len(m.NewValue)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OldValue) > 0 {
+		i -= len(m.OldValue)
+		copy(dAtA[i:], m.OldValue)
+		i = encodeVarintStateChanges(dAtA, i, uint64(len(m.OldValue)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintStateChanges(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountStateChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountStateChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountStateChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DataTrieChanges) > 0 {
+		for iNdEx := len(m.DataTrieChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DataTrieChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStateChanges(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.NewUserName) > 0 {
+		i -= len(m.NewUserName)
+		copy(dAtA[i:], m.NewUserName)
+		i = encodeVarintStateChanges(dAtA, i, uint64(len(m.NewUserName)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.OldUserName) > 0 {
+		i -= len(m.OldUserName)
+		copy(dAtA[i:], m.OldUserName)
+		i = encodeVarintStateChanges(dAtA, i, uint64(len(m.OldUserName)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.NewCodeHash) > 0 {
+		i -= len(m.NewCodeHash)
+		copy(dAtA[i:], m.NewCodeHash)
+		i = encodeVarintStateChanges(dAtA, i, uint64(len(m.NewCodeHash)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.OldCodeHash) > 0 {
+		i -= len(m.OldCodeHash)
+		copy(dAtA[i:], m.OldCodeHash)
+		i = encodeVarintStateChanges(dAtA, i, uint64(len(m.OldCodeHash)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.NewNonce != 0 {
+		i = encodeVarintStateChanges(dAtA, i, uint64(m.NewNonce))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.OldNonce != 0 {
+		i = encodeVarintStateChanges(dAtA, i, uint64(m.OldNonce))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_core_data.BigIntCaster{}
+		size := __caster.Size(m.NewBalance)
+		i -= size
+		if _, err := __caster.MarshalTo(m.NewBalance, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStateChanges(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_core_data.BigIntCaster{}
+		size := __caster.Size(m.OldBalance)
+		i -= size
+		if _, err := __caster.MarshalTo(m.OldBalance, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStateChanges(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.IsRemoved {
+		i--
+		if m.IsRemoved {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.IsCreated {
+		i--
+		if m.IsCreated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintStateChanges(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockStateChanges) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockStateChanges) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockStateChanges) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStateChanges(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStateChanges(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStateChanges(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DataTrieChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovStateChanges(uint64(l))
+	}
+	l = len(m.OldValue)
+	if l > 0 {
+		n += 1 + l + sovStateChanges(uint64(l))
+	}
+	l = len(m.NewValue)
+	if l > 0 {
+		n += 1 + l + sovStateChanges(uint64(l))
+	}
+	return n
+}
+
+func (m *AccountStateChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovStateChanges(uint64(l))
+	}
+	if m.IsCreated {
+		n += 2
+	}
+	if m.IsRemoved {
+		n += 2
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_core_data.BigIntCaster{}
+		l = __caster.Size(m.OldBalance)
+		n += 1 + l + sovStateChanges(uint64(l))
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_core_data.BigIntCaster{}
+		l = __caster.Size(m.NewBalance)
+		n += 1 + l + sovStateChanges(uint64(l))
+	}
+	if m.OldNonce != 0 {
+		n += 1 + sovStateChanges(uint64(m.OldNonce))
+	}
+	if m.NewNonce != 0 {
+		n += 1 + sovStateChanges(uint64(m.NewNonce))
+	}
+	l = len(m.OldCodeHash)
+	if l > 0 {
+		n += 1 + l + sovStateChanges(uint64(l))
+	}
+	l = len(m.NewCodeHash)
+	if l > 0 {
+		n += 1 + l + sovStateChanges(uint64(l))
+	}
+	l = len(m.OldUserName)
+	if l > 0 {
+		n += 1 + l + sovStateChanges(uint64(l))
+	}
+	l = len(m.NewUserName)
+	if l > 0 {
+		n += 1 + l + sovStateChanges(uint64(l))
+	}
+	if len(m.DataTrieChanges) > 0 {
+		for _, e := range m.DataTrieChanges {
+			l = e.Size()
+			n += 1 + l + sovStateChanges(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BlockStateChanges) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovStateChanges(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovStateChanges(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStateChanges(x uint64) (n int) {
+	return sovStateChanges(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *DataTrieChange) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DataTrieChange{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`OldValue:` + fmt.Sprintf("%v", this.OldValue) + `,`,
+		`NewValue:` + fmt.Sprintf("%v", this.NewValue) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AccountStateChange) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForDataTrieChanges := "[]*DataTrieChange{"
+	for _, f := range this.DataTrieChanges {
+		repeatedStringForDataTrieChanges += strings.Replace(f.String(), "DataTrieChange", "DataTrieChange", 1) + ","
+	}
+	repeatedStringForDataTrieChanges += "}"
+	s := strings.Join([]string{`&AccountStateChange{`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`IsCreated:` + fmt.Sprintf("%v", this.IsCreated) + `,`,
+		`IsRemoved:` + fmt.Sprintf("%v", this.IsRemoved) + `,`,
+		`OldBalance:` + fmt.Sprintf("%v", this.OldBalance) + `,`,
+		`NewBalance:` + fmt.Sprintf("%v", this.NewBalance) + `,`,
+		`OldNonce:` + fmt.Sprintf("%v", this.OldNonce) + `,`,
+		`NewNonce:` + fmt.Sprintf("%v", this.NewNonce) + `,`,
+		`OldCodeHash:` + fmt.Sprintf("%v", this.OldCodeHash) + `,`,
+		`NewCodeHash:` + fmt.Sprintf("%v", this.NewCodeHash) + `,`,
+		`OldUserName:` + fmt.Sprintf("%v", this.OldUserName) + `,`,
+		`NewUserName:` + fmt.Sprintf("%v", this.NewUserName) + `,`,
+		`DataTrieChanges:` + repeatedStringForDataTrieChanges + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BlockStateChanges) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForAccounts := "[]*AccountStateChange{"
+	for _, f := range this.Accounts {
+		repeatedStringForAccounts += strings.Replace(f.String(), "AccountStateChange", "AccountStateChange", 1) + ","
+	}
+	repeatedStringForAccounts += "}"
+	s := strings.Join([]string{`&BlockStateChanges{`,
+		`Accounts:` + repeatedStringForAccounts + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringStateChanges(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *DataTrieChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStateChanges
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DataTrieChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DataTrieChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateChanges
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStateChanges
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStateChanges
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldValue", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateChanges
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStateChanges
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStateChanges
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldValue = append(m.OldValue[:0], dAtA[iNdEx:postIndex]...)
+			if m.OldValue == nil {
+				m.OldValue = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewValue", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateChanges
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStateChanges
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStateChanges
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewValue = append(m.NewValue[:0], dAtA[iNdEx:postIndex]...)
+			if m.NewValue == nil {
+				m.NewValue = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStateChanges(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStateChanges
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthStateChanges
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountStateChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStateChanges
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountStateChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountStateChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateChanges
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStateChanges
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStateChanges
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsCreated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateChanges
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsCreated = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsRemoved", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateChanges
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsRemoved = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldBalance", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateChanges
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStateChanges
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStateChanges
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_ElrondNetwork_elrond_go_core_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.OldBalance = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewBalance", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateChanges
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStateChanges
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStateChanges
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_ElrondNetwork_elrond_go_core_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.NewBalance = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldNonce", wireType)
+			}
+			m.OldNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateChanges
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OldNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewNonce", wireType)
+			}
+			m.NewNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateChanges
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldCodeHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateChanges
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStateChanges
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStateChanges
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldCodeHash = append(m.OldCodeHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.OldCodeHash == nil {
+				m.OldCodeHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewCodeHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateChanges
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStateChanges
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStateChanges
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewCodeHash = append(m.NewCodeHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.NewCodeHash == nil {
+				m.NewCodeHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldUserName", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateChanges
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStateChanges
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStateChanges
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldUserName = append(m.OldUserName[:0], dAtA[iNdEx:postIndex]...)
+			if m.OldUserName == nil {
+				m.OldUserName = []byte{}
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewUserName", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateChanges
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStateChanges
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStateChanges
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewUserName = append(m.NewUserName[:0], dAtA[iNdEx:postIndex]...)
+			if m.NewUserName == nil {
+				m.NewUserName = []byte{}
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataTrieChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateChanges
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStateChanges
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStateChanges
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataTrieChanges = append(m.DataTrieChanges, &DataTrieChange{})
+			if err := m.DataTrieChanges[len(m.DataTrieChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStateChanges(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStateChanges
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthStateChanges
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockStateChanges) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStateChanges
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockStateChanges: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockStateChanges: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateChanges
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStateChanges
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStateChanges
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, &AccountStateChange{})
+			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStateChanges(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStateChanges
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthStateChanges
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipStateChanges(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowStateChanges
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStateChanges
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStateChanges
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthStateChanges
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupStateChanges
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthStateChanges
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthStateChanges        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowStateChanges          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupStateChanges = fmt.Errorf("proto: unexpected end of group")
+)
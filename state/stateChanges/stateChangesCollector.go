@@ -0,0 +1,125 @@
+package stateChanges
+
+import (
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var log = logger.GetOrCreate("state/stateChanges")
+
+// ArgsStateChangesCollector holds the arguments needed to create a state changes collector
+type ArgsStateChangesCollector struct {
+	Storer                 storage.Storer
+	Marshalizer            marshal.Marshalizer
+	AddressPubkeyConverter core.PubkeyConverter
+	SaveInOutportEnabled   bool
+}
+
+type stateChangesCollector struct {
+	storer                 storage.Storer
+	marshalizer            marshal.Marshalizer
+	addressPubkeyConverter core.PubkeyConverter
+	saveInOutportEnabled   bool
+
+	mutStateChanges sync.RWMutex
+	stateChanges    *state.BlockStateChanges
+}
+
+// NewStateChangesCollector creates a component that holds the state changes of the last commit, until the committed
+// block saves them in the storage, under its hash
+func NewStateChangesCollector(args ArgsStateChangesCollector) (*stateChangesCollector, error) {
+	if check.IfNil(args.Storer) {
+		return nil, state.ErrNilStorer
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, state.ErrNilMarshalizer
+	}
+	if check.IfNil(args.AddressPubkeyConverter) {
+		return nil, state.ErrNilPubkeyConverter
+	}
+
+	return &stateChangesCollector{
+		storer:                 args.Storer,
+		marshalizer:            args.Marshalizer,
+		addressPubkeyConverter: args.AddressPubkeyConverter,
+		saveInOutportEnabled:   args.SaveInOutportEnabled,
+	}, nil
+}
+
+// SetStateChanges replaces the held state changes with the ones done by the last commit. A nil value signals that the
+// changes could not be collected
+func (collector *stateChangesCollector) SetStateChanges(stateChanges *state.BlockStateChanges) {
+	collector.mutStateChanges.Lock()
+	collector.stateChanges = stateChanges
+	collector.mutStateChanges.Unlock()
+}
+
+// SaveStateChanges saves the held state changes in the storage, under the provided header hash
+func (collector *stateChangesCollector) SaveStateChanges(headerHash []byte) error {
+	collector.mutStateChanges.RLock()
+	stateChanges := collector.stateChanges
+	collector.mutStateChanges.RUnlock()
+
+	if stateChanges == nil {
+		log.Debug("stateChangesCollector.SaveStateChanges: no state changes collected", "header hash", headerHash)
+		return nil
+	}
+
+	buff, err := collector.marshalizer.Marshal(stateChanges)
+	if err != nil {
+		return err
+	}
+
+	return collector.storer.Put(headerHash, buff)
+}
+
+// GetAlteredAccounts returns the held state changes in the format expected by the outport drivers. Returns nil if
+// the state changes should not be sent to the outport drivers
+func (collector *stateChangesCollector) GetAlteredAccounts() map[string]*indexer.AlteredAccount {
+	if !collector.saveInOutportEnabled {
+		return nil
+	}
+
+	collector.mutStateChanges.RLock()
+	defer collector.mutStateChanges.RUnlock()
+
+	if collector.stateChanges == nil {
+		return nil
+	}
+
+	alteredAccounts := make(map[string]*indexer.AlteredAccount, len(collector.stateChanges.Accounts))
+	for _, change := range collector.stateChanges.Accounts {
+		encodedAddress := collector.addressPubkeyConverter.Encode(change.Address)
+		alteredAccount := &indexer.AlteredAccount{
+			Address: encodedAddress,
+			Balance: "0",
+		}
+		if !change.IsRemoved {
+			alteredAccount.Nonce = change.NewNonce
+			if change.NewBalance != nil {
+				alteredAccount.Balance = change.NewBalance.String()
+			}
+		}
+
+		alteredAccounts[encodedAddress] = alteredAccount
+	}
+
+	return alteredAccounts
+}
+
+// IsEnabled returns true
+func (collector *stateChangesCollector) IsEnabled() bool {
+	return true
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (collector *stateChangesCollector) IsInterfaceNil() bool {
+	return collector == nil
+}
@@ -0,0 +1,167 @@
+package stateChanges
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	storageStubs "github.com/ElrondNetwork/elrond-go/testscommon/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsStateChangesCollector() ArgsStateChangesCollector {
+	return ArgsStateChangesCollector{
+		Storer:                 &storageStubs.StorerStub{},
+		Marshalizer:            &testscommon.MarshalizerMock{},
+		AddressPubkeyConverter: testscommon.NewPubkeyConverterMock(32),
+		SaveInOutportEnabled:   true,
+	}
+}
+
+func createStateChanges() *state.BlockStateChanges {
+	return &state.BlockStateChanges{
+		Accounts: []*state.AccountStateChange{
+			{
+				Address:    []byte("alice"),
+				OldBalance: big.NewInt(10),
+				NewBalance: big.NewInt(7),
+				OldNonce:   1,
+				NewNonce:   2,
+			},
+			{
+				Address:    []byte("bob"),
+				IsRemoved:  true,
+				OldBalance: big.NewInt(5),
+				OldNonce:   4,
+			},
+		},
+	}
+}
+
+func TestNewStateChangesCollector(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStateChangesCollector()
+		args.Storer = nil
+		collector, err := NewStateChangesCollector(args)
+		assert.True(t, check.IfNil(collector))
+		assert.Equal(t, state.ErrNilStorer, err)
+	})
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStateChangesCollector()
+		args.Marshalizer = nil
+		collector, err := NewStateChangesCollector(args)
+		assert.True(t, check.IfNil(collector))
+		assert.Equal(t, state.ErrNilMarshalizer, err)
+	})
+	t.Run("nil address pubkey converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStateChangesCollector()
+		args.AddressPubkeyConverter = nil
+		collector, err := NewStateChangesCollector(args)
+		assert.True(t, check.IfNil(collector))
+		assert.Equal(t, state.ErrNilPubkeyConverter, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		collector, err := NewStateChangesCollector(createMockArgsStateChangesCollector())
+		assert.False(t, check.IfNil(collector))
+		assert.Nil(t, err)
+		assert.True(t, collector.IsEnabled())
+	})
+}
+
+func TestStateChangesCollector_SaveStateChanges(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no state changes should not save", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStateChangesCollector()
+		args.Storer = &storageStubs.StorerStub{
+			PutCalled: func(_, _ []byte) error {
+				assert.Fail(t, "should have not been called")
+				return nil
+			},
+		}
+		collector, _ := NewStateChangesCollector(args)
+
+		err := collector.SaveStateChanges([]byte("hash"))
+		assert.Nil(t, err)
+	})
+	t.Run("should save under the header hash", func(t *testing.T) {
+		t.Parallel()
+
+		savedData := make(map[string][]byte)
+		args := createMockArgsStateChangesCollector()
+		args.Storer = &storageStubs.StorerStub{
+			PutCalled: func(key, data []byte) error {
+				savedData[string(key)] = data
+				return nil
+			},
+		}
+		collector, _ := NewStateChangesCollector(args)
+		stateChanges := createStateChanges()
+		collector.SetStateChanges(stateChanges)
+
+		err := collector.SaveStateChanges([]byte("hash"))
+		require.Nil(t, err)
+		require.Len(t, savedData, 1)
+
+		recovered := &state.BlockStateChanges{}
+		err = args.Marshalizer.Unmarshal(recovered, savedData["hash"])
+		require.Nil(t, err)
+		assert.Equal(t, stateChanges, recovered)
+	})
+}
+
+func TestStateChangesCollector_GetAlteredAccounts(t *testing.T) {
+	t.Parallel()
+
+	t.Run("save in outport disabled should return nil", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStateChangesCollector()
+		args.SaveInOutportEnabled = false
+		collector, _ := NewStateChangesCollector(args)
+		collector.SetStateChanges(createStateChanges())
+
+		assert.Nil(t, collector.GetAlteredAccounts())
+	})
+	t.Run("no state changes should return nil", func(t *testing.T) {
+		t.Parallel()
+
+		collector, _ := NewStateChangesCollector(createMockArgsStateChangesCollector())
+
+		assert.Nil(t, collector.GetAlteredAccounts())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStateChangesCollector()
+		collector, _ := NewStateChangesCollector(args)
+		collector.SetStateChanges(createStateChanges())
+
+		alteredAccounts := collector.GetAlteredAccounts()
+		require.Len(t, alteredAccounts, 2)
+
+		alice := args.AddressPubkeyConverter.Encode([]byte("alice"))
+		assert.Equal(t, alice, alteredAccounts[alice].Address)
+		assert.Equal(t, "7", alteredAccounts[alice].Balance)
+		assert.Equal(t, uint64(2), alteredAccounts[alice].Nonce)
+
+		bob := args.AddressPubkeyConverter.Encode([]byte("bob"))
+		assert.Equal(t, "0", alteredAccounts[bob].Balance)
+		assert.Equal(t, uint64(0), alteredAccounts[bob].Nonce)
+	})
+}
@@ -0,0 +1,45 @@
+package state
+
+import (
+	"encoding/hex"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+// NewAccountStateChangeResponse converts the provided account change into its API representation
+func NewAccountStateChangeResponse(change *AccountStateChange, pubKeyConverter core.PubkeyConverter) *common.AccountStateChangeResponse {
+	response := &common.AccountStateChangeResponse{
+		Address:         pubKeyConverter.Encode(change.Address),
+		IsCreated:       change.IsCreated,
+		IsRemoved:       change.IsRemoved,
+		OldBalance:      balanceToString(change.OldBalance),
+		NewBalance:      balanceToString(change.NewBalance),
+		OldNonce:        change.OldNonce,
+		NewNonce:        change.NewNonce,
+		OldCodeHash:     hex.EncodeToString(change.OldCodeHash),
+		NewCodeHash:     hex.EncodeToString(change.NewCodeHash),
+		OldUsername:     string(change.OldUserName),
+		NewUsername:     string(change.NewUserName),
+		DataTrieChanges: make([]*common.DataTrieChangeResponse, 0, len(change.DataTrieChanges)),
+	}
+
+	for _, dataTrieChange := range change.DataTrieChanges {
+		response.DataTrieChanges = append(response.DataTrieChanges, &common.DataTrieChangeResponse{
+			Key:      hex.EncodeToString(dataTrieChange.Key),
+			OldValue: hex.EncodeToString(dataTrieChange.OldValue),
+			NewValue: hex.EncodeToString(dataTrieChange.NewValue),
+		})
+	}
+
+	return response
+}
+
+func balanceToString(balance *big.Int) string {
+	if balance == nil {
+		return "0"
+	}
+
+	return balance.String()
+}
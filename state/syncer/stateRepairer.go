@@ -0,0 +1,167 @@
+package syncer
+
+import (
+	"context"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/process/factory"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/trie"
+	"github.com/ElrondNetwork/elrond-go/trie/statistics"
+)
+
+// maxStateRepairRounds is the number of times the missing and corrupt nodes are requested before giving up. More than
+// one round is needed because the data tries of the accounts stored under broken nodes can only be checked after the
+// accounts trie is repaired
+const maxStateRepairRounds = 5
+
+// ArgsNewStateRepairer defines the arguments needed for the new state repairer
+type ArgsNewStateRepairer struct {
+	ArgsNewBaseAccountsSyncer
+	ShardId uint32
+}
+
+type stateRepairer struct {
+	hasher                    hashing.Hasher
+	marshalizer               marshal.Marshalizer
+	trieStorageManager        common.StorageManager
+	requestHandler            trie.RequestHandler
+	cacher                    storage.Cacher
+	timeout                   time.Duration
+	shardId                   uint32
+	maxTrieLevelInMemory      uint
+	maxHardCapForMissingNodes int
+	trieSyncerVersion         int
+}
+
+// NewStateRepairer creates a component able to check the integrity of the user accounts trie and all its data tries
+// and to request the missing or corrupt trie nodes from the peers
+func NewStateRepairer(args ArgsNewStateRepairer) (*stateRepairer, error) {
+	err := checkArgs(args.ArgsNewBaseAccountsSyncer)
+	if err != nil {
+		return nil, err
+	}
+
+	return &stateRepairer{
+		hasher:                    args.Hasher,
+		marshalizer:               args.Marshalizer,
+		trieStorageManager:        args.TrieStorageManager,
+		requestHandler:            args.RequestHandler,
+		cacher:                    args.Cacher,
+		timeout:                   args.Timeout,
+		shardId:                   args.ShardId,
+		maxTrieLevelInMemory:      args.MaxTrieLevelInMemory,
+		maxHardCapForMissingNodes: args.MaxHardCapForMissingNodes,
+		trieSyncerVersion:         args.TrieSyncerVersion,
+	}, nil
+}
+
+// CheckIntegrity walks the accounts trie with the provided root hash and all its data tries, reporting the missing
+// and corrupt trie nodes without repairing them
+func (sr *stateRepairer) CheckIntegrity(rootHash []byte) (*state.IntegrityReport, error) {
+	mainTrie, err := trie.NewTrie(sr.trieStorageManager, sr.marshalizer, sr.hasher, sr.maxTrieLevelInMemory)
+	if err != nil {
+		return nil, err
+	}
+
+	checker, err := state.NewAccountsIntegrityChecker(mainTrie, sr.marshalizer)
+	if err != nil {
+		return nil, err
+	}
+
+	return checker.CheckIntegrity(rootHash)
+}
+
+// RepairState checks the accounts trie with the provided root hash and all its data tries, and requests from the
+// peers the subtrees found under the missing and corrupt nodes. The check is repeated after each repair round. The
+// returned report holds the broken nodes still found after the last round - it is a blocking method
+func (sr *stateRepairer) RepairState(rootHash []byte) (*state.IntegrityReport, error) {
+	sr.trieStorageManager.EnterPruningBufferingMode()
+	defer sr.trieStorageManager.ExitPruningBufferingMode()
+
+	for round := 1; ; round++ {
+		report, err := sr.CheckIntegrity(rootHash)
+		if err != nil {
+			return nil, err
+		}
+		if report.IsIntact() || round > maxStateRepairRounds {
+			return report, nil
+		}
+
+		log.Info("repairing state",
+			"round", round,
+			"root hash", rootHash,
+			"missing nodes", len(report.MissingNodes),
+			"corrupt nodes", len(report.CorruptNodes))
+
+		err = sr.repairNodes(report)
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (sr *stateRepairer) repairNodes(report *state.IntegrityReport) error {
+	for _, hash := range report.CorruptNodes {
+		// the corrupt node might be found in an older epoch, case in which the synced node will shadow it
+		err := sr.trieStorageManager.Remove(hash)
+		if err != nil {
+			log.Debug("could not remove the corrupt trie node", "hash", hash, "error", err)
+		}
+	}
+
+	timeoutHandler, err := common.NewTimeoutHandler(sr.timeout)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer func() {
+		sr.cacher.Clear()
+		cancel()
+	}()
+
+	brokenNodes := make([][]byte, 0, len(report.MissingNodes)+len(report.CorruptNodes))
+	brokenNodes = append(brokenNodes, report.MissingNodes...)
+	brokenNodes = append(brokenNodes, report.CorruptNodes...)
+
+	tss := statistics.NewTrieSyncStatistics()
+	for _, hash := range brokenNodes {
+		timeoutHandler.ResetWatchdog()
+
+		arg := trie.ArgTrieSyncer{
+			RequestHandler:            sr.requestHandler,
+			InterceptedNodes:          sr.cacher,
+			DB:                        sr.trieStorageManager,
+			Marshalizer:               sr.marshalizer,
+			Hasher:                    sr.hasher,
+			ShardId:                   sr.shardId,
+			Topic:                     factory.AccountTrieNodesTopic,
+			TrieSyncStatistics:        tss,
+			TimeoutHandler:            timeoutHandler,
+			MaxHardCapForMissingNodes: sr.maxHardCapForMissingNodes,
+		}
+		trieSyncer, errCreate := trie.CreateTrieSyncer(arg, sr.trieSyncerVersion)
+		if errCreate != nil {
+			return errCreate
+		}
+
+		err = trieSyncer.StartSyncing(hash, ctx)
+		if err != nil {
+			return err
+		}
+
+		log.Debug("repaired trie node", "hash", hash, "num nodes received", tss.NumReceived())
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sr *stateRepairer) IsInterfaceNil() bool {
+	return sr == nil
+}
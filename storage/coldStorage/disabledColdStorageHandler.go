@@ -0,0 +1,28 @@
+package coldStorage
+
+type disabledColdStorageHandler struct {
+}
+
+// NewDisabledColdStorageHandler returns a cold storage handler which never seals persisters
+func NewDisabledColdStorageHandler() *disabledColdStorageHandler {
+	return &disabledColdStorageHandler{}
+}
+
+// IsEnabled returns false
+func (dcsh *disabledColdStorageHandler) IsEnabled() bool {
+	return false
+}
+
+// NumEpochsBeforeSealing returns 0
+func (dcsh *disabledColdStorageHandler) NumEpochsBeforeSealing() uint32 {
+	return 0
+}
+
+// SealAsync does nothing
+func (dcsh *disabledColdStorageHandler) SealAsync(_ []string) {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dcsh *disabledColdStorageHandler) IsInterfaceNil() bool {
+	return dcsh == nil
+}
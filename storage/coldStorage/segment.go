@@ -0,0 +1,218 @@
+package coldStorage
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/compression"
+)
+
+// A segment file holds the sorted key-value pairs of a sealed persister and has the following layout:
+//
+//   [block 0][block 1]...[block n-1][index][footer]
+//
+// Each block contains consecutive entries encoded as uvarint(len(key)) | key | uvarint(len(value)) | value, the whole
+// block being compressed with the codec recorded in the footer. The index holds, for each block, its last key, offset,
+// length, number of entries and the crc32 checksum of the stored (compressed) bytes. The footer has a fixed size and
+// is written last so a truncated file is detected when opened.
+
+const (
+	// SegmentFileExtension is the extension of the segment files
+	SegmentFileExtension = ".seg"
+
+	segmentVersion = byte(1)
+	footerSize     = 32
+)
+
+var segmentMagic = []byte("ERDCOLD1")
+
+var compressionTypes = []string{
+	compression.NoneCompression,
+	compression.SnappyCompression,
+	compression.ZstdCompression,
+}
+
+type blockHandle struct {
+	lastKey    []byte
+	offset     uint64
+	length     uint64
+	numEntries uint64
+	checksum   uint32
+}
+
+type footer struct {
+	indexOffset     uint64
+	indexLength     uint64
+	indexChecksum   uint32
+	compressionType byte
+	version         byte
+}
+
+func compressionTypeToByte(compressionType string) (byte, error) {
+	for i, ct := range compressionTypes {
+		if ct == compressionType {
+			return byte(i), nil
+		}
+	}
+
+	return 0, fmt.Errorf("%w: %s", storage.ErrNotSupportedCompressionType, compressionType)
+}
+
+func compressionTypeFromByte(b byte) (string, error) {
+	if int(b) >= len(compressionTypes) {
+		return "", fmt.Errorf("%w: unknown compression type %d", storage.ErrInvalidSegmentFile, b)
+	}
+
+	return compressionTypes[b], nil
+}
+
+func (f *footer) encode() []byte {
+	buff := make([]byte, footerSize)
+	binary.BigEndian.PutUint64(buff[0:8], f.indexOffset)
+	binary.BigEndian.PutUint64(buff[8:16], f.indexLength)
+	binary.BigEndian.PutUint32(buff[16:20], f.indexChecksum)
+	buff[20] = f.compressionType
+	buff[21] = f.version
+	copy(buff[footerSize-len(segmentMagic):], segmentMagic)
+
+	return buff
+}
+
+func decodeFooter(buff []byte) (*footer, error) {
+	if len(buff) != footerSize || !bytes.Equal(buff[footerSize-len(segmentMagic):], segmentMagic) {
+		return nil, fmt.Errorf("%w: missing footer", storage.ErrInvalidSegmentFile)
+	}
+
+	f := &footer{
+		indexOffset:     binary.BigEndian.Uint64(buff[0:8]),
+		indexLength:     binary.BigEndian.Uint64(buff[8:16]),
+		indexChecksum:   binary.BigEndian.Uint32(buff[16:20]),
+		compressionType: buff[20],
+		version:         buff[21],
+	}
+	if f.version != segmentVersion {
+		return nil, fmt.Errorf("%w: unknown version %d", storage.ErrInvalidSegmentFile, f.version)
+	}
+
+	return f, nil
+}
+
+func encodeIndex(handles []*blockHandle) []byte {
+	buff := make([]byte, 0)
+	buff = appendUvarint(buff, uint64(len(handles)))
+	for _, handle := range handles {
+		buff = appendBytes(buff, handle.lastKey)
+		buff = appendUvarint(buff, handle.offset)
+		buff = appendUvarint(buff, handle.length)
+		buff = appendUvarint(buff, handle.numEntries)
+		buff = append(buff, 0, 0, 0, 0)
+		binary.BigEndian.PutUint32(buff[len(buff)-4:], handle.checksum)
+	}
+
+	return buff
+}
+
+func decodeIndex(buff []byte) ([]*blockHandle, error) {
+	reader := &bufferReader{buff: buff}
+	numBlocks, err := reader.readUvarint()
+	if err != nil {
+		return nil, err
+	}
+	if numBlocks > uint64(len(buff)) {
+		return nil, fmt.Errorf("%w: invalid number of blocks", storage.ErrInvalidSegmentFile)
+	}
+
+	handles := make([]*blockHandle, 0, numBlocks)
+	for i := uint64(0); i < numBlocks; i++ {
+		handle := &blockHandle{}
+		handle.lastKey, err = reader.readBytes()
+		if err != nil {
+			return nil, err
+		}
+		handle.offset, err = reader.readUvarint()
+		if err != nil {
+			return nil, err
+		}
+		handle.length, err = reader.readUvarint()
+		if err != nil {
+			return nil, err
+		}
+		handle.numEntries, err = reader.readUvarint()
+		if err != nil {
+			return nil, err
+		}
+		handle.checksum, err = reader.readUint32()
+		if err != nil {
+			return nil, err
+		}
+
+		handles = append(handles, handle)
+	}
+
+	return handles, nil
+}
+
+func computeChecksum(data []byte) uint32 {
+	return crc32.ChecksumIEEE(data)
+}
+
+func appendUvarint(buff []byte, value uint64) []byte {
+	var tmp [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(tmp[:], value)
+
+	return append(buff, tmp[:n]...)
+}
+
+func appendBytes(buff []byte, data []byte) []byte {
+	buff = appendUvarint(buff, uint64(len(data)))
+
+	return append(buff, data...)
+}
+
+type bufferReader struct {
+	buff   []byte
+	offset int
+}
+
+func (br *bufferReader) hasData() bool {
+	return br.offset < len(br.buff)
+}
+
+func (br *bufferReader) readUvarint() (uint64, error) {
+	value, n := binary.Uvarint(br.buff[br.offset:])
+	if n <= 0 {
+		return 0, fmt.Errorf("%w: malformed varint", storage.ErrInvalidSegmentFile)
+	}
+	br.offset += n
+
+	return value, nil
+}
+
+func (br *bufferReader) readBytes() ([]byte, error) {
+	length, err := br.readUvarint()
+	if err != nil {
+		return nil, err
+	}
+	if length > uint64(len(br.buff)-br.offset) {
+		return nil, fmt.Errorf("%w: data out of bounds", storage.ErrInvalidSegmentFile)
+	}
+
+	data := br.buff[br.offset : br.offset+int(length)]
+	br.offset += int(length)
+
+	return data, nil
+}
+
+func (br *bufferReader) readUint32() (uint32, error) {
+	if len(br.buff)-br.offset < 4 {
+		return 0, fmt.Errorf("%w: data out of bounds", storage.ErrInvalidSegmentFile)
+	}
+
+	value := binary.BigEndian.Uint32(br.buff[br.offset:])
+	br.offset += 4
+
+	return value, nil
+}
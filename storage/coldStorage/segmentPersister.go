@@ -0,0 +1,295 @@
+package coldStorage
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/compression"
+	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
+)
+
+var _ storage.Persister = (*segmentPersister)(nil)
+
+// segmentPersister is a read only persister serving the data of a segment file
+type segmentPersister struct {
+	mutFile     sync.RWMutex
+	file        *os.File
+	path        string
+	handles     []*blockHandle
+	codec       compression.BlockCodec
+	blocksCache storage.Cacher
+	numEntries  uint64
+}
+
+// NewSegmentPersister opens the segment file found at the provided path. The index of the segment is kept in memory
+// while the last numCachedBlocks decompressed blocks are cached
+func NewSegmentPersister(path string, numCachedBlocks int) (*segmentPersister, error) {
+	blocksCache, err := lrucache.NewCache(numCachedBlocks)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the number of cached blocks: %s", storage.ErrInvalidColdStorageConfig, err.Error())
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	sp := &segmentPersister{
+		file:        file,
+		path:        path,
+		blocksCache: blocksCache,
+	}
+	err = sp.loadIndex()
+	if err != nil {
+		_ = file.Close()
+		return nil, fmt.Errorf("%w, path %s", err, path)
+	}
+
+	return sp, nil
+}
+
+func (sp *segmentPersister) loadIndex() error {
+	fileInfo, err := sp.file.Stat()
+	if err != nil {
+		return err
+	}
+	size := uint64(fileInfo.Size())
+	if size < footerSize {
+		return fmt.Errorf("%w: file too small", storage.ErrInvalidSegmentFile)
+	}
+
+	footerBuff := make([]byte, footerSize)
+	_, err = sp.file.ReadAt(footerBuff, int64(size-footerSize))
+	if err != nil {
+		return err
+	}
+	f, err := decodeFooter(footerBuff)
+	if err != nil {
+		return err
+	}
+	if f.indexOffset+f.indexLength+footerSize != size {
+		return fmt.Errorf("%w: index out of bounds", storage.ErrInvalidSegmentFile)
+	}
+
+	indexBuff := make([]byte, f.indexLength)
+	_, err = sp.file.ReadAt(indexBuff, int64(f.indexOffset))
+	if err != nil {
+		return err
+	}
+	if computeChecksum(indexBuff) != f.indexChecksum {
+		return fmt.Errorf("%w: index checksum mismatch", storage.ErrInvalidSegmentFile)
+	}
+	sp.handles, err = decodeIndex(indexBuff)
+	if err != nil {
+		return err
+	}
+
+	for _, handle := range sp.handles {
+		if handle.offset+handle.length > f.indexOffset {
+			return fmt.Errorf("%w: block out of bounds", storage.ErrInvalidSegmentFile)
+		}
+		sp.numEntries += handle.numEntries
+	}
+
+	compressionType, err := compressionTypeFromByte(f.compressionType)
+	if err != nil {
+		return err
+	}
+	sp.codec, err = compression.NewBlockCodec(compressionType, 0)
+
+	return err
+}
+
+// Put returns an error as a segment file can not be modified
+func (sp *segmentPersister) Put(_, _ []byte) error {
+	return storage.ErrReadOnlyPersister
+}
+
+// Get returns the value associated to the key
+func (sp *segmentPersister) Get(key []byte) ([]byte, error) {
+	sp.mutFile.RLock()
+	defer sp.mutFile.RUnlock()
+
+	if sp.file == nil {
+		return nil, storage.ErrDBIsClosed
+	}
+
+	idx := sort.Search(len(sp.handles), func(i int) bool {
+		return bytes.Compare(sp.handles[i].lastKey, key) >= 0
+	})
+	if idx == len(sp.handles) {
+		return nil, storage.ErrKeyNotFound
+	}
+
+	block, err := sp.getBlock(idx)
+	if err != nil {
+		return nil, err
+	}
+
+	var value []byte
+	found := false
+	err = iterateBlock(block, func(k []byte, v []byte) bool {
+		cmp := bytes.Compare(k, key)
+		if cmp == 0 {
+			value = make([]byte, len(v))
+			copy(value, v)
+			found = true
+		}
+
+		return cmp < 0
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, storage.ErrKeyNotFound
+	}
+
+	return value, nil
+}
+
+// Has returns nil if the given key is present in the segment
+func (sp *segmentPersister) Has(key []byte) error {
+	_, err := sp.Get(key)
+
+	return err
+}
+
+// Remove returns an error as a segment file can not be modified
+func (sp *segmentPersister) Remove(_ []byte) error {
+	return storage.ErrReadOnlyPersister
+}
+
+// RangeKeys iterates over all the stored key-value pairs, in the keys' order
+func (sp *segmentPersister) RangeKeys(handler func(key []byte, val []byte) bool) {
+	if handler == nil {
+		return
+	}
+
+	sp.mutFile.RLock()
+	defer sp.mutFile.RUnlock()
+
+	if sp.file == nil {
+		return
+	}
+
+	for idx := range sp.handles {
+		block, err := sp.readBlock(idx)
+		if err != nil {
+			log.Warn("segmentPersister.RangeKeys", "path", sp.path, "block", idx, "error", err.Error())
+			return
+		}
+
+		shouldContinue := true
+		err = iterateBlock(block, func(key []byte, val []byte) bool {
+			shouldContinue = handler(key, val)
+			return shouldContinue
+		})
+		if err != nil {
+			log.Warn("segmentPersister.RangeKeys", "path", sp.path, "block", idx, "error", err.Error())
+			return
+		}
+		if !shouldContinue {
+			return
+		}
+	}
+}
+
+func (sp *segmentPersister) getBlock(idx int) ([]byte, error) {
+	cacheKey := []byte(strconv.Itoa(idx))
+	cached, ok := sp.blocksCache.Get(cacheKey)
+	if ok {
+		block, isBlock := cached.([]byte)
+		if isBlock {
+			return block, nil
+		}
+	}
+
+	block, err := sp.readBlock(idx)
+	if err != nil {
+		return nil, err
+	}
+	sp.blocksCache.Put(cacheKey, block, len(block))
+
+	return block, nil
+}
+
+func (sp *segmentPersister) readBlock(idx int) ([]byte, error) {
+	handle := sp.handles[idx]
+	compressed := make([]byte, handle.length)
+	_, err := sp.file.ReadAt(compressed, int64(handle.offset))
+	if err != nil {
+		return nil, err
+	}
+	if computeChecksum(compressed) != handle.checksum {
+		return nil, fmt.Errorf("%w: checksum mismatch for block %d", storage.ErrInvalidSegmentFile, idx)
+	}
+
+	return sp.codec.Decompress(compressed)
+}
+
+func iterateBlock(block []byte, handler func(key []byte, val []byte) bool) error {
+	reader := &bufferReader{buff: block}
+	for reader.hasData() {
+		key, err := reader.readBytes()
+		if err != nil {
+			return err
+		}
+		val, err := reader.readBytes()
+		if err != nil {
+			return err
+		}
+
+		if !handler(key, val) {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// NumEntries returns the number of key-value pairs stored in the segment
+func (sp *segmentPersister) NumEntries() uint64 {
+	return sp.numEntries
+}
+
+// Close closes the segment file
+func (sp *segmentPersister) Close() error {
+	sp.mutFile.Lock()
+	defer sp.mutFile.Unlock()
+
+	if sp.file == nil {
+		return nil
+	}
+
+	err := sp.file.Close()
+	sp.file = nil
+	sp.blocksCache.Clear()
+
+	return err
+}
+
+// Destroy closes and removes the segment file
+func (sp *segmentPersister) Destroy() error {
+	err := sp.Close()
+	if err != nil {
+		return err
+	}
+
+	return sp.DestroyClosed()
+}
+
+// DestroyClosed removes the already closed segment file
+func (sp *segmentPersister) DestroyClosed() error {
+	return os.RemoveAll(sp.path)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sp *segmentPersister) IsInterfaceNil() bool {
+	return sp == nil
+}
@@ -0,0 +1,245 @@
+package coldStorage
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/compression"
+	"github.com/ElrondNetwork/elrond-go/storage/leveldb"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/ElrondNetwork/elrond-go/storage/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createSourcePersister(t *testing.T, numEntries int) storage.Persister {
+	source, err := leveldb.NewSerialDB(filepath.Join(t.TempDir(), "source"), 1, 1, 10)
+	require.Nil(t, err)
+	t.Cleanup(func() {
+		_ = source.Close()
+	})
+	for i := 0; i < numEntries; i++ {
+		_ = source.Put([]byte(fmt.Sprintf("key%05d", i)), []byte(fmt.Sprintf("value of the key %d", i)))
+	}
+
+	return source
+}
+
+func writeTestSegment(t *testing.T, source storage.Persister, compressionType string) string {
+	path := filepath.Join(t.TempDir(), "unit"+SegmentFileExtension)
+	numEntries, err := WriteSegment(ArgsWriteSegment{
+		Source:           source,
+		Path:             path,
+		CompressionType:  compressionType,
+		CompressionLevel: 3,
+		BlockSizeInBytes: 256,
+	})
+	require.Nil(t, err)
+
+	numSourceEntries := uint64(0)
+	source.RangeKeys(func(_ []byte, _ []byte) bool {
+		numSourceEntries++
+		return true
+	})
+	require.Equal(t, numSourceEntries, numEntries)
+
+	return path
+}
+
+func TestWriteSegment_InvalidArgsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := ArgsWriteSegment{
+		Source:           memorydb.New(),
+		Path:             filepath.Join(t.TempDir(), "unit"+SegmentFileExtension),
+		CompressionType:  compression.ZstdCompression,
+		BlockSizeInBytes: 256,
+	}
+
+	argsCopy := args
+	argsCopy.Source = nil
+	_, err := WriteSegment(argsCopy)
+	assert.Equal(t, storage.ErrNilPersister, err)
+
+	argsCopy = args
+	argsCopy.Path = ""
+	_, err = WriteSegment(argsCopy)
+	assert.True(t, errors.Is(err, storage.ErrInvalidColdStorageConfig))
+
+	argsCopy = args
+	argsCopy.BlockSizeInBytes = 0
+	_, err = WriteSegment(argsCopy)
+	assert.True(t, errors.Is(err, storage.ErrInvalidColdStorageConfig))
+
+	argsCopy = args
+	argsCopy.CompressionType = "Gzip"
+	_, err = WriteSegment(argsCopy)
+	assert.True(t, errors.Is(err, storage.ErrNotSupportedCompressionType))
+}
+
+func TestWriteSegment_UnorderedKeysShouldErr(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "unit"+SegmentFileExtension)
+	numEntries, err := WriteSegment(ArgsWriteSegment{
+		Source: &mock.PersisterStub{
+			RangeKeysCalled: func(handler func(key []byte, val []byte) bool) {
+				for _, key := range []string{"key1", "key3", "key2"} {
+					if !handler([]byte(key), []byte("value")) {
+						return
+					}
+				}
+			},
+		},
+		Path:             path,
+		CompressionType:  compression.ZstdCompression,
+		BlockSizeInBytes: 256,
+	})
+	assert.True(t, errors.Is(err, storage.ErrUnorderedSegmentKeys))
+	assert.Equal(t, uint64(0), numEntries)
+	_, err = os.Stat(path)
+	assert.True(t, os.IsNotExist(err))
+	_, err = os.Stat(path + temporaryFileExtension)
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestSegmentPersister_GetAndRangeKeys(t *testing.T) {
+	t.Parallel()
+
+	numEntries := 500
+	for _, compressionType := range compressionTypes {
+		compressionType := compressionType
+		t.Run(compressionType, func(t *testing.T) {
+			t.Parallel()
+
+			path := writeTestSegment(t, createSourcePersister(t, numEntries), compressionType)
+			sp, err := NewSegmentPersister(path, 2)
+			require.Nil(t, err)
+			assert.Equal(t, uint64(numEntries), sp.NumEntries())
+			assert.True(t, len(sp.handles) > 1)
+
+			for i := 0; i < numEntries; i++ {
+				key := []byte(fmt.Sprintf("key%05d", i))
+				val, errGet := sp.Get(key)
+				assert.Nil(t, errGet)
+				assert.Equal(t, []byte(fmt.Sprintf("value of the key %d", i)), val)
+				assert.Nil(t, sp.Has(key))
+			}
+
+			_, err = sp.Get([]byte("key"))
+			assert.Equal(t, storage.ErrKeyNotFound, err)
+			_, err = sp.Get([]byte("missing key"))
+			assert.Equal(t, storage.ErrKeyNotFound, err)
+			assert.Equal(t, storage.ErrKeyNotFound, sp.Has([]byte("key00001a")))
+
+			var previousKey []byte
+			numIterated := 0
+			sp.RangeKeys(func(key []byte, val []byte) bool {
+				assert.True(t, string(previousKey) < string(key))
+				previousKey = append([]byte{}, key...)
+				numIterated++
+				return numIterated < 100
+			})
+			assert.Equal(t, 100, numIterated)
+
+			assert.Nil(t, sp.Close())
+		})
+	}
+}
+
+func TestSegmentPersister_EmptySegment(t *testing.T) {
+	t.Parallel()
+
+	path := writeTestSegment(t, memorydb.New(), compression.ZstdCompression)
+	sp, err := NewSegmentPersister(path, 1)
+	require.Nil(t, err)
+
+	assert.Equal(t, uint64(0), sp.NumEntries())
+	_, err = sp.Get([]byte("key"))
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+	sp.RangeKeys(func(_ []byte, _ []byte) bool {
+		assert.Fail(t, "should not have been called")
+		return true
+	})
+}
+
+func TestSegmentPersister_ShouldBeReadOnly(t *testing.T) {
+	t.Parallel()
+
+	path := writeTestSegment(t, createSourcePersister(t, 10), compression.SnappyCompression)
+	sp, _ := NewSegmentPersister(path, 1)
+
+	assert.Equal(t, storage.ErrReadOnlyPersister, sp.Put([]byte("key"), []byte("value")))
+	assert.Equal(t, storage.ErrReadOnlyPersister, sp.Remove([]byte("key00001")))
+	assert.Nil(t, sp.Has([]byte("key00001")))
+}
+
+func TestSegmentPersister_CloseAndDestroy(t *testing.T) {
+	t.Parallel()
+
+	path := writeTestSegment(t, createSourcePersister(t, 10), compression.ZstdCompression)
+	sp, _ := NewSegmentPersister(path, 1)
+
+	assert.Nil(t, sp.Close())
+	assert.Nil(t, sp.Close())
+	_, err := sp.Get([]byte("key00001"))
+	assert.Equal(t, storage.ErrDBIsClosed, err)
+
+	assert.Nil(t, sp.DestroyClosed())
+	assert.False(t, fileExists(path))
+}
+
+func TestNewSegmentPersister_CorruptedFilesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid number of cached blocks", func(t *testing.T) {
+		t.Parallel()
+
+		path := writeTestSegment(t, createSourcePersister(t, 10), compression.ZstdCompression)
+		sp, err := NewSegmentPersister(path, 0)
+		assert.Nil(t, sp)
+		assert.True(t, errors.Is(err, storage.ErrInvalidColdStorageConfig))
+	})
+	t.Run("truncated file", func(t *testing.T) {
+		t.Parallel()
+
+		path := writeTestSegment(t, createSourcePersister(t, 10), compression.ZstdCompression)
+		data, _ := ioutil.ReadFile(path)
+		_ = ioutil.WriteFile(path, data[:len(data)-1], 0644)
+
+		sp, err := NewSegmentPersister(path, 1)
+		assert.Nil(t, sp)
+		assert.True(t, errors.Is(err, storage.ErrInvalidSegmentFile))
+	})
+	t.Run("corrupted index", func(t *testing.T) {
+		t.Parallel()
+
+		path := writeTestSegment(t, createSourcePersister(t, 10), compression.ZstdCompression)
+		data, _ := ioutil.ReadFile(path)
+		data[len(data)-footerSize-1] ^= 0xff
+		_ = ioutil.WriteFile(path, data, 0644)
+
+		sp, err := NewSegmentPersister(path, 1)
+		assert.Nil(t, sp)
+		assert.True(t, errors.Is(err, storage.ErrInvalidSegmentFile))
+	})
+	t.Run("corrupted block", func(t *testing.T) {
+		t.Parallel()
+
+		path := writeTestSegment(t, createSourcePersister(t, 10), compression.NoneCompression)
+		data, _ := ioutil.ReadFile(path)
+		data[0] ^= 0xff
+		_ = ioutil.WriteFile(path, data, 0644)
+
+		sp, err := NewSegmentPersister(path, 1)
+		require.Nil(t, err)
+
+		_, err = sp.Get([]byte("key00001"))
+		assert.True(t, errors.Is(err, storage.ErrInvalidSegmentFile))
+	})
+}
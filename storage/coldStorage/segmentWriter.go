@@ -0,0 +1,172 @@
+package coldStorage
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/compression"
+)
+
+const temporaryFileExtension = ".tmp"
+
+// ArgsWriteSegment holds the arguments needed to write a segment file
+type ArgsWriteSegment struct {
+	Source           storage.Persister
+	Path             string
+	CompressionType  string
+	CompressionLevel int
+	BlockSizeInBytes int
+}
+
+type segmentWriter struct {
+	file       *os.File
+	writer     *bufio.Writer
+	codec      compression.BlockCodec
+	offset     uint64
+	handles    []*blockHandle
+	block      []byte
+	lastKey    []byte
+	entries    uint64
+	numEntries uint64
+}
+
+// WriteSegment writes all the key-value pairs of the source persister into a new segment file. The pairs are
+// streamed in the order the source iterates them, which must be the ascending keys order, as for the leveldb and
+// pebble persisters. The file is first written under a temporary name and renamed only after it was completely
+// written and synced, so a segment file is either complete or missing. Returns the number of written entries
+func WriteSegment(args ArgsWriteSegment) (uint64, error) {
+	if check.IfNil(args.Source) {
+		return 0, storage.ErrNilPersister
+	}
+	if len(args.Path) == 0 {
+		return 0, fmt.Errorf("%w: empty segment path", storage.ErrInvalidColdStorageConfig)
+	}
+	if args.BlockSizeInBytes < 1 {
+		return 0, fmt.Errorf("%w: block size should be positive", storage.ErrInvalidColdStorageConfig)
+	}
+	compressionType, err := compressionTypeToByte(args.CompressionType)
+	if err != nil {
+		return 0, err
+	}
+	codec, err := compression.NewBlockCodec(args.CompressionType, args.CompressionLevel)
+	if err != nil {
+		return 0, err
+	}
+
+	err = os.MkdirAll(filepath.Dir(args.Path), os.ModePerm)
+	if err != nil {
+		return 0, err
+	}
+
+	temporaryPath := args.Path + temporaryFileExtension
+	file, err := os.Create(temporaryPath)
+	if err != nil {
+		return 0, err
+	}
+
+	sw := &segmentWriter{
+		file:    file,
+		writer:  bufio.NewWriter(file),
+		codec:   codec,
+		handles: make([]*blockHandle, 0),
+	}
+	numEntries, err := sw.write(args.Source, args.BlockSizeInBytes, compressionType)
+	errClose := file.Close()
+	if err == nil {
+		err = errClose
+	}
+	if err != nil {
+		_ = os.Remove(temporaryPath)
+		return 0, err
+	}
+
+	return numEntries, os.Rename(temporaryPath, args.Path)
+}
+
+func (sw *segmentWriter) write(source storage.Persister, blockSize int, compressionType byte) (uint64, error) {
+	var err error
+	source.RangeKeys(func(key []byte, value []byte) bool {
+		err = sw.addEntry(key, value, blockSize)
+		return err == nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	err = sw.flushBlock()
+	if err != nil {
+		return 0, err
+	}
+
+	index := encodeIndex(sw.handles)
+	f := &footer{
+		indexOffset:     sw.offset,
+		indexLength:     uint64(len(index)),
+		indexChecksum:   computeChecksum(index),
+		compressionType: compressionType,
+		version:         segmentVersion,
+	}
+	_, err = sw.writer.Write(index)
+	if err != nil {
+		return 0, err
+	}
+	_, err = sw.writer.Write(f.encode())
+	if err != nil {
+		return 0, err
+	}
+	err = sw.writer.Flush()
+	if err != nil {
+		return 0, err
+	}
+
+	return sw.numEntries, sw.file.Sync()
+}
+
+func (sw *segmentWriter) addEntry(key []byte, value []byte, blockSize int) error {
+	if sw.numEntries > 0 && bytes.Compare(key, sw.lastKey) <= 0 {
+		return fmt.Errorf("%w: key %x iterated after key %x", storage.ErrUnorderedSegmentKeys, key, sw.lastKey)
+	}
+
+	sw.block = appendBytes(sw.block, key)
+	sw.block = appendBytes(sw.block, value)
+	// the iterated key buffer might be reused by the source
+	sw.lastKey = append(sw.lastKey[:0], key...)
+	sw.entries++
+	sw.numEntries++
+
+	if len(sw.block) >= blockSize {
+		return sw.flushBlock()
+	}
+
+	return nil
+}
+
+func (sw *segmentWriter) flushBlock() error {
+	if sw.entries == 0 {
+		return nil
+	}
+
+	compressed := sw.codec.Compress(sw.block)
+	_, err := sw.writer.Write(compressed)
+	if err != nil {
+		return err
+	}
+
+	sw.handles = append(sw.handles, &blockHandle{
+		lastKey:    append([]byte(nil), sw.lastKey...),
+		offset:     sw.offset,
+		length:     uint64(len(compressed)),
+		numEntries: sw.entries,
+		checksum:   computeChecksum(compressed),
+	})
+	sw.offset += uint64(len(compressed))
+	sw.block = sw.block[:0]
+	sw.entries = 0
+
+	return nil
+}
@@ -0,0 +1,227 @@
+package coldStorage
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/atomic"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var log = logger.GetOrCreate("storage/coldStorage")
+
+// ArgsTieredPersisterFactory holds the arguments needed to create a tiered persister factory
+type ArgsTieredPersisterFactory struct {
+	PersisterFactory DbFactoryHandler
+	DatabasePath     string
+	Config           config.ColdStorageConfig
+}
+
+// tieredPersisterFactory creates the persisters of a storage unit, opening the sealed segment file of a persister
+// whenever one exists instead of the original (hot) database. It is also able to seal old persisters into
+// segment files, possibly placed in a different location
+type tieredPersisterFactory struct {
+	persisterFactory DbFactoryHandler
+	databasePath     string
+	config           config.ColdStorageConfig
+
+	mutSealing  sync.Mutex
+	sealingDone *sync.Cond
+	sealingPath string
+	isSealing   atomic.Flag
+}
+
+// NewTieredPersisterFactory creates a new tiered persister factory
+func NewTieredPersisterFactory(args ArgsTieredPersisterFactory) (*tieredPersisterFactory, error) {
+	if check.IfNil(args.PersisterFactory) {
+		return nil, storage.ErrNilPersisterFactory
+	}
+	err := CheckConfig(args.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	tpf := &tieredPersisterFactory{
+		persisterFactory: args.PersisterFactory,
+		databasePath:     args.DatabasePath,
+		config:           args.Config,
+	}
+	tpf.sealingDone = sync.NewCond(&tpf.mutSealing)
+
+	return tpf, nil
+}
+
+// CheckConfig verifies the cold storage configuration
+func CheckConfig(cfg config.ColdStorageConfig) error {
+	if cfg.NumEpochsBeforeSealing < 1 {
+		return fmt.Errorf("%w: NumEpochsBeforeSealing should be at least 1", storage.ErrInvalidColdStorageConfig)
+	}
+	if cfg.BlockSizeInBytes < 1 {
+		return fmt.Errorf("%w: BlockSizeInBytes should be positive", storage.ErrInvalidColdStorageConfig)
+	}
+	if cfg.NumCachedBlocks < 1 {
+		return fmt.Errorf("%w: NumCachedBlocks should be positive", storage.ErrInvalidColdStorageConfig)
+	}
+	_, err := compressionTypeToByte(cfg.CompressionType)
+
+	return err
+}
+
+// Create opens the persister found at the provided path. If the persister was sealed, the segment file is opened
+// instead, in read only mode. If the persister is being sealed, the call blocks until the sealing completes
+func (tpf *tieredPersisterFactory) Create(path string) (storage.Persister, error) {
+	tpf.mutSealing.Lock()
+	defer tpf.mutSealing.Unlock()
+
+	for tpf.sealingPath == path {
+		tpf.sealingDone.Wait()
+	}
+
+	segmentPath := tpf.segmentPath(path)
+	if fileExists(segmentPath) {
+		return NewSegmentPersister(segmentPath, tpf.config.NumCachedBlocks)
+	}
+
+	return tpf.persisterFactory.Create(path)
+}
+
+// CreateDisabled will return a new disabled persister
+func (tpf *tieredPersisterFactory) CreateDisabled() storage.Persister {
+	return tpf.persisterFactory.CreateDisabled()
+}
+
+// IsEnabled returns true as the tiered persister factory is only created when the cold storage is enabled
+func (tpf *tieredPersisterFactory) IsEnabled() bool {
+	return true
+}
+
+// NumEpochsBeforeSealing returns the number of epochs after which a persister can be sealed
+func (tpf *tieredPersisterFactory) NumEpochsBeforeSealing() uint32 {
+	return tpf.config.NumEpochsBeforeSealing
+}
+
+// SealAsync seals the persisters found at the provided paths on a separate go routine. The persisters should be
+// closed by the caller. The call is ignored if a previous sealing is still in progress, the remaining
+// persisters being sealed on a later call
+func (tpf *tieredPersisterFactory) SealAsync(paths []string) {
+	if len(paths) == 0 {
+		return
+	}
+	if tpf.isSealing.SetReturningPrevious() {
+		log.Debug("tieredPersisterFactory.SealAsync: sealing already in progress", "num paths", len(paths))
+		return
+	}
+
+	go func() {
+		defer tpf.isSealing.Reset()
+
+		for _, path := range paths {
+			err := tpf.seal(path)
+			if err != nil {
+				log.Warn("tieredPersisterFactory: can not seal persister", "path", path, "error", err.Error())
+			}
+		}
+	}()
+}
+
+func (tpf *tieredPersisterFactory) seal(path string) error {
+	segmentPath := tpf.segmentPath(path)
+	if fileExists(segmentPath) || !directoryExists(path) {
+		return nil
+	}
+
+	tpf.setSealingPath(path)
+	defer tpf.setSealingPath("")
+
+	// opening the database fails if it was reopened by the storer meanwhile, in which case the sealing is retried later
+	source, err := tpf.persisterFactory.Create(path)
+	if err != nil {
+		return err
+	}
+
+	numEntries, err := WriteSegment(ArgsWriteSegment{
+		Source:           source,
+		Path:             segmentPath,
+		CompressionType:  tpf.config.CompressionType,
+		CompressionLevel: tpf.config.CompressionLevel,
+		BlockSizeInBytes: tpf.config.BlockSizeInBytes,
+	})
+	errClose := source.Close()
+	if err != nil {
+		return err
+	}
+	if errClose != nil {
+		_ = os.Remove(segmentPath)
+		return errClose
+	}
+
+	err = verifySegment(segmentPath, numEntries)
+	if err != nil {
+		_ = os.Remove(segmentPath)
+		return err
+	}
+
+	log.Debug("persister sealed", "path", path, "segment", segmentPath, "num entries", numEntries)
+
+	return os.RemoveAll(path)
+}
+
+func (tpf *tieredPersisterFactory) setSealingPath(path string) {
+	tpf.mutSealing.Lock()
+	tpf.sealingPath = path
+	tpf.sealingDone.Broadcast()
+	tpf.mutSealing.Unlock()
+}
+
+// segmentPath returns the path of the segment file corresponding to the provided persister path. The segment files
+// are placed in the configured cold storage path, keeping the directories structure relative to the database path
+func (tpf *tieredPersisterFactory) segmentPath(path string) string {
+	if len(tpf.config.Path) == 0 {
+		return path + SegmentFileExtension
+	}
+
+	relativePath, err := filepath.Rel(tpf.databasePath, path)
+	if err != nil || strings.HasPrefix(relativePath, "..") {
+		relativePath = filepath.Base(path)
+	}
+
+	return filepath.Join(tpf.config.Path, relativePath) + SegmentFileExtension
+}
+
+func verifySegment(segmentPath string, expectedNumEntries uint64) error {
+	segment, err := NewSegmentPersister(segmentPath, 1)
+	if err != nil {
+		return err
+	}
+	numEntries := segment.NumEntries()
+	_ = segment.Close()
+
+	if numEntries != expectedNumEntries {
+		return fmt.Errorf("%w: expected %d entries, found %d", storage.ErrInvalidSegmentFile, expectedNumEntries, numEntries)
+	}
+
+	return nil
+}
+
+func fileExists(path string) bool {
+	info, err := os.Stat(path)
+
+	return err == nil && !info.IsDir()
+}
+
+func directoryExists(path string) bool {
+	info, err := os.Stat(path)
+
+	return err == nil && info.IsDir()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tpf *tieredPersisterFactory) IsInterfaceNil() bool {
+	return tpf == nil
+}
@@ -0,0 +1,223 @@
+package coldStorage
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/compression"
+	"github.com/ElrondNetwork/elrond-go/storage/leveldb"
+	"github.com/ElrondNetwork/elrond-go/storage/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createColdStorageConfig() config.ColdStorageConfig {
+	return config.ColdStorageConfig{
+		Enabled:                true,
+		NumEpochsBeforeSealing: 2,
+		BlockSizeInBytes:       1024,
+		CompressionType:        compression.ZstdCompression,
+		CompressionLevel:       3,
+		NumCachedBlocks:        4,
+	}
+}
+
+func createMockArgsTieredPersisterFactory() ArgsTieredPersisterFactory {
+	return ArgsTieredPersisterFactory{
+		PersisterFactory: &mock.PersisterFactoryStub{
+			CreateCalled: func(path string) (storage.Persister, error) {
+				return leveldb.NewSerialDB(path, 1, 100, 10)
+			},
+		},
+		Config: createColdStorageConfig(),
+	}
+}
+
+func createHotPersister(t *testing.T, path string, numEntries int) {
+	db, err := leveldb.NewSerialDB(path, 1, 100, 10)
+	require.Nil(t, err)
+	for i := 0; i < numEntries; i++ {
+		_ = db.Put([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i)))
+	}
+	require.Nil(t, db.Close())
+}
+
+func TestNewTieredPersisterFactory(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil persister factory should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTieredPersisterFactory()
+		args.PersisterFactory = nil
+		tpf, err := NewTieredPersisterFactory(args)
+		assert.Nil(t, tpf)
+		assert.Equal(t, storage.ErrNilPersisterFactory, err)
+	})
+	t.Run("invalid config should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTieredPersisterFactory()
+		args.Config.BlockSizeInBytes = 0
+		tpf, err := NewTieredPersisterFactory(args)
+		assert.Nil(t, tpf)
+		assert.True(t, errors.Is(err, storage.ErrInvalidColdStorageConfig))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		tpf, err := NewTieredPersisterFactory(createMockArgsTieredPersisterFactory())
+		assert.Nil(t, err)
+		assert.False(t, tpf.IsInterfaceNil())
+		assert.True(t, tpf.IsEnabled())
+		assert.Equal(t, uint32(2), tpf.NumEpochsBeforeSealing())
+	})
+}
+
+func TestCheckConfig(t *testing.T) {
+	t.Parallel()
+
+	cfg := createColdStorageConfig()
+	assert.Nil(t, CheckConfig(cfg))
+
+	cfg = createColdStorageConfig()
+	cfg.NumEpochsBeforeSealing = 0
+	assert.True(t, errors.Is(CheckConfig(cfg), storage.ErrInvalidColdStorageConfig))
+
+	cfg = createColdStorageConfig()
+	cfg.NumCachedBlocks = 0
+	assert.True(t, errors.Is(CheckConfig(cfg), storage.ErrInvalidColdStorageConfig))
+
+	cfg = createColdStorageConfig()
+	cfg.CompressionType = "Gzip"
+	assert.True(t, errors.Is(CheckConfig(cfg), storage.ErrNotSupportedCompressionType))
+}
+
+func TestTieredPersisterFactory_SegmentPath(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsTieredPersisterFactory()
+	args.DatabasePath = filepath.Join("db", "chain")
+	tpf, _ := NewTieredPersisterFactory(args)
+
+	hotPath := filepath.Join("db", "chain", "Epoch_1", "Shard_0", "BlockHeaders")
+	assert.Equal(t, hotPath+SegmentFileExtension, tpf.segmentPath(hotPath))
+
+	tpf.config.Path = "cold"
+	expectedPath := filepath.Join("cold", "Epoch_1", "Shard_0", "BlockHeaders") + SegmentFileExtension
+	assert.Equal(t, expectedPath, tpf.segmentPath(hotPath))
+	assert.Equal(t, filepath.Join("cold", "Unit")+SegmentFileExtension, tpf.segmentPath(filepath.Join("other", "Unit")))
+}
+
+func TestTieredPersisterFactory_SealAndCreate(t *testing.T) {
+	t.Parallel()
+
+	workingDir := t.TempDir()
+	args := createMockArgsTieredPersisterFactory()
+	args.DatabasePath = filepath.Join(workingDir, "db")
+	args.Config.Path = filepath.Join(workingDir, "cold")
+	tpf, _ := NewTieredPersisterFactory(args)
+
+	hotPath := filepath.Join(args.DatabasePath, "Epoch_0", "Shard_0", "Unit")
+	numEntries := 100
+	createHotPersister(t, hotPath, numEntries)
+
+	err := tpf.seal(hotPath)
+	require.Nil(t, err)
+	assert.False(t, directoryExists(hotPath))
+	assert.True(t, fileExists(filepath.Join(args.Config.Path, "Epoch_0", "Shard_0", "Unit")+SegmentFileExtension))
+
+	persister, err := tpf.Create(hotPath)
+	require.Nil(t, err)
+	assert.Equal(t, "*coldStorage.segmentPersister", fmt.Sprintf("%T", persister))
+	for i := 0; i < numEntries; i++ {
+		val, errGet := persister.Get([]byte(fmt.Sprintf("key%d", i)))
+		assert.Nil(t, errGet)
+		assert.Equal(t, []byte(fmt.Sprintf("value%d", i)), val)
+	}
+	assert.Equal(t, storage.ErrReadOnlyPersister, persister.Put([]byte("key"), []byte("value")))
+	_ = persister.Close()
+
+	// sealing an already sealed persister should do nothing
+	assert.Nil(t, tpf.seal(hotPath))
+}
+
+func TestTieredPersisterFactory_SealOpenedPersisterShouldFail(t *testing.T) {
+	t.Parallel()
+
+	tpf, _ := NewTieredPersisterFactory(createMockArgsTieredPersisterFactory())
+
+	hotPath := filepath.Join(t.TempDir(), "Unit")
+	createHotPersister(t, hotPath, 10)
+	persister, err := tpf.Create(hotPath)
+	require.Nil(t, err)
+
+	err = tpf.seal(hotPath)
+	assert.NotNil(t, err)
+	assert.True(t, directoryExists(hotPath))
+	assert.False(t, fileExists(tpf.segmentPath(hotPath)))
+
+	val, err := persister.Get([]byte("key1"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value1"), val)
+	_ = persister.Close()
+}
+
+func TestTieredPersisterFactory_SealAsync(t *testing.T) {
+	t.Parallel()
+
+	tpf, _ := NewTieredPersisterFactory(createMockArgsTieredPersisterFactory())
+
+	workingDir := t.TempDir()
+	paths := []string{
+		filepath.Join(workingDir, "Epoch_0"),
+		filepath.Join(workingDir, "Epoch_1"),
+		filepath.Join(workingDir, "missing"),
+	}
+	createHotPersister(t, paths[0], 10)
+	createHotPersister(t, paths[1], 10)
+
+	tpf.SealAsync(paths)
+	for tpf.isSealing.IsSet() {
+		time.Sleep(time.Millisecond * 10)
+	}
+
+	assert.True(t, fileExists(paths[0]+SegmentFileExtension))
+	assert.True(t, fileExists(paths[1]+SegmentFileExtension))
+	assert.False(t, fileExists(paths[2]+SegmentFileExtension))
+	assert.False(t, directoryExists(paths[2]))
+}
+
+func TestTieredPersisterFactory_CreateShouldWaitForSealing(t *testing.T) {
+	t.Parallel()
+
+	tpf, _ := NewTieredPersisterFactory(createMockArgsTieredPersisterFactory())
+	hotPath := filepath.Join(t.TempDir(), "Unit")
+	tpf.setSealingPath(hotPath)
+
+	chDone := make(chan struct{})
+	go func() {
+		persister, err := tpf.Create(hotPath)
+		assert.Nil(t, err)
+		_ = persister.Close()
+		close(chDone)
+	}()
+
+	select {
+	case <-chDone:
+		assert.Fail(t, "create should have waited for the sealing to finish")
+	case <-time.After(time.Millisecond * 100):
+	}
+
+	tpf.setSealingPath("")
+	select {
+	case <-chDone:
+	case <-time.After(time.Second):
+		assert.Fail(t, "create should have finished")
+	}
+}
@@ -0,0 +1,52 @@
+package compression
+
+import (
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// BlockCodec is able to compress and decompress independent blocks of data
+type BlockCodec interface {
+	Compress(data []byte) []byte
+	Decompress(data []byte) ([]byte, error)
+	IsInterfaceNil() bool
+}
+
+type blockCodec struct {
+	codec codec
+}
+
+// NewBlockCodec creates a block codec for the provided compression type. The level is only used by the zstd codec
+func NewBlockCodec(compressionType string, level int) (*blockCodec, error) {
+	switch compressionType {
+	case NoneCompression:
+		return &blockCodec{codec: &noneCodec{}}, nil
+	case SnappyCompression:
+		return &blockCodec{codec: &snappyCodec{}}, nil
+	case ZstdCompression:
+		zstd, err := newZstdCodec(level, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		return &blockCodec{codec: zstd}, nil
+	default:
+		return nil, fmt.Errorf("%w: %s", storage.ErrNotSupportedCompressionType, compressionType)
+	}
+}
+
+// Compress returns the compressed form of the provided data
+func (bc *blockCodec) Compress(data []byte) []byte {
+	return bc.codec.compress(data)
+}
+
+// Decompress returns the original form of the provided compressed data
+func (bc *blockCodec) Decompress(data []byte) ([]byte, error) {
+	return bc.codec.decompress(data)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (bc *blockCodec) IsInterfaceNil() bool {
+	return bc == nil
+}
@@ -0,0 +1,35 @@
+package compression
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBlockCodec(t *testing.T) {
+	t.Parallel()
+
+	bc, err := NewBlockCodec("Gzip", 0)
+	assert.Nil(t, bc)
+	assert.True(t, errors.Is(err, storage.ErrNotSupportedCompressionType))
+
+	data := bytes.Repeat([]byte("block data "), 100)
+	for _, compressionType := range []string{NoneCompression, SnappyCompression, ZstdCompression} {
+		bc, err = NewBlockCodec(compressionType, 3)
+		require.Nil(t, err)
+		assert.False(t, bc.IsInterfaceNil())
+
+		compressed := bc.Compress(data)
+		if compressionType != NoneCompression {
+			assert.True(t, len(compressed) < len(data))
+		}
+
+		decompressed, errDecompress := bc.Decompress(compressed)
+		assert.Nil(t, errDecompress)
+		assert.Equal(t, data, decompressed)
+	}
+}
@@ -0,0 +1,85 @@
+package compression
+
+import (
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+)
+
+type codec interface {
+	compress(data []byte) []byte
+	decompress(data []byte) ([]byte, error)
+}
+
+type noneCodec struct {
+}
+
+func (nc *noneCodec) compress(data []byte) []byte {
+	return data
+}
+
+func (nc *noneCodec) decompress(data []byte) ([]byte, error) {
+	return data, nil
+}
+
+type snappyCodec struct {
+}
+
+func (sc *snappyCodec) compress(data []byte) []byte {
+	return snappy.Encode(nil, data)
+}
+
+func (sc *snappyCodec) decompress(data []byte) ([]byte, error) {
+	return snappy.Decode(nil, data)
+}
+
+type zstdCodec struct {
+	encoder *zstd.Encoder
+	decoder *zstd.Decoder
+}
+
+// newZstdCodec creates a zstd codec. The encoder will use the provided dictionary, if any, while the decoder
+// is able to decode the values compressed with any of the provided dictionaries or without a dictionary
+func newZstdCodec(level int, encoderDictionary []byte, dictionaries [][]byte) (*zstdCodec, error) {
+	encoderOptions := []zstd.EOption{
+		zstd.WithEncoderLevel(zstd.EncoderLevelFromZstd(level)),
+		zstd.WithEncoderConcurrency(1),
+	}
+	if len(encoderDictionary) > 0 {
+		encoderOptions = append(encoderOptions, zstd.WithEncoderDict(encoderDictionary))
+	}
+	encoder, err := zstd.NewWriter(nil, encoderOptions...)
+	if err != nil {
+		return nil, err
+	}
+
+	decoderOptions := []zstd.DOption{
+		zstd.WithDecoderConcurrency(1),
+	}
+	if len(dictionaries) > 0 {
+		decoderOptions = append(decoderOptions, zstd.WithDecoderDicts(dictionaries...))
+	}
+	decoder, err := zstd.NewReader(nil, decoderOptions...)
+	if err != nil {
+		_ = encoder.Close()
+		return nil, err
+	}
+
+	return &zstdCodec{
+		encoder: encoder,
+		decoder: decoder,
+	}, nil
+}
+
+func (zc *zstdCodec) compress(data []byte) []byte {
+	return zc.encoder.EncodeAll(data, nil)
+}
+
+func (zc *zstdCodec) decompress(data []byte) ([]byte, error) {
+	return zc.decoder.DecodeAll(data, nil)
+}
+
+// close releases the codec's resources, the codec can not be used afterwards
+func (zc *zstdCodec) close() {
+	_ = zc.encoder.Close()
+	zc.decoder.Close()
+}
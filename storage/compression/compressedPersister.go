@@ -0,0 +1,372 @@
+package compression
+
+import (
+	"bytes"
+	"fmt"
+	"hash/fnv"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/klauspost/compress/zstd"
+)
+
+var _ storage.Persister = (*compressedPersister)(nil)
+
+var log = logger.GetOrCreate("storage/compression")
+
+const (
+	// NoneCompression is the string representation of the disabled values compression
+	NoneCompression = "None"
+	// SnappyCompression is the string representation of the snappy values compression
+	SnappyCompression = "Snappy"
+	// ZstdCompression is the string representation of the zstd values compression
+	ZstdCompression = "Zstd"
+)
+
+// formatMarker prefixes every value written by the compressed persister, being followed by one byte
+// describing how the rest of the value is encoded. Values without the marker are the uncompressed values
+// written before the compression was enabled
+var formatMarker = []byte{0xc0, 0x5e, 0x7a}
+
+const (
+	uncompressedValue byte = 0
+	snappyValue       byte = 1
+	zstdValue         byte = 2
+)
+
+const (
+	minPrivateDictionaryID = uint32(1) << 15
+	maxPrivateDictionaryID = uint32(1) << 31
+)
+
+// dictionaryKey is the key the trained zstd dictionary is saved under, in the wrapped persister
+var dictionaryKey = []byte("\x00compressionDictionary")
+
+type compressedPersister struct {
+	persister          storage.Persister
+	valueType          byte
+	level              int
+	minValueSize       int
+	numTrainingSamples int
+	dictionarySize     int
+	snappy             *snappyCodec
+
+	mutZstd sync.RWMutex
+	zstd    *zstdCodec
+
+	mutTraining    sync.Mutex
+	isTrainingDone bool
+	samples        [][]byte
+}
+
+// IsEnabled returns true if the provided configuration enables the values compression
+func IsEnabled(cfg config.DBCompressionConfig) bool {
+	return len(cfg.Type) > 0 && cfg.Type != NoneCompression
+}
+
+// NewCompressedPersister creates a persister that compresses the values before writing them into the provided
+// persister. The reads are transparent: the values written before the compression was enabled, or with
+// another compression type, are still returned as they were written
+func NewCompressedPersister(persister storage.Persister, cfg config.DBCompressionConfig) (*compressedPersister, error) {
+	if check.IfNil(persister) {
+		return nil, storage.ErrNilPersister
+	}
+
+	valueType, err := checkCompressionConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	cp := &compressedPersister{
+		persister:          persister,
+		valueType:          valueType,
+		level:              cfg.Level,
+		minValueSize:       cfg.MinValueSizeInBytes,
+		numTrainingSamples: cfg.DictionaryTrainingSamples,
+		dictionarySize:     cfg.DictionarySizeInBytes,
+		snappy:             &snappyCodec{},
+		isTrainingDone:     cfg.DictionaryTrainingSamples == 0,
+	}
+
+	dictionary, errGet := persister.Get(dictionaryKey)
+	if errGet != nil {
+		dictionary = nil
+	}
+	if len(dictionary) > 0 {
+		cp.isTrainingDone = true
+	}
+
+	cp.zstd, err = createZstdCodecWithDictionary(cfg.Level, dictionary)
+	if err != nil {
+		return nil, err
+	}
+
+	return cp, nil
+}
+
+func checkCompressionConfig(cfg config.DBCompressionConfig) (byte, error) {
+	if cfg.MinValueSizeInBytes < 0 {
+		return 0, fmt.Errorf("%w, MinValueSizeInBytes is negative", storage.ErrInvalidCompressionConfig)
+	}
+	if cfg.DictionaryTrainingSamples < 0 {
+		return 0, fmt.Errorf("%w, DictionaryTrainingSamples is negative", storage.ErrInvalidCompressionConfig)
+	}
+
+	switch cfg.Type {
+	case SnappyCompression:
+		if cfg.DictionaryTrainingSamples > 0 {
+			return 0, fmt.Errorf("%w, dictionaries are supported only by the %s compression",
+				storage.ErrInvalidCompressionConfig, ZstdCompression)
+		}
+		return snappyValue, nil
+	case ZstdCompression:
+		if cfg.DictionaryTrainingSamples > 0 && cfg.DictionarySizeInBytes <= 0 {
+			return 0, fmt.Errorf("%w, DictionarySizeInBytes should be positive when training dictionaries",
+				storage.ErrInvalidCompressionConfig)
+		}
+		return zstdValue, nil
+	default:
+		return 0, fmt.Errorf("%w: %s", storage.ErrNotSupportedCompressionType, cfg.Type)
+	}
+}
+
+func createZstdCodecWithDictionary(level int, dictionary []byte) (*zstdCodec, error) {
+	if len(dictionary) == 0 {
+		return newZstdCodec(level, nil, nil)
+	}
+
+	return newZstdCodec(level, dictionary, [][]byte{dictionary})
+}
+
+// Put compresses the value and adds it to the (key, val) storage medium
+func (cp *compressedPersister) Put(key, val []byte) error {
+	err := cp.persister.Put(key, cp.encode(val))
+	if err != nil {
+		return err
+	}
+
+	cp.addTrainingSample(val)
+
+	return nil
+}
+
+// Get returns the decompressed value associated to the key
+func (cp *compressedPersister) Get(key []byte) ([]byte, error) {
+	data, err := cp.persister.Get(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cp.decode(key, data), nil
+}
+
+// Has returns nil if the given key is present in the persistence medium
+func (cp *compressedPersister) Has(key []byte) error {
+	return cp.persister.Has(key)
+}
+
+// Close closes the files/resources associated to the storage medium
+func (cp *compressedPersister) Close() error {
+	return cp.persister.Close()
+}
+
+// Remove removes the data associated to the given key
+func (cp *compressedPersister) Remove(key []byte) error {
+	return cp.persister.Remove(key)
+}
+
+// Destroy removes the storage medium stored data
+func (cp *compressedPersister) Destroy() error {
+	return cp.persister.Destroy()
+}
+
+// DestroyClosed removes the already closed storage medium stored data
+func (cp *compressedPersister) DestroyClosed() error {
+	return cp.persister.DestroyClosed()
+}
+
+// RangeKeys will call the handler function for each (key, decompressed value) pair
+// If the handler returns true, the iteration will continue, otherwise will stop
+func (cp *compressedPersister) RangeKeys(handler func(key []byte, val []byte) bool) {
+	if handler == nil {
+		return
+	}
+
+	cp.persister.RangeKeys(func(key []byte, val []byte) bool {
+		if bytes.Equal(key, dictionaryKey) {
+			return true
+		}
+
+		return handler(key, cp.decode(key, val))
+	})
+}
+
+func (cp *compressedPersister) encode(val []byte) []byte {
+	if len(val) < cp.minValueSize {
+		return markValue(uncompressedValue, val)
+	}
+
+	var compressed []byte
+	switch cp.valueType {
+	case snappyValue:
+		compressed = cp.snappy.compress(val)
+	default:
+		cp.mutZstd.RLock()
+		compressed = cp.zstd.compress(val)
+		cp.mutZstd.RUnlock()
+	}
+
+	if len(compressed) >= len(val) {
+		return markValue(uncompressedValue, val)
+	}
+
+	return markValue(cp.valueType, compressed)
+}
+
+func markValue(valueType byte, payload []byte) []byte {
+	value := make([]byte, 0, len(formatMarker)+1+len(payload))
+	value = append(value, formatMarker...)
+	value = append(value, valueType)
+
+	return append(value, payload...)
+}
+
+// decode returns the value as it was before being written. A value holding the format marker that can not be
+// decoded is considered an uncompressed value which happened to start with the marker bytes
+func (cp *compressedPersister) decode(key []byte, data []byte) []byte {
+	headerLen := len(formatMarker) + 1
+	if len(data) < headerLen || !bytes.HasPrefix(data, formatMarker) {
+		return data
+	}
+
+	payload := data[headerLen:]
+	var decoded []byte
+	var err error
+	switch data[len(formatMarker)] {
+	case uncompressedValue:
+		return payload
+	case snappyValue:
+		decoded, err = cp.snappy.decompress(payload)
+	case zstdValue:
+		cp.mutZstd.RLock()
+		decoded, err = cp.zstd.decompress(payload)
+		cp.mutZstd.RUnlock()
+	default:
+		return data
+	}
+
+	if err != nil {
+		log.Trace("compressedPersister.decode: value treated as uncompressed", "key", key, "error", err)
+		return data
+	}
+
+	return decoded
+}
+
+func (cp *compressedPersister) addTrainingSample(val []byte) {
+	cp.mutTraining.Lock()
+	defer cp.mutTraining.Unlock()
+
+	if cp.isTrainingDone || len(val) < cp.minValueSize {
+		return
+	}
+
+	sample := make([]byte, len(val))
+	copy(sample, val)
+	cp.samples = append(cp.samples, sample)
+	if len(cp.samples) < cp.numTrainingSamples {
+		return
+	}
+
+	cp.trainDictionary()
+	cp.isTrainingDone = true
+	cp.samples = nil
+}
+
+// buildDictionary calls zstd.BuildDict converting any panic into an error as the dictionary builder can panic
+// when the samples do not contain enough repeated sequences
+func buildDictionary(options zstd.BuildDictOptions) (dictionary []byte, err error) {
+	defer func() {
+		r := recover()
+		if r != nil {
+			err = fmt.Errorf("can not build the compression dictionary: %v", r)
+		}
+	}()
+
+	return zstd.BuildDict(options)
+}
+
+// trainDictionary builds a zstd dictionary out of the gathered samples. The dictionary is saved in the wrapped
+// persister so the values compressed with it can be decompressed after the persister is reopened
+func (cp *compressedPersister) trainDictionary() {
+	history := buildHistory(cp.samples, cp.dictionarySize)
+	dictionary, err := buildDictionary(zstd.BuildDictOptions{
+		ID:       dictionaryID(history),
+		Contents: cp.samples,
+		History:  history,
+		Offsets:  [3]int{1, 4, 8},
+		Level:    zstd.EncoderLevelFromZstd(cp.level),
+	})
+	if err != nil {
+		log.Warn("compressedPersister: can not train the compression dictionary", "error", err)
+		return
+	}
+
+	err = cp.persister.Put(dictionaryKey, dictionary)
+	if err != nil {
+		log.Warn("compressedPersister: can not save the compression dictionary", "error", err)
+		return
+	}
+
+	codec, err := createZstdCodecWithDictionary(cp.level, dictionary)
+	if err != nil {
+		log.Warn("compressedPersister: can not use the compression dictionary", "error", err)
+		return
+	}
+
+	cp.mutZstd.Lock()
+	oldCodec := cp.zstd
+	cp.zstd = codec
+	cp.mutZstd.Unlock()
+
+	oldCodec.close()
+	log.Debug("compressedPersister: trained compression dictionary",
+		"num samples", len(cp.samples),
+		"dictionary size", len(dictionary))
+}
+
+// buildHistory concatenates the most recent samples, up to the maximum size, placing the most recent ones at the end
+func buildHistory(samples [][]byte, maxSize int) []byte {
+	firstIndex := len(samples)
+	size := 0
+	for firstIndex > 0 && size < maxSize {
+		firstIndex--
+		size += len(samples[firstIndex])
+	}
+
+	history := make([]byte, 0, size)
+	for _, sample := range samples[firstIndex:] {
+		history = append(history, sample...)
+	}
+	if len(history) > maxSize {
+		history = history[len(history)-maxSize:]
+	}
+
+	return history
+}
+
+// dictionaryID returns a dictionary ID from the range the zstd format leaves for private use
+func dictionaryID(history []byte) uint32 {
+	hasher := fnv.New32a()
+	_, _ = hasher.Write(history)
+
+	return minPrivateDictionaryID + hasher.Sum32()%(maxPrivateDictionaryID-minPrivateDictionaryID)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cp *compressedPersister) IsInterfaceNil() bool {
+	return cp == nil
+}
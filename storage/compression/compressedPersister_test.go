@@ -0,0 +1,282 @@
+package compression
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	storageStubs "github.com/ElrondNetwork/elrond-go/testscommon/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createZstdConfig() config.DBCompressionConfig {
+	return config.DBCompressionConfig{
+		Type:                ZstdCompression,
+		Level:               3,
+		MinValueSizeInBytes: 16,
+	}
+}
+
+func createCompressibleValue(index int) []byte {
+	return []byte(fmt.Sprintf(`{"nonce":%d,"value":"1000000000000000000","receiver":"erd1qqqqqqqqqqqqqpgq","sender":"erd1qqqqqqqqqqqqqpgq","gasPrice":1000000000,"gasLimit":50000}`, index))
+}
+
+func TestIsEnabled(t *testing.T) {
+	t.Parallel()
+
+	assert.False(t, IsEnabled(config.DBCompressionConfig{}))
+	assert.False(t, IsEnabled(config.DBCompressionConfig{Type: NoneCompression}))
+	assert.True(t, IsEnabled(config.DBCompressionConfig{Type: SnappyCompression}))
+	assert.True(t, IsEnabled(config.DBCompressionConfig{Type: ZstdCompression}))
+}
+
+func TestNewCompressedPersister(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil persister should error", func(t *testing.T) {
+		t.Parallel()
+
+		cp, err := NewCompressedPersister(nil, createZstdConfig())
+		assert.Nil(t, cp)
+		assert.Equal(t, storage.ErrNilPersister, err)
+	})
+	t.Run("not supported type should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createZstdConfig()
+		cfg.Type = "Gzip"
+		cp, err := NewCompressedPersister(memorydb.New(), cfg)
+		assert.Nil(t, cp)
+		assert.True(t, errors.Is(err, storage.ErrNotSupportedCompressionType))
+	})
+	t.Run("negative min value size should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createZstdConfig()
+		cfg.MinValueSizeInBytes = -1
+		cp, err := NewCompressedPersister(memorydb.New(), cfg)
+		assert.Nil(t, cp)
+		assert.True(t, errors.Is(err, storage.ErrInvalidCompressionConfig))
+	})
+	t.Run("negative training samples should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createZstdConfig()
+		cfg.DictionaryTrainingSamples = -1
+		cp, err := NewCompressedPersister(memorydb.New(), cfg)
+		assert.Nil(t, cp)
+		assert.True(t, errors.Is(err, storage.ErrInvalidCompressionConfig))
+	})
+	t.Run("snappy with dictionary training should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.DBCompressionConfig{
+			Type:                      SnappyCompression,
+			DictionaryTrainingSamples: 10,
+			DictionarySizeInBytes:     1024,
+		}
+		cp, err := NewCompressedPersister(memorydb.New(), cfg)
+		assert.Nil(t, cp)
+		assert.True(t, errors.Is(err, storage.ErrInvalidCompressionConfig))
+	})
+	t.Run("zstd dictionary training without dictionary size should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createZstdConfig()
+		cfg.DictionaryTrainingSamples = 10
+		cp, err := NewCompressedPersister(memorydb.New(), cfg)
+		assert.Nil(t, cp)
+		assert.True(t, errors.Is(err, storage.ErrInvalidCompressionConfig))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		cp, err := NewCompressedPersister(memorydb.New(), createZstdConfig())
+		assert.Nil(t, err)
+		assert.False(t, cp.IsInterfaceNil())
+	})
+}
+
+func TestCompressedPersister_PutGet(t *testing.T) {
+	t.Parallel()
+
+	for _, compressionType := range []string{SnappyCompression, ZstdCompression} {
+		compressionType := compressionType
+		t.Run(compressionType, func(t *testing.T) {
+			t.Parallel()
+
+			db := memorydb.New()
+			cfg := createZstdConfig()
+			cfg.Type = compressionType
+			cp, _ := NewCompressedPersister(db, cfg)
+
+			key, val := []byte("key"), bytes.Repeat([]byte("compressible value "), 20)
+			err := cp.Put(key, val)
+			require.Nil(t, err)
+
+			stored, _ := db.Get(key)
+			assert.True(t, bytes.HasPrefix(stored, formatMarker))
+			assert.True(t, len(stored) < len(val))
+
+			recovered, err := cp.Get(key)
+			assert.Nil(t, err)
+			assert.Equal(t, val, recovered)
+		})
+	}
+}
+
+func TestCompressedPersister_SmallAndIncompressibleValuesShouldBeStoredUncompressed(t *testing.T) {
+	t.Parallel()
+
+	db := memorydb.New()
+	cp, _ := NewCompressedPersister(db, createZstdConfig())
+
+	smallVal := []byte("small")
+	incompressibleVal := []byte("abcdefghijklmnopqrstuvwxyz0123456789")
+	_ = cp.Put([]byte("small"), smallVal)
+	_ = cp.Put([]byte("incompressible"), incompressibleVal)
+
+	stored, _ := db.Get([]byte("small"))
+	assert.Equal(t, markValue(uncompressedValue, smallVal), stored)
+	stored, _ = db.Get([]byte("incompressible"))
+	assert.Equal(t, markValue(uncompressedValue, incompressibleVal), stored)
+
+	recovered, _ := cp.Get([]byte("small"))
+	assert.Equal(t, smallVal, recovered)
+	recovered, _ = cp.Get([]byte("incompressible"))
+	assert.Equal(t, incompressibleVal, recovered)
+}
+
+func TestCompressedPersister_ShouldReadValuesWrittenBeforeCompression(t *testing.T) {
+	t.Parallel()
+
+	db := memorydb.New()
+	legacyVal := createCompressibleValue(0)
+	_ = db.Put([]byte("legacy"), legacyVal)
+
+	// an uncompressed value which happens to start with the format marker
+	markerCollisionVal := append(append([]byte{}, formatMarker...), zstdValue, 1, 2, 3)
+	_ = db.Put([]byte("collision"), markerCollisionVal)
+
+	cp, _ := NewCompressedPersister(db, createZstdConfig())
+
+	recovered, err := cp.Get([]byte("legacy"))
+	assert.Nil(t, err)
+	assert.Equal(t, legacyVal, recovered)
+
+	recovered, err = cp.Get([]byte("collision"))
+	assert.Nil(t, err)
+	assert.Equal(t, markerCollisionVal, recovered)
+}
+
+func TestCompressedPersister_ShouldReadValuesWrittenWithAnotherCompressionType(t *testing.T) {
+	t.Parallel()
+
+	db := memorydb.New()
+	snappyPersister, _ := NewCompressedPersister(db, config.DBCompressionConfig{Type: SnappyCompression})
+	val := createCompressibleValue(0)
+	_ = snappyPersister.Put([]byte("key"), val)
+
+	zstdPersister, _ := NewCompressedPersister(db, createZstdConfig())
+	recovered, err := zstdPersister.Get([]byte("key"))
+	assert.Nil(t, err)
+	assert.Equal(t, val, recovered)
+}
+
+func TestCompressedPersister_DictionaryTraining(t *testing.T) {
+	t.Parallel()
+
+	db := memorydb.New()
+	cfg := createZstdConfig()
+	cfg.DictionaryTrainingSamples = 500
+	cfg.DictionarySizeInBytes = 4096
+	cp, _ := NewCompressedPersister(db, cfg)
+
+	numValues := 600
+	for i := 0; i < numValues; i++ {
+		err := cp.Put([]byte(fmt.Sprintf("key%d", i)), createCompressibleValue(i))
+		require.Nil(t, err)
+	}
+
+	dictionary, err := db.Get(dictionaryKey)
+	require.Nil(t, err)
+	assert.True(t, len(dictionary) > 0)
+
+	// values compressed with the trained dictionary should be smaller than the ones compressed before training
+	storedBeforeTraining, _ := db.Get([]byte("key0"))
+	storedAfterTraining, _ := db.Get([]byte("key599"))
+	assert.True(t, len(storedAfterTraining) < len(storedBeforeTraining))
+
+	// the dictionary should be loaded when reopening the persister
+	reopened, _ := NewCompressedPersister(db, cfg)
+	assert.True(t, reopened.isTrainingDone)
+
+	recovered := make(map[string][]byte)
+	reopened.RangeKeys(func(key []byte, val []byte) bool {
+		recovered[string(key)] = val
+		return true
+	})
+	require.Equal(t, numValues, len(recovered))
+	for i := 0; i < numValues; i++ {
+		assert.Equal(t, createCompressibleValue(i), recovered[fmt.Sprintf("key%d", i)])
+	}
+}
+
+func TestCompressedPersister_DictionaryTrainingWithTooFewSequencesShouldNotPanic(t *testing.T) {
+	t.Parallel()
+
+	db := memorydb.New()
+	cfg := createZstdConfig()
+	cfg.DictionaryTrainingSamples = 5
+	cfg.DictionarySizeInBytes = 4096
+	cp, _ := NewCompressedPersister(db, cfg)
+
+	for i := 0; i < 10; i++ {
+		err := cp.Put([]byte(fmt.Sprintf("key%d", i)), createCompressibleValue(i))
+		require.Nil(t, err)
+	}
+
+	_, err := db.Get(dictionaryKey)
+	assert.NotNil(t, err)
+	assert.True(t, cp.isTrainingDone)
+
+	for i := 0; i < 10; i++ {
+		recovered, errGet := cp.Get([]byte(fmt.Sprintf("key%d", i)))
+		assert.Nil(t, errGet)
+		assert.Equal(t, createCompressibleValue(i), recovered)
+	}
+}
+
+func TestBuildHistory(t *testing.T) {
+	t.Parallel()
+
+	samples := [][]byte{[]byte("aaaa"), []byte("bbbb"), []byte("cccc")}
+
+	assert.Equal(t, []byte("bbcccc"), buildHistory(samples, 6))
+	assert.Equal(t, []byte("aaaabbbbcccc"), buildHistory(samples, 100))
+	assert.Equal(t, 0, len(buildHistory(nil, 100)))
+}
+
+func TestDictionaryID(t *testing.T) {
+	t.Parallel()
+
+	for i := 0; i < 100; i++ {
+		id := dictionaryID([]byte(fmt.Sprintf("history%d", i)))
+		assert.True(t, id >= minPrivateDictionaryID)
+		assert.True(t, id < maxPrivateDictionaryID)
+	}
+}
+
+func TestCompressedPersister_PersisterConformance(t *testing.T) {
+	storageStubs.RunPersisterConformanceTests(t, func(t *testing.T) storage.Persister {
+		cp, err := NewCompressedPersister(memorydb.New(), createZstdConfig())
+		require.Nil(t, err)
+
+		return cp
+	})
+}
@@ -82,6 +82,9 @@ var ErrInvalidDatabasePath = errors.New("invalid database path")
 // ErrOldestEpochNotAvailable signals that fetching the oldest epoch is not available
 var ErrOldestEpochNotAvailable = errors.New("oldest epoch not available")
 
+// ErrInvalidEpochsRange signals that an invalid range of epochs has been provided
+var ErrInvalidEpochsRange = errors.New("invalid epochs range")
+
 // ErrInvalidNumberOfEpochsToSave signals that an invalid number of epochs to save has been provided
 var ErrInvalidNumberOfEpochsToSave = errors.New("invalid number of epochs to save")
 
@@ -144,3 +147,46 @@ var ErrNilOldDataCleanerProvider = errors.New("nil old data cleaner provider")
 
 // ErrNilStoredDataFactory signals that a nil stored data factory has been provided
 var ErrNilStoredDataFactory = errors.New("nil stored data factory")
+
+// ErrNotSupportedCompressionType is raised when an unsupported values compression type is provided
+var ErrNotSupportedCompressionType = errors.New("not supported compression type")
+
+// ErrInvalidCompressionConfig signals that an invalid values compression configuration has been provided
+var ErrInvalidCompressionConfig = errors.New("invalid compression config")
+
+// ErrReadOnlyPersister signals that a write operation was attempted on a read only persister
+var ErrReadOnlyPersister = errors.New("read only persister")
+
+// ErrInvalidSegmentFile signals that a cold storage segment file is malformed or corrupted
+var ErrInvalidSegmentFile = errors.New("invalid segment file")
+
+// ErrUnorderedSegmentKeys signals that the source of a cold storage segment did not iterate its keys in ascending order
+var ErrUnorderedSegmentKeys = errors.New("segment keys are not in ascending order")
+
+// ErrInvalidColdStorageConfig signals that an invalid cold storage configuration has been provided
+var ErrInvalidColdStorageConfig = errors.New("invalid cold storage config")
+
+// ErrNilColdStorageHandler signals that a nil cold storage handler has been provided
+var ErrNilColdStorageHandler = errors.New("nil cold storage handler")
+
+// ErrNilStorageStatisticsHandler signals that a nil storage statistics handler has been provided
+var ErrNilStorageStatisticsHandler = errors.New("nil storage statistics handler")
+
+// ErrEmptyFilePath signals that an empty file path has been provided
+var ErrEmptyFilePath = errors.New("empty file path")
+
+// ErrInvalidRefreshInterval signals that an invalid refresh interval has been provided
+var ErrInvalidRefreshInterval = errors.New("invalid refresh interval")
+
+// ErrPathOutsideDatabases signals that a persister path is located outside the databases directories
+var ErrPathOutsideDatabases = errors.New("path outside the databases directories")
+
+// ErrInconsistentCheckpoint signals that a consistent checkpoint of a database could not be created, as the
+// database kept changing while being copied
+var ErrInconsistentCheckpoint = errors.New("inconsistent checkpoint")
+
+// ErrInvalidSecondaryModeConfig signals that an invalid secondary mode configuration has been provided
+var ErrInvalidSecondaryModeConfig = errors.New("invalid secondary mode config")
+
+// ErrUnorderedRangeKeys signals that an epoch persister did not iterate its keys in ascending order
+var ErrUnorderedRangeKeys = errors.New("range keys are not in ascending order")
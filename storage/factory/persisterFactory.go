@@ -5,8 +5,10 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/compression"
 	"github.com/ElrondNetwork/elrond-go/storage/leveldb"
 	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/ElrondNetwork/elrond-go/storage/pebble"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 )
 
@@ -16,6 +18,7 @@ type PersisterFactory struct {
 	batchDelaySeconds int
 	maxBatchSize      int
 	maxOpenFiles      int
+	compressionConfig config.DBCompressionConfig
 }
 
 // NewPersisterFactory will return a new instance of a PersisterFactory
@@ -25,6 +28,7 @@ func NewPersisterFactory(config config.DBConfig) *PersisterFactory {
 		batchDelaySeconds: config.BatchDelaySeconds,
 		maxBatchSize:      config.MaxBatchSize,
 		maxOpenFiles:      config.MaxOpenFiles,
+		compressionConfig: config.Compression,
 	}
 }
 
@@ -34,6 +38,25 @@ func (pf *PersisterFactory) Create(path string) (storage.Persister, error) {
 		return nil, errors.New("invalid file path")
 	}
 
+	persister, err := pf.createDB(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if !compression.IsEnabled(pf.compressionConfig) {
+		return persister, nil
+	}
+
+	compressedPersister, err := compression.NewCompressedPersister(persister, pf.compressionConfig)
+	if err != nil {
+		_ = persister.Close()
+		return nil, err
+	}
+
+	return compressedPersister, nil
+}
+
+func (pf *PersisterFactory) createDB(path string) (storage.Persister, error) {
 	switch storageUnit.DBType(pf.dbType) {
 	case storageUnit.LvlDB:
 		return leveldb.NewDB(path, pf.batchDelaySeconds, pf.maxBatchSize, pf.maxOpenFiles)
@@ -41,6 +64,8 @@ func (pf *PersisterFactory) Create(path string) (storage.Persister, error) {
 		return leveldb.NewSerialDB(path, pf.batchDelaySeconds, pf.maxBatchSize, pf.maxOpenFiles)
 	case storageUnit.MemoryDB:
 		return memorydb.New(), nil
+	case storageUnit.PebbleDB:
+		return pebble.NewDB(path, pf.batchDelaySeconds, pf.maxBatchSize, pf.maxOpenFiles)
 	default:
 		return nil, storage.ErrNotSupportedDBType
 	}
@@ -3,15 +3,20 @@ package factory
 import (
 	"fmt"
 	"path/filepath"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/storage/clean"
+	"github.com/ElrondNetwork/elrond-go/storage/coldStorage"
 	"github.com/ElrondNetwork/elrond-go/storage/pruning"
+	"github.com/ElrondNetwork/elrond-go/storage/secondary"
+	"github.com/ElrondNetwork/elrond-go/storage/statistics"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 )
 
@@ -32,6 +37,7 @@ type StorageServiceFactory struct {
 	oldDataCleanerProvider        clean.OldDataCleanerProvider
 	createTrieEpochRootHashStorer bool
 	currentEpoch                  uint32
+	storageStatistics             storage.StorageStatisticsHandler
 }
 
 // NewStorageServiceFactory will return a new instance of StorageServiceFactory
@@ -44,6 +50,7 @@ func NewStorageServiceFactory(
 	nodeTypeProvider NodeTypeProviderHandler,
 	currentEpoch uint32,
 	createTrieEpochRootHashStorer bool,
+	storageStatistics storage.StorageStatisticsHandler,
 ) (*StorageServiceFactory, error) {
 	if config == nil {
 		return nil, fmt.Errorf("%w for config.Config", storage.ErrNilConfig)
@@ -63,6 +70,9 @@ func NewStorageServiceFactory(
 	if check.IfNil(epochStartNotifier) {
 		return nil, storage.ErrNilEpochStartNotifier
 	}
+	if check.IfNil(storageStatistics) {
+		return nil, storage.ErrNilStorageStatisticsHandler
+	}
 
 	oldDataCleanProvider, err := clean.NewOldDataCleanerProvider(
 		nodeTypeProvider,
@@ -74,6 +84,18 @@ func NewStorageServiceFactory(
 	if config.StoragePruning.NumEpochsToKeep < minimumNumberOfEpochsToKeep && oldDataCleanProvider.ShouldClean() {
 		return nil, storage.ErrInvalidNumberOfEpochsToSave
 	}
+	if config.StoragePruning.ColdStorage.Enabled {
+		err = coldStorage.CheckConfig(config.StoragePruning.ColdStorage)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if config.SecondaryMode.Enabled {
+		err = secondary.CheckConfig(config.SecondaryMode)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	return &StorageServiceFactory{
 		generalConfig:                 config,
@@ -84,6 +106,7 @@ func NewStorageServiceFactory(
 		currentEpoch:                  currentEpoch,
 		createTrieEpochRootHashStorer: createTrieEpochRootHashStorer,
 		oldDataCleanerProvider:        oldDataCleanProvider,
+		storageStatistics:             storageStatistics,
 	}, nil
 }
 
@@ -115,77 +138,110 @@ func (psf *StorageServiceFactory) CreateForShard() (dataRetriever.StorageService
 		}
 	}()
 
-	txUnitStorerArgs := psf.createPruningStorerArgs(psf.generalConfig.TxStorage)
+	txUnitStorerArgs, err := psf.createPruningStorerArgs(psf.generalConfig.TxStorage, dataRetriever.TransactionUnit)
+	if err != nil {
+		return nil, err
+	}
 	txUnit, err = psf.createPruningPersister(txUnitStorerArgs)
 	if err != nil {
 		return nil, err
 	}
 	successfullyCreatedStorers = append(successfullyCreatedStorers, txUnit)
 
-	unsignedTxUnitStorerArgs := psf.createPruningStorerArgs(psf.generalConfig.UnsignedTransactionStorage)
+	unsignedTxUnitStorerArgs, err := psf.createPruningStorerArgs(psf.generalConfig.UnsignedTransactionStorage, dataRetriever.UnsignedTransactionUnit)
+	if err != nil {
+		return nil, err
+	}
 	unsignedTxUnit, err = psf.createPruningPersister(unsignedTxUnitStorerArgs)
 	if err != nil {
 		return nil, err
 	}
 	successfullyCreatedStorers = append(successfullyCreatedStorers, unsignedTxUnit)
 
-	rewardTxUnitArgs := psf.createPruningStorerArgs(psf.generalConfig.RewardTxStorage)
+	rewardTxUnitArgs, err := psf.createPruningStorerArgs(psf.generalConfig.RewardTxStorage, dataRetriever.RewardTransactionUnit)
+	if err != nil {
+		return nil, err
+	}
 	rewardTxUnit, err = psf.createPruningPersister(rewardTxUnitArgs)
 	if err != nil {
 		return nil, err
 	}
 	successfullyCreatedStorers = append(successfullyCreatedStorers, rewardTxUnit)
 
-	miniBlockUnitArgs := psf.createPruningStorerArgs(psf.generalConfig.MiniBlocksStorage)
+	miniBlockUnitArgs, err := psf.createPruningStorerArgs(psf.generalConfig.MiniBlocksStorage, dataRetriever.MiniBlockUnit)
+	if err != nil {
+		return nil, err
+	}
 	miniBlockUnit, err = psf.createPruningPersister(miniBlockUnitArgs)
 	if err != nil {
 		return nil, err
 	}
 	successfullyCreatedStorers = append(successfullyCreatedStorers, miniBlockUnit)
 
-	peerBlockUnitArgs := psf.createPruningStorerArgs(psf.generalConfig.PeerBlockBodyStorage)
+	peerBlockUnitArgs, err := psf.createPruningStorerArgs(psf.generalConfig.PeerBlockBodyStorage, dataRetriever.PeerChangesUnit)
+	if err != nil {
+		return nil, err
+	}
 	peerBlockUnit, err = psf.createPruningPersister(peerBlockUnitArgs)
 	if err != nil {
 		return nil, err
 	}
 	successfullyCreatedStorers = append(successfullyCreatedStorers, peerBlockUnit)
 
-	headerUnitArgs := psf.createPruningStorerArgs(psf.generalConfig.BlockHeaderStorage)
+	headerUnitArgs, err := psf.createPruningStorerArgs(psf.generalConfig.BlockHeaderStorage, dataRetriever.BlockHeaderUnit)
+	if err != nil {
+		return nil, err
+	}
 	headerUnit, err = psf.createPruningPersister(headerUnitArgs)
 	if err != nil {
 		return nil, err
 	}
 	successfullyCreatedStorers = append(successfullyCreatedStorers, headerUnit)
 
-	metaChainHeaderUnitArgs := psf.createPruningStorerArgs(psf.generalConfig.MetaBlockStorage)
+	metaChainHeaderUnitArgs, err := psf.createPruningStorerArgs(psf.generalConfig.MetaBlockStorage, dataRetriever.MetaBlockUnit)
+	if err != nil {
+		return nil, err
+	}
 	metachainHeaderUnit, err = psf.createPruningPersister(metaChainHeaderUnitArgs)
 	if err != nil {
 		return nil, err
 	}
 	successfullyCreatedStorers = append(successfullyCreatedStorers, metachainHeaderUnit)
 
-	userAccountsUnitArgs := psf.createPruningStorerArgs(psf.generalConfig.AccountsTrieStorage)
+	userAccountsUnitArgs, err := psf.createPruningStorerArgs(psf.generalConfig.AccountsTrieStorage, dataRetriever.UserAccountsUnit)
+	if err != nil {
+		return nil, err
+	}
 	userAccountsUnit, err = psf.createTriePruningPersister(userAccountsUnitArgs)
 	if err != nil {
 		return nil, err
 	}
 	successfullyCreatedStorers = append(successfullyCreatedStorers, userAccountsUnit)
 
-	peerAccountsUnitArgs := psf.createPruningStorerArgs(psf.generalConfig.PeerAccountsTrieStorage)
+	peerAccountsUnitArgs, err := psf.createPruningStorerArgs(psf.generalConfig.PeerAccountsTrieStorage, dataRetriever.PeerAccountsUnit)
+	if err != nil {
+		return nil, err
+	}
 	peerAccountsUnit, err = psf.createTriePruningPersister(peerAccountsUnitArgs)
 	if err != nil {
 		return nil, err
 	}
 	successfullyCreatedStorers = append(successfullyCreatedStorers, peerAccountsUnit)
 
-	userAccountsCheckpointsUnitArgs := psf.createPruningStorerArgs(psf.generalConfig.AccountsTrieCheckpointsStorage)
+	userAccountsCheckpointsUnitArgs, err := psf.createPruningStorerArgs(psf.generalConfig.AccountsTrieCheckpointsStorage, dataRetriever.UserAccountsCheckpointsUnit)
+	if err != nil {
+		return nil, err
+	}
 	userAccountsCheckpointsUnit, err = psf.createPruningPersister(userAccountsCheckpointsUnitArgs)
 	if err != nil {
 		return nil, err
 	}
 	successfullyCreatedStorers = append(successfullyCreatedStorers, userAccountsCheckpointsUnit)
 
-	peerAccountsCheckpointsUnitArgs := psf.createPruningStorerArgs(psf.generalConfig.PeerAccountsTrieCheckpointsStorage)
+	peerAccountsCheckpointsUnitArgs, err := psf.createPruningStorerArgs(psf.generalConfig.PeerAccountsTrieCheckpointsStorage, dataRetriever.PeerAccountsCheckpointsUnit)
+	if err != nil {
+		return nil, err
+	}
 	peerAccountsCheckpointsUnit, err = psf.createPruningPersister(peerAccountsCheckpointsUnitArgs)
 	if err != nil {
 		return nil, err
@@ -197,9 +253,10 @@ func (psf *StorageServiceFactory) CreateForShard() (dataRetriever.StorageService
 	shardID := core.GetShardIDString(psf.shardCoordinator.SelfId())
 	dbPath := psf.pathManager.PathForStatic(shardID, psf.generalConfig.MetaHdrNonceHashStorage.DB.FilePath)
 	metaHdrHashNonceUnitConfig.FilePath = dbPath
-	metaHdrHashNonceUnit, err := storageUnit.NewStorageUnitFromConf(
+	metaHdrHashNonceUnit, err := psf.createStaticStorageUnit(
 		GetCacherFromConfig(psf.generalConfig.MetaHdrNonceHashStorage.Cache),
-		metaHdrHashNonceUnitConfig)
+		metaHdrHashNonceUnitConfig,
+		dataRetriever.MetaHdrNonceHashDataUnit)
 	if err != nil {
 		return nil, err
 	}
@@ -210,9 +267,10 @@ func (psf *StorageServiceFactory) CreateForShard() (dataRetriever.StorageService
 	shardID = core.GetShardIDString(psf.shardCoordinator.SelfId())
 	dbPath = psf.pathManager.PathForStatic(shardID, psf.generalConfig.ShardHdrNonceHashStorage.DB.FilePath) + shardID
 	shardHdrHashNonceConfig.FilePath = dbPath
-	shardHdrHashNonceUnit, err := storageUnit.NewStorageUnitFromConf(
+	shardHdrHashNonceUnit, err := psf.createStaticStorageUnit(
 		GetCacherFromConfig(psf.generalConfig.ShardHdrNonceHashStorage.Cache),
-		shardHdrHashNonceConfig)
+		shardHdrHashNonceConfig,
+		dataRetriever.ShardHdrNonceHashDataUnit+dataRetriever.UnitType(psf.shardCoordinator.SelfId()))
 	if err != nil {
 		return nil, err
 	}
@@ -222,9 +280,10 @@ func (psf *StorageServiceFactory) CreateForShard() (dataRetriever.StorageService
 	shardId := core.GetShardIDString(psf.shardCoordinator.SelfId())
 	dbPath = psf.pathManager.PathForStatic(shardId, psf.generalConfig.Heartbeat.HeartbeatStorage.DB.FilePath)
 	heartbeatDbConfig.FilePath = dbPath
-	heartbeatStorageUnit, err := storageUnit.NewStorageUnitFromConf(
+	heartbeatStorageUnit, err := psf.createStaticStorageUnit(
 		GetCacherFromConfig(psf.generalConfig.Heartbeat.HeartbeatStorage.Cache),
-		heartbeatDbConfig)
+		heartbeatDbConfig,
+		dataRetriever.HeartbeatUnit)
 	if err != nil {
 		return nil, err
 	}
@@ -234,9 +293,10 @@ func (psf *StorageServiceFactory) CreateForShard() (dataRetriever.StorageService
 	shardId = core.GetShardIDString(psf.shardCoordinator.SelfId())
 	dbPath = psf.pathManager.PathForStatic(shardId, psf.generalConfig.StatusMetricsStorage.DB.FilePath)
 	statusMetricsDbConfig.FilePath = dbPath
-	statusMetricsStorageUnit, err := storageUnit.NewStorageUnitFromConf(
+	statusMetricsStorageUnit, err := psf.createStaticStorageUnit(
 		GetCacherFromConfig(psf.generalConfig.StatusMetricsStorage.Cache),
-		statusMetricsDbConfig)
+		statusMetricsDbConfig,
+		dataRetriever.StatusMetricsUnit)
 	if err != nil {
 		return nil, err
 	}
@@ -248,21 +308,30 @@ func (psf *StorageServiceFactory) CreateForShard() (dataRetriever.StorageService
 	}
 	successfullyCreatedStorers = append(successfullyCreatedStorers, trieEpochRootHashStorageUnit)
 
-	bootstrapUnitArgs := psf.createPruningStorerArgs(psf.generalConfig.BootstrapStorage)
+	bootstrapUnitArgs, err := psf.createPruningStorerArgs(psf.generalConfig.BootstrapStorage, dataRetriever.BootstrapUnit)
+	if err != nil {
+		return nil, err
+	}
 	bootstrapUnit, err = psf.createPruningPersister(bootstrapUnitArgs)
 	if err != nil {
 		return nil, err
 	}
 	successfullyCreatedStorers = append(successfullyCreatedStorers, bootstrapUnit)
 
-	receiptsUnitArgs := psf.createPruningStorerArgs(psf.generalConfig.ReceiptsStorage)
+	receiptsUnitArgs, err := psf.createPruningStorerArgs(psf.generalConfig.ReceiptsStorage, dataRetriever.ReceiptsUnit)
+	if err != nil {
+		return nil, err
+	}
 	receiptsUnit, err = psf.createPruningPersister(receiptsUnitArgs)
 	if err != nil {
 		return nil, err
 	}
 	successfullyCreatedStorers = append(successfullyCreatedStorers, receiptsUnit)
 
-	scheduledSCRsUnitArgs := psf.createPruningStorerArgs(psf.generalConfig.ScheduledSCRsStorage)
+	scheduledSCRsUnitArgs, err := psf.createPruningStorerArgs(psf.generalConfig.ScheduledSCRsStorage, dataRetriever.ScheduledSCRsUnit)
+	if err != nil {
+		return nil, err
+	}
 	scheduledSCRsUnit, err = pruning.NewPruningStorer(scheduledSCRsUnitArgs)
 	if err != nil {
 		return nil, err
@@ -303,6 +372,18 @@ func (psf *StorageServiceFactory) CreateForShard() (dataRetriever.StorageService
 		return nil, err
 	}
 
+	createdStorers, err = psf.setupStateChangesStorer(store)
+	successfullyCreatedStorers = append(successfullyCreatedStorers, createdStorers...)
+	if err != nil {
+		return nil, err
+	}
+
+	createdStorers, err = psf.setupBlockWitnessStorer(store)
+	successfullyCreatedStorers = append(successfullyCreatedStorers, createdStorers...)
+	if err != nil {
+		return nil, err
+	}
+
 	err = psf.initOldDatabasesCleaningIfNeeded(store)
 	if err != nil {
 		return nil, err
@@ -340,42 +421,60 @@ func (psf *StorageServiceFactory) CreateForMeta() (dataRetriever.StorageService,
 		}
 	}()
 
-	metaBlockUnitArgs := psf.createPruningStorerArgs(psf.generalConfig.MetaBlockStorage)
+	metaBlockUnitArgs, err := psf.createPruningStorerArgs(psf.generalConfig.MetaBlockStorage, dataRetriever.MetaBlockUnit)
+	if err != nil {
+		return nil, err
+	}
 	metaBlockUnit, err = psf.createPruningPersister(metaBlockUnitArgs)
 	if err != nil {
 		return nil, err
 	}
 	successfullyCreatedStorers = append(successfullyCreatedStorers, metaBlockUnit)
 
-	headerUnitArgs := psf.createPruningStorerArgs(psf.generalConfig.BlockHeaderStorage)
+	headerUnitArgs, err := psf.createPruningStorerArgs(psf.generalConfig.BlockHeaderStorage, dataRetriever.BlockHeaderUnit)
+	if err != nil {
+		return nil, err
+	}
 	headerUnit, err = psf.createPruningPersister(headerUnitArgs)
 	if err != nil {
 		return nil, err
 	}
 	successfullyCreatedStorers = append(successfullyCreatedStorers, headerUnit)
 
-	userAccountsUnitArgs := psf.createPruningStorerArgs(psf.generalConfig.AccountsTrieStorage)
+	userAccountsUnitArgs, err := psf.createPruningStorerArgs(psf.generalConfig.AccountsTrieStorage, dataRetriever.UserAccountsUnit)
+	if err != nil {
+		return nil, err
+	}
 	userAccountsUnit, err = psf.createTriePruningPersister(userAccountsUnitArgs)
 	if err != nil {
 		return nil, err
 	}
 	successfullyCreatedStorers = append(successfullyCreatedStorers, userAccountsUnit)
 
-	peerAccountsUnitArgs := psf.createPruningStorerArgs(psf.generalConfig.PeerAccountsTrieStorage)
+	peerAccountsUnitArgs, err := psf.createPruningStorerArgs(psf.generalConfig.PeerAccountsTrieStorage, dataRetriever.PeerAccountsUnit)
+	if err != nil {
+		return nil, err
+	}
 	peerAccountsUnit, err = psf.createTriePruningPersister(peerAccountsUnitArgs)
 	if err != nil {
 		return nil, err
 	}
 	successfullyCreatedStorers = append(successfullyCreatedStorers, peerAccountsUnit)
 
-	userAccountsCheckpointsUnitArgs := psf.createPruningStorerArgs(psf.generalConfig.AccountsTrieCheckpointsStorage)
+	userAccountsCheckpointsUnitArgs, err := psf.createPruningStorerArgs(psf.generalConfig.AccountsTrieCheckpointsStorage, dataRetriever.UserAccountsCheckpointsUnit)
+	if err != nil {
+		return nil, err
+	}
 	userAccountsCheckpointsUnit, err = psf.createPruningPersister(userAccountsCheckpointsUnitArgs)
 	if err != nil {
 		return nil, err
 	}
 	successfullyCreatedStorers = append(successfullyCreatedStorers, userAccountsCheckpointsUnit)
 
-	peerAccountsCheckpointsUnitArgs := psf.createPruningStorerArgs(psf.generalConfig.PeerAccountsTrieCheckpointsStorage)
+	peerAccountsCheckpointsUnitArgs, err := psf.createPruningStorerArgs(psf.generalConfig.PeerAccountsTrieCheckpointsStorage, dataRetriever.PeerAccountsCheckpointsUnit)
+	if err != nil {
+		return nil, err
+	}
 	peerAccountsCheckpointsUnit, err = psf.createPruningPersister(peerAccountsCheckpointsUnitArgs)
 	if err != nil {
 		return nil, err
@@ -387,9 +486,10 @@ func (psf *StorageServiceFactory) CreateForMeta() (dataRetriever.StorageService,
 	shardID := core.GetShardIDString(core.MetachainShardId)
 	dbPath := psf.pathManager.PathForStatic(shardID, psf.generalConfig.MetaHdrNonceHashStorage.DB.FilePath)
 	metaHdrHashNonceUnitConfig.FilePath = dbPath
-	metaHdrHashNonceUnit, err := storageUnit.NewStorageUnitFromConf(
+	metaHdrHashNonceUnit, err := psf.createStaticStorageUnit(
 		GetCacherFromConfig(psf.generalConfig.MetaHdrNonceHashStorage.Cache),
-		metaHdrHashNonceUnitConfig)
+		metaHdrHashNonceUnitConfig,
+		dataRetriever.MetaHdrNonceHashDataUnit)
 	if err != nil {
 		return nil, err
 	}
@@ -401,9 +501,10 @@ func (psf *StorageServiceFactory) CreateForMeta() (dataRetriever.StorageService,
 		shardID = core.GetShardIDString(core.MetachainShardId)
 		dbPath = psf.pathManager.PathForStatic(shardID, psf.generalConfig.ShardHdrNonceHashStorage.DB.FilePath) + fmt.Sprintf("%d", i)
 		shardHdrHashNonceConfig.FilePath = dbPath
-		shardHdrHashNonceUnits[i], err = storageUnit.NewStorageUnitFromConf(
+		shardHdrHashNonceUnits[i], err = psf.createStaticStorageUnit(
 			GetCacherFromConfig(psf.generalConfig.ShardHdrNonceHashStorage.Cache),
-			shardHdrHashNonceConfig)
+			shardHdrHashNonceConfig,
+			dataRetriever.ShardHdrNonceHashDataUnit+dataRetriever.UnitType(i))
 		if err != nil {
 			return nil, err
 		}
@@ -415,9 +516,10 @@ func (psf *StorageServiceFactory) CreateForMeta() (dataRetriever.StorageService,
 	heartbeatDbConfig := GetDBFromConfig(psf.generalConfig.Heartbeat.HeartbeatStorage.DB)
 	dbPath = psf.pathManager.PathForStatic(shardId, psf.generalConfig.Heartbeat.HeartbeatStorage.DB.FilePath)
 	heartbeatDbConfig.FilePath = dbPath
-	heartbeatStorageUnit, err := storageUnit.NewStorageUnitFromConf(
+	heartbeatStorageUnit, err := psf.createStaticStorageUnit(
 		GetCacherFromConfig(psf.generalConfig.Heartbeat.HeartbeatStorage.Cache),
-		heartbeatDbConfig)
+		heartbeatDbConfig,
+		dataRetriever.HeartbeatUnit)
 	if err != nil {
 		return nil, err
 	}
@@ -427,9 +529,10 @@ func (psf *StorageServiceFactory) CreateForMeta() (dataRetriever.StorageService,
 	shardId = core.GetShardIDString(psf.shardCoordinator.SelfId())
 	dbPath = psf.pathManager.PathForStatic(shardId, psf.generalConfig.StatusMetricsStorage.DB.FilePath)
 	statusMetricsDbConfig.FilePath = dbPath
-	statusMetricsStorageUnit, err := storageUnit.NewStorageUnitFromConf(
+	statusMetricsStorageUnit, err := psf.createStaticStorageUnit(
 		GetCacherFromConfig(psf.generalConfig.StatusMetricsStorage.Cache),
-		statusMetricsDbConfig)
+		statusMetricsDbConfig,
+		dataRetriever.StatusMetricsUnit)
 	if err != nil {
 		return nil, err
 	}
@@ -441,49 +544,70 @@ func (psf *StorageServiceFactory) CreateForMeta() (dataRetriever.StorageService,
 	}
 	successfullyCreatedStorers = append(successfullyCreatedStorers, trieEpochRootHashStorageUnit)
 
-	txUnitArgs := psf.createPruningStorerArgs(psf.generalConfig.TxStorage)
+	txUnitArgs, err := psf.createPruningStorerArgs(psf.generalConfig.TxStorage, dataRetriever.TransactionUnit)
+	if err != nil {
+		return nil, err
+	}
 	txUnit, err = psf.createPruningPersister(txUnitArgs)
 	if err != nil {
 		return nil, err
 	}
 	successfullyCreatedStorers = append(successfullyCreatedStorers, txUnit)
 
-	unsignedTxUnitArgs := psf.createPruningStorerArgs(psf.generalConfig.UnsignedTransactionStorage)
+	unsignedTxUnitArgs, err := psf.createPruningStorerArgs(psf.generalConfig.UnsignedTransactionStorage, dataRetriever.UnsignedTransactionUnit)
+	if err != nil {
+		return nil, err
+	}
 	unsignedTxUnit, err = psf.createPruningPersister(unsignedTxUnitArgs)
 	if err != nil {
 		return nil, err
 	}
 	successfullyCreatedStorers = append(successfullyCreatedStorers, unsignedTxUnit)
 
-	rewardTxUnitArgs := psf.createPruningStorerArgs(psf.generalConfig.RewardTxStorage)
+	rewardTxUnitArgs, err := psf.createPruningStorerArgs(psf.generalConfig.RewardTxStorage, dataRetriever.RewardTransactionUnit)
+	if err != nil {
+		return nil, err
+	}
 	rewardTxUnit, err = psf.createPruningPersister(rewardTxUnitArgs)
 	if err != nil {
 		return nil, err
 	}
 	successfullyCreatedStorers = append(successfullyCreatedStorers, rewardTxUnit)
 
-	miniBlockUnitArgs := psf.createPruningStorerArgs(psf.generalConfig.MiniBlocksStorage)
+	miniBlockUnitArgs, err := psf.createPruningStorerArgs(psf.generalConfig.MiniBlocksStorage, dataRetriever.MiniBlockUnit)
+	if err != nil {
+		return nil, err
+	}
 	miniBlockUnit, err = psf.createPruningPersister(miniBlockUnitArgs)
 	if err != nil {
 		return nil, err
 	}
 	successfullyCreatedStorers = append(successfullyCreatedStorers, miniBlockUnit)
 
-	bootstrapUnitArgs := psf.createPruningStorerArgs(psf.generalConfig.BootstrapStorage)
+	bootstrapUnitArgs, err := psf.createPruningStorerArgs(psf.generalConfig.BootstrapStorage, dataRetriever.BootstrapUnit)
+	if err != nil {
+		return nil, err
+	}
 	bootstrapUnit, err = psf.createPruningPersister(bootstrapUnitArgs)
 	if err != nil {
 		return nil, err
 	}
 	successfullyCreatedStorers = append(successfullyCreatedStorers, bootstrapUnit)
 
-	receiptsUnitArgs := psf.createPruningStorerArgs(psf.generalConfig.ReceiptsStorage)
+	receiptsUnitArgs, err := psf.createPruningStorerArgs(psf.generalConfig.ReceiptsStorage, dataRetriever.ReceiptsUnit)
+	if err != nil {
+		return nil, err
+	}
 	receiptsUnit, err = psf.createPruningPersister(receiptsUnitArgs)
 	if err != nil {
 		return nil, err
 	}
 	successfullyCreatedStorers = append(successfullyCreatedStorers, receiptsUnit)
 
-	scheduledSCRsUnitArgs := psf.createPruningStorerArgs(psf.generalConfig.ScheduledSCRsStorage)
+	scheduledSCRsUnitArgs, err := psf.createPruningStorerArgs(psf.generalConfig.ScheduledSCRsStorage, dataRetriever.ScheduledSCRsUnit)
+	if err != nil {
+		return nil, err
+	}
 	scheduledSCRsUnit, err = pruning.NewPruningStorer(scheduledSCRsUnitArgs)
 	if err != nil {
 		return nil, err
@@ -525,6 +649,18 @@ func (psf *StorageServiceFactory) CreateForMeta() (dataRetriever.StorageService,
 		return nil, err
 	}
 
+	createdStorers, err = psf.setupStateChangesStorer(store)
+	successfullyCreatedStorers = append(successfullyCreatedStorers, createdStorers...)
+	if err != nil {
+		return nil, err
+	}
+
+	createdStorers, err = psf.setupBlockWitnessStorer(store)
+	successfullyCreatedStorers = append(successfullyCreatedStorers, createdStorers...)
+	if err != nil {
+		return nil, err
+	}
+
 	err = psf.initOldDatabasesCleaningIfNeeded(store)
 	if err != nil {
 		return nil, err
@@ -544,7 +680,10 @@ func (psf *StorageServiceFactory) setupLogsAndEventsStorer(chainStorer *dataRetr
 		return createdStorers, nil
 	}
 
-	txLogsUnitArgs := psf.createPruningStorerArgs(psf.generalConfig.LogsAndEvents.TxLogsStorage)
+	txLogsUnitArgs, err := psf.createPruningStorerArgs(psf.generalConfig.LogsAndEvents.TxLogsStorage, dataRetriever.TxLogsUnit)
+	if err != nil {
+		return createdStorers, err
+	}
 	txLogsUnit, err := psf.createPruningPersister(txLogsUnitArgs)
 	if err != nil {
 		return createdStorers, err
@@ -556,6 +695,50 @@ func (psf *StorageServiceFactory) setupLogsAndEventsStorer(chainStorer *dataRetr
 	return createdStorers, nil
 }
 
+func (psf *StorageServiceFactory) setupStateChangesStorer(chainStorer *dataRetriever.ChainStorer) ([]storage.Storer, error) {
+	createdStorers := make([]storage.Storer, 0)
+
+	if !psf.generalConfig.StateChanges.Enabled {
+		return createdStorers, nil
+	}
+
+	stateChangesUnitArgs, err := psf.createPruningStorerArgs(psf.generalConfig.StateChanges.StateChangesStorage, dataRetriever.BlockStateChangesUnit)
+	if err != nil {
+		return createdStorers, err
+	}
+	stateChangesUnit, err := psf.createPruningPersister(stateChangesUnitArgs)
+	if err != nil {
+		return createdStorers, err
+	}
+
+	createdStorers = append(createdStorers, stateChangesUnit)
+	chainStorer.AddStorer(dataRetriever.BlockStateChangesUnit, stateChangesUnit)
+
+	return createdStorers, nil
+}
+
+func (psf *StorageServiceFactory) setupBlockWitnessStorer(chainStorer *dataRetriever.ChainStorer) ([]storage.Storer, error) {
+	createdStorers := make([]storage.Storer, 0)
+
+	if !psf.generalConfig.BlockWitness.Enabled {
+		return createdStorers, nil
+	}
+
+	blockWitnessUnitArgs, err := psf.createPruningStorerArgs(psf.generalConfig.BlockWitness.BlockWitnessStorage, dataRetriever.BlockWitnessUnit)
+	if err != nil {
+		return createdStorers, err
+	}
+	blockWitnessUnit, err := psf.createPruningPersister(blockWitnessUnitArgs)
+	if err != nil {
+		return createdStorers, err
+	}
+
+	createdStorers = append(createdStorers, blockWitnessUnit)
+	chainStorer.AddStorer(dataRetriever.BlockWitnessUnit, blockWitnessUnit)
+
+	return createdStorers, nil
+}
+
 func (psf *StorageServiceFactory) setupDbLookupExtensions(chainStorer *dataRetriever.ChainStorer) ([]storage.Storer, error) {
 	createdStorers := make([]storage.Storer, 0)
 
@@ -567,7 +750,10 @@ func (psf *StorageServiceFactory) setupDbLookupExtensions(chainStorer *dataRetri
 
 	// Create the eventsHashesByTxHash (PRUNING) storer
 	eventsHashesByTxHashConfig := psf.generalConfig.DbLookupExtensions.ResultsHashesByTxHashStorageConfig
-	eventsHashesByTxHashStorerArgs := psf.createPruningStorerArgs(eventsHashesByTxHashConfig)
+	eventsHashesByTxHashStorerArgs, err := psf.createPruningStorerArgs(eventsHashesByTxHashConfig, dataRetriever.ResultsHashesByTxHashUnit)
+	if err != nil {
+		return createdStorers, err
+	}
 	eventsHashesByTxHashPruningStorer, err := psf.createPruningPersister(eventsHashesByTxHashStorerArgs)
 	if err != nil {
 		return createdStorers, err
@@ -578,7 +764,10 @@ func (psf *StorageServiceFactory) setupDbLookupExtensions(chainStorer *dataRetri
 
 	// Create the miniblocksMetadata (PRUNING) storer
 	miniblocksMetadataConfig := psf.generalConfig.DbLookupExtensions.MiniblocksMetadataStorageConfig
-	miniblocksMetadataPruningStorerArgs := psf.createPruningStorerArgs(miniblocksMetadataConfig)
+	miniblocksMetadataPruningStorerArgs, err := psf.createPruningStorerArgs(miniblocksMetadataConfig, dataRetriever.MiniblocksMetadataUnit)
+	if err != nil {
+		return createdStorers, err
+	}
 	miniblocksMetadataPruningStorer, err := psf.createPruningPersister(miniblocksMetadataPruningStorerArgs)
 	if err != nil {
 		return createdStorers, err
@@ -592,7 +781,7 @@ func (psf *StorageServiceFactory) setupDbLookupExtensions(chainStorer *dataRetri
 	miniblockHashByTxHashDbConfig := GetDBFromConfig(miniblockHashByTxHashConfig.DB)
 	miniblockHashByTxHashDbConfig.FilePath = psf.pathManager.PathForStatic(shardID, miniblockHashByTxHashConfig.DB.FilePath)
 	miniblockHashByTxHashCacherConfig := GetCacherFromConfig(miniblockHashByTxHashConfig.Cache)
-	miniblockHashByTxHashUnit, err := storageUnit.NewStorageUnitFromConf(miniblockHashByTxHashCacherConfig, miniblockHashByTxHashDbConfig)
+	miniblockHashByTxHashUnit, err := psf.createStaticStorageUnit(miniblockHashByTxHashCacherConfig, miniblockHashByTxHashDbConfig, dataRetriever.MiniblockHashByTxHashUnit)
 	if err != nil {
 		return createdStorers, err
 	}
@@ -605,7 +794,7 @@ func (psf *StorageServiceFactory) setupDbLookupExtensions(chainStorer *dataRetri
 	blockHashByRoundDBConfig := GetDBFromConfig(blockHashByRoundConfig.DB)
 	blockHashByRoundDBConfig.FilePath = psf.pathManager.PathForStatic(shardID, blockHashByRoundConfig.DB.FilePath)
 	blockHashByRoundCacherConfig := GetCacherFromConfig(blockHashByRoundConfig.Cache)
-	blockHashByRoundUnit, err := storageUnit.NewStorageUnitFromConf(blockHashByRoundCacherConfig, blockHashByRoundDBConfig)
+	blockHashByRoundUnit, err := psf.createStaticStorageUnit(blockHashByRoundCacherConfig, blockHashByRoundDBConfig, dataRetriever.RoundHdrHashDataUnit)
 	if err != nil {
 		return createdStorers, err
 	}
@@ -618,7 +807,7 @@ func (psf *StorageServiceFactory) setupDbLookupExtensions(chainStorer *dataRetri
 	epochByHashDbConfig := GetDBFromConfig(epochByHashConfig.DB)
 	epochByHashDbConfig.FilePath = psf.pathManager.PathForStatic(shardID, epochByHashConfig.DB.FilePath)
 	epochByHashCacherConfig := GetCacherFromConfig(epochByHashConfig.Cache)
-	epochByHashUnit, err := storageUnit.NewStorageUnitFromConf(epochByHashCacherConfig, epochByHashDbConfig)
+	epochByHashUnit, err := psf.createStaticStorageUnit(epochByHashCacherConfig, epochByHashDbConfig, dataRetriever.EpochByHashUnit)
 	if err != nil {
 		return createdStorers, err
 	}
@@ -630,7 +819,7 @@ func (psf *StorageServiceFactory) setupDbLookupExtensions(chainStorer *dataRetri
 	esdtSuppliesDbConfig := GetDBFromConfig(esdtSuppliesConfig.DB)
 	esdtSuppliesDbConfig.FilePath = psf.pathManager.PathForStatic(shardID, esdtSuppliesConfig.DB.FilePath)
 	esdtSuppliesCacherConfig := GetCacherFromConfig(esdtSuppliesConfig.Cache)
-	esdtSuppliesUnit, err := storageUnit.NewStorageUnitFromConf(esdtSuppliesCacherConfig, esdtSuppliesDbConfig)
+	esdtSuppliesUnit, err := psf.createStaticStorageUnit(esdtSuppliesCacherConfig, esdtSuppliesDbConfig, dataRetriever.ESDTSuppliesUnit)
 	if err != nil {
 		return createdStorers, err
 	}
@@ -638,15 +827,35 @@ func (psf *StorageServiceFactory) setupDbLookupExtensions(chainStorer *dataRetri
 	createdStorers = append(createdStorers, esdtSuppliesUnit)
 	chainStorer.AddStorer(dataRetriever.ESDTSuppliesUnit, esdtSuppliesUnit)
 
+	// Create the accountTransactions (STATIC) storer
+	accountTransactionsConfig := psf.generalConfig.DbLookupExtensions.AccountTransactionsStorageConfig
+	accountTransactionsDbConfig := GetDBFromConfig(accountTransactionsConfig.DB)
+	accountTransactionsDbConfig.FilePath = psf.pathManager.PathForStatic(shardID, accountTransactionsConfig.DB.FilePath)
+	accountTransactionsCacherConfig := GetCacherFromConfig(accountTransactionsConfig.Cache)
+	accountTransactionsUnit, err := psf.createStaticStorageUnit(accountTransactionsCacherConfig, accountTransactionsDbConfig, dataRetriever.AccountTransactionsUnit)
+	if err != nil {
+		return createdStorers, err
+	}
+
+	createdStorers = append(createdStorers, accountTransactionsUnit)
+	chainStorer.AddStorer(dataRetriever.AccountTransactionsUnit, accountTransactionsUnit)
+
 	return createdStorers, nil
 }
 
-func (psf *StorageServiceFactory) createPruningStorerArgs(storageConfig config.StorageConfig) *pruning.StorerArgs {
+func (psf *StorageServiceFactory) createPruningStorerArgs(
+	storageConfig config.StorageConfig,
+	unitType dataRetriever.UnitType,
+) (*pruning.StorerArgs, error) {
 	numOfEpochsToKeep := uint32(psf.generalConfig.StoragePruning.NumEpochsToKeep)
 	numOfActivePersisters := uint32(psf.generalConfig.StoragePruning.NumActivePersisters)
 	pruningEnabled := psf.generalConfig.StoragePruning.Enabled
 	shardId := core.GetShardIDString(psf.shardCoordinator.SelfId())
 	dbPath := filepath.Join(psf.pathManager.PathForEpoch(shardId, psf.currentEpoch, storageConfig.DB.FilePath))
+	persisterFactory, coldStorageHandler, err := psf.createPersisterFactoryAndColdStorageHandler(storageConfig.DB)
+	if err != nil {
+		return nil, err
+	}
 	args := &pruning.StorerArgs{
 		Identifier:                storageConfig.DB.FilePath,
 		PruningEnabled:            pruningEnabled,
@@ -656,7 +865,8 @@ func (psf *StorageServiceFactory) createPruningStorerArgs(storageConfig config.S
 		CacheConf:                 GetCacherFromConfig(storageConfig.Cache),
 		PathManager:               psf.pathManager,
 		DbPath:                    dbPath,
-		PersisterFactory:          NewPersisterFactory(storageConfig.DB),
+		PersisterFactory:          psf.createPersisterFactoryWithStatistics(persisterFactory, unitType),
+		ColdStorageHandler:        coldStorageHandler,
 		NumOfEpochsToKeep:         numOfEpochsToKeep,
 		NumOfActivePersisters:     numOfActivePersisters,
 		Notifier:                  psf.epochStartNotifier,
@@ -664,7 +874,134 @@ func (psf *StorageServiceFactory) createPruningStorerArgs(storageConfig config.S
 		EnabledDbLookupExtensions: psf.generalConfig.DbLookupExtensions.Enabled,
 	}
 
-	return args
+	return args, nil
+}
+
+// createPersisterFactoryAndColdStorageHandler returns the persister factory of a pruning storer along with the
+// component which seals its old epochs persisters. When the cold storage is enabled, both are the same tiered factory.
+// In secondary mode the persisters only read the primary's databases, so nothing is sealed. Failing to create the
+// secondary persister factory is an error, as the node must never open its own writable databases in this mode
+func (psf *StorageServiceFactory) createPersisterFactoryAndColdStorageHandler(
+	dbConfig config.DBConfig,
+) (pruning.DbFactoryHandler, pruning.ColdStorageHandler, error) {
+	persisterFactory := NewPersisterFactory(dbConfig)
+	if psf.generalConfig.SecondaryMode.Enabled {
+		secondaryPersisterFactory, err := psf.createSecondaryPersisterFactory(persisterFactory)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w while creating the secondary persister factory for %s", err, dbConfig.FilePath)
+		}
+
+		return secondaryPersisterFactory, coldStorage.NewDisabledColdStorageHandler(), nil
+	}
+
+	coldStorageConfig := psf.generalConfig.StoragePruning.ColdStorage
+	if !coldStorageConfig.Enabled {
+		return persisterFactory, coldStorage.NewDisabledColdStorageHandler(), nil
+	}
+
+	tieredPersisterFactory, err := coldStorage.NewTieredPersisterFactory(coldStorage.ArgsTieredPersisterFactory{
+		PersisterFactory: persisterFactory,
+		DatabasePath:     psf.pathManager.DatabasePath(),
+		Config:           coldStorageConfig,
+	})
+	if err != nil {
+		log.Error("can not create the tiered persister factory, cold storage disabled",
+			"unit", dbConfig.FilePath, "error", err.Error())
+		return persisterFactory, coldStorage.NewDisabledColdStorageHandler(), nil
+	}
+
+	return tieredPersisterFactory, tieredPersisterFactory, nil
+}
+
+// createSecondaryPersisterFactory returns a factory creating persisters which read the databases of the primary node
+// the secondary mode is following
+func (psf *StorageServiceFactory) createSecondaryPersisterFactory(
+	persisterFactory secondary.DbFactoryHandler,
+) (pruning.DbFactoryHandler, error) {
+	secondaryConfig := psf.generalConfig.SecondaryMode
+	return secondary.NewPersisterFactory(secondary.ArgsPersisterFactory{
+		PersisterFactory: persisterFactory,
+		PrimaryDbPath:    filepath.Join(secondaryConfig.PrimaryWorkingDirectory, common.DefaultDBPath, psf.generalConfig.GeneralSettings.ChainID),
+		DbPath:           psf.pathManager.DatabasePath(),
+		RefreshInterval:  time.Duration(secondaryConfig.RefreshIntervalInMilliseconds) * time.Millisecond,
+	})
+}
+
+// createPersisterFactoryWithStatistics wraps the persister factory of a pruning storer so that the operations done
+// on each of its epoch persisters are collected in the storage statistics
+func (psf *StorageServiceFactory) createPersisterFactoryWithStatistics(
+	persisterFactory pruning.DbFactoryHandler,
+	unitType dataRetriever.UnitType,
+) pruning.DbFactoryHandler {
+	persisterFactoryWithStatistics, err := statistics.NewPersisterFactoryWithStatistics(
+		persisterFactory,
+		psf.storageStatistics,
+		unitType.String(),
+	)
+	if err != nil {
+		log.Error("can not create the persister factory with statistics, statistics disabled",
+			"unit", unitType.String(), "error", err.Error())
+		return persisterFactory
+	}
+
+	return persisterFactoryWithStatistics
+}
+
+// createStaticStorageUnit creates a storage unit which does not change with the epoch, collecting the operations
+// done on its persister in the storage statistics
+func (psf *StorageServiceFactory) createStaticStorageUnit(
+	cacheConf storageUnit.CacheConfig,
+	dbConf storageUnit.DBConfig,
+	unitType dataRetriever.UnitType,
+) (*storageUnit.Unit, error) {
+	if dbConf.MaxBatchSize > int(cacheConf.Capacity) {
+		return nil, storage.ErrCacheSizeIsLowerThanBatchSize
+	}
+
+	cacher, err := storageUnit.NewCache(cacheConf)
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := psf.createStaticPersister(dbConf)
+	if err != nil {
+		return nil, err
+	}
+
+	persister := psf.storageStatistics.WrapPersister(db, unitType.String(), common.DefaultStaticDbString, dbConf.FilePath)
+	unit, err := storageUnit.NewStorageUnit(cacher, persister)
+	if err != nil {
+		_ = db.Destroy()
+		return nil, err
+	}
+
+	return unit, nil
+}
+
+func (psf *StorageServiceFactory) createStaticPersister(dbConf storageUnit.DBConfig) (storage.Persister, error) {
+	if !psf.generalConfig.SecondaryMode.Enabled {
+		return storageUnit.NewDB(storageUnit.ArgDB{
+			DBType:            dbConf.Type,
+			Path:              dbConf.FilePath,
+			BatchDelaySeconds: dbConf.BatchDelaySeconds,
+			MaxBatchSize:      dbConf.MaxBatchSize,
+			MaxOpenFiles:      dbConf.MaxOpenFiles,
+			Compression:       dbConf.Compression,
+		})
+	}
+
+	secondaryPersisterFactory, err := psf.createSecondaryPersisterFactory(NewPersisterFactory(config.DBConfig{
+		Type:              string(dbConf.Type),
+		BatchDelaySeconds: dbConf.BatchDelaySeconds,
+		MaxBatchSize:      dbConf.MaxBatchSize,
+		MaxOpenFiles:      dbConf.MaxOpenFiles,
+		Compression:       dbConf.Compression,
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	return secondaryPersisterFactory.Create(dbConf.FilePath)
 }
 
 func (psf *StorageServiceFactory) createTrieEpochRootHashStorerIfNeeded() (storage.Storer, error) {
@@ -676,9 +1013,10 @@ func (psf *StorageServiceFactory) createTrieEpochRootHashStorerIfNeeded() (stora
 	shardId := core.GetShardIDString(psf.shardCoordinator.SelfId())
 	dbPath := psf.pathManager.PathForStatic(shardId, psf.generalConfig.TrieEpochRootHashStorage.DB.FilePath)
 	trieEpochRootHashDbConfig.FilePath = dbPath
-	trieEpochRootHashStorageUnit, err := storageUnit.NewStorageUnitFromConf(
+	trieEpochRootHashStorageUnit, err := psf.createStaticStorageUnit(
 		GetCacherFromConfig(psf.generalConfig.TrieEpochRootHashStorage.Cache),
-		trieEpochRootHashDbConfig)
+		trieEpochRootHashDbConfig,
+		dataRetriever.TrieEpochRootHashUnit)
 	if err != nil {
 		return nil, err
 	}
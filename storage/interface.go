@@ -158,6 +158,21 @@ type LatestDataFromStorage struct {
 	EpochStartRound uint64
 }
 
+// RangeKeysOptions holds the options used when iterating over the keys stored by a storer in multiple epochs
+type RangeKeysOptions struct {
+	FromEpoch             uint32
+	ToEpoch               uint32
+	KeyPrefix             []byte
+	IncludeClosedEpochs   bool
+	AllowDuplicateEntries bool
+}
+
+// StorerWithRangeKeysInEpochs is an extended storer able to iterate over the keys stored in a range of epochs
+type StorerWithRangeKeysInEpochs interface {
+	Storer
+	RangeKeysInEpochs(options RangeKeysOptions, handler func(key []byte, val []byte) bool) error
+}
+
 // ShardCoordinator defines what a shard state coordinator should hold
 type ShardCoordinator interface {
 	NumberOfShards() uint32
@@ -224,3 +239,35 @@ type SerializedStoredData interface {
 	GetSerialized() []byte
 	SetSerialized([]byte)
 }
+
+// StorageStatisticsHandler defines what a component which collects the operations statistics of the storage units should do
+type StorageStatisticsHandler interface {
+	WrapPersister(persister Persister, unit string, epoch string, path string) Persister
+	GetStatistics(computeDiskUsage bool) []UnitStatistics
+	IsInterfaceNil() bool
+}
+
+// OperationsStatistics holds the operations counters of a persister or of a whole storage unit
+type OperationsStatistics struct {
+	NumPuts      uint64 `json:"numPuts"`
+	NumGets      uint64 `json:"numGets"`
+	NumMisses    uint64 `json:"numMisses"`
+	NumRemoves   uint64 `json:"numRemoves"`
+	BytesWritten uint64 `json:"bytesWritten"`
+	BytesRead    uint64 `json:"bytesRead"`
+	DiskUsage    uint64 `json:"diskUsage,omitempty"`
+}
+
+// PersisterStatistics represents the DTO structure holding the statistics of one persister of a storage unit
+type PersisterStatistics struct {
+	OperationsStatistics
+	Epoch string `json:"epoch"`
+	Path  string `json:"path"`
+}
+
+// UnitStatistics represents the DTO structure holding the statistics of a storage unit, aggregated over all its persisters
+type UnitStatistics struct {
+	OperationsStatistics
+	Unit       string                `json:"unit"`
+	Persisters []PersisterStatistics `json:"persisters"`
+}
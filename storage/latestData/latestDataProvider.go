@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go-core/data/block"
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
@@ -33,6 +34,9 @@ type ArgsLatestDataProvider struct {
 	ParentDir             string
 	DefaultEpochString    string
 	DefaultShardString    string
+	// PersisterFactory opens the bootstrap storage persisters. If not provided, the persisters are opened as
+	// configured in the bootstrap storage config
+	PersisterFactory storage.PersisterFactory
 }
 
 type iteratedShardData struct {
@@ -49,10 +53,16 @@ type latestDataProvider struct {
 	parentDir             string
 	defaultEpochString    string
 	defaultShardString    string
+	persisterFactory      storage.PersisterFactory
 }
 
 // NewLatestDataProvider returns a new instance of latestDataProvider
 func NewLatestDataProvider(args ArgsLatestDataProvider) (*latestDataProvider, error) {
+	persisterFactory := args.PersisterFactory
+	if check.IfNil(persisterFactory) {
+		persisterFactory = factory.NewPersisterFactory(args.GeneralConfig.BootstrapStorage.DB)
+	}
+
 	return &latestDataProvider{
 		generalConfig:         args.GeneralConfig,
 		parentDir:             args.ParentDir,
@@ -60,6 +70,7 @@ func NewLatestDataProvider(args ArgsLatestDataProvider) (*latestDataProvider, er
 		defaultShardString:    args.DefaultShardString,
 		defaultEpochString:    args.DefaultEpochString,
 		bootstrapDataProvider: args.BootstrapDataProvider,
+		persisterFactory:      persisterFactory,
 	}, nil
 }
 
@@ -124,7 +135,6 @@ func (ldp *latestDataProvider) getEpochDirs() ([]string, error) {
 }
 
 func (ldp *latestDataProvider) getLastEpochAndRoundFromStorage(parentDir string, lastEpoch uint32) (storage.LatestDataFromStorage, error) {
-	persisterFactory := factory.NewPersisterFactory(ldp.generalConfig.BootstrapStorage.DB)
 	pathWithoutShard := filepath.Join(
 		parentDir,
 		fmt.Sprintf("%s_%d", ldp.defaultEpochString, lastEpoch),
@@ -146,7 +156,7 @@ func (ldp *latestDataProvider) getLastEpochAndRoundFromStorage(parentDir string,
 			ldp.generalConfig.BootstrapStorage.DB.FilePath,
 		)
 
-		shardData := ldp.loadDataForShard(highestRoundInStoredShards, shardIdStr, persisterFactory, persisterPath)
+		shardData := ldp.loadDataForShard(highestRoundInStoredShards, shardIdStr, ldp.persisterFactory, persisterPath)
 		if shardData.successful {
 			epochStartRound = shardData.epochStartRound
 			highestRoundInStoredShards = shardData.bootstrapData.LastRound
@@ -118,6 +118,32 @@ func TestLatestDataProvider_Get(t *testing.T) {
 	assert.Equal(t, expectedRes, result)
 }
 
+func TestLatestDataProvider_GetShouldUseTheProvidedPersisterFactory(t *testing.T) {
+	t.Parallel()
+
+	args := getLatestDataProviderArgs()
+	args.DirectoryReader = &mock.DirectoryReaderStub{
+		ListDirectoriesAsStringCalled: func(directoryPath string) ([]string, error) {
+			if directoryPath == args.ParentDir {
+				return []string{"Epoch_0"}, nil
+			}
+			return []string{"Shard_0"}, nil
+		},
+	}
+	args.PersisterFactory = &mock.PersisterFactoryStub{}
+	usedProvidedFactory := false
+	args.BootstrapDataProvider = &mock.BootStrapDataProviderStub{
+		LoadForPathCalled: func(persisterFactory storage.PersisterFactory, path string) (*bootstrapStorage.BootstrapData, storage.Storer, error) {
+			usedProvidedFactory = persisterFactory == args.PersisterFactory
+			return nil, nil, errors.New("local error")
+		},
+	}
+
+	ldp, _ := NewLatestDataProvider(args)
+	_, _ = ldp.Get()
+	assert.True(t, usedProvidedFactory)
+}
+
 func getLatestDataProviderArgs() ArgsLatestDataProvider {
 	return ArgsLatestDataProvider{
 		GeneralConfig:         config.Config{},
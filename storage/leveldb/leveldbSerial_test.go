@@ -7,6 +7,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/storage/leveldb"
+	storageStubs "github.com/ElrondNetwork/elrond-go/testscommon/storage"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -219,3 +220,9 @@ func TestSerialDB_Destroy(t *testing.T) {
 
 	assert.Nil(t, err, "no error expected but got %s", err)
 }
+
+func TestSerialDB_PersisterConformance(t *testing.T) {
+	storageStubs.RunPersisterConformanceTests(t, func(t *testing.T) storage.Persister {
+		return createSerialLevelDb(t, 10, 1, 10)
+	})
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/storage/leveldb"
+	storageStubs "github.com/ElrondNetwork/elrond-go/testscommon/storage"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -351,3 +352,9 @@ func testDbAllMethodsShouldNotPanic(t *testing.T, closeHandler func(db *leveldb.
 	err = ldb.Remove([]byte("key4"))
 	require.Equal(t, storage.ErrDBIsClosed, err)
 }
+
+func TestDB_PersisterConformance(t *testing.T) {
+	storageStubs.RunPersisterConformanceTests(t, func(t *testing.T) storage.Persister {
+		return createLevelDb(t, 10, 1, 10)
+	})
+}
@@ -2,7 +2,6 @@ package memorydb
 
 import (
 	"encoding/base64"
-	"errors"
 	"fmt"
 	"sync"
 
@@ -44,7 +43,7 @@ func (s *DB) Get(key []byte) ([]byte, error) {
 	val, ok := s.db[string(key)]
 
 	if !ok {
-		return nil, fmt.Errorf("key: %s not found", base64.StdEncoding.EncodeToString(key))
+		return nil, fmt.Errorf("%w, key: %s", storage.ErrKeyNotFound, base64.StdEncoding.EncodeToString(key))
 	}
 
 	return val, nil
@@ -58,7 +57,7 @@ func (s *DB) Has(key []byte) error {
 	_, ok := s.db[string(key)]
 
 	if !ok {
-		return errors.New("key not found")
+		return storage.ErrKeyNotFound
 	}
 	return nil
 }
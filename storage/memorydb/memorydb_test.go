@@ -3,7 +3,9 @@ package memorydb_test
 import (
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	storageStubs "github.com/ElrondNetwork/elrond-go/testscommon/storage"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -120,3 +122,9 @@ func Test_RangeKeys(t *testing.T) {
 
 	assert.Equal(t, keysVals, recovered)
 }
+
+func TestDB_PersisterConformance(t *testing.T) {
+	storageStubs.RunPersisterConformanceTests(t, func(t *testing.T) storage.Persister {
+		return memorydb.New()
+	})
+}
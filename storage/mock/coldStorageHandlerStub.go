@@ -0,0 +1,38 @@
+package mock
+
+// ColdStorageHandlerStub -
+type ColdStorageHandlerStub struct {
+	IsEnabledCalled              func() bool
+	NumEpochsBeforeSealingCalled func() uint32
+	SealAsyncCalled              func(paths []string)
+}
+
+// IsEnabled -
+func (cshs *ColdStorageHandlerStub) IsEnabled() bool {
+	if cshs.IsEnabledCalled != nil {
+		return cshs.IsEnabledCalled()
+	}
+
+	return false
+}
+
+// NumEpochsBeforeSealing -
+func (cshs *ColdStorageHandlerStub) NumEpochsBeforeSealing() uint32 {
+	if cshs.NumEpochsBeforeSealingCalled != nil {
+		return cshs.NumEpochsBeforeSealingCalled()
+	}
+
+	return 0
+}
+
+// SealAsync -
+func (cshs *ColdStorageHandlerStub) SealAsync(paths []string) {
+	if cshs.SealAsyncCalled != nil {
+		cshs.SealAsyncCalled(paths)
+	}
+}
+
+// IsInterfaceNil -
+func (cshs *ColdStorageHandlerStub) IsInterfaceNil() bool {
+	return cshs == nil
+}
@@ -0,0 +1,87 @@
+package pebble
+
+import (
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/cockroachdb/pebble"
+)
+
+var _ storage.Batcher = (*batch)(nil)
+
+const removed = "removed"
+
+type batch struct {
+	batch      *pebble.Batch
+	cachedData map[string][]byte
+	mutBatch   sync.RWMutex
+}
+
+// NewBatch creates a batch
+func NewBatch() *batch {
+	return &batch{
+		batch:      &pebble.Batch{},
+		cachedData: make(map[string][]byte),
+		mutBatch:   sync.RWMutex{},
+	}
+}
+
+// Put inserts one entry - key, value pair - into the batch
+func (b *batch) Put(key []byte, val []byte) error {
+	b.mutBatch.Lock()
+	defer b.mutBatch.Unlock()
+
+	err := b.batch.Set(key, val, nil)
+	if err != nil {
+		return err
+	}
+	b.cachedData[string(key)] = val
+
+	return nil
+}
+
+// Delete deletes the entry for the provided key from the batch
+func (b *batch) Delete(key []byte) error {
+	b.mutBatch.Lock()
+	defer b.mutBatch.Unlock()
+
+	err := b.batch.Delete(key, nil)
+	if err != nil {
+		return err
+	}
+	b.cachedData[string(key)] = []byte(removed)
+
+	return nil
+}
+
+// Reset clears the contents of the batch
+func (b *batch) Reset() {
+	b.mutBatch.Lock()
+	b.batch.Reset()
+	b.cachedData = make(map[string][]byte)
+	b.mutBatch.Unlock()
+}
+
+// Get returns the value
+func (b *batch) Get(key []byte) []byte {
+	b.mutBatch.RLock()
+	defer b.mutBatch.RUnlock()
+
+	return b.cachedData[string(key)]
+}
+
+func (b *batch) applyTo(db *pebble.DB) error {
+	b.mutBatch.Lock()
+	defer b.mutBatch.Unlock()
+
+	if b.batch.Empty() {
+		return nil
+	}
+
+	return db.Apply(b.batch, pebble.Sync)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (b *batch) IsInterfaceNil() bool {
+	return b == nil
+}
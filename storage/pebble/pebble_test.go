@@ -0,0 +1,190 @@
+package pebble_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/pebble"
+	storageStubs "github.com/ElrondNetwork/elrond-go/testscommon/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createPebbleDb(t *testing.T, batchDelaySeconds int, maxBatchSize int, maxOpenFiles int) (p *pebble.DB) {
+	pdb, err := pebble.NewDB(t.TempDir(), batchDelaySeconds, maxBatchSize, maxOpenFiles)
+
+	assert.Nil(t, err, "Failed creating pebble database file")
+	return pdb
+}
+
+func TestNewDB_InvalidNumOpenFilesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pdb, err := pebble.NewDB(t.TempDir(), 10, 1, 0)
+	assert.Nil(t, pdb)
+	assert.Equal(t, storage.ErrInvalidNumOpenFiles, err)
+}
+
+func TestDB_DoubleOpenShouldError(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	pdb1, err := pebble.NewDB(dir, 10, 1, 10)
+	require.Nil(t, err)
+
+	defer func() {
+		_ = pdb1.Close()
+	}()
+
+	_, err = pebble.NewDB(dir, 10, 1, 10)
+	assert.NotNil(t, err)
+}
+
+func TestDB_ReopenShouldKeepData(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	pdb, err := pebble.NewDB(dir, 10, 100, 10)
+	require.Nil(t, err)
+
+	key, val := []byte("key"), []byte("value")
+	err = pdb.Put(key, val)
+	require.Nil(t, err)
+
+	// closing should write the pending batch
+	err = pdb.Close()
+	require.Nil(t, err)
+
+	pdbReopened, err := pebble.NewDB(dir, 10, 100, 10)
+	require.Nil(t, err)
+
+	recovered, err := pdbReopened.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, val, recovered)
+
+	_ = pdbReopened.Close()
+}
+
+func TestDB_GetAfterPutBeforeTimeout(t *testing.T) {
+	t.Parallel()
+
+	key, val := []byte("key"), []byte("value")
+	pdb := createPebbleDb(t, 1, 100, 10)
+
+	err := pdb.Put(key, val)
+	assert.Nil(t, err)
+
+	v, err := pdb.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, val, v)
+
+	numEntries := 0
+	pdb.RangeKeys(func(key []byte, val []byte) bool {
+		numEntries++
+		return true
+	})
+	assert.Equal(t, 0, numEntries)
+
+	_ = pdb.Destroy()
+}
+
+func TestDB_GetOKAfterPutWithTimeout(t *testing.T) {
+	t.Parallel()
+
+	key, val := []byte("key"), []byte("value")
+	pdb := createPebbleDb(t, 1, 100, 10)
+
+	err := pdb.Put(key, val)
+	assert.Nil(t, err)
+	time.Sleep(time.Second * 3)
+
+	v, err := pdb.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, val, v)
+
+	numEntries := 0
+	pdb.RangeKeys(func(key []byte, val []byte) bool {
+		numEntries++
+		return true
+	})
+	assert.Equal(t, 1, numEntries)
+
+	_ = pdb.Destroy()
+}
+
+func TestDB_RemoveBeforeTimeoutOK(t *testing.T) {
+	t.Parallel()
+
+	key, val := []byte("key"), []byte("value")
+	pdb := createPebbleDb(t, 1, 100, 10)
+
+	err := pdb.Put(key, val)
+	assert.Nil(t, err)
+	time.Sleep(time.Second * 3)
+
+	_ = pdb.Remove(key)
+	v, err := pdb.Get(key)
+	assert.Nil(t, v)
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+
+	_ = pdb.Destroy()
+}
+
+func TestDB_MethodCallsAfterCloseOrDestroy(t *testing.T) {
+	t.Parallel()
+
+	t.Run("when closing", func(t *testing.T) {
+		t.Parallel()
+
+		testDbAllMethodsShouldNotPanic(t, func(db *pebble.DB) {
+			_ = db.Close()
+		})
+	})
+	t.Run("when destroying", func(t *testing.T) {
+		t.Parallel()
+
+		testDbAllMethodsShouldNotPanic(t, func(db *pebble.DB) {
+			_ = db.Destroy()
+		})
+	})
+}
+
+func testDbAllMethodsShouldNotPanic(t *testing.T, closeHandler func(db *pebble.DB)) {
+	defer func() {
+		r := recover()
+		if r != nil {
+			assert.Fail(t, fmt.Sprintf("should have not panic %v", r))
+		}
+	}()
+
+	pdb := createPebbleDb(t, 1, 1, 10)
+	closeHandler(pdb)
+
+	err := pdb.Put([]byte("key1"), []byte("val1"))
+	require.Equal(t, storage.ErrDBIsClosed, err)
+
+	_, err = pdb.Get([]byte("key2"))
+	require.Equal(t, storage.ErrDBIsClosed, err)
+
+	err = pdb.Has([]byte("key3"))
+	require.Equal(t, storage.ErrDBIsClosed, err)
+
+	pdb.RangeKeys(func(key []byte, value []byte) bool {
+		require.Fail(t, "should have not called range")
+		return false
+	})
+
+	err = pdb.Remove([]byte("key4"))
+	require.Equal(t, storage.ErrDBIsClosed, err)
+
+	err = pdb.Close()
+	require.Nil(t, err)
+}
+
+func TestDB_PersisterConformance(t *testing.T) {
+	storageStubs.RunPersisterConformanceTests(t, func(t *testing.T) storage.Persister {
+		return createPebbleDb(t, 10, 1, 10)
+	})
+}
@@ -9,6 +9,8 @@ import (
 	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
 )
 
+var _ storage.StorerWithRangeKeysInEpochs = (*FullHistoryPruningStorer)(nil)
+
 // FullHistoryPruningStorer represents a storer for full history nodes
 // which creates a new persister for each epoch and removes older activePersisters
 type FullHistoryPruningStorer struct {
@@ -152,8 +154,8 @@ func (fhps *FullHistoryPruningStorer) getFromOldEpoch(key []byte, epoch uint32)
 		"key", key,
 		"error", err.Error())
 
-	return nil, fmt.Errorf("key %s not found in %s",
-		hex.EncodeToString(key), fhps.identifier)
+	return nil, fmt.Errorf("%w - getFromOldEpoch, unit = %s, key = %s, epoch = %d",
+		storage.ErrKeyNotFound, fhps.identifier, hex.EncodeToString(key), epoch)
 }
 
 func (fhps *FullHistoryPruningStorer) getOrOpenPersister(epoch uint32) (storage.Persister, error) {
@@ -5,6 +5,7 @@ import (
 	"crypto/rand"
 	"fmt"
 	"math"
+	"os"
 	"path/filepath"
 	"sync"
 	"testing"
@@ -16,8 +17,10 @@ import (
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/storage/factory"
 	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/ElrondNetwork/elrond-go/storage/mock"
 	"github.com/ElrondNetwork/elrond-go/storage/pathmanager"
 	"github.com/ElrondNetwork/elrond-go/storage/pruning"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -386,3 +389,67 @@ func TestFullHistoryPruningStorer_ConcurrentOperations(t *testing.T) {
 	// if the "resource temporary unavailable" occurs, this test will take longer than this to execute
 	require.True(t, elapsedTime < 100*time.Second)
 }
+
+func TestFullHistoryPruningStorer_RangeKeysInEpochsShouldOpenOldEpochsFoundOnDisk(t *testing.T) {
+	t.Parallel()
+
+	dbPath := t.TempDir()
+	args := getDefaultArgs()
+	args.StartingEpoch = 10
+	args.PathManager = &testscommon.PathManagerStub{
+		PathForEpochCalled: func(shardId string, epoch uint32, identifier string) string {
+			return filepath.Join(dbPath, fmt.Sprintf("Epoch_%d", epoch), identifier)
+		},
+	}
+	createPersister := args.PersisterFactory.Create
+	mutCreatedPaths := sync.Mutex{}
+	createdPaths := make(map[string]struct{})
+	args.PersisterFactory = &mock.PersisterFactoryStub{
+		CreateCalled: func(path string) (storage.Persister, error) {
+			mutCreatedPaths.Lock()
+			createdPaths[path] = struct{}{}
+			mutCreatedPaths.Unlock()
+
+			return createPersister(path)
+		},
+	}
+	fhArgs := &pruning.FullHistoryStorerArgs{
+		StorerArgs:               args,
+		NumOfOldActivePersisters: 2,
+	}
+
+	fhps, err := pruning.NewFullHistoryPruningStorer(fhArgs)
+	require.Nil(t, err)
+	_ = fhps.PutInEpoch([]byte("key10"), []byte("val10"), 10)
+	_ = fhps.PutInEpoch([]byte("key7"), []byte("val7"), 7)
+	_ = fhps.PutInEpoch([]byte("key4"), []byte("val4"), 4)
+	_ = fhps.Close()
+	require.Nil(t, os.MkdirAll(filepath.Join(dbPath, "Epoch_7", args.Identifier), os.ModePerm))
+	require.Nil(t, os.MkdirAll(filepath.Join(dbPath, "Epoch_4", args.Identifier), os.ModePerm))
+
+	// a new storer does not know about the old epochs until it finds them on the disk
+	fhps, err = pruning.NewFullHistoryPruningStorer(fhArgs)
+	require.Nil(t, err)
+
+	result := make(map[string]string)
+	options := storage.RangeKeysOptions{FromEpoch: 5, ToEpoch: math.MaxUint32}
+	err = fhps.RangeKeysInEpochs(options, func(key []byte, val []byte) bool {
+		result[string(key)] = string(val)
+		return true
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"key10": "val10"}, result)
+
+	options.IncludeClosedEpochs = true
+	err = fhps.RangeKeysInEpochs(options, func(key []byte, val []byte) bool {
+		result[string(key)] = string(val)
+		return true
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"key10": "val10", "key7": "val7"}, result)
+
+	mutCreatedPaths.Lock()
+	_, epoch6Created := createdPaths[filepath.Join(dbPath, "Epoch_6", args.Identifier)]
+	mutCreatedPaths.Unlock()
+	assert.False(t, epoch6Created)
+}
@@ -17,3 +17,11 @@ type DbFactoryHandler interface {
 	CreateDisabled() storage.Persister
 	IsInterfaceNil() bool
 }
+
+// ColdStorageHandler defines what a component able to move the old epochs' persisters into the cold storage tier should do
+type ColdStorageHandler interface {
+	IsEnabled() bool
+	NumEpochsBeforeSealing() uint32
+	SealAsync(paths []string)
+	IsInterfaceNil() bool
+}
@@ -5,7 +5,6 @@ import (
 	"errors"
 	"fmt"
 	"math"
-	"runtime/debug"
 	"sync"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
@@ -21,6 +20,7 @@ import (
 )
 
 var _ storage.Storer = (*PruningStorer)(nil)
+var _ storage.StorerWithRangeKeysInEpochs = (*PruningStorer)(nil)
 
 var log = logger.GetOrCreate("storage/pruning")
 
@@ -89,6 +89,7 @@ type PruningStorer struct {
 	pathManager            storage.PathManagerHandler
 	dbPath                 string
 	persisterFactory       DbFactoryHandler
+	coldStorageHandler     ColdStorageHandler
 	mutEpochPrepareHdr     sync.RWMutex
 	epochPrepareHdr        data.HeaderHandler
 	oldDataCleanerProvider clean.OldDataCleanerProvider
@@ -143,6 +144,7 @@ func initPruningStorer(
 	pdb.pruningEnabled = args.PruningEnabled
 	pdb.identifier = identifier
 	pdb.persisterFactory = args.PersisterFactory
+	pdb.coldStorageHandler = args.ColdStorageHandler
 	pdb.shardCoordinator = args.ShardCoordinator
 	pdb.cacher = cache
 	pdb.epochPrepareHdr = &block.MetaBlock{Epoch: epochForDefaultEpochPrepareHdr}
@@ -172,6 +174,9 @@ func checkArgs(args *StorerArgs) error {
 	if check.IfNil(args.PersisterFactory) {
 		return storage.ErrNilPersisterFactory
 	}
+	if check.IfNil(args.ColdStorageHandler) {
+		return storage.ErrNilColdStorageHandler
+	}
 	if check.IfNil(args.ShardCoordinator) {
 		return storage.ErrNilShardCoordinator
 	}
@@ -454,8 +459,8 @@ func (ps *PruningStorer) GetFromEpoch(key []byte, epoch uint32) ([]byte, error)
 	pd, exists := ps.persistersMapByEpoch[epoch]
 	ps.lock.RUnlock()
 	if !exists {
-		return nil, fmt.Errorf("key %s not found in %s",
-			hex.EncodeToString(key), ps.identifier)
+		return nil, fmt.Errorf("%w - GetFromEpoch, unit = %s, key = %s, epoch = %d",
+			storage.ErrKeyNotFound, ps.identifier, hex.EncodeToString(key), epoch)
 	}
 
 	persister, closePersister, err := ps.createAndInitPersisterIfClosedProtected(pd)
@@ -475,9 +480,8 @@ func (ps *PruningStorer) GetFromEpoch(key []byte, epoch uint32) ([]byte, error)
 		"key", key,
 		"error", err.Error())
 
-	return nil, fmt.Errorf("key %s not found in %s",
-		hex.EncodeToString(key), ps.identifier)
-
+	return nil, fmt.Errorf("%w - GetFromEpoch, unit = %s, key = %s, epoch = %d",
+		storage.ErrKeyNotFound, ps.identifier, hex.EncodeToString(key), epoch)
 }
 
 // GetBulkFromEpoch will return a slice of keys only in the persister for the given epoch
@@ -766,9 +770,41 @@ func (ps *PruningStorer) changeEpoch(header data.HeaderHandler) error {
 		log.Warn("closing persisters", "error", err.Error())
 		return err
 	}
+
+	ps.sealOldPersisters(epoch)
+
 	return nil
 }
 
+// sealOldPersisters moves the closed persisters older than the configured number of epochs into the cold storage
+// tier. Only applicable when the old epochs data is kept.
+// should be called under mutex protection
+func (ps *PruningStorer) sealOldPersisters(epoch uint32) {
+	if !ps.coldStorageHandler.IsEnabled() || ps.oldDataCleanerProvider.ShouldClean() {
+		return
+	}
+
+	numEpochsBeforeSealing := ps.coldStorageHandler.NumEpochsBeforeSealing()
+	if epoch < numEpochsBeforeSealing {
+		return
+	}
+
+	shardID := core.GetShardIDString(ps.shardCoordinator.SelfId())
+	paths := make([]string, 0)
+	for e := int64(epoch - numEpochsBeforeSealing); e >= 0; e-- {
+		pd, exists := ps.persistersMapByEpoch[uint32(e)]
+		if !exists {
+			paths = append(paths, ps.pathManager.PathForEpoch(shardID, uint32(e), ps.identifier))
+			continue
+		}
+		if pd.getIsClosed() {
+			paths = append(paths, pd.path)
+		}
+	}
+
+	ps.coldStorageHandler.SealAsync(paths)
+}
+
 // should be called under mutex protection
 func (ps *PruningStorer) extendSavedEpochsIfNeeded(header data.HeaderHandler) bool {
 	if ps.extendPersisterLifeHandler() {
@@ -950,13 +986,6 @@ func (ps *PruningStorer) processPersistersToClose() []*persisterData {
 	return persistersToClose
 }
 
-// RangeKeys does nothing as it is unable to iterate over multiple persisters
-// RangeKeys -
-func (ps *PruningStorer) RangeKeys(_ func(key []byte, val []byte) bool) {
-	log.Error("improper use of PruningStorer.RangeKeys()")
-	debug.PrintStack()
-}
-
 // IsInterfaceNil returns true if there is no value under the interface
 func (ps *PruningStorer) IsInterfaceNil() bool {
 	return ps == nil
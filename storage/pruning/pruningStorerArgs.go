@@ -14,6 +14,7 @@ type StorerArgs struct {
 	PathManager               storage.PathManagerHandler
 	DbPath                    string
 	PersisterFactory          DbFactoryHandler
+	ColdStorageHandler        ColdStorageHandler
 	Notifier                  EpochStartNotifier
 	OldDataCleanerProvider    clean.OldDataCleanerProvider
 	MaxBatchSize              int
@@ -78,6 +78,7 @@ func getDefaultArgs() *pruning.StorerArgs {
 		CacheConf:              cacheConf,
 		DbPath:                 dbConf.FilePath,
 		PersisterFactory:       persisterFactory,
+		ColdStorageHandler:     &mock.ColdStorageHandlerStub{},
 		NumOfEpochsToKeep:      2,
 		NumOfActivePersisters:  2,
 		Notifier:               &mock.EpochStartNotifierStub{},
@@ -105,6 +106,7 @@ func getDefaultArgsSerialDB() *pruning.StorerArgs {
 		CacheConf:              cacheConf,
 		DbPath:                 dbConf.FilePath,
 		PersisterFactory:       persisterFactory,
+		ColdStorageHandler:     &mock.ColdStorageHandlerStub{},
 		NumOfEpochsToKeep:      3,
 		NumOfActivePersisters:  2,
 		Notifier:               &mock.EpochStartNotifierStub{},
@@ -319,6 +321,75 @@ func TestPruningStorer_DestroyUnitShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestNewPruningStorer_NilColdStorageHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := getDefaultArgs()
+	args.ColdStorageHandler = nil
+	ps, err := pruning.NewPruningStorer(args)
+
+	assert.Nil(t, ps)
+	assert.Equal(t, storage.ErrNilColdStorageHandler, err)
+}
+
+func TestPruningStorer_ChangeEpochShouldSealOldPersisters(t *testing.T) {
+	t.Parallel()
+
+	sealedPaths := make([][]string, 0)
+	args := getDefaultArgs()
+	args.NumOfEpochsToKeep = 10
+	args.ColdStorageHandler = &mock.ColdStorageHandlerStub{
+		IsEnabledCalled: func() bool {
+			return true
+		},
+		NumEpochsBeforeSealingCalled: func() uint32 {
+			return 2
+		},
+		SealAsyncCalled: func(paths []string) {
+			sealedPaths = append(sealedPaths, paths)
+		},
+	}
+	ps, _ := pruning.NewPruningStorer(args)
+
+	_ = ps.ChangeEpochSimple(1)
+	assert.Equal(t, 0, len(sealedPaths))
+
+	_ = ps.ChangeEpochSimple(2)
+	_ = ps.ChangeEpochSimple(3)
+	expectedSealedPaths := [][]string{
+		{"Epoch_0/Shard_0/id"},
+		{"Epoch_1/Shard_0/id", "Epoch_0/Shard_0/id"},
+	}
+	assert.Equal(t, expectedSealedPaths, sealedPaths)
+}
+
+func TestPruningStorer_ChangeEpochShouldNotSealPersistersIfOldDataIsCleaned(t *testing.T) {
+	t.Parallel()
+
+	args := getDefaultArgs()
+	args.OldDataCleanerProvider = &testscommon.OldDataCleanerProviderStub{
+		ShouldCleanCalled: func() bool {
+			return true
+		},
+	}
+	args.ColdStorageHandler = &mock.ColdStorageHandlerStub{
+		IsEnabledCalled: func() bool {
+			return true
+		},
+		NumEpochsBeforeSealingCalled: func() uint32 {
+			return 1
+		},
+		SealAsyncCalled: func(paths []string) {
+			assert.Fail(t, "should have not been called")
+		},
+	}
+	ps, _ := pruning.NewPruningStorer(args)
+
+	_ = ps.ChangeEpochSimple(1)
+	_ = ps.ChangeEpochSimple(2)
+	_ = ps.ChangeEpochSimple(3)
+}
+
 func TestNewPruningStorer_Has_OnePersisterShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -931,3 +1002,177 @@ func TestPruningStorer_ConcurrentOperations(t *testing.T) {
 	// if the "resource temporary unavailable" occurs, this test will take longer than this to execute
 	require.True(t, elapsedTime < 100*time.Second)
 }
+
+func createPruningStorerWithDataInEpochs(t *testing.T) *pruning.PruningStorer {
+	// the epochs iterations are merged, so the persisters should iterate their keys in ascending order
+	dbPath := t.TempDir()
+	args := getDefaultArgs()
+	args.StartingEpoch = 3
+	args.NumOfEpochsToKeep = 4
+	args.NumOfActivePersisters = 2
+	args.PathManager = &testscommon.PathManagerStub{
+		PathForEpochCalled: func(shardId string, epoch uint32, identifier string) string {
+			return filepath.Join(dbPath, fmt.Sprintf("Epoch_%d", epoch), identifier)
+		},
+	}
+	args.PersisterFactory = &mock.PersisterFactoryStub{
+		CreateCalled: func(path string) (storage.Persister, error) {
+			// a batch of one entry writes each put on the disk, before the keys are iterated
+			return leveldb.NewSerialDB(path, 1, 1, 10)
+		},
+	}
+	ps, err := pruning.NewPruningStorer(args)
+	require.Nil(t, err)
+	t.Cleanup(func() {
+		_ = ps.Close()
+	})
+
+	for epoch := uint32(0); epoch <= 3; epoch++ {
+		epochStr := strconv.Itoa(int(epoch))
+		_ = ps.PutInEpoch([]byte("key"+epochStr), []byte("val"+epochStr), epoch)
+		_ = ps.PutInEpoch([]byte("common"), []byte("val"+epochStr), epoch)
+	}
+	ps.ClearCache()
+
+	return ps
+}
+
+func rangeKeysToMap(t *testing.T, ps storage.StorerWithRangeKeysInEpochs, options storage.RangeKeysOptions) map[string]string {
+	result := make(map[string]string)
+	err := ps.RangeKeysInEpochs(options, func(key []byte, val []byte) bool {
+		_, exists := result[string(key)]
+		require.False(t, exists)
+
+		result[string(key)] = string(val)
+		return true
+	})
+	require.Nil(t, err)
+
+	return result
+}
+
+func TestPruningStorer_RangeKeysShouldIterateActivePersisters(t *testing.T) {
+	t.Parallel()
+
+	ps := createPruningStorerWithDataInEpochs(t)
+
+	result := make(map[string]string)
+	ps.RangeKeys(func(key []byte, val []byte) bool {
+		result[string(key)] = string(val)
+		return true
+	})
+
+	expected := map[string]string{
+		"key3":   "val3",
+		"key2":   "val2",
+		"common": "val3",
+	}
+	assert.Equal(t, expected, result)
+}
+
+func TestPruningStorer_RangeKeysInEpochs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid epochs range should error", func(t *testing.T) {
+		t.Parallel()
+
+		ps := createPruningStorerWithDataInEpochs(t)
+		err := ps.RangeKeysInEpochs(storage.RangeKeysOptions{FromEpoch: 2, ToEpoch: 1}, func(key []byte, val []byte) bool {
+			assert.Fail(t, "should have not been called")
+			return true
+		})
+		assert.Equal(t, storage.ErrInvalidEpochsRange, err)
+	})
+	t.Run("closed epochs should be iterated only if requested", func(t *testing.T) {
+		t.Parallel()
+
+		ps := createPruningStorerWithDataInEpochs(t)
+
+		result := rangeKeysToMap(t, ps, storage.RangeKeysOptions{FromEpoch: 1, ToEpoch: 2})
+		assert.Equal(t, map[string]string{"key2": "val2", "common": "val2"}, result)
+
+		result = rangeKeysToMap(t, ps, storage.RangeKeysOptions{FromEpoch: 1, ToEpoch: 2, IncludeClosedEpochs: true})
+		assert.Equal(t, map[string]string{"key2": "val2", "key1": "val1", "common": "val2"}, result)
+	})
+	t.Run("key prefix should filter", func(t *testing.T) {
+		t.Parallel()
+
+		ps := createPruningStorerWithDataInEpochs(t)
+
+		result := rangeKeysToMap(t, ps, storage.RangeKeysOptions{ToEpoch: 3, KeyPrefix: []byte("key"), IncludeClosedEpochs: true})
+		assert.Equal(t, map[string]string{"key3": "val3", "key2": "val2", "key1": "val1", "key0": "val0"}, result)
+	})
+	t.Run("duplicate entries if allowed", func(t *testing.T) {
+		t.Parallel()
+
+		ps := createPruningStorerWithDataInEpochs(t)
+
+		values := make([]string, 0)
+		options := storage.RangeKeysOptions{ToEpoch: 3, KeyPrefix: []byte("common"), IncludeClosedEpochs: true, AllowDuplicateEntries: true}
+		err := ps.RangeKeysInEpochs(options, func(key []byte, val []byte) bool {
+			values = append(values, string(val))
+			return true
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"val3", "val2", "val1", "val0"}, values)
+	})
+	t.Run("keys should be reported in ascending order", func(t *testing.T) {
+		t.Parallel()
+
+		ps := createPruningStorerWithDataInEpochs(t)
+
+		keys := make([]string, 0)
+		options := storage.RangeKeysOptions{ToEpoch: 3, IncludeClosedEpochs: true}
+		err := ps.RangeKeysInEpochs(options, func(key []byte, val []byte) bool {
+			keys = append(keys, string(key))
+			return true
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"common", "key0", "key1", "key2", "key3"}, keys)
+	})
+	t.Run("unordered persister should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := getDefaultArgs()
+		args.PersisterFactory = &mock.PersisterFactoryStub{
+			CreateCalled: func(path string) (storage.Persister, error) {
+				return &mock.PersisterStub{
+					RangeKeysCalled: func(handler func(key []byte, val []byte) bool) {
+						_ = handler([]byte("key2"), []byte("val2")) && handler([]byte("key1"), []byte("val1"))
+					},
+				}, nil
+			},
+		}
+		ps, _ := pruning.NewPruningStorer(args)
+
+		err := ps.RangeKeysInEpochs(storage.RangeKeysOptions{ToEpoch: 1}, func(key []byte, val []byte) bool {
+			return true
+		})
+		assert.True(t, errors.Is(err, storage.ErrUnorderedRangeKeys))
+	})
+	t.Run("handler returning false should stop", func(t *testing.T) {
+		t.Parallel()
+
+		ps := createPruningStorerWithDataInEpochs(t)
+
+		numCalls := 0
+		options := storage.RangeKeysOptions{ToEpoch: 3, IncludeClosedEpochs: true}
+		err := ps.RangeKeysInEpochs(options, func(key []byte, val []byte) bool {
+			numCalls++
+			return false
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, 1, numCalls)
+	})
+	t.Run("pruning disabled should iterate the single persister", func(t *testing.T) {
+		t.Parallel()
+
+		args := getDefaultArgs()
+		args.PruningEnabled = false
+		ps, _ := pruning.NewPruningStorer(args)
+		_ = ps.Put([]byte("key"), []byte("val"))
+
+		result := rangeKeysToMap(t, ps, storage.RangeKeysOptions{FromEpoch: 5, ToEpoch: 5})
+		assert.Equal(t, map[string]string{"key": "val"}, result)
+	})
+}
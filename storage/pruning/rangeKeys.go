@@ -0,0 +1,356 @@
+package pruning
+
+import (
+	"bytes"
+	"container/heap"
+	"fmt"
+	"math"
+	"os"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// epochPersisterOpener returns the persister of the provided epoch and the function to be called after the iteration
+// over it ended. A nil persister means that the epoch holds no data
+type epochPersisterOpener func(epoch uint32) (storage.Persister, func(), error)
+
+func noopClosePersister() {}
+
+// RangeKeys iterates over the (key, value) pairs stored in all the active persisters, in ascending key order. A key
+// stored in more than one epoch is reported only once, with the value from the newest epoch
+func (ps *PruningStorer) RangeKeys(handler func(key []byte, val []byte) bool) {
+	err := ps.RangeKeysInEpochs(createDefaultRangeKeysOptions(), handler)
+	if err != nil {
+		log.Warn("PruningStorer.RangeKeys", "identifier", ps.identifier, "error", err.Error())
+	}
+}
+
+// RangeKeysInEpochs iterates over the (key, value) pairs stored in the persisters of the epochs from the provided
+// range, in ascending key order. The persisters of the closed epochs are opened only for the duration of the iteration.
+// If pruning is disabled, the single persister holding the data from all the epochs is used
+func (ps *PruningStorer) RangeKeysInEpochs(options storage.RangeKeysOptions, handler func(key []byte, val []byte) bool) error {
+	if handler == nil {
+		return nil
+	}
+	if options.FromEpoch > options.ToEpoch {
+		return storage.ErrInvalidEpochsRange
+	}
+
+	return rangeKeysInEpochs(ps.getEpochsToRange(options), ps.openPersisterForRange, options, handler)
+}
+
+func (ps *PruningStorer) getEpochsToRange(options storage.RangeKeysOptions) []uint32 {
+	ps.lock.RLock()
+	defer ps.lock.RUnlock()
+
+	if !ps.pruningEnabled {
+		return []uint32{ps.activePersisters[0].epoch}
+	}
+
+	activeEpochs := make(map[uint32]struct{}, len(ps.activePersisters))
+	for _, pd := range ps.activePersisters {
+		activeEpochs[pd.epoch] = struct{}{}
+	}
+
+	epochs := make([]uint32, 0, len(ps.persistersMapByEpoch))
+	for epoch := range ps.persistersMapByEpoch {
+		if epoch < options.FromEpoch || epoch > options.ToEpoch {
+			continue
+		}
+
+		_, isActive := activeEpochs[epoch]
+		if !isActive && !options.IncludeClosedEpochs {
+			continue
+		}
+
+		epochs = append(epochs, epoch)
+	}
+
+	sortEpochsDescending(epochs)
+
+	return epochs
+}
+
+func (ps *PruningStorer) openPersisterForRange(epoch uint32) (storage.Persister, func(), error) {
+	ps.lock.RLock()
+	if !ps.pruningEnabled {
+		persister := ps.activePersisters[0].getPersister()
+		ps.lock.RUnlock()
+
+		return persister, noopClosePersister, nil
+	}
+
+	pd, exists := ps.persistersMapByEpoch[epoch]
+	ps.lock.RUnlock()
+	if !exists {
+		// the persister was removed in the meantime
+		return nil, noopClosePersister, nil
+	}
+
+	return ps.createAndInitPersisterIfClosedProtected(pd)
+}
+
+// RangeKeys iterates over the (key, value) pairs stored in all the active persisters, in ascending key order. A key
+// stored in more than one epoch is reported only once, with the value from the newest epoch
+func (fhps *FullHistoryPruningStorer) RangeKeys(handler func(key []byte, val []byte) bool) {
+	err := fhps.RangeKeysInEpochs(createDefaultRangeKeysOptions(), handler)
+	if err != nil {
+		log.Warn("FullHistoryPruningStorer.RangeKeys", "identifier", fhps.identifier, "error", err.Error())
+	}
+}
+
+// RangeKeysInEpochs iterates over the (key, value) pairs stored in the persisters of the epochs from the provided
+// range, in ascending key order. When the closed epochs are included, the old epochs found on the disk are opened
+// through the old epochs persisters cache
+func (fhps *FullHistoryPruningStorer) RangeKeysInEpochs(options storage.RangeKeysOptions, handler func(key []byte, val []byte) bool) error {
+	if handler == nil {
+		return nil
+	}
+	if options.FromEpoch > options.ToEpoch {
+		return storage.ErrInvalidEpochsRange
+	}
+
+	return rangeKeysInEpochs(fhps.getEpochsToRange(options), fhps.openPersisterForRange, options, handler)
+}
+
+func (fhps *FullHistoryPruningStorer) getEpochsToRange(options storage.RangeKeysOptions) []uint32 {
+	epochs := fhps.PruningStorer.getEpochsToRange(options)
+	if !options.IncludeClosedEpochs {
+		return epochs
+	}
+
+	knownEpochs := make(map[uint32]struct{}, len(epochs))
+	for _, epoch := range epochs {
+		knownEpochs[epoch] = struct{}{}
+	}
+
+	fhps.lock.RLock()
+	lastEpoch := fhps.activePersisters[0].epoch
+	fhps.lock.RUnlock()
+	if lastEpoch > options.ToEpoch {
+		lastEpoch = options.ToEpoch
+	}
+
+	for epoch := int64(lastEpoch); epoch >= int64(options.FromEpoch); epoch-- {
+		_, isKnown := knownEpochs[uint32(epoch)]
+		if isKnown {
+			continue
+		}
+
+		path := createPersisterPathForEpoch(fhps.args, uint32(epoch), fhps.shardId)
+		_, err := os.Stat(path)
+		if err != nil {
+			continue
+		}
+
+		epochs = append(epochs, uint32(epoch))
+	}
+
+	sortEpochsDescending(epochs)
+
+	return epochs
+}
+
+func (fhps *FullHistoryPruningStorer) openPersisterForRange(epoch uint32) (storage.Persister, func(), error) {
+	if fhps.isEpochActive(epoch) {
+		return fhps.PruningStorer.openPersisterForRange(epoch)
+	}
+
+	// the old epochs persisters cache will close the persister when evicted
+	persister, err := fhps.getOrOpenPersister(epoch)
+
+	return persister, noopClosePersister, err
+}
+
+// rangeKeysInEpochs calls the handler for each (key, value) pair from the provided epochs, in ascending key order,
+// until the handler returns false. The epochs are expected from the newest to the oldest one and their persisters
+// should iterate their keys in ascending order, so a k-way merge over them reports a key stored in several epochs
+// once, with the value from the newest epoch, or once per epoch, newest first, if duplicate entries are allowed
+func rangeKeysInEpochs(
+	epochs []uint32,
+	openPersister epochPersisterOpener,
+	options storage.RangeKeysOptions,
+	handler func(key []byte, val []byte) bool,
+) error {
+	iterators := make(epochIteratorsHeap, 0, len(epochs))
+	closePersisters := make([]func(), 0, len(epochs))
+	defer func() {
+		for _, iterator := range iterators {
+			iterator.stop()
+		}
+		for _, closePersister := range closePersisters {
+			closePersister()
+		}
+	}()
+
+	for index, epoch := range epochs {
+		persister, closePersister, errOpen := openPersister(epoch)
+		if errOpen != nil {
+			return errOpen
+		}
+		closePersisters = append(closePersisters, closePersister)
+		if check.IfNil(persister) {
+			continue
+		}
+
+		iterator := newEpochIterator(index, persister, options.KeyPrefix)
+		if !iterator.next() {
+			iterator.stop()
+			continue
+		}
+		iterators = append(iterators, iterator)
+	}
+
+	heap.Init(&iterators)
+	var lastReportedKey []byte
+	for len(iterators) > 0 {
+		iterator := iterators[0]
+		isDuplicate := lastReportedKey != nil && bytes.Equal(iterator.key, lastReportedKey)
+		if !isDuplicate || options.AllowDuplicateEntries {
+			if !handler(iterator.key, iterator.val) {
+				return nil
+			}
+			lastReportedKey = iterator.key
+		}
+
+		previousKey := iterator.key
+		if !iterator.next() {
+			iterator.stop()
+			heap.Pop(&iterators)
+			continue
+		}
+		if bytes.Compare(iterator.key, previousKey) <= 0 {
+			return fmt.Errorf("%w in epoch %d", storage.ErrUnorderedRangeKeys, epochs[iterator.index])
+		}
+		heap.Fix(&iterators, 0)
+	}
+
+	return nil
+}
+
+type keyValuePair struct {
+	key []byte
+	val []byte
+}
+
+// epochIterator turns the push-style iteration of an epoch persister into a pull-style one, so the iterations of
+// several epochs can be merged
+type epochIterator struct {
+	index   int
+	entries chan keyValuePair
+	done    chan struct{}
+	key     []byte
+	val     []byte
+}
+
+func newEpochIterator(index int, persister storage.Persister, keyPrefix []byte) *epochIterator {
+	iterator := &epochIterator{
+		index:   index,
+		entries: make(chan keyValuePair),
+		done:    make(chan struct{}),
+	}
+
+	go func() {
+		defer close(iterator.entries)
+
+		persister.RangeKeys(func(key []byte, val []byte) bool {
+			if !bytes.HasPrefix(key, keyPrefix) {
+				return true
+			}
+
+			// the persister might reuse the buffers after the handler returns
+			entry := keyValuePair{
+				key: append([]byte{}, key...),
+				val: append([]byte{}, val...),
+			}
+			select {
+			case iterator.entries <- entry:
+				return true
+			case <-iterator.done:
+				return false
+			}
+		})
+	}()
+
+	return iterator
+}
+
+func (iterator *epochIterator) next() bool {
+	entry, ok := <-iterator.entries
+	if !ok {
+		return false
+	}
+
+	iterator.key = entry.key
+	iterator.val = entry.val
+
+	return true
+}
+
+// stop ends the iteration and waits for the persister to be released
+func (iterator *epochIterator) stop() {
+	select {
+	case <-iterator.done:
+		return
+	default:
+		close(iterator.done)
+	}
+
+	for range iterator.entries {
+		// drain the entries sent before the iteration noticed the stop
+	}
+}
+
+// epochIteratorsHeap orders the iterators by their current key and, for equal keys, from the newest epoch to the
+// oldest one
+type epochIteratorsHeap []*epochIterator
+
+// Len returns the number of iterators
+func (h epochIteratorsHeap) Len() int {
+	return len(h)
+}
+
+// Less returns true if the iterator from position i should be consumed before the one from position j
+func (h epochIteratorsHeap) Less(i, j int) bool {
+	comparison := bytes.Compare(h[i].key, h[j].key)
+	if comparison != 0 {
+		return comparison < 0
+	}
+
+	return h[i].index < h[j].index
+}
+
+// Swap swaps the iterators from the provided positions
+func (h epochIteratorsHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+}
+
+// Push adds an iterator
+func (h *epochIteratorsHeap) Push(x interface{}) {
+	*h = append(*h, x.(*epochIterator))
+}
+
+// Pop removes and returns the last iterator
+func (h *epochIteratorsHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	iterator := old[n-1]
+	*h = old[:n-1]
+
+	return iterator
+}
+
+func createDefaultRangeKeysOptions() storage.RangeKeysOptions {
+	return storage.RangeKeysOptions{
+		FromEpoch: 0,
+		ToEpoch:   math.MaxUint32,
+	}
+}
+
+func sortEpochsDescending(epochs []uint32) {
+	sort.Slice(epochs, func(i, j int) bool {
+		return epochs[i] > epochs[j]
+	})
+}
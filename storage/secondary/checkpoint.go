@@ -0,0 +1,189 @@
+package secondary
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+const maxCheckpointAttempts = 5
+
+type fileKind int
+
+const (
+	ignoredFile fileKind = iota
+	// metadataFile describes the database's structure (the current manifest, its options and format markers).
+	// A change of a metadata file means that the set of table files changed
+	metadataFile
+	// journalFile holds the writes not yet flushed into table files. It is only appended to
+	journalFile
+	// tableFile is immutable once written, the database only removes it after a compaction
+	tableFile
+)
+
+// getFileKind classifies the files of both the LevelDB and the Pebble databases
+func getFileKind(name string) fileKind {
+	switch {
+	case name == "LOCK" || strings.HasPrefix(name, "LOG") || strings.HasSuffix(name, ".tmp"):
+		return ignoredFile
+	case strings.HasSuffix(name, ".ldb") || strings.HasSuffix(name, ".sst"):
+		return tableFile
+	case strings.HasSuffix(name, ".log"):
+		return journalFile
+	case name == "CURRENT" || strings.HasPrefix(name, "MANIFEST-") || strings.HasPrefix(name, "OPTIONS-") ||
+		strings.HasPrefix(name, "marker."):
+		return metadataFile
+	default:
+		return ignoredFile
+	}
+}
+
+// computeFingerprint returns a description of the database files, which changes whenever the database is written
+func computeFingerprint(sourcePath string, kinds ...fileKind) (string, error) {
+	files, err := ioutil.ReadDir(sourcePath)
+	if err != nil {
+		return "", err
+	}
+
+	var sb strings.Builder
+	for _, file := range files {
+		if file.IsDir() || !containsKind(kinds, getFileKind(file.Name())) {
+			continue
+		}
+
+		sb.WriteString(fmt.Sprintf("%s:%d:%d;", file.Name(), file.Size(), file.ModTime().UnixNano()))
+	}
+
+	return sb.String(), nil
+}
+
+func containsKind(kinds []fileKind, kind fileKind) bool {
+	for _, k := range kinds {
+		if k == kind {
+			return true
+		}
+	}
+
+	return false
+}
+
+// createCheckpoint creates, in the destination directory, a copy of the database found in the source directory
+// that can be opened while the source database is being written by its owner. The metadata and journal files are
+// copied while the immutable table files are hard linked (or copied, if the directories are on different devices).
+// If the metadata changed while the checkpoint was created, a table file referenced by the copied metadata might
+// have been removed, so the checkpoint is created again
+func createCheckpoint(sourcePath string, destinationPath string) error {
+	var err error
+	for attempt := 0; attempt < maxCheckpointAttempts; attempt++ {
+		err = tryCreateCheckpoint(sourcePath, destinationPath)
+		if err == nil {
+			return nil
+		}
+
+		log.Trace("secondary: checkpoint attempt failed", "source", sourcePath, "attempt", attempt, "error", err)
+		errRemove := os.RemoveAll(destinationPath)
+		if errRemove != nil {
+			return errRemove
+		}
+	}
+
+	return fmt.Errorf("%w for %s: %v", storage.ErrInconsistentCheckpoint, sourcePath, err)
+}
+
+func tryCreateCheckpoint(sourcePath string, destinationPath string) error {
+	metadataBefore, err := computeFingerprint(sourcePath, metadataFile)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(destinationPath, os.ModePerm)
+	if err != nil {
+		return err
+	}
+
+	// the order matters: the table files referenced by the copied metadata and journals must exist when linked
+	for _, kind := range []fileKind{metadataFile, journalFile, tableFile} {
+		err = copyFiles(sourcePath, destinationPath, kind)
+		if err != nil {
+			return err
+		}
+	}
+
+	metadataAfter, err := computeFingerprint(sourcePath, metadataFile)
+	if err != nil {
+		return err
+	}
+	if metadataBefore != metadataAfter {
+		return fmt.Errorf("the database metadata changed while creating the checkpoint")
+	}
+
+	return nil
+}
+
+func copyFiles(sourcePath string, destinationPath string, kind fileKind) error {
+	files, err := ioutil.ReadDir(sourcePath)
+	if err != nil {
+		return err
+	}
+
+	for _, file := range files {
+		if file.IsDir() || getFileKind(file.Name()) != kind {
+			continue
+		}
+
+		source := filepath.Join(sourcePath, file.Name())
+		destination := filepath.Join(destinationPath, file.Name())
+		if kind == tableFile {
+			err = linkFile(source, destination)
+		} else {
+			err = copyFile(source, destination)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func linkFile(source string, destination string) error {
+	err := os.Link(source, destination)
+	if err == nil {
+		return nil
+	}
+	if os.IsNotExist(err) {
+		return err
+	}
+
+	log.Trace("secondary: can not hard link the table file, copying it", "source", source, "error", err)
+
+	return copyFile(source, destination)
+}
+
+func copyFile(source string, destination string) error {
+	sourceFile, err := os.Open(source)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = sourceFile.Close()
+	}()
+
+	destinationFile, err := os.Create(destination)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(destinationFile, sourceFile)
+	if err != nil {
+		_ = destinationFile.Close()
+		return err
+	}
+
+	return destinationFile.Close()
+}
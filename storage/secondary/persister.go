@@ -0,0 +1,279 @@
+package secondary
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var _ storage.Persister = (*persister)(nil)
+
+var log = logger.GetOrCreate("storage/secondary")
+
+const checkpointDirPrefix = "Checkpoint"
+
+// checkpointIndex makes the checkpoint directories unique, even for persisters sharing the same checkpoints path
+var checkpointIndex uint64
+
+// ArgsPersister holds the arguments needed to create a secondary persister
+type ArgsPersister struct {
+	SourcePath       string
+	CheckpointsPath  string
+	PersisterFactory DbFactoryHandler
+	RefreshInterval  time.Duration
+}
+
+// persister reads, without ever writing it, the database another process keeps open at the source path. LevelDB
+// and Pebble take the database's lock even when opened in read only mode, so the database is opened from a
+// checkpoint instead. The checkpoint is renewed in the background once every refresh interval, if the source
+// database changed, so that the reads never wait for a checkpoint to be created. If the source database does not
+// exist yet, the persister behaves as an empty one
+type persister struct {
+	sourcePath       string
+	checkpointsPath  string
+	persisterFactory DbFactoryHandler
+	refreshInterval  time.Duration
+	cancel           context.CancelFunc
+
+	mutRefresh  sync.Mutex
+	fingerprint string
+
+	mutDB          sync.RWMutex
+	db             storage.Persister
+	checkpointPath string
+	isClosed       bool
+}
+
+// NewPersister creates a new secondary persister, opening the current checkpoint of the source database
+func NewPersister(args ArgsPersister) (*persister, error) {
+	if len(args.SourcePath) == 0 || len(args.CheckpointsPath) == 0 {
+		return nil, storage.ErrEmptyFilePath
+	}
+	if check.IfNil(args.PersisterFactory) {
+		return nil, storage.ErrNilPersisterFactory
+	}
+	if args.RefreshInterval <= 0 {
+		return nil, storage.ErrInvalidRefreshInterval
+	}
+
+	p := &persister{
+		sourcePath:       args.SourcePath,
+		checkpointsPath:  args.CheckpointsPath,
+		persisterFactory: args.PersisterFactory,
+		refreshInterval:  args.RefreshInterval,
+	}
+
+	err := p.Refresh()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	p.cancel = cancel
+	go p.refreshPeriodically(ctx)
+
+	return p, nil
+}
+
+// Refresh opens a new checkpoint of the source database, if the database changed since the last checkpoint
+func (p *persister) Refresh() error {
+	p.mutRefresh.Lock()
+	defer p.mutRefresh.Unlock()
+
+	p.mutDB.RLock()
+	isClosed := p.isClosed
+	p.mutDB.RUnlock()
+	if isClosed {
+		return storage.ErrDBIsClosed
+	}
+
+	fingerprint, err := computeFingerprint(p.sourcePath, metadataFile, journalFile, tableFile)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if fingerprint == p.fingerprint {
+		return nil
+	}
+
+	checkpointPath := filepath.Join(
+		p.checkpointsPath,
+		fmt.Sprintf("%s_%d", checkpointDirPrefix, atomic.AddUint64(&checkpointIndex, 1)),
+	)
+	err = createCheckpoint(p.sourcePath, checkpointPath)
+	if err != nil {
+		return err
+	}
+
+	db, err := p.persisterFactory.Create(checkpointPath)
+	if err != nil {
+		removeCheckpoint(checkpointPath)
+		return err
+	}
+
+	p.mutDB.Lock()
+	if p.isClosed {
+		p.mutDB.Unlock()
+		closeCheckpoint(db, checkpointPath)
+		return storage.ErrDBIsClosed
+	}
+	oldDB, oldCheckpointPath := p.db, p.checkpointPath
+	p.db, p.checkpointPath = db, checkpointPath
+	p.mutDB.Unlock()
+
+	p.fingerprint = fingerprint
+	if !check.IfNil(oldDB) {
+		closeCheckpoint(oldDB, oldCheckpointPath)
+	}
+
+	log.Trace("secondary: opened a new checkpoint", "source", p.sourcePath, "checkpoint", checkpointPath)
+
+	return nil
+}
+
+func (p *persister) refreshPeriodically(ctx context.Context) {
+	timer := time.NewTimer(p.refreshInterval)
+	defer timer.Stop()
+
+	for {
+		timer.Reset(p.refreshInterval)
+
+		select {
+		case <-timer.C:
+			err := p.Refresh()
+			if err != nil {
+				log.Debug("secondary: can not refresh the checkpoint, the previous one will be used",
+					"source", p.sourcePath, "error", err)
+			}
+		case <-ctx.Done():
+			log.Debug("secondary: closing the checkpoint refresh", "source", p.sourcePath)
+			return
+		}
+	}
+}
+
+// Put returns ErrReadOnlyPersister as the source database is never written
+func (p *persister) Put(_, _ []byte) error {
+	return storage.ErrReadOnlyPersister
+}
+
+// Get gets the value associated to the key from the latest checkpoint of the source database
+func (p *persister) Get(key []byte) ([]byte, error) {
+	p.mutDB.RLock()
+	defer p.mutDB.RUnlock()
+
+	if p.isClosed {
+		return nil, storage.ErrDBIsClosed
+	}
+	if check.IfNil(p.db) {
+		return nil, storage.ErrKeyNotFound
+	}
+
+	return p.db.Get(key)
+}
+
+// Has returns nil if the given key is present in the latest checkpoint of the source database
+func (p *persister) Has(key []byte) error {
+	p.mutDB.RLock()
+	defer p.mutDB.RUnlock()
+
+	if p.isClosed {
+		return storage.ErrDBIsClosed
+	}
+	if check.IfNil(p.db) {
+		return storage.ErrKeyNotFound
+	}
+
+	return p.db.Has(key)
+}
+
+// RangeKeys iterates over the key-value pairs of the latest checkpoint of the source database
+func (p *persister) RangeKeys(handler func(key []byte, val []byte) bool) {
+	if handler == nil {
+		return
+	}
+
+	p.mutDB.RLock()
+	defer p.mutDB.RUnlock()
+
+	if p.isClosed || check.IfNil(p.db) {
+		return
+	}
+
+	p.db.RangeKeys(handler)
+}
+
+// Remove returns ErrReadOnlyPersister as the source database is never written
+func (p *persister) Remove(_ []byte) error {
+	return storage.ErrReadOnlyPersister
+}
+
+// Close stops the checkpoint refresh, closes the opened checkpoint and removes it
+func (p *persister) Close() error {
+	if p.cancel != nil {
+		p.cancel()
+	}
+
+	p.mutDB.Lock()
+	defer p.mutDB.Unlock()
+
+	if p.isClosed {
+		return nil
+	}
+	p.isClosed = true
+
+	if check.IfNil(p.db) {
+		return nil
+	}
+
+	err := p.db.Close()
+	removeCheckpoint(p.checkpointPath)
+	p.db = nil
+
+	return err
+}
+
+// Destroy closes the persister and removes its checkpoints. The source database is not affected
+func (p *persister) Destroy() error {
+	err := p.Close()
+	if err != nil {
+		return err
+	}
+
+	return p.DestroyClosed()
+}
+
+// DestroyClosed removes the checkpoints of the already closed persister. The source database is not affected
+func (p *persister) DestroyClosed() error {
+	return os.RemoveAll(p.checkpointsPath)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (p *persister) IsInterfaceNil() bool {
+	return p == nil
+}
+
+func closeCheckpoint(db storage.Persister, checkpointPath string) {
+	err := db.Close()
+	if err != nil {
+		log.Debug("secondary: can not close the checkpoint", "checkpoint", checkpointPath, "error", err)
+	}
+	removeCheckpoint(checkpointPath)
+}
+
+func removeCheckpoint(checkpointPath string) {
+	err := os.RemoveAll(checkpointPath)
+	if err != nil {
+		log.Debug("secondary: can not remove the checkpoint", "checkpoint", checkpointPath, "error", err)
+	}
+}
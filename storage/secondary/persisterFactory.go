@@ -0,0 +1,111 @@
+package secondary
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// ArgsPersisterFactory holds the arguments needed to create a secondary persister factory
+type ArgsPersisterFactory struct {
+	PersisterFactory DbFactoryHandler
+	PrimaryDbPath    string
+	DbPath           string
+	RefreshInterval  time.Duration
+}
+
+// persisterFactory creates secondary persisters over the databases of a primary node. The databases directories of
+// both nodes share the same structure, so a path in one of them designates the same storage unit in the other one:
+// the primary's database is the source of the persister while its checkpoints are kept in this node's directory
+type persisterFactory struct {
+	persisterFactory DbFactoryHandler
+	primaryDbPath    string
+	dbPath           string
+	refreshInterval  time.Duration
+}
+
+// NewPersisterFactory creates a new secondary persister factory
+func NewPersisterFactory(args ArgsPersisterFactory) (*persisterFactory, error) {
+	if check.IfNil(args.PersisterFactory) {
+		return nil, storage.ErrNilPersisterFactory
+	}
+	if len(args.PrimaryDbPath) == 0 || len(args.DbPath) == 0 {
+		return nil, storage.ErrEmptyFilePath
+	}
+	if args.RefreshInterval <= 0 {
+		return nil, storage.ErrInvalidRefreshInterval
+	}
+
+	return &persisterFactory{
+		persisterFactory: args.PersisterFactory,
+		primaryDbPath:    filepath.Clean(args.PrimaryDbPath),
+		dbPath:           filepath.Clean(args.DbPath),
+		refreshInterval:  args.RefreshInterval,
+	}, nil
+}
+
+// CheckConfig verifies the secondary mode configuration
+func CheckConfig(cfg config.SecondaryModeConfig) error {
+	if len(cfg.PrimaryWorkingDirectory) == 0 {
+		return fmt.Errorf("%w: PrimaryWorkingDirectory should be provided", storage.ErrInvalidSecondaryModeConfig)
+	}
+	if cfg.RefreshIntervalInMilliseconds < 1 {
+		return fmt.Errorf("%w: RefreshIntervalInMilliseconds should be positive", storage.ErrInvalidSecondaryModeConfig)
+	}
+
+	return nil
+}
+
+// Create returns a secondary persister for the storage unit found at the provided path, which can be located either
+// in this node's databases directory or in the primary's one
+func (pf *persisterFactory) Create(path string) (storage.Persister, error) {
+	sourcePath, checkpointsPath, err := pf.computePaths(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewPersister(ArgsPersister{
+		SourcePath:       sourcePath,
+		CheckpointsPath:  checkpointsPath,
+		PersisterFactory: pf.persisterFactory,
+		RefreshInterval:  pf.refreshInterval,
+	})
+}
+
+func (pf *persisterFactory) computePaths(path string) (string, string, error) {
+	relativePath, ok := relativeTo(pf.dbPath, path)
+	if ok {
+		return filepath.Join(pf.primaryDbPath, relativePath), path, nil
+	}
+
+	relativePath, ok = relativeTo(pf.primaryDbPath, path)
+	if ok {
+		return path, filepath.Join(pf.dbPath, relativePath), nil
+	}
+
+	return "", "", fmt.Errorf("%w: %s", storage.ErrPathOutsideDatabases, path)
+}
+
+func relativeTo(basePath string, path string) (string, bool) {
+	relativePath, err := filepath.Rel(basePath, filepath.Clean(path))
+	if err != nil || relativePath == "." || relativePath == ".." || strings.HasPrefix(relativePath, ".."+string(filepath.Separator)) {
+		return "", false
+	}
+
+	return relativePath, true
+}
+
+// CreateDisabled will return a new disabled persister
+func (pf *persisterFactory) CreateDisabled() storage.Persister {
+	return pf.persisterFactory.CreateDisabled()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pf *persisterFactory) IsInterfaceNil() bool {
+	return pf == nil
+}
@@ -0,0 +1,134 @@
+package secondary
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsPersisterFactory(rootPath string) ArgsPersisterFactory {
+	return ArgsPersisterFactory{
+		PersisterFactory: &mock.PersisterFactoryStub{
+			CreateCalled: createLevelDB,
+		},
+		PrimaryDbPath:   filepath.Join(rootPath, "primary", "db"),
+		DbPath:          filepath.Join(rootPath, "secondary", "db"),
+		RefreshInterval: time.Hour,
+	}
+}
+
+func directoryExists(path string) bool {
+	fileInfo, err := os.Stat(path)
+	return err == nil && fileInfo.IsDir()
+}
+
+func TestNewPersisterFactory(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil persister factory should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPersisterFactory(t.TempDir())
+		args.PersisterFactory = nil
+		pf, err := NewPersisterFactory(args)
+		assert.Nil(t, pf)
+		assert.Equal(t, storage.ErrNilPersisterFactory, err)
+	})
+	t.Run("empty primary db path should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPersisterFactory(t.TempDir())
+		args.PrimaryDbPath = ""
+		pf, err := NewPersisterFactory(args)
+		assert.Nil(t, pf)
+		assert.Equal(t, storage.ErrEmptyFilePath, err)
+	})
+	t.Run("invalid refresh interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPersisterFactory(t.TempDir())
+		args.RefreshInterval = -time.Second
+		pf, err := NewPersisterFactory(args)
+		assert.Nil(t, pf)
+		assert.Equal(t, storage.ErrInvalidRefreshInterval, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		pf, err := NewPersisterFactory(createMockArgsPersisterFactory(t.TempDir()))
+		assert.Nil(t, err)
+		assert.False(t, pf.IsInterfaceNil())
+	})
+}
+
+func TestCheckConfig(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.SecondaryModeConfig{
+		Enabled:                       true,
+		PrimaryWorkingDirectory:       "primary",
+		RefreshIntervalInMilliseconds: 2000,
+	}
+	assert.Nil(t, CheckConfig(cfg))
+
+	cfgWithoutPrimary := cfg
+	cfgWithoutPrimary.PrimaryWorkingDirectory = ""
+	assert.True(t, errors.Is(CheckConfig(cfgWithoutPrimary), storage.ErrInvalidSecondaryModeConfig))
+
+	cfgWithInvalidInterval := cfg
+	cfgWithInvalidInterval.RefreshIntervalInMilliseconds = 0
+	assert.True(t, errors.Is(CheckConfig(cfgWithInvalidInterval), storage.ErrInvalidSecondaryModeConfig))
+}
+
+func TestPersisterFactory_ComputePaths(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsPersisterFactory(t.TempDir())
+	pf, _ := NewPersisterFactory(args)
+	unitPath := filepath.Join("Epoch_3", "Shard_1", "BlockHeaders")
+
+	sourcePath, checkpointsPath, err := pf.computePaths(filepath.Join(args.DbPath, unitPath))
+	assert.Nil(t, err)
+	assert.Equal(t, filepath.Join(args.PrimaryDbPath, unitPath), sourcePath)
+	assert.Equal(t, filepath.Join(args.DbPath, unitPath), checkpointsPath)
+
+	sourcePath, checkpointsPath, err = pf.computePaths(filepath.Join(args.PrimaryDbPath, unitPath))
+	assert.Nil(t, err)
+	assert.Equal(t, filepath.Join(args.PrimaryDbPath, unitPath), sourcePath)
+	assert.Equal(t, filepath.Join(args.DbPath, unitPath), checkpointsPath)
+
+	_, _, err = pf.computePaths(filepath.Join(filepath.Dir(args.DbPath), "other", unitPath))
+	assert.True(t, errors.Is(err, storage.ErrPathOutsideDatabases))
+	_, _, err = pf.computePaths(args.DbPath)
+	assert.True(t, errors.Is(err, storage.ErrPathOutsideDatabases))
+}
+
+func TestPersisterFactory_CreateShouldOpenThePrimaryDatabase(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsPersisterFactory(t.TempDir())
+	pf, _ := NewPersisterFactory(args)
+	unitPath := filepath.Join("Static", "Shard_0", "MetaHdrHashNonce")
+
+	primary, err := createLevelDB(filepath.Join(args.PrimaryDbPath, unitPath))
+	require.Nil(t, err)
+	putEntries(t, primary, 0, 10)
+
+	p, err := pf.Create(filepath.Join(args.DbPath, unitPath))
+	require.Nil(t, err)
+	val, err := p.Get([]byte("key5"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value5"), val)
+	assert.True(t, directoryExists(filepath.Join(args.DbPath, unitPath)))
+
+	assert.Nil(t, p.Close())
+	assert.Nil(t, primary.Close())
+}
@@ -0,0 +1,260 @@
+package secondary
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/leveldb"
+	"github.com/ElrondNetwork/elrond-go/storage/mock"
+	"github.com/ElrondNetwork/elrond-go/storage/pebble"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type createDBHandler func(path string) (storage.Persister, error)
+
+func createLevelDB(path string) (storage.Persister, error) {
+	return leveldb.NewSerialDB(path, 1, 1, 10)
+}
+
+func createPebbleDB(path string) (storage.Persister, error) {
+	return pebble.NewDB(path, 1, 1, 10)
+}
+
+func createMockArgsPersister(sourcePath string, createDB createDBHandler) ArgsPersister {
+	return ArgsPersister{
+		SourcePath:      sourcePath,
+		CheckpointsPath: filepath.Join(filepath.Dir(sourcePath), "secondary"),
+		PersisterFactory: &mock.PersisterFactoryStub{
+			CreateCalled: createDB,
+		},
+		RefreshInterval: time.Hour,
+	}
+}
+
+func putEntries(t *testing.T, db storage.Persister, from int, to int) {
+	for i := from; i < to; i++ {
+		err := db.Put([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i)))
+		require.Nil(t, err)
+	}
+}
+
+func TestNewPersister(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty source path should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPersister(filepath.Join(t.TempDir(), "db"), createLevelDB)
+		args.SourcePath = ""
+		p, err := NewPersister(args)
+		assert.Nil(t, p)
+		assert.Equal(t, storage.ErrEmptyFilePath, err)
+	})
+	t.Run("nil persister factory should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPersister(filepath.Join(t.TempDir(), "db"), createLevelDB)
+		args.PersisterFactory = nil
+		p, err := NewPersister(args)
+		assert.Nil(t, p)
+		assert.Equal(t, storage.ErrNilPersisterFactory, err)
+	})
+	t.Run("invalid refresh interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPersister(filepath.Join(t.TempDir(), "db"), createLevelDB)
+		args.RefreshInterval = 0
+		p, err := NewPersister(args)
+		assert.Nil(t, p)
+		assert.Equal(t, storage.ErrInvalidRefreshInterval, err)
+	})
+	t.Run("missing source database should behave as an empty one", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPersister(filepath.Join(t.TempDir(), "db"), createLevelDB)
+		p, err := NewPersister(args)
+		require.Nil(t, err)
+
+		_, err = p.Get([]byte("key"))
+		assert.Equal(t, storage.ErrKeyNotFound, err)
+		assert.Equal(t, storage.ErrKeyNotFound, p.Has([]byte("key")))
+
+		primary, err := createLevelDB(args.SourcePath)
+		require.Nil(t, err)
+		putEntries(t, primary, 0, 1)
+
+		require.Nil(t, p.Refresh())
+		val, err := p.Get([]byte("key0"))
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("value0"), val)
+
+		assert.Nil(t, p.Close())
+		assert.Nil(t, primary.Close())
+	})
+}
+
+func TestPersister_ShouldReadTheDatabaseKeptOpenByThePrimary(t *testing.T) {
+	t.Parallel()
+
+	dbTypes := map[string]createDBHandler{
+		"LevelDB": createLevelDB,
+		"Pebble":  createPebbleDB,
+	}
+	for name, createDB := range dbTypes {
+		createDB := createDB
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			args := createMockArgsPersister(filepath.Join(t.TempDir(), "db"), createDB)
+			primary, err := createDB(args.SourcePath)
+			require.Nil(t, err)
+			putEntries(t, primary, 0, 100)
+
+			p, err := NewPersister(args)
+			require.Nil(t, err)
+			assert.Nil(t, p.Has([]byte("key99")))
+
+			putEntries(t, primary, 100, 200)
+			assert.Equal(t, storage.ErrKeyNotFound, p.Has([]byte("key100")), "the checkpoint should not change until refreshed")
+
+			require.Nil(t, p.Refresh())
+			val, err := p.Get([]byte("key150"))
+			assert.Nil(t, err)
+			assert.Equal(t, []byte("value150"), val)
+
+			numKeys := 0
+			p.RangeKeys(func(key []byte, val []byte) bool {
+				numKeys++
+				return true
+			})
+			assert.Equal(t, 200, numKeys)
+
+			assert.Equal(t, storage.ErrReadOnlyPersister, p.Put([]byte("key"), []byte("value")))
+			assert.Equal(t, storage.ErrReadOnlyPersister, p.Remove([]byte("key0")))
+
+			// destroying the persister removes only its checkpoints
+			assert.Nil(t, p.Destroy())
+			assert.False(t, directoryExists(args.CheckpointsPath))
+			_, err = p.Get([]byte("key0"))
+			assert.Equal(t, storage.ErrDBIsClosed, err)
+
+			val, err = primary.Get([]byte("key0"))
+			assert.Nil(t, err)
+			assert.Equal(t, []byte("value0"), val)
+			assert.Nil(t, primary.Close())
+		})
+	}
+}
+
+func TestPersister_RefreshShouldKeepTheCheckpointIfTheSourceDidNotChange(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsPersister(filepath.Join(t.TempDir(), "db"), createLevelDB)
+	primary, err := createLevelDB(args.SourcePath)
+	require.Nil(t, err)
+	putEntries(t, primary, 0, 10)
+
+	numCreated := 0
+	args.PersisterFactory = &mock.PersisterFactoryStub{
+		CreateCalled: func(path string) (storage.Persister, error) {
+			numCreated++
+			return createLevelDB(path)
+		},
+	}
+	p, err := NewPersister(args)
+	require.Nil(t, err)
+
+	require.Nil(t, p.Refresh())
+	assert.Equal(t, 1, numCreated)
+	checkpoints, err := ioutil.ReadDir(args.CheckpointsPath)
+	require.Nil(t, err)
+	assert.Equal(t, 1, len(checkpoints))
+
+	putEntries(t, primary, 10, 11)
+	require.Nil(t, p.Refresh())
+	assert.Equal(t, 2, numCreated)
+	checkpoints, err = ioutil.ReadDir(args.CheckpointsPath)
+	require.Nil(t, err)
+	assert.Equal(t, 1, len(checkpoints), "the previous checkpoint should have been removed")
+
+	assert.Nil(t, p.Close())
+	assert.Nil(t, primary.Close())
+}
+
+func TestPersister_ShouldRefreshInTheBackgroundAfterTheRefreshInterval(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsPersister(filepath.Join(t.TempDir(), "db"), createLevelDB)
+	args.RefreshInterval = time.Millisecond * 10
+	primary, err := createLevelDB(args.SourcePath)
+	require.Nil(t, err)
+	putEntries(t, primary, 0, 1)
+
+	p, err := NewPersister(args)
+	require.Nil(t, err)
+
+	putEntries(t, primary, 1, 2)
+	assert.Eventually(t, func() bool {
+		return p.Has([]byte("key1")) == nil
+	}, time.Second*5, args.RefreshInterval)
+	val, err := p.Get([]byte("key1"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value1"), val)
+
+	assert.Nil(t, p.Close())
+	assert.Nil(t, primary.Close())
+}
+
+func TestPersister_ReadsShouldNotRefresh(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsPersister(filepath.Join(t.TempDir(), "db"), createLevelDB)
+	primary, err := createLevelDB(args.SourcePath)
+	require.Nil(t, err)
+	putEntries(t, primary, 0, 1)
+
+	p, err := NewPersister(args)
+	require.Nil(t, err)
+
+	putEntries(t, primary, 1, 2)
+	_, err = p.Get([]byte("key1"))
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+	assert.Equal(t, storage.ErrKeyNotFound, p.Has([]byte("key1")))
+
+	require.Nil(t, p.Refresh())
+	val, err := p.Get([]byte("key1"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value1"), val)
+
+	assert.Nil(t, p.Close())
+	assert.Equal(t, storage.ErrDBIsClosed, p.Refresh())
+	assert.Nil(t, primary.Close())
+}
+
+func TestGetFileKind(t *testing.T) {
+	t.Parallel()
+
+	expectedKinds := map[string]fileKind{
+		"LOCK":                               ignoredFile,
+		"LOG":                                ignoredFile,
+		"LOG.old":                            ignoredFile,
+		"000012.tmp":                         ignoredFile,
+		"000005.ldb":                         tableFile,
+		"000005.sst":                         tableFile,
+		"000004.log":                         journalFile,
+		"CURRENT":                            metadataFile,
+		"MANIFEST-000002":                    metadataFile,
+		"OPTIONS-000003":                     metadataFile,
+		"marker.format-version.000001.005":   metadataFile,
+		"marker.manifest.000001.MANIFEST-01": metadataFile,
+		"unknown":                            ignoredFile,
+	}
+	for name, kind := range expectedKinds {
+		assert.Equal(t, kind, getFileKind(name), name)
+	}
+}
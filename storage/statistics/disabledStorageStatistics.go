@@ -0,0 +1,28 @@
+package statistics
+
+import (
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+type disabledStorageStatistics struct {
+}
+
+// NewDisabledStorageStatistics returns a storage statistics handler which does not collect anything
+func NewDisabledStorageStatistics() *disabledStorageStatistics {
+	return &disabledStorageStatistics{}
+}
+
+// WrapPersister returns the provided persister
+func (dss *disabledStorageStatistics) WrapPersister(persister storage.Persister, _ string, _ string, _ string) storage.Persister {
+	return persister
+}
+
+// GetStatistics returns an empty slice
+func (dss *disabledStorageStatistics) GetStatistics(_ bool) []storage.UnitStatistics {
+	return make([]storage.UnitStatistics, 0)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dss *disabledStorageStatistics) IsInterfaceNil() bool {
+	return dss == nil
+}
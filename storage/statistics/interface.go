@@ -0,0 +1,12 @@
+package statistics
+
+import (
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// DbFactoryHandler defines what a db factory implementation should do
+type DbFactoryHandler interface {
+	Create(filePath string) (storage.Persister, error)
+	CreateDisabled() storage.Persister
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,72 @@
+package statistics
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// persisterFactoryWithStatistics creates the persisters of a storage unit, registering each of them in the
+// statistics handler
+type persisterFactoryWithStatistics struct {
+	persisterFactory  DbFactoryHandler
+	statisticsHandler storage.StorageStatisticsHandler
+	unit              string
+}
+
+// NewPersisterFactoryWithStatistics creates a persister factory which collects the operations statistics of the
+// persisters created by the provided factory, under the provided unit name
+func NewPersisterFactoryWithStatistics(
+	persisterFactory DbFactoryHandler,
+	statisticsHandler storage.StorageStatisticsHandler,
+	unit string,
+) (*persisterFactoryWithStatistics, error) {
+	if check.IfNil(persisterFactory) {
+		return nil, storage.ErrNilPersisterFactory
+	}
+	if check.IfNil(statisticsHandler) {
+		return nil, storage.ErrNilStorageStatisticsHandler
+	}
+
+	return &persisterFactoryWithStatistics{
+		persisterFactory:  persisterFactory,
+		statisticsHandler: statisticsHandler,
+		unit:              unit,
+	}, nil
+}
+
+// Create creates the persister found at the provided path and registers it in the statistics handler
+func (pfws *persisterFactoryWithStatistics) Create(path string) (storage.Persister, error) {
+	persister, err := pfws.persisterFactory.Create(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return pfws.statisticsHandler.WrapPersister(persister, pfws.unit, EpochFromPath(path), path), nil
+}
+
+// CreateDisabled returns the disabled persister of the wrapped factory
+func (pfws *persisterFactoryWithStatistics) CreateDisabled() storage.Persister {
+	return pfws.persisterFactory.CreateDisabled()
+}
+
+// EpochFromPath returns the epoch of the persister found at the provided path or the static database name if the
+// path does not contain an epoch directory
+func EpochFromPath(path string) string {
+	epochPrefix := common.DefaultEpochString + "_"
+	for _, element := range strings.Split(filepath.ToSlash(path), "/") {
+		if strings.HasPrefix(element, epochPrefix) {
+			return strings.TrimPrefix(element, epochPrefix)
+		}
+	}
+
+	return common.DefaultStaticDbString
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pfws *persisterFactoryWithStatistics) IsInterfaceNil() bool {
+	return pfws == nil
+}
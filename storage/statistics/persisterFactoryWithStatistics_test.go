@@ -0,0 +1,107 @@
+package statistics
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/ElrondNetwork/elrond-go/storage/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPersisterFactoryWithStatistics(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil persister factory should error", func(t *testing.T) {
+		t.Parallel()
+
+		pfws, err := NewPersisterFactoryWithStatistics(nil, NewStorageStatistics(), "unit")
+		assert.True(t, check.IfNil(pfws))
+		assert.Equal(t, storage.ErrNilPersisterFactory, err)
+	})
+	t.Run("nil statistics handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		pfws, err := NewPersisterFactoryWithStatistics(&mock.PersisterFactoryStub{}, nil, "unit")
+		assert.True(t, check.IfNil(pfws))
+		assert.Equal(t, storage.ErrNilStorageStatisticsHandler, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		pfws, err := NewPersisterFactoryWithStatistics(&mock.PersisterFactoryStub{}, NewStorageStatistics(), "unit")
+		assert.False(t, check.IfNil(pfws))
+		assert.Nil(t, err)
+	})
+}
+
+func TestPersisterFactoryWithStatistics_Create(t *testing.T) {
+	t.Parallel()
+
+	t.Run("wrapped factory errors should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		ss := NewStorageStatistics()
+		pfws, _ := NewPersisterFactoryWithStatistics(&mock.PersisterFactoryStub{
+			CreateCalled: func(path string) (storage.Persister, error) {
+				return nil, expectedErr
+			},
+		}, ss, "unit")
+
+		persister, err := pfws.Create("Epoch_0/Shard_0/id")
+		assert.Nil(t, persister)
+		assert.Equal(t, expectedErr, err)
+		assert.Equal(t, 0, len(ss.GetStatistics(false)))
+	})
+	t.Run("should register the persister under the epoch found in path", func(t *testing.T) {
+		t.Parallel()
+
+		ss := NewStorageStatistics()
+		pfws, _ := NewPersisterFactoryWithStatistics(&mock.PersisterFactoryStub{
+			CreateCalled: func(path string) (storage.Persister, error) {
+				return memorydb.New(), nil
+			},
+		}, ss, "TransactionUnit")
+
+		path := filepath.Join("db", "Epoch_4", "Shard_1", "Transactions")
+		persister, err := pfws.Create(path)
+		require.Nil(t, err)
+		_ = persister.Put([]byte("key"), []byte("value"))
+
+		statistics := ss.GetStatistics(false)
+		require.Equal(t, 1, len(statistics))
+		assert.Equal(t, "TransactionUnit", statistics[0].Unit)
+		require.Equal(t, 1, len(statistics[0].Persisters))
+		assert.Equal(t, "4", statistics[0].Persisters[0].Epoch)
+		assert.Equal(t, path, statistics[0].Persisters[0].Path)
+		assert.Equal(t, uint64(1), statistics[0].Persisters[0].NumPuts)
+	})
+}
+
+func TestPersisterFactoryWithStatistics_CreateDisabledShouldNotWrap(t *testing.T) {
+	t.Parallel()
+
+	disabledPersister := memorydb.New()
+	pfws, _ := NewPersisterFactoryWithStatistics(&mock.PersisterFactoryStub{
+		CreateDisabledCalled: func() storage.Persister {
+			return disabledPersister
+		},
+	}, NewStorageStatistics(), "unit")
+
+	assert.True(t, disabledPersister == pfws.CreateDisabled())
+}
+
+func TestEpochFromPath(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "0", EpochFromPath("Epoch_0/Shard_0/Transactions"))
+	assert.Equal(t, "2654", EpochFromPath(filepath.Join("db", "Epoch_2654", "Shard_metachain", "MetaBlock")))
+	assert.Equal(t, common.DefaultStaticDbString, EpochFromPath("db/Static/Shard_0/Heartbeat"))
+	assert.Equal(t, common.DefaultStaticDbString, EpochFromPath(""))
+}
@@ -0,0 +1,104 @@
+package statistics
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/core/atomic"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var _ storage.Persister = (*persisterWithStatistics)(nil)
+
+type operationsCounters struct {
+	numPuts      atomic.Counter
+	numGets      atomic.Counter
+	numMisses    atomic.Counter
+	numRemoves   atomic.Counter
+	bytesWritten atomic.Counter
+	bytesRead    atomic.Counter
+}
+
+func (oc *operationsCounters) toStatistics() storage.OperationsStatistics {
+	return storage.OperationsStatistics{
+		NumPuts:      oc.numPuts.GetUint64(),
+		NumGets:      oc.numGets.GetUint64(),
+		NumMisses:    oc.numMisses.GetUint64(),
+		NumRemoves:   oc.numRemoves.GetUint64(),
+		BytesWritten: oc.bytesWritten.GetUint64(),
+		BytesRead:    oc.bytesRead.GetUint64(),
+	}
+}
+
+// persisterWithStatistics counts the operations done on the wrapped persister
+type persisterWithStatistics struct {
+	persister storage.Persister
+	counters  *operationsCounters
+}
+
+// Put adds the value to the wrapped persister and counts the written bytes
+func (pws *persisterWithStatistics) Put(key, val []byte) error {
+	err := pws.persister.Put(key, val)
+	if err != nil {
+		return err
+	}
+
+	pws.counters.numPuts.Increment()
+	pws.counters.bytesWritten.Add(int64(len(key) + len(val)))
+
+	return nil
+}
+
+// Get returns the value associated to the key, counting a miss if the key could not be found
+func (pws *persisterWithStatistics) Get(key []byte) ([]byte, error) {
+	pws.counters.numGets.Increment()
+
+	val, err := pws.persister.Get(key)
+	if err != nil {
+		pws.counters.numMisses.Increment()
+		return nil, err
+	}
+
+	pws.counters.bytesRead.Add(int64(len(val)))
+
+	return val, nil
+}
+
+// Has returns nil if the given key is present in the wrapped persister
+func (pws *persisterWithStatistics) Has(key []byte) error {
+	return pws.persister.Has(key)
+}
+
+// Close closes the wrapped persister
+func (pws *persisterWithStatistics) Close() error {
+	return pws.persister.Close()
+}
+
+// Remove removes the data associated to the given key
+func (pws *persisterWithStatistics) Remove(key []byte) error {
+	err := pws.persister.Remove(key)
+	if err != nil {
+		return err
+	}
+
+	pws.counters.numRemoves.Increment()
+
+	return nil
+}
+
+// Destroy removes the wrapped persister stored data
+func (pws *persisterWithStatistics) Destroy() error {
+	return pws.persister.Destroy()
+}
+
+// DestroyClosed removes the already closed wrapped persister stored data
+func (pws *persisterWithStatistics) DestroyClosed() error {
+	return pws.persister.DestroyClosed()
+}
+
+// RangeKeys iterates over all the (key, value) pairs of the wrapped persister
+func (pws *persisterWithStatistics) RangeKeys(handler func(key []byte, val []byte) bool) {
+	pws.persister.RangeKeys(handler)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pws *persisterWithStatistics) IsInterfaceNil() bool {
+	return pws == nil
+}
@@ -0,0 +1,157 @@
+package statistics
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var _ storage.StorageStatisticsHandler = (*storageStatistics)(nil)
+
+type persisterEntry struct {
+	epoch    string
+	path     string
+	counters *operationsCounters
+}
+
+// storageStatistics collects the operations statistics of the persisters of each storage unit. The counters of a
+// persister are kept by its path, so they survive the closing and the reopening of the persister
+type storageStatistics struct {
+	mut   sync.RWMutex
+	units map[string]map[string]*persisterEntry
+}
+
+// NewStorageStatistics creates a new storage statistics collector
+func NewStorageStatistics() *storageStatistics {
+	return &storageStatistics{
+		units: make(map[string]map[string]*persisterEntry),
+	}
+}
+
+// WrapPersister returns a persister which counts the operations done on the provided persister, under the provided
+// unit and epoch
+func (ss *storageStatistics) WrapPersister(persister storage.Persister, unit string, epoch string, path string) storage.Persister {
+	return &persisterWithStatistics{
+		persister: persister,
+		counters:  ss.getOrCreateCounters(unit, epoch, path),
+	}
+}
+
+func (ss *storageStatistics) getOrCreateCounters(unit string, epoch string, path string) *operationsCounters {
+	ss.mut.Lock()
+	defer ss.mut.Unlock()
+
+	persisters, ok := ss.units[unit]
+	if !ok {
+		persisters = make(map[string]*persisterEntry)
+		ss.units[unit] = persisters
+	}
+
+	entry, ok := persisters[path]
+	if !ok {
+		entry = &persisterEntry{
+			epoch:    epoch,
+			path:     path,
+			counters: &operationsCounters{},
+		}
+		persisters[path] = entry
+	}
+
+	return entry.counters
+}
+
+// GetStatistics returns the statistics of all storage units, sorted by unit name, along with the statistics of
+// their persisters, sorted by epoch. If computeDiskUsage is set, the size on disk of each persister directory is
+// computed as well, which can take a while on large databases
+func (ss *storageStatistics) GetStatistics(computeDiskUsage bool) []storage.UnitStatistics {
+	ss.mut.RLock()
+	units := make([]storage.UnitStatistics, 0, len(ss.units))
+	for unit, persisters := range ss.units {
+		unitStatistics := storage.UnitStatistics{
+			Unit:       unit,
+			Persisters: make([]storage.PersisterStatistics, 0, len(persisters)),
+		}
+		for _, entry := range persisters {
+			unitStatistics.Persisters = append(unitStatistics.Persisters, storage.PersisterStatistics{
+				OperationsStatistics: entry.counters.toStatistics(),
+				Epoch:                entry.epoch,
+				Path:                 entry.path,
+			})
+		}
+		units = append(units, unitStatistics)
+	}
+	ss.mut.RUnlock()
+
+	for i := range units {
+		sortPersisters(units[i].Persisters)
+		for j := range units[i].Persisters {
+			if computeDiskUsage {
+				units[i].Persisters[j].DiskUsage = diskUsage(units[i].Persisters[j].Path)
+			}
+			addStatistics(&units[i].OperationsStatistics, units[i].Persisters[j].OperationsStatistics)
+		}
+	}
+
+	sort.Slice(units, func(i, j int) bool {
+		return units[i].Unit < units[j].Unit
+	})
+
+	return units
+}
+
+func addStatistics(total *storage.OperationsStatistics, statistics storage.OperationsStatistics) {
+	total.NumPuts += statistics.NumPuts
+	total.NumGets += statistics.NumGets
+	total.NumMisses += statistics.NumMisses
+	total.NumRemoves += statistics.NumRemoves
+	total.BytesWritten += statistics.BytesWritten
+	total.BytesRead += statistics.BytesRead
+	total.DiskUsage += statistics.DiskUsage
+}
+
+// sortPersisters sorts the persisters by epoch, numerically, the static persisters being placed first
+func sortPersisters(persisters []storage.PersisterStatistics) {
+	sort.Slice(persisters, func(i, j int) bool {
+		epochI, errI := strconv.ParseUint(persisters[i].Epoch, 10, 32)
+		epochJ, errJ := strconv.ParseUint(persisters[j].Epoch, 10, 32)
+		if errI != nil || errJ != nil {
+			if errI != nil && errJ != nil {
+				return persisters[i].Path < persisters[j].Path
+			}
+
+			return errI != nil
+		}
+		if epochI != epochJ {
+			return epochI < epochJ
+		}
+
+		return persisters[i].Path < persisters[j].Path
+	})
+}
+
+// diskUsage returns the total size of the files found under the provided path. Missing paths, like the ones of
+// the in-memory databases, have a size of 0
+func diskUsage(path string) uint64 {
+	size := uint64(0)
+	_ = filepath.Walk(path, func(_ string, info os.FileInfo, err error) error {
+		if err != nil {
+			return nil
+		}
+		if !info.IsDir() {
+			size += uint64(info.Size())
+		}
+
+		return nil
+	})
+
+	return size
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ss *storageStatistics) IsInterfaceNil() bool {
+	return ss == nil
+}
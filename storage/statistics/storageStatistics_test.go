@@ -0,0 +1,169 @@
+package statistics
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewStorageStatistics(t *testing.T) {
+	t.Parallel()
+
+	ss := NewStorageStatistics()
+	assert.False(t, check.IfNil(ss))
+	assert.Equal(t, 0, len(ss.GetStatistics(false)))
+}
+
+func TestStorageStatistics_WrapPersisterShouldCountOperations(t *testing.T) {
+	t.Parallel()
+
+	ss := NewStorageStatistics()
+	persister := ss.WrapPersister(memorydb.New(), "TransactionUnit", "3", "Epoch_3/Shard_0/Transactions")
+
+	require.Nil(t, persister.Put([]byte("key1"), []byte("value1")))
+	require.Nil(t, persister.Put([]byte("key2"), []byte("value22")))
+	_, err := persister.Get([]byte("key1"))
+	require.Nil(t, err)
+	_, err = persister.Get([]byte("missing"))
+	require.NotNil(t, err)
+	require.Nil(t, persister.Remove([]byte("key2")))
+
+	statistics := ss.GetStatistics(false)
+	require.Equal(t, 1, len(statistics))
+	expectedOperations := storage.OperationsStatistics{
+		NumPuts:      2,
+		NumGets:      2,
+		NumMisses:    1,
+		NumRemoves:   1,
+		BytesWritten: uint64(len("key1value1key2value22")),
+		BytesRead:    uint64(len("value1")),
+	}
+	assert.Equal(t, "TransactionUnit", statistics[0].Unit)
+	assert.Equal(t, expectedOperations, statistics[0].OperationsStatistics)
+	require.Equal(t, 1, len(statistics[0].Persisters))
+	assert.Equal(t, "3", statistics[0].Persisters[0].Epoch)
+	assert.Equal(t, "Epoch_3/Shard_0/Transactions", statistics[0].Persisters[0].Path)
+	assert.Equal(t, expectedOperations, statistics[0].Persisters[0].OperationsStatistics)
+}
+
+func TestStorageStatistics_ReopenedPersisterShouldKeepCounters(t *testing.T) {
+	t.Parallel()
+
+	ss := NewStorageStatistics()
+	path := "Epoch_1/Shard_0/MiniBlocks"
+	persister := ss.WrapPersister(memorydb.New(), "MiniBlockUnit", "1", path)
+	_ = persister.Put([]byte("key"), []byte("value"))
+	_ = persister.Close()
+
+	persister = ss.WrapPersister(memorydb.New(), "MiniBlockUnit", "1", path)
+	_ = persister.Put([]byte("key"), []byte("value"))
+
+	statistics := ss.GetStatistics(false)
+	require.Equal(t, 1, len(statistics))
+	require.Equal(t, 1, len(statistics[0].Persisters))
+	assert.Equal(t, uint64(2), statistics[0].Persisters[0].NumPuts)
+}
+
+func TestStorageStatistics_GetStatisticsShouldSortAndAggregate(t *testing.T) {
+	t.Parallel()
+
+	ss := NewStorageStatistics()
+	epochs := []string{"10", "2", common.DefaultStaticDbString, "9"}
+	for _, epoch := range epochs {
+		persister := ss.WrapPersister(memorydb.New(), "ReceiptsUnit", epoch, "Epoch_"+epoch+"/Receipts")
+		_ = persister.Put([]byte("key"), []byte("value"))
+	}
+	persister := ss.WrapPersister(memorydb.New(), "BootstrapUnit", "0", "Epoch_0/Bootstrap")
+	_, _ = persister.Get([]byte("key"))
+
+	statistics := ss.GetStatistics(false)
+	require.Equal(t, 2, len(statistics))
+	assert.Equal(t, "BootstrapUnit", statistics[0].Unit)
+	assert.Equal(t, uint64(1), statistics[0].NumMisses)
+
+	assert.Equal(t, "ReceiptsUnit", statistics[1].Unit)
+	assert.Equal(t, uint64(4), statistics[1].NumPuts)
+	sortedEpochs := make([]string, 0, len(statistics[1].Persisters))
+	for _, persisterStatistics := range statistics[1].Persisters {
+		sortedEpochs = append(sortedEpochs, persisterStatistics.Epoch)
+	}
+	assert.Equal(t, []string{common.DefaultStaticDbString, "2", "9", "10"}, sortedEpochs)
+}
+
+func TestStorageStatistics_GetStatisticsShouldComputeDiskUsage(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "Epoch_0", "Shard_0", "Transactions")
+	require.Nil(t, writeFile(filepath.Join(path, "000001.ldb"), make([]byte, 100)))
+	require.Nil(t, writeFile(filepath.Join(path, "sub", "000002.log"), make([]byte, 28)))
+
+	ss := NewStorageStatistics()
+	_ = ss.WrapPersister(memorydb.New(), "TransactionUnit", "0", path)
+	_ = ss.WrapPersister(memorydb.New(), "TransactionUnit", "1", filepath.Join(dir, "missing"))
+
+	statistics := ss.GetStatistics(false)
+	require.Equal(t, 1, len(statistics))
+	assert.Equal(t, uint64(0), statistics[0].DiskUsage)
+
+	statistics = ss.GetStatistics(true)
+	require.Equal(t, 1, len(statistics))
+	assert.Equal(t, uint64(128), statistics[0].DiskUsage)
+	require.Equal(t, 2, len(statistics[0].Persisters))
+	assert.Equal(t, uint64(128), statistics[0].Persisters[0].DiskUsage)
+	assert.Equal(t, uint64(0), statistics[0].Persisters[1].DiskUsage)
+}
+
+func TestStorageStatistics_ConcurrentOperationsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	ss := NewStorageStatistics()
+	numGoRoutines := 50
+	wg := sync.WaitGroup{}
+	wg.Add(numGoRoutines)
+	for i := 0; i < numGoRoutines; i++ {
+		go func(idx int) {
+			defer wg.Done()
+
+			persister := ss.WrapPersister(memorydb.New(), "TransactionUnit", "0", "Epoch_0/Transactions")
+			_ = persister.Put([]byte("key"), []byte("value"))
+			_, _ = persister.Get([]byte("key"))
+			_ = ss.GetStatistics(false)
+		}(i)
+	}
+	wg.Wait()
+
+	statistics := ss.GetStatistics(false)
+	require.Equal(t, 1, len(statistics))
+	assert.Equal(t, uint64(numGoRoutines), statistics[0].NumPuts)
+	assert.Equal(t, uint64(numGoRoutines), statistics[0].NumGets)
+}
+
+func TestDisabledStorageStatistics(t *testing.T) {
+	t.Parallel()
+
+	dss := NewDisabledStorageStatistics()
+	assert.False(t, check.IfNil(dss))
+
+	persister := memorydb.New()
+	assert.True(t, persister == dss.WrapPersister(persister, "TransactionUnit", "0", "path"))
+	assert.Equal(t, 0, len(dss.GetStatistics(true)))
+}
+
+func writeFile(path string, data []byte) error {
+	err := os.MkdirAll(filepath.Dir(path), os.ModePerm)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, data, 0644)
+}
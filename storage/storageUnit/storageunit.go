@@ -14,11 +14,14 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/hashing/keccak"
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/compression"
 	"github.com/ElrondNetwork/elrond-go/storage/fifocache"
 	"github.com/ElrondNetwork/elrond-go/storage/leveldb"
 	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
 	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/ElrondNetwork/elrond-go/storage/pebble"
 )
 
 var _ storage.Storer = (*Unit)(nil)
@@ -47,6 +50,7 @@ const (
 	LvlDB       DBType = "LvlDB"
 	LvlDBSerial DBType = "LvlDBSerial"
 	MemoryDB    DBType = "MemoryDB"
+	PebbleDB    DBType = "PebbleDB"
 )
 
 const (
@@ -94,6 +98,7 @@ type DBConfig struct {
 	BatchDelaySeconds int
 	MaxBatchSize      int
 	MaxOpenFiles      int
+	Compression       config.DBCompressionConfig
 }
 
 // Unit represents a storer's data bank
@@ -300,6 +305,7 @@ func NewStorageUnitFromConf(cacheConf CacheConfig, dbConf DBConfig) (*Unit, erro
 		BatchDelaySeconds: dbConf.BatchDelaySeconds,
 		MaxBatchSize:      dbConf.MaxBatchSize,
 		MaxOpenFiles:      dbConf.MaxOpenFiles,
+		Compression:       dbConf.Compression,
 	}
 	db, err = NewDB(argDB)
 	if err != nil {
@@ -362,6 +368,7 @@ type ArgDB struct {
 	BatchDelaySeconds int
 	MaxBatchSize      int
 	MaxOpenFiles      int
+	Compression       config.DBCompressionConfig
 }
 
 // NewDB creates a new database from database config
@@ -377,12 +384,14 @@ func NewDB(argDB ArgDB) (storage.Persister, error) {
 			db, err = leveldb.NewSerialDB(argDB.Path, argDB.BatchDelaySeconds, argDB.MaxBatchSize, argDB.MaxOpenFiles)
 		case MemoryDB:
 			db = memorydb.New()
+		case PebbleDB:
+			db, err = pebble.NewDB(argDB.Path, argDB.BatchDelaySeconds, argDB.MaxBatchSize, argDB.MaxOpenFiles)
 		default:
 			return nil, storage.ErrNotSupportedDBType
 		}
 
 		if err == nil {
-			return db, nil
+			return wrapWithCompression(db, argDB.Compression)
 		}
 
 		// TODO: extract this in a parameter and inject it
@@ -395,6 +404,20 @@ func NewDB(argDB ArgDB) (storage.Persister, error) {
 	return db, nil
 }
 
+func wrapWithCompression(db storage.Persister, compressionConfig config.DBCompressionConfig) (storage.Persister, error) {
+	if !compression.IsEnabled(compressionConfig) {
+		return db, nil
+	}
+
+	compressedDB, err := compression.NewCompressedPersister(db, compressionConfig)
+	if err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+
+	return compressedDB, nil
+}
+
 // NewHasher will return a hasher implementation form the string HasherType
 func (h HasherType) NewHasher() (hashing.Hasher, error) {
 	switch h {
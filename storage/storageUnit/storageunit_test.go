@@ -1,12 +1,15 @@
 package storageUnit_test
 
 import (
+	"errors"
 	"fmt"
 	"math/rand"
 	"strconv"
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/compression"
 	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
 	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
@@ -300,6 +303,58 @@ func TestCreateDBFromConfLvlDBOk(t *testing.T) {
 	assert.Nil(t, err, "no error expected destroying the persister")
 }
 
+func TestCreateDBFromConfPebbleDBOk(t *testing.T) {
+	arg := storageUnit.ArgDB{
+		DBType:            storageUnit.PebbleDB,
+		Path:              t.TempDir(),
+		BatchDelaySeconds: 10,
+		MaxBatchSize:      10,
+		MaxOpenFiles:      10,
+	}
+	persister, err := storageUnit.NewDB(arg)
+	assert.Nil(t, err, "no error expected")
+	assert.NotNil(t, persister, "valid persister expected but got nil")
+
+	err = persister.Destroy()
+	assert.Nil(t, err, "no error expected destroying the persister")
+}
+
+func TestCreateDBFromConfWithCompressionOk(t *testing.T) {
+	arg := storageUnit.ArgDB{
+		DBType:            storageUnit.LvlDBSerial,
+		Path:              t.TempDir(),
+		BatchDelaySeconds: 10,
+		MaxBatchSize:      10,
+		MaxOpenFiles:      10,
+		Compression: config.DBCompressionConfig{
+			Type:  compression.ZstdCompression,
+			Level: 3,
+		},
+	}
+	persister, err := storageUnit.NewDB(arg)
+	assert.Nil(t, err, "no error expected")
+	assert.Equal(t, "*compression.compressedPersister", fmt.Sprintf("%T", persister))
+
+	err = persister.Destroy()
+	assert.Nil(t, err, "no error expected destroying the persister")
+}
+
+func TestCreateDBFromConfWithInvalidCompression(t *testing.T) {
+	arg := storageUnit.ArgDB{
+		DBType:            storageUnit.LvlDBSerial,
+		Path:              t.TempDir(),
+		BatchDelaySeconds: 10,
+		MaxBatchSize:      10,
+		MaxOpenFiles:      10,
+		Compression: config.DBCompressionConfig{
+			Type: "Gzip",
+		},
+	}
+	persister, err := storageUnit.NewDB(arg)
+	assert.Nil(t, persister)
+	assert.True(t, errors.Is(err, storage.ErrNotSupportedCompressionType))
+}
+
 func TestNewStorageUnit_FromConfWrongCacheSizeVsBatchSize(t *testing.T) {
 
 	storer, err := storageUnit.NewStorageUnitFromConf(storageUnit.CacheConfig{
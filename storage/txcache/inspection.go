@@ -0,0 +1,137 @@
+package txcache
+
+// CacheInspection holds the aggregated counters of a transactions cache, as exposed for debugging purposes
+type CacheInspection struct {
+	Name                 string
+	NumTxs               uint64
+	NumSenders           uint64
+	NumBytes             int
+	IsEvictionEnabled    bool
+	IsEvictionInProgress bool
+	IsCapacityExceeded   bool
+}
+
+// NonceGap defines an (inclusive) range of nonces missing from the list of transactions of a sender
+type NonceGap struct {
+	FromNonce uint64
+	ToNonce   uint64
+}
+
+// SenderInspection holds a snapshot of the list of transactions of a sender, along with its scoring and eviction status
+type SenderInspection struct {
+	CacheName           string
+	Transactions        []*WrappedTransaction
+	AccountNonce        uint64
+	IsAccountNonceKnown bool
+	NonceGaps           []NonceGap
+	Score               uint32
+	TotalBytes          uint64
+	TotalGas            uint64
+	NumFailedSelections uint64
+	IsInGracePeriod     bool
+	IsSweepable         bool
+	IsCapacityExceeded  bool
+}
+
+// Inspect returns the aggregated counters of the cache
+func (cache *TxCache) Inspect() CacheInspection {
+	return CacheInspection{
+		Name:                 cache.name,
+		NumTxs:               cache.CountTx(),
+		NumSenders:           cache.CountSenders(),
+		NumBytes:             cache.NumBytes(),
+		IsEvictionEnabled:    cache.config.EvictionEnabled,
+		IsEvictionInProgress: cache.isEvictionInProgress.IsSet(),
+		IsCapacityExceeded:   cache.isCapacityExceeded(),
+	}
+}
+
+// InspectSender returns a snapshot of the transactions of the provided sender, if the sender is known by the cache
+func (cache *TxCache) InspectSender(sender []byte) (*SenderInspection, bool) {
+	listForSender, ok := cache.txListBySender.getListForSender(string(sender))
+	if !ok {
+		return nil, false
+	}
+
+	inspection := listForSender.inspect()
+	inspection.CacheName = cache.name
+
+	return inspection, true
+}
+
+func (listForSender *txListForSender) inspect() *SenderInspection {
+	listForSender.mutex.RLock()
+	defer listForSender.mutex.RUnlock()
+
+	inspection := &SenderInspection{
+		Transactions:        make([]*WrappedTransaction, 0, listForSender.countTx()),
+		AccountNonce:        listForSender.accountNonce.Get(),
+		IsAccountNonceKnown: listForSender.accountNonceKnown.IsSet(),
+		NonceGaps:           make([]NonceGap, 0),
+		Score:               listForSender.getLastComputedScore(),
+		TotalBytes:          listForSender.totalBytes.GetUint64(),
+		TotalGas:            listForSender.totalGas.GetUint64(),
+		NumFailedSelections: listForSender.numFailedSelections.GetUint64(),
+		IsInGracePeriod:     listForSender.isInGracePeriod(),
+		IsSweepable:         listForSender.sweepable.IsSet(),
+		IsCapacityExceeded:  listForSender.isCapacityExceeded(),
+	}
+
+	firstTx := listForSender.getLowestNonceTx()
+	if firstTx == nil {
+		return inspection
+	}
+
+	// without a known account nonce, an initial gap cannot be detected
+	expectedNonce := firstTx.Tx.GetNonce()
+	if inspection.IsAccountNonceKnown {
+		expectedNonce = inspection.AccountNonce
+	}
+
+	for element := listForSender.items.Front(); element != nil; element = element.Next() {
+		value := element.Value.(*WrappedTransaction)
+		inspection.Transactions = append(inspection.Transactions, value)
+
+		nonce := value.Tx.GetNonce()
+		if nonce < expectedNonce {
+			// duplicated nonce or transaction already outdated by the account nonce
+			continue
+		}
+		if nonce > expectedNonce {
+			inspection.NonceGaps = append(inspection.NonceGaps, NonceGap{
+				FromNonce: expectedNonce,
+				ToNonce:   nonce - 1,
+			})
+		}
+
+		expectedNonce = nonce + 1
+	}
+
+	return inspection
+}
+
+// Inspect returns the aggregated counters of the cache. Cross-shard transactions are not organized by sender.
+func (cache *CrossTxCache) Inspect() CacheInspection {
+	return CacheInspection{
+		Name:               cache.config.Name,
+		NumTxs:             uint64(cache.Count()),
+		NumBytes:           cache.NumBytes(),
+		IsEvictionEnabled:  true,
+		IsCapacityExceeded: cache.Count() >= int(cache.config.MaxNumItems) || cache.NumBytes() >= int(cache.config.MaxNumBytes),
+	}
+}
+
+// InspectSender returns false, since cross-shard transactions are not organized by sender
+func (cache *CrossTxCache) InspectSender(_ []byte) (*SenderInspection, bool) {
+	return nil, false
+}
+
+// Inspect returns empty counters
+func (cache *DisabledCache) Inspect() CacheInspection {
+	return CacheInspection{}
+}
+
+// InspectSender returns false
+func (cache *DisabledCache) InspectSender(_ []byte) (*SenderInspection, bool) {
+	return nil, false
+}
@@ -0,0 +1,88 @@
+package txcache
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTxCache_Inspect(t *testing.T) {
+	cache := newUnconstrainedCacheToTest()
+	cache.AddTx(createTx([]byte("hash-alice-1"), "alice", 1))
+	cache.AddTx(createTx([]byte("hash-alice-2"), "alice", 2))
+	cache.AddTx(createTx([]byte("hash-bob-1"), "bob", 1))
+
+	inspection := cache.Inspect()
+	require.Equal(t, "test", inspection.Name)
+	require.Equal(t, uint64(3), inspection.NumTxs)
+	require.Equal(t, uint64(2), inspection.NumSenders)
+	require.Equal(t, cache.NumBytes(), inspection.NumBytes)
+	require.False(t, inspection.IsEvictionInProgress)
+}
+
+func TestTxCache_InspectSender(t *testing.T) {
+	t.Run("unknown sender", func(t *testing.T) {
+		cache := newUnconstrainedCacheToTest()
+
+		inspection, ok := cache.InspectSender([]byte("alice"))
+		require.False(t, ok)
+		require.Nil(t, inspection)
+	})
+
+	t.Run("without known account nonce, only middle gaps are reported", func(t *testing.T) {
+		cache := newUnconstrainedCacheToTest()
+		cache.AddTx(createTx([]byte("hash-alice-5"), "alice", 5))
+		cache.AddTx(createTx([]byte("hash-alice-6"), "alice", 6))
+		cache.AddTx(createTx([]byte("hash-alice-9"), "alice", 9))
+		cache.AddTx(createTx([]byte("hash-alice-11"), "alice", 11))
+
+		inspection, ok := cache.InspectSender([]byte("alice"))
+		require.True(t, ok)
+		require.Equal(t, "test", inspection.CacheName)
+		require.Len(t, inspection.Transactions, 4)
+		require.Equal(t, []byte("hash-alice-5"), inspection.Transactions[0].TxHash)
+		require.False(t, inspection.IsAccountNonceKnown)
+		require.Equal(t, []NonceGap{{FromNonce: 7, ToNonce: 8}, {FromNonce: 10, ToNonce: 10}}, inspection.NonceGaps)
+		require.Equal(t, cache.getScoreOfSender("alice"), inspection.Score)
+	})
+
+	t.Run("with known account nonce, the initial gap is reported", func(t *testing.T) {
+		cache := newUnconstrainedCacheToTest()
+		cache.AddTx(createTx([]byte("hash-alice-5"), "alice", 5))
+		cache.AddTx(createTx([]byte("hash-alice-6"), "alice", 6))
+		cache.NotifyAccountNonce([]byte("alice"), 3)
+
+		inspection, ok := cache.InspectSender([]byte("alice"))
+		require.True(t, ok)
+		require.True(t, inspection.IsAccountNonceKnown)
+		require.Equal(t, uint64(3), inspection.AccountNonce)
+		require.Equal(t, []NonceGap{{FromNonce: 3, ToNonce: 4}}, inspection.NonceGaps)
+	})
+
+	t.Run("outdated and duplicated nonces are not gaps", func(t *testing.T) {
+		cache := newUnconstrainedCacheToTest()
+		cache.AddTx(createTx([]byte("hash-alice-1"), "alice", 1))
+		cache.AddTx(createTx([]byte("hash-alice-2"), "alice", 2))
+		cache.AddTx(createTx([]byte("hash-alice-2-bis"), "alice", 2))
+		cache.AddTx(createTx([]byte("hash-alice-3"), "alice", 3))
+		cache.NotifyAccountNonce([]byte("alice"), 2)
+
+		inspection, ok := cache.InspectSender([]byte("alice"))
+		require.True(t, ok)
+		require.Len(t, inspection.Transactions, 4)
+		require.Empty(t, inspection.NonceGaps)
+	})
+}
+
+func TestCrossTxCache_Inspect(t *testing.T) {
+	cache := newCrossTxCacheToTest(1, 8, 8192)
+	cache.addTestTxs("a", "b", "c")
+
+	inspection := cache.Inspect()
+	require.Equal(t, uint64(3), inspection.NumTxs)
+	require.Equal(t, uint64(0), inspection.NumSenders)
+
+	senderInspection, ok := cache.InspectSender([]byte("alice"))
+	require.False(t, ok)
+	require.Nil(t, senderInspection)
+}
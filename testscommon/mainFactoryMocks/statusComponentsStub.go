@@ -4,13 +4,15 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go/common/statistics"
 	"github.com/ElrondNetwork/elrond-go/outport"
+	"github.com/ElrondNetwork/elrond-go/outport/events"
 )
 
 // StatusComponentsStub -
 type StatusComponentsStub struct {
-	Outport              outport.OutportHandler
-	SoftwareVersionCheck statistics.SoftwareVersionChecker
-	AppStatusHandler     core.AppStatusHandler
+	Outport                    outport.OutportHandler
+	EventsSubscriptionsHandler events.SubscriptionsHandler
+	SoftwareVersionCheck       statistics.SoftwareVersionChecker
+	AppStatusHandler           core.AppStatusHandler
 }
 
 // Create -
@@ -33,6 +35,11 @@ func (scs *StatusComponentsStub) OutportHandler() outport.OutportHandler {
 	return scs.Outport
 }
 
+// EventsSubscriptions -
+func (scs *StatusComponentsStub) EventsSubscriptions() events.SubscriptionsHandler {
+	return scs.EventsSubscriptionsHandler
+}
+
 // SoftwareVersionChecker -
 func (scs *StatusComponentsStub) SoftwareVersionChecker() statistics.SoftwareVersionChecker {
 	return scs.SoftwareVersionCheck
@@ -0,0 +1,32 @@
+package state
+
+import "github.com/ElrondNetwork/elrond-go/state"
+
+// AccountsDumpHandlerStub -
+type AccountsDumpHandlerStub struct {
+	SaveAccountCalled       func(account *state.AccountDump) error
+	SaveDataTrieEntryCalled func(address []byte, key []byte, value []byte) error
+}
+
+// SaveAccount -
+func (stub *AccountsDumpHandlerStub) SaveAccount(account *state.AccountDump) error {
+	if stub.SaveAccountCalled != nil {
+		return stub.SaveAccountCalled(account)
+	}
+
+	return nil
+}
+
+// SaveDataTrieEntry -
+func (stub *AccountsDumpHandlerStub) SaveDataTrieEntry(address []byte, key []byte, value []byte) error {
+	if stub.SaveDataTrieEntryCalled != nil {
+		return stub.SaveDataTrieEntryCalled(address, key, value)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *AccountsDumpHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
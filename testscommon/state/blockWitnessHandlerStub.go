@@ -0,0 +1,50 @@
+package state
+
+import "github.com/ElrondNetwork/elrond-go/common"
+
+// BlockWitnessHandlerStub -
+type BlockWitnessHandlerStub struct {
+	WrapStorageManagerCalled func(storageManager common.StorageManager) common.StorageManager
+	StartRecordingCalled     func(rootHash []byte, validatorStatsRootHash []byte)
+	SaveWitnessCalled        func(headerHash []byte) error
+	IsEnabledCalled          func() bool
+}
+
+// WrapStorageManager -
+func (stub *BlockWitnessHandlerStub) WrapStorageManager(storageManager common.StorageManager) common.StorageManager {
+	if stub.WrapStorageManagerCalled != nil {
+		return stub.WrapStorageManagerCalled(storageManager)
+	}
+
+	return storageManager
+}
+
+// StartRecording -
+func (stub *BlockWitnessHandlerStub) StartRecording(rootHash []byte, validatorStatsRootHash []byte) {
+	if stub.StartRecordingCalled != nil {
+		stub.StartRecordingCalled(rootHash, validatorStatsRootHash)
+	}
+}
+
+// SaveWitness -
+func (stub *BlockWitnessHandlerStub) SaveWitness(headerHash []byte) error {
+	if stub.SaveWitnessCalled != nil {
+		return stub.SaveWitnessCalled(headerHash)
+	}
+
+	return nil
+}
+
+// IsEnabled -
+func (stub *BlockWitnessHandlerStub) IsEnabled() bool {
+	if stub.IsEnabledCalled != nil {
+		return stub.IsEnabledCalled()
+	}
+
+	return false
+}
+
+// IsInterfaceNil -
+func (stub *BlockWitnessHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
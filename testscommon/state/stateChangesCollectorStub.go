@@ -0,0 +1,53 @@
+package state
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go/state"
+)
+
+// StateChangesCollectorStub -
+type StateChangesCollectorStub struct {
+	SetStateChangesCalled    func(stateChanges *state.BlockStateChanges)
+	SaveStateChangesCalled   func(headerHash []byte) error
+	GetAlteredAccountsCalled func() map[string]*indexer.AlteredAccount
+	IsEnabledCalled          func() bool
+}
+
+// SetStateChanges -
+func (stub *StateChangesCollectorStub) SetStateChanges(stateChanges *state.BlockStateChanges) {
+	if stub.SetStateChangesCalled != nil {
+		stub.SetStateChangesCalled(stateChanges)
+	}
+}
+
+// SaveStateChanges -
+func (stub *StateChangesCollectorStub) SaveStateChanges(headerHash []byte) error {
+	if stub.SaveStateChangesCalled != nil {
+		return stub.SaveStateChangesCalled(headerHash)
+	}
+
+	return nil
+}
+
+// GetAlteredAccounts -
+func (stub *StateChangesCollectorStub) GetAlteredAccounts() map[string]*indexer.AlteredAccount {
+	if stub.GetAlteredAccountsCalled != nil {
+		return stub.GetAlteredAccountsCalled()
+	}
+
+	return nil
+}
+
+// IsEnabled -
+func (stub *StateChangesCollectorStub) IsEnabled() bool {
+	if stub.IsEnabledCalled != nil {
+		return stub.IsEnabledCalled()
+	}
+
+	return false
+}
+
+// IsInterfaceNil -
+func (stub *StateChangesCollectorStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -12,6 +12,8 @@ type StateComponentsMock struct {
 	AccountsAPI     state.AccountsAdapter
 	Tries           common.TriesHolder
 	StorageManagers map[string]common.StorageManager
+	StateChanges    state.StateChangesCollector
+	BlockWitness    state.BlockWitnessHandler
 }
 
 // Create -
@@ -54,6 +56,16 @@ func (scm *StateComponentsMock) TrieStorageManagers() map[string]common.StorageM
 	return scm.StorageManagers
 }
 
+// StateChangesCollector -
+func (scm *StateComponentsMock) StateChangesCollector() state.StateChangesCollector {
+	return scm.StateChanges
+}
+
+// BlockWitnessHandler -
+func (scm *StateComponentsMock) BlockWitnessHandler() state.BlockWitnessHandler {
+	return scm.BlockWitness
+}
+
 // String -
 func (scm *StateComponentsMock) String() string {
 	return "StateComponentsMock"
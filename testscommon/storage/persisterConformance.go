@@ -0,0 +1,170 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// RunPersisterConformanceTests runs the set of tests each storage.Persister implementation should pass.
+// The createPersister function is called once for every test and should return an empty persister that writes
+// through to the underlying storage medium on every operation (a max batch size of 1 for the batched implementations)
+func RunPersisterConformanceTests(t *testing.T, createPersister func(t *testing.T) storage.Persister) {
+	tests := []struct {
+		name     string
+		testFunc func(t *testing.T, persister storage.Persister)
+	}{
+		{name: "PutThenGetShouldWork", testFunc: testPersisterPutThenGet},
+		{name: "GetMissingKeyShouldErr", testFunc: testPersisterGetMissingKey},
+		{name: "HasShouldWork", testFunc: testPersisterHas},
+		{name: "PutShouldOverwrite", testFunc: testPersisterPutOverwrite},
+		{name: "RemoveShouldWork", testFunc: testPersisterRemove},
+		{name: "RemoveMissingKeyShouldNotErr", testFunc: testPersisterRemoveMissingKey},
+		{name: "RangeKeysShouldIterateAllEntries", testFunc: testPersisterRangeKeys},
+		{name: "RangeKeysShouldStopWhenHandlerReturnsFalse", testFunc: testPersisterRangeKeysStop},
+		{name: "RangeKeysNilHandlerShouldNotPanic", testFunc: testPersisterRangeKeysNilHandler},
+		{name: "RangeKeysShouldNotIterateRemovedEntries", testFunc: testPersisterRangeKeysAfterRemove},
+	}
+
+	for _, tt := range tests {
+		testFunc := tt.testFunc
+		t.Run(tt.name, func(t *testing.T) {
+			persister := createPersister(t)
+			require.False(t, persister.IsInterfaceNil())
+
+			testFunc(t, persister)
+
+			err := persister.Destroy()
+			assert.Nil(t, err)
+		})
+	}
+}
+
+func testPersisterPutThenGet(t *testing.T, persister storage.Persister) {
+	key, val := []byte("key"), []byte("value")
+
+	err := persister.Put(key, val)
+	require.Nil(t, err)
+
+	recovered, err := persister.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, val, recovered)
+}
+
+func testPersisterGetMissingKey(t *testing.T, persister storage.Persister) {
+	recovered, err := persister.Get([]byte("missing key"))
+	assert.True(t, errors.Is(err, storage.ErrKeyNotFound))
+	assert.Nil(t, recovered)
+}
+
+func testPersisterHas(t *testing.T, persister storage.Persister) {
+	key, val := []byte("key"), []byte("value")
+
+	err := persister.Put(key, val)
+	require.Nil(t, err)
+
+	assert.Nil(t, persister.Has(key))
+	err = persister.Has([]byte("missing key"))
+	assert.True(t, errors.Is(err, storage.ErrKeyNotFound))
+}
+
+func testPersisterPutOverwrite(t *testing.T, persister storage.Persister) {
+	key := []byte("key")
+
+	err := persister.Put(key, []byte("value1"))
+	require.Nil(t, err)
+	err = persister.Put(key, []byte("value2"))
+	require.Nil(t, err)
+
+	recovered, err := persister.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value2"), recovered)
+}
+
+func testPersisterRemove(t *testing.T, persister storage.Persister) {
+	key, val := []byte("key"), []byte("value")
+
+	err := persister.Put(key, val)
+	require.Nil(t, err)
+
+	err = persister.Remove(key)
+	require.Nil(t, err)
+
+	recovered, err := persister.Get(key)
+	assert.NotNil(t, err)
+	assert.Nil(t, recovered)
+	assert.NotNil(t, persister.Has(key))
+}
+
+func testPersisterRemoveMissingKey(t *testing.T, persister storage.Persister) {
+	err := persister.Remove([]byte("missing key"))
+	assert.Nil(t, err)
+}
+
+func testPersisterRangeKeys(t *testing.T, persister storage.Persister) {
+	expected := putTestEntries(t, persister, 10)
+
+	recovered := make(map[string][]byte)
+	persister.RangeKeys(func(key []byte, val []byte) bool {
+		recovered[string(key)] = val
+		return true
+	})
+
+	assert.Equal(t, expected, recovered)
+}
+
+func testPersisterRangeKeysStop(t *testing.T, persister storage.Persister) {
+	_ = putTestEntries(t, persister, 10)
+
+	numCalls := 0
+	persister.RangeKeys(func(key []byte, val []byte) bool {
+		numCalls++
+		return numCalls < 3
+	})
+
+	assert.Equal(t, 3, numCalls)
+}
+
+func testPersisterRangeKeysNilHandler(t *testing.T, persister storage.Persister) {
+	_ = putTestEntries(t, persister, 2)
+
+	assert.NotPanics(t, func() {
+		persister.RangeKeys(nil)
+	})
+}
+
+func testPersisterRangeKeysAfterRemove(t *testing.T, persister storage.Persister) {
+	expected := putTestEntries(t, persister, 5)
+
+	removedKey := []byte("key0")
+	err := persister.Remove(removedKey)
+	require.Nil(t, err)
+	delete(expected, string(removedKey))
+
+	recovered := make(map[string][]byte)
+	persister.RangeKeys(func(key []byte, val []byte) bool {
+		recovered[string(key)] = val
+		return true
+	})
+
+	assert.Equal(t, expected, recovered)
+}
+
+func putTestEntries(t *testing.T, persister storage.Persister, numEntries int) map[string][]byte {
+	entries := make(map[string][]byte)
+	for i := 0; i < numEntries; i++ {
+		key := []byte(fmt.Sprintf("key%d", i))
+		val := []byte(fmt.Sprintf("value%d", i))
+
+		err := persister.Put(key, val)
+		require.Nil(t, err)
+
+		entries[string(key)] = val
+	}
+
+	return entries
+}
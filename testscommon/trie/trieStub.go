@@ -24,6 +24,12 @@ type TrieStub struct {
 	GetAllLeavesOnChannelCalled func(rootHash []byte) (chan core.KeyValueHolder, error)
 	GetProofCalled              func(key []byte) ([][]byte, []byte, error)
 	VerifyProofCalled           func(rootHash []byte, key []byte, proof [][]byte) (bool, error)
+	GetMultiProofCalled         func(keys [][]byte) ([][]byte, [][]byte, error)
+	GetRangeProofCalled         func(keySuffix []byte, maxNumNodes uint32) ([][]byte, []core.KeyValueHolder, error)
+	VerifyMultiProofCalled      func(rootHash []byte, keys [][]byte, values [][]byte, proof [][]byte) (bool, error)
+	VerifyRangeProofCalled      func(rootHash []byte, keySuffix []byte, leaves []core.KeyValueHolder, proof [][]byte) (bool, error)
+	GetLeavesDiffCalled         func(fromRootHash []byte, toRootHash []byte, startKey []byte, handler func(diff common.TrieLeafDiff) bool) error
+	CheckIntegrityCalled        func(rootHash []byte, leafHandler func(key []byte, value []byte) error) (*common.TrieIntegrityReport, error)
 	GetStorageManagerCalled     func() common.StorageManager
 	GetSerializedNodeCalled     func(bytes []byte) ([]byte, error)
 	GetNumNodesCalled           func() common.NumNodesDTO
@@ -58,6 +64,60 @@ func (ts *TrieStub) VerifyProof(rootHash []byte, key []byte, proof [][]byte) (bo
 	return false, nil
 }
 
+// GetMultiProof -
+func (ts *TrieStub) GetMultiProof(keys [][]byte) ([][]byte, [][]byte, error) {
+	if ts.GetMultiProofCalled != nil {
+		return ts.GetMultiProofCalled(keys)
+	}
+
+	return nil, nil, nil
+}
+
+// GetRangeProof -
+func (ts *TrieStub) GetRangeProof(keySuffix []byte, maxNumNodes uint32) ([][]byte, []core.KeyValueHolder, error) {
+	if ts.GetRangeProofCalled != nil {
+		return ts.GetRangeProofCalled(keySuffix, maxNumNodes)
+	}
+
+	return nil, nil, nil
+}
+
+// VerifyMultiProof -
+func (ts *TrieStub) VerifyMultiProof(rootHash []byte, keys [][]byte, values [][]byte, proof [][]byte) (bool, error) {
+	if ts.VerifyMultiProofCalled != nil {
+		return ts.VerifyMultiProofCalled(rootHash, keys, values, proof)
+	}
+
+	return false, nil
+}
+
+// VerifyRangeProof -
+func (ts *TrieStub) VerifyRangeProof(rootHash []byte, keySuffix []byte, leaves []core.KeyValueHolder, proof [][]byte) (bool, error) {
+	if ts.VerifyRangeProofCalled != nil {
+		return ts.VerifyRangeProofCalled(rootHash, keySuffix, leaves, proof)
+	}
+
+	return false, nil
+}
+
+// GetLeavesDiff -
+func (ts *TrieStub) GetLeavesDiff(fromRootHash []byte, toRootHash []byte, startKey []byte, handler func(diff common.TrieLeafDiff) bool) error {
+	if ts.GetLeavesDiffCalled != nil {
+		return ts.GetLeavesDiffCalled(fromRootHash, toRootHash, startKey, handler)
+	}
+
+	return nil
+}
+
+// CheckIntegrity -
+func (ts *TrieStub) CheckIntegrity(rootHash []byte, leafHandler func(key []byte, value []byte) error) (*common.TrieIntegrityReport, error) {
+	if ts.CheckIntegrityCalled != nil {
+		return ts.CheckIntegrityCalled(rootHash, leafHandler)
+	}
+
+	return &common.TrieIntegrityReport{}, nil
+}
+
 // GetAllLeavesOnChannel -
 func (ts *TrieStub) GetAllLeavesOnChannel(rootHash []byte) (chan core.KeyValueHolder, error) {
 	if ts.GetAllLeavesOnChannelCalled != nil {
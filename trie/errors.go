@@ -105,3 +105,12 @@ var ErrKeyNotFound = errors.New("key not found")
 
 // ErrNilEpochNotifier signals that the provided EpochNotifier is nil
 var ErrNilEpochNotifier = errors.New("nil EpochNotifier")
+
+// ErrKeysValuesLengthMismatch signals that the number of keys differs from the number of values
+var ErrKeysValuesLengthMismatch = errors.New("keys and values have different lengths")
+
+// ErrTooManyProofNodes signals that a range proof would hold more trie nodes than allowed
+var ErrTooManyProofNodes = errors.New("too many trie nodes in proof, use a longer key suffix")
+
+// ErrNilLeavesDiffHandler signals that a nil trie leaves diff handler was provided
+var ErrNilLeavesDiffHandler = errors.New("nil leaves diff handler")
@@ -0,0 +1,114 @@
+package trie
+
+import (
+	"bytes"
+
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+// integrityNode is a trie node waiting to be checked, along with the hex key walked in order to reach it
+type integrityNode struct {
+	hash      []byte
+	walkedKey []byte
+}
+
+type integrityChecker struct {
+	db          common.DBWriteCacher
+	marshalizer marshal.Marshalizer
+	hasher      hashing.Hasher
+	leafHandler func(key []byte, value []byte) error
+	report      *common.TrieIntegrityReport
+}
+
+// check walks the trie the same way the iterator does, reading each node from storage, but it does not stop at the
+// first node that can not be resolved. The nodes are kept on a stack instead of a queue, so the memory used is
+// bounded by the trie depth and not by its width
+func (checker *integrityChecker) check(rootHash []byte) error {
+	if emptyTrie(rootHash) {
+		return nil
+	}
+
+	nextNodes := []integrityNode{{hash: rootHash, walkedKey: make([]byte, 0)}}
+	for len(nextNodes) > 0 {
+		lastIndex := len(nextNodes) - 1
+		current := nextNodes[lastIndex]
+		nextNodes = nextNodes[:lastIndex]
+
+		children, err := checker.checkNode(current)
+		if err != nil {
+			return err
+		}
+
+		nextNodes = append(nextNodes, children...)
+	}
+
+	return nil
+}
+
+// checkNode verifies that the node is found in storage under its hash and that it can be decoded, and returns the
+// children which need to be checked next. Missing and corrupt nodes are added to the report, as their children can
+// not be reached
+func (checker *integrityChecker) checkNode(current integrityNode) ([]integrityNode, error) {
+	encodedNode, err := checker.db.Get(current.hash)
+	if isClosingError(err) {
+		return nil, err
+	}
+	if err != nil || len(encodedNode) == 0 {
+		log.Debug("missing trie node", "hash", current.hash)
+		checker.report.MissingNodes = append(checker.report.MissingNodes, current.hash)
+		return nil, nil
+	}
+
+	checker.report.NumNodes++
+	computedHash := checker.hasher.Compute(string(encodedNode))
+	if !bytes.Equal(computedHash, current.hash) {
+		log.Debug("corrupt trie node", "hash", current.hash, "computed hash", computedHash)
+		checker.report.CorruptNodes = append(checker.report.CorruptNodes, current.hash)
+		return nil, nil
+	}
+
+	decodedNode, err := decodeNode(encodedNode, checker.marshalizer, checker.hasher)
+	if err != nil {
+		log.Debug("corrupt trie node", "hash", current.hash, "error", err)
+		checker.report.CorruptNodes = append(checker.report.CorruptNodes, current.hash)
+		return nil, nil
+	}
+
+	switch n := decodedNode.(type) {
+	case *branchNode:
+		children := make([]integrityNode, 0, nrOfChildren)
+		for i, childHash := range n.EncodedChildren {
+			if len(childHash) == 0 {
+				continue
+			}
+
+			children = append(children, integrityNode{hash: childHash, walkedKey: concat(current.walkedKey, byte(i))})
+		}
+
+		return children, nil
+	case *extensionNode:
+		return []integrityNode{{hash: n.EncodedChild, walkedKey: concat(current.walkedKey, n.Key...)}}, nil
+	case *leafNode:
+		return nil, checker.checkLeaf(current, n)
+	default:
+		return nil, ErrInvalidNode
+	}
+}
+
+func (checker *integrityChecker) checkLeaf(current integrityNode, n *leafNode) error {
+	key, err := hexToKeyBytes(concat(current.walkedKey, n.Key...))
+	if err != nil {
+		log.Debug("corrupt trie leaf", "hash", current.hash, "error", err)
+		checker.report.CorruptNodes = append(checker.report.CorruptNodes, current.hash)
+		return nil
+	}
+
+	checker.report.NumLeaves++
+	if checker.leafHandler == nil {
+		return nil
+	}
+
+	return checker.leafHandler(key, n.Value)
+}
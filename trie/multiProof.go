@@ -0,0 +1,295 @@
+package trie
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/keyValStorage"
+)
+
+const noProofNodesLimit = math.MaxUint32
+
+type proofNodesCollector struct {
+	proof       [][]byte
+	addedNodes  map[string]struct{}
+	leaves      []core.KeyValueHolder
+	maxNumNodes uint32
+}
+
+func newProofNodesCollector(maxNumNodes uint32) *proofNodesCollector {
+	return &proofNodesCollector{
+		proof:       make([][]byte, 0),
+		addedNodes:  make(map[string]struct{}),
+		leaves:      make([]core.KeyValueHolder, 0),
+		maxNumNodes: maxNumNodes,
+	}
+}
+
+func (collector *proofNodesCollector) addNode(n node) error {
+	encodedNode, err := n.getEncodedNode()
+	if err != nil {
+		return err
+	}
+
+	_, found := collector.addedNodes[string(encodedNode)]
+	if found {
+		return nil
+	}
+	if uint32(len(collector.proof)) >= collector.maxNumNodes {
+		return fmt.Errorf("%w, maximum is %d", ErrTooManyProofNodes, collector.maxNumNodes)
+	}
+
+	collector.addedNodes[string(encodedNode)] = struct{}{}
+	collector.proof = append(collector.proof, encodedNode)
+
+	return nil
+}
+
+func (collector *proofNodesCollector) addLeaf(hexKey []byte, value []byte) error {
+	key, err := hexToKeyBytes(hexKey)
+	if err != nil {
+		return err
+	}
+
+	collector.leaves = append(collector.leaves, keyValStorage.NewKeyValStorage(key, value))
+
+	return nil
+}
+
+// keySuffixToHexPath returns the trie path shared by all the keys ending with the given suffix
+func keySuffixToHexPath(keySuffix []byte) []byte {
+	hexPath := keyBytesToHex(keySuffix)
+
+	return hexPath[:len(hexPath)-1]
+}
+
+func (tr *patriciaMerkleTrie) addKeyPathToProof(key []byte, collector *proofNodesCollector) ([]byte, error) {
+	hexKey := keyBytesToHex(key)
+	currentNode := tr.root
+
+	for {
+		err := collector.addNode(currentNode)
+		if err != nil {
+			return nil, err
+		}
+		value := currentNode.getValue()
+
+		currentNode, hexKey, err = currentNode.getNext(hexKey, tr.trieStorage)
+		if errors.Is(err, ErrNodeNotFound) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if currentNode == nil {
+			return value, nil
+		}
+	}
+}
+
+// addRangeToProof adds to the proof the nodes on the given path and, once the path is consumed, the whole subtree
+func (tr *patriciaMerkleTrie) addRangeToProof(n node, hexPath []byte, walkedKey []byte, collector *proofNodesCollector) error {
+	err := collector.addNode(n)
+	if err != nil {
+		return err
+	}
+
+	switch currentNode := n.(type) {
+	case *leafNode:
+		if !bytes.HasPrefix(currentNode.Key, hexPath) {
+			return nil
+		}
+
+		return collector.addLeaf(concat(walkedKey, currentNode.Key...), currentNode.Value)
+	case *extensionNode:
+		remainingPath, isInRange := getExtensionRemainingPath(currentNode.Key, hexPath)
+		if !isInRange {
+			return nil
+		}
+
+		err = resolveIfCollapsed(currentNode, 0, tr.trieStorage)
+		if err != nil {
+			return err
+		}
+
+		return tr.addRangeToProof(currentNode.child, remainingPath, concat(walkedKey, currentNode.Key...), collector)
+	case *branchNode:
+		for i := range currentNode.children {
+			pos := byte(i)
+			if len(hexPath) > 0 && hexPath[0] != pos {
+				continue
+			}
+
+			err = resolveIfCollapsed(currentNode, pos, tr.trieStorage)
+			if err != nil {
+				return err
+			}
+			if currentNode.children[pos] == nil {
+				continue
+			}
+
+			err = tr.addRangeToProof(currentNode.children[pos], trimFirstNibble(hexPath), concat(walkedKey, pos), collector)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	default:
+		return ErrWrongTypeAssertion
+	}
+}
+
+func (tr *patriciaMerkleTrie) getProofNodesByHash(proof [][]byte) map[string][]byte {
+	proofNodes := make(map[string][]byte, len(proof))
+	for _, encodedNode := range proof {
+		if len(encodedNode) == 0 {
+			continue
+		}
+
+		proofNodes[string(tr.hasher.Compute(string(encodedNode)))] = encodedNode
+	}
+
+	return proofNodes
+}
+
+func (tr *patriciaMerkleTrie) getNodeFromProof(proofNodes map[string][]byte, hash []byte) (node, bool, error) {
+	encodedNode, found := proofNodes[string(hash)]
+	if !found {
+		return nil, false, nil
+	}
+
+	n, err := decodeNode(encodedNode, tr.marshalizer, tr.hasher)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return n, true, nil
+}
+
+// getValueFromProof follows the path of the key through the proof nodes. It returns false if the proof is
+// incomplete, or the value bound to the key otherwise, which is nil if the proof attests the key absence
+func (tr *patriciaMerkleTrie) getValueFromProof(proofNodes map[string][]byte, rootHash []byte, key []byte) ([]byte, bool, error) {
+	hexKey := keyBytesToHex(key)
+	wantHash := rootHash
+
+	for {
+		n, found, err := tr.getNodeFromProof(proofNodes, wantHash)
+		if err != nil || !found {
+			return nil, false, err
+		}
+
+		switch currentNode := n.(type) {
+		case *leafNode:
+			if !bytes.Equal(currentNode.Key, hexKey) {
+				return nil, true, nil
+			}
+
+			return currentNode.Value, true, nil
+		case *extensionNode:
+			if !bytes.HasPrefix(hexKey, currentNode.Key) {
+				return nil, true, nil
+			}
+
+			hexKey = hexKey[len(currentNode.Key):]
+			wantHash = currentNode.EncodedChild
+		case *branchNode:
+			if len(hexKey) == 0 || childPosOutOfRange(hexKey[0]) {
+				return nil, false, nil
+			}
+
+			wantHash = currentNode.EncodedChildren[hexKey[0]]
+			if len(wantHash) == 0 {
+				return nil, true, nil
+			}
+			hexKey = hexKey[1:]
+		default:
+			return nil, false, ErrWrongTypeAssertion
+		}
+	}
+}
+
+// getRangeFromProof gathers the leaves on the given path out of the proof nodes. It returns false if any node of the
+// subtree found at the end of the path is missing from the proof
+func (tr *patriciaMerkleTrie) getRangeFromProof(
+	proofNodes map[string][]byte,
+	hash []byte,
+	hexPath []byte,
+	walkedKey []byte,
+	leaves map[string][]byte,
+) (bool, error) {
+	n, found, err := tr.getNodeFromProof(proofNodes, hash)
+	if err != nil || !found {
+		return false, err
+	}
+
+	switch currentNode := n.(type) {
+	case *leafNode:
+		if !bytes.HasPrefix(currentNode.Key, hexPath) {
+			return true, nil
+		}
+
+		hexKey := concat(walkedKey, currentNode.Key...)
+		if len(hexKey) == 0 {
+			return false, nil
+		}
+		key, errConvert := hexToKeyBytes(hexKey)
+		if errConvert != nil {
+			return false, errConvert
+		}
+
+		leaves[string(key)] = currentNode.Value
+		return true, nil
+	case *extensionNode:
+		remainingPath, isInRange := getExtensionRemainingPath(currentNode.Key, hexPath)
+		if !isInRange {
+			return true, nil
+		}
+
+		return tr.getRangeFromProof(proofNodes, currentNode.EncodedChild, remainingPath, concat(walkedKey, currentNode.Key...), leaves)
+	case *branchNode:
+		for i, childHash := range currentNode.EncodedChildren {
+			pos := byte(i)
+			if len(hexPath) > 0 && hexPath[0] != pos {
+				continue
+			}
+			if len(childHash) == 0 {
+				continue
+			}
+
+			verified, errGet := tr.getRangeFromProof(proofNodes, childHash, trimFirstNibble(hexPath), concat(walkedKey, pos), leaves)
+			if errGet != nil || !verified {
+				return false, errGet
+			}
+		}
+
+		return true, nil
+	default:
+		return false, ErrWrongTypeAssertion
+	}
+}
+
+// getExtensionRemainingPath returns the path left to walk after the extension node key, and false if none of the
+// extension node leaves are on the path
+func getExtensionRemainingPath(extensionKey []byte, hexPath []byte) ([]byte, bool) {
+	if len(hexPath) <= len(extensionKey) {
+		return nil, bytes.HasPrefix(extensionKey, hexPath)
+	}
+	if !bytes.HasPrefix(hexPath, extensionKey) {
+		return nil, false
+	}
+
+	return hexPath[len(extensionKey):], true
+}
+
+func trimFirstNibble(hexPath []byte) []byte {
+	if len(hexPath) == 0 {
+		return hexPath
+	}
+
+	return hexPath[1:]
+}
@@ -3,6 +3,7 @@ package trie
 import (
 	"bytes"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"sync"
 
@@ -569,6 +570,191 @@ func (tr *patriciaMerkleTrie) VerifyProof(rootHash []byte, key []byte, proof [][
 	return false, nil
 }
 
+// GetMultiProof computes a single Merkle proof for all the provided keys, each trie node shared by the keys paths
+// being included only once. The returned values follow the order of the keys. A nil value signals that the key is
+// not present in the trie, case in which the proof attests its absence
+func (tr *patriciaMerkleTrie) GetMultiProof(keys [][]byte) ([][]byte, [][]byte, error) {
+	tr.mutOperation.Lock()
+	defer tr.mutOperation.Unlock()
+
+	if tr.root == nil {
+		return nil, nil, ErrNilNode
+	}
+
+	err := tr.root.setRootHash()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	collector := newProofNodesCollector(noProofNodesLimit)
+	values := make([][]byte, 0, len(keys))
+	for _, key := range keys {
+		value, errAdd := tr.addKeyPathToProof(key, collector)
+		if errAdd != nil {
+			return nil, nil, errAdd
+		}
+
+		values = append(values, value)
+	}
+
+	return collector.proof, values, nil
+}
+
+// GetRangeProof computes a Merkle proof for all the leaves whose keys end with the provided suffix. As the key nibbles
+// are reversed in the trie, these leaves form a single subtree, which is included entirely in the proof. Only key
+// suffix ranges can be proven this way. The walk stops with ErrTooManyProofNodes once the proof would hold more
+// than maxNumNodes trie nodes
+func (tr *patriciaMerkleTrie) GetRangeProof(keySuffix []byte, maxNumNodes uint32) ([][]byte, []core.KeyValueHolder, error) {
+	tr.mutOperation.Lock()
+	defer tr.mutOperation.Unlock()
+
+	if tr.root == nil {
+		return nil, nil, ErrNilNode
+	}
+
+	err := tr.root.setRootHash()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	collector := newProofNodesCollector(maxNumNodes)
+	err = tr.addRangeToProof(tr.root, keySuffixToHexPath(keySuffix), make([]byte, 0), collector)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return collector.proof, collector.leaves, nil
+}
+
+// VerifyMultiProof verifies that the given multi-proof binds each key to its value. A nil or empty value stands for
+// a key that is absent from the trie
+func (tr *patriciaMerkleTrie) VerifyMultiProof(rootHash []byte, keys [][]byte, values [][]byte, proof [][]byte) (bool, error) {
+	tr.mutOperation.Lock()
+	defer tr.mutOperation.Unlock()
+
+	if len(keys) != len(values) {
+		return false, ErrKeysValuesLengthMismatch
+	}
+
+	proofNodes := tr.getProofNodesByHash(proof)
+	for i, key := range keys {
+		value, verified, err := tr.getValueFromProof(proofNodes, rootHash, key)
+		if err != nil {
+			return false, err
+		}
+		if !verified || !bytes.Equal(value, values[i]) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// VerifyRangeProof verifies that the given leaves are exactly the leaves of the trie whose keys end with the
+// provided suffix
+func (tr *patriciaMerkleTrie) VerifyRangeProof(rootHash []byte, keySuffix []byte, leaves []core.KeyValueHolder, proof [][]byte) (bool, error) {
+	tr.mutOperation.Lock()
+	defer tr.mutOperation.Unlock()
+
+	proofNodes := tr.getProofNodesByHash(proof)
+	provenLeaves := make(map[string][]byte)
+	verified, err := tr.getRangeFromProof(proofNodes, rootHash, keySuffixToHexPath(keySuffix), make([]byte, 0), provenLeaves)
+	if err != nil || !verified {
+		return false, err
+	}
+
+	if len(provenLeaves) != len(leaves) {
+		return false, nil
+	}
+	for _, leaf := range leaves {
+		value, found := provenLeaves[string(leaf.Key())]
+		if !found || !bytes.Equal(value, leaf.Value()) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// GetLeavesDiff walks the tries with the provided root hashes in lockstep, skipping the identical subtrees, and calls
+// the handler for each added, removed or modified leaf. The walk stops as soon as the handler returns false. The
+// leaves are always walked in the same order for the same root hashes: if a start key is provided, the walk resumes
+// at the leaf with this key, skipping the leaves walked before it
+func (tr *patriciaMerkleTrie) GetLeavesDiff(fromRootHash []byte, toRootHash []byte, startKey []byte, handler func(diff common.TrieLeafDiff) bool) error {
+	if handler == nil {
+		return ErrNilLeavesDiffHandler
+	}
+
+	tr.mutOperation.RLock()
+	tr.trieStorage.EnterPruningBufferingMode()
+	tr.mutOperation.RUnlock()
+
+	defer func() {
+		tr.mutOperation.Lock()
+		tr.trieStorage.ExitPruningBufferingMode()
+		tr.mutOperation.Unlock()
+	}()
+
+	walker := &leavesDiffWalker{
+		db:          tr.trieStorage,
+		marshalizer: tr.marshalizer,
+		hasher:      tr.hasher,
+		handler:     handler,
+	}
+	if len(startKey) > 0 {
+		walker.startHexKey = keyBytesToHex(startKey)
+	}
+
+	from, err := walker.getView(fromRootHash)
+	if err != nil {
+		return err
+	}
+	to, err := walker.getView(toRootHash)
+	if err != nil {
+		return err
+	}
+
+	err = walker.diff(from, to, make([]byte, 0))
+	if errors.Is(err, errDiffWalkStopped) {
+		return nil
+	}
+
+	return err
+}
+
+// CheckIntegrity walks all the nodes of the trie with the provided root hash, reading each one from storage and
+// verifying its hash. The missing and the corrupt nodes are reported, while the walk continues with the rest of the
+// trie. The leaf handler, if provided, is called with the key and the value of each leaf found
+func (tr *patriciaMerkleTrie) CheckIntegrity(rootHash []byte, leafHandler func(key []byte, value []byte) error) (*common.TrieIntegrityReport, error) {
+	tr.mutOperation.RLock()
+	tr.trieStorage.EnterPruningBufferingMode()
+	tr.mutOperation.RUnlock()
+
+	defer func() {
+		tr.mutOperation.Lock()
+		tr.trieStorage.ExitPruningBufferingMode()
+		tr.mutOperation.Unlock()
+	}()
+
+	checker := &integrityChecker{
+		db:          tr.trieStorage,
+		marshalizer: tr.marshalizer,
+		hasher:      tr.hasher,
+		leafHandler: leafHandler,
+		report: &common.TrieIntegrityReport{
+			MissingNodes: make([][]byte, 0),
+			CorruptNodes: make([][]byte, 0),
+		},
+	}
+
+	err := checker.check(rootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return checker.report, nil
+}
+
 // GetNumNodes will return the trie nodes statistics DTO
 func (tr *patriciaMerkleTrie) GetNumNodes() common.NumNodesDTO {
 	tr.mutOperation.Lock()
@@ -2,6 +2,7 @@ package trie_test
 
 import (
 	cryptoRand "crypto/rand"
+	"errors"
 	"fmt"
 	"math/rand"
 	"strconv"
@@ -709,6 +710,383 @@ func dumpTrieContents(tr common.Trie, values [][]byte) {
 	}
 }
 
+func TestPatriciaMerkleTrie_GetMultiProofEmptyTrieShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tr := emptyTrie(t)
+
+	proof, values, err := tr.GetMultiProof([][]byte{[]byte("dog")})
+	assert.Nil(t, proof)
+	assert.Nil(t, values)
+	assert.Equal(t, trie.ErrNilNode, err)
+}
+
+func TestPatriciaMerkleTrie_GetAndVerifyMultiProof(t *testing.T) {
+	t.Parallel()
+
+	tr, values := initTrieMultipleValues(t, 100)
+	rootHash, _ := tr.RootHash()
+
+	keys := make([][]byte, 0, 22)
+	keys = append(keys, values[:20]...)
+	keys = append(keys, []byte("absent key"), []byte("another absent key"))
+	proof, provenValues, err := tr.GetMultiProof(keys)
+	require.Nil(t, err)
+	require.Equal(t, len(keys), len(provenValues))
+	for i := 0; i < 20; i++ {
+		assert.Equal(t, values[i], provenValues[i])
+	}
+	assert.Nil(t, provenValues[20])
+	assert.Nil(t, provenValues[21])
+
+	numNodesInSeparateProofs := 0
+	for i := 0; i < 20; i++ {
+		singleProof, _, _ := tr.GetProof(keys[i])
+		numNodesInSeparateProofs += len(singleProof)
+	}
+	assert.True(t, len(proof) < numNodesInSeparateProofs)
+
+	ok, err := tr.VerifyMultiProof(rootHash, keys, provenValues, proof)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+
+	t.Run("changed value should not verify", func(t *testing.T) {
+		changedValues := make([][]byte, len(provenValues))
+		copy(changedValues, provenValues)
+		changedValues[0] = []byte("changed value")
+
+		ok, err = tr.VerifyMultiProof(rootHash, keys, changedValues, proof)
+		assert.Nil(t, err)
+		assert.False(t, ok)
+	})
+	t.Run("present key claimed absent should not verify", func(t *testing.T) {
+		changedValues := make([][]byte, len(provenValues))
+		copy(changedValues, provenValues)
+		changedValues[5] = nil
+
+		ok, err = tr.VerifyMultiProof(rootHash, keys, changedValues, proof)
+		assert.Nil(t, err)
+		assert.False(t, ok)
+	})
+	t.Run("key not covered by the proof should not verify", func(t *testing.T) {
+		ok, err = tr.VerifyMultiProof(rootHash, [][]byte{values[50]}, [][]byte{values[50]}, proof)
+		assert.Nil(t, err)
+		assert.False(t, ok)
+	})
+	t.Run("different number of keys and values should error", func(t *testing.T) {
+		ok, err = tr.VerifyMultiProof(rootHash, keys, provenValues[1:], proof)
+		assert.Equal(t, trie.ErrKeysValuesLengthMismatch, err)
+		assert.False(t, ok)
+	})
+}
+
+func TestPatriciaMerkleTrie_GetAndVerifyRangeProof(t *testing.T) {
+	t.Parallel()
+
+	tr := emptyTrie(t)
+	suffix := []byte("-suffix")
+	expectedLeaves := make(map[string][]byte)
+	for i := 0; i < 30; i++ {
+		key := []byte(fmt.Sprintf("key%d", i))
+		_ = tr.Update(key, key)
+
+		keyInRange := append([]byte(fmt.Sprintf("key%d", i)), suffix...)
+		_ = tr.Update(keyInRange, []byte(fmt.Sprintf("value%d", i)))
+		expectedLeaves[string(keyInRange)] = []byte(fmt.Sprintf("value%d", i))
+	}
+	rootHash, _ := tr.RootHash()
+
+	proof, leaves, err := tr.GetRangeProof(suffix, 1000)
+	require.Nil(t, err)
+	require.Equal(t, len(expectedLeaves), len(leaves))
+	for _, leaf := range leaves {
+		assert.Equal(t, expectedLeaves[string(leaf.Key())], leaf.Value())
+	}
+
+	ok, err := tr.VerifyRangeProof(rootHash, suffix, leaves, proof)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+
+	t.Run("missing leaf should not verify", func(t *testing.T) {
+		ok, err = tr.VerifyRangeProof(rootHash, suffix, leaves[1:], proof)
+		assert.Nil(t, err)
+		assert.False(t, ok)
+	})
+	t.Run("incomplete proof should not verify", func(t *testing.T) {
+		ok, err = tr.VerifyRangeProof(rootHash, suffix, leaves, proof[:len(proof)-1])
+		assert.Nil(t, err)
+		assert.False(t, ok)
+	})
+	t.Run("suffix with no keys should return an absence proof", func(t *testing.T) {
+		absenceProof, noLeaves, errGet := tr.GetRangeProof([]byte("missing"), 1000)
+		require.Nil(t, errGet)
+		assert.Equal(t, 0, len(noLeaves))
+
+		ok, err = tr.VerifyRangeProof(rootHash, []byte("missing"), noLeaves, absenceProof)
+		assert.Nil(t, err)
+		assert.True(t, ok)
+	})
+	t.Run("range holding more nodes than allowed should error", func(t *testing.T) {
+		tooLargeProof, noLeaves, errGet := tr.GetRangeProof(suffix, uint32(len(proof)-1))
+		assert.True(t, errors.Is(errGet, trie.ErrTooManyProofNodes))
+		assert.Nil(t, tooLargeProof)
+		assert.Nil(t, noLeaves)
+
+		_, _, errGet = tr.GetRangeProof(suffix, uint32(len(proof)))
+		assert.Nil(t, errGet)
+	})
+}
+
+func getLeavesDiff(t *testing.T, tr common.Trie, fromRootHash []byte, toRootHash []byte) map[string]common.TrieLeafDiff {
+	diffs := make(map[string]common.TrieLeafDiff)
+	err := tr.GetLeavesDiff(fromRootHash, toRootHash, nil, func(diff common.TrieLeafDiff) bool {
+		_, found := diffs[string(diff.Key)]
+		assert.False(t, found)
+		diffs[string(diff.Key)] = diff
+
+		return true
+	})
+	require.Nil(t, err)
+
+	return diffs
+}
+
+func TestPatriciaMerkleTrie_GetLeavesDiffNilHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie(t)
+
+	err := tr.GetLeavesDiff(nil, nil, nil, nil)
+	assert.Equal(t, trie.ErrNilLeavesDiffHandler, err)
+}
+
+func TestPatriciaMerkleTrie_GetLeavesDiff(t *testing.T) {
+	t.Parallel()
+
+	tr := emptyTrie(t)
+	oldValues := make(map[string][]byte)
+	for i := 0; i < 200; i++ {
+		key := []byte(fmt.Sprintf("key%d", i))
+		_ = tr.Update(key, key)
+		oldValues[string(key)] = key
+	}
+	_ = tr.Commit()
+	fromRootHash, _ := tr.RootHash()
+
+	newValues := make(map[string][]byte)
+	for key, value := range oldValues {
+		newValues[key] = value
+	}
+	for i := 0; i < 200; i += 7 {
+		key := []byte(fmt.Sprintf("key%d", i))
+		_ = tr.Delete(key)
+		delete(newValues, string(key))
+	}
+	for i := 1; i < 200; i += 5 {
+		key := []byte(fmt.Sprintf("key%d", i))
+		_ = tr.Update(key, []byte("modified"))
+		newValues[string(key)] = []byte("modified")
+	}
+	for i := 200; i < 250; i++ {
+		key := []byte(fmt.Sprintf("key%d", i))
+		_ = tr.Update(key, key)
+		newValues[string(key)] = key
+	}
+	_ = tr.Update([]byte("ey1"), []byte("shorter key"))
+	newValues["ey1"] = []byte("shorter key")
+	_ = tr.Commit()
+	toRootHash, _ := tr.RootHash()
+
+	expectedDiffs := make(map[string]common.TrieLeafDiff)
+	for key, oldValue := range oldValues {
+		newValue := newValues[key]
+		if string(oldValue) != string(newValue) {
+			expectedDiffs[key] = common.TrieLeafDiff{Key: []byte(key), OldValue: oldValue, NewValue: newValue}
+		}
+	}
+	for key, newValue := range newValues {
+		_, found := oldValues[key]
+		if !found {
+			expectedDiffs[key] = common.TrieLeafDiff{Key: []byte(key), NewValue: newValue}
+		}
+	}
+
+	t.Run("from old to new root hash", func(t *testing.T) {
+		assert.Equal(t, expectedDiffs, getLeavesDiff(t, tr, fromRootHash, toRootHash))
+	})
+	t.Run("from new to old root hash", func(t *testing.T) {
+		diffs := getLeavesDiff(t, tr, toRootHash, fromRootHash)
+		require.Equal(t, len(expectedDiffs), len(diffs))
+		for key, expectedDiff := range expectedDiffs {
+			assert.Equal(t, expectedDiff.OldValue, diffs[key].NewValue)
+			assert.Equal(t, expectedDiff.NewValue, diffs[key].OldValue)
+		}
+	})
+	t.Run("same root hash should return no diff", func(t *testing.T) {
+		assert.Equal(t, 0, len(getLeavesDiff(t, tr, toRootHash, toRootHash)))
+	})
+	t.Run("from empty root hash should return all leaves as added", func(t *testing.T) {
+		diffs := getLeavesDiff(t, tr, trie.EmptyTrieHash, toRootHash)
+		require.Equal(t, len(newValues), len(diffs))
+		for key, value := range newValues {
+			assert.Nil(t, diffs[key].OldValue)
+			assert.Equal(t, value, diffs[key].NewValue)
+		}
+	})
+	t.Run("to empty root hash should return all leaves as removed", func(t *testing.T) {
+		diffs := getLeavesDiff(t, tr, fromRootHash, nil)
+		require.Equal(t, len(oldValues), len(diffs))
+		for key, value := range oldValues {
+			assert.Equal(t, value, diffs[key].OldValue)
+			assert.Nil(t, diffs[key].NewValue)
+		}
+	})
+	t.Run("handler returning false should stop the walk", func(t *testing.T) {
+		numCalls := 0
+		err := tr.GetLeavesDiff(fromRootHash, toRootHash, nil, func(_ common.TrieLeafDiff) bool {
+			numCalls++
+			return numCalls < 3
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, 3, numCalls)
+	})
+	t.Run("start key should resume the walk from that key", func(t *testing.T) {
+		getOrderedKeys := func(fromRootHash []byte, toRootHash []byte, startKey []byte) []string {
+			keys := make([]string, 0)
+			err := tr.GetLeavesDiff(fromRootHash, toRootHash, startKey, func(diff common.TrieLeafDiff) bool {
+				keys = append(keys, string(diff.Key))
+				return true
+			})
+			require.Nil(t, err)
+
+			return keys
+		}
+
+		for _, rootHashes := range [][2][]byte{{fromRootHash, toRootHash}, {toRootHash, fromRootHash}, {trie.EmptyTrieHash, toRootHash}} {
+			allKeys := getOrderedKeys(rootHashes[0], rootHashes[1], nil)
+			require.Equal(t, len(allKeys), len(getOrderedKeys(rootHashes[0], rootHashes[1], []byte{})))
+			for i := 0; i < len(allKeys); i += 9 {
+				assert.Equal(t, allKeys[i:], getOrderedKeys(rootHashes[0], rootHashes[1], []byte(allKeys[i])))
+			}
+		}
+	})
+	t.Run("missing root hash should err", func(t *testing.T) {
+		err := tr.GetLeavesDiff(fromRootHash, []byte("missing root hash"), nil, func(_ common.TrieLeafDiff) bool {
+			return true
+		})
+		assert.NotNil(t, err)
+	})
+}
+
+func TestPatriciaMerkleTrie_GetLeavesDiffSmallTries(t *testing.T) {
+	t.Parallel()
+
+	tr := emptyTrie(t)
+	_ = tr.Update([]byte("doe"), []byte("reindeer"))
+	_ = tr.Commit()
+	singleLeafRootHash, _ := tr.RootHash()
+
+	_ = tr.Update([]byte("dog"), []byte("puppy"))
+	_ = tr.Update([]byte("ddog"), []byte("cat"))
+	_ = tr.Commit()
+	rootHash, _ := tr.RootHash()
+
+	expectedDiffs := map[string]common.TrieLeafDiff{
+		"dog":  {Key: []byte("dog"), NewValue: []byte("puppy")},
+		"ddog": {Key: []byte("ddog"), NewValue: []byte("cat")},
+	}
+	assert.Equal(t, expectedDiffs, getLeavesDiff(t, tr, singleLeafRootHash, rootHash))
+
+	_ = tr.Update([]byte("doe"), []byte("deer"))
+	_ = tr.Delete([]byte("dog"))
+	_ = tr.Delete([]byte("ddog"))
+	_ = tr.Commit()
+	modifiedLeafRootHash, _ := tr.RootHash()
+
+	expectedDiffs = map[string]common.TrieLeafDiff{
+		"doe":  {Key: []byte("doe"), OldValue: []byte("reindeer"), NewValue: []byte("deer")},
+		"dog":  {Key: []byte("dog"), OldValue: []byte("puppy")},
+		"ddog": {Key: []byte("ddog"), OldValue: []byte("cat")},
+	}
+	assert.Equal(t, expectedDiffs, getLeavesDiff(t, tr, rootHash, modifiedLeafRootHash))
+}
+
+func TestPatriciaMerkleTrie_CheckIntegrity(t *testing.T) {
+	t.Parallel()
+
+	numLeaves := 100
+	createCommittedTrie := func() (common.Trie, []byte, [][]byte) {
+		tr, values := initTrieMultipleValues(t, numLeaves)
+		_ = tr.Commit()
+		rootHash, _ := tr.RootHash()
+
+		return tr, rootHash, values
+	}
+
+	t.Run("empty root hash should return an empty report", func(t *testing.T) {
+		t.Parallel()
+
+		tr := emptyTrie(t)
+		report, err := tr.CheckIntegrity(emptyTrieHash, nil)
+		require.Nil(t, err)
+		assert.Equal(t, uint64(0), report.NumNodes)
+		assert.Empty(t, report.MissingNodes)
+		assert.Empty(t, report.CorruptNodes)
+	})
+	t.Run("intact trie should call the leaf handler for each leaf", func(t *testing.T) {
+		t.Parallel()
+
+		tr, rootHash, values := createCommittedTrie()
+		allHashes, _ := tr.GetAllHashes()
+
+		leaves := make(map[string][]byte)
+		report, err := tr.CheckIntegrity(rootHash, func(key []byte, value []byte) error {
+			leaves[string(key)] = value
+			return nil
+		})
+		require.Nil(t, err)
+		assert.Equal(t, uint64(len(allHashes)), report.NumNodes)
+		assert.Equal(t, uint64(numLeaves), report.NumLeaves)
+		assert.Empty(t, report.MissingNodes)
+		assert.Empty(t, report.CorruptNodes)
+		require.Len(t, leaves, numLeaves)
+		for _, value := range values {
+			assert.Equal(t, value, leaves[string(value)])
+		}
+	})
+	t.Run("missing and corrupt nodes should be reported", func(t *testing.T) {
+		t.Parallel()
+
+		tr, rootHash, _ := createCommittedTrie()
+		allHashes, _ := tr.GetAllHashes()
+		missingHash := allHashes[0]
+		corruptHash := allHashes[1]
+		_ = tr.GetStorageManager().Remove(missingHash)
+		_ = tr.GetStorageManager().Put(corruptHash, []byte("corrupt node"))
+
+		report, err := tr.CheckIntegrity(rootHash, nil)
+		require.Nil(t, err)
+		assert.Equal(t, [][]byte{missingHash}, report.MissingNodes)
+		assert.Equal(t, [][]byte{corruptHash}, report.CorruptNodes)
+		assert.Equal(t, uint64(len(allHashes)-1), report.NumNodes)
+		assert.True(t, report.NumLeaves < uint64(numLeaves))
+	})
+	t.Run("leaf handler error should stop the walk", func(t *testing.T) {
+		t.Parallel()
+
+		tr, rootHash, _ := createCommittedTrie()
+		expectedErr := fmt.Errorf("expected error")
+		numCalls := 0
+		report, err := tr.CheckIntegrity(rootHash, func(_ []byte, _ []byte) error {
+			numCalls++
+			return expectedErr
+		})
+		assert.Nil(t, report)
+		assert.Equal(t, expectedErr, err)
+		assert.Equal(t, 1, numCalls)
+	})
+}
+
 func TestPatriciaMerkleTrie_GetNumNodesNilRootShouldReturnEmpty(t *testing.T) {
 	t.Parallel()
 
@@ -1,6 +1,7 @@
 package trie
 
 import (
+	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go-core/hashing"
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
@@ -31,3 +32,13 @@ func NewMerkleProofVerifier(marshalizer marshal.Marshalizer, hasher hashing.Hash
 func (mpv *merkleProofVerifier) VerifyProof(rootHash []byte, key []byte, proof [][]byte) (bool, error) {
 	return mpv.trie.VerifyProof(rootHash, key, proof)
 }
+
+// VerifyMultiProof verifies the given Merkle multi-proof against the provided keys and values
+func (mpv *merkleProofVerifier) VerifyMultiProof(rootHash []byte, keys [][]byte, values [][]byte, proof [][]byte) (bool, error) {
+	return mpv.trie.VerifyMultiProof(rootHash, keys, values, proof)
+}
+
+// VerifyRangeProof verifies the given Merkle range proof against the provided leaves
+func (mpv *merkleProofVerifier) VerifyRangeProof(rootHash []byte, keySuffix []byte, leaves []core.KeyValueHolder, proof [][]byte) (bool, error) {
+	return mpv.trie.VerifyRangeProof(rootHash, keySuffix, leaves, proof)
+}
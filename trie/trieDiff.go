@@ -0,0 +1,283 @@
+package trie
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+var errDiffWalkStopped = errors.New("trie diff walk stopped by the handler")
+
+// diffView is a lazily resolved position inside a trie: the node found at the position and the number of nibbles of
+// the node key already walked. Positions inside extension and leaf keys are needed because the two tries might split
+// the same path at different depths
+type diffView struct {
+	hash []byte
+	n    node
+	skip int
+}
+
+// isWholeNode returns true if none of the view's node key nibbles were walked, case in which the view hash
+// identifies the whole subtree found at the view position
+func (view *diffView) isWholeNode() bool {
+	return view.skip == 0
+}
+
+type leavesDiffWalker struct {
+	db          common.DBWriteCacher
+	marshalizer marshal.Marshalizer
+	hasher      hashing.Hasher
+	handler     func(diff common.TrieLeafDiff) bool
+	startHexKey []byte
+}
+
+// isBeforeStart returns true if all the leaves found under the path were walked before the start key, so that the
+// whole subtree can be skipped. Once the walk goes past the start key path the start key is dropped, so that a start
+// key which is not a leaf of the diff resumes the walk at its position
+func (walker *leavesDiffWalker) isBeforeStart(hexPath []byte) bool {
+	if walker.startHexKey == nil {
+		return false
+	}
+
+	prefixLen := len(hexPath)
+	if prefixLen > len(walker.startHexKey) {
+		prefixLen = len(walker.startHexKey)
+	}
+
+	comparison := bytes.Compare(hexPath[:prefixLen], walker.startHexKey[:prefixLen])
+	if comparison > 0 {
+		walker.startHexKey = nil
+	}
+
+	return comparison < 0
+}
+
+func (walker *leavesDiffWalker) getView(hash []byte) (*diffView, error) {
+	if emptyTrie(hash) {
+		return nil, nil
+	}
+
+	n, err := getNodeFromDBAndDecode(hash, walker.db, walker.marshalizer, walker.hasher)
+	if err != nil {
+		return nil, err
+	}
+
+	return &diffView{hash: hash, n: n}, nil
+}
+
+// getChildView returns the view found one nibble below the provided view, on the given position
+func (walker *leavesDiffWalker) getChildView(view *diffView, pos byte) (*diffView, error) {
+	if view == nil {
+		return nil, nil
+	}
+
+	switch currentNode := view.n.(type) {
+	case *branchNode:
+		if childPosOutOfRange(pos) {
+			return nil, nil
+		}
+
+		return walker.getView(currentNode.EncodedChildren[pos])
+	case *extensionNode:
+		if currentNode.Key[view.skip] != pos {
+			return nil, nil
+		}
+		if view.skip+1 == len(currentNode.Key) {
+			return walker.getView(currentNode.EncodedChild)
+		}
+
+		return &diffView{hash: view.hash, n: currentNode, skip: view.skip + 1}, nil
+	default:
+		return nil, ErrWrongTypeAssertion
+	}
+}
+
+func (walker *leavesDiffWalker) diff(from *diffView, to *diffView, walkedKey []byte) error {
+	if from == nil && to == nil {
+		return nil
+	}
+	if from == nil {
+		return walker.walkLeaves(to, walkedKey, true, func(hexKey []byte, value []byte) error {
+			return walker.emit(hexKey, nil, value)
+		})
+	}
+	if to == nil {
+		return walker.walkLeaves(from, walkedKey, true, func(hexKey []byte, value []byte) error {
+			return walker.emit(hexKey, value, nil)
+		})
+	}
+	if from.skip == to.skip && bytes.Equal(from.hash, to.hash) {
+		return nil
+	}
+
+	fromLeaf, isFromLeaf := from.n.(*leafNode)
+	toLeaf, isToLeaf := to.n.(*leafNode)
+	switch {
+	case isFromLeaf && isToLeaf:
+		return walker.diffLeaves(fromLeaf.Key[from.skip:], fromLeaf.Value, toLeaf.Key[to.skip:], toLeaf.Value, walkedKey)
+	case isFromLeaf:
+		return walker.diffLeafWithSubtree(fromLeaf.Key[from.skip:], fromLeaf.Value, to, walkedKey, true)
+	case isToLeaf:
+		return walker.diffLeafWithSubtree(toLeaf.Key[to.skip:], toLeaf.Value, from, walkedKey, false)
+	}
+
+	for i := 0; i < nrOfChildren; i++ {
+		pos := byte(i)
+		if walker.isBeforeStart(concat(walkedKey, pos)) {
+			continue
+		}
+
+		fromChild, err := walker.getChildView(from, pos)
+		if err != nil {
+			return err
+		}
+		toChild, err := walker.getChildView(to, pos)
+		if err != nil {
+			return err
+		}
+
+		err = walker.diff(fromChild, toChild, concat(walkedKey, pos))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (walker *leavesDiffWalker) diffLeaves(fromKey []byte, fromValue []byte, toKey []byte, toValue []byte, walkedKey []byte) error {
+	if bytes.Equal(fromKey, toKey) {
+		if bytes.Equal(fromValue, toValue) {
+			return nil
+		}
+
+		return walker.emit(concat(walkedKey, fromKey...), fromValue, toValue)
+	}
+
+	err := walker.emit(concat(walkedKey, fromKey...), fromValue, nil)
+	if err != nil {
+		return err
+	}
+
+	return walker.emit(concat(walkedKey, toKey...), nil, toValue)
+}
+
+// diffLeafWithSubtree compares a single leaf against all the leaves of the subtree found on the other trie, at the
+// same position. The leaf belongs to the old trie if isLeafFromOldTrie is set, or to the new trie otherwise
+func (walker *leavesDiffWalker) diffLeafWithSubtree(
+	leafKey []byte,
+	leafValue []byte,
+	subtree *diffView,
+	walkedKey []byte,
+	isLeafFromOldTrie bool,
+) error {
+	leafHexKey := concat(walkedKey, leafKey...)
+	leafFound := false
+	// the lone leaf is emitted after the subtree leaves, so the subtree can not be pruned by the start key
+	err := walker.walkLeaves(subtree, walkedKey, false, func(hexKey []byte, value []byte) error {
+		oldValue, newValue := value, []byte(nil)
+		if isLeafFromOldTrie {
+			oldValue, newValue = nil, value
+		}
+
+		if !bytes.Equal(hexKey, leafHexKey) {
+			return walker.emit(hexKey, oldValue, newValue)
+		}
+
+		leafFound = true
+		if bytes.Equal(leafValue, value) {
+			return nil
+		}
+		if isLeafFromOldTrie {
+			return walker.emit(hexKey, leafValue, value)
+		}
+
+		return walker.emit(hexKey, value, leafValue)
+	})
+	if err != nil || leafFound {
+		return err
+	}
+
+	if isLeafFromOldTrie {
+		return walker.emit(leafHexKey, leafValue, nil)
+	}
+
+	return walker.emit(leafHexKey, nil, leafValue)
+}
+
+// walkLeaves calls the provided function for all the leaves of the subtree found at the view position. The leaves
+// are walked in the trie order, so the branches found before the start key are skipped if canSkipBranches is set
+func (walker *leavesDiffWalker) walkLeaves(
+	view *diffView,
+	walkedKey []byte,
+	canSkipBranches bool,
+	leafHandler func(hexKey []byte, value []byte) error,
+) error {
+	switch currentNode := view.n.(type) {
+	case *leafNode:
+		return leafHandler(concat(walkedKey, currentNode.Key[view.skip:]...), currentNode.Value)
+	case *extensionNode:
+		child, err := walker.getView(currentNode.EncodedChild)
+		if err != nil {
+			return err
+		}
+		if child == nil {
+			return nil
+		}
+
+		return walker.walkLeaves(child, concat(walkedKey, currentNode.Key[view.skip:]...), canSkipBranches, leafHandler)
+	case *branchNode:
+		for i, childHash := range currentNode.EncodedChildren {
+			childPath := concat(walkedKey, byte(i))
+			if canSkipBranches && walker.isBeforeStart(childPath) {
+				continue
+			}
+
+			child, err := walker.getView(childHash)
+			if err != nil {
+				return err
+			}
+			if child == nil {
+				continue
+			}
+
+			err = walker.walkLeaves(child, childPath, canSkipBranches, leafHandler)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	default:
+		return ErrWrongTypeAssertion
+	}
+}
+
+func (walker *leavesDiffWalker) emit(hexKey []byte, oldValue []byte, newValue []byte) error {
+	if walker.startHexKey != nil {
+		if !bytes.Equal(hexKey, walker.startHexKey) {
+			return nil
+		}
+
+		walker.startHexKey = nil
+	}
+
+	key, err := hexToKeyBytes(hexKey)
+	if err != nil {
+		return err
+	}
+
+	shouldContinue := walker.handler(common.TrieLeafDiff{
+		Key:      key,
+		OldValue: oldValue,
+		NewValue: newValue,
+	})
+	if !shouldContinue {
+		return errDiffWalkStopped
+	}
+
+	return nil
+}